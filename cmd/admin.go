@@ -0,0 +1,227 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/models/backup"
+	backupfile "github.com/gleb-korostelev/GophKeeper/pkg/backup"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	backupSvc "github.com/gleb-korostelev/GophKeeper/service/backup"
+	"github.com/spf13/cobra"
+)
+
+// newAdminCmd creates the command group for operators of a deployment.
+func newAdminCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "admin",
+		Short: "Operate the GophKeeper deployment",
+	}
+	cmd.AddCommand(newBackupKeygenCmd(), newBackupCmd(), newRestoreCmd())
+	return cmd
+}
+
+// newBackupKeygenCmd creates the command generating an operator key pair for backups.
+func newBackupKeygenCmd() *cobra.Command {
+	var privateKeyFile, publicKeyFile string
+
+	cmd := &cobra.Command{
+		Use:   "backup-keygen",
+		Short: "Generate an operator key pair for backups",
+		Long: "Generate the operator key pair backups are encrypted to. The public key is all hosts taking backups need;\n" +
+			"keep the private key offline, since it decrypts every backup encrypted to the public key.\n" +
+			"Existing files are never overwritten.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			key, err := backupfile.GenerateKey()
+			if err != nil {
+				return err
+			}
+			if err = writeNewFile(privateKeyFile, backupfile.MarshalPrivateKey(key), 0o600); err != nil {
+				return err
+			}
+			if err = writeNewFile(publicKeyFile, backupfile.MarshalPublicKey(key.PublicKey()), 0o644); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Generated operator key %s\n", backupfile.KeyID(key.PublicKey()))
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&privateKeyFile, "private-key", "", "file to write the private key to")
+	cmd.Flags().StringVar(&publicKeyFile, "public-key", "", "file to write the public key to")
+	_ = cmd.MarkFlagRequired("private-key")
+	_ = cmd.MarkFlagRequired("public-key")
+
+	return cmd
+}
+
+// newBackupCmd creates the command backing up the vault database.
+func newBackupCmd() *cobra.Command {
+	var publicKeyFile, out string
+
+	cmd := &cobra.Command{
+		Use:   "backup",
+		Short: "Back up the vault database",
+		Long: "Write a backup of all tables of the vault and of the attachment contents kept in Postgres, taken as a\n" +
+			"consistent snapshot while the servers keep running. The backup is compressed, checksummed and encrypted\n" +
+			"to the operator public key; restore it with 'admin restore'. The contents of the \"local\" blob store\n" +
+			"are files outside the database and must be backed up along with their directory.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			data, err := os.ReadFile(publicKeyFile)
+			if err != nil {
+				return err
+			}
+			recipient, err := backupfile.ParsePublicKey(data)
+			if err != nil {
+				return err
+			}
+
+			db, err := openDB(cmd)
+			if err != nil {
+				return err
+			}
+			defer db.GetConn().Close()
+
+			f, err := os.OpenFile(out, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+			if err != nil {
+				return err
+			}
+			// A failed backup is removed rather than left to be mistaken for a complete one.
+			defer func() {
+				if cerr := f.Close(); err == nil {
+					err = cerr
+				}
+				if err != nil {
+					_ = os.Remove(out)
+				}
+			}()
+
+			summary, err := backupSvc.NewService(db).Backup(cmd.Context(), f, recipient)
+			if err != nil {
+				return err
+			}
+			if err = f.Sync(); err != nil {
+				return err
+			}
+			return printSummary(cmd.OutOrStdout(), "Backup written", summary)
+		},
+	}
+
+	cmd.Flags().StringVar(&publicKeyFile, "public-key", "", "operator public key file to encrypt the backup to")
+	cmd.Flags().StringVarP(&out, "out", "o", "", "file to write; an existing file is never overwritten")
+	_ = cmd.MarkFlagRequired("public-key")
+	_ = cmd.MarkFlagRequired("out")
+
+	return cmd
+}
+
+// newRestoreCmd creates the command restoring the vault database from a backup.
+func newRestoreCmd() *cobra.Command {
+	var (
+		privateKeyFile string
+		in             string
+		verifyOnly     bool
+		force          bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "restore",
+		Short: "Restore the vault database from a backup",
+		Long: "Restore a backup written by 'admin backup' into the configured database, in a single transaction.\n" +
+			"The database schema must be at the version the backup was taken from: create it with 'migrate up'\n" +
+			"of the same release. The vault must be empty unless --force is given, which removes everything in it.\n" +
+			"With --verify-only the backup is decrypted and checked entirely without connecting to a database.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			data, err := os.ReadFile(privateKeyFile)
+			if err != nil {
+				return err
+			}
+			identity, err := backupfile.ParsePrivateKey(data)
+			if err != nil {
+				return err
+			}
+
+			var r io.Reader = cmd.InOrStdin()
+			if in != "-" {
+				f, err := os.Open(in)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				r = f
+			}
+
+			if verifyOnly {
+				summary, err := backupSvc.NewService(nil).Verify(cmd.Context(), r, identity)
+				if err != nil {
+					return err
+				}
+				return printSummary(cmd.OutOrStdout(), "Backup verified", summary)
+			}
+
+			db, err := openDB(cmd)
+			if err != nil {
+				return err
+			}
+			defer db.GetConn().Close()
+
+			summary, err := backupSvc.NewService(db).Restore(cmd.Context(), r, identity, force)
+			switch {
+			case errors.Is(err, svc.ErrVaultNotEmpty):
+				return fmt.Errorf("%w; use --force to remove everything in it", err)
+			case errors.Is(err, svc.ErrBackupSchemaMismatch):
+				return fmt.Errorf("%w; migrate the database with the release that took the backup", err)
+			case err != nil:
+				return err
+			}
+			return printSummary(cmd.OutOrStdout(), "Backup restored", summary)
+		},
+	}
+
+	cmd.Flags().StringVar(&privateKeyFile, "private-key", "", "operator private key file to decrypt the backup with")
+	cmd.Flags().StringVarP(&in, "in", "i", "", "backup file to read, - for standard input")
+	cmd.Flags().BoolVar(&verifyOnly, "verify-only", false, "only check the backup, without restoring it")
+	cmd.Flags().BoolVar(&force, "force", false, "restore into a vault that is not empty, removing everything in it")
+	_ = cmd.MarkFlagRequired("private-key")
+	_ = cmd.MarkFlagRequired("in")
+
+	return cmd
+}
+
+// printSummary prints what a backup holds, followed by the outcome of the command.
+func printSummary(w io.Writer, outcome string, summary backup.Summary) error {
+	fmt.Fprintf(w, "Snapshot of %s, schema version %d, encrypted to key %s\n",
+		summary.CreatedAt.Format(time.RFC3339), summary.SchemaVersion, summary.KeyID)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "TABLE\tROWS")
+	for _, t := range summary.Tables {
+		fmt.Fprintf(tw, "%s\t%d\n", t.Name, t.Rows)
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "Blobs: %d (%d bytes)\n%s\n", summary.Blobs, summary.BlobBytes, outcome)
+	return nil
+}
+
+// writeNewFile writes data to a file that must not exist yet.
+func writeNewFile(name string, data []byte, perm os.FileMode) error {
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/gleb-korostelev/GophKeeper/config"
+	"github.com/spf13/cobra"
+)
+
+// newConfigCmd creates the command group inspecting the configuration.
+func newConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Inspect the configuration",
+	}
+
+	checkCmd := &cobra.Command{
+		Use:   "check",
+		Short: "Print the effective configuration and report its problems",
+		Long: "Print the effective configuration, built from the defaults, the configuration file, the environment\n" +
+			"and the flags, noting where each setting was set from. Secrets are redacted.\n" +
+			"All problems are reported, and the command fails if there are any.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := config.Load(cmd.Flags())
+			if dumpErr := cfg.Dump(cmd.OutOrStdout()); dumpErr != nil {
+				return dumpErr
+			}
+			if err != nil {
+				return fmt.Errorf("invalid configuration:\n%w", err)
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), "# configuration is valid")
+			return nil
+		},
+	}
+
+	cmd.AddCommand(checkCmd)
+	return cmd
+}
+
+// loadConfig loads the configuration of a command, failing on any problem.
+func loadConfig(cmd *cobra.Command) (config.Config, error) {
+	cfg, err := config.Load(cmd.Flags())
+	if err != nil {
+		return config.Config{}, fmt.Errorf("invalid configuration, see the config check command:\n%w", err)
+	}
+	return cfg, nil
+}
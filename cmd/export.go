@@ -0,0 +1,105 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/gleb-korostelev/GophKeeper/cmd/initConnection"
+	"github.com/gleb-korostelev/GophKeeper/models/transfer"
+	transferSvc "github.com/gleb-korostelev/GophKeeper/service/transfer"
+	"github.com/spf13/cobra"
+)
+
+// exportPasswordEnv is the environment variable the archive password is read from when no password file is given.
+const exportPasswordEnv = "GOPHKEEPER_EXPORT_PASSWORD"
+
+// newExportCmd creates the command exporting a user's vault.
+func newExportCmd() *cobra.Command {
+	var (
+		username         string
+		format           string
+		out              string
+		passwordFile     string
+		confirmPlaintext bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export a user's vault",
+		Long: fmt.Sprintf("Export a user's vault.\n"+
+			"By default the vault is written as an archive encrypted with a password read from --password-file "+
+			"or the %s environment variable; restore it with 'import --format %s'.\n"+
+			"Plaintext %s and %s exports require --confirm-plaintext. Every export is recorded in the audit log.",
+			exportPasswordEnv, transfer.FormatArchive, transfer.FormatJSON, transfer.FormatCSV),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts := transfer.ExportOptions{Format: format, ConfirmPlaintext: confirmPlaintext}
+			if format == transfer.FormatArchive {
+				password, err := readExportPassword(passwordFile)
+				if err != nil {
+					return err
+				}
+				opts.Password = password
+			}
+
+			cfg, err := loadConfig(cmd)
+			if err != nil {
+				return err
+			}
+
+			ctx := cmd.Context()
+			db := initConnection.NewDBConn(ctx, cfg.DB)
+			defer db.GetConn().Close()
+
+			export, err := transferSvc.NewService(db, cfg.Quota.Plans()).Export(ctx, username, opts)
+			if err != nil {
+				return err
+			}
+
+			var w io.Writer = cmd.OutOrStdout()
+			if out != "-" {
+				f, err := os.OpenFile(out, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				w = f
+			}
+
+			_, err = w.Write(export.Data)
+			return err
+		},
+	}
+
+	cmd.Flags().StringVarP(&username, "user", "u", "", "username of the vault to export")
+	cmd.Flags().StringVarP(&format, "format", "f", transfer.FormatArchive, fmt.Sprintf("export format: %s",
+		strings.Join([]string{transfer.FormatArchive, transfer.FormatJSON, transfer.FormatCSV}, ", ")))
+	cmd.Flags().StringVarP(&out, "out", "o", "-", "file to write, - for standard output; an existing file is never overwritten")
+	cmd.Flags().StringVar(&passwordFile, "password-file", "", "file holding the archive password")
+	cmd.Flags().BoolVar(&confirmPlaintext, "confirm-plaintext", false, "allow writing the vault unencrypted")
+	_ = cmd.MarkFlagRequired("user")
+
+	return cmd
+}
+
+// readExportPassword reads the archive password from the first line of a file
+// or, if no file is given, from the environment.
+func readExportPassword(file string) (string, error) {
+	if file == "" {
+		password := os.Getenv(exportPasswordEnv)
+		if password == "" {
+			return "", errors.New("archive password required: use --password-file or " + exportPasswordEnv)
+		}
+		return password, nil
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+
+	password, _, _ := strings.Cut(string(data), "\n")
+	return strings.TrimSuffix(password, "\r"), nil
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/gleb-korostelev/GophKeeper/cmd/initConnection"
+	"github.com/gleb-korostelev/GophKeeper/models/transfer"
+	"github.com/gleb-korostelev/GophKeeper/pkg/importer"
+	transferSvc "github.com/gleb-korostelev/GophKeeper/service/transfer"
+	"github.com/spf13/cobra"
+)
+
+// newImportCmd creates the command importing the export of another password manager into a user's vault.
+func newImportCmd() *cobra.Command {
+	var (
+		username string
+		format   string
+		file     string
+		dryRun   bool
+		pwFile   string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import another password manager's export into a user's vault",
+		Long: fmt.Sprintf("Import another password manager's export into a user's vault.\n"+
+			"Supported formats: %s. Use --dry-run to list duplicates without storing anything.\n"+
+			"Restore a GophKeeper archive with --format %s; its password is read like the export command reads it.",
+			strings.Join(importer.Formats, ", "), transfer.FormatArchive),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var password string
+			if format == transfer.FormatArchive {
+				var err error
+				if password, err = readExportPassword(pwFile); err != nil {
+					return err
+				}
+			}
+
+			var r io.Reader = os.Stdin
+			if file != "-" {
+				f, err := os.Open(file)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				r = f
+			}
+
+			cfg, err := loadConfig(cmd)
+			if err != nil {
+				return err
+			}
+
+			ctx := cmd.Context()
+			db := initConnection.NewDBConn(ctx, cfg.DB)
+			defer db.GetConn().Close()
+
+			report, err := transferSvc.NewService(db, cfg.Quota.Plans()).Import(ctx, username, format, r, password, dryRun)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			verb := "Imported"
+			if report.DryRun {
+				verb = "Would import"
+			}
+			fmt.Fprintf(out, "%s %d of %d items from %s\n", verb, report.Imported, report.Total, report.Format)
+			for _, d := range report.Duplicates {
+				fmt.Fprintf(out, "Duplicate %s %q (matched by %s)\n", d.Type, d.Name, d.Match)
+			}
+			if report.QuotaExceeded != "" {
+				fmt.Fprintf(out, "The import would fail: %s\n", report.QuotaExceeded)
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&username, "user", "u", "", "username of the vault to import into")
+	cmd.Flags().StringVarP(&format, "format", "f", "", "format of the file: "+
+		strings.Join(importer.Formats, ", ")+", "+transfer.FormatArchive)
+	cmd.Flags().StringVar(&file, "file", "-", "file to import, - for standard input")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "report duplicates and quota overruns without storing anything")
+	cmd.Flags().StringVar(&pwFile, "password-file", "", "file holding the archive password")
+	_ = cmd.MarkFlagRequired("user")
+	_ = cmd.MarkFlagRequired("format")
+
+	return cmd
+}
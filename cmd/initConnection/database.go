@@ -14,26 +14,19 @@ import (
 )
 
 // NewDBConn initializes a new database connection adapter using the provided context
-// and configuration parameters from the application's configuration.
-//
-// It retrieves the following configuration settings:
-//   - config.DBDSN: the database connection string.
-//   - config.MaxOpenConns: the maximum number of open database connections.
-//   - config.MaxIdleConns: the maximum number of idle connections.
-//   - config.ConnMaxLifetime: the maximum lifetime of a connection.
-func NewDBConn(ctx context.Context) db.IAdapter {
-	dsn := config.GetConfigString(config.DBDSN)
-
-	cfg := db.Config{
-		MaxOpenConns:    config.GetConfigInt(config.MaxOpenConns),
-		MaxIdleConns:    config.GetConfigInt(config.MaxIdleConns),
-		ConnMaxLifetime: config.GetConfigDuration(config.ConnMaxLifetime),
-		Dsn:             dsn,
+// and the database settings of the application's configuration.
+func NewDBConn(ctx context.Context, cfg config.DB) db.IAdapter {
+	dbCfg := db.Config{
+		MaxOpenConns:    cfg.MaxOpenConns,
+		MaxIdleConns:    cfg.MaxIdleConns,
+		ConnMaxLifetime: cfg.ConnMaxLifetime,
+		Dsn:             cfg.DSN,
+		AutoMigrate:     cfg.AutoMigrate,
 	}
 
-	ad, err := db.NewAdapter(ctx, cfg, sql.LevelReadUncommitted)
+	ad, err := db.NewAdapter(ctx, dbCfg, sql.LevelReadUncommitted)
 	if err != nil {
-		logger.Fatal("can't initialize database adapter: %w", err.Error())
+		logger.Fatalf("can't initialize database adapter: %v", err)
 	}
 	return ad
 }
@@ -0,0 +1,33 @@
+package initConnection
+
+import (
+	"context"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/pkg/keyring"
+	"github.com/gleb-korostelev/GophKeeper/tools/db"
+	"github.com/gleb-korostelev/GophKeeper/tools/health"
+)
+
+// healthCheckTimeout bounds how long each check of the readiness probe may take.
+const healthCheckTimeout = 3 * time.Second
+
+// NewHealth initializes the registry of the readiness checks: that the database answers, that its schema
+// is at the version of the embedded migrations and that a signing key is loaded. Other dependencies
+// register their checks with the returned registry.
+func NewHealth(adapter db.IAdapter, keys *keyring.Store) *health.Registry {
+	checks := health.NewRegistry(healthCheckTimeout)
+
+	checks.Register("database", health.CheckerFunc(func(ctx context.Context) error {
+		return db.Ping(ctx, adapter)
+	}))
+	checks.Register("migrations", health.CheckerFunc(func(ctx context.Context) error {
+		return db.CheckMigrations(ctx, adapter)
+	}))
+	checks.Register("signing_key", health.CheckerFunc(func(context.Context) error {
+		_, err := keys.Signing()
+		return err
+	}))
+
+	return checks
+}
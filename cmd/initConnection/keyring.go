@@ -0,0 +1,42 @@
+package initConnection
+
+import (
+	"context"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/config"
+	"github.com/gleb-korostelev/GophKeeper/pkg/keyring"
+	"github.com/gleb-korostelev/GophKeeper/tools/closer"
+	"github.com/gleb-korostelev/GophKeeper/tools/logger"
+	"github.com/gleb-korostelev/GophKeeper/tools/scheduler"
+)
+
+// keyringReloadInterval is how often the keyring file is checked for changes.
+const keyringReloadInterval = time.Minute
+
+// NewKeyring initializes the keyring of the keys signing access tokens from the application's configuration.
+//
+// With a keyring file configured, the keyring is loaded from it and reloaded when it changes, so that keys
+// generated or promoted with the keys command take effect without a restart; the JWT key signs tokens until
+// the file exists. Otherwise the JWT key is the only key.
+func NewKeyring(ctx context.Context, cfg config.Auth) *keyring.Store {
+	var fallback *keyring.Keyring
+	if cfg.JwtKey != "" {
+		priv, err := cfg.JwtPrivateKey()
+		if err != nil {
+			logger.Fatalf("error in JwtPrivateKey: %v", err)
+		}
+		fallback = keyring.Single(keyring.NewKey(priv, time.Now()))
+	}
+
+	if cfg.KeyringFile == "" {
+		return keyring.Static(fallback)
+	}
+
+	store, err := keyring.NewStore(cfg.KeyringFile, fallback)
+	if err != nil {
+		logger.Fatalf("error in keyring.NewStore: %v", err)
+	}
+	closer.Add(scheduler.Every(ctx, "reload-keyring", keyringReloadInterval, store.Reload))
+	return store
+}
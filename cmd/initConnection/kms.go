@@ -0,0 +1,44 @@
+package initConnection
+
+import (
+	"github.com/gleb-korostelev/GophKeeper/config"
+	"github.com/gleb-korostelev/GophKeeper/tools/kms"
+	"github.com/gleb-korostelev/GophKeeper/tools/logger"
+)
+
+// NewKeyManager initializes the key manager wrapping the data keys of encryption at rest from the application's
+// configuration. It returns nil if no key manager is configured.
+func NewKeyManager(cfg config.KMS) kms.KeyManager {
+	switch cfg.Provider {
+	case "none":
+		return nil
+	case "local":
+		passphrase, err := cfg.KeystorePassphrase()
+		if err != nil {
+			logger.Fatalf("error in KeystorePassphrase: %v", err)
+		}
+		km, err := kms.NewLocal(cfg.KeystoreFile, passphrase)
+		if err != nil {
+			logger.Fatalf("error in kms.NewLocal: %v", err)
+		}
+		return km
+	case "vault":
+		return kms.NewVault(kms.VaultConfig{
+			Addr:      cfg.VaultAddr,
+			Token:     cfg.VaultToken,
+			Namespace: cfg.VaultNamespace,
+			Mount:     cfg.VaultMount,
+			Key:       cfg.VaultKey,
+		}, nil)
+	case "memory":
+		logger.Warnf("the in-memory key manager loses its master key on exit, with everything encrypted under it")
+		km, err := kms.NewMemory()
+		if err != nil {
+			logger.Fatalf("error in kms.NewMemory: %v", err)
+		}
+		return km
+	default:
+		logger.Fatalf("unknown key manager %q", cfg.Provider)
+		return nil
+	}
+}
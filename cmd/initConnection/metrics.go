@@ -0,0 +1,37 @@
+package initConnection
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/config"
+	"github.com/gleb-korostelev/GophKeeper/tools/db"
+	"github.com/gleb-korostelev/GophKeeper/tools/logger"
+	"github.com/gleb-korostelev/GophKeeper/tools/metrics"
+)
+
+// NewMetricsServer initializes the server exposing the Prometheus metrics at /metrics on the configured
+// metrics address, including the statistics of the database connection pool. It returns nil if the metrics
+// are disabled.
+//
+// The metrics server listens apart from the API and serves plain HTTP, so that it can be kept on an internal
+// network and scraped without credentials.
+func NewMetricsServer(cfg config.Server, db db.IAdapter) *http.Server {
+	if cfg.MetricsAddr == "" {
+		return nil
+	}
+
+	if pool := db.GetConn(); pool != nil {
+		if err := metrics.RegisterPool(pool); err != nil {
+			logger.Fatalf("error in metrics.RegisterPool: %v", err)
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.Handler())
+	return &http.Server{
+		Addr:              cfg.MetricsAddr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+}
@@ -2,47 +2,71 @@ package initConnection
 
 import (
 	"context"
-	"crypto/ed25519"
-	"encoding/hex"
 	"net/http"
+	"time"
 
 	"github.com/gleb-korostelev/GophKeeper/config"
 	"github.com/gleb-korostelev/GophKeeper/internal/handler"
 	"github.com/gleb-korostelev/GophKeeper/internal/router"
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/pkg/keyring"
+	"github.com/gleb-korostelev/GophKeeper/service/account"
+	"github.com/gleb-korostelev/GophKeeper/service/attachment"
 	"github.com/gleb-korostelev/GophKeeper/service/auth"
+	"github.com/gleb-korostelev/GophKeeper/service/emergency"
+	"github.com/gleb-korostelev/GophKeeper/service/org"
 	"github.com/gleb-korostelev/GophKeeper/service/profile"
+	"github.com/gleb-korostelev/GophKeeper/service/send"
+	"github.com/gleb-korostelev/GophKeeper/service/transfer"
+	"github.com/gleb-korostelev/GophKeeper/tools/blobstore"
+	"github.com/gleb-korostelev/GophKeeper/tools/closer"
 	"github.com/gleb-korostelev/GophKeeper/tools/db"
 	"github.com/gleb-korostelev/GophKeeper/tools/logger"
+	"github.com/gleb-korostelev/GophKeeper/tools/notify"
+	"github.com/gleb-korostelev/GophKeeper/tools/scheduler"
 	"github.com/rs/cors"
 )
 
+const (
+	// sendPurgeInterval is how often expired one-time share links are removed.
+	sendPurgeInterval = 10 * time.Minute
+
+	// emergencyApproveInterval is how often emergency access requests past their wait period are approved.
+	emergencyApproveInterval = time.Hour
+
+	// attachmentPurgeInterval is how often attachments of deleted cards and abandoned uploads are removed.
+	attachmentPurgeInterval = time.Hour
+
+	// sessionPurgeInterval is how often expired and revoked sign-in sessions are removed.
+	sessionPurgeInterval = time.Hour
+)
+
 // InitImpl initializes the main HTTP handler for the GophKeeper application.
 //
 // It configures and initializes the following components:
-// - Profile and Authentication services.
+// - Profile, Authentication, Organization, Send, Emergency access, Attachment and Transfer services.
+// - The readiness checks of the database and the signing keys.
 // - HTTP API handler with routing and middleware.
 // - CORS middleware for cross-origin requests.
+//
+// certs maps verified TLS client certificates to users; nil disables client certificate authentication.
 func InitImpl(
 	ctx context.Context,
 	adapter db.IAdapter,
-	port int,
+	cfg config.Config,
+	certs middleware.ClientCertMapper,
 ) http.Handler {
 
-	isSwaggerCreated := config.GetConfigBool(config.IsSwaggerCreated)
+	keys := NewKeyring(ctx, cfg.Auth)
 
-	keyRaw := config.GetConfigString(config.JwtKey)
-	keyBytes, err := hex.DecodeString(keyRaw)
-	if err != nil {
-		logger.Fatalf("error in hex.DecodeString: %v", err)
-	}
-	if l := len(keyBytes); l != ed25519.PrivateKeySize {
-		logger.Fatalf("ed25519: bad private key length: %d", l)
-	}
+	profileSvc, authSvc, orgSvc, sendSvc, emergencySvc, attachmentSvc, transferSvc, accountSvc := initServices(ctx, adapter, cfg, keys)
 
-	profileSvc, authSvc := initServices(adapter, keyBytes)
+	checks := NewHealth(adapter, keys)
 
-	api := handler.NewImplementation(profileSvc, authSvc)
-	r := router.CreateRouter(api, port, keyBytes, isSwaggerCreated)
+	api := handler.NewImplementation(profileSvc, authSvc, orgSvc, sendSvc, emergencySvc, attachmentSvc, transferSvc,
+		accountSvc, checks)
+	r := router.CreateRouter(api, cfg.Server.Port, cfg.Server.HttpsHost, keys.Verifying, authSvc, authSvc, certs,
+		cfg.Auth.AllowFake, cfg.Server.IsSwaggerCreated)
 
 	c := cors.New(cors.Options{
 		AllowedOrigins: []string{"*"},
@@ -55,19 +79,76 @@ func InitImpl(
 			http.MethodOptions,
 		},
 		AllowCredentials: true,
-		ExposedHeaders:   []string{"Content-Length", "Content-Type", "Access-Control-Allow-Origin"},
+		ExposedHeaders: []string{
+			"Content-Length",
+			"Content-Type",
+			"Content-Disposition",
+			"Access-Control-Allow-Origin",
+			handler.HeaderContentSHA256,
+		},
 	})
 
 	return c.Handler(r)
 }
 
-// initServices initializes and returns the Profile and Authentication services.
-func initServices(db db.IAdapter, key []byte) (
+// initServices initializes and returns the Profile, Authentication, Organization, Send, Emergency access,
+// Attachment, Transfer and Account services, and starts their background jobs.
+func initServices(ctx context.Context, db db.IAdapter, cfg config.Config, keys *keyring.Store) (
 	profileSvc handler.ProfileSvc,
 	authSvc handler.AuthSvc,
+	orgSvc handler.OrgSvc,
+	sendSvc handler.SendSvc,
+	emergencySvc handler.EmergencySvc,
+	attachmentSvc handler.AttachmentSvc,
+	transferSvc handler.TransferSvc,
+	accountSvc handler.AccountSvc,
 ) {
-	profileSvc = profile.NewService(db)
-	authSvc = auth.NewService(db, key)
+	plans := cfg.Quota.Plans()
+
+	profileSvc = profile.NewService(db, plans)
+
+	notifier := notify.NewLogNotifier()
+
+	auths := auth.NewService(db, keys, notifier, plans, cfg.Auth.Lockout())
+	closer.Add(scheduler.Every(ctx, "purge-sessions", sessionPurgeInterval, auths.PurgeSessions))
+	authSvc = auths
+
+	orgSvc = org.NewService(db)
+
+	sends := send.NewService(db)
+	closer.Add(scheduler.Every(ctx, "purge-sends", sendPurgeInterval, sends.PurgeExpired))
+	sendSvc = sends
+
+	emergencies := emergency.NewService(db, notifier)
+	closer.Add(scheduler.Every(ctx, "approve-emergency-access", emergencyApproveInterval, emergencies.AutoApprove))
+	emergencySvc = emergencies
+
+	blobs := initBlobStore(db, cfg.Blob)
+
+	attachments := attachment.NewService(db, blobs, plans)
+	closer.Add(scheduler.Every(ctx, "purge-attachments", attachmentPurgeInterval, attachments.PurgeStale))
+	attachmentSvc = attachments
+
+	transferSvc = transfer.NewService(db, plans)
+
+	accountSvc = account.NewService(db, blobs, plans)
 
 	return
 }
+
+// initBlobStore creates the blob store selected by the configuration.
+func initBlobStore(db db.IAdapter, cfg config.Blob) blobstore.BlobStore {
+	switch cfg.Store {
+	case "postgres":
+		return blobstore.NewPostgresStore(db)
+	case "local":
+		store, err := blobstore.NewLocalStore(cfg.Dir)
+		if err != nil {
+			logger.Fatalf("error in blobstore.NewLocalStore: %v", err)
+		}
+		return store
+	default:
+		logger.Fatalf("unknown blob store %q", cfg.Store)
+		return nil
+	}
+}
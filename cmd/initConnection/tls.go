@@ -0,0 +1,80 @@
+package initConnection
+
+import (
+	"context"
+	"crypto/tls"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/config"
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/tools/certs"
+	"github.com/gleb-korostelev/GophKeeper/tools/closer"
+	"github.com/gleb-korostelev/GophKeeper/tools/logger"
+	"github.com/gleb-korostelev/GophKeeper/tools/scheduler"
+)
+
+// certReloadInterval is how often the certificate files are checked for changes.
+const certReloadInterval = time.Minute
+
+// NewTLSConfig initializes the TLS configuration of the server from the application's configuration.
+// It returns nil if TLS is disabled.
+//
+// The certificate is loaded from the configured certificate and key files and reloaded when the files change.
+// Without them, which the configuration only allows in development mode, a self-signed development certificate
+// for the server's host is created in the development certificate directory. If a client CA file is configured, client certificates are verified, and those listed
+// in the client users file authenticate their users; the returned mapper is nil otherwise.
+func NewTLSConfig(ctx context.Context, cfg config.Config) (*tls.Config, middleware.ClientCertMapper) {
+	if cfg.TLS.Disabled {
+		logger.Warnf("TLS is disabled, serving plain HTTP; terminate TLS in front of the server")
+		return nil, nil
+	}
+
+	certFile, keyFile := cfg.TLS.CertFile, cfg.TLS.KeyFile
+	if certFile == "" || keyFile == "" {
+		var err error
+		certFile, keyFile, err = certs.EnsureSelfSigned(cfg.TLS.DevDir, cfg.Server.HttpsHost)
+		if err != nil {
+			logger.Fatalf("error in certs.EnsureSelfSigned: %v", err)
+		}
+		logger.Warnf("no TLS certificate configured, serving the self-signed development certificate %s", certFile)
+	}
+
+	reloader, err := certs.NewReloader(certFile, keyFile)
+	if err != nil {
+		logger.Fatalf("error in certs.NewReloader: %v", err)
+	}
+	closer.Add(scheduler.Every(ctx, "reload-certificate", certReloadInterval, reloader.Reload))
+
+	tlsCfg := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: reloader.GetCertificate,
+	}
+
+	if cfg.TLS.ClientCAFile == "" {
+		return tlsCfg, nil
+	}
+
+	tlsCfg.ClientCAs, err = certs.LoadCertPool(cfg.TLS.ClientCAFile)
+	if err != nil {
+		logger.Fatalf("error in certs.LoadCertPool: %v", err)
+	}
+
+	switch cfg.TLS.ClientAuth {
+	case "optional":
+		tlsCfg.ClientAuth = tls.VerifyClientCertIfGiven
+	case "require":
+		tlsCfg.ClientAuth = tls.RequireAndVerifyClientCert
+	default:
+		logger.Fatalf("unknown client certificate mode %q", cfg.TLS.ClientAuth)
+	}
+
+	if cfg.TLS.ClientUsersFile == "" {
+		return tlsCfg, nil
+	}
+
+	subjects, err := certs.LoadSubjectMap(cfg.TLS.ClientUsersFile)
+	if err != nil {
+		logger.Fatalf("error in certs.LoadSubjectMap: %v", err)
+	}
+	return tlsCfg, subjects
+}
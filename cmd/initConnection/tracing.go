@@ -0,0 +1,29 @@
+package initConnection
+
+import (
+	"context"
+
+	"github.com/gleb-korostelev/GophKeeper/config"
+	"github.com/gleb-korostelev/GophKeeper/internal/router"
+	"github.com/gleb-korostelev/GophKeeper/tools/closer"
+	"github.com/gleb-korostelev/GophKeeper/tools/logger"
+	"github.com/gleb-korostelev/GophKeeper/tools/tracing"
+)
+
+// NewTracing initializes the tracing of requests from the application's configuration, exporting the spans
+// of the given version of the server. The remaining spans are exported when the server shuts down.
+func NewTracing(ctx context.Context, cfg config.Tracing, version string) {
+	provider, err := tracing.Setup(ctx, tracing.Options{
+		Exporter:       cfg.Exporter,
+		Endpoint:       cfg.Endpoint,
+		SampleRatio:    cfg.SampleRatio,
+		ServiceName:    router.AppName,
+		ServiceVersion: version,
+	})
+	if err != nil {
+		logger.Fatalf("error in tracing.Setup: %v", err)
+	}
+	if provider != nil {
+		closer.Add(provider)
+	}
+}
@@ -0,0 +1,167 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/config"
+	"github.com/gleb-korostelev/GophKeeper/pkg/keyring"
+	"github.com/gleb-korostelev/GophKeeper/service/auth"
+	"github.com/spf13/cobra"
+)
+
+// newKeysCmd creates the command group managing the keyring of the keys signing access tokens.
+func newKeysCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "keys",
+		Short: "Manage the keys signing access tokens",
+		Long: "Manage the keyring file of the keys signing access tokens, configured with --jwt-keyring-file.\n" +
+			"Servers reload the file within a minute of a change. To rotate the signing key without signing anyone out,\n" +
+			"generate a key, wait for the servers and the clients caching /.well-known/jwks.json to pick it up,\n" +
+			"then promote it. Retired keys keep verifying tokens until the tokens they signed expire.\n" +
+			"Only the keyring settings of the configuration are used.",
+	}
+
+	var promote bool
+	generateCmd := &cobra.Command{
+		Use:   "generate",
+		Short: "Generate a new signing key",
+		Long: "Generate a new signing key and add it to the keyring, creating the keyring if needed.\n" +
+			"A new keyring starts with the configured JWT key, if any, so that its tokens stay valid.\n" +
+			"The new key only verifies tokens until it is promoted, unless it is the only key.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadKeysConfig(cmd)
+			if err != nil {
+				return err
+			}
+
+			now := time.Now()
+			ring, err := keyring.Load(cfg.KeyringFile)
+			switch {
+			case errors.Is(err, fs.ErrNotExist):
+				ring = &keyring.Keyring{}
+				if cfg.JwtKey != "" {
+					priv, err := cfg.JwtPrivateKey()
+					if err != nil {
+						return err
+					}
+					ring = keyring.Single(keyring.NewKey(priv, now))
+					fmt.Fprintf(cmd.OutOrStdout(), "Imported the configured JWT key as %s\n", ring.Active)
+				}
+			case err != nil:
+				return err
+			}
+
+			key, err := keyring.Generate(now)
+			if err != nil {
+				return err
+			}
+			ring.Add(key)
+			if promote || ring.Active == "" {
+				if err = ring.Promote(key.ID, now); err != nil {
+					return err
+				}
+			}
+
+			if err = save(cmd.OutOrStdout(), ring, cfg.KeyringFile, now); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Generated key %s (%s)\n", key.ID, status(ring, key))
+			return nil
+		},
+	}
+	generateCmd.Flags().BoolVar(&promote, "promote", false,
+		"make the new key active at once; servers that did not reload yet reject its tokens")
+
+	promoteCmd := &cobra.Command{
+		Use:   "promote <kid>",
+		Short: "Make a key the active signing key",
+		Long:  "Make a key the active signing key, retiring the previously active key.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadKeysConfig(cmd)
+			if err != nil {
+				return err
+			}
+
+			ring, err := keyring.Load(cfg.KeyringFile)
+			if err != nil {
+				return err
+			}
+
+			now := time.Now()
+			prev := ring.Active
+			if err = ring.Promote(args[0], now); err != nil {
+				return fmt.Errorf("%w: %s", err, args[0])
+			}
+			if err = save(cmd.OutOrStdout(), ring, cfg.KeyringFile, now); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Promoted key %s, retired key %s\n", args[0], prev)
+			return nil
+		},
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the keys of the keyring",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadKeysConfig(cmd)
+			if err != nil {
+				return err
+			}
+
+			ring, err := keyring.Load(cfg.KeyringFile)
+			if err != nil {
+				return err
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "KID\tSTATUS\tCREATED")
+			for _, k := range ring.Keys {
+				fmt.Fprintf(w, "%s\t%s\t%s\n", k.ID, status(ring, k), k.CreatedAt.Format(time.RFC3339))
+			}
+			return w.Flush()
+		},
+	}
+
+	cmd.AddCommand(generateCmd, promoteCmd, listCmd)
+	return cmd
+}
+
+// loadKeysConfig loads the keyring settings of the configuration. Problems with other settings are ignored,
+// so that the first keyring can be created before the configuration is complete.
+func loadKeysConfig(cmd *cobra.Command) (config.Auth, error) {
+	cfg, _ := config.Load(cmd.Flags())
+	if cfg.Auth.KeyringFile == "" {
+		return config.Auth{}, fmt.Errorf("no keyring file configured, set --jwt-keyring-file or %s",
+			config.JwtKeyringFile)
+	}
+	return cfg.Auth, nil
+}
+
+// save removes the keys that no longer verify unexpired tokens from the keyring and stores it in path.
+func save(out io.Writer, ring *keyring.Keyring, path string, now time.Time) error {
+	for _, k := range ring.Prune(now, auth.KeyRetention) {
+		fmt.Fprintf(out, "Removed key %s, retired at %s\n", k.ID, k.RetiredAt.Format(time.RFC3339))
+	}
+	return ring.Save(path)
+}
+
+// status describes the state of a key of the keyring.
+func status(ring *keyring.Keyring, k keyring.Key) string {
+	switch {
+	case k.ID == ring.Active:
+		return "active"
+	case k.RetiredAt != nil:
+		return "retired at " + k.RetiredAt.Format(time.RFC3339)
+	default:
+		return "pending"
+	}
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/gleb-korostelev/GophKeeper/cmd/initConnection"
+	"github.com/gleb-korostelev/GophKeeper/config"
+	"github.com/gleb-korostelev/GophKeeper/tools/kms"
+	"github.com/spf13/cobra"
+)
+
+// newKMSCmd creates the command group operating the key manager of encryption at rest.
+func newKMSCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "kms",
+		Short: "Operate the key manager of encryption at rest",
+		Long: "Operate the key manager wrapping the data keys of encryption at rest, selected with --kms-provider.\n" +
+			"The \"local\" key manager keeps its master key in a passphrase-protected keystore file, the \"vault\" one\n" +
+			"uses a key of a Vault transit secrets engine, and the \"memory\" one, for development, keeps it in memory.",
+	}
+
+	checkCmd := &cobra.Command{
+		Use:   "check",
+		Short: "Check that the key manager wraps and unwraps data keys",
+		Long: "Wrap a random data key with the key manager and unwrap it again, reporting the master key version.\n" +
+			"The \"local\" key manager creates its keystore if it does not exist.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			km, err := loadKeyManager(cmd)
+			if err != nil {
+				return err
+			}
+
+			dataKey, err := kms.GenerateDataKey()
+			if err != nil {
+				return err
+			}
+			wrapped, err := km.WrapKey(cmd.Context(), dataKey)
+			if err != nil {
+				return fmt.Errorf("failed to wrap data key: %w", err)
+			}
+			unwrapped, err := km.UnwrapKey(cmd.Context(), wrapped)
+			if err != nil {
+				return fmt.Errorf("failed to unwrap data key: %w", err)
+			}
+			if !bytes.Equal(unwrapped, dataKey) {
+				return errors.New("the unwrapped data key differs from the wrapped one")
+			}
+
+			version, err := kms.KeyVersion(wrapped)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "The key manager works, data keys are wrapped by master key version %d\n", version)
+			return nil
+		},
+	}
+
+	rotateCmd := &cobra.Command{
+		Use:   "rotate",
+		Short: "Create a new version of the master key",
+		Long: "Create a new version of the master key, which wraps data keys from then on.\n" +
+			"Data keys wrapped by previous versions keep being unwrapped by them.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			km, err := loadKeyManager(cmd)
+			if err != nil {
+				return err
+			}
+			if err = km.Rotate(cmd.Context()); err != nil {
+				return fmt.Errorf("failed to rotate the master key: %w", err)
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), "Rotated the master key")
+			return nil
+		},
+	}
+
+	cmd.AddCommand(checkCmd, rotateCmd)
+	return cmd
+}
+
+// loadKeyManager initializes the configured key manager. Problems with settings other than those
+// of the key manager are ignored; those of the key manager fail when it is initialized.
+func loadKeyManager(cmd *cobra.Command) (kms.KeyManager, error) {
+	cfg, _ := config.Load(cmd.Flags())
+	km := initConnection.NewKeyManager(cfg.KMS)
+	if km == nil {
+		return nil, fmt.Errorf("no key manager configured, set --kms-provider or %s", config.KMSProvider)
+	}
+	return km, nil
+}
@@ -1,10 +1,16 @@
 // Package main is the entry point for the GophKeeper CLI application.
 // It initializes the database connection, configures and starts the HTTP server,
-// and provides a CLI interface for versioning information.
+// and provides a CLI interface for versioning information and maintenance tasks.
 //
 // Features:
-// - Starts an HTTP server to handle API requests.
+// - Starts an HTTPS server to handle API requests, optionally verifying client certificates.
+// - Serves Prometheus metrics on a separate address.
+// - Traces requests with OpenTelemetry.
 // - Provides version and build date information via CLI.
+// - Imports the exports of other password managers via CLI.
+// - Reads a layered configuration from a YAML file, the environment and flags, and checks it via CLI.
+// - Rotates the keys signing access tokens via CLI.
+// - Checks and rotates the master key of encryption at rest via CLI.
 // - Handles graceful shutdown on interrupt signals.
 package main
 
@@ -28,21 +34,28 @@ var (
 	buildDate = "unknown" // Build date, injected at build time.
 )
 
-// main initializes the CLI application and HTTP server.
-// It supports a "version" command to display the application version and build date.
-// The function also handles server startup and graceful shutdown on interrupt signals.
+// main initializes the CLI application.
+// Without a subcommand it starts the HTTP server; subcommands such as "version" and "import"
+// run on their own and exit. The configuration flags are shared by all commands.
 func main() {
-	// Defer the cleanup of all resources using the closer utility.
-	defer func() {
-		closer.Wait()
-		closer.CloseAll()
-	}()
-
-	// Root command for the CLI application.
+	// Root command for the CLI application, serving the API.
 	rootCmd := &cobra.Command{
 		Use:   "gophkeeper-cli-app",
 		Short: "CLI for bank card application",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := loadConfig(cmd)
+			if err != nil {
+				return err
+			}
+			serve(cfg)
+			return nil
+		},
 	}
+	config.RegisterFlags(rootCmd.PersistentFlags())
+
+	// Errors are printed once, below, without the usage, which would hide them.
+	rootCmd.SilenceErrors = true
+	rootCmd.SilenceUsage = true
 
 	// Command to display version and build date.
 	versionCmd := &cobra.Command{
@@ -54,43 +67,86 @@ func main() {
 		},
 	}
 
-	// Add the version command to the root command.
-	rootCmd.AddCommand(versionCmd)
+	// Add the subcommands to the root command.
+	rootCmd.AddCommand(versionCmd, newImportCmd(), newExportCmd(), newConfigCmd(), newKeysCmd(), newKMSCmd(),
+		newMigrateCmd(), newAdminCmd())
 
 	// Execute the root command.
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Println(err)
+		os.Exit(1)
 	}
+}
+
+// serve starts the HTTPS server, or a plain HTTP one if TLS is disabled, and blocks until it is shut down
+// by an interrupt signal.
+func serve(cfg config.Config) {
+	// Defer the cleanup of all resources using the closer utility.
+	defer func() {
+		closer.Wait()
+		closer.CloseAll()
+	}()
 
 	// Create a background context for managing the server lifecycle.
 	ctx := context.Background()
 
+	// Set up tracing before the database connection, so that its queries are traced.
+	initConnection.NewTracing(ctx, cfg.Tracing, version)
+
 	// Initialize the database connection using the initConnection package.
-	db := initConnection.NewDBConn(ctx)
+	db := initConnection.NewDBConn(ctx, cfg.DB)
 
 	// Retrieve the server port from the configuration.
-	port := config.GetConfigInt(config.Port)
+	port := cfg.Server.Port
+
+	// Load the server certificate and the client certificate verification settings.
+	tlsCfg, certs := initConnection.NewTLSConfig(ctx, cfg)
 
 	// Log the startup message, including the server port and Swagger documentation port.
-	logger.Info(fmt.Sprintf("Started server at :%d. Swagger docs stated at %d", port, port+1))
+	scheme := "https"
+	if tlsCfg == nil {
+		scheme = "http"
+	}
+	logger.Info(fmt.Sprintf("Started server at %s://:%d. Swagger docs stated at %d", scheme, port, port+1))
 
 	// Configure the HTTP server.
 	srv := &http.Server{
-		Addr:    fmt.Sprintf(":%d", port),
-		Handler: initConnection.InitImpl(ctx, db, port),
+		Addr:      fmt.Sprintf(":%d", port),
+		Handler:   initConnection.InitImpl(ctx, db, cfg, certs),
+		TLSConfig: tlsCfg,
 	}
 
+	// Configure the metrics server, unless the metrics are disabled.
+	metricsSrv := initConnection.NewMetricsServer(cfg.Server, db)
+
 	// Channel to capture OS interrupt signals.
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, os.Interrupt, os.Kill)
 
 	// Start the HTTP server in a separate goroutine.
 	go func() {
-		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
-			logger.Fatal("Error starting server: %v", err)
+		var err error
+		if tlsCfg != nil {
+			// The certificate comes from TLSConfig.GetCertificate, so no files are passed.
+			err = srv.ListenAndServeTLS("", "")
+		} else {
+			err = srv.ListenAndServe()
+		}
+		if err != nil && err != http.ErrServerClosed {
+			logger.Fatalf("Error starting server: %v", err)
 		}
 	}()
 
+	// Start the metrics server in a separate goroutine.
+	if metricsSrv != nil {
+		logger.Info(fmt.Sprintf("Started metrics server at http://%s/metrics", metricsSrv.Addr))
+		go func() {
+			if err := metricsSrv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				logger.Fatalf("Error starting metrics server: %v", err)
+			}
+		}()
+	}
+
 	// Block until an interrupt signal is received.
 	<-stop
 
@@ -100,7 +156,12 @@ func main() {
 
 	logger.Info("Shutting down server...")
 	if err := srv.Shutdown(ctx); err != nil {
-		logger.Fatal("Server forced to shutdown: %v", err)
+		logger.Fatalf("Server forced to shutdown: %v", err)
+	}
+	if metricsSrv != nil {
+		if err := metricsSrv.Shutdown(ctx); err != nil {
+			logger.Fatalf("Metrics server forced to shutdown: %v", err)
+		}
 	}
 
 	logger.Info("Server exiting")
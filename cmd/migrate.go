@@ -0,0 +1,189 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"text/tabwriter"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/cmd/initConnection"
+	"github.com/gleb-korostelev/GophKeeper/config"
+	"github.com/gleb-korostelev/GophKeeper/tools/db"
+	"github.com/pressly/goose/v3"
+	"github.com/spf13/cobra"
+)
+
+// newMigrateCmd creates the command group managing the database schema.
+func newMigrateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Manage the database schema",
+		Long: "Apply, roll back and inspect the migrations of the database schema, which are embedded in the application.\n" +
+			"Commands changing the schema hold a Postgres advisory lock, so that they never run concurrently with\n" +
+			"the migrations of starting servers. Set --db-auto-migrate=false to keep servers from migrating on startup.",
+	}
+
+	upCmd := &cobra.Command{
+		Use:   "up",
+		Short: "Apply all pending migrations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, err := openMigrator(cmd)
+			if err != nil {
+				return err
+			}
+			defer m.Close()
+
+			results, err := m.Up(cmd.Context())
+			for _, res := range results {
+				fmt.Fprintln(cmd.OutOrStdout(), res)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to apply migrations: %w", err)
+			}
+			if len(results) == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "No pending migrations")
+			}
+			return nil
+		},
+	}
+
+	downCmd := &cobra.Command{
+		Use:   "down",
+		Short: "Roll back the migration applied last",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, err := openMigrator(cmd)
+			if err != nil {
+				return err
+			}
+			defer m.Close()
+
+			res, err := m.Down(cmd.Context())
+			if errors.Is(err, goose.ErrNoNextVersion) {
+				return errors.New("no migration to roll back")
+			}
+			if err != nil {
+				return fmt.Errorf("failed to roll back migration: %w", err)
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), res)
+			return nil
+		},
+	}
+
+	redoCmd := &cobra.Command{
+		Use:   "redo",
+		Short: "Roll back the migration applied last and apply it again",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, err := openMigrator(cmd)
+			if err != nil {
+				return err
+			}
+			defer m.Close()
+
+			results, err := m.Redo(cmd.Context())
+			for _, res := range results {
+				fmt.Fprintln(cmd.OutOrStdout(), res)
+			}
+			if errors.Is(err, goose.ErrNoNextVersion) {
+				return errors.New("no migration to redo")
+			}
+			if err != nil {
+				return fmt.Errorf("failed to redo migration: %w", err)
+			}
+			return nil
+		},
+	}
+
+	statusCmd := &cobra.Command{
+		Use:   "status",
+		Short: "List the migrations and whether they are applied",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, err := openMigrator(cmd)
+			if err != nil {
+				return err
+			}
+			defer m.Close()
+
+			statuses, err := m.Status(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to get migration status: %w", err)
+			}
+
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "MIGRATION\tSTATE\tAPPLIED")
+			for _, s := range statuses {
+				applied := ""
+				if s.State == goose.StateApplied {
+					applied = s.AppliedAt.Format(time.RFC3339)
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\n", filepath.Base(s.Source.Path), s.State, applied)
+			}
+			return w.Flush()
+		},
+	}
+
+	versionCmd := &cobra.Command{
+		Use:   "version",
+		Short: "Print the schema version of the database and of the application",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m, err := openMigrator(cmd)
+			if err != nil {
+				return err
+			}
+			defer m.Close()
+
+			current, latest, err := m.Version(cmd.Context())
+			if err != nil {
+				return fmt.Errorf("failed to get schema version: %w", err)
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Database version: %d\nLatest migration: %d\n", current, latest)
+			return nil
+		},
+	}
+
+	var dir string
+	createCmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create an empty SQL migration",
+		Long: "Create an empty SQL migration in the migrations directory of the source tree, named after the current\n" +
+			"time and the given name. The application embeds it when it is built again.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := db.CreateMigration(dir, args[0]); err != nil {
+				return fmt.Errorf("failed to create migration: %w", err)
+			}
+			return nil
+		},
+	}
+	createCmd.Flags().StringVar(&dir, "dir", db.MigrationsDir, "migrations directory of the source tree")
+
+	cmd.AddCommand(upCmd, downCmd, redoCmd, statusCmd, versionCmd, createCmd)
+	return cmd
+}
+
+// openMigrator connects to the configured database, without applying migrations on connecting,
+// and creates a migrator for it.
+func openMigrator(cmd *cobra.Command) (*db.Migrator, error) {
+	adapter, err := openDB(cmd)
+	if err != nil {
+		return nil, err
+	}
+	return db.NewMigrator(adapter.GetConn())
+}
+
+// openDB connects to the configured database without applying migrations on connecting.
+// Problems with settings other than those of the database are ignored.
+func openDB(cmd *cobra.Command) (db.IAdapter, error) {
+	cfg, _ := config.Load(cmd.Flags())
+	if cfg.DB.DSN == "" {
+		return nil, fmt.Errorf("no database configured, set --db-dsn or %s", config.DBDSN)
+	}
+	cfg.DB.AutoMigrate = false
+
+	return initConnection.NewDBConn(cmd.Context(), cfg.DB), nil
+}
@@ -1,26 +1,68 @@
-// Package config provides configuration keys for retrieving application settings.
-// These keys are used to fetch environment variables or other configuration values
-// required by the application.
+// Package config provides the typed configuration of the application.
+//
+// The configuration is layered: every setting has a default, which a YAML configuration file,
+// environment variables and command-line flags override, in increasing order of precedence.
+// The keys below name the environment variables; the file and flag names of each setting are
+// listed in settings.
 package config
 
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/quota"
+)
+
 type configKey string
 
 const (
+	// ConfigFile specifies the YAML configuration file; the --config flag takes precedence over it.
+	ConfigFile = configKey("CONFIG_FILE")
+
 	// Port specifies the port on which the HTTP server will run.
 	Port = configKey("PORT")
 
 	// DBDSN specifies the Data Source Name (DSN) for the database connection.
 	DBDSN = configKey("DB_DSN")
 
-	// HttpsHost specifies the hostname for the HTTPS server.
+	// HttpsHost specifies the hostname for the HTTPS server, which the development certificate is issued for.
 	HttpsHost = configKey("HTTPS_HOST")
 
+	// MetricsAddr specifies the address the Prometheus metrics are served at, apart from the API;
+	// empty disables them.
+	MetricsAddr = configKey("METRICS_ADDR")
+
+	// DevMode marks a development deployment, which allows settings that are unsafe in production.
+	DevMode = configKey("DEV_MODE")
+
 	// IsSwaggerCreated determines whether Swagger documentation should be created and exposed.
 	IsSwaggerCreated = configKey("IS_SWAGGER_CREATED")
 
-	// JwtKey specifies the private key for signing JWT tokens.
+	// JwtKey specifies the private key for signing JWT tokens when no keyring file is configured.
+	// With a keyring file, it signs tokens until the file is created, and the keys generate command
+	// imports it into the file as its first key, so that its tokens stay valid.
 	JwtKey = configKey("JWT_KEY")
 
+	// AuthAllowFake accepts a bare username in the Authorization header in place of a token, so that
+	// the API can be tried without signing in. It is rejected unless DevMode is set.
+	AuthAllowFake = configKey("AUTH_ALLOW_FAKE")
+
+	// AuthLockoutAttempts specifies the number of consecutive wrong passwords from a client address after
+	// which sign-ins to the account from that address are refused. 0 disables the lockout.
+	AuthLockoutAttempts = configKey("AUTH_LOCKOUT_ATTEMPTS")
+
+	// AuthLockoutDuration specifies how long sign-ins are refused after AuthLockoutAttempts wrong passwords.
+	AuthLockoutDuration = configKey("AUTH_LOCKOUT_DURATION")
+
+	// JwtKeyringFile specifies the file holding the keyring of JWT signing keys, which is reloaded when it changes.
+	JwtKeyringFile = configKey("JWT_KEYRING_FILE")
+
 	// MaxOpenConns specifies the maximum number of open database connections.
 	MaxOpenConns = configKey("MAX_OPEN_CONNS")
 
@@ -29,4 +71,307 @@ const (
 
 	// ConnMaxLifetime specifies the maximum lifetime of a database connection.
 	ConnMaxLifetime = configKey("CONN_MAX_LIFETIME")
+
+	// DBAutoMigrate applies pending migrations when the server starts (default true). Disable it to apply them
+	// with the migrate command instead, such as in a release job ahead of the rollout.
+	DBAutoMigrate = configKey("DB_AUTO_MIGRATE")
+
+	// BlobStore selects where attachment contents are kept: "postgres" (default) or "local".
+	BlobStore = configKey("BLOB_STORE")
+
+	// BlobDir specifies the directory the "local" blob store keeps attachment contents in.
+	BlobDir = configKey("BLOB_DIR")
+
+	// TLSCertFile specifies the PEM file of the server certificate; it is reloaded when it changes.
+	TLSCertFile = configKey("TLS_CERT_FILE")
+
+	// TLSKeyFile specifies the PEM file of the server certificate's private key.
+	TLSKeyFile = configKey("TLS_KEY_FILE")
+
+	// TLSDisabled serves plain HTTP, for deployments where a proxy terminates TLS.
+	TLSDisabled = configKey("TLS_DISABLED")
+
+	// TLSDevDir specifies the directory the self-signed development certificate is kept in
+	// when no certificate is configured.
+	TLSDevDir = configKey("TLS_DEV_DIR")
+
+	// TLSClientCAFile specifies the PEM file of the CA certificates client certificates are verified against.
+	// Setting it enables mutual TLS.
+	TLSClientCAFile = configKey("TLS_CLIENT_CA_FILE")
+
+	// TLSClientAuth selects whether client certificates are "optional" (default) or "require"d
+	// when mutual TLS is enabled.
+	TLSClientAuth = configKey("TLS_CLIENT_AUTH")
+
+	// TLSClientUsersFile specifies the file mapping client certificate subjects to the users they authenticate.
+	TLSClientUsersFile = configKey("TLS_CLIENT_USERS_FILE")
+
+	// TracingExporter selects where trace spans are exported: "none" (default), "otlp" or "stdout".
+	TracingExporter = configKey("TRACING_EXPORTER")
+
+	// TracingEndpoint specifies the URL of the OTLP/HTTP collector spans are exported to; empty uses
+	// the standard OTEL_EXPORTER_OTLP_* environment variables.
+	TracingEndpoint = configKey("TRACING_ENDPOINT")
+
+	// TracingSampleRatio specifies the fraction of traces sampled, unless the caller already decided
+	// whether to sample them.
+	TracingSampleRatio = configKey("TRACING_SAMPLE_RATIO")
+
+	// KMSProvider selects the key manager wrapping the data keys of encryption at rest:
+	// "none" (default), "local", "vault" or "memory".
+	KMSProvider = configKey("KMS_PROVIDER")
+
+	// KMSKeystoreFile specifies the keystore file of the "local" key manager, created if it does not exist.
+	KMSKeystoreFile = configKey("KMS_KEYSTORE_FILE")
+
+	// KMSPassphrase specifies the passphrase encrypting the keystore of the "local" key manager.
+	KMSPassphrase = configKey("KMS_PASSPHRASE")
+
+	// KMSPassphraseFile specifies a file holding the keystore passphrase, such as a mounted secret,
+	// so that the passphrase stays out of the environment.
+	KMSPassphraseFile = configKey("KMS_PASSPHRASE_FILE")
+
+	// KMSVaultAddr specifies the address of the Vault server of the "vault" key manager.
+	KMSVaultAddr = configKey("KMS_VAULT_ADDR")
+
+	// KMSVaultToken specifies the token authenticating to Vault.
+	KMSVaultToken = configKey("KMS_VAULT_TOKEN")
+
+	// KMSVaultNamespace specifies the Vault Enterprise namespace, if any.
+	KMSVaultNamespace = configKey("KMS_VAULT_NAMESPACE")
+
+	// KMSVaultMount specifies the path the Vault transit secrets engine is mounted at.
+	KMSVaultMount = configKey("KMS_VAULT_MOUNT")
+
+	// KMSVaultKey specifies the name of the Vault transit key used as the master key.
+	KMSVaultKey = configKey("KMS_VAULT_KEY")
+
+	// QuotaUserItems, QuotaUserBytes, QuotaUserAttachmentSize, QuotaUserAPIKeys and QuotaUserDevices specify
+	// the limits of the plan of users: the number of items, the total size of items and attachments in bytes,
+	// the size of a single attachment in bytes, the number of active API keys and the number of devices.
+	// A limit of 0 is unlimited. Administrators can override them per user.
+	QuotaUserItems          = configKey("QUOTA_USER_ITEMS")
+	QuotaUserBytes          = configKey("QUOTA_USER_BYTES")
+	QuotaUserAttachmentSize = configKey("QUOTA_USER_ATTACHMENT_SIZE")
+	QuotaUserAPIKeys        = configKey("QUOTA_USER_API_KEYS")
+	QuotaUserDevices        = configKey("QUOTA_USER_DEVICES")
+
+	// QuotaAdminItems, QuotaAdminBytes, QuotaAdminAttachmentSize, QuotaAdminAPIKeys and QuotaAdminDevices specify
+	// the limits of the plan of administrators, like those of users.
+	QuotaAdminItems          = configKey("QUOTA_ADMIN_ITEMS")
+	QuotaAdminBytes          = configKey("QUOTA_ADMIN_BYTES")
+	QuotaAdminAttachmentSize = configKey("QUOTA_ADMIN_ATTACHMENT_SIZE")
+	QuotaAdminAPIKeys        = configKey("QUOTA_ADMIN_API_KEYS")
+	QuotaAdminDevices        = configKey("QUOTA_ADMIN_DEVICES")
 )
+
+// Config is the configuration of the application.
+//
+// Fields:
+// - Server: Settings of the API server.
+// - DB: Settings of the database connection.
+// - Auth: Settings of authentication.
+// - Blob: Settings of the attachment content store.
+// - TLS: Settings of HTTPS serving and client certificates.
+// - Tracing: Settings of the export of trace spans.
+// - KMS: Settings of the key manager.
+// - Quota: Limits of the account plans.
+type Config struct {
+	Server  Server
+	DB      DB
+	Auth    Auth
+	Blob    Blob
+	TLS     TLS
+	Tracing Tracing
+	KMS     KMS
+	Quota   Quota
+
+	// sources records where each setting, by file key, was last set from.
+	sources map[string]string
+}
+
+// Server holds the settings of the API server.
+type Server struct {
+	Port             int    // Port of the API server; Swagger documentation is served on the next one.
+	HttpsHost        string // Hostname the server is reached at.
+	MetricsAddr      string // Address of the metrics server; empty disables it.
+	DevMode          bool   // Whether this is a development deployment.
+	IsSwaggerCreated bool   // Whether the Swagger documentation is already generated.
+}
+
+// DB holds the settings of the database connection.
+type DB struct {
+	DSN             string        // Data Source Name of the database.
+	MaxOpenConns    int           // Maximum number of open connections.
+	MaxIdleConns    int           // Maximum number of idle connections.
+	ConnMaxLifetime time.Duration // Maximum lifetime of a connection.
+	AutoMigrate     bool          // Whether pending migrations are applied on startup.
+}
+
+// Auth holds the settings of authentication.
+type Auth struct {
+	JwtKey          string        // Hex-encoded Ed25519 private key signing access tokens.
+	KeyringFile     string        // File holding the keyring of signing keys.
+	AllowFake       bool          // Whether a bare username is accepted as authentication; development only.
+	LockoutAttempts int           // Wrong passwords from a client address that lock it out; 0 disables it.
+	LockoutDuration time.Duration // How long a client address stays locked out.
+}
+
+// Lockout returns the limit on wrong passwords given for an account.
+func (a Auth) Lockout() models.Lockout {
+	return models.Lockout{Attempts: a.LockoutAttempts, Duration: a.LockoutDuration}
+}
+
+// JwtPrivateKey decodes JwtKey.
+func (a Auth) JwtPrivateKey() (ed25519.PrivateKey, error) {
+	key, err := hex.DecodeString(a.JwtKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(key) != ed25519.PrivateKeySize {
+		return nil, fmt.Errorf("ed25519: bad private key length: %d", len(key))
+	}
+	// The key holds its public key after its seed; tokens it signs only verify if they match.
+	if !ed25519.NewKeyFromSeed(ed25519.PrivateKey(key).Seed()).Equal(ed25519.PrivateKey(key)) {
+		return nil, errors.New("ed25519: the public key does not match the seed")
+	}
+	return key, nil
+}
+
+// Blob holds the settings of the attachment content store.
+type Blob struct {
+	Store string // "postgres" or "local".
+	Dir   string // Directory of the "local" store.
+}
+
+// TLS holds the settings of HTTPS serving and client certificates.
+type TLS struct {
+	CertFile        string // PEM file of the server certificate.
+	KeyFile         string // PEM file of the server certificate's private key.
+	Disabled        bool   // Whether to serve plain HTTP.
+	DevDir          string // Directory of the self-signed development certificate.
+	ClientCAFile    string // PEM file of the CA certificates of client certificates.
+	ClientAuth      string // "optional" or "require".
+	ClientUsersFile string // File mapping client certificate subjects to users.
+}
+
+// Tracing holds the settings of the export of trace spans.
+type Tracing struct {
+	Exporter    string  // "none", "otlp" or "stdout".
+	Endpoint    string  // URL of the OTLP/HTTP collector.
+	SampleRatio float64 // Fraction of traces sampled.
+}
+
+// KMS holds the settings of the key manager wrapping the data keys of encryption at rest.
+type KMS struct {
+	Provider       string // "none", "local", "vault" or "memory".
+	KeystoreFile   string // Keystore file of the "local" key manager.
+	Passphrase     string // Passphrase of the keystore.
+	PassphraseFile string // File holding the passphrase of the keystore.
+	VaultAddr      string // Address of the Vault server.
+	VaultToken     string // Token authenticating to Vault.
+	VaultNamespace string // Vault Enterprise namespace.
+	VaultMount     string // Path of the transit secrets engine.
+	VaultKey       string // Name of the transit key.
+}
+
+// Quota holds the limits of the account plans: the plan of users, which covers unauthorized and authorized
+// users, and the plan of administrators, which covers admins and superadmins.
+type Quota struct {
+	User  Limits
+	Admin Limits
+}
+
+// Limits holds the limits of an account plan; a limit of 0 is unlimited.
+type Limits struct {
+	Items          int // Number of items.
+	Bytes          int // Total size of items and attachments in bytes.
+	AttachmentSize int // Size of a single attachment in bytes.
+	APIKeys        int // Number of active API keys.
+	Devices        int // Number of devices.
+}
+
+// Plans returns the limits of the account plans.
+func (q Quota) Plans() quota.Plans {
+	return quota.Plans{User: q.User.limits(), Admin: q.Admin.limits()}
+}
+
+// limits converts the limits of a plan.
+func (l Limits) limits() quota.Limits {
+	return quota.Limits{
+		Items:          int64(l.Items),
+		Bytes:          int64(l.Bytes),
+		AttachmentSize: int64(l.AttachmentSize),
+		APIKeys:        int64(l.APIKeys),
+		Devices:        int64(l.Devices),
+	}
+}
+
+// KeystorePassphrase returns the passphrase of the keystore, read from PassphraseFile if it is set.
+// A single trailing newline of the file is ignored.
+func (k KMS) KeystorePassphrase() (string, error) {
+	if k.PassphraseFile == "" {
+		return k.Passphrase, nil
+	}
+	data, err := os.ReadFile(k.PassphraseFile)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(strings.TrimSuffix(string(data), "\n"), "\r"), nil
+}
+
+// Default returns the configuration used for settings that are not configured.
+// The database DSN and the JWT key or keyring file have no defaults and must be configured.
+func Default() Config {
+	return Config{
+		Server: Server{
+			Port:        8080,
+			HttpsHost:   "localhost",
+			MetricsAddr: ":9464",
+		},
+		DB: DB{
+			MaxOpenConns:    10,
+			MaxIdleConns:    5,
+			ConnMaxLifetime: 15 * time.Minute,
+			AutoMigrate:     true,
+		},
+		Auth: Auth{
+			LockoutAttempts: 5,
+			LockoutDuration: 15 * time.Minute,
+		},
+		Blob: Blob{
+			Store: "postgres",
+			Dir:   "./data/blobs",
+		},
+		TLS: TLS{
+			DevDir:     "./data/tls",
+			ClientAuth: "optional",
+		},
+		Tracing: Tracing{
+			Exporter:    "none",
+			SampleRatio: 1,
+		},
+		KMS: KMS{
+			Provider:     "none",
+			KeystoreFile: "./data/kms/keystore.json",
+			VaultMount:   "transit",
+			VaultKey:     "gophkeeper",
+		},
+		Quota: Quota{
+			User: Limits{
+				Items:          10000,
+				Bytes:          1 << 30,
+				AttachmentSize: 100 << 20,
+				APIKeys:        20,
+				Devices:        20,
+			},
+			Admin: Limits{
+				Items:          50000,
+				Bytes:          10 << 30,
+				AttachmentSize: 100 << 20,
+				APIKeys:        50,
+				Devices:        50,
+			},
+		},
+	}
+}
@@ -0,0 +1,98 @@
+package config
+
+import (
+	"io"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// redacted replaces secrets in printed configurations.
+const redacted = "REDACTED"
+
+// dsnPassword matches the password of a DSN in keyword/value form, such as "host=db password='s3 cret'".
+var dsnPassword = regexp.MustCompile(`(password\s*=\s*)('(?:[^'\\]|\\.)*'|\S*)`)
+
+// Dump writes the configuration as a YAML configuration file, noting where each setting was set from.
+// Secrets, such as the JWT key and the password of the database DSN, are redacted.
+func (c Config) Dump(w io.Writer) error {
+	doc := &yaml.Node{Kind: yaml.MappingNode}
+	sections := make(map[string]*yaml.Node)
+
+	for _, s := range c.settings() {
+		section, name, _ := strings.Cut(s.key, ".")
+		values, ok := sections[section]
+		if !ok {
+			values = &yaml.Node{Kind: yaml.MappingNode}
+			sections[section] = values
+			doc.Content = append(doc.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: section}, values)
+		}
+
+		value := &yaml.Node{Kind: yaml.ScalarNode, Tag: tag(s.value), Value: s.String()}
+		if s.redact != nil {
+			value.Value = s.redact(value.Value)
+		}
+		source := c.sources[s.key]
+		if source == "" {
+			source = "default"
+		}
+		value.LineComment = source
+
+		values.Content = append(values.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: name}, value)
+	}
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// tag returns the YAML tag of the value a setting points to.
+func tag(value any) string {
+	switch value.(type) {
+	case *int:
+		return "!!int"
+	case *bool:
+		return "!!bool"
+	case *float64:
+		return "!!float"
+	case *time.Duration, *string:
+		return "!!str"
+	default:
+		return ""
+	}
+}
+
+// redactAll hides a secret entirely, showing only whether it is set.
+func redactAll(secret string) string {
+	if secret == "" {
+		return ""
+	}
+	return redacted
+}
+
+// redactDSN hides the password of a DSN in either URL or keyword/value form.
+func redactDSN(dsn string) string {
+	if !strings.Contains(dsn, "://") {
+		return dsnPassword.ReplaceAllString(dsn, "${1}"+redacted)
+	}
+
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return redactAll(dsn)
+	}
+	if _, ok := u.User.Password(); ok {
+		u.User = url.UserPassword(u.User.Username(), redacted)
+	}
+	// Passwords may also be passed as a query parameter.
+	if q := u.Query(); q.Has("password") {
+		q.Set("password", redacted)
+		u.RawQuery = q.Encode()
+	}
+	return u.String()
+}
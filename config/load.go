@@ -0,0 +1,175 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+// configFlag is the command-line flag naming the configuration file.
+const configFlag = "config"
+
+// RegisterFlags defines the command-line flags of the configuration in fs: --config, naming the configuration
+// file, and a flag for every setting except secrets.
+func RegisterFlags(fs *pflag.FlagSet) {
+	fs.String(configFlag, "", fmt.Sprintf("YAML configuration file (env %s)", ConfigFile))
+
+	def := Default()
+	for _, s := range def.settings() {
+		if s.flag == "" {
+			continue
+		}
+		f := fs.VarPF(&flagValue{text: s.String(), typ: typeName(s.value)}, s.flag, "",
+			fmt.Sprintf("%s (env %s)", s.usage, s.env))
+		if _, ok := s.value.(*bool); ok {
+			f.NoOptDefVal = "true"
+		}
+	}
+}
+
+// flagValue holds the text of a configuration flag; Load parses it along with the other layers,
+// so that invalid flags are reported together with the other problems.
+type flagValue struct {
+	text string
+	typ  string
+}
+
+func (v *flagValue) String() string     { return v.text }
+func (v *flagValue) Set(s string) error { v.text = s; return nil }
+func (v *flagValue) Type() string       { return v.typ }
+
+// typeName returns the name of the type of the value a setting points to, as shown in the help of flags.
+func typeName(value any) string {
+	switch value.(type) {
+	case *int:
+		return "int"
+	case *bool:
+		return "bool"
+	case *float64:
+		return "float"
+	case *time.Duration:
+		return "duration"
+	default:
+		return "string"
+	}
+}
+
+// Load builds the configuration from, in increasing order of precedence, the defaults, the configuration file,
+// the environment and the flags set in fs, which may be nil, and validates it.
+// The configuration file is named by the --config flag or the CONFIG_FILE environment variable; there is none
+// if neither is set. Empty environment variables are ignored.
+//
+// All problems found are reported together in the returned error. The configuration is returned even then,
+// with the values that could be read, so that it can be shown alongside the problems.
+func Load(fs *pflag.FlagSet) (Config, error) {
+	c := Default()
+	c.sources = make(map[string]string)
+
+	var errs []error
+
+	path := os.Getenv(string(ConfigFile))
+	if f := lookupFlag(fs, configFlag); f != nil {
+		path = f.Value.String()
+	}
+	if path != "" {
+		errs = append(errs, c.loadFile(path)...)
+	}
+
+	for _, s := range c.settings() {
+		text := os.Getenv(string(s.env))
+		if text == "" {
+			continue
+		}
+		if err := s.set(text); err != nil {
+			errs = append(errs, s.problem("%v", err))
+			continue
+		}
+		c.sources[s.key] = "env " + string(s.env)
+	}
+
+	for _, s := range c.settings() {
+		f := lookupFlag(fs, s.flag)
+		if f == nil {
+			continue
+		}
+		if err := s.set(f.Value.String()); err != nil {
+			errs = append(errs, fmt.Errorf("--%s: %w", s.flag, err))
+			continue
+		}
+		c.sources[s.key] = "flag --" + s.flag
+	}
+
+	// Settings that could not be read keep their previous value, so validation still finds the other problems.
+	errs = append(errs, c.Validate())
+	return c, errors.Join(errs...)
+}
+
+// loadFile reads the settings in the YAML configuration file at path into c.
+// The file holds a mapping of sections to mappings of setting names to values, such as
+//
+//	db:
+//	  dsn: postgres://localhost:5432/gophkeeper
+//	  max_open_conns: 20
+func (c *Config) loadFile(path string) []error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return []error{fmt.Errorf("failed to read configuration file: %w", err)}
+	}
+
+	var file map[string]map[string]yaml.Node
+	if err = yaml.Unmarshal(data, &file); err != nil {
+		return []error{fmt.Errorf("failed to parse %s: %w", path, err)}
+	}
+
+	settings := make(map[string]setting)
+	for _, s := range c.settings() {
+		settings[s.key] = s
+	}
+
+	type entry struct {
+		key  string
+		node yaml.Node
+	}
+	var entries []entry
+	for section, values := range file {
+		for name, node := range values {
+			entries = append(entries, entry{section + "." + name, node})
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].node.Line < entries[j].node.Line })
+
+	var errs []error
+	for _, e := range entries {
+		s, ok := settings[e.key]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s:%d: unknown setting %s", path, e.node.Line, e.key))
+			continue
+		}
+		if e.node.Kind != yaml.ScalarNode {
+			errs = append(errs, fmt.Errorf("%s:%d: %s: expected a single value", path, e.node.Line, e.key))
+			continue
+		}
+		if err = s.set(e.node.Value); err != nil {
+			errs = append(errs, fmt.Errorf("%s:%d: %s: %w", path, e.node.Line, e.key, err))
+			continue
+		}
+		c.sources[e.key] = "file " + path
+	}
+	return errs
+}
+
+// lookupFlag returns the flag of fs with the given name if it was set on the command line.
+func lookupFlag(fs *pflag.FlagSet, name string) *pflag.Flag {
+	if fs == nil || name == "" {
+		return nil
+	}
+	if f := fs.Lookup(name); f != nil && f.Changed {
+		return f
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// setting describes how a configuration value is read from the configuration file,
+// the environment and the command line.
+//
+// Fields:
+// - key: The key in the configuration file, as section.name.
+// - env: The environment variable.
+// - flag: The command-line flag; empty for secrets, which would otherwise show up in process listings.
+// - usage: The description of the setting.
+// - redact: Hides the secret parts of the value when the configuration is printed; nil for values without secrets.
+// - value: A pointer to the field of Config holding the value.
+type setting struct {
+	key    string
+	env    configKey
+	flag   string
+	usage  string
+	redact func(string) string
+	value  any
+}
+
+// settings lists the settings of c, in the order they are printed.
+func (c *Config) settings() []setting {
+	return []setting{
+		{"server.port", Port, "port", "port of the API server", nil, &c.Server.Port},
+		{"server.https_host", HttpsHost, "https-host", "hostname the server is reached at", nil, &c.Server.HttpsHost},
+		{"server.metrics_addr", MetricsAddr, "metrics-addr",
+			"address the Prometheus metrics are served at, apart from the API; empty disables them",
+			nil, &c.Server.MetricsAddr},
+		{"server.dev_mode", DevMode, "dev-mode", "run as a development deployment, allowing unsafe settings",
+			nil, &c.Server.DevMode},
+		{"server.is_swagger_created", IsSwaggerCreated, "swagger-created",
+			"skip generating the Swagger documentation", nil, &c.Server.IsSwaggerCreated},
+
+		{"db.dsn", DBDSN, "db-dsn", "data source name of the database", redactDSN, &c.DB.DSN},
+		{"db.max_open_conns", MaxOpenConns, "db-max-open-conns", "maximum number of open database connections",
+			nil, &c.DB.MaxOpenConns},
+		{"db.max_idle_conns", MaxIdleConns, "db-max-idle-conns", "maximum number of idle database connections",
+			nil, &c.DB.MaxIdleConns},
+		{"db.conn_max_lifetime", ConnMaxLifetime, "db-conn-max-lifetime", "maximum lifetime of a database connection",
+			nil, &c.DB.ConnMaxLifetime},
+		{"db.auto_migrate", DBAutoMigrate, "db-auto-migrate",
+			"apply pending migrations on startup, under a lock shared with the other replicas", nil, &c.DB.AutoMigrate},
+
+		{"auth.jwt_key", JwtKey, "", "hex-encoded Ed25519 private key signing access tokens", redactAll, &c.Auth.JwtKey},
+		{"auth.keyring_file", JwtKeyringFile, "jwt-keyring-file", "file holding the keyring of token signing keys",
+			nil, &c.Auth.KeyringFile},
+		{"auth.allow_fake", AuthAllowFake, "auth-allow-fake",
+			"accept a bare username in the Authorization header as authentication; requires development mode",
+			nil, &c.Auth.AllowFake},
+		{"auth.lockout_attempts", AuthLockoutAttempts, "auth-lockout-attempts",
+			"consecutive wrong passwords from a client address that lock it out of the account; 0 disables the lockout",
+			nil, &c.Auth.LockoutAttempts},
+		{"auth.lockout_duration", AuthLockoutDuration, "auth-lockout-duration",
+			"how long a client address is locked out after too many wrong passwords", nil, &c.Auth.LockoutDuration},
+
+		{"blob.store", BlobStore, "blob-store", `store of attachment contents: "postgres" or "local"`,
+			nil, &c.Blob.Store},
+		{"blob.dir", BlobDir, "blob-dir", `directory of the "local" blob store`, nil, &c.Blob.Dir},
+
+		{"tls.cert_file", TLSCertFile, "tls-cert-file", "PEM file of the server certificate", nil, &c.TLS.CertFile},
+		{"tls.key_file", TLSKeyFile, "tls-key-file", "PEM file of the server certificate's private key",
+			nil, &c.TLS.KeyFile},
+		{"tls.disabled", TLSDisabled, "tls-disabled", "serve plain HTTP behind a TLS-terminating proxy",
+			nil, &c.TLS.Disabled},
+		{"tls.dev_dir", TLSDevDir, "tls-dev-dir", "directory of the self-signed development certificate",
+			nil, &c.TLS.DevDir},
+		{"tls.client_ca_file", TLSClientCAFile, "tls-client-ca-file",
+			"PEM file of the CA certificates client certificates are verified against", nil, &c.TLS.ClientCAFile},
+		{"tls.client_auth", TLSClientAuth, "tls-client-auth", `whether client certificates are "optional" or "require"d`,
+			nil, &c.TLS.ClientAuth},
+		{"tls.client_users_file", TLSClientUsersFile, "tls-client-users-file",
+			"file mapping client certificate subjects to users", nil, &c.TLS.ClientUsersFile},
+
+		{"tracing.exporter", TracingExporter, "tracing-exporter",
+			`where trace spans are exported: "none", "otlp" or "stdout"`, nil, &c.Tracing.Exporter},
+		{"tracing.endpoint", TracingEndpoint, "tracing-endpoint",
+			"URL of the OTLP/HTTP collector trace spans are exported to", nil, &c.Tracing.Endpoint},
+		{"tracing.sample_ratio", TracingSampleRatio, "tracing-sample-ratio", "fraction of traces sampled, from 0 to 1",
+			nil, &c.Tracing.SampleRatio},
+
+		{"kms.provider", KMSProvider, "kms-provider",
+			`key manager of encryption at rest: "none", "local", "vault" or "memory"`, nil, &c.KMS.Provider},
+		{"kms.keystore_file", KMSKeystoreFile, "kms-keystore-file", `keystore file of the "local" key manager`,
+			nil, &c.KMS.KeystoreFile},
+		{"kms.passphrase", KMSPassphrase, "", "passphrase of the keystore", redactAll, &c.KMS.Passphrase},
+		{"kms.passphrase_file", KMSPassphraseFile, "kms-passphrase-file", "file holding the passphrase of the keystore",
+			nil, &c.KMS.PassphraseFile},
+		{"kms.vault_addr", KMSVaultAddr, "kms-vault-addr", `address of the Vault server of the "vault" key manager`,
+			nil, &c.KMS.VaultAddr},
+		{"kms.vault_token", KMSVaultToken, "", "token authenticating to Vault", redactAll, &c.KMS.VaultToken},
+		{"kms.vault_namespace", KMSVaultNamespace, "kms-vault-namespace", "Vault Enterprise namespace",
+			nil, &c.KMS.VaultNamespace},
+		{"kms.vault_mount", KMSVaultMount, "kms-vault-mount", "path the Vault transit secrets engine is mounted at",
+			nil, &c.KMS.VaultMount},
+		{"kms.vault_key", KMSVaultKey, "kms-vault-key", "name of the Vault transit key", nil, &c.KMS.VaultKey},
+
+		{"quota.user_items", QuotaUserItems, "quota-user-items", "maximum number of items of a user, 0 for unlimited",
+			nil, &c.Quota.User.Items},
+		{"quota.user_bytes", QuotaUserBytes, "quota-user-bytes",
+			"maximum total size of the items and attachments of a user in bytes, 0 for unlimited",
+			nil, &c.Quota.User.Bytes},
+		{"quota.user_attachment_size", QuotaUserAttachmentSize, "quota-user-attachment-size",
+			"maximum size of an attachment of a user in bytes, 0 for unlimited", nil, &c.Quota.User.AttachmentSize},
+		{"quota.user_api_keys", QuotaUserAPIKeys, "quota-user-api-keys",
+			"maximum number of active API keys of a user, 0 for unlimited", nil, &c.Quota.User.APIKeys},
+		{"quota.user_devices", QuotaUserDevices, "quota-user-devices",
+			"maximum number of devices of a user, 0 for unlimited", nil, &c.Quota.User.Devices},
+		{"quota.admin_items", QuotaAdminItems, "quota-admin-items",
+			"maximum number of items of an administrator, 0 for unlimited", nil, &c.Quota.Admin.Items},
+		{"quota.admin_bytes", QuotaAdminBytes, "quota-admin-bytes",
+			"maximum total size of the items and attachments of an administrator in bytes, 0 for unlimited",
+			nil, &c.Quota.Admin.Bytes},
+		{"quota.admin_attachment_size", QuotaAdminAttachmentSize, "quota-admin-attachment-size",
+			"maximum size of an attachment of an administrator in bytes, 0 for unlimited",
+			nil, &c.Quota.Admin.AttachmentSize},
+		{"quota.admin_api_keys", QuotaAdminAPIKeys, "quota-admin-api-keys",
+			"maximum number of active API keys of an administrator, 0 for unlimited", nil, &c.Quota.Admin.APIKeys},
+		{"quota.admin_devices", QuotaAdminDevices, "quota-admin-devices",
+			"maximum number of devices of an administrator, 0 for unlimited", nil, &c.Quota.Admin.Devices},
+	}
+}
+
+// lookup returns the setting of c holding the field value points to.
+func (c *Config) lookup(value any) setting {
+	for _, s := range c.settings() {
+		if s.value == value {
+			return s
+		}
+	}
+	panic(fmt.Sprintf("config: no setting holds %T", value))
+}
+
+// set parses text into the value of the setting. The value is left unchanged if text is invalid.
+func (s setting) set(text string) error {
+	var err error
+	switch v := s.value.(type) {
+	case *string:
+		*v = text
+	case *int:
+		err = assign(v, text, strconv.Atoi)
+	case *bool:
+		err = assign(v, text, strconv.ParseBool)
+	case *float64:
+		err = assign(v, text, func(text string) (float64, error) { return strconv.ParseFloat(text, 64) })
+	case *time.Duration:
+		err = assign(v, text, time.ParseDuration)
+	default:
+		panic(fmt.Sprintf("config: unsupported setting type %T", v))
+	}
+	if err != nil {
+		if s.redact != nil {
+			text = s.redact(text)
+		}
+		return fmt.Errorf("invalid value %q", text)
+	}
+	return nil
+}
+
+// assign parses text and stores the result in v if it is valid.
+func assign[T any](v *T, text string, parse func(string) (T, error)) error {
+	parsed, err := parse(text)
+	if err != nil {
+		return err
+	}
+	*v = parsed
+	return nil
+}
+
+// String formats the value of the setting the way set parses it.
+func (s setting) String() string {
+	switch v := s.value.(type) {
+	case *string:
+		return *v
+	case *int:
+		return strconv.Itoa(*v)
+	case *bool:
+		return strconv.FormatBool(*v)
+	case *float64:
+		return strconv.FormatFloat(*v, 'g', -1, 64)
+	case *time.Duration:
+		return v.String()
+	default:
+		panic(fmt.Sprintf("config: unsupported setting type %T", v))
+	}
+}
+
+// problem returns an error describing a problem with the setting.
+func (s setting) problem(format string, args ...any) error {
+	return fmt.Errorf("%s (%s): %s", s.key, s.env, fmt.Sprintf(format, args...))
+}
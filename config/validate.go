@@ -0,0 +1,141 @@
+package config
+
+import (
+	"crypto/ed25519"
+	"errors"
+	"io/fs"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+
+	"github.com/gleb-korostelev/GophKeeper/pkg/keyring"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Validate checks the configuration and reports all problems found together.
+func (c *Config) Validate() error {
+	var errs []error
+	check := func(ok bool, value any, format string, args ...any) {
+		if !ok {
+			errs = append(errs, c.lookup(value).problem(format, args...))
+		}
+	}
+
+	check(c.Server.Port > 0 && c.Server.Port < 65535, &c.Server.Port,
+		"must be between 1 and 65534, the next port serves the Swagger documentation")
+	check(c.Server.HttpsHost != "", &c.Server.HttpsHost, "must be set")
+	if c.Server.MetricsAddr != "" {
+		_, port, err := net.SplitHostPort(c.Server.MetricsAddr)
+		check(err == nil && port != strconv.Itoa(c.Server.Port) && port != strconv.Itoa(c.Server.Port+1),
+			&c.Server.MetricsAddr, "must be a host:port address apart from the API and Swagger documentation ports")
+	}
+
+	if c.DB.DSN == "" {
+		check(false, &c.DB.DSN, "must be set")
+	} else if _, err := pgconn.ParseConfig(c.DB.DSN); err != nil {
+		// The parse error redacts the password of the DSN.
+		check(false, &c.DB.DSN, "%v", err)
+	}
+	check(c.DB.MaxOpenConns > 0, &c.DB.MaxOpenConns, "must be positive")
+	check(c.DB.MaxIdleConns >= 0 && c.DB.MaxIdleConns <= c.DB.MaxOpenConns, &c.DB.MaxIdleConns,
+		"must be between 0 and the maximum number of open connections")
+	check(c.DB.ConnMaxLifetime > 0, &c.DB.ConnMaxLifetime, "must be positive")
+
+	if c.Auth.JwtKey != "" {
+		_, err := c.Auth.JwtPrivateKey()
+		check(err == nil, &c.Auth.JwtKey, "must be a hex-encoded Ed25519 private key of %d bytes: %v",
+			ed25519.PrivateKeySize, err)
+	}
+	if c.Auth.KeyringFile == "" {
+		check(c.Auth.JwtKey != "", &c.Auth.JwtKey, "must be set unless a keyring file is configured")
+	} else if _, err := keyring.Load(c.Auth.KeyringFile); errors.Is(err, fs.ErrNotExist) {
+		check(c.Auth.JwtKey != "", &c.Auth.KeyringFile,
+			"does not exist; create it with the keys generate command or set the JWT key")
+	} else {
+		check(err == nil, &c.Auth.KeyringFile, "%v", err)
+	}
+
+	// Fake authentication lets anyone act as any user, so it must never be enabled by accident.
+	check(!c.Auth.AllowFake || c.Server.DevMode, &c.Auth.AllowFake, "requires development mode (%s)", DevMode)
+	check(c.Auth.LockoutAttempts >= 0, &c.Auth.LockoutAttempts, "must not be negative, 0 disables the lockout")
+	check(c.Auth.LockoutDuration > 0, &c.Auth.LockoutDuration, "must be positive")
+
+	switch c.Blob.Store {
+	case "postgres":
+	case "local":
+		check(c.Blob.Dir != "", &c.Blob.Dir, `must be set for the "local" blob store`)
+	default:
+		check(false, &c.Blob.Store, `must be "postgres" or "local"`)
+	}
+
+	if !c.TLS.Disabled {
+		check((c.TLS.CertFile == "") == (c.TLS.KeyFile == ""), &c.TLS.KeyFile,
+			"must be set together with the certificate file")
+		if c.TLS.CertFile == "" {
+			check(c.Server.DevMode, &c.TLS.CertFile,
+				"must be set unless in development mode (%s), which serves a self-signed certificate", DevMode)
+			check(c.TLS.DevDir != "", &c.TLS.DevDir, "must be set when no certificate is configured")
+		}
+		check(c.TLS.ClientAuth == "optional" || c.TLS.ClientAuth == "require", &c.TLS.ClientAuth,
+			`must be "optional" or "require"`)
+		check(c.TLS.ClientUsersFile == "" || c.TLS.ClientCAFile != "", &c.TLS.ClientUsersFile,
+			"requires the client CA file")
+
+		for _, file := range []*string{&c.TLS.CertFile, &c.TLS.KeyFile, &c.TLS.ClientCAFile, &c.TLS.ClientUsersFile} {
+			if *file == "" {
+				continue
+			}
+			_, err := os.Stat(*file)
+			check(err == nil, file, "%v", err)
+		}
+	}
+
+	switch c.Tracing.Exporter {
+	case "none", "stdout":
+	case "otlp":
+		if c.Tracing.Endpoint != "" {
+			u, err := url.Parse(c.Tracing.Endpoint)
+			check(err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != "", &c.Tracing.Endpoint,
+				"must be an http or https URL")
+		}
+	default:
+		check(false, &c.Tracing.Exporter, `must be "none", "otlp" or "stdout"`)
+	}
+	check(c.Tracing.SampleRatio >= 0 && c.Tracing.SampleRatio <= 1, &c.Tracing.SampleRatio, "must be between 0 and 1")
+
+	switch c.KMS.Provider {
+	case "none", "memory":
+	case "local":
+		check(c.KMS.KeystoreFile != "", &c.KMS.KeystoreFile, `must be set for the "local" key manager`)
+		if c.KMS.PassphraseFile != "" {
+			check(c.KMS.Passphrase == "", &c.KMS.PassphraseFile, "must not be set together with the passphrase")
+			passphrase, err := c.KMS.KeystorePassphrase()
+			if err != nil {
+				check(false, &c.KMS.PassphraseFile, "%v", err)
+			} else {
+				check(passphrase != "", &c.KMS.PassphraseFile, "is empty")
+			}
+		} else {
+			check(c.KMS.Passphrase != "", &c.KMS.Passphrase, `or the passphrase file must be set for the "local" key manager`)
+		}
+	case "vault":
+		u, err := url.Parse(c.KMS.VaultAddr)
+		check(err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != "", &c.KMS.VaultAddr,
+			`must be an http or https URL for the "vault" key manager`)
+		check(c.KMS.VaultToken != "", &c.KMS.VaultToken, `must be set for the "vault" key manager`)
+		check(c.KMS.VaultMount != "", &c.KMS.VaultMount, `must be set for the "vault" key manager`)
+		check(c.KMS.VaultKey != "", &c.KMS.VaultKey, `must be set for the "vault" key manager`)
+	default:
+		check(false, &c.KMS.Provider, `must be "none", "local", "vault" or "memory"`)
+	}
+
+	for _, limits := range []*Limits{&c.Quota.User, &c.Quota.Admin} {
+		for _, limit := range []*int{&limits.Items, &limits.Bytes, &limits.AttachmentSize, &limits.APIKeys,
+			&limits.Devices} {
+			check(*limit >= 0, limit, "must not be negative, 0 is unlimited")
+		}
+	}
+
+	return errors.Join(errs...)
+}
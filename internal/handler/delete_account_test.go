@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteAccount(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+	mockAccountSvc := MockService.NewAccountSvcMock(mc)
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		requestBody    interface{}
+		contextIssuer  string
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Successful deletion",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAccountSvc.DeleteAccountMock.Expect(
+					minimock.AnyContext, "test_user", "secret",
+				).Return(nil)
+			},
+			requestBody: map[string]string{
+				"password": "secret",
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Incorrect password",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAccountSvc.DeleteAccountMock.Expect(
+					minimock.AnyContext, "test_user", "wrong",
+				).Return(svc.ErrIncorrectPassword)
+			},
+			requestBody: map[string]string{
+				"password": "wrong",
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "incorrect password",
+			},
+		},
+		{
+			name: "Last organization owner",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAccountSvc.DeleteAccountMock.Expect(
+					minimock.AnyContext, "test_user", "secret",
+				).Return(fmt.Errorf("%w: %s", svc.ErrLastOrgOwner, "acme"))
+			},
+			requestBody: map[string]string{
+				"password": "secret",
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusConflict,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "account is the last owner of an organization: acme",
+			},
+		},
+		{
+			name: "Not enough rights",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountUnauthorizedUser,
+				}, nil)
+			},
+			requestBody: map[string]string{
+				"password": "secret",
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "not enough rights",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc:    mockAuthSvc,
+				AccountSvc: mockAccountSvc,
+			}
+
+			reqBody, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest("DELETE", "/api/v1/account", bytes.NewBuffer(reqBody))
+			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, tt.contextIssuer)
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+
+			h.DeleteAccount(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
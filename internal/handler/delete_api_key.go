@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gorilla/mux"
+)
+
+// APIKeyIDPathVar is the route variable holding the API key identifier.
+const APIKeyIDPathVar = "id"
+
+// DeleteAPIKey handles revoking one of the user's personal API keys.
+func (i *Implementation) DeleteAPIKey(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Retrieve the issuer (user ID or token subject) from the request context.
+	issuer, err := middleware.GetIssuer(ctx)
+	if err != nil {
+		handleErrResponse(rw, middleware.ErrTokenInvalid)
+		return
+	}
+
+	// Retrieve the user's account details from the authentication service.
+	var acc models.Account
+	acc, err = i.AuthSvc.GetAccountByUserName(ctx, issuer)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Ensure the user has sufficient rights to perform this action.
+	if acc.AccountType != models.AccountAuthorizedUser {
+		handleErrResponse(rw, middleware.ErrNotEnoughRights)
+		return
+	}
+
+	// Revoke the key using the authentication service.
+	err = i.AuthSvc.RevokeAPIKey(ctx, acc.Username, mux.Vars(r)[APIKeyIDPathVar])
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Respond with a success message.
+	response.OK(rw, nil)
+}
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteAPIKey(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		contextIssuer  string
+		keyID          string
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Successful revocation",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAuthSvc.RevokeAPIKeyMock.Expect(
+					minimock.AnyContext, "test_user", "0a1b2c3d-0000-0000-0000-000000000001",
+				).Return(nil)
+			},
+			contextIssuer:  "test_user",
+			keyID:          "0a1b2c3d-0000-0000-0000-000000000001",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Key not found",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAuthSvc.RevokeAPIKeyMock.Expect(
+					minimock.AnyContext, "test_user", "unknown",
+				).Return(svc.ErrAPIKeyNotFound)
+			},
+			contextIssuer:  "test_user",
+			keyID:          "unknown",
+			expectedStatus: http.StatusNotFound,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "api key not found",
+			},
+		},
+		{
+			name: "Not enough rights",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountUnauthorizedUser,
+				}, nil)
+			},
+			contextIssuer:  "test_user",
+			keyID:          "0a1b2c3d-0000-0000-0000-000000000001",
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "not enough rights",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc: mockAuthSvc,
+			}
+
+			req := httptest.NewRequest("DELETE", "/api/v1/api-keys/"+tt.keyID, nil)
+			req = mux.SetURLVars(req, map[string]string{APIKeyIDPathVar: tt.keyID})
+			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, tt.contextIssuer)
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+
+			h.DeleteAPIKey(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
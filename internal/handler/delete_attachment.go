@@ -0,0 +1,46 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gorilla/mux"
+)
+
+// DeleteAttachment handles deleting one of the user's attachments, finished or not.
+func (i *Implementation) DeleteAttachment(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Retrieve the issuer (user ID or token subject) from the request context.
+	issuer, err := middleware.GetIssuer(ctx)
+	if err != nil {
+		handleErrResponse(rw, middleware.ErrTokenInvalid)
+		return
+	}
+
+	// Retrieve the user's account details from the authentication service.
+	var acc models.Account
+	acc, err = i.AuthSvc.GetAccountByUserName(ctx, issuer)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Ensure the user has sufficient rights to perform this action.
+	if acc.AccountType != models.AccountAuthorizedUser {
+		handleErrResponse(rw, middleware.ErrNotEnoughRights)
+		return
+	}
+
+	// Delete the attachment using the attachment service.
+	err = i.AttachmentSvc.DeleteAttachment(ctx, acc.Username, mux.Vars(r)[AttachmentIDPathVar])
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Respond with a success message.
+	response.OK(rw, nil)
+}
@@ -0,0 +1,117 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteAttachment(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+	mockAttachmentSvc := MockService.NewAttachmentSvcMock(mc)
+
+	const id = "8d3c1c2e-4f3a-4b8a-9a0c-2f5d2c1e7b6a"
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		contextIssuer  string
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Successful deletion",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAttachmentSvc.DeleteAttachmentMock.Expect(
+					minimock.AnyContext, "test_user", id,
+				).Return(nil)
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"success": true,
+				"message": "Success",
+			},
+		},
+		{
+			name: "Attachment not found",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAttachmentSvc.DeleteAttachmentMock.Expect(
+					minimock.AnyContext, "test_user", id,
+				).Return(svc.ErrAttachmentNotFound)
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusNotFound,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "attachment not found",
+			},
+		},
+		{
+			name: "Not enough rights",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountUnauthorizedUser,
+				}, nil)
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "not enough rights",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc:       mockAuthSvc,
+				AttachmentSvc: mockAttachmentSvc,
+			}
+
+			req := httptest.NewRequest("DELETE", "/api/v1/attachments/"+id, nil)
+			req = mux.SetURLVars(req, map[string]string{AttachmentIDPathVar: id})
+			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, tt.contextIssuer)
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+
+			h.DeleteAttachment(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
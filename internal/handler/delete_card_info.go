@@ -48,8 +48,8 @@ func (i *Implementation) DeleteCardInfo(rw http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	// Call the Profile service to delete the card for the user.
-	err = i.ProfileSvc.DeleteCard(ctx, acc.Username, req.CardNumber)
+	// Call the Profile service to delete the card for the user, within the scope of the API key making the request.
+	err = i.ProfileSvc.DeleteCard(ctx, acc.Username, req.CardNumber, middleware.GetAPIKeyScope(ctx))
 	if err != nil {
 		handleErrResponse(rw, err)
 		return
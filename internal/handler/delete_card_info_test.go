@@ -41,7 +41,7 @@ func TestDeleteCardInfo(t *testing.T) {
 				}, nil)
 
 				mockProfileSvc.DeleteCardMock.Expect(
-					minimock.AnyContext, "test_user", "1234567812345678",
+					minimock.AnyContext, "test_user", "1234567812345678", nil,
 				).Return(nil)
 			},
 			requestBody: map[string]string{
@@ -133,7 +133,7 @@ func TestDeleteCardInfo(t *testing.T) {
 				}, nil)
 
 				mockProfileSvc.DeleteCardMock.Expect(
-					minimock.AnyContext, "test_user", "1234567812345678",
+					minimock.AnyContext, "test_user", "1234567812345678", nil,
 				).Return(errors.New("card deletion error"))
 			},
 			requestBody: map[string]string{
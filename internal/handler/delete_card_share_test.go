@@ -0,0 +1,128 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteCardShare(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+	mockProfileSvc := MockService.NewProfileSvcMock(mc)
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		requestBody    interface{}
+		contextIssuer  string
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Successful revoke",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockProfileSvc.RevokeShareMock.Expect(
+					minimock.AnyContext, "test_user", "teammate", "1234567812345678",
+				).Return(nil)
+			},
+			requestBody: map[string]string{
+				"card_number": "1234567812345678",
+				"username":    "teammate",
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"success": true,
+				"message": "Success",
+			},
+		},
+		{
+			name: "Not enough rights",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountUnauthorizedUser,
+				}, nil)
+			},
+			requestBody: map[string]string{
+				"card_number": "1234567812345678",
+				"username":    "teammate",
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "not enough rights",
+			},
+		},
+		{
+			name: "Share not found",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockProfileSvc.RevokeShareMock.Expect(
+					minimock.AnyContext, "test_user", "stranger", "1234567812345678",
+				).Return(svc.ErrShareNotFound)
+			},
+			requestBody: map[string]string{
+				"card_number": "1234567812345678",
+				"username":    "stranger",
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusNotFound,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "share not found",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc:    mockAuthSvc,
+				ProfileSvc: mockProfileSvc,
+			}
+
+			reqBody, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest("DELETE", "/api/v1/cards/share", bytes.NewBuffer(reqBody))
+			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, tt.contextIssuer)
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+
+			h.DeleteCardShare(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gorilla/mux"
+)
+
+// DeviceIDPathVar is the route variable holding the device identifier.
+const DeviceIDPathVar = "id"
+
+// DeleteDevice handles removing one of the user's devices. Every session of the device is signed out.
+func (i *Implementation) DeleteDevice(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Retrieve the issuer (user ID or token subject) from the request context.
+	issuer, err := middleware.GetIssuer(ctx)
+	if err != nil {
+		handleErrResponse(rw, middleware.ErrTokenInvalid)
+		return
+	}
+
+	// Retrieve the user's account details from the authentication service.
+	var acc models.Account
+	acc, err = i.AuthSvc.GetAccountByUserName(ctx, issuer)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Ensure the user has sufficient rights to perform this action.
+	if acc.AccountType != models.AccountAuthorizedUser {
+		handleErrResponse(rw, middleware.ErrNotEnoughRights)
+		return
+	}
+
+	// Delete the device using the authentication service.
+	revoked, err := i.AuthSvc.DeleteDevice(ctx, acc.Username, mux.Vars(r)[DeviceIDPathVar])
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Respond with the number of signed out sessions.
+	response.OK(rw, models.DeleteDeviceResp{RevokedSessions: revoked})
+}
@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteDevice(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		contextIssuer  string
+		deviceID       string
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Successful removal",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAuthSvc.DeleteDeviceMock.Expect(
+					minimock.AnyContext, "test_user", "device-1",
+				).Return(2, nil)
+			},
+			contextIssuer:  "test_user",
+			deviceID:       "device-1",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"revoked_sessions": 2,
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Device not found",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAuthSvc.DeleteDeviceMock.Expect(
+					minimock.AnyContext, "test_user", "unknown",
+				).Return(0, svc.ErrDeviceNotFound)
+			},
+			contextIssuer:  "test_user",
+			deviceID:       "unknown",
+			expectedStatus: http.StatusNotFound,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "device not found",
+			},
+		},
+		{
+			name: "Not enough rights",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountUnauthorizedUser,
+				}, nil)
+			},
+			contextIssuer:  "test_user",
+			deviceID:       "device-1",
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "not enough rights",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc: mockAuthSvc,
+			}
+
+			req := httptest.NewRequest("DELETE", "/api/v1/devices/"+tt.deviceID, nil)
+			req = mux.SetURLVars(req, map[string]string{DeviceIDPathVar: tt.deviceID})
+			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, tt.contextIssuer)
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+
+			h.DeleteDevice(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteEmergencyContact(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+	mockEmergencySvc := MockService.NewEmergencySvcMock(mc)
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		requestBody    interface{}
+		contextIssuer  string
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Successful request",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockEmergencySvc.RemoveContactMock.Expect(
+					minimock.AnyContext, "test_user", "trusted",
+				).Return(nil)
+			},
+			requestBody: map[string]string{
+				"username": "trusted",
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"success": true,
+				"message": "Success",
+			},
+		},
+		{
+			name: "Not enough rights",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountUnauthorizedUser,
+				}, nil)
+			},
+			requestBody: map[string]string{
+				"username": "trusted",
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "not enough rights",
+			},
+		},
+		{
+			name: "Contact not found",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockEmergencySvc.RemoveContactMock.Expect(
+					minimock.AnyContext, "test_user", "stranger",
+				).Return(svc.ErrEmergencyContactNotFound)
+			},
+			requestBody: map[string]string{
+				"username": "stranger",
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusNotFound,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "emergency contact not found",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc:      mockAuthSvc,
+				EmergencySvc: mockEmergencySvc,
+			}
+
+			reqBody, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest("DELETE", "/api/v1/emergency/contacts", bytes.NewBuffer(reqBody))
+			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, tt.contextIssuer)
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+
+			h.DeleteEmergencyContact(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
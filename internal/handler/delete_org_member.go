@@ -0,0 +1,63 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/tools/decoder"
+	"github.com/gorilla/mux"
+)
+
+// DeleteOrgMember handles removing a user from an organization.
+func (i *Implementation) DeleteOrgMember(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Retrieve the issuer (user ID or token subject) from the request context.
+	issuer, err := middleware.GetIssuer(ctx)
+	if err != nil {
+		handleErrResponse(rw, middleware.ErrTokenInvalid)
+		return
+	}
+
+	// Retrieve the user's account details from the authentication service.
+	var acc models.Account
+	acc, err = i.AuthSvc.GetAccountByUserName(ctx, issuer)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Ensure the user has sufficient rights to perform this action.
+	if acc.AccountType != models.AccountAuthorizedUser {
+		handleErrResponse(rw, middleware.ErrNotEnoughRights)
+		return
+	}
+
+	// Extract the organization name from the route.
+	orgName := mux.Vars(r)[middleware.OrgPathVar]
+
+	// Decode the request body to extract the member username.
+	req, err := decoder.DecodeJson[models.DeleteOrgMemberReq](r.Body)
+	if err != nil {
+		if _, ok := err.(*json.SyntaxError); ok || strings.Contains(err.Error(), "invalid character") {
+			handleErrResponse(rw, errInvalidRequestBody)
+		} else {
+			handleErrResponse(rw, err)
+		}
+		return
+	}
+
+	// Remove the membership using the organization service.
+	err = i.OrgSvc.RemoveMember(ctx, acc.Username, orgName, req.Username)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Respond with a success message.
+	response.OK(rw, nil)
+}
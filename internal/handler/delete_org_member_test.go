@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeleteOrgMember(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+	mockOrgSvc := MockService.NewOrgSvcMock(mc)
+
+	authorized := func() {
+		mockAuthSvc.GetAccountByUserNameMock.Expect(
+			minimock.AnyContext, "test_user",
+		).Return(models.Account{
+			Username:    "test_user",
+			AccountType: models.AccountAuthorizedUser,
+		}, nil)
+	}
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		requestBody    interface{}
+		contextIssuer  string
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Successful removal",
+			setupMocks: func() {
+				authorized()
+				mockOrgSvc.RemoveMemberMock.Expect(minimock.AnyContext, "test_user", "acme", "teammate").Return(nil)
+			},
+			requestBody:    map[string]string{"username": "teammate"},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"success": true,
+				"message": "Success",
+			},
+		},
+		{
+			name:           "Error retrieving issuer",
+			setupMocks:     func() {},
+			requestBody:    map[string]string{},
+			contextIssuer:  "",
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "bearer token is not correct",
+			},
+		},
+		{
+			name: "Not a member",
+			setupMocks: func() {
+				authorized()
+				mockOrgSvc.RemoveMemberMock.Expect(minimock.AnyContext, "test_user", "acme", "stranger").Return(svc.ErrNotOrgMember)
+			},
+			requestBody:    map[string]string{"username": "stranger"},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusNotFound,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "user is not a member of the organization",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc: mockAuthSvc,
+				OrgSvc:  mockOrgSvc,
+			}
+
+			reqBody, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest("DELETE", "/api/v1/orgs/acme/members", bytes.NewBuffer(reqBody))
+			req = mux.SetURLVars(req, map[string]string{middleware.OrgPathVar: "acme"})
+			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, tt.contextIssuer)
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+
+			h.DeleteOrgMember(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
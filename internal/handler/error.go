@@ -6,7 +6,7 @@ import (
 
 	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
 	"github.com/gleb-korostelev/GophKeeper/middleware"
-	"github.com/gleb-korostelev/GophKeeper/tools/logger"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
 )
 
 // Predefined errors for common failure scenarios.
@@ -20,6 +20,9 @@ var (
 	// errInvalidArgument indicates that an argument provided in the request is invalid.
 	errInvalidArgument = errors.New("invalid argument")
 
+	// errInvalidQuery indicates that a query parameter could not be parsed.
+	errInvalidQuery = errors.New("invalid query parameter")
+
 	// errAuthFailed indicates that user authentication has failed.
 	errAuthFailed = errors.New("authentication failed")
 )
@@ -27,25 +30,69 @@ var (
 // handleErrResponse sends an appropriate HTTP response based on the provided error.
 // It maps predefined errors to specific HTTP status codes and response messages,
 // ensuring consistent error handling across the application.
+// The error is logged with the request by the access log.
 func handleErrResponse(rw http.ResponseWriter, err error) {
-	defer logger.Info(err)
+	middleware.RecordError(rw, err)
 
-	switch err {
-	case errInvalidRequestBody:
-		// Handle invalid request body errors.
+	switch {
+	case errors.Is(err, errInvalidRequestBody), errors.Is(err, errInvalidQuery):
+		// Handle invalid request body and query parameter errors.
 		response.BadRequest(rw, err.Error())
-	case errHashingPassword:
+	case errors.Is(err, errHashingPassword):
 		// Handle errors related to password hashing.
 		response.Internal(rw, err.Error())
-	case middleware.ErrTokenInvalid:
+	case errors.Is(err, middleware.ErrTokenInvalid):
 		// Handle token invalid errors (unauthorized access).
 		response.Unauthenticated(rw, err.Error())
-	case middleware.ErrNotEnoughRights:
-		// Handle insufficient permission errors (forbidden access).
+	case errors.Is(err, middleware.ErrNotEnoughRights), errors.Is(err, svc.ErrNotAuthorized),
+		errors.Is(err, svc.ErrOutOfScope):
+		// Handle insufficient permission errors (forbidden access), including API keys acting outside their scope.
 		response.Forbidden(rw, err.Error())
-	case errAuthFailed:
+	case errors.Is(err, errAuthFailed):
 		// Handle authentication failure errors (unauthorized access).
 		response.Unauthenticated(rw, err.Error())
+	case errors.Is(err, svc.ErrIncorrectPassword), errors.Is(err, svc.ErrRecoveryFailed):
+		// Handle wrong passwords on password-protected resources and failed account recoveries.
+		response.Unauthenticated(rw, err.Error())
+	case errors.Is(err, svc.ErrAccountNotFound), errors.Is(err, svc.ErrCardNotFound),
+		errors.Is(err, svc.ErrShareNotFound), errors.Is(err, svc.ErrSendNotFound),
+		errors.Is(err, svc.ErrEmergencyContactNotFound), errors.Is(err, svc.ErrFolderNotFound),
+		errors.Is(err, svc.ErrTagNotFound), errors.Is(err, svc.ErrAttachmentNotFound),
+		errors.Is(err, svc.ErrRecoveryKitNotFound), errors.Is(err, svc.ErrDeviceNotFound),
+		errors.Is(err, svc.ErrAPIKeyNotFound):
+		// Handle missing accounts, cards and shares.
+		response.NotFound(rw, err.Error())
+	case errors.Is(err, svc.ErrOrgNotFound), errors.Is(err, svc.ErrNotOrgMember),
+		errors.Is(err, svc.ErrGroupNotFound), errors.Is(err, svc.ErrCollectionNotFound):
+		// Handle missing organizations, memberships, groups and collections.
+		response.NotFound(rw, err.Error())
+	case errors.Is(err, svc.ErrInvalidPermission), errors.Is(err, svc.ErrWrappedKeyRequired),
+		errors.Is(err, svc.ErrInvalidRole), errors.Is(err, svc.ErrOrgExists),
+		errors.Is(err, svc.ErrInvalidSend), errors.Is(err, svc.ErrInvalidWaitPeriod),
+		errors.Is(err, svc.ErrInvalidType), errors.Is(err, svc.ErrInvalidTag),
+		errors.Is(err, svc.ErrInvalidMetadata), errors.Is(err, svc.ErrInvalidQuota),
+		errors.Is(err, svc.ErrInvalidFolder), errors.Is(err, svc.ErrFolderExists),
+		errors.Is(err, svc.ErrInvalidSearch), errors.Is(err, svc.ErrInvalidAttachment),
+		errors.Is(err, svc.ErrInvalidRange), errors.Is(err, svc.ErrChecksumMismatch),
+		errors.Is(err, svc.ErrUnknownFormat), errors.Is(err, svc.ErrInvalidImport),
+		errors.Is(err, svc.ErrUnknownExportFormat), errors.Is(err, svc.ErrWeakExportPassword),
+		errors.Is(err, svc.ErrPlaintextNotConfirmed), errors.Is(err, svc.ErrInvalidPassword),
+		errors.Is(err, svc.ErrIncompleteRekey), errors.Is(err, svc.ErrInvalidRecoveryKit),
+		errors.Is(err, svc.ErrInvalidRecoveryShare), errors.Is(err, svc.ErrNotEnoughShares),
+		errors.Is(err, svc.ErrInvalidDevice), errors.Is(err, svc.ErrInvalidAPIKey):
+		// Handle semantically invalid share, organization, send, emergency access, item, folder, search, attachment,
+		// import, export, password change, recovery, device, API key and quota requests.
+		response.BadRequest(rw, err.Error())
+	case errors.Is(err, svc.ErrInvalidTransition), errors.Is(err, svc.ErrRangeMismatch),
+		errors.Is(err, svc.ErrUploadComplete), errors.Is(err, svc.ErrUploadIncomplete),
+		errors.Is(err, svc.ErrCardExists), errors.Is(err, svc.ErrAccountExists),
+		errors.Is(err, svc.ErrLastOrgOwner):
+		// Handle emergency access actions, upload chunks, imports, registrations and account deletions
+		// that conflict with the current state.
+		response.Conflict(rw, err.Error())
+	case errors.Is(err, svc.ErrQuotaExceeded):
+		// Handle writes that do not fit into the user's quotas.
+		response.TooLarge(rw, err.Error())
 	default:
 		// Default case for unrecognized errors.
 		response.Internal(rw, err.Error())
@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/account"
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAccountData(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+	mockAccountSvc := MockService.NewAccountSvcMock(mc)
+
+	createdAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	exportedAt := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		contextIssuer  string
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Successful export",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAccountSvc.GetPersonalDataMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(account.PersonalData{
+					Username:    "test_user",
+					AccountType: "authorized user",
+					CreatedAt:   createdAt,
+					UpdatedAt:   createdAt,
+					ExportedAt:  exportedAt,
+					Tags:        []profile.Tag{{Name: "travel", Cards: 1}},
+				}, nil)
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"username":           "test_user",
+					"account_type":       "authorized user",
+					"created_at":         "2025-01-01T00:00:00Z",
+					"updated_at":         "2025-01-01T00:00:00Z",
+					"exported_at":        "2025-03-01T00:00:00Z",
+					"folders":            nil,
+					"tags":               []map[string]interface{}{{"name": "travel", "cards": 1}},
+					"items":              nil,
+					"shares":             nil,
+					"orgs":               nil,
+					"emergency_contacts": nil,
+					"sends":              nil,
+					"attachments":        nil,
+					"sessions":           nil,
+					"devices":            nil,
+					"api_keys":           nil,
+					"events":             nil,
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Not enough rights",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountUnauthorizedUser,
+				}, nil)
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "not enough rights",
+			},
+		},
+		{
+			name: "Internal error",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAccountSvc.GetPersonalDataMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(account.PersonalData{}, errors.New("database error"))
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "database error",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc:    mockAuthSvc,
+				AccountSvc: mockAccountSvc,
+			}
+
+			req := httptest.NewRequest("GET", "/api/v1/account/data", nil)
+			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, tt.contextIssuer)
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+
+			h.GetAccountData(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/quota"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAccountUsage(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+	mockAccountSvc := MockService.NewAccountSvcMock(mc)
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		contextIssuer  string
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Successful report",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAccountSvc.GetUsageMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(quota.Report{
+					Username: "test_user",
+					Plan:     quota.PlanUser,
+					Usage:    quota.Usage{Items: 12, Bytes: 2048, APIKeys: 1, Devices: 2},
+					Limits:   quota.Limits{Items: 100, Bytes: 1 << 20, AttachmentSize: 1 << 10, APIKeys: 5, Devices: 5},
+				}, nil)
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"username": "test_user",
+					"plan":     "user",
+					"usage": map[string]interface{}{
+						"items":    12,
+						"bytes":    2048,
+						"api_keys": 1,
+						"devices":  2,
+					},
+					"limits": map[string]interface{}{
+						"items":           100,
+						"bytes":           1 << 20,
+						"attachment_size": 1 << 10,
+						"api_keys":        5,
+						"devices":         5,
+					},
+					"override": map[string]interface{}{},
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Not enough rights",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountUnauthorizedUser,
+				}, nil)
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "not enough rights",
+			},
+		},
+		{
+			name: "Internal error",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAccountSvc.GetUsageMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(quota.Report{}, errors.New("database error"))
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "database error",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc:    mockAuthSvc,
+				AccountSvc: mockAccountSvc,
+			}
+
+			req := httptest.NewRequest("GET", "/api/v1/account/usage", nil)
+			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, tt.contextIssuer)
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+
+			h.GetAccountUsage(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
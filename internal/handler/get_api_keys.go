@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/apikey"
+)
+
+// GetAPIKeys handles the retrieval of the user's personal API keys, including when each was last used,
+// newest first unless another order is requested, a page at a time.
+func (i *Implementation) GetAPIKeys(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Retrieve the issuer (user ID or token subject) from the request context.
+	issuer, err := middleware.GetIssuer(ctx)
+	if err != nil {
+		handleErrResponse(rw, middleware.ErrTokenInvalid)
+		return
+	}
+
+	// Retrieve the user's account details from the authentication service.
+	var acc models.Account
+	acc, err = i.AuthSvc.GetAccountByUserName(ctx, issuer)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Ensure the user has sufficient rights to perform this action.
+	if acc.AccountType != models.AccountAuthorizedUser {
+		handleErrResponse(rw, middleware.ErrNotEnoughRights)
+		return
+	}
+
+	// Extract the requested page from the query parameters.
+	page, err := parseUUIDPage(r, []string{apikey.SortCreated}, apikey.DefaultSort)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Retrieve the keys from the authentication service.
+	keys, next, err := i.AuthSvc.GetAPIKeys(ctx, acc.Username, page)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Send the response with the repacked key data and the cursor of the next page.
+	now := time.Now()
+	resp := models.GetAPIKeysResp{
+		Username:   acc.Username,
+		Keys:       make([]models.APIKeyResp, 0, len(keys)),
+		NextCursor: next,
+	}
+	for _, k := range keys {
+		resp.Keys = append(resp.Keys, repackAPIKey(k, now))
+	}
+	response.OK(rw, resp)
+}
+
+// repackAPIKey converts an API key to the API response structure (APIKeyResp), telling whether it expired by now.
+func repackAPIKey(k apikey.Key, now time.Time) models.APIKeyResp {
+	return models.APIKeyResp{
+		ID:   k.ID,
+		Name: k.Name,
+		Hint: k.Hint(),
+		Scope: models.APIKeyScope{
+			Items:    k.Scope.Items,
+			Folders:  k.Scope.Folders,
+			ReadOnly: k.Scope.ReadOnly,
+		},
+		ExpiresAt:  k.ExpiresAt,
+		CreatedAt:  k.CreatedAt,
+		LastUsedAt: k.LastUsedAt,
+		Expired:    k.Expired(now),
+	}
+}
@@ -0,0 +1,232 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/apikey"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAPIKeys(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+
+	createdAt := time.Date(2025, 3, 25, 12, 0, 0, 0, time.UTC)
+	lastUsedAt := time.Date(2025, 3, 26, 8, 0, 0, 0, time.UTC)
+	expiredAt := time.Date(2025, 4, 25, 12, 0, 0, 0, time.UTC)
+	expiresAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		query          string
+		contextIssuer  string
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Successful retrieval",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAuthSvc.GetAPIKeysMock.Expect(
+					minimock.AnyContext, "test_user", pagination.Request{Limit: pagination.DefaultLimit, Sort: apikey.SortCreated, Desc: true},
+				).Return([]apikey.Key{
+					{
+						ID:         "0a1b2c3d-0000-0000-0000-000000000001",
+						Username:   "test_user",
+						Name:       "deploy",
+						Scope:      apikey.Scope{Items: []string{"1234567812345678"}},
+						ExpiresAt:  expiresAt,
+						CreatedAt:  createdAt,
+						LastUsedAt: &lastUsedAt,
+					},
+					{
+						ID:        "ffeeddcc-0000-0000-0000-000000000002",
+						Username:  "test_user",
+						Name:      "old",
+						Scope:     apikey.Scope{ReadOnly: true},
+						ExpiresAt: expiredAt,
+						CreatedAt: createdAt,
+					},
+				}, "", nil)
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"username": "test_user",
+					"keys": []map[string]interface{}{
+						{
+							"id":   "0a1b2c3d-0000-0000-0000-000000000001",
+							"name": "deploy",
+							"hint": "gk_0a1b2c3d",
+							"scope": map[string]interface{}{
+								"items":     []string{"1234567812345678"},
+								"read_only": false,
+							},
+							"expires_at":   expiresAt,
+							"created_at":   createdAt,
+							"last_used_at": lastUsedAt,
+							"expired":      false,
+						},
+						{
+							"id":   "ffeeddcc-0000-0000-0000-000000000002",
+							"name": "old",
+							"hint": "gk_ffeeddcc",
+							"scope": map[string]interface{}{
+								"read_only": true,
+							},
+							"expires_at": expiredAt,
+							"created_at": createdAt,
+							"expired":    true,
+						},
+					},
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Paginated retrieval",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAuthSvc.GetAPIKeysMock.Expect(
+					minimock.AnyContext, "test_user", pagination.Request{Limit: 1, Sort: apikey.SortCreated},
+				).Return([]apikey.Key{
+					{
+						ID:        "ffeeddcc-0000-0000-0000-000000000002",
+						Username:  "test_user",
+						Name:      "old",
+						Scope:     apikey.Scope{ReadOnly: true},
+						ExpiresAt: expiredAt,
+						CreatedAt: createdAt,
+					},
+				}, "next-page", nil)
+			},
+			query:          "?limit=1&sort=created",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"username": "test_user",
+					"keys": []map[string]interface{}{
+						{
+							"id":   "ffeeddcc-0000-0000-0000-000000000002",
+							"name": "old",
+							"hint": "gk_ffeeddcc",
+							"scope": map[string]interface{}{
+								"read_only": true,
+							},
+							"expires_at": expiredAt,
+							"created_at": createdAt,
+							"expired":    true,
+						},
+					},
+					"next_cursor": "next-page",
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Limit above the maximum",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+			},
+			query:          "?limit=1000",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "invalid query parameter",
+			},
+		},
+		{
+			name: "Service error",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAuthSvc.GetAPIKeysMock.Expect(
+					minimock.AnyContext, "test_user", pagination.Request{Limit: pagination.DefaultLimit, Sort: apikey.SortCreated, Desc: true},
+				).Return(nil, "", errors.New("database error"))
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "database error",
+			},
+		},
+		{
+			name: "Not enough rights",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountUnauthorizedUser,
+				}, nil)
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "not enough rights",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc: mockAuthSvc,
+			}
+
+			req := httptest.NewRequest("GET", "/api/v1/api-keys"+tt.query, nil)
+			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, tt.contextIssuer)
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+
+			h.GetAPIKeys(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
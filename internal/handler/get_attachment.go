@@ -0,0 +1,60 @@
+package handler
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/tools/logger"
+	"github.com/gorilla/mux"
+)
+
+// GetAttachment handles downloading the content of a fully uploaded attachment.
+// The content is streamed as is; its checksum is returned in the X-Content-SHA256 header.
+func (i *Implementation) GetAttachment(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Retrieve the issuer (user ID or token subject) from the request context.
+	issuer, err := middleware.GetIssuer(ctx)
+	if err != nil {
+		handleErrResponse(rw, middleware.ErrTokenInvalid)
+		return
+	}
+
+	// Retrieve the user's account details from the authentication service.
+	var acc models.Account
+	acc, err = i.AuthSvc.GetAccountByUserName(ctx, issuer)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Ensure the user has sufficient rights to perform this action.
+	if acc.AccountType != models.AccountAuthorizedUser {
+		handleErrResponse(rw, middleware.ErrNotEnoughRights)
+		return
+	}
+
+	// Open the attachment content using the attachment service.
+	a, rc, err := i.AttachmentSvc.OpenAttachment(ctx, acc.Username, mux.Vars(r)[AttachmentIDPathVar])
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+	defer rc.Close()
+
+	// Describe the file before streaming it.
+	rw.Header().Set("Content-Type", a.ContentType)
+	rw.Header().Set("Content-Length", strconv.FormatInt(a.Size, 10))
+	rw.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", a.Name))
+	rw.Header().Set(HeaderContentSHA256, a.SHA256)
+	rw.WriteHeader(http.StatusOK)
+
+	// Stream the content; once the headers are sent, failures can only be logged.
+	if _, err = io.Copy(rw, io.LimitReader(rc, a.Size)); err != nil {
+		logger.Ctx(r.Context()).Errorf("failed to stream attachment %s: %v", a.ID, err)
+	}
+}
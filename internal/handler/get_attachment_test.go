@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/attachment"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAttachment(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+	mockAttachmentSvc := MockService.NewAttachmentSvcMock(mc)
+
+	const (
+		id  = "8d3c1c2e-4f3a-4b8a-9a0c-2f5d2c1e7b6a"
+		sum = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	)
+
+	tests := []struct {
+		name            string
+		setupMocks      func()
+		contextIssuer   string
+		expectedStatus  int
+		expectedHeaders map[string]string
+		expectedBody    string
+	}{
+		{
+			name: "Successful download",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAttachmentSvc.OpenAttachmentMock.Expect(
+					minimock.AnyContext, "test_user", id,
+				).Return(attachment.Attachment{
+					ID:          id,
+					Name:        "key.enc",
+					ContentType: "application/octet-stream",
+					Size:        4,
+					SHA256:      sum,
+					Complete:    true,
+				}, io.NopCloser(strings.NewReader("test")), nil)
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedHeaders: map[string]string{
+				"Content-Type":        "application/octet-stream",
+				"Content-Length":      "4",
+				"Content-Disposition": `attachment; filename="key.enc"`,
+				HeaderContentSHA256:   sum,
+			},
+			expectedBody: "test",
+		},
+		{
+			name: "Upload not complete",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAttachmentSvc.OpenAttachmentMock.Expect(
+					minimock.AnyContext, "test_user", id,
+				).Return(attachment.Attachment{}, nil, svc.ErrUploadIncomplete)
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusConflict,
+			expectedBody:   `{"success":false,"message":"upload is not complete"}`,
+		},
+		{
+			name: "Attachment not found",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAttachmentSvc.OpenAttachmentMock.Expect(
+					minimock.AnyContext, "test_user", id,
+				).Return(attachment.Attachment{}, nil, svc.ErrAttachmentNotFound)
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   `{"success":false,"message":"attachment not found"}`,
+		},
+		{
+			name: "Not enough rights",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountUnauthorizedUser,
+				}, nil)
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   `{"success":false,"message":"not enough rights"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc:       mockAuthSvc,
+				AttachmentSvc: mockAttachmentSvc,
+			}
+
+			req := httptest.NewRequest("GET", "/api/v1/attachments/"+id, nil)
+			req = mux.SetURLVars(req, map[string]string{AttachmentIDPathVar: id})
+			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, tt.contextIssuer)
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+
+			h.GetAttachment(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			for k, v := range tt.expectedHeaders {
+				assert.Equal(t, v, rec.Header().Get(k))
+			}
+
+			if tt.expectedHeaders != nil {
+				assert.Equal(t, tt.expectedBody, rec.Body.String())
+			} else {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/attachment"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
+	"github.com/google/uuid"
+)
+
+// GetAttachments handles listing the attachments of one of the user's cards, oldest first, a page at a time.
+// Unfinished uploads are included, so a client can resume them at their received offset.
+func (i *Implementation) GetAttachments(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Retrieve the issuer (user ID or token subject) from the request context.
+	issuer, err := middleware.GetIssuer(ctx)
+	if err != nil {
+		handleErrResponse(rw, middleware.ErrTokenInvalid)
+		return
+	}
+
+	// Retrieve the user's account details from the authentication service.
+	var acc models.Account
+	acc, err = i.AuthSvc.GetAccountByUserName(ctx, issuer)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Ensure the user has sufficient rights to perform this action.
+	if acc.AccountType != models.AccountAuthorizedUser {
+		handleErrResponse(rw, middleware.ErrNotEnoughRights)
+		return
+	}
+
+	// Extract the card number and the requested page from the query.
+	cardNumber := r.URL.Query().Get(CardNumberParam)
+	page, err := parseUUIDPage(r, []string{attachment.SortCreated}, attachment.SortCreated)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Retrieve the attachments from the attachment service.
+	attachments, next, err := i.AttachmentSvc.GetAttachments(ctx, acc.Username, cardNumber, page)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Send the response with the repacked attachment data and the cursor of the next page.
+	resp := repackGetAttachments(acc.Username, cardNumber, attachments)
+	resp.NextCursor = next
+	response.OK(rw, resp)
+}
+
+// parseUUIDPage reads the requested page of a listing of items identified by a UUID from the query
+// parameters of the request, rejecting cursors whose tie-breaking UUID is malformed.
+func parseUUIDPage(r *http.Request, sorts []string, defaultSort string) (pagination.Request, error) {
+	page, err := pagination.Parse(r.URL.Query(), sorts, defaultSort)
+	if err != nil {
+		return pagination.Request{}, errInvalidQuery
+	}
+	if page.After != nil {
+		if _, err = uuid.Parse(page.After.UID); err != nil {
+			return pagination.Request{}, errInvalidQuery
+		}
+	}
+	return page, nil
+}
+
+// repackGetAttachments converts a slice of attachments to the API response structure (GetAttachmentsResp).
+func repackGetAttachments(username, cardNumber string, attachments []attachment.Attachment) models.GetAttachmentsResp {
+	resp := make([]models.AttachmentResp, 0, len(attachments))
+	for _, a := range attachments {
+		resp = append(resp, repackAttachment(a))
+	}
+	return models.GetAttachmentsResp{Username: username, CardNumber: cardNumber, Attachments: resp}
+}
+
+// repackAttachment converts an attachment to the API response structure (AttachmentResp).
+func repackAttachment(a attachment.Attachment) models.AttachmentResp {
+	return models.AttachmentResp{
+		ID:          a.ID,
+		Name:        a.Name,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		Received:    a.Received,
+		SHA256:      a.SHA256,
+		Complete:    a.Complete,
+		CreatedAt:   a.CreatedAt,
+	}
+}
@@ -0,0 +1,224 @@
+package handler
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/attachment"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetAttachments(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+	mockAttachmentSvc := MockService.NewAttachmentSvcMock(mc)
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		query          string
+		contextIssuer  string
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Successful retrieval",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAttachmentSvc.GetAttachmentsMock.Expect(
+					minimock.AnyContext, "test_user", "1234567812345678", pagination.Request{Limit: pagination.DefaultLimit, Sort: attachment.SortCreated},
+				).Return([]attachment.Attachment{
+					{
+						ID:          "8d3c1c2e-4f3a-4b8a-9a0c-2f5d2c1e7b6a",
+						Username:    "test_user",
+						CardNumber:  "1234567812345678",
+						Name:        "passport.pdf.enc",
+						ContentType: "application/octet-stream",
+						Size:        1024,
+						Received:    512,
+						SHA256:      "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+						CreatedAt:   time.Date(2025, 2, 25, 12, 0, 0, 0, time.UTC),
+					},
+				}, "", nil)
+			},
+			query:          "?card_number=1234567812345678",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"username":    "test_user",
+					"card_number": "1234567812345678",
+					"attachments": []interface{}{
+						map[string]interface{}{
+							"id":           "8d3c1c2e-4f3a-4b8a-9a0c-2f5d2c1e7b6a",
+							"name":         "passport.pdf.enc",
+							"content_type": "application/octet-stream",
+							"size":         1024,
+							"received":     512,
+							"sha256":       "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+							"complete":     false,
+							"created_at":   "2025-02-25T12:00:00Z",
+						},
+					},
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "No attachments",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAttachmentSvc.GetAttachmentsMock.Expect(
+					minimock.AnyContext, "test_user", "8765432187654321", pagination.Request{Limit: pagination.DefaultLimit, Sort: attachment.SortCreated},
+				).Return(nil, "", nil)
+			},
+			query:          "?card_number=8765432187654321",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"username":    "test_user",
+					"card_number": "8765432187654321",
+					"attachments": []interface{}{},
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Paginated retrieval",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAttachmentSvc.GetAttachmentsMock.Expect(
+					minimock.AnyContext, "test_user", "1234567812345678",
+					pagination.Request{Limit: 1, Sort: attachment.SortCreated, Desc: true},
+				).Return([]attachment.Attachment{
+					{
+						ID:          "8d3c1c2e-4f3a-4b8a-9a0c-2f5d2c1e7b6a",
+						Username:    "test_user",
+						CardNumber:  "1234567812345678",
+						Name:        "passport.pdf.enc",
+						ContentType: "application/octet-stream",
+						Size:        1024,
+						Received:    1024,
+						SHA256:      "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+						Complete:    true,
+						CreatedAt:   time.Date(2025, 2, 25, 12, 0, 0, 0, time.UTC),
+					},
+				}, "next-page", nil)
+			},
+			query:          "?card_number=1234567812345678&limit=1&sort=-created",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"username":    "test_user",
+					"card_number": "1234567812345678",
+					"attachments": []interface{}{
+						map[string]interface{}{
+							"id":           "8d3c1c2e-4f3a-4b8a-9a0c-2f5d2c1e7b6a",
+							"name":         "passport.pdf.enc",
+							"content_type": "application/octet-stream",
+							"size":         1024,
+							"received":     1024,
+							"sha256":       "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+							"complete":     true,
+							"created_at":   "2025-02-25T12:00:00Z",
+						},
+					},
+					"next_cursor": "next-page",
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Cursor without a UUID",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+			},
+			query: "?card_number=1234567812345678&cursor=" +
+				base64.RawURLEncoding.EncodeToString([]byte(`{"s":"created","k":"2025-02-25T12:00:00.000000Z","u":"1"}`)),
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "invalid query parameter",
+			},
+		},
+		{
+			name: "Not enough rights",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountUnauthorizedUser,
+				}, nil)
+			},
+			query:          "?card_number=1234567812345678",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "not enough rights",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc:       mockAuthSvc,
+				AttachmentSvc: mockAttachmentSvc,
+			}
+
+			req := httptest.NewRequest("GET", "/api/v1/attachments"+tt.query, nil)
+			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, tt.contextIssuer)
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+
+			h.GetAttachments(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/device"
+)
+
+// GetDevices handles the retrieval of the devices the user signed in from, most recently seen first
+// unless another sort is requested, a page at a time.
+func (i *Implementation) GetDevices(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Retrieve the issuer (user ID or token subject) from the request context.
+	issuer, err := middleware.GetIssuer(ctx)
+	if err != nil {
+		handleErrResponse(rw, middleware.ErrTokenInvalid)
+		return
+	}
+
+	// Retrieve the user's account details from the authentication service.
+	var acc models.Account
+	acc, err = i.AuthSvc.GetAccountByUserName(ctx, issuer)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Ensure the user has sufficient rights to perform this action.
+	if acc.AccountType != models.AccountAuthorizedUser {
+		handleErrResponse(rw, middleware.ErrNotEnoughRights)
+		return
+	}
+
+	// Extract the requested page from the query parameters.
+	page, err := parseUUIDPage(r, device.Sorts, device.DefaultSort)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Retrieve the devices from the authentication service, marking the one of the current session.
+	devices, next, err := i.AuthSvc.GetDevices(ctx, acc.Username, middleware.GetSessionID(ctx), page)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Send the response with the repacked device data and the cursor of the next page.
+	resp := repackGetDevices(acc.Username, devices)
+	resp.NextCursor = next
+	response.OK(rw, resp)
+}
+
+// repackGetDevices converts a slice of devices to the API response structure (GetDevicesResp).
+func repackGetDevices(username string, devices []device.Device) models.GetDevicesResp {
+	resp := make([]models.DeviceResp, 0, len(devices))
+	for _, d := range devices {
+		resp = append(resp, models.DeviceResp{
+			ID:         d.ID,
+			Name:       d.Name,
+			Platform:   d.Platform,
+			PublicKey:  d.PublicKey,
+			CreatedAt:  d.CreatedAt,
+			LastSeenAt: d.LastSeenAt,
+			Current:    d.Current,
+		})
+	}
+	return models.GetDevicesResp{Username: username, Devices: resp}
+}
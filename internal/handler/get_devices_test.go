@@ -0,0 +1,232 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/device"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDevices(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+
+	createdAt := time.Date(2025, 3, 20, 12, 0, 0, 0, time.UTC)
+	lastSeenAt := time.Date(2025, 3, 21, 8, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		query          string
+		contextIssuer  string
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Successful retrieval",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAuthSvc.GetDevicesMock.Expect(
+					minimock.AnyContext, "test_user", "", pagination.Request{Limit: pagination.DefaultLimit, Sort: device.SortLastSeen, Desc: true},
+				).Return([]device.Device{
+					{
+						ID:         "device-1",
+						Username:   "test_user",
+						Name:       "laptop",
+						Platform:   "linux",
+						PublicKey:  []byte("public_key"),
+						CreatedAt:  createdAt,
+						LastSeenAt: lastSeenAt,
+						Current:    true,
+					},
+				}, "", nil)
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"username": "test_user",
+					"devices": []map[string]interface{}{
+						{
+							"id":           "device-1",
+							"name":         "laptop",
+							"platform":     "linux",
+							"public_key":   []byte("public_key"),
+							"created_at":   createdAt,
+							"last_seen_at": lastSeenAt,
+							"current":      true,
+						},
+					},
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "No devices",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAuthSvc.GetDevicesMock.Expect(
+					minimock.AnyContext, "test_user", "", pagination.Request{Limit: pagination.DefaultLimit, Sort: device.SortLastSeen, Desc: true},
+				).Return(nil, "", nil)
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"username": "test_user",
+					"devices":  []interface{}{},
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Paginated retrieval",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAuthSvc.GetDevicesMock.Expect(
+					minimock.AnyContext, "test_user", "",
+					pagination.Request{Limit: 1, Sort: device.SortCreated},
+				).Return([]device.Device{
+					{
+						ID:         "device-1",
+						Username:   "test_user",
+						Name:       "laptop",
+						Platform:   "linux",
+						PublicKey:  []byte("public_key"),
+						CreatedAt:  createdAt,
+						LastSeenAt: lastSeenAt,
+					},
+				}, "next-page", nil)
+			},
+			query:          "?limit=1&sort=created",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"username": "test_user",
+					"devices": []map[string]interface{}{
+						{
+							"id":           "device-1",
+							"name":         "laptop",
+							"platform":     "linux",
+							"public_key":   []byte("public_key"),
+							"created_at":   createdAt,
+							"last_seen_at": lastSeenAt,
+							"current":      false,
+						},
+					},
+					"next_cursor": "next-page",
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Invalid sort",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+			},
+			query:          "?sort=name",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "invalid query parameter",
+			},
+		},
+		{
+			name: "Service error",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAuthSvc.GetDevicesMock.Expect(
+					minimock.AnyContext, "test_user", "", pagination.Request{Limit: pagination.DefaultLimit, Sort: device.SortLastSeen, Desc: true},
+				).Return(nil, "", errors.New("database error"))
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "database error",
+			},
+		},
+		{
+			name: "Not enough rights",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountUnauthorizedUser,
+				}, nil)
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "not enough rights",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc: mockAuthSvc,
+			}
+
+			req := httptest.NewRequest("GET", "/api/v1/devices"+tt.query, nil)
+			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, tt.contextIssuer)
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+
+			h.GetDevices(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/models"
+)
+
+// GetEmergencyCards handles a trusted contact reading the cards of the grantor given in the username query parameter,
+// once emergency access was approved.
+func (i *Implementation) GetEmergencyCards(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Retrieve the issuer (user ID or token subject) from the request context.
+	issuer, err := middleware.GetIssuer(ctx)
+	if err != nil {
+		handleErrResponse(rw, middleware.ErrTokenInvalid)
+		return
+	}
+
+	// Retrieve the user's account details from the authentication service.
+	var acc models.Account
+	acc, err = i.AuthSvc.GetAccountByUserName(ctx, issuer)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Ensure the user has sufficient rights to perform this action.
+	if acc.AccountType != models.AccountAuthorizedUser {
+		handleErrResponse(rw, middleware.ErrNotEnoughRights)
+		return
+	}
+
+	// Extract the grantor's username from the query parameters.
+	grantor := r.URL.Query().Get(UsernameParam)
+	if grantor == "" {
+		handleErrResponse(rw, errInvalidRequestBody)
+		return
+	}
+
+	// Extract the requested page from the query parameters.
+	page, err := parseCardPage(r)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Retrieve the grantor's cards from the emergency access service.
+	cards, next, err := i.EmergencySvc.GetGrantorCards(ctx, acc.Username, grantor, page)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Send the response with the repacked card data and the cursor of the next page.
+	resp := repackGetCards(acc.Username, cards)
+	resp.NextCursor = next
+	response.OK(rw, resp)
+}
@@ -0,0 +1,145 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEmergencyCards(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+	mockEmergencySvc := MockService.NewEmergencySvcMock(mc)
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		query          string
+		contextIssuer  string
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Successful retrieval",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockEmergencySvc.GetGrantorCardsMock.Expect(
+					minimock.AnyContext, "test_user", "grantor", pagination.Request{Limit: pagination.DefaultLimit, Sort: profile.SortCreated},
+				).Return([]profile.CardInfo{
+					{
+						CardNumber:     "1234567812345678",
+						CardHolder:     "Grantor",
+						ExpirationDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+						Cvv:            "123",
+						Metadata:       "Emergency card",
+						Owner:          "grantor",
+						Permission:     profile.PermissionRead,
+					},
+				}, "", nil)
+			},
+			query:          "?username=grantor",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"username": "test_user",
+					"cards": []interface{}{
+						map[string]interface{}{
+							"card_holder":     "Grantor",
+							"card_number":     "1234567812345678",
+							"cvv":             "123",
+							"expiration_date": "2025-01-01T00:00:00Z",
+							"metadata":        "Emergency card",
+							"shared":          true,
+							"owner":           "grantor",
+							"permission":      "read",
+						},
+					},
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Missing grantor",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+			},
+			query:          "",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "invalid request body",
+			},
+		},
+		{
+			name: "Access not approved",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockEmergencySvc.GetGrantorCardsMock.Expect(
+					minimock.AnyContext, "test_user", "pending", pagination.Request{Limit: pagination.DefaultLimit, Sort: profile.SortCreated},
+				).Return(nil, "", svc.ErrNotAuthorized)
+			},
+			query:          "?username=pending",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": svc.ErrNotAuthorized.Error(),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc:      mockAuthSvc,
+				EmergencySvc: mockEmergencySvc,
+			}
+
+			req := httptest.NewRequest("GET", "/api/v1/emergency/cards"+tt.query, nil)
+			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, tt.contextIssuer)
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+
+			h.GetEmergencyCards(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/emergency"
+)
+
+// GetEmergencyContacts handles listing the trusted contacts the user designated and those that designated the user.
+func (i *Implementation) GetEmergencyContacts(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Retrieve the issuer (user ID or token subject) from the request context.
+	issuer, err := middleware.GetIssuer(ctx)
+	if err != nil {
+		handleErrResponse(rw, middleware.ErrTokenInvalid)
+		return
+	}
+
+	// Retrieve the user's account details from the authentication service.
+	var acc models.Account
+	acc, err = i.AuthSvc.GetAccountByUserName(ctx, issuer)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Ensure the user has sufficient rights to perform this action.
+	if acc.AccountType != models.AccountAuthorizedUser {
+		handleErrResponse(rw, middleware.ErrNotEnoughRights)
+		return
+	}
+
+	// Retrieve the contacts from the emergency access service.
+	contacts, err := i.EmergencySvc.GetContacts(ctx, acc.Username)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Send the response with the repacked contact data.
+	response.OK(rw, repackGetEmergencyContacts(acc.Username, contacts))
+}
+
+// repackGetEmergencyContacts converts a slice of contacts to the API response structure (GetEmergencyContactsResp).
+func repackGetEmergencyContacts(username string, contacts []emergency.Contact) models.GetEmergencyContactsResp {
+	resp := make([]models.EmergencyContactResp, 0, len(contacts))
+	for _, c := range contacts {
+		resp = append(resp, models.EmergencyContactResp{
+			Grantor:       c.Grantor,
+			Grantee:       c.Grantee,
+			WaitDays:      c.WaitDays,
+			Status:        c.Status,
+			RequestedAt:   c.RequestedAt,
+			AutoApproveAt: c.AutoApproveAt(),
+		})
+	}
+	return models.GetEmergencyContactsResp{Username: username, Contacts: resp}
+}
@@ -0,0 +1,129 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/emergency"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetEmergencyContacts(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+	mockEmergencySvc := MockService.NewEmergencySvcMock(mc)
+
+	requestedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		contextIssuer  string
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Successful retrieval",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockEmergencySvc.GetContactsMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return([]emergency.Contact{
+					{
+						Grantor:  "test_user",
+						Grantee:  "trusted",
+						WaitDays: 7,
+						Status:   emergency.StatusIdle,
+					},
+					{
+						Grantor:     "grantor",
+						Grantee:     "test_user",
+						WaitDays:    3,
+						Status:      emergency.StatusRequested,
+						RequestedAt: &requestedAt,
+					},
+				}, nil)
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"username": "test_user",
+					"contacts": []interface{}{
+						map[string]interface{}{
+							"grantor":   "test_user",
+							"grantee":   "trusted",
+							"wait_days": 7,
+							"status":    "idle",
+						},
+						map[string]interface{}{
+							"grantor":         "grantor",
+							"grantee":         "test_user",
+							"wait_days":       3,
+							"status":          "requested",
+							"requested_at":    "2025-01-01T00:00:00Z",
+							"auto_approve_at": "2025-01-04T00:00:00Z",
+						},
+					},
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Not enough rights",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountUnauthorizedUser,
+				}, nil)
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "not enough rights",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc:      mockAuthSvc,
+				EmergencySvc: mockEmergencySvc,
+			}
+
+			req := httptest.NewRequest("GET", "/api/v1/emergency/contacts", nil)
+			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, tt.contextIssuer)
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+
+			h.GetEmergencyContacts(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package handler
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/transfer"
+	"github.com/gleb-korostelev/GophKeeper/tools/logger"
+)
+
+// Parameters of the export endpoint.
+const (
+	HeaderExportPassword  = "X-Export-Password" // Header carrying the password of an archive.
+	ConfirmPlaintextParam = "confirm_plaintext" // Confirms that a plaintext export may be written.
+)
+
+// GetExport handles exporting the user's vault as a file download. By default the vault is sealed into
+// an archive encrypted with the password from the X-Export-Password header; plaintext json and csv
+// exports must be confirmed with confirm_plaintext.
+func (i *Implementation) GetExport(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Retrieve the issuer (user ID or token subject) from the request context.
+	issuer, err := middleware.GetIssuer(ctx)
+	if err != nil {
+		handleErrResponse(rw, middleware.ErrTokenInvalid)
+		return
+	}
+
+	// Retrieve the user's account details from the authentication service.
+	var acc models.Account
+	acc, err = i.AuthSvc.GetAccountByUserName(ctx, issuer)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Ensure the user has sufficient rights to perform this action.
+	if acc.AccountType != models.AccountAuthorizedUser {
+		handleErrResponse(rw, middleware.ErrNotEnoughRights)
+		return
+	}
+
+	// Parse the plaintext confirmation.
+	query := r.URL.Query()
+	opts := transfer.ExportOptions{
+		Format:   query.Get(FormatParam),
+		Password: r.Header.Get(HeaderExportPassword),
+	}
+	if v := query.Get(ConfirmPlaintextParam); v != "" {
+		if opts.ConfirmPlaintext, err = strconv.ParseBool(v); err != nil {
+			handleErrResponse(rw, errInvalidQuery)
+			return
+		}
+	}
+
+	// Export the vault using the transfer service.
+	export, err := i.TransferSvc.Export(ctx, acc.Username, opts)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Describe the file before sending it.
+	rw.Header().Set("Content-Type", export.ContentType)
+	rw.Header().Set("Content-Length", strconv.Itoa(len(export.Data)))
+	rw.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", exportFileName(acc.Username, export)))
+	rw.Header().Set("Cache-Control", "no-store")
+	rw.WriteHeader(http.StatusOK)
+
+	// Once the headers are sent, failures can only be logged.
+	if _, err = rw.Write(export.Data); err != nil {
+		logger.Ctx(r.Context()).Errorf("failed to send export of %s: %v", acc.Username, err)
+	}
+}
+
+// exportFileName returns the suggested file name of an export.
+func exportFileName(username string, export transfer.Export) string {
+	ext := "json"
+	if export.Format == transfer.FormatCSV {
+		ext = "csv"
+	}
+	return fmt.Sprintf("gophkeeper-%s-%s-%s.%s", username, export.Format, time.Now().UTC().Format("20060102"), ext)
+}
@@ -0,0 +1,192 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/transfer"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetExport(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+	mockTransferSvc := MockService.NewTransferSvcMock(mc)
+
+	const archive = `{"format":"gophkeeper-export","version":1}`
+	today := time.Now().UTC().Format("20060102")
+
+	tests := []struct {
+		name            string
+		setupMocks      func()
+		query           string
+		password        string
+		contextIssuer   string
+		expectedStatus  int
+		expectedHeaders map[string]string
+		expectedBody    string
+	}{
+		{
+			name: "Encrypted archive",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockTransferSvc.ExportMock.Expect(
+					minimock.AnyContext, "test_user", transfer.ExportOptions{Password: "correct horse"},
+				).Return(transfer.Export{
+					Format:      transfer.FormatArchive,
+					ContentType: "application/json",
+					Data:        []byte(archive),
+				}, nil)
+			},
+			password:       "correct horse",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedHeaders: map[string]string{
+				"Content-Type":        "application/json",
+				"Content-Length":      fmt.Sprint(len(archive)),
+				"Content-Disposition": fmt.Sprintf(`attachment; filename="gophkeeper-test_user-gophkeeper-%s.json"`, today),
+				"Cache-Control":       "no-store",
+			},
+			expectedBody: archive,
+		},
+		{
+			name: "Confirmed plaintext csv",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockTransferSvc.ExportMock.Expect(
+					minimock.AnyContext, "test_user", transfer.ExportOptions{Format: "csv", ConfirmPlaintext: true},
+				).Return(transfer.Export{
+					Format:      transfer.FormatCSV,
+					ContentType: "text/csv",
+					Data:        []byte("type,name\n"),
+				}, nil)
+			},
+			query:          "?format=csv&confirm_plaintext=true",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedHeaders: map[string]string{
+				"Content-Type":        "text/csv",
+				"Content-Disposition": fmt.Sprintf(`attachment; filename="gophkeeper-test_user-csv-%s.csv"`, today),
+			},
+			expectedBody: "type,name\n",
+		},
+		{
+			name: "Plaintext not confirmed",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockTransferSvc.ExportMock.Expect(
+					minimock.AnyContext, "test_user", transfer.ExportOptions{Format: "json"},
+				).Return(transfer.Export{}, svc.ErrPlaintextNotConfirmed)
+			},
+			query:          "?format=json",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"success":false,"message":"plaintext export must be confirmed"}`,
+		},
+		{
+			name: "Weak password",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockTransferSvc.ExportMock.Expect(
+					minimock.AnyContext, "test_user", transfer.ExportOptions{Password: "short"},
+				).Return(transfer.Export{}, svc.ErrWeakExportPassword)
+			},
+			password:       "short",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"success":false,"message":"export password must be at least 8 characters"}`,
+		},
+		{
+			name: "Invalid confirmation",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+			},
+			query:          "?format=json&confirm_plaintext=sure",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   `{"success":false,"message":"invalid query parameter"}`,
+		},
+		{
+			name: "Not enough rights",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountUnauthorizedUser,
+				}, nil)
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   `{"success":false,"message":"not enough rights"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc:     mockAuthSvc,
+				TransferSvc: mockTransferSvc,
+			}
+
+			req := httptest.NewRequest("GET", "/api/v1/export"+tt.query, nil)
+			if tt.password != "" {
+				req.Header.Set(HeaderExportPassword, tt.password)
+			}
+			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, tt.contextIssuer)
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+
+			h.GetExport(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			for k, v := range tt.expectedHeaders {
+				assert.Equal(t, v, rec.Header().Get(k))
+			}
+
+			if tt.expectedHeaders != nil {
+				assert.Equal(t, tt.expectedBody, rec.Body.String())
+			} else {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+)
+
+// GetFolders handles the retrieval of the user's folders.
+func (i *Implementation) GetFolders(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Retrieve the issuer (user ID or token subject) from the request context.
+	issuer, err := middleware.GetIssuer(ctx)
+	if err != nil {
+		handleErrResponse(rw, middleware.ErrTokenInvalid)
+		return
+	}
+
+	// Retrieve the user's account details from the authentication service.
+	var acc models.Account
+	acc, err = i.AuthSvc.GetAccountByUserName(ctx, issuer)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Ensure the user has sufficient rights to perform this action.
+	if acc.AccountType != models.AccountAuthorizedUser {
+		handleErrResponse(rw, middleware.ErrNotEnoughRights)
+		return
+	}
+
+	// Retrieve the folders from the profile service.
+	folders, err := i.ProfileSvc.GetFolders(ctx, acc.Username)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Send the response with the repacked folder data.
+	response.OK(rw, repackGetFolders(acc.Username, folders))
+}
+
+// repackGetFolders converts a slice of folders to the API response structure (GetFoldersResp).
+func repackGetFolders(username string, folders []profile.Folder) models.GetFoldersResp {
+	resp := make([]models.FolderResp, 0, len(folders))
+	for _, f := range folders {
+		resp = append(resp, models.FolderResp{ID: f.ID, ParentID: f.ParentID, Name: f.Name})
+	}
+	return models.GetFoldersResp{Username: username, Folders: resp}
+}
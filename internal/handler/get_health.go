@@ -0,0 +1,19 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+)
+
+// GetHealth handles the detailed health report for administrators: the result, error and duration
+// of each check of the readiness probe. It answers 503 Service Unavailable if any check failed.
+func (i *Implementation) GetHealth(rw http.ResponseWriter, r *http.Request) {
+	report := i.HealthSvc.Check(r.Context())
+
+	status := http.StatusOK
+	if !report.Up() {
+		status = http.StatusServiceUnavailable
+	}
+	response.Health(rw, status, report)
+}
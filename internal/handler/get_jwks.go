@@ -0,0 +1,24 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+)
+
+// jwksMaxAge is how long, in seconds, clients may cache the published keys. Keys are published
+// before they sign tokens, so clients refreshing this often always know the signing key.
+const jwksMaxAge = "300"
+
+// GetJWKS handles publishing the public keys verifying access tokens as a JSON Web Key Set,
+// so that other services can verify the tokens themselves. No authentication is required.
+func (i *Implementation) GetJWKS(rw http.ResponseWriter, r *http.Request) {
+	jwks, err := i.AuthSvc.GetJWKS(r.Context())
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	rw.Header().Set("Cache-Control", "public, max-age="+jwksMaxAge)
+	response.JSON(rw, jwks)
+}
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/pkg/keyring"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetJWKS(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Successful retrieval",
+			setupMocks: func() {
+				mockAuthSvc.GetJWKSMock.Expect(minimock.AnyContext).Return(keyring.JWKS{
+					Keys: []keyring.JWK{
+						{Kty: "OKP", Crv: "Ed25519", X: "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo",
+							Kid: "kid-new", Use: "sig", Alg: "EdDSA"},
+						{Kty: "OKP", Crv: "Ed25519", X: "PUAXw-hDiVqStwqnTRt-vJyYLM8uxJaMwM1V8Sr0Zgw",
+							Kid: "kid-old", Use: "sig", Alg: "EdDSA"},
+					},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"keys": []map[string]interface{}{
+					{
+						"kty": "OKP",
+						"crv": "Ed25519",
+						"x":   "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo",
+						"kid": "kid-new",
+						"use": "sig",
+						"alg": "EdDSA",
+					},
+					{
+						"kty": "OKP",
+						"crv": "Ed25519",
+						"x":   "PUAXw-hDiVqStwqnTRt-vJyYLM8uxJaMwM1V8Sr0Zgw",
+						"kid": "kid-old",
+						"use": "sig",
+						"alg": "EdDSA",
+					},
+				},
+			},
+		},
+		{
+			name: "Service error",
+			setupMocks: func() {
+				mockAuthSvc.GetJWKSMock.Expect(minimock.AnyContext).Return(keyring.JWKS{}, errors.New("database error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "database error",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc: mockAuthSvc,
+			}
+
+			req := httptest.NewRequest("GET", "/.well-known/jwks.json", nil)
+			req = req.WithContext(context.Background())
+
+			rec := httptest.NewRecorder()
+
+			h.GetJWKS(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
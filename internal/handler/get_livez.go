@@ -0,0 +1,15 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+	"github.com/gleb-korostelev/GophKeeper/tools/health"
+)
+
+// GetLivez handles the liveness probe. It reports that the process serves requests and checks no dependencies,
+// so that an unavailable database gets the instance taken out of rotation by the readiness probe
+// rather than restarted.
+func (i *Implementation) GetLivez(rw http.ResponseWriter, r *http.Request) {
+	response.Health(rw, http.StatusOK, response.HealthcheckResp{Status: health.StatusUp})
+}
@@ -0,0 +1,24 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetLivez(t *testing.T) {
+	h := &Implementation{}
+
+	req := httptest.NewRequest("GET", "/livez", nil)
+	rec := httptest.NewRecorder()
+
+	h.GetLivez(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+
+	expectedJSON, _ := json.Marshal(map[string]interface{}{"status": "up"})
+	assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+}
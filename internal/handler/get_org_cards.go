@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gorilla/mux"
+)
+
+// GetOrgCards handles the retrieval of the organization cards visible to the user.
+func (i *Implementation) GetOrgCards(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Retrieve the issuer (user ID or token subject) from the request context.
+	issuer, err := middleware.GetIssuer(ctx)
+	if err != nil {
+		handleErrResponse(rw, middleware.ErrTokenInvalid)
+		return
+	}
+
+	// Retrieve the user's account details from the authentication service.
+	var acc models.Account
+	acc, err = i.AuthSvc.GetAccountByUserName(ctx, issuer)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Ensure the user has sufficient rights to perform this action.
+	if acc.AccountType != models.AccountAuthorizedUser {
+		handleErrResponse(rw, middleware.ErrNotEnoughRights)
+		return
+	}
+
+	// Extract the organization name from the route.
+	orgName := mux.Vars(r)[middleware.OrgPathVar]
+
+	// Extract the requested page from the query parameters.
+	page, err := parseCardPage(r)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Retrieve the visible cards from the organization service.
+	cards, next, err := i.OrgSvc.GetOrgCards(ctx, acc.Username, orgName, page)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Send the response with the repacked card data and the cursor of the next page.
+	resp := repackGetCards(acc.Username, cards)
+	resp.NextCursor = next
+	response.OK(rw, resp)
+}
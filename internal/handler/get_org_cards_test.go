@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
+	"github.com/gojuno/minimock/v3"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetOrgCards(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+	mockOrgSvc := MockService.NewOrgSvcMock(mc)
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		contextIssuer  string
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Successful retrieval",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockOrgSvc.GetOrgCardsMock.Expect(
+					minimock.AnyContext, "test_user", "acme", pagination.Request{Limit: pagination.DefaultLimit, Sort: profile.SortCreated},
+				).Return([]profile.CardInfo{
+					{
+						CardNumber:     "1234567812345678",
+						CardHolder:     "Acme Corp",
+						ExpirationDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+						Cvv:            "123",
+						Metadata:       "Corporate card",
+						Owner:          "teammate",
+						Permission:     profile.PermissionRead,
+						Org:            "acme",
+						Collection:     "payments",
+					},
+				}, "", nil)
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"username": "test_user",
+					"cards": []interface{}{
+						map[string]interface{}{
+							"card_holder":     "Acme Corp",
+							"card_number":     "1234567812345678",
+							"cvv":             "123",
+							"expiration_date": "2025-01-01T00:00:00Z",
+							"metadata":        "Corporate card",
+							"shared":          true,
+							"owner":           "teammate",
+							"permission":      "read",
+							"org":             "acme",
+							"collection":      "payments",
+						},
+					},
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Not enough rights",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountUnauthorizedUser,
+				}, nil)
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "not enough rights",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc: mockAuthSvc,
+				OrgSvc:  mockOrgSvc,
+			}
+
+			req := httptest.NewRequest("GET", "/api/v1/orgs/acme/cards", nil)
+			req = mux.SetURLVars(req, map[string]string{middleware.OrgPathVar: "acme"})
+			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, tt.contextIssuer)
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+
+			h.GetOrgCards(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
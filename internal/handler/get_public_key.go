@@ -0,0 +1,52 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/models"
+)
+
+// GetPublicKey handles retrieving the public key of the user given in the username query parameter.
+func (i *Implementation) GetPublicKey(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Retrieve the issuer (user ID or token subject) from the request context.
+	issuer, err := middleware.GetIssuer(ctx)
+	if err != nil {
+		handleErrResponse(rw, middleware.ErrTokenInvalid)
+		return
+	}
+
+	// Retrieve the user's account details from the authentication service.
+	var acc models.Account
+	acc, err = i.AuthSvc.GetAccountByUserName(ctx, issuer)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Ensure the user has sufficient rights to perform this action.
+	if acc.AccountType != models.AccountAuthorizedUser {
+		handleErrResponse(rw, middleware.ErrNotEnoughRights)
+		return
+	}
+
+	// Extract the target username from the query parameters.
+	username := r.URL.Query().Get(UsernameParam)
+	if username == "" {
+		handleErrResponse(rw, errInvalidRequestBody)
+		return
+	}
+
+	// Retrieve the target account from the authentication service.
+	target, err := i.AuthSvc.GetAccountByUserName(ctx, username)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Respond with the target user's public key.
+	response.OK(rw, models.GetPublicKeyResp{Username: target.Username, PublicKey: target.PublicKey})
+}
@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetPublicKey(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		query          string
+		contextIssuer  string
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Successful retrieval",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Set(func(_ context.Context, username string) (models.Account, error) {
+					if username == "teammate" {
+						return models.Account{Username: "teammate", PublicKey: []byte("pub")}, nil
+					}
+					return models.Account{Username: username, AccountType: models.AccountAuthorizedUser}, nil
+				})
+			},
+			query:          "?username=teammate",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"username":   "teammate",
+					"public_key": "cHVi",
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Missing username",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Set(func(_ context.Context, username string) (models.Account, error) {
+					return models.Account{Username: username, AccountType: models.AccountAuthorizedUser}, nil
+				})
+			},
+			query:          "",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "invalid request body",
+			},
+		},
+		{
+			name: "Unknown user",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Set(func(_ context.Context, username string) (models.Account, error) {
+					if username == "stranger" {
+						return models.Account{}, svc.ErrAccountNotFound
+					}
+					return models.Account{Username: username, AccountType: models.AccountAuthorizedUser}, nil
+				})
+			},
+			query:          "?username=stranger",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusNotFound,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "account not found",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc: mockAuthSvc,
+			}
+
+			req := httptest.NewRequest("GET", "/api/v1/public-key"+tt.query, nil)
+			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, tt.contextIssuer)
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+
+			h.GetPublicKey(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
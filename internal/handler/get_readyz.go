@@ -0,0 +1,20 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+)
+
+// GetReadyz handles the readiness probe. It runs the checks of the dependencies of the application
+// and answers 503 Service Unavailable if any of them failed. Which checks failed is only reported
+// to administrators, by GetHealth.
+func (i *Implementation) GetReadyz(rw http.ResponseWriter, r *http.Request) {
+	report := i.HealthSvc.Check(r.Context())
+
+	status := http.StatusOK
+	if !report.Up() {
+		status = http.StatusServiceUnavailable
+	}
+	response.Health(rw, status, response.HealthcheckResp{Status: report.Status})
+}
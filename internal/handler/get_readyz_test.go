@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/tools/health"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetReadyz(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockHealthSvc := MockService.NewHealthSvcMock(mc)
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "All checks passed",
+			setupMocks: func() {
+				mockHealthSvc.CheckMock.Expect(minimock.AnyContext).Return(health.Report{
+					Status: health.StatusUp,
+					Checks: []health.Result{{Name: "database", Status: health.StatusUp, DurationMs: 1.5}},
+				})
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"status": "up",
+			},
+		},
+		{
+			name: "Check failed",
+			setupMocks: func() {
+				mockHealthSvc.CheckMock.Expect(minimock.AnyContext).Return(health.Report{
+					Status: health.StatusDown,
+					Checks: []health.Result{
+						{Name: "database", Status: health.StatusDown, Error: "connection refused", DurationMs: 1.5},
+					},
+				})
+			},
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedBody: map[string]interface{}{
+				"status": "down",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				HealthSvc: mockHealthSvc,
+			}
+
+			req := httptest.NewRequest("GET", "/readyz", nil)
+			req = req.WithContext(context.Background())
+
+			rec := httptest.NewRecorder()
+
+			h.GetReadyz(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
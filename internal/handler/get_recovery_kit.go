@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/recovery"
+)
+
+// GetRecoveryKit handles the retrieval of the user's recovery kit. Only the kit's parameters are returned;
+// its shares are never stored.
+func (i *Implementation) GetRecoveryKit(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Retrieve the issuer (user ID or token subject) from the request context.
+	issuer, err := middleware.GetIssuer(ctx)
+	if err != nil {
+		handleErrResponse(rw, middleware.ErrTokenInvalid)
+		return
+	}
+
+	// Retrieve the user's account details from the authentication service.
+	var acc models.Account
+	acc, err = i.AuthSvc.GetAccountByUserName(ctx, issuer)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Ensure the user has sufficient rights to perform this action.
+	if acc.AccountType != models.AccountAuthorizedUser {
+		handleErrResponse(rw, middleware.ErrNotEnoughRights)
+		return
+	}
+
+	// Retrieve the kit from the authentication service.
+	kit, err := i.AuthSvc.GetRecoveryKit(ctx, acc.Username)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Send the response with the repacked kit data.
+	response.OK(rw, repackGetRecoveryKit(kit))
+}
+
+// repackGetRecoveryKit converts a recovery kit to the API response structure (GetRecoveryKitResp).
+func repackGetRecoveryKit(kit recovery.Kit) models.GetRecoveryKitResp {
+	return models.GetRecoveryKitResp{
+		KitID:     recovery.FormatKitID(kit.ID),
+		Shares:    kit.Shares,
+		Threshold: kit.Threshold,
+		CreatedAt: kit.CreatedAt,
+	}
+}
@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
+)
+
+// GetSearch handles a full-text search over the names, holders, metadata and tags of the user's cards,
+// paged with the cursor of the previous page like the card listings.
+func (i *Implementation) GetSearch(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Retrieve the issuer (user ID or token subject) from the request context.
+	issuer, err := middleware.GetIssuer(ctx)
+	if err != nil {
+		handleErrResponse(rw, middleware.ErrTokenInvalid)
+		return
+	}
+
+	// Retrieve the user's account details from the authentication service.
+	var acc models.Account
+	acc, err = i.AuthSvc.GetAccountByUserName(ctx, issuer)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Ensure the user has sufficient rights to perform this action.
+	if acc.AccountType != models.AccountAuthorizedUser {
+		handleErrResponse(rw, middleware.ErrNotEnoughRights)
+		return
+	}
+
+	// Extract the search query and the requested page from the query parameters.
+	text := r.URL.Query().Get(SearchQueryParam)
+	page, err := pagination.Parse(r.URL.Query(), []string{profile.SortRelevance}, profile.SortRelevance)
+	if err != nil {
+		handleErrResponse(rw, errInvalidQuery)
+		return
+	}
+
+	// Search the cards using the profile service.
+	cards, next, err := i.ProfileSvc.Search(ctx, acc.Username, text, page)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Send the response with the repacked cards and the cursor of the next page.
+	response.OK(rw, models.GetSearchResp{
+		Username:   acc.Username,
+		Query:      text,
+		Cards:      repackGetCards(acc.Username, cards).Cards,
+		NextCursor: next,
+	})
+}
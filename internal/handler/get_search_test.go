@@ -0,0 +1,184 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSearch(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+	mockProfileSvc := MockService.NewProfileSvcMock(mc)
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		query          string
+		contextIssuer  string
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "First page with more results",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockProfileSvc.SearchMock.Expect(
+					minimock.AnyContext, "test_user", "travel",
+					pagination.Request{Limit: 1, Sort: profile.SortRelevance},
+				).Return([]profile.CardInfo{
+					{
+						CardNumber:     "1234567812345678",
+						CardHolder:     "John Doe",
+						ExpirationDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+						Cvv:            "123",
+						Metadata:       "Travel card",
+						Name:           "Travel",
+						Type:           "card",
+					},
+				}, "next-page", nil)
+			},
+			query:          "?q=travel&limit=1",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"username": "test_user",
+					"query":    "travel",
+					"cards": []interface{}{
+						map[string]interface{}{
+							"card_holder":     "John Doe",
+							"card_number":     "1234567812345678",
+							"cvv":             "123",
+							"expiration_date": "2025-01-01T00:00:00Z",
+							"metadata":        "Travel card",
+							"name":            "Travel",
+							"type":            "card",
+						},
+					},
+					"next_cursor": "next-page",
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Invalid limit",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+			},
+			query:          "?q=travel&limit=-1",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "invalid query parameter",
+			},
+		},
+		{
+			name: "Invalid cursor",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+			},
+			query:          "?q=travel&cursor=garbage",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "invalid query parameter",
+			},
+		},
+		{
+			name: "Missing query",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockProfileSvc.SearchMock.Expect(
+					minimock.AnyContext, "test_user", "",
+					pagination.Request{Limit: pagination.DefaultLimit, Sort: profile.SortRelevance},
+				).Return(nil, "", svc.ErrInvalidSearch)
+			},
+			query:          "",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "invalid search parameters",
+			},
+		},
+		{
+			name: "Not enough rights",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountUnauthorizedUser,
+				}, nil)
+			},
+			query:          "?q=travel",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "not enough rights",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc:    mockAuthSvc,
+				ProfileSvc: mockProfileSvc,
+			}
+
+			req := httptest.NewRequest("GET", "/api/v1/search"+tt.query, nil)
+			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, tt.contextIssuer)
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+
+			h.GetSearch(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gorilla/mux"
+)
+
+// Request parameters of the unauthenticated send endpoint.
+const (
+	SendIDPathVar      = "id"              // Route variable holding the send identifier.
+	HeaderSendPassword = "X-Send-Password" // Header carrying the optional send password.
+)
+
+// GetSend handles opening a one-time share link. It requires no account:
+// each successful call consumes one view and returns the client-encrypted payload.
+func (i *Implementation) GetSend(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Extract the send identifier from the route.
+	id := mux.Vars(r)[SendIDPathVar]
+
+	// Consume a view using the send service.
+	sd, err := i.SendSvc.OpenSend(ctx, id, r.Header.Get(HeaderSendPassword))
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Respond with the ciphertext and the remaining views.
+	response.OK(rw, models.GetSendResp{
+		Ciphertext: sd.Ciphertext,
+		ViewsLeft:  sd.ViewsLeft(),
+		ExpiresAt:  sd.ExpiresAt,
+	})
+}
@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models/send"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSend(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockSendSvc := MockService.NewSendSvcMock(mc)
+
+	const id = "8d3c1c2e-4f3a-4b8a-9a0c-2f5d2c1e7b6a"
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		password       string
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Successful open",
+			setupMocks: func() {
+				mockSendSvc.OpenSendMock.Expect(minimock.AnyContext, id, "pass").Return(send.Send{
+					ID:         id,
+					Ciphertext: []byte("secret"),
+					MaxViews:   3,
+					Views:      1,
+					ExpiresAt:  time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+				}, nil)
+			},
+			password:       "pass",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"ciphertext": "c2VjcmV0",
+					"views_left": 2,
+					"expires_at": "2025-02-01T00:00:00Z",
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Wrong password",
+			setupMocks: func() {
+				mockSendSvc.OpenSendMock.Expect(minimock.AnyContext, id, "wrong").Return(send.Send{}, svc.ErrIncorrectPassword)
+			},
+			password:       "wrong",
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "incorrect password",
+			},
+		},
+		{
+			name: "Expired send",
+			setupMocks: func() {
+				mockSendSvc.OpenSendMock.Expect(minimock.AnyContext, id, "").Return(send.Send{}, svc.ErrSendNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "send not found",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				SendSvc: mockSendSvc,
+			}
+
+			req := httptest.NewRequest("GET", "/api/v1/sends/"+id, nil)
+			req = mux.SetURLVars(req, map[string]string{SendIDPathVar: id})
+			if tt.password != "" {
+				req.Header.Set(HeaderSendPassword, tt.password)
+			}
+
+			rec := httptest.NewRecorder()
+
+			h.GetSend(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
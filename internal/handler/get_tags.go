@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+)
+
+// GetTags handles the retrieval of the user's tags.
+func (i *Implementation) GetTags(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Retrieve the issuer (user ID or token subject) from the request context.
+	issuer, err := middleware.GetIssuer(ctx)
+	if err != nil {
+		handleErrResponse(rw, middleware.ErrTokenInvalid)
+		return
+	}
+
+	// Retrieve the user's account details from the authentication service.
+	var acc models.Account
+	acc, err = i.AuthSvc.GetAccountByUserName(ctx, issuer)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Ensure the user has sufficient rights to perform this action.
+	if acc.AccountType != models.AccountAuthorizedUser {
+		handleErrResponse(rw, middleware.ErrNotEnoughRights)
+		return
+	}
+
+	// Retrieve the tags from the profile service.
+	tags, err := i.ProfileSvc.GetTags(ctx, acc.Username)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Send the response with the repacked tag data.
+	response.OK(rw, repackGetTags(acc.Username, tags))
+}
+
+// repackGetTags converts a slice of tags to the API response structure (GetTagsResp).
+func repackGetTags(username string, tags []profile.Tag) models.GetTagsResp {
+	resp := make([]models.TagResp, 0, len(tags))
+	for _, t := range tags {
+		resp = append(resp, models.TagResp{Name: t.Name, Cards: t.Cards})
+	}
+	return models.GetTagsResp{Username: username, Tags: resp}
+}
@@ -2,16 +2,23 @@ package handler
 
 import (
 	"net/http"
+	"strconv"
 
 	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
 	"github.com/gleb-korostelev/GophKeeper/middleware"
 	"github.com/gleb-korostelev/GophKeeper/models"
 	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
 )
 
-// UsernameParam is the query parameter key for filtering by username in API endpoints.
+// Query parameter keys used by the API endpoints.
 const (
-	UsernameParam = "username"
+	UsernameParam = "username" // Filters by username.
+	FolderParam   = "folder"   // Filters cards by folder identifier, including subfolders.
+	TagParam      = "tag"      // Filters cards by tag name.
+	TypeParam     = "type"     // Filters cards by item type.
+
+	SearchQueryParam = "q" // Full-text search query.
 )
 
 // GetUserCards handles the retrieval of a user's saved card information.
@@ -39,15 +46,63 @@ func (i *Implementation) GetUserCards(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// Extract the folder, tag and type filters from the query parameters.
+	filter, err := parseCardFilter(r)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Restrict the listing to the scope of the API key making the request.
+	filter.Scope = middleware.GetAPIKeyScope(ctx)
+
+	// Extract the requested page from the query parameters.
+	page, err := parseCardPage(r)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
 	// Retrieve the user's cards from the profile service.
-	cards, err := i.ProfileSvc.GetUserCards(ctx, acc.Username)
+	cards, next, err := i.ProfileSvc.GetUserCards(ctx, acc.Username, filter, page)
 	if err != nil {
 		handleErrResponse(rw, err)
 		return
 	}
 
-	// Send the response with the repacked card data.
-	response.OK(rw, repackGetCards(acc.Username, cards))
+	// Send the response with the repacked card data and the cursor of the next page.
+	resp := repackGetCards(acc.Username, cards)
+	resp.NextCursor = next
+	response.OK(rw, resp)
+}
+
+// parseCardFilter reads the card filter from the query parameters of the request.
+func parseCardFilter(r *http.Request) (profile.Filter, error) {
+	query := r.URL.Query()
+	filter := profile.Filter{
+		Tag:  query.Get(TagParam),
+		Type: query.Get(TypeParam),
+	}
+
+	if folder := query.Get(FolderParam); folder != "" {
+		id, err := strconv.ParseInt(folder, 10, 64)
+		if err != nil {
+			return profile.Filter{}, errInvalidQuery
+		}
+		filter.FolderID = &id
+	}
+
+	return filter, nil
+}
+
+// parseCardPage reads the requested page of a card listing from the query parameters of the request.
+// Cards are sorted by creation time unless another sort is requested.
+func parseCardPage(r *http.Request) (pagination.Request, error) {
+	page, err := pagination.Parse(r.URL.Query(), profile.Sorts, profile.SortCreated)
+	if err != nil {
+		return pagination.Request{}, errInvalidQuery
+	}
+	return page, nil
 }
 
 // repackGetCards converts a slice of CardInfo to the API response structure (GetUserCardsResp).
@@ -63,6 +118,19 @@ func repackGetCards(username string, cards []profile.CardInfo) models.GetUserCar
 			ExpirationDate: card.ExpirationDate,
 			Cvv:            card.Cvv,
 			Metadata:       card.Metadata,
+			ItemKey:        card.ItemKey,
+			Shared:         card.Owner != "",
+			Owner:          card.Owner,
+			Permission:     card.Permission,
+			Org:            card.Org,
+			Collection:     card.Collection,
+			Name:           card.Name,
+			Type:           card.Type,
+			FolderID:       card.FolderID,
+			Tags:           card.Tags,
+			URL:            card.URL,
+			Login:          card.Login,
+			Password:       card.Password,
 		})
 	}
 
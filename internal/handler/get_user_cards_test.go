@@ -13,6 +13,7 @@ import (
 	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
 	"github.com/gleb-korostelev/GophKeeper/models"
 	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/assert"
 )
@@ -26,6 +27,7 @@ func TestGetUserCards(t *testing.T) {
 	tests := []struct {
 		name           string
 		setupMocks     func()
+		query          string
 		contextIssuer  string
 		expectedStatus int
 		expectedBody   interface{}
@@ -41,7 +43,7 @@ func TestGetUserCards(t *testing.T) {
 				}, nil)
 
 				mockProfileSvc.GetUserCardsMock.Expect(
-					minimock.AnyContext, "test_user",
+					minimock.AnyContext, "test_user", profile.Filter{}, pagination.Request{Limit: pagination.DefaultLimit, Sort: profile.SortCreated},
 				).Return([]profile.CardInfo{
 					{
 						CardNumber:     "1234567812345678",
@@ -57,7 +59,7 @@ func TestGetUserCards(t *testing.T) {
 						Cvv:            "456",
 						Metadata:       "Backup card",
 					},
-				}, nil)
+				}, "", nil)
 			},
 			contextIssuer:  "test_user",
 			expectedStatus: http.StatusOK,
@@ -117,7 +119,7 @@ func TestGetUserCards(t *testing.T) {
 			},
 		},
 		{
-			name: "Error retrieving cards",
+			name: "Filtered retrieval",
 			setupMocks: func() {
 				mockAuthSvc.GetAccountByUserNameMock.Expect(
 					minimock.AnyContext, "test_user",
@@ -126,9 +128,141 @@ func TestGetUserCards(t *testing.T) {
 					AccountType: models.AccountAuthorizedUser,
 				}, nil)
 
+				folderID := int64(7)
 				mockProfileSvc.GetUserCardsMock.Expect(
+					minimock.AnyContext, "test_user", profile.Filter{FolderID: &folderID, Tag: "travel", Type: "card"},
+					pagination.Request{Limit: pagination.DefaultLimit, Sort: profile.SortCreated},
+				).Return([]profile.CardInfo{
+					{
+						CardNumber:     "1234567812345678",
+						CardHolder:     "John Doe",
+						ExpirationDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+						Cvv:            "123",
+						Metadata:       "Travel card",
+						Name:           "Travel",
+						Type:           "card",
+						FolderID:       &folderID,
+						Tags:           []string{"travel"},
+					},
+				}, "", nil)
+			},
+			query:          "?folder=7&tag=travel&type=card",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"username": "test_user",
+					"cards": []interface{}{
+						map[string]interface{}{
+							"card_holder":     "John Doe",
+							"card_number":     "1234567812345678",
+							"cvv":             "123",
+							"expiration_date": "2025-01-01T00:00:00Z",
+							"metadata":        "Travel card",
+							"name":            "Travel",
+							"type":            "card",
+							"folder_id":       7,
+							"tags":            []string{"travel"},
+						},
+					},
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Paginated retrieval",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
 					minimock.AnyContext, "test_user",
-				).Return(nil, errors.New("database error"))
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+
+				mockProfileSvc.GetUserCardsMock.Expect(
+					minimock.AnyContext, "test_user", profile.Filter{},
+					pagination.Request{Limit: 1, Sort: profile.SortHolder, Desc: true},
+				).Return([]profile.CardInfo{
+					{
+						CardNumber:     "1234567812345678",
+						CardHolder:     "John Doe",
+						ExpirationDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+						Cvv:            "123",
+						Metadata:       "Personal card",
+					},
+				}, "next-page", nil)
+			},
+			query:          "?limit=1&sort=-holder",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"username": "test_user",
+					"cards": []interface{}{
+						map[string]interface{}{
+							"card_holder":     "John Doe",
+							"card_number":     "1234567812345678",
+							"cvv":             "123",
+							"expiration_date": "2025-01-01T00:00:00Z",
+							"metadata":        "Personal card",
+						},
+					},
+					"next_cursor": "next-page",
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Invalid sort",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+			},
+			query:          "?sort=cvv",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "invalid query parameter",
+			},
+		},
+		{
+			name: "Invalid folder",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+			},
+			query:          "?folder=abc",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "invalid query parameter",
+			},
+		},
+		{
+			name: "Error retrieving cards",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+
+				mockProfileSvc.GetUserCardsMock.Expect(
+					minimock.AnyContext, "test_user", profile.Filter{}, pagination.Request{Limit: pagination.DefaultLimit, Sort: profile.SortCreated},
+				).Return(nil, "", errors.New("database error"))
 			},
 			contextIssuer:  "test_user",
 			expectedStatus: http.StatusInternalServerError,
@@ -148,7 +282,7 @@ func TestGetUserCards(t *testing.T) {
 				ProfileSvc: mockProfileSvc,
 			}
 
-			req := httptest.NewRequest("GET", "/api/v1/cards", nil)
+			req := httptest.NewRequest("GET", "/api/v1/cards"+tt.query, nil)
 
 			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, tt.contextIssuer)
 			req = req.WithContext(ctx)
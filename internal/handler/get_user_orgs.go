@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/org"
+)
+
+// GetUserOrgs handles the retrieval of the organizations the user belongs to.
+func (i *Implementation) GetUserOrgs(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Retrieve the issuer (user ID or token subject) from the request context.
+	issuer, err := middleware.GetIssuer(ctx)
+	if err != nil {
+		handleErrResponse(rw, middleware.ErrTokenInvalid)
+		return
+	}
+
+	// Retrieve the user's account details from the authentication service.
+	var acc models.Account
+	acc, err = i.AuthSvc.GetAccountByUserName(ctx, issuer)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Ensure the user has sufficient rights to perform this action.
+	if acc.AccountType != models.AccountAuthorizedUser {
+		handleErrResponse(rw, middleware.ErrNotEnoughRights)
+		return
+	}
+
+	// Retrieve the memberships from the organization service.
+	members, err := i.OrgSvc.GetUserOrgs(ctx, acc.Username)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Send the response with the repacked membership data.
+	response.OK(rw, repackGetUserOrgs(acc.Username, members))
+}
+
+// repackGetUserOrgs converts a slice of memberships to the API response structure (GetUserOrgsResp).
+func repackGetUserOrgs(username string, members []org.Member) models.GetUserOrgsResp {
+	orgs := make([]models.OrgResp, 0, len(members))
+	for _, m := range members {
+		orgs = append(orgs, models.OrgResp{Name: m.Org, Role: m.Role})
+	}
+	return models.GetUserOrgsResp{Username: username, Orgs: orgs}
+}
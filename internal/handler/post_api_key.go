@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/apikey"
+	"github.com/gleb-korostelev/GophKeeper/tools/decoder"
+)
+
+// PostAPIKey handles the creation of a personal API key, letting automation such as CI pipelines access
+// the user's items without signing in. The key is returned exactly once; only a hash of it is stored.
+func (i *Implementation) PostAPIKey(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Retrieve the issuer (user ID or token subject) from the request context.
+	issuer, err := middleware.GetIssuer(ctx)
+	if err != nil {
+		handleErrResponse(rw, middleware.ErrTokenInvalid)
+		return
+	}
+
+	// Retrieve the user's account details from the authentication service.
+	var acc models.Account
+	acc, err = i.AuthSvc.GetAccountByUserName(ctx, issuer)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Ensure the user has sufficient rights to perform this action.
+	if acc.AccountType != models.AccountAuthorizedUser {
+		handleErrResponse(rw, middleware.ErrNotEnoughRights)
+		return
+	}
+
+	// Decode the request body to extract the name, expiry and scope of the key.
+	req, err := decoder.DecodeJson[models.PostAPIKeyReq](r.Body)
+	if err != nil {
+		if _, ok := err.(*json.SyntaxError); ok || strings.Contains(err.Error(), "invalid character") {
+			handleErrResponse(rw, errInvalidRequestBody)
+		} else {
+			handleErrResponse(rw, err)
+		}
+		return
+	}
+
+	// Create the key using the authentication service.
+	key, token, err := i.AuthSvc.CreateAPIKey(ctx, acc.Username, apikey.Key{
+		Name:      req.Name,
+		ExpiresAt: req.ExpiresAt,
+		Scope: apikey.Scope{
+			Items:    req.Scope.Items,
+			Folders:  req.Scope.Folders,
+			ReadOnly: req.Scope.ReadOnly,
+		},
+	})
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Respond with the key and its stored form.
+	response.OK(rw, models.PostAPIKeyResp{Key: token, APIKeyResp: repackAPIKey(key, key.CreatedAt)})
+}
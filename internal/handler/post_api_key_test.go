@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/apikey"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostAPIKey(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+
+	createdAt := time.Date(2025, 3, 25, 12, 0, 0, 0, time.UTC)
+	expiresAt := time.Date(2025, 6, 25, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		contextIssuer  string
+		requestBody    interface{}
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Successful creation",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAuthSvc.CreateAPIKeyMock.Expect(
+					minimock.AnyContext, "test_user", apikey.Key{
+						Name:      "ci",
+						ExpiresAt: expiresAt,
+						Scope:     apikey.Scope{Folders: []int64{3}, ReadOnly: true},
+					},
+				).Return(apikey.Key{
+					ID:        "0a1b2c3d-0000-0000-0000-000000000000",
+					Username:  "test_user",
+					Name:      "ci",
+					Scope:     apikey.Scope{Folders: []int64{3}, ReadOnly: true},
+					ExpiresAt: expiresAt,
+					CreatedAt: createdAt,
+				}, "gk_secret", nil)
+			},
+			contextIssuer: "test_user",
+			requestBody: map[string]interface{}{
+				"name":       "ci",
+				"expires_at": expiresAt,
+				"scope": map[string]interface{}{
+					"folders":   []int64{3},
+					"read_only": true,
+				},
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"key":  "gk_secret",
+					"id":   "0a1b2c3d-0000-0000-0000-000000000000",
+					"name": "ci",
+					"hint": "gk_0a1b2c3d",
+					"scope": map[string]interface{}{
+						"folders":   []int64{3},
+						"read_only": true,
+					},
+					"expires_at": expiresAt,
+					"created_at": createdAt,
+					"expired":    false,
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Invalid key parameters",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAuthSvc.CreateAPIKeyMock.Expect(
+					minimock.AnyContext, "test_user", apikey.Key{Name: "ci"},
+				).Return(apikey.Key{}, "", svc.ErrInvalidAPIKey)
+			},
+			contextIssuer: "test_user",
+			requestBody: map[string]interface{}{
+				"name": "ci",
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "invalid api key",
+			},
+		},
+		{
+			name: "Invalid request body",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+			},
+			contextIssuer:  "test_user",
+			requestBody:    "invalid_json",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "invalid request body",
+			},
+		},
+		{
+			name: "Not enough rights",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountUnauthorizedUser,
+				}, nil)
+			},
+			contextIssuer:  "test_user",
+			requestBody:    map[string]interface{}{},
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "not enough rights",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc: mockAuthSvc,
+			}
+
+			var reqBody []byte
+			if body, ok := tt.requestBody.(string); ok {
+				reqBody = []byte(body)
+			} else {
+				reqBody, _ = json.Marshal(tt.requestBody)
+			}
+
+			req := httptest.NewRequest("POST", "/api/v1/api-keys", bytes.NewBuffer(reqBody))
+			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, tt.contextIssuer)
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+
+			h.PostAPIKey(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
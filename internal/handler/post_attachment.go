@@ -0,0 +1,82 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/attachment"
+	"github.com/gleb-korostelev/GophKeeper/tools/decoder"
+)
+
+// Request parameters of the attachment endpoints.
+const (
+	attachmentsPath = "/api/v1/attachments/" // API path attachments are uploaded to and downloaded from.
+
+	AttachmentIDPathVar = "id"          // Route variable holding the attachment identifier.
+	CardNumberParam     = "card_number" // Query parameter selecting the card whose attachments are listed.
+
+	HeaderContentRange  = "Content-Range"    // Header giving the position of an upload chunk.
+	HeaderContentSHA256 = "X-Content-SHA256" // Header carrying the checksum of a downloaded attachment.
+)
+
+// PostAttachment handles registering a file attachment on one of the user's cards.
+// The content is then uploaded in chunks to the returned path.
+func (i *Implementation) PostAttachment(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Retrieve the issuer (user ID or token subject) from the request context.
+	issuer, err := middleware.GetIssuer(ctx)
+	if err != nil {
+		handleErrResponse(rw, middleware.ErrTokenInvalid)
+		return
+	}
+
+	// Retrieve the user's account details from the authentication service.
+	var acc models.Account
+	acc, err = i.AuthSvc.GetAccountByUserName(ctx, issuer)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Ensure the user has sufficient rights to perform this action.
+	if acc.AccountType != models.AccountAuthorizedUser {
+		handleErrResponse(rw, middleware.ErrNotEnoughRights)
+		return
+	}
+
+	// Decode the request body to extract the card and file details.
+	req, err := decoder.DecodeJson[models.PostAttachmentReq](r.Body)
+	if err != nil {
+		if _, ok := err.(*json.SyntaxError); ok || strings.Contains(err.Error(), "invalid character") {
+			handleErrResponse(rw, errInvalidRequestBody)
+		} else {
+			handleErrResponse(rw, err)
+		}
+		return
+	}
+
+	// Create an Attachment object owned by the current user.
+	a := attachment.Attachment{
+		Username:    acc.Username,
+		CardNumber:  req.CardNumber,
+		Name:        req.Name,
+		ContentType: req.ContentType,
+		Size:        req.Size,
+		SHA256:      req.SHA256,
+	}
+
+	// Register the attachment using the attachment service.
+	id, err := i.AttachmentSvc.CreateAttachment(ctx, a)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Respond with the attachment identifier and path.
+	response.OK(rw, models.PostAttachmentResp{ID: id, Path: attachmentsPath + id})
+}
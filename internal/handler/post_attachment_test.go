@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/attachment"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostAttachment(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+	mockAttachmentSvc := MockService.NewAttachmentSvcMock(mc)
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		requestBody    interface{}
+		contextIssuer  string
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Successful registration",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAttachmentSvc.CreateAttachmentMock.Expect(
+					minimock.AnyContext, attachment.Attachment{
+						Username:   "test_user",
+						CardNumber: "1234567812345678",
+						Name:       "passport.pdf.enc",
+						Size:       1024,
+						SHA256:     "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+					},
+				).Return("8d3c1c2e-4f3a-4b8a-9a0c-2f5d2c1e7b6a", nil)
+			},
+			requestBody: map[string]interface{}{
+				"card_number": "1234567812345678",
+				"name":        "passport.pdf.enc",
+				"size":        1024,
+				"sha256":      "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"id":   "8d3c1c2e-4f3a-4b8a-9a0c-2f5d2c1e7b6a",
+					"path": "/api/v1/attachments/8d3c1c2e-4f3a-4b8a-9a0c-2f5d2c1e7b6a",
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Quota exceeded",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAttachmentSvc.CreateAttachmentMock.Expect(
+					minimock.AnyContext, attachment.Attachment{
+						Username:   "test_user",
+						CardNumber: "1234567812345678",
+						Name:       "passport.pdf.enc",
+						Size:       1 << 30,
+						SHA256:     "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+					},
+				).Return("", svc.ErrQuotaExceeded)
+			},
+			requestBody: map[string]interface{}{
+				"card_number": "1234567812345678",
+				"name":        "passport.pdf.enc",
+				"size":        1 << 30,
+				"sha256":      "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusRequestEntityTooLarge,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "quota exceeded",
+			},
+		},
+		{
+			name: "Card not found",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAttachmentSvc.CreateAttachmentMock.Expect(
+					minimock.AnyContext, attachment.Attachment{
+						Username:   "test_user",
+						CardNumber: "1234567812345678",
+						Name:       "passport.pdf.enc",
+						Size:       1024,
+						SHA256:     "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+					},
+				).Return("", svc.ErrCardNotFound)
+			},
+			requestBody: map[string]interface{}{
+				"card_number": "1234567812345678",
+				"name":        "passport.pdf.enc",
+				"size":        1024,
+				"sha256":      "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusNotFound,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "card not found",
+			},
+		},
+		{
+			name: "Not enough rights",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountUnauthorizedUser,
+				}, nil)
+			},
+			requestBody: map[string]interface{}{
+				"card_number": "1234567812345678",
+				"name":        "passport.pdf.enc",
+				"size":        1024,
+				"sha256":      "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "not enough rights",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc:       mockAuthSvc,
+				AttachmentSvc: mockAttachmentSvc,
+			}
+
+			reqBody, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest("POST", "/api/v1/attachments", bytes.NewBuffer(reqBody))
+			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, tt.contextIssuer)
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+
+			h.PostAttachment(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/gleb-korostelev/GophKeeper/tools/decoder"
+)
+
+// PostChangePassword handles changing the user's master password. The old password is verified first;
+// on success every other session of the user is signed out. Clients using client-side encryption
+// send their new public key together with every item key re-wrapped to it.
+func (i *Implementation) PostChangePassword(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Retrieve the issuer (user ID or token subject) from the request context.
+	issuer, err := middleware.GetIssuer(ctx)
+	if err != nil {
+		handleErrResponse(rw, middleware.ErrTokenInvalid)
+		return
+	}
+
+	// Retrieve the user's account details from the authentication service.
+	var acc models.Account
+	acc, err = i.AuthSvc.GetAccountByUserName(ctx, issuer)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Ensure the user has sufficient rights to perform this action.
+	if acc.AccountType != models.AccountAuthorizedUser {
+		handleErrResponse(rw, middleware.ErrNotEnoughRights)
+		return
+	}
+
+	// Decode the request body to extract the passwords and re-wrapped keys.
+	req, err := decoder.DecodeJson[models.PostChangePasswordReq](r.Body)
+	if err != nil {
+		if _, ok := err.(*json.SyntaxError); ok || strings.Contains(err.Error(), "invalid character") {
+			handleErrResponse(rw, errInvalidRequestBody)
+		} else {
+			handleErrResponse(rw, err)
+		}
+		return
+	}
+
+	// Create a PasswordChange object from the request.
+	change := models.PasswordChange{
+		OldPassword: req.OldPassword,
+		NewPassword: req.NewPassword,
+		PublicKey:   req.PublicKey,
+	}
+	for _, k := range req.ItemKeys {
+		change.ItemKeys = append(change.ItemKeys, profile.ItemKey{Owner: k.Owner, CardNumber: k.CardNumber, Key: k.ItemKey})
+	}
+
+	// Change the password using the authentication service, keeping the current session.
+	revoked, err := i.AuthSvc.ChangePassword(ctx, acc.Username, middleware.GetSessionID(ctx), change)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Respond with the number of signed out sessions.
+	response.OK(rw, models.PostChangePasswordResp{RevokedSessions: revoked})
+}
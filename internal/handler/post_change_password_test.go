@@ -0,0 +1,175 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostChangePassword(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		requestBody    interface{}
+		contextIssuer  string
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Successful change with re-keying",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAuthSvc.ChangePasswordMock.Expect(
+					minimock.AnyContext, "test_user", "", models.PasswordChange{
+						OldPassword: "old_password",
+						NewPassword: "new_password",
+						PublicKey:   []byte("pub"),
+						ItemKeys: []profile.ItemKey{
+							{CardNumber: "1234567812345678", Key: []byte("key1")},
+							{Owner: "jane_doe", CardNumber: "8765432187654321", Key: []byte("key2")},
+						},
+					},
+				).Return(2, nil)
+			},
+			requestBody: map[string]interface{}{
+				"old_password": "old_password",
+				"new_password": "new_password",
+				"public_key":   []byte("pub"),
+				"item_keys": []map[string]interface{}{
+					{"card_number": "1234567812345678", "item_key": []byte("key1")},
+					{"owner": "jane_doe", "card_number": "8765432187654321", "item_key": []byte("key2")},
+				},
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"revoked_sessions": 2,
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Wrong old password",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAuthSvc.ChangePasswordMock.Expect(
+					minimock.AnyContext, "test_user", "", models.PasswordChange{
+						OldPassword: "wrong",
+						NewPassword: "new_password",
+					},
+				).Return(0, svc.ErrIncorrectPassword)
+			},
+			requestBody: map[string]string{
+				"old_password": "wrong",
+				"new_password": "new_password",
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "incorrect password",
+			},
+		},
+		{
+			name: "Incomplete re-keying",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAuthSvc.ChangePasswordMock.Expect(
+					minimock.AnyContext, "test_user", "", models.PasswordChange{
+						OldPassword: "old_password",
+						NewPassword: "new_password",
+						PublicKey:   []byte("pub"),
+					},
+				).Return(0, svc.ErrIncompleteRekey)
+			},
+			requestBody: map[string]interface{}{
+				"old_password": "old_password",
+				"new_password": "new_password",
+				"public_key":   []byte("pub"),
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "re-keying must re-wrap every item key",
+			},
+		},
+		{
+			name: "Not enough rights",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountUnauthorizedUser,
+				}, nil)
+			},
+			requestBody: map[string]string{
+				"old_password": "old_password",
+				"new_password": "new_password",
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "not enough rights",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc: mockAuthSvc,
+			}
+
+			reqBody, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest("POST", "/api/v1/account/password", bytes.NewBuffer(reqBody))
+			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, tt.contextIssuer)
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+
+			h.PostChangePassword(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostCollectionCard(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+	mockOrgSvc := MockService.NewOrgSvcMock(mc)
+
+	authorized := func() {
+		mockAuthSvc.GetAccountByUserNameMock.Expect(
+			minimock.AnyContext, "test_user",
+		).Return(models.Account{
+			Username:    "test_user",
+			AccountType: models.AccountAuthorizedUser,
+		}, nil)
+	}
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		requestBody    interface{}
+		contextIssuer  string
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Successful add",
+			setupMocks: func() {
+				authorized()
+				mockOrgSvc.AddCollectionCardMock.Expect(minimock.AnyContext, "test_user", "acme", "payments", "1234567812345678").Return(nil)
+			},
+			requestBody:    map[string]string{"collection": "payments", "card_number": "1234567812345678"},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"success": true,
+				"message": "Success",
+			},
+		},
+		{
+			name: "Unknown collection",
+			setupMocks: func() {
+				authorized()
+				mockOrgSvc.AddCollectionCardMock.Expect(minimock.AnyContext, "test_user", "acme", "missing", "1234567812345678").Return(svc.ErrCollectionNotFound)
+			},
+			requestBody:    map[string]string{"collection": "missing", "card_number": "1234567812345678"},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusNotFound,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "collection not found",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc: mockAuthSvc,
+				OrgSvc:  mockOrgSvc,
+			}
+
+			reqBody, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest("POST", "/api/v1/orgs/acme/collections/cards", bytes.NewBuffer(reqBody))
+			req = mux.SetURLVars(req, map[string]string{middleware.OrgPathVar: "acme"})
+			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, tt.contextIssuer)
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+
+			h.PostCollectionCard(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
 	"github.com/gleb-korostelev/GophKeeper/models"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/assert"
 )
@@ -75,6 +76,24 @@ func TestPostCreateProfile(t *testing.T) {
 				"message": "profile creation error",
 			},
 		},
+		{
+			name: "Existing username with another password",
+			setupMocks: func() {
+				mockAuthSvc.CreateProfileMock.Expect(
+					minimock.AnyContext,
+					models.Profile{Username: "test_user", Password: "other_password"},
+				).Return("", svc.ErrAccountExists)
+			},
+			requestBody: map[string]string{
+				"username": "test_user",
+				"password": "other_password",
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "account already exists",
+			},
+		},
 	}
 
 	for _, tt := range tests {
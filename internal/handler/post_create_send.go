@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/send"
+	"github.com/gleb-korostelev/GophKeeper/tools/decoder"
+)
+
+// sendsPath is the API path one-time share links are opened at.
+const sendsPath = "/api/v1/sends/"
+
+// PostCreateSend handles the creation of a one-time share link for a client-encrypted payload.
+func (i *Implementation) PostCreateSend(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Retrieve the issuer (user ID or token subject) from the request context.
+	issuer, err := middleware.GetIssuer(ctx)
+	if err != nil {
+		handleErrResponse(rw, middleware.ErrTokenInvalid)
+		return
+	}
+
+	// Retrieve the user's account details from the authentication service.
+	var acc models.Account
+	acc, err = i.AuthSvc.GetAccountByUserName(ctx, issuer)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Ensure the user has sufficient rights to perform this action.
+	if acc.AccountType != models.AccountAuthorizedUser {
+		handleErrResponse(rw, middleware.ErrNotEnoughRights)
+		return
+	}
+
+	// Decode the request body to extract the payload and limits.
+	req, err := decoder.DecodeJson[models.PostCreateSendReq](r.Body)
+	if err != nil {
+		if _, ok := err.(*json.SyntaxError); ok || strings.Contains(err.Error(), "invalid character") {
+			handleErrResponse(rw, errInvalidRequestBody)
+		} else {
+			handleErrResponse(rw, err)
+		}
+		return
+	}
+
+	// Create a Send object owned by the current user.
+	sd := send.Send{
+		Username:   acc.Username,
+		Ciphertext: req.Ciphertext,
+		MaxViews:   req.MaxViews,
+		ExpiresAt:  req.ExpiresAt,
+	}
+
+	// Store the send using the send service.
+	id, err := i.SendSvc.CreateSend(ctx, sd, req.Password)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Respond with the send identifier and path.
+	response.OK(rw, models.PostCreateSendResp{ID: id, Path: sendsPath + id, ExpiresAt: req.ExpiresAt})
+}
@@ -0,0 +1,144 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/send"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostCreateSend(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+	mockSendSvc := MockService.NewSendSvcMock(mc)
+
+	expiresAt := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		requestBody    interface{}
+		contextIssuer  string
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Successful creation",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockSendSvc.CreateSendMock.Expect(
+					minimock.AnyContext,
+					send.Send{
+						Username:   "test_user",
+						Ciphertext: []byte("secret"),
+						MaxViews:   1,
+						ExpiresAt:  expiresAt,
+					},
+					"pass",
+				).Return("8d3c1c2e-4f3a-4b8a-9a0c-2f5d2c1e7b6a", nil)
+			},
+			requestBody: map[string]interface{}{
+				"ciphertext": "c2VjcmV0",
+				"max_views":  1,
+				"expires_at": "2025-02-01T00:00:00Z",
+				"password":   "pass",
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"id":         "8d3c1c2e-4f3a-4b8a-9a0c-2f5d2c1e7b6a",
+					"path":       "/api/v1/sends/8d3c1c2e-4f3a-4b8a-9a0c-2f5d2c1e7b6a",
+					"expires_at": "2025-02-01T00:00:00Z",
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name:           "Error retrieving issuer",
+			setupMocks:     func() {},
+			requestBody:    map[string]interface{}{},
+			contextIssuer:  "",
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "bearer token is not correct",
+			},
+		},
+		{
+			name: "Invalid limits",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockSendSvc.CreateSendMock.Expect(
+					minimock.AnyContext,
+					send.Send{
+						Username:   "test_user",
+						Ciphertext: []byte("secret"),
+						MaxViews:   0,
+						ExpiresAt:  expiresAt,
+					},
+					"",
+				).Return("", svc.ErrInvalidSend)
+			},
+			requestBody: map[string]interface{}{
+				"ciphertext": "c2VjcmV0",
+				"max_views":  0,
+				"expires_at": "2025-02-01T00:00:00Z",
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "invalid send parameters",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc: mockAuthSvc,
+				SendSvc: mockSendSvc,
+			}
+
+			reqBody, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest("POST", "/api/v1/sends", bytes.NewBuffer(reqBody))
+			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, tt.contextIssuer)
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+
+			h.PostCreateSend(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
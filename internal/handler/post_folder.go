@@ -0,0 +1,67 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/gleb-korostelev/GophKeeper/tools/decoder"
+)
+
+// PostFolder handles creating a folder, optionally nested in another of the user's folders.
+func (i *Implementation) PostFolder(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Retrieve the issuer (user ID or token subject) from the request context.
+	issuer, err := middleware.GetIssuer(ctx)
+	if err != nil {
+		handleErrResponse(rw, middleware.ErrTokenInvalid)
+		return
+	}
+
+	// Retrieve the user's account details from the authentication service.
+	var acc models.Account
+	acc, err = i.AuthSvc.GetAccountByUserName(ctx, issuer)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Ensure the user has sufficient rights to perform this action.
+	if acc.AccountType != models.AccountAuthorizedUser {
+		handleErrResponse(rw, middleware.ErrNotEnoughRights)
+		return
+	}
+
+	// Decode the request body to extract the folder details.
+	req, err := decoder.DecodeJson[models.PostFolderReq](r.Body)
+	if err != nil {
+		if _, ok := err.(*json.SyntaxError); ok || strings.Contains(err.Error(), "invalid character") {
+			handleErrResponse(rw, errInvalidRequestBody)
+		} else {
+			handleErrResponse(rw, err)
+		}
+		return
+	}
+
+	// Create a Folder object owned by the current user.
+	folder := profile.Folder{
+		Username: acc.Username,
+		ParentID: req.ParentID,
+		Name:     req.Name,
+	}
+
+	// Create the folder using the profile service.
+	id, err := i.ProfileSvc.CreateFolder(ctx, folder)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Respond with the identifier of the new folder.
+	response.OK(rw, models.PostFolderResp{ID: id})
+}
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/transfer"
+)
+
+// Query parameters of the import endpoint.
+const (
+	FormatParam = "format"  // Format of the imported file.
+	DryRunParam = "dry_run" // Only reports what would be imported.
+)
+
+// PostImport handles importing the export of another password manager into the user's vault.
+// The file is sent as the request body; its format is given by the format query parameter.
+// GophKeeper archives are decrypted with the password from the X-Export-Password header.
+// With dry_run set, duplicates and a quota the import would exceed are reported without storing anything.
+func (i *Implementation) PostImport(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Retrieve the issuer (user ID or token subject) from the request context.
+	issuer, err := middleware.GetIssuer(ctx)
+	if err != nil {
+		handleErrResponse(rw, middleware.ErrTokenInvalid)
+		return
+	}
+
+	// Retrieve the user's account details from the authentication service.
+	var acc models.Account
+	acc, err = i.AuthSvc.GetAccountByUserName(ctx, issuer)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Ensure the user has sufficient rights to perform this action.
+	if acc.AccountType != models.AccountAuthorizedUser {
+		handleErrResponse(rw, middleware.ErrNotEnoughRights)
+		return
+	}
+
+	// Parse the dry run flag.
+	query := r.URL.Query()
+	dryRun := false
+	if v := query.Get(DryRunParam); v != "" {
+		if dryRun, err = strconv.ParseBool(v); err != nil {
+			handleErrResponse(rw, errInvalidQuery)
+			return
+		}
+	}
+
+	// Never read more than the import size limit from the request body.
+	body := http.MaxBytesReader(rw, r.Body, transfer.MaxImportSize)
+
+	// Import the file using the transfer service.
+	report, err := i.TransferSvc.Import(ctx, acc.Username, query.Get(FormatParam), body,
+		r.Header.Get(HeaderExportPassword), dryRun)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Send the response with the repacked import report.
+	response.OK(rw, repackImportReport(report))
+}
+
+// repackImportReport converts an import report to the API response structure (PostImportResp).
+func repackImportReport(report transfer.Report) models.PostImportResp {
+	duplicates := make([]models.ImportDuplicateResp, 0, len(report.Duplicates))
+	for _, d := range report.Duplicates {
+		duplicates = append(duplicates, models.ImportDuplicateResp{Name: d.Name, Type: d.Type, Match: d.Match})
+	}
+
+	return models.PostImportResp{
+		Format:        report.Format,
+		DryRun:        report.DryRun,
+		Total:         report.Total,
+		Imported:      report.Imported,
+		Duplicates:    duplicates,
+		QuotaExceeded: report.QuotaExceeded,
+	}
+}
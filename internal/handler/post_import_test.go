@@ -0,0 +1,260 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/transfer"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostImport(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+	mockTransferSvc := MockService.NewTransferSvcMock(mc)
+
+	const file = "name,url,username,password\nBank,https://bank.example.com,john,secret\n"
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		query          string
+		password       string
+		contextIssuer  string
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Dry run with duplicates",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockTransferSvc.ImportMock.
+					ExpectUsernameParam2("test_user").
+					ExpectFormatParam3("chrome").
+					ExpectDryRunParam6(true).
+					Return(transfer.Report{
+						Format:   "chrome",
+						DryRun:   true,
+						Total:    1,
+						Imported: 0,
+						Duplicates: []transfer.Duplicate{
+							{Name: "Bank", Type: "login", Match: transfer.MatchLogin},
+						},
+					}, nil)
+			},
+			query:          "?format=chrome&dry_run=true",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"format":   "chrome",
+					"dry_run":  true,
+					"total":    1,
+					"imported": 0,
+					"duplicates": []interface{}{
+						map[string]interface{}{
+							"name":  "Bank",
+							"type":  "login",
+							"match": "url_login",
+						},
+					},
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Dry run exceeding the quota",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockTransferSvc.ImportMock.
+					ExpectUsernameParam2("test_user").
+					ExpectFormatParam3("chrome").
+					ExpectDryRunParam6(true).
+					Return(transfer.Report{
+						Format:        "chrome",
+						DryRun:        true,
+						Total:         1,
+						Imported:      1,
+						QuotaExceeded: "quota exceeded: the limit of 100 items is reached",
+					}, nil)
+			},
+			query:          "?format=chrome&dry_run=true",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"format":         "chrome",
+					"dry_run":        true,
+					"total":          1,
+					"imported":       1,
+					"duplicates":     []interface{}{},
+					"quota_exceeded": "quota exceeded: the limit of 100 items is reached",
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Successful import",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockTransferSvc.ImportMock.
+					ExpectUsernameParam2("test_user").
+					ExpectFormatParam3("chrome").
+					ExpectDryRunParam6(false).
+					Return(transfer.Report{Format: "chrome", Total: 1, Imported: 1}, nil)
+			},
+			query:          "?format=chrome",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"format":     "chrome",
+					"dry_run":    false,
+					"total":      1,
+					"imported":   1,
+					"duplicates": []interface{}{},
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Unknown format",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockTransferSvc.ImportMock.
+					ExpectUsernameParam2("test_user").
+					ExpectFormatParam3("lastpass").
+					ExpectDryRunParam6(false).
+					Return(transfer.Report{}, svc.ErrUnknownFormat)
+			},
+			query:          "?format=lastpass",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "unknown import format",
+			},
+		},
+		{
+			name: "Wrong archive password",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockTransferSvc.ImportMock.
+					ExpectUsernameParam2("test_user").
+					ExpectFormatParam3("gophkeeper").
+					ExpectPasswordParam5("wrong password").
+					ExpectDryRunParam6(false).
+					Return(transfer.Report{}, svc.ErrIncorrectPassword)
+			},
+			query:          "?format=gophkeeper",
+			password:       "wrong password",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "incorrect password",
+			},
+		},
+		{
+			name: "Invalid dry run flag",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+			},
+			query:          "?format=chrome&dry_run=maybe",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "invalid query parameter",
+			},
+		},
+		{
+			name: "Not enough rights",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountUnauthorizedUser,
+				}, nil)
+			},
+			query:          "?format=chrome",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "not enough rights",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc:     mockAuthSvc,
+				TransferSvc: mockTransferSvc,
+			}
+
+			req := httptest.NewRequest("POST", "/api/v1/import"+tt.query, strings.NewReader(file))
+			if tt.password != "" {
+				req.Header.Set(HeaderExportPassword, tt.password)
+			}
+			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, tt.contextIssuer)
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+
+			h.PostImport(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
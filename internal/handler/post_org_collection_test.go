@@ -0,0 +1,107 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/org"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostOrgCollection(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+	mockOrgSvc := MockService.NewOrgSvcMock(mc)
+
+	authorized := func() {
+		mockAuthSvc.GetAccountByUserNameMock.Expect(
+			minimock.AnyContext, "test_user",
+		).Return(models.Account{
+			Username:    "test_user",
+			AccountType: models.AccountAuthorizedUser,
+		}, nil)
+	}
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		requestBody    interface{}
+		contextIssuer  string
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Successful update",
+			setupMocks: func() {
+				authorized()
+				mockOrgSvc.SetCollectionMock.Expect(minimock.AnyContext, org.Collection{
+					Org:    "acme",
+					Name:   "payments",
+					Groups: []string{"backend"},
+				}).Return(nil)
+			},
+			requestBody:    map[string]interface{}{"name": "payments", "groups": []string{"backend"}},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"success": true,
+				"message": "Success",
+			},
+		},
+		{
+			name: "Unknown group",
+			setupMocks: func() {
+				authorized()
+				mockOrgSvc.SetCollectionMock.Expect(minimock.AnyContext, org.Collection{
+					Org:    "acme",
+					Name:   "payments",
+					Groups: []string{"frontend"},
+				}).Return(svc.ErrGroupNotFound)
+			},
+			requestBody:    map[string]interface{}{"name": "payments", "groups": []string{"frontend"}},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusNotFound,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "group not found",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc: mockAuthSvc,
+				OrgSvc:  mockOrgSvc,
+			}
+
+			reqBody, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest("POST", "/api/v1/orgs/acme/collections", bytes.NewBuffer(reqBody))
+			req = mux.SetURLVars(req, map[string]string{middleware.OrgPathVar: "acme"})
+			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, tt.contextIssuer)
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+
+			h.PostOrgCollection(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/org"
+	"github.com/gleb-korostelev/GophKeeper/tools/decoder"
+	"github.com/gorilla/mux"
+)
+
+// PostOrgGroup handles creating a group within an organization or replacing its members.
+func (i *Implementation) PostOrgGroup(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Retrieve the issuer (user ID or token subject) from the request context.
+	issuer, err := middleware.GetIssuer(ctx)
+	if err != nil {
+		handleErrResponse(rw, middleware.ErrTokenInvalid)
+		return
+	}
+
+	// Retrieve the user's account details from the authentication service.
+	var acc models.Account
+	acc, err = i.AuthSvc.GetAccountByUserName(ctx, issuer)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Ensure the user has sufficient rights to perform this action.
+	if acc.AccountType != models.AccountAuthorizedUser {
+		handleErrResponse(rw, middleware.ErrNotEnoughRights)
+		return
+	}
+
+	// Extract the organization name from the route.
+	orgName := mux.Vars(r)[middleware.OrgPathVar]
+
+	// Decode the request body to extract the group name and members.
+	req, err := decoder.DecodeJson[models.PostOrgGroupReq](r.Body)
+	if err != nil {
+		if _, ok := err.(*json.SyntaxError); ok || strings.Contains(err.Error(), "invalid character") {
+			handleErrResponse(rw, errInvalidRequestBody)
+		} else {
+			handleErrResponse(rw, err)
+		}
+		return
+	}
+
+	// Validate that the group name is provided.
+	if len(req.Name) == 0 {
+		handleErrResponse(rw, errInvalidRequestBody)
+		return
+	}
+
+	// Update the group using the organization service.
+	err = i.OrgSvc.SetGroup(ctx, org.Group{Org: orgName, Name: req.Name, Members: req.Members})
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Respond with a success message.
+	response.OK(rw, nil)
+}
@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/gleb-korostelev/GophKeeper/tools/decoder"
+)
+
+// PostRecover handles the recovery of an account whose master password was forgotten. The shares of the
+// account's recovery kit reconstruct the vault key, which authorizes setting a new password; every session
+// of the user is signed out and the vault key is returned to the client.
+func (i *Implementation) PostRecover(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Decode the request body to extract the shares and the new password.
+	req, err := decoder.DecodeJson[models.PostRecoverReq](r.Body)
+	if err != nil {
+		if _, ok := err.(*json.SyntaxError); ok || strings.Contains(err.Error(), "invalid character") {
+			handleErrResponse(rw, errInvalidRequestBody)
+		} else {
+			handleErrResponse(rw, err)
+		}
+		return
+	}
+
+	// Validate that all required fields are provided.
+	if len(req.Username) == 0 || len(req.Shares) == 0 || len(req.NewPassword) == 0 {
+		handleErrResponse(rw, errInvalidRequestBody)
+		return
+	}
+
+	// Create a PasswordChange object from the request.
+	change := models.PasswordChange{
+		NewPassword: req.NewPassword,
+		PublicKey:   req.PublicKey,
+	}
+	for _, k := range req.ItemKeys {
+		change.ItemKeys = append(change.ItemKeys, profile.ItemKey{Owner: k.Owner, CardNumber: k.CardNumber, Key: k.ItemKey})
+	}
+
+	// Recover the account using the authentication service.
+	vaultKey, revoked, err := i.AuthSvc.Recover(ctx, req.Username, req.Shares, change)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Respond with the vault key and the number of signed out sessions.
+	response.OK(rw, models.PostRecoverResp{VaultKey: vaultKey, RevokedSessions: revoked})
+}
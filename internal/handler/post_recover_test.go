@@ -0,0 +1,159 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostRecover(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+
+	codes := []string{"AAAAA-BBBBB", "CCCCC-DDDDD"}
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		requestBody    interface{}
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Successful recovery",
+			setupMocks: func() {
+				mockAuthSvc.RecoverMock.Expect(
+					minimock.AnyContext, "test_user", codes, models.PasswordChange{NewPassword: "new-secret"},
+				).Return([]byte("vault-key-bytes!"), 2, nil)
+			},
+			requestBody: map[string]interface{}{
+				"username":     "test_user",
+				"shares":       codes,
+				"new_password": "new-secret",
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"vault_key":        []byte("vault-key-bytes!"),
+					"revoked_sessions": 2,
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Successful recovery with re-keying",
+			setupMocks: func() {
+				mockAuthSvc.RecoverMock.Expect(
+					minimock.AnyContext, "test_user", codes, models.PasswordChange{
+						NewPassword: "new-secret",
+						PublicKey:   []byte("new-public-key"),
+						ItemKeys: []profile.ItemKey{
+							{CardNumber: "1234567812345678", Key: []byte("rewrapped")},
+						},
+					},
+				).Return([]byte("vault-key-bytes!"), 0, nil)
+			},
+			requestBody: map[string]interface{}{
+				"username":     "test_user",
+				"shares":       codes,
+				"new_password": "new-secret",
+				"public_key":   []byte("new-public-key"),
+				"item_keys": []map[string]interface{}{
+					{"card_number": "1234567812345678", "item_key": []byte("rewrapped")},
+				},
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"vault_key":        []byte("vault-key-bytes!"),
+					"revoked_sessions": 0,
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Recovery failed",
+			setupMocks: func() {
+				mockAuthSvc.RecoverMock.Expect(
+					minimock.AnyContext, "test_user", codes, models.PasswordChange{NewPassword: "new-secret"},
+				).Return(nil, 0, svc.ErrRecoveryFailed)
+			},
+			requestBody: map[string]interface{}{
+				"username":     "test_user",
+				"shares":       codes,
+				"new_password": "new-secret",
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "recovery failed",
+			},
+		},
+		{
+			name: "Not enough shares",
+			setupMocks: func() {
+				mockAuthSvc.RecoverMock.Expect(
+					minimock.AnyContext, "test_user", codes, models.PasswordChange{NewPassword: "new-secret"},
+				).Return(nil, 0, fmt.Errorf("%w: 2 of 3 given", svc.ErrNotEnoughShares))
+			},
+			requestBody: map[string]interface{}{
+				"username":     "test_user",
+				"shares":       codes,
+				"new_password": "new-secret",
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "not enough recovery shares: 2 of 3 given",
+			},
+		},
+		{
+			name:       "Missing shares",
+			setupMocks: func() {},
+			requestBody: map[string]interface{}{
+				"username":     "test_user",
+				"new_password": "new-secret",
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "invalid request body",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc: mockAuthSvc,
+			}
+
+			reqBody, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest("POST", "/api/v1/account/recover", bytes.NewBuffer(reqBody))
+
+			rec := httptest.NewRecorder()
+
+			h.PostRecover(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
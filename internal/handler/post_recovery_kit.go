@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/recovery"
+	"github.com/gleb-korostelev/GophKeeper/tools/decoder"
+	"github.com/skip2/go-qrcode"
+)
+
+// qrSize is the width and height in pixels of the QR code images of recovery shares.
+const qrSize = 256
+
+// PostRecoveryKit handles the creation of a recovery kit. The user's vault key is split into shares,
+// any threshold of which recover the account; the shares are returned as text and QR codes exactly once.
+// Creating a kit invalidates the shares of the previous one.
+func (i *Implementation) PostRecoveryKit(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Retrieve the issuer (user ID or token subject) from the request context.
+	issuer, err := middleware.GetIssuer(ctx)
+	if err != nil {
+		handleErrResponse(rw, middleware.ErrTokenInvalid)
+		return
+	}
+
+	// Retrieve the user's account details from the authentication service.
+	var acc models.Account
+	acc, err = i.AuthSvc.GetAccountByUserName(ctx, issuer)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Ensure the user has sufficient rights to perform this action.
+	if acc.AccountType != models.AccountAuthorizedUser {
+		handleErrResponse(rw, middleware.ErrNotEnoughRights)
+		return
+	}
+
+	// Decode the request body to extract the vault key and the kit parameters.
+	req, err := decoder.DecodeJson[models.PostRecoveryKitReq](r.Body)
+	if err != nil {
+		if _, ok := err.(*json.SyntaxError); ok || strings.Contains(err.Error(), "invalid character") {
+			handleErrResponse(rw, errInvalidRequestBody)
+		} else {
+			handleErrResponse(rw, err)
+		}
+		return
+	}
+
+	// Split the vault key using the authentication service.
+	kit, shares, err := i.AuthSvc.CreateRecoveryKit(ctx, acc.Username, recovery.Setup{
+		Password:  req.Password,
+		VaultKey:  req.VaultKey,
+		Shares:    req.Shares,
+		Threshold: req.Threshold,
+	})
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Respond with the shares as text and QR codes.
+	resp, err := repackPostRecoveryKit(kit, shares)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+	response.OK(rw, resp)
+}
+
+// repackPostRecoveryKit converts a new recovery kit and its shares to the API response structure (PostRecoveryKitResp).
+func repackPostRecoveryKit(kit recovery.Kit, shares []recovery.Share) (models.PostRecoveryKitResp, error) {
+	resp := models.PostRecoveryKitResp{
+		KitID:     recovery.FormatKitID(kit.ID),
+		Threshold: kit.Threshold,
+		Shares:    make([]models.RecoveryShareResp, 0, len(shares)),
+	}
+	for _, share := range shares {
+		code := share.Code()
+		qr, err := qrcode.Encode(code, qrcode.Medium, qrSize)
+		if err != nil {
+			return models.PostRecoveryKitResp{}, fmt.Errorf("error in qrcode.Encode: %w", err)
+		}
+
+		resp.Shares = append(resp.Shares, models.RecoveryShareResp{Index: share.Index(), Code: code, QR: qr})
+	}
+	return resp, nil
+}
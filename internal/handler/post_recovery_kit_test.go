@@ -0,0 +1,176 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/recovery"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/skip2/go-qrcode"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostRecoveryKit(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+
+	vaultKey := bytes.Repeat([]byte{0x2a}, 16)
+	shares := []recovery.Share{
+		{KitID: 0xdeadbeef, Threshold: 2, Data: append(bytes.Repeat([]byte{0x01}, 16), 1)},
+		{KitID: 0xdeadbeef, Threshold: 2, Data: append(bytes.Repeat([]byte{0x02}, 16), 2)},
+	}
+	qr1, _ := qrcode.Encode(shares[0].Code(), qrcode.Medium, qrSize)
+	qr2, _ := qrcode.Encode(shares[1].Code(), qrcode.Medium, qrSize)
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		requestBody    interface{}
+		contextIssuer  string
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Successful creation",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAuthSvc.CreateRecoveryKitMock.Expect(
+					minimock.AnyContext, "test_user",
+					recovery.Setup{Password: "secret", VaultKey: vaultKey, Shares: 2, Threshold: 2},
+				).Return(recovery.Kit{ID: 0xdeadbeef, Username: "test_user", Shares: 2, Threshold: 2}, shares, nil)
+			},
+			requestBody: map[string]interface{}{
+				"password":  "secret",
+				"vault_key": vaultKey,
+				"shares":    2,
+				"threshold": 2,
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"kit_id":    "DEADBEEF",
+					"threshold": 2,
+					"shares": []map[string]interface{}{
+						{"index": 1, "code": shares[0].Code(), "qr": qr1},
+						{"index": 2, "code": shares[1].Code(), "qr": qr2},
+					},
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Invalid kit",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAuthSvc.CreateRecoveryKitMock.Expect(
+					minimock.AnyContext, "test_user",
+					recovery.Setup{Password: "secret", VaultKey: vaultKey, Shares: 2, Threshold: 3},
+				).Return(recovery.Kit{}, nil, svc.ErrInvalidRecoveryKit)
+			},
+			requestBody: map[string]interface{}{
+				"password":  "secret",
+				"vault_key": vaultKey,
+				"shares":    2,
+				"threshold": 3,
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "invalid recovery kit",
+			},
+		},
+		{
+			name: "Incorrect password",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAuthSvc.CreateRecoveryKitMock.Expect(
+					minimock.AnyContext, "test_user",
+					recovery.Setup{Password: "wrong", VaultKey: vaultKey, Shares: 2, Threshold: 2},
+				).Return(recovery.Kit{}, nil, svc.ErrIncorrectPassword)
+			},
+			requestBody: map[string]interface{}{
+				"password":  "wrong",
+				"vault_key": vaultKey,
+				"shares":    2,
+				"threshold": 2,
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "incorrect password",
+			},
+		},
+		{
+			name: "Not enough rights",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountUnauthorizedUser,
+				}, nil)
+			},
+			requestBody: map[string]interface{}{
+				"password": "secret",
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "not enough rights",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc: mockAuthSvc,
+			}
+
+			reqBody, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest("POST", "/api/v1/account/recovery", bytes.NewBuffer(reqBody))
+			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, tt.contextIssuer)
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+
+			h.PostRecoveryKit(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPostShareCard(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+	mockProfileSvc := MockService.NewProfileSvcMock(mc)
+
+	authorized := func() {
+		mockAuthSvc.GetAccountByUserNameMock.Expect(
+			minimock.AnyContext, "test_user",
+		).Return(models.Account{
+			Username:    "test_user",
+			AccountType: models.AccountAuthorizedUser,
+		}, nil)
+	}
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		requestBody    interface{}
+		contextIssuer  string
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Successful share",
+			setupMocks: func() {
+				authorized()
+				mockProfileSvc.ShareCardMock.Expect(
+					minimock.AnyContext,
+					profile.CardShare{
+						Owner:      "test_user",
+						Grantee:    "teammate",
+						CardNumber: "1234567812345678",
+						Permission: profile.PermissionRead,
+					},
+				).Return(nil)
+			},
+			requestBody: map[string]string{
+				"card_number": "1234567812345678",
+				"username":    "teammate",
+				"permission":  "read",
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"success": true,
+				"message": "Success",
+			},
+		},
+		{
+			name:       "Error retrieving issuer",
+			setupMocks: func() {},
+			requestBody: map[string]string{
+				"card_number": "1234567812345678",
+			},
+			contextIssuer:  "",
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "bearer token is not correct",
+			},
+		},
+		{
+			name: "Invalid permission",
+			setupMocks: func() {
+				authorized()
+				mockProfileSvc.ShareCardMock.Expect(
+					minimock.AnyContext,
+					profile.CardShare{
+						Owner:      "test_user",
+						Grantee:    "teammate",
+						CardNumber: "1234567812345678",
+						Permission: "admin",
+					},
+				).Return(svc.ErrInvalidPermission)
+			},
+			requestBody: map[string]string{
+				"card_number": "1234567812345678",
+				"username":    "teammate",
+				"permission":  "admin",
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "invalid permission",
+			},
+		},
+		{
+			name: "Card not owned",
+			setupMocks: func() {
+				authorized()
+				mockProfileSvc.ShareCardMock.Expect(
+					minimock.AnyContext,
+					profile.CardShare{
+						Owner:      "test_user",
+						Grantee:    "teammate",
+						CardNumber: "8765432187654321",
+						Permission: profile.PermissionReadWrite,
+					},
+				).Return(svc.ErrNotAuthorized)
+			},
+			requestBody: map[string]string{
+				"card_number": "8765432187654321",
+				"username":    "teammate",
+				"permission":  "read-write",
+			},
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "not authorized",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc:    mockAuthSvc,
+				ProfileSvc: mockProfileSvc,
+			}
+
+			reqBody, _ := json.Marshal(tt.requestBody)
+			req := httptest.NewRequest("POST", "/api/v1/cards/share", bytes.NewBuffer(reqBody))
+			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, tt.contextIssuer)
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+
+			h.PostShareCard(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
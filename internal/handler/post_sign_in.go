@@ -2,16 +2,21 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
+	"net"
 	"net/http"
 	"strings"
 
 	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
 	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/device"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
 	"github.com/gleb-korostelev/GophKeeper/tools/decoder"
 )
 
 // PostSignIn handles user authentication and generates access tokens.
 // This function validates the provided credentials and challenge, then issues tokens for authenticated users.
+// A client presenting a device binds the tokens to it; requests must then be signed with the device key.
 func (i *Implementation) PostSignIn(rw http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -39,10 +44,27 @@ func (i *Implementation) PostSignIn(rw http.ResponseWriter, r *http.Request) {
 		Password: req.Password,
 	}
 
+	// Create a device Registration object if the client presented a device.
+	var reg *device.Registration
+	if req.Device != nil {
+		reg = &device.Registration{
+			Name:      req.Device.Name,
+			Platform:  req.Device.Platform,
+			PublicKey: req.Device.PublicKey,
+			Signature: req.Device.Signature,
+		}
+	}
+
 	// Authenticate the user and generate tokens using the authentication service.
-	token, rToken, err := i.AuthSvc.SignIn(ctx, p, req.Challenge)
+	// Unknown users, wrong passwords and locked accounts fail alike, so that usernames cannot be probed.
+	// Wrong passwords lock out the client address they come from rather than the whole account.
+	token, rToken, err := i.AuthSvc.SignIn(ctx, p, req.Challenge, clientAddr(r), reg)
 	if err != nil {
-		handleErrResponse(rw, errAuthFailed)
+		if errors.Is(err, svc.ErrInvalidDevice) {
+			handleErrResponse(rw, err)
+		} else {
+			handleErrResponse(rw, errAuthFailed)
+		}
 		return
 	}
 
@@ -54,3 +76,12 @@ func (i *Implementation) PostSignIn(rw http.ResponseWriter, r *http.Request) {
 func repackPostSignIn(token, rToken string) models.PostSignInResp {
 	return models.PostSignInResp{Token: token, RefreshToken: rToken}
 }
+
+// clientAddr returns the IP address a request comes from, without its port.
+func clientAddr(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
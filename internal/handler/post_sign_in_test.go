@@ -10,6 +10,8 @@ import (
 
 	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
 	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/device"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/assert"
 )
@@ -33,6 +35,8 @@ func TestPostSignIn(t *testing.T) {
 					minimock.AnyContext,
 					models.Profile{Username: "test_user", Password: "secure_password"},
 					"valid_challenge",
+					"192.0.2.1", // httptest.NewRequest's client address
+					nil,
 				).Return("access_token", "refresh_token", nil)
 			},
 			requestBody: map[string]string{
@@ -81,6 +85,8 @@ func TestPostSignIn(t *testing.T) {
 					minimock.AnyContext,
 					models.Profile{Username: "test_user", Password: "secure_password"},
 					"valid_challenge",
+					"192.0.2.1",
+					nil,
 				).Return("", "", errors.New("authentication failed"))
 			},
 			requestBody: map[string]string{
@@ -94,6 +100,88 @@ func TestPostSignIn(t *testing.T) {
 				"message": "authentication failed",
 			},
 		},
+		{
+			name: "Locked account",
+			setupMocks: func() {
+				mockAuthSvc.SignInMock.Expect(
+					minimock.AnyContext,
+					models.Profile{Username: "test_user", Password: "secure_password"},
+					"valid_challenge",
+					"192.0.2.1",
+					nil,
+				).Return("", "", svc.ErrAccountLocked)
+			},
+			requestBody: map[string]string{
+				"username":  "test_user",
+				"password":  "secure_password",
+				"challenge": "valid_challenge",
+			},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "authentication failed",
+			},
+		},
+		{
+			name: "Successful sign-in with device",
+			setupMocks: func() {
+				mockAuthSvc.SignInMock.Expect(
+					minimock.AnyContext,
+					models.Profile{Username: "test_user", Password: "secure_password"},
+					"valid_challenge",
+					"192.0.2.1",
+					&device.Registration{
+						Name:      "laptop",
+						Platform:  "linux",
+						PublicKey: []byte("public_key"),
+						Signature: []byte("signature"),
+					},
+				).Return("access_token", "refresh_token", nil)
+			},
+			requestBody: map[string]interface{}{
+				"username":  "test_user",
+				"password":  "secure_password",
+				"challenge": "valid_challenge",
+				"device": map[string]interface{}{
+					"name":       "laptop",
+					"platform":   "linux",
+					"public_key": []byte("public_key"),
+					"signature":  []byte("signature"),
+				},
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"token":         "access_token",
+					"refresh_token": "refresh_token",
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Invalid device",
+			setupMocks: func() {
+				mockAuthSvc.SignInMock.Expect(
+					minimock.AnyContext,
+					models.Profile{Username: "test_user", Password: "secure_password"},
+					"valid_challenge",
+					"192.0.2.1",
+					&device.Registration{Name: "laptop"},
+				).Return("", "", svc.ErrInvalidDevice)
+			},
+			requestBody: map[string]interface{}{
+				"username":  "test_user",
+				"password":  "secure_password",
+				"challenge": "valid_challenge",
+				"device":    map[string]string{"name": "laptop"},
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "invalid device registration",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -105,10 +193,10 @@ func TestPostSignIn(t *testing.T) {
 			}
 
 			var reqBody []byte
-			if body, ok := tt.requestBody.(map[string]string); ok {
-				reqBody, _ = json.Marshal(body)
+			if body, ok := tt.requestBody.(string); ok {
+				reqBody = []byte(body)
 			} else {
-				reqBody = []byte(tt.requestBody.(string))
+				reqBody, _ = json.Marshal(tt.requestBody)
 			}
 
 			req := httptest.NewRequest("POST", "/api/v1/login", bytes.NewBuffer(reqBody))
@@ -57,10 +57,19 @@ func (i *Implementation) PostUploadInfo(rw http.ResponseWriter, r *http.Request)
 		ExpirationDate: req.ExpirationDate,
 		Cvv:            req.Cvv,
 		Metadata:       req.Metadata,
+		ItemKey:        req.ItemKey,
+		Owner:          req.Owner,
+		Name:           req.Name,
+		Type:           req.Type,
+		FolderID:       req.FolderID,
+		Tags:           req.Tags,
+		URL:            req.URL,
+		Login:          req.Login,
+		Password:       req.Password,
 	}
 
-	// Upload the card information using the profile service.
-	err = i.ProfileSvc.UploadInfo(ctx, p)
+	// Upload the card information using the profile service, within the scope of the API key making the request.
+	err = i.ProfileSvc.UploadInfo(ctx, p, middleware.GetAPIKeyScope(ctx))
 	if err != nil {
 		handleErrResponse(rw, err)
 		return
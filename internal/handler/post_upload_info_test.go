@@ -52,6 +52,7 @@ func TestPostUploadInfo(t *testing.T) {
 						Cvv:            "123",
 						Metadata:       "Test metadata",
 					},
+					nil,
 				).Return(nil)
 			},
 			contextIssuer: "test_user",
@@ -123,6 +124,7 @@ func TestPostUploadInfo(t *testing.T) {
 						Cvv:            "123",
 						Metadata:       "Test metadata",
 					},
+					nil,
 				).Return(errors.New("upload error"))
 			},
 			contextIssuer: "test_user",
@@ -0,0 +1,92 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/attachment"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gorilla/mux"
+)
+
+// PutAttachment handles uploading one chunk of an attachment's content.
+// The chunk position is given by the Content-Range header and must continue the upload
+// where it stopped; the response reports the new received offset.
+func (i *Implementation) PutAttachment(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Retrieve the issuer (user ID or token subject) from the request context.
+	issuer, err := middleware.GetIssuer(ctx)
+	if err != nil {
+		handleErrResponse(rw, middleware.ErrTokenInvalid)
+		return
+	}
+
+	// Retrieve the user's account details from the authentication service.
+	var acc models.Account
+	acc, err = i.AuthSvc.GetAccountByUserName(ctx, issuer)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Ensure the user has sufficient rights to perform this action.
+	if acc.AccountType != models.AccountAuthorizedUser {
+		handleErrResponse(rw, middleware.ErrNotEnoughRights)
+		return
+	}
+
+	// Parse the position of the chunk within the attachment.
+	rng, err := parseContentRange(r.Header.Get(HeaderContentRange))
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Never read more than a single chunk from the request body.
+	body := http.MaxBytesReader(rw, r.Body, attachment.MaxChunkSize)
+
+	// Store the chunk using the attachment service.
+	a, err := i.AttachmentSvc.UploadChunk(ctx, acc.Username, mux.Vars(r)[AttachmentIDPathVar], rng, body)
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Respond with the upload state.
+	response.OK(rw, repackAttachment(a))
+}
+
+// parseContentRange parses a "bytes <start>-<end>/<total>" Content-Range header.
+func parseContentRange(header string) (rng attachment.Range, err error) {
+	spec, ok := strings.CutPrefix(header, "bytes ")
+	if !ok {
+		return rng, svc.ErrInvalidRange
+	}
+
+	span, total, ok := strings.Cut(spec, "/")
+	if !ok {
+		return rng, svc.ErrInvalidRange
+	}
+	start, end, ok := strings.Cut(span, "-")
+	if !ok {
+		return rng, svc.ErrInvalidRange
+	}
+
+	if rng.Start, err = strconv.ParseInt(start, 10, 64); err != nil {
+		return rng, errors.Join(svc.ErrInvalidRange, err)
+	}
+	if rng.End, err = strconv.ParseInt(end, 10, 64); err != nil {
+		return rng, errors.Join(svc.ErrInvalidRange, err)
+	}
+	if rng.Total, err = strconv.ParseInt(total, 10, 64); err != nil {
+		return rng, errors.Join(svc.ErrInvalidRange, err)
+	}
+
+	return rng, nil
+}
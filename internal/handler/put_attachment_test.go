@@ -0,0 +1,194 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/attachment"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPutAttachment(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAuthSvc := MockService.NewAuthSvcMock(mc)
+	mockAttachmentSvc := MockService.NewAttachmentSvcMock(mc)
+
+	const id = "8d3c1c2e-4f3a-4b8a-9a0c-2f5d2c1e7b6a"
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		contentRange   string
+		chunk          string
+		contextIssuer  string
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Successful chunk",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAttachmentSvc.UploadChunkMock.
+					ExpectUsernameParam2("test_user").
+					ExpectIdParam3(id).
+					ExpectRngParam4(attachment.Range{Start: 0, End: 3, Total: 8}).
+					Return(attachment.Attachment{
+						ID:          id,
+						Name:        "key.enc",
+						ContentType: "application/octet-stream",
+						Size:        8,
+						Received:    4,
+						SHA256:      "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+						CreatedAt:   time.Date(2025, 2, 25, 12, 0, 0, 0, time.UTC),
+					}, nil)
+			},
+			contentRange:   "bytes 0-3/8",
+			chunk:          "test",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"id":           id,
+					"name":         "key.enc",
+					"content_type": "application/octet-stream",
+					"size":         8,
+					"received":     4,
+					"sha256":       "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+					"complete":     false,
+					"created_at":   "2025-02-25T12:00:00Z",
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Chunk out of order",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAttachmentSvc.UploadChunkMock.
+					ExpectUsernameParam2("test_user").
+					ExpectIdParam3(id).
+					ExpectRngParam4(attachment.Range{Start: 4, End: 7, Total: 8}).
+					Return(attachment.Attachment{}, svc.ErrRangeMismatch)
+			},
+			contentRange:   "bytes 4-7/8",
+			chunk:          "test",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusConflict,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "content range does not continue the upload",
+			},
+		},
+		{
+			name: "Checksum mismatch",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+				mockAttachmentSvc.UploadChunkMock.
+					ExpectUsernameParam2("test_user").
+					ExpectIdParam3(id).
+					ExpectRngParam4(attachment.Range{Start: 4, End: 7, Total: 8}).
+					Return(attachment.Attachment{}, svc.ErrChecksumMismatch)
+			},
+			contentRange:   "bytes 4-7/8",
+			chunk:          "test",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "checksum mismatch",
+			},
+		},
+		{
+			name: "Invalid content range",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountAuthorizedUser,
+				}, nil)
+			},
+			contentRange:   "bytes=0-3",
+			chunk:          "test",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "invalid content range",
+			},
+		},
+		{
+			name: "Not enough rights",
+			setupMocks: func() {
+				mockAuthSvc.GetAccountByUserNameMock.Expect(
+					minimock.AnyContext, "test_user",
+				).Return(models.Account{
+					Username:    "test_user",
+					AccountType: models.AccountUnauthorizedUser,
+				}, nil)
+			},
+			contentRange:   "bytes 0-3/8",
+			chunk:          "test",
+			contextIssuer:  "test_user",
+			expectedStatus: http.StatusForbidden,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "not enough rights",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AuthSvc:       mockAuthSvc,
+				AttachmentSvc: mockAttachmentSvc,
+			}
+
+			req := httptest.NewRequest("PUT", "/api/v1/attachments/"+id, strings.NewReader(tt.chunk))
+			req.Header.Set(HeaderContentRange, tt.contentRange)
+			req = mux.SetURLVars(req, map[string]string{AttachmentIDPathVar: id})
+			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, tt.contextIssuer)
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+
+			h.PutAttachment(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/quota"
+	"github.com/gleb-korostelev/GophKeeper/tools/decoder"
+	"github.com/gorilla/mux"
+)
+
+// UsernamePathVar is the route variable holding the username of the user an admin manages.
+const UsernamePathVar = "username"
+
+// PutQuotaOverride handles an admin replacing the limits of a user's plan with limits set for the user alone,
+// and reports the user's usage against their new limits.
+func (i *Implementation) PutQuotaOverride(rw http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Retrieve the issuer (user ID or token subject) from the request context.
+	issuer, err := middleware.GetIssuer(ctx)
+	if err != nil {
+		handleErrResponse(rw, middleware.ErrTokenInvalid)
+		return
+	}
+
+	// Decode the request body to extract the overridden limits.
+	req, err := decoder.DecodeJson[models.PutQuotaOverrideReq](r.Body)
+	if err != nil {
+		if _, ok := err.(*json.SyntaxError); ok || strings.Contains(err.Error(), "invalid character") {
+			handleErrResponse(rw, errInvalidRequestBody)
+		} else {
+			handleErrResponse(rw, err)
+		}
+		return
+	}
+
+	// Set the override using the account service.
+	report, err := i.AccountSvc.SetQuotaOverride(ctx, issuer, mux.Vars(r)[UsernamePathVar], quota.Override{
+		Items:          req.Items,
+		Bytes:          req.Bytes,
+		AttachmentSize: req.AttachmentSize,
+		APIKeys:        req.APIKeys,
+		Devices:        req.Devices,
+	})
+	if err != nil {
+		handleErrResponse(rw, err)
+		return
+	}
+
+	// Respond with the usage and limits of the user.
+	response.OK(rw, report)
+}
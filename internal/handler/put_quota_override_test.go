@@ -0,0 +1,147 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gleb-korostelev/GophKeeper/middleware"
+	MockService "github.com/gleb-korostelev/GophKeeper/mocks"
+	"github.com/gleb-korostelev/GophKeeper/models/quota"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gojuno/minimock/v3"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPutQuotaOverride(t *testing.T) {
+	mc := minimock.NewController(t)
+
+	mockAccountSvc := MockService.NewAccountSvcMock(mc)
+
+	items, negative := int64(20000), int64(-1)
+
+	tests := []struct {
+		name           string
+		setupMocks     func()
+		username       string
+		requestBody    interface{}
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Successful override",
+			setupMocks: func() {
+				mockAccountSvc.SetQuotaOverrideMock.Expect(
+					minimock.AnyContext, "admin_user", "test_user", quota.Override{Items: &items},
+				).Return(quota.Report{
+					Username: "test_user",
+					Plan:     quota.PlanUser,
+					Usage:    quota.Usage{Items: 12},
+					Limits:   quota.Limits{Items: 20000},
+					Override: quota.Override{Items: &items},
+				}, nil)
+			},
+			username:       "test_user",
+			requestBody:    map[string]interface{}{"items": 20000},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"data": map[string]interface{}{
+					"username": "test_user",
+					"plan":     "user",
+					"usage": map[string]interface{}{
+						"items":    12,
+						"bytes":    0,
+						"api_keys": 0,
+						"devices":  0,
+					},
+					"limits": map[string]interface{}{
+						"items":           20000,
+						"bytes":           0,
+						"attachment_size": 0,
+						"api_keys":        0,
+						"devices":         0,
+					},
+					"override": map[string]interface{}{"items": 20000},
+				},
+				"message": "Success",
+				"success": true,
+			},
+		},
+		{
+			name: "Negative limit",
+			setupMocks: func() {
+				mockAccountSvc.SetQuotaOverrideMock.Expect(
+					minimock.AnyContext, "admin_user", "test_user", quota.Override{Devices: &negative},
+				).Return(quota.Report{}, svc.ErrInvalidQuota)
+			},
+			username:       "test_user",
+			requestBody:    map[string]interface{}{"devices": -1},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "invalid quota",
+			},
+		},
+		{
+			name: "Account not found",
+			setupMocks: func() {
+				mockAccountSvc.SetQuotaOverrideMock.Expect(
+					minimock.AnyContext, "admin_user", "missing_user", quota.Override{},
+				).Return(quota.Report{}, svc.ErrAccountNotFound)
+			},
+			username:       "missing_user",
+			requestBody:    map[string]interface{}{},
+			expectedStatus: http.StatusNotFound,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "account not found",
+			},
+		},
+		{
+			name:           "Invalid request body",
+			setupMocks:     func() {},
+			username:       "test_user",
+			requestBody:    "invalid_json",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"success": false,
+				"message": "invalid request body",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.setupMocks()
+
+			h := &Implementation{
+				AccountSvc: mockAccountSvc,
+			}
+
+			var reqBody []byte
+			if body, ok := tt.requestBody.(string); ok {
+				reqBody = []byte(body)
+			} else {
+				reqBody, _ = json.Marshal(tt.requestBody)
+			}
+
+			req := httptest.NewRequest("PUT", "/api/v1/admin/users/"+tt.username+"/quota", bytes.NewBuffer(reqBody))
+			req = mux.SetURLVars(req, map[string]string{UsernamePathVar: tt.username})
+			ctx := context.WithValue(req.Context(), middleware.CtxKeyUserID, "admin_user")
+			req = req.WithContext(ctx)
+
+			rec := httptest.NewRecorder()
+
+			h.PutQuotaOverride(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			expectedJSON, _ := json.Marshal(tt.expectedBody)
+			assert.JSONEq(t, string(expectedJSON), rec.Body.String())
+		})
+	}
+}
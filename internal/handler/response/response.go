@@ -44,6 +44,16 @@ func Forbidden(rw http.ResponseWriter, message string) {
 	result(rw, http.StatusForbidden, message, nil)
 }
 
+// Conflict sends a 409 Conflict HTTP response with the provided error message.
+func Conflict(rw http.ResponseWriter, message string) {
+	result(rw, http.StatusConflict, message, nil)
+}
+
+// TooLarge sends a 413 Request Entity Too Large HTTP response with the provided error message.
+func TooLarge(rw http.ResponseWriter, message string) {
+	result(rw, http.StatusRequestEntityTooLarge, message, nil)
+}
+
 // Internal sends a 500 Internal Server Error HTTP response with the provided error message.
 func Internal(rw http.ResponseWriter, message string) {
 	result(rw, http.StatusInternalServerError, message, nil)
@@ -63,11 +73,19 @@ func result(rw http.ResponseWriter, status int, message string, data any) {
 	})
 }
 
-// Healthcheck sends a 200 OK HTTP response indicating the health status of the application.
-func Healthcheck(rw http.ResponseWriter) {
+// JSON sends a 200 OK HTTP response with the data as is, without the envelope of Response,
+// for documents in a standard format.
+func JSON(rw http.ResponseWriter, data any) {
 	rw.Header().Set("Content-Type", "application/json")
 	rw.WriteHeader(http.StatusOK)
-	json.NewEncoder(rw).Encode(HealthcheckResp{
-		Status: "healthy",
-	})
+	json.NewEncoder(rw).Encode(data)
+}
+
+// Health sends a health check result with the given status code, without the envelope of Response,
+// as load balancers and orchestrators expect.
+func Health(rw http.ResponseWriter, status int, data any) {
+	rw.Header().Set("Content-Type", "application/json")
+	rw.Header().Set("Cache-Control", "no-store")
+	rw.WriteHeader(status)
+	json.NewEncoder(rw).Encode(data)
 }
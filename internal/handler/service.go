@@ -2,43 +2,177 @@ package handler
 
 import (
 	"context"
+	"io"
 	"net/http"
 
 	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/account"
+	"github.com/gleb-korostelev/GophKeeper/models/apikey"
+	"github.com/gleb-korostelev/GophKeeper/models/attachment"
+	"github.com/gleb-korostelev/GophKeeper/models/device"
+	"github.com/gleb-korostelev/GophKeeper/models/emergency"
+	"github.com/gleb-korostelev/GophKeeper/models/org"
 	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/gleb-korostelev/GophKeeper/models/quota"
+	"github.com/gleb-korostelev/GophKeeper/models/recovery"
+	"github.com/gleb-korostelev/GophKeeper/models/send"
+	"github.com/gleb-korostelev/GophKeeper/models/transfer"
+	"github.com/gleb-korostelev/GophKeeper/pkg/keyring"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
+	"github.com/gleb-korostelev/GophKeeper/tools/health"
 )
 
 // API defines the interface for the handler's API.
 // Each method corresponds to an HTTP endpoint and performs a specific operation.
 //
 // Methods:
-// - Healthcheck: Checks the health status of the application.
+// - GetLivez: Reports that the application is alive.
+// - GetReadyz: Reports whether the dependencies of the application are usable.
 // - PostSignIn: Handles user sign-in and generates tokens.
 // - PostCreateProfile: Creates a new user profile.
 // - PostChallenge: Retrieves a challenge for user authentication.
 // - PostUploadInfo: Uploads or updates card information for a user.
 // - GetUserCards: Retrieves all cards associated with a user.
 // - DeleteCardInfo: Deletes a specific card associated with a user.
+// - PostShareCard: Grants another user access to a card.
+// - DeleteCardShare: Revokes another user's access to a card.
+// - PostPublicKey: Publishes the user's public key for wrapping shared item keys.
+// - GetPublicKey: Retrieves another user's public key.
+// - PostCreateOrg: Creates an organization owned by the user.
+// - GetUserOrgs: Retrieves the organizations the user belongs to.
+// - PostOrgMember: Adds a member to an organization or changes their role.
+// - DeleteOrgMember: Removes a member from an organization.
+// - PostOrgGroup: Creates or replaces a group within an organization.
+// - PostOrgCollection: Creates or replaces a collection within an organization.
+// - PostCollectionCard: Adds a card to an organization collection.
+// - GetOrgCards: Retrieves the organization cards visible to the user.
+// - PostCreateSend: Creates a one-time share link.
+// - GetSend: Opens a one-time share link without authentication.
+// - PostEmergencyContact: Designates a trusted contact for emergency access.
+// - DeleteEmergencyContact: Removes a trusted contact.
+// - GetEmergencyContacts: Retrieves the user's emergency contacts in both directions.
+// - PostEmergencyRequest: Requests emergency access to a grantor's vault.
+// - PostEmergencyApprove: Approves a pending emergency access request.
+// - PostEmergencyReject: Rejects a pending emergency access request.
+// - GetEmergencyCards: Retrieves a grantor's cards after emergency access was approved.
+// - PostFolder: Creates a folder, optionally nested in another folder.
+// - GetFolders: Retrieves the user's folders.
+// - DeleteFolder: Deletes a folder and its subfolders.
+// - GetTags: Retrieves the user's tags.
+// - DeleteTag: Deletes a tag from all of the user's cards.
+// - GetSearch: Performs a full-text search over the user's cards.
+// - PostAttachment: Registers a file attachment on one of the user's cards.
+// - PutAttachment: Uploads one chunk of an attachment's content.
+// - GetAttachments: Retrieves the attachments of a card with their upload state.
+// - GetAttachment: Streams the content of an attachment.
+// - DeleteAttachment: Deletes an attachment.
+// - PostImport: Imports the export of another password manager.
+// - GetExport: Exports the user's vault.
+// - PostChangePassword: Changes the user's master password.
+// - GetAccountData: Exports all personal data held about the user.
+// - GetAccountUsage: Reports what the user stores against the limits of their plan.
+// - DeleteAccount: Deletes the user's account and everything it owns.
+// - PostRecoveryKit: Creates a recovery kit for the user's vault key.
+// - GetRecoveryKit: Retrieves the user's recovery kit.
+// - DeleteRecoveryKit: Removes the user's recovery kit.
+// - PostRecover: Recovers an account with recovery shares and sets a new password.
+// - GetDevices: Retrieves the user's devices.
+// - DeleteDevice: Removes one of the user's devices and signs it out.
+// - PostAPIKey: Creates a personal API key.
+// - GetAPIKeys: Retrieves the user's API keys.
+// - DeleteAPIKey: Revokes one of the user's API keys.
+// - GetJWKS: Publishes the public keys verifying access tokens.
+// - GetHealth: Reports the result of each dependency check to administrators.
+// - PutQuotaOverride: Overrides the limits of a user for administrators.
 type API interface {
-	Healthcheck(rw http.ResponseWriter, r *http.Request)
+	GetLivez(rw http.ResponseWriter, r *http.Request)
+	GetReadyz(rw http.ResponseWriter, r *http.Request)
 	PostSignIn(rw http.ResponseWriter, r *http.Request)
 	PostCreateProfile(rw http.ResponseWriter, r *http.Request)
 	PostChallenge(rw http.ResponseWriter, r *http.Request)
 	PostUploadInfo(rw http.ResponseWriter, r *http.Request)
 	GetUserCards(rw http.ResponseWriter, r *http.Request)
 	DeleteCardInfo(rw http.ResponseWriter, r *http.Request)
+	PostShareCard(rw http.ResponseWriter, r *http.Request)
+	DeleteCardShare(rw http.ResponseWriter, r *http.Request)
+	PostPublicKey(rw http.ResponseWriter, r *http.Request)
+	GetPublicKey(rw http.ResponseWriter, r *http.Request)
+	PostCreateOrg(rw http.ResponseWriter, r *http.Request)
+	GetUserOrgs(rw http.ResponseWriter, r *http.Request)
+	PostOrgMember(rw http.ResponseWriter, r *http.Request)
+	DeleteOrgMember(rw http.ResponseWriter, r *http.Request)
+	PostOrgGroup(rw http.ResponseWriter, r *http.Request)
+	PostOrgCollection(rw http.ResponseWriter, r *http.Request)
+	PostCollectionCard(rw http.ResponseWriter, r *http.Request)
+	GetOrgCards(rw http.ResponseWriter, r *http.Request)
+	PostCreateSend(rw http.ResponseWriter, r *http.Request)
+	GetSend(rw http.ResponseWriter, r *http.Request)
+	PostEmergencyContact(rw http.ResponseWriter, r *http.Request)
+	DeleteEmergencyContact(rw http.ResponseWriter, r *http.Request)
+	GetEmergencyContacts(rw http.ResponseWriter, r *http.Request)
+	PostEmergencyRequest(rw http.ResponseWriter, r *http.Request)
+	PostEmergencyApprove(rw http.ResponseWriter, r *http.Request)
+	PostEmergencyReject(rw http.ResponseWriter, r *http.Request)
+	GetEmergencyCards(rw http.ResponseWriter, r *http.Request)
+	PostFolder(rw http.ResponseWriter, r *http.Request)
+	GetFolders(rw http.ResponseWriter, r *http.Request)
+	DeleteFolder(rw http.ResponseWriter, r *http.Request)
+	GetTags(rw http.ResponseWriter, r *http.Request)
+	DeleteTag(rw http.ResponseWriter, r *http.Request)
+	GetSearch(rw http.ResponseWriter, r *http.Request)
+	PostAttachment(rw http.ResponseWriter, r *http.Request)
+	PutAttachment(rw http.ResponseWriter, r *http.Request)
+	GetAttachments(rw http.ResponseWriter, r *http.Request)
+	GetAttachment(rw http.ResponseWriter, r *http.Request)
+	DeleteAttachment(rw http.ResponseWriter, r *http.Request)
+	PostImport(rw http.ResponseWriter, r *http.Request)
+	GetExport(rw http.ResponseWriter, r *http.Request)
+	PostChangePassword(rw http.ResponseWriter, r *http.Request)
+	GetAccountData(rw http.ResponseWriter, r *http.Request)
+	GetAccountUsage(rw http.ResponseWriter, r *http.Request)
+	DeleteAccount(rw http.ResponseWriter, r *http.Request)
+	PostRecoveryKit(rw http.ResponseWriter, r *http.Request)
+	GetRecoveryKit(rw http.ResponseWriter, r *http.Request)
+	DeleteRecoveryKit(rw http.ResponseWriter, r *http.Request)
+	PostRecover(rw http.ResponseWriter, r *http.Request)
+	GetDevices(rw http.ResponseWriter, r *http.Request)
+	DeleteDevice(rw http.ResponseWriter, r *http.Request)
+	PostAPIKey(rw http.ResponseWriter, r *http.Request)
+	GetAPIKeys(rw http.ResponseWriter, r *http.Request)
+	DeleteAPIKey(rw http.ResponseWriter, r *http.Request)
+	GetJWKS(rw http.ResponseWriter, r *http.Request)
+	GetHealth(rw http.ResponseWriter, r *http.Request)
+	PutQuotaOverride(rw http.ResponseWriter, r *http.Request)
 }
 
 // ProfileSvc defines the interface for interacting with the profile service.
 //
 // Methods:
-// - UploadInfo: Uploads or updates card information for a specific user.
-// - GetUserCards: Retrieves all cards associated with a username.
-// - DeleteCard: Deletes a specific card for a user based on username and card number.
+// - UploadInfo: Uploads or updates card information for a specific user, within the scope of an API key if given.
+// - GetUserCards: Retrieves a page of the cards associated with a username that match a filter.
+// - DeleteCard: Deletes a specific card for a user based on username and card number, within the scope
+// of an API key if given.
+// - ShareCard: Grants another user read or read-write access to a card.
+// - RevokeShare: Revokes a grantee's access to a card.
+// - CreateFolder: Creates a folder and returns its identifier.
+// - GetFolders: Retrieves all folders of a user.
+// - DeleteFolder: Deletes a folder and its subfolders.
+// - GetTags: Retrieves all tags of a user.
+// - DeleteTag: Removes a tag from all of a user's cards.
+// - Search: Performs a full-text search over a user's cards.
 type ProfileSvc interface {
-	UploadInfo(ctx context.Context, profile profile.CardInfo) (err error)
-	GetUserCards(ctx context.Context, username string) ([]profile.CardInfo, error)
-	DeleteCard(ctx context.Context, username, cardNumber string) (err error)
+	UploadInfo(ctx context.Context, profile profile.CardInfo, scope *apikey.Scope) (err error)
+	GetUserCards(ctx context.Context, username string, filter profile.Filter, page pagination.Request) (
+		cards []profile.CardInfo, next string, err error)
+	DeleteCard(ctx context.Context, username, cardNumber string, scope *apikey.Scope) (err error)
+	ShareCard(ctx context.Context, share profile.CardShare) (err error)
+	RevokeShare(ctx context.Context, owner, grantee, cardNumber string) (err error)
+	CreateFolder(ctx context.Context, folder profile.Folder) (id int64, err error)
+	GetFolders(ctx context.Context, username string) ([]profile.Folder, error)
+	DeleteFolder(ctx context.Context, username string, id int64) (err error)
+	GetTags(ctx context.Context, username string) ([]profile.Tag, error)
+	DeleteTag(ctx context.Context, username, name string) (err error)
+	Search(ctx context.Context, username, text string, page pagination.Request) (cards []profile.CardInfo, next string, err error)
 }
 
 // AuthSvc defines the interface for interacting with the authentication service.
@@ -46,13 +180,150 @@ type ProfileSvc interface {
 // Methods:
 // - CreateProfile: Creates a new user profile and generates a challenge for authentication.
 // - GetChallenge: Retrieves an authentication challenge for a user.
-// - SignIn: Authenticates a user and generates an access token and refresh token.
+// - SignIn: Authenticates a user from a client address, registers the device they sign in from and
+// generates an access token and refresh token.
 // - GetAccountByUserName: Retrieves account details for a specific username.
+// - SetPublicKey: Publishes the public key other users wrap shared item keys to.
+// - ChangePassword: Changes a user's master password, re-keys the vault and revokes the other sessions.
+// - SessionActive: Reports whether a sign-in session is still valid and returns its device key.
+// - CreateRecoveryKit: Splits a user's vault key into recovery shares.
+// - GetRecoveryKit: Retrieves the recovery kit of a user.
+// - DeleteRecoveryKit: Removes the recovery kit of a user.
+// - Recover: Reconstructs a user's vault key from recovery shares and sets a new password.
+// - GetDevices: Retrieves a page of the devices a user signed in from.
+// - DeleteDevice: Removes a device of a user and revokes its sessions.
+// - CreateAPIKey: Creates a personal API key with a name, scope and expiry.
+// - GetAPIKeys: Retrieves a page of the API keys of a user.
+// - RevokeAPIKey: Removes an API key of a user.
+// - AuthenticateAPIKey: Reports whether an API key is valid and records its use.
+// - GetJWKS: Retrieves the public keys verifying access tokens.
 type AuthSvc interface {
 	CreateProfile(ctx context.Context, profile models.Profile) (challenge string, err error)
 	GetChallenge(ctx context.Context, profile models.Profile) (challenge string, err error)
-	SignIn(ctx context.Context, profile models.Profile, challenge string) (token, refresh string, err error)
+	SignIn(ctx context.Context, profile models.Profile, challenge, client string, reg *device.Registration) (
+		token, refresh string, err error)
 	GetAccountByUserName(ctx context.Context, username string) (acc models.Account, err error)
+	SetPublicKey(ctx context.Context, username string, publicKey []byte) (err error)
+	ChangePassword(ctx context.Context, username, sessionID string, change models.PasswordChange) (revoked int64, err error)
+	SessionActive(ctx context.Context, sessionID string) (active bool, deviceKey []byte, err error)
+	CreateRecoveryKit(ctx context.Context, username string, setup recovery.Setup) (
+		kit recovery.Kit, shares []recovery.Share, err error)
+	GetRecoveryKit(ctx context.Context, username string) (kit recovery.Kit, err error)
+	DeleteRecoveryKit(ctx context.Context, username string) (err error)
+	Recover(ctx context.Context, username string, codes []string, change models.PasswordChange) (
+		vaultKey []byte, revoked int64, err error)
+	GetDevices(ctx context.Context, username, sessionID string, page pagination.Request) (
+		devices []device.Device, next string, err error)
+	DeleteDevice(ctx context.Context, username, id string) (revoked int64, err error)
+	CreateAPIKey(ctx context.Context, username string, k apikey.Key) (key apikey.Key, token string, err error)
+	GetAPIKeys(ctx context.Context, username string, page pagination.Request) (keys []apikey.Key, next string, err error)
+	RevokeAPIKey(ctx context.Context, username, id string) (err error)
+	AuthenticateAPIKey(ctx context.Context, token string) (key apikey.Key, ok bool, err error)
+	GetJWKS(ctx context.Context) (jwks keyring.JWKS, err error)
+}
+
+// OrgSvc defines the interface for interacting with the organization service.
+//
+// Methods:
+// - CreateOrg: Creates an organization owned by the given user.
+// - GetUserOrgs: Retrieves the organizations a user belongs to.
+// - SetMember: Adds a member to an organization or changes their role.
+// - RemoveMember: Removes a member from an organization.
+// - SetGroup: Creates or replaces a group within an organization.
+// - SetCollection: Creates or replaces a collection within an organization.
+// - AddCollectionCard: Adds a card owned by the user to an organization collection.
+// - GetOrgCards: Retrieves a page of the organization cards visible to a user.
+type OrgSvc interface {
+	CreateOrg(ctx context.Context, username, name string) (err error)
+	GetUserOrgs(ctx context.Context, username string) ([]org.Member, error)
+	SetMember(ctx context.Context, actor string, member org.Member) (err error)
+	RemoveMember(ctx context.Context, actor, orgName, username string) (err error)
+	SetGroup(ctx context.Context, group org.Group) (err error)
+	SetCollection(ctx context.Context, collection org.Collection) (err error)
+	AddCollectionCard(ctx context.Context, username, orgName, collection, cardNumber string) (err error)
+	GetOrgCards(ctx context.Context, username, orgName string, page pagination.Request) (
+		cards []profile.CardInfo, next string, err error)
+}
+
+// SendSvc defines the interface for interacting with the one-time share link service.
+//
+// Methods:
+// - CreateSend: Stores a new send, optionally protected by a password, and returns its identifier.
+// - OpenSend: Consumes one view of a send and returns it.
+type SendSvc interface {
+	CreateSend(ctx context.Context, sd send.Send, password string) (id string, err error)
+	OpenSend(ctx context.Context, id, password string) (send.Send, error)
+}
+
+// EmergencySvc defines the interface for interacting with the emergency access service.
+//
+// Methods:
+// - AddContact: Designates a trusted contact or changes their wait period.
+// - RemoveContact: Removes a trusted contact and revokes any granted access.
+// - GetContacts: Retrieves the contacts a user designated and those that designated the user.
+// - RequestAccess: Starts the wait period for a grantee's access to a grantor's vault.
+// - Approve: Grants a pending request before its wait period ends.
+// - Reject: Denies a pending request.
+// - GetGrantorCards: Retrieves a page of a grantor's cards for a grantee whose access was approved.
+type EmergencySvc interface {
+	AddContact(ctx context.Context, contact emergency.Contact) (err error)
+	RemoveContact(ctx context.Context, grantor, grantee string) (err error)
+	GetContacts(ctx context.Context, username string) ([]emergency.Contact, error)
+	RequestAccess(ctx context.Context, grantee, grantor string) (err error)
+	Approve(ctx context.Context, grantor, grantee string) (err error)
+	Reject(ctx context.Context, grantor, grantee string) (err error)
+	GetGrantorCards(ctx context.Context, grantee, grantor string, page pagination.Request) (
+		cards []profile.CardInfo, next string, err error)
+}
+
+// AttachmentSvc defines the interface for interacting with the attachment service.
+//
+// Methods:
+// - CreateAttachment: Registers a new attachment on one of a user's cards and returns its identifier.
+// - UploadChunk: Stores one chunk of an attachment's content and returns the upload state.
+// - GetAttachments: Retrieves a page of the attachments of one of a user's cards.
+// - OpenAttachment: Returns a fully uploaded attachment and a reader streaming its content.
+// - DeleteAttachment: Removes an attachment and its content.
+type AttachmentSvc interface {
+	CreateAttachment(ctx context.Context, a attachment.Attachment) (id string, err error)
+	UploadChunk(ctx context.Context, username, id string, rng attachment.Range, body io.Reader) (attachment.Attachment, error)
+	GetAttachments(ctx context.Context, username, cardNumber string, page pagination.Request) (
+		attachments []attachment.Attachment, next string, err error)
+	OpenAttachment(ctx context.Context, username, id string) (attachment.Attachment, io.ReadCloser, error)
+	DeleteAttachment(ctx context.Context, username, id string) (err error)
+}
+
+// TransferSvc defines the interface for interacting with the transfer service.
+//
+// Methods:
+// - Import: Imports the export of another password manager or a GophKeeper archive into a user's vault
+// and reports duplicates.
+// - Export: Exports a user's vault as an encrypted archive or, if confirmed, as plaintext.
+type TransferSvc interface {
+	Import(ctx context.Context, username, format string, r io.Reader, password string, dryRun bool) (transfer.Report, error)
+	Export(ctx context.Context, username string, opts transfer.ExportOptions) (transfer.Export, error)
+}
+
+// AccountSvc defines the interface for interacting with the account service.
+//
+// Methods:
+// - GetPersonalData: Collects all personal data and events held about a user.
+// - DeleteAccount: Deletes a user's account after verifying their password.
+// - GetUsage: Reports what a user stores and the limits it counts against.
+// - SetQuotaOverride: Replaces the limits an admin overrides for a user.
+type AccountSvc interface {
+	GetPersonalData(ctx context.Context, username string) (account.PersonalData, error)
+	DeleteAccount(ctx context.Context, username, password string) (err error)
+	GetUsage(ctx context.Context, username string) (quota.Report, error)
+	SetQuotaOverride(ctx context.Context, admin, username string, o quota.Override) (quota.Report, error)
+}
+
+// HealthSvc defines the interface for checking the dependencies of the application.
+//
+// Methods:
+// - Check: Runs the checks of all dependencies and reports their results.
+type HealthSvc interface {
+	Check(ctx context.Context) health.Report
 }
 
 // Implementation provides the concrete implementation of the API interface.
@@ -61,9 +332,23 @@ type AuthSvc interface {
 // Fields:
 // - ProfileSvc: The service responsible for managing user profiles.
 // - AuthSvc: The service responsible for managing authentication.
+// - OrgSvc: The service responsible for managing organizations.
+// - SendSvc: The service responsible for one-time share links.
+// - EmergencySvc: The service responsible for emergency access.
+// - AttachmentSvc: The service responsible for file attachments.
+// - TransferSvc: The service responsible for imports and exports.
+// - AccountSvc: The service responsible for account data exports, deletion and usage.
+// - HealthSvc: The checks of the dependencies of the application.
 type Implementation struct {
-	ProfileSvc ProfileSvc
-	AuthSvc    AuthSvc
+	ProfileSvc    ProfileSvc
+	AuthSvc       AuthSvc
+	OrgSvc        OrgSvc
+	SendSvc       SendSvc
+	EmergencySvc  EmergencySvc
+	AttachmentSvc AttachmentSvc
+	TransferSvc   TransferSvc
+	AccountSvc    AccountSvc
+	HealthSvc     HealthSvc
 }
 
 // NewImplementation creates a new instance of the API implementation.
@@ -71,9 +356,33 @@ type Implementation struct {
 // Parameters:
 // - profileSvc: The service for managing user profile operations.
 // - authSvc: The service for managing authentication operations.
-func NewImplementation(profileSvc ProfileSvc, authSvc AuthSvc) API {
+// - orgSvc: The service for managing organizations.
+// - sendSvc: The service for one-time share links.
+// - emergencySvc: The service for emergency access.
+// - attachmentSvc: The service for file attachments.
+// - transferSvc: The service for imports.
+// - accountSvc: The service for account data exports and deletion.
+// - healthSvc: The checks of the dependencies of the application.
+func NewImplementation(
+	profileSvc ProfileSvc,
+	authSvc AuthSvc,
+	orgSvc OrgSvc,
+	sendSvc SendSvc,
+	emergencySvc EmergencySvc,
+	attachmentSvc AttachmentSvc,
+	transferSvc TransferSvc,
+	accountSvc AccountSvc,
+	healthSvc HealthSvc,
+) API {
 	return &Implementation{
-		ProfileSvc: profileSvc,
-		AuthSvc:    authSvc,
+		ProfileSvc:    profileSvc,
+		AuthSvc:       authSvc,
+		OrgSvc:        orgSvc,
+		SendSvc:       sendSvc,
+		EmergencySvc:  emergencySvc,
+		AttachmentSvc: attachmentSvc,
+		TransferSvc:   transferSvc,
+		AccountSvc:    accountSvc,
+		HealthSvc:     healthSvc,
 	}
 }
@@ -21,8 +21,8 @@ type Swagger struct {
 	JSON     string
 }
 
-// NewAPI initializes a new HTTP router and optionally generates Swagger documentation.
-func NewAPI(nameAPI string, appPort int, isSwaggerCreated bool, handlers []swagger.Handler) *mux.Router {
+// NewAPI initializes a new HTTP router and optionally generates Swagger documentation for the API served at host.
+func NewAPI(nameAPI string, appPort int, host string, isSwaggerCreated bool, handlers []swagger.Handler) *mux.Router {
 	// Return nil if no handlers are provided.
 	if len(handlers) == 0 {
 		return nil
@@ -31,6 +31,16 @@ func NewAPI(nameAPI string, appPort int, isSwaggerCreated bool, handlers []swagg
 	// Initialize a new Gorilla Mux router.
 	r := mux.NewRouter()
 
+	// Apply tracing middleware first, so that the spans cover the rest of the request.
+	r.Use(middleware.TracingMid)
+
+	// Apply request metrics middleware, outside of panic handling so that recovered panics count as errors.
+	r.Use(middleware.MetricsMid)
+
+	// Apply access log middleware, which gives the request its ID and logger, outside of panic handling
+	// so that recovered panics are logged with the request.
+	r.Use(middleware.AccessLogMid)
+
 	// Apply panic handling middleware.
 	r.Use(middleware.PanicMid)
 
@@ -41,7 +51,7 @@ func NewAPI(nameAPI string, appPort int, isSwaggerCreated bool, handlers []swagg
 
 	// Generate Swagger documentation if not already created.
 	if !isSwaggerCreated {
-		swaggerDoc, err := swagger.GenerateDoc(nameAPI, handlers)
+		swaggerDoc, err := swagger.GenerateDoc(nameAPI, host, handlers)
 		if err != nil {
 			logger.Fatal(err)
 		}
@@ -1,6 +1,40 @@
 package router
 
-// Hc is the constant representing the Healthcheck endpoint name.
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
+	"github.com/gleb-korostelev/GophKeeper/tools/swagger"
+)
+
+// Hc is the constant representing the Swagger tag of the health endpoints.
 const (
 	Hc = "Healthcheck"
 )
+
+// pageOpts documents the keyset pagination query parameters of a list endpoint
+// sorted by one of sorts, defaultSort first.
+func pageOpts(sorts []string, defaultSort string) []swagger.Option {
+	return []swagger.Option{
+		swagger.QueryOpt{
+			Name:        pagination.LimitParam,
+			Type:        swagger.Integer,
+			Required:    false,
+			Description: fmt.Sprintf("Page size, %d by default, at most %d", pagination.DefaultLimit, pagination.MaxLimit),
+		},
+		swagger.QueryOpt{
+			Name:        pagination.CursorParam,
+			Type:        swagger.String,
+			Required:    false,
+			Description: "Cursor of the page, taken from next_cursor of the previous page",
+		},
+		swagger.QueryOpt{
+			Name:     pagination.SortParam,
+			Type:     swagger.String,
+			Required: false,
+			Description: fmt.Sprintf("Sort field, one of %s (%s by default); prefix with '-' for descending order",
+				strings.Join(sorts, ", "), defaultSort),
+		},
+	}
+}
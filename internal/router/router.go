@@ -1,13 +1,22 @@
 package router
 
 import (
-	"crypto/ed25519"
 	"net/http"
 
 	"github.com/gleb-korostelev/GophKeeper/internal/handler"
 	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
 	"github.com/gleb-korostelev/GophKeeper/middleware"
 	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/account"
+	"github.com/gleb-korostelev/GophKeeper/models/apikey"
+	"github.com/gleb-korostelev/GophKeeper/models/attachment"
+	"github.com/gleb-korostelev/GophKeeper/models/device"
+	"github.com/gleb-korostelev/GophKeeper/models/org"
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/gleb-korostelev/GophKeeper/models/quota"
+	"github.com/gleb-korostelev/GophKeeper/pkg/claims"
+	"github.com/gleb-korostelev/GophKeeper/pkg/keyring"
+	"github.com/gleb-korostelev/GophKeeper/tools/health"
 	"github.com/gleb-korostelev/GophKeeper/tools/swagger"
 	"github.com/gorilla/mux"
 )
@@ -20,38 +29,92 @@ const AppName = "gophkeeper"
 // Parameters:
 // - impl: An implementation of the `handler.API` interface containing the HTTP handlers for the application.
 // - appPort: The main application port for the router.
-// - authKey: The Ed25519 private key for authentication purposes.
+// - host: The hostname the API is reached at, used in the Swagger documentation.
+// - publicKeys: Looks up the Ed25519 public keys verifying access tokens by key ID.
+// - sessions: Checks that tokens belong to sessions that were not revoked.
+// - keys: Authenticates personal API keys.
+// - certs: Maps TLS client certificates to users; nil disables client certificate authentication.
 // - isSwaggerCreated: A boolean indicating whether Swagger documentation has already been generated.
 //
 // Middleware:
 // - The `mw.Auth` middleware is applied to endpoints requiring authentication.
+// - The `mw.AuthKey` middleware is applied to the item endpoints that also accept API keys and client certificates.
+// - The `mw.AuthAdmin` middleware is applied to the endpoints of administrators.
 // - Swagger headers are configured for authenticated endpoints.
 //
 // Swagger Integration:
 // - Each handler is defined with Swagger metadata, including paths, request/response bodies, and options.
 //
 // Registered Endpoints:
-// - `/healthcheck`: Checks the application's health.
+// - `/livez`: Reports that the application is alive.
+// - `/readyz`: Reports whether the dependencies of the application are usable.
 // - `/api/v1/challenge`: Retrieves an authentication challenge.
 // - `/api/v1/register`: Registers a new user profile.
 // - `/api/v1/login`: Authenticates a user and issues tokens.
 // - `/api/v1/upload-card-info`: Uploads or updates card information.
 // - `/api/v1/cards` (GET): Retrieves all user cards.
 // - `/api/v1/cards` (DELETE): Deletes a specific user card.
-func CreateRouter(impl handler.API, appPort int, authKey ed25519.PrivateKey, isSwaggerCreated bool) *mux.Router {
-	// Extract the public key for middleware initialization.
-	pub := authKey.Public().(ed25519.PublicKey)
-
-	// Initialize core middleware with the public key.
-	mw := middleware.NewCoreMW(true, &pub)
+// - `/api/v1/cards/share` (POST): Grants another user access to a card.
+// - `/api/v1/cards/share` (DELETE): Revokes another user's access to a card.
+// - `/api/v1/public-key` (POST): Publishes the user's public key.
+// - `/api/v1/public-key` (GET): Retrieves another user's public key.
+// - `/api/v1/orgs` (POST, GET): Creates an organization and lists the user's organizations.
+// - `/api/v1/orgs/{org}/members` (POST, DELETE): Manages organization members (admin or owner).
+// - `/api/v1/orgs/{org}/groups` (POST): Creates or replaces a group (admin or owner).
+// - `/api/v1/orgs/{org}/collections` (POST): Creates or replaces a collection (admin or owner).
+// - `/api/v1/orgs/{org}/collections/cards` (POST): Adds a card to a collection (member or above).
+// - `/api/v1/orgs/{org}/cards` (GET): Retrieves organization cards visible to the user.
+// - `/api/v1/sends` (POST): Creates a one-time share link.
+// - `/api/v1/sends/{id}` (GET): Opens a one-time share link, no authentication required.
+// - `/api/v1/emergency/contacts` (POST, DELETE, GET): Manages trusted contacts for emergency access.
+// - `/api/v1/emergency/request` (POST): Requests emergency access to a grantor's vault.
+// - `/api/v1/emergency/approve` (POST): Approves a pending emergency access request.
+// - `/api/v1/emergency/reject` (POST): Rejects a pending emergency access request.
+// - `/api/v1/emergency/cards` (GET): Retrieves a grantor's cards after emergency access was approved.
+// - `/api/v1/folders` (POST, GET, DELETE): Manages the user's nestable folders.
+// - `/api/v1/tags` (GET, DELETE): Lists and deletes the user's tags.
+// - `/api/v1/search` (GET): Full-text search over the user's cards.
+// - `/api/v1/attachments` (POST, GET): Registers attachment uploads and lists a card's attachments.
+// - `/api/v1/attachments/{id}` (PUT, GET, DELETE): Uploads chunks of, downloads and deletes an attachment.
+// - `/api/v1/import` (POST): Imports the export of another password manager.
+// - `/api/v1/export` (GET): Exports the user's vault.
+// - `/api/v1/account/password` (POST): Changes the user's master password.
+// - `/api/v1/account/data` (GET): Exports all personal data held about the user.
+// - `/api/v1/account/usage` (GET): Reports what the user stores against the limits of their plan.
+// - `/api/v1/account` (DELETE): Deletes the user's account.
+// - `/api/v1/account/recovery` (POST, GET, DELETE): Creates, retrieves and removes the user's recovery kit.
+// - `/api/v1/account/recover` (POST): Recovers an account with recovery shares and sets a new password.
+// - `/api/v1/devices` (GET): Retrieves the devices the user signed in from.
+// - `/api/v1/devices/{id}` (DELETE): Removes a device and signs out its sessions.
+// - `/api/v1/api-keys` (POST, GET): Creates and lists the user's personal API keys.
+// - `/api/v1/api-keys/{id}` (DELETE): Revokes a personal API key.
+// - `/.well-known/jwks.json` (GET): Publishes the public keys verifying access tokens, no authentication required.
+// - `/api/v1/admin/health` (GET): Reports the result of each dependency check (admin only).
+// - `/api/v1/admin/users/{username}/quota` (PUT): Overrides the limits of a user (admin only).
+func CreateRouter(impl handler.API, appPort int, host string, publicKeys claims.KeyFunc,
+	sessions middleware.SessionChecker, keys middleware.APIKeyChecker, certs middleware.ClientCertMapper,
+	allowFake, isSwaggerCreated bool) *mux.Router {
+	// Initialize core middleware with the public keys, the session check, the API key check and the client
+	// certificate mapping. Fake authentication is only enabled in development deployments.
+	mw := middleware.NewCoreMW(allowFake, publicKeys, sessions, keys, certs)
 
 	// Define handlers with Swagger metadata.
 	var handlers = []swagger.Handler{
 		{
-			HandlerFunc:      impl.Healthcheck,
-			Path:             "/healthcheck",
+			HandlerFunc:      impl.GetLivez,
+			Path:             "/livez",
+			Method:           http.MethodGet,
+			Description:      "Liveness probe, checking no dependencies",
+			ResponseBody:     response.HealthcheckResp{},
+			ResponseMimeType: swagger.MimeJson,
+			Opts:             []swagger.Option{},
+			Tag:              Hc,
+		},
+		{
+			HandlerFunc:      impl.GetReadyz,
+			Path:             "/readyz",
 			Method:           http.MethodGet,
-			Description:      "Healthcheck",
+			Description:      "Readiness probe, answering 503 if a dependency check failed",
 			ResponseBody:     response.HealthcheckResp{},
 			ResponseMimeType: swagger.MimeJson,
 			Opts:             []swagger.Option{},
@@ -82,7 +145,7 @@ func CreateRouter(impl handler.API, appPort int, authKey ed25519.PrivateKey, isS
 			RequestBody:  models.PostSignInReq{},
 		},
 		{
-			HandlerFunc:  mw.Auth(impl.PostUploadInfo),
+			HandlerFunc:  mw.AuthKey(impl.PostUploadInfo),
 			Path:         "/api/v1/upload-card-info",
 			Method:       http.MethodPost,
 			Description:  "Uploads or edits new card info",
@@ -93,32 +156,381 @@ func CreateRouter(impl handler.API, appPort int, authKey ed25519.PrivateKey, isS
 					Name:        middleware.HeaderAuth,
 					Type:        swagger.String,
 					Required:    true,
-					Description: `Required 'Bearer ' prefix`,
+					Description: `Required 'Bearer ' or 'ApiKey ' prefix`,
 				},
 			},
 		},
 		{
-			HandlerFunc:  mw.Auth(impl.GetUserCards),
+			HandlerFunc:  mw.AuthKey(impl.GetUserCards),
 			Path:         "/api/v1/cards",
 			Method:       http.MethodGet,
 			Description:  "Get card details",
 			ResponseBody: response.Response[models.GetUserCardsResp]{},
-			Opts: []swagger.Option{
+			Opts: append([]swagger.Option{
 				swagger.HeaderOpt{
 					Name:        middleware.HeaderAuth,
 					Type:        swagger.String,
 					Required:    true,
-					Description: `Required 'Bearer ' prefix`,
+					Description: `Required 'Bearer ' or 'ApiKey ' prefix`,
 				},
-			},
+				swagger.QueryOpt{
+					Name:        "folder",
+					Type:        swagger.Integer,
+					Required:    false,
+					Description: "Only cards in this folder or its subfolders",
+				},
+				swagger.QueryOpt{
+					Name:        "tag",
+					Type:        swagger.String,
+					Required:    false,
+					Description: "Only cards with this tag",
+				},
+				swagger.QueryOpt{
+					Name:        "type",
+					Type:        swagger.String,
+					Required:    false,
+					Description: "Only cards of this type: card, login, note or identity",
+				},
+			}, pageOpts(profile.Sorts, profile.SortCreated)...),
 		},
 		{
-			HandlerFunc:  mw.Auth(impl.DeleteCardInfo),
+			HandlerFunc:  mw.AuthKey(impl.DeleteCardInfo),
 			Path:         "/api/v1/cards",
 			Method:       http.MethodDelete,
 			Description:  "Delete specific card",
 			ResponseBody: response.Response[struct{}]{},
 			RequestBody:  models.DeleteCardInfoReq{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' or 'ApiKey ' prefix`,
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.PostShareCard),
+			Path:         "/api/v1/cards/share",
+			Method:       http.MethodPost,
+			Description:  "Share card with another user",
+			ResponseBody: response.Response[struct{}]{},
+			RequestBody:  models.PostShareCardReq{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.DeleteCardShare),
+			Path:         "/api/v1/cards/share",
+			Method:       http.MethodDelete,
+			Description:  "Revoke card share",
+			ResponseBody: response.Response[struct{}]{},
+			RequestBody:  models.DeleteCardShareReq{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.PostPublicKey),
+			Path:         "/api/v1/public-key",
+			Method:       http.MethodPost,
+			Description:  "Publish public key for shared item keys",
+			ResponseBody: response.Response[struct{}]{},
+			RequestBody:  models.PostPublicKeyReq{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.GetPublicKey),
+			Path:         "/api/v1/public-key",
+			Method:       http.MethodGet,
+			Description:  "Get another user's public key",
+			ResponseBody: response.Response[models.GetPublicKeyResp]{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+				swagger.QueryOpt{
+					Name:        "username",
+					Type:        swagger.String,
+					Required:    true,
+					Description: "Username whose public key is requested",
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.PostCreateOrg),
+			Path:         "/api/v1/orgs",
+			Method:       http.MethodPost,
+			Description:  "Create organization",
+			ResponseBody: response.Response[struct{}]{},
+			RequestBody:  models.PostCreateOrgReq{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.GetUserOrgs),
+			Path:         "/api/v1/orgs",
+			Method:       http.MethodGet,
+			Description:  "Get user organizations",
+			ResponseBody: response.Response[models.GetUserOrgsResp]{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.AuthOrg(impl.PostOrgMember, org.RoleAdmin),
+			Path:         "/api/v1/orgs/{org}/members",
+			Method:       http.MethodPost,
+			Description:  "Add or update organization member",
+			ResponseBody: response.Response[struct{}]{},
+			RequestBody:  models.PostOrgMemberReq{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+				swagger.PathOpt{
+					Name:        middleware.OrgPathVar,
+					Type:        swagger.String,
+					Required:    true,
+					Description: "Organization name",
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.AuthOrg(impl.DeleteOrgMember, org.RoleAdmin),
+			Path:         "/api/v1/orgs/{org}/members",
+			Method:       http.MethodDelete,
+			Description:  "Remove organization member",
+			ResponseBody: response.Response[struct{}]{},
+			RequestBody:  models.DeleteOrgMemberReq{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+				swagger.PathOpt{
+					Name:        middleware.OrgPathVar,
+					Type:        swagger.String,
+					Required:    true,
+					Description: "Organization name",
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.AuthOrg(impl.PostOrgGroup, org.RoleAdmin),
+			Path:         "/api/v1/orgs/{org}/groups",
+			Method:       http.MethodPost,
+			Description:  "Create or replace organization group",
+			ResponseBody: response.Response[struct{}]{},
+			RequestBody:  models.PostOrgGroupReq{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+				swagger.PathOpt{
+					Name:        middleware.OrgPathVar,
+					Type:        swagger.String,
+					Required:    true,
+					Description: "Organization name",
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.AuthOrg(impl.PostOrgCollection, org.RoleAdmin),
+			Path:         "/api/v1/orgs/{org}/collections",
+			Method:       http.MethodPost,
+			Description:  "Create or replace organization collection",
+			ResponseBody: response.Response[struct{}]{},
+			RequestBody:  models.PostOrgCollectionReq{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+				swagger.PathOpt{
+					Name:        middleware.OrgPathVar,
+					Type:        swagger.String,
+					Required:    true,
+					Description: "Organization name",
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.AuthOrg(impl.PostCollectionCard, org.RoleMember),
+			Path:         "/api/v1/orgs/{org}/collections/cards",
+			Method:       http.MethodPost,
+			Description:  "Add card to organization collection",
+			ResponseBody: response.Response[struct{}]{},
+			RequestBody:  models.PostCollectionCardReq{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+				swagger.PathOpt{
+					Name:        middleware.OrgPathVar,
+					Type:        swagger.String,
+					Required:    true,
+					Description: "Organization name",
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.AuthOrg(impl.GetOrgCards, org.RoleReadOnly),
+			Path:         "/api/v1/orgs/{org}/cards",
+			Method:       http.MethodGet,
+			Description:  "Get organization cards",
+			ResponseBody: response.Response[models.GetUserCardsResp]{},
+			Opts: append([]swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+				swagger.PathOpt{
+					Name:        middleware.OrgPathVar,
+					Type:        swagger.String,
+					Required:    true,
+					Description: "Organization name",
+				},
+			}, pageOpts(profile.Sorts, profile.SortCreated)...),
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.PostCreateSend),
+			Path:         "/api/v1/sends",
+			Method:       http.MethodPost,
+			Description:  "Create one-time share link",
+			ResponseBody: response.Response[models.PostCreateSendResp]{},
+			RequestBody:  models.PostCreateSendReq{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+			},
+		},
+		{
+			HandlerFunc:  http.HandlerFunc(impl.GetSend),
+			Path:         "/api/v1/sends/{id}",
+			Method:       http.MethodGet,
+			Description:  "Open one-time share link",
+			ResponseBody: response.Response[models.GetSendResp]{},
+			Opts: []swagger.Option{
+				swagger.PathOpt{
+					Name:        handler.SendIDPathVar,
+					Type:        swagger.String,
+					Required:    true,
+					Description: "Send identifier",
+				},
+				swagger.HeaderOpt{
+					Name:        handler.HeaderSendPassword,
+					Type:        swagger.String,
+					Required:    false,
+					Description: "Password, if the send is protected by one; the send is disabled after 10 wrong passwords",
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.PostEmergencyContact),
+			Path:         "/api/v1/emergency/contacts",
+			Method:       http.MethodPost,
+			Description:  "Designate emergency contact",
+			ResponseBody: response.Response[struct{}]{},
+			RequestBody:  models.PostEmergencyContactReq{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.DeleteEmergencyContact),
+			Path:         "/api/v1/emergency/contacts",
+			Method:       http.MethodDelete,
+			Description:  "Remove emergency contact",
+			ResponseBody: response.Response[struct{}]{},
+			RequestBody:  models.EmergencyUsernameReq{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.GetEmergencyContacts),
+			Path:         "/api/v1/emergency/contacts",
+			Method:       http.MethodGet,
+			Description:  "Get emergency contacts",
+			ResponseBody: response.Response[models.GetEmergencyContactsResp]{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.PostEmergencyRequest),
+			Path:         "/api/v1/emergency/request",
+			Method:       http.MethodPost,
+			Description:  "Request emergency access",
+			ResponseBody: response.Response[struct{}]{},
+			RequestBody:  models.EmergencyUsernameReq{},
 			Opts: []swagger.Option{
 				swagger.HeaderOpt{
 					Name:        middleware.HeaderAuth,
@@ -128,8 +540,582 @@ func CreateRouter(impl handler.API, appPort int, authKey ed25519.PrivateKey, isS
 				},
 			},
 		},
+		{
+			HandlerFunc:  mw.Auth(impl.PostEmergencyApprove),
+			Path:         "/api/v1/emergency/approve",
+			Method:       http.MethodPost,
+			Description:  "Approve emergency access request",
+			ResponseBody: response.Response[struct{}]{},
+			RequestBody:  models.EmergencyUsernameReq{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.PostEmergencyReject),
+			Path:         "/api/v1/emergency/reject",
+			Method:       http.MethodPost,
+			Description:  "Reject emergency access request",
+			ResponseBody: response.Response[struct{}]{},
+			RequestBody:  models.EmergencyUsernameReq{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.GetEmergencyCards),
+			Path:         "/api/v1/emergency/cards",
+			Method:       http.MethodGet,
+			Description:  "Get grantor's cards via emergency access",
+			ResponseBody: response.Response[models.GetUserCardsResp]{},
+			Opts: append([]swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+				swagger.QueryOpt{
+					Name:        "username",
+					Type:        swagger.String,
+					Required:    true,
+					Description: "Username of the grantor",
+				},
+			}, pageOpts(profile.Sorts, profile.SortCreated)...),
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.PostFolder),
+			Path:         "/api/v1/folders",
+			Method:       http.MethodPost,
+			Description:  "Create folder",
+			ResponseBody: response.Response[models.PostFolderResp]{},
+			RequestBody:  models.PostFolderReq{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.GetFolders),
+			Path:         "/api/v1/folders",
+			Method:       http.MethodGet,
+			Description:  "Get folders",
+			ResponseBody: response.Response[models.GetFoldersResp]{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.DeleteFolder),
+			Path:         "/api/v1/folders",
+			Method:       http.MethodDelete,
+			Description:  "Delete folder and its subfolders",
+			ResponseBody: response.Response[struct{}]{},
+			RequestBody:  models.DeleteFolderReq{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.GetTags),
+			Path:         "/api/v1/tags",
+			Method:       http.MethodGet,
+			Description:  "Get tags",
+			ResponseBody: response.Response[models.GetTagsResp]{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.DeleteTag),
+			Path:         "/api/v1/tags",
+			Method:       http.MethodDelete,
+			Description:  "Delete tag",
+			ResponseBody: response.Response[struct{}]{},
+			RequestBody:  models.DeleteTagReq{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.GetSearch),
+			Path:         "/api/v1/search",
+			Method:       http.MethodGet,
+			Description:  "Full-text search over cards, best matches first",
+			ResponseBody: response.Response[models.GetSearchResp]{},
+			// Searches are only sorted by relevance, so the sort parameter is not documented.
+			Opts: append([]swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+				swagger.QueryOpt{
+					Name:        handler.SearchQueryParam,
+					Type:        swagger.String,
+					Required:    true,
+					Description: "Search query over names, holders, metadata and tags",
+				},
+			}, pageOpts(nil, profile.SortRelevance)[:2]...),
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.PostAttachment),
+			Path:         "/api/v1/attachments",
+			Method:       http.MethodPost,
+			Description:  "Register attachment upload",
+			ResponseBody: response.Response[models.PostAttachmentResp]{},
+			RequestBody:  models.PostAttachmentReq{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.GetAttachments),
+			Path:         "/api/v1/attachments",
+			Method:       http.MethodGet,
+			Description:  "Get card attachments",
+			ResponseBody: response.Response[models.GetAttachmentsResp]{},
+			Opts: append([]swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+				swagger.QueryOpt{
+					Name:        handler.CardNumberParam,
+					Type:        swagger.String,
+					Required:    true,
+					Description: "Card whose attachments are listed",
+				},
+			}, pageOpts([]string{attachment.SortCreated}, attachment.SortCreated)...),
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.PutAttachment),
+			Path:         "/api/v1/attachments/{id}",
+			Method:       http.MethodPut,
+			Description:  "Upload attachment chunk",
+			ResponseBody: response.Response[models.AttachmentResp]{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+				swagger.PathOpt{
+					Name:        handler.AttachmentIDPathVar,
+					Type:        swagger.String,
+					Required:    true,
+					Description: "Attachment identifier",
+				},
+				swagger.HeaderOpt{
+					Name:        handler.HeaderContentRange,
+					Type:        swagger.String,
+					Required:    true,
+					Description: "Chunk position as 'bytes <start>-<end>/<size>', continuing at the received offset",
+				},
+			},
+		},
+		{
+			HandlerFunc: mw.Auth(impl.GetAttachment),
+			Path:        "/api/v1/attachments/{id}",
+			Method:      http.MethodGet,
+			Description: "Download attachment",
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+				swagger.PathOpt{
+					Name:        handler.AttachmentIDPathVar,
+					Type:        swagger.String,
+					Required:    true,
+					Description: "Attachment identifier",
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.DeleteAttachment),
+			Path:         "/api/v1/attachments/{id}",
+			Method:       http.MethodDelete,
+			Description:  "Delete attachment",
+			ResponseBody: response.Response[struct{}]{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+				swagger.PathOpt{
+					Name:        handler.AttachmentIDPathVar,
+					Type:        swagger.String,
+					Required:    true,
+					Description: "Attachment identifier",
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.PostImport),
+			Path:         "/api/v1/import",
+			Method:       http.MethodPost,
+			Description:  "Import from another password manager",
+			ResponseBody: response.Response[models.PostImportResp]{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+				swagger.QueryOpt{
+					Name:        handler.FormatParam,
+					Type:        swagger.String,
+					Required:    true,
+					Description: "Format of the file sent as request body: bitwarden, keepass-xml, keepass-csv, 1password, chrome or gophkeeper",
+				},
+				swagger.HeaderOpt{
+					Name:        handler.HeaderExportPassword,
+					Type:        swagger.String,
+					Required:    false,
+					Description: "Password of a gophkeeper archive",
+				},
+				swagger.QueryOpt{
+					Name:        handler.DryRunParam,
+					Type:        swagger.Boolean,
+					Required:    false,
+					Description: "Only report what would be imported and the duplicates",
+				},
+			},
+		},
+		{
+			HandlerFunc: mw.Auth(impl.GetExport),
+			Path:        "/api/v1/export",
+			Method:      http.MethodGet,
+			Description: "Export vault",
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+				swagger.QueryOpt{
+					Name:        handler.FormatParam,
+					Type:        swagger.String,
+					Required:    false,
+					Description: "Export format: gophkeeper (encrypted archive, default), json or csv",
+				},
+				swagger.HeaderOpt{
+					Name:        handler.HeaderExportPassword,
+					Type:        swagger.String,
+					Required:    false,
+					Description: "Password the gophkeeper archive is encrypted with, at least 8 characters",
+				},
+				swagger.QueryOpt{
+					Name:        handler.ConfirmPlaintextParam,
+					Type:        swagger.Boolean,
+					Required:    false,
+					Description: "Confirms that the vault may be exported unencrypted as json or csv",
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.PostChangePassword),
+			Path:         "/api/v1/account/password",
+			Method:       http.MethodPost,
+			Description:  "Change master password",
+			ResponseBody: response.Response[models.PostChangePasswordResp]{},
+			RequestBody:  models.PostChangePasswordReq{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.GetAccountData),
+			Path:         "/api/v1/account/data",
+			Method:       http.MethodGet,
+			Description:  "Export all personal data held about the user",
+			ResponseBody: response.Response[account.PersonalData]{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.GetAccountUsage),
+			Path:         "/api/v1/account/usage",
+			Method:       http.MethodGet,
+			Description:  "Get what the user stores and the limits of their plan; a limit of 0 is unlimited",
+			ResponseBody: response.Response[quota.Report]{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.DeleteAccount),
+			Path:         "/api/v1/account",
+			Method:       http.MethodDelete,
+			Description:  "Delete the user's account",
+			ResponseBody: response.Response[struct{}]{},
+			RequestBody:  models.DeleteAccountReq{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.PostRecoveryKit),
+			Path:         "/api/v1/account/recovery",
+			Method:       http.MethodPost,
+			Description:  "Create recovery kit",
+			ResponseBody: response.Response[models.PostRecoveryKitResp]{},
+			RequestBody:  models.PostRecoveryKitReq{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.GetRecoveryKit),
+			Path:         "/api/v1/account/recovery",
+			Method:       http.MethodGet,
+			Description:  "Get recovery kit",
+			ResponseBody: response.Response[models.GetRecoveryKitResp]{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.DeleteRecoveryKit),
+			Path:         "/api/v1/account/recovery",
+			Method:       http.MethodDelete,
+			Description:  "Remove recovery kit",
+			ResponseBody: response.Response[struct{}]{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+			},
+		},
+		{
+			HandlerFunc:  http.HandlerFunc(impl.PostRecover),
+			Path:         "/api/v1/account/recover",
+			Method:       http.MethodPost,
+			Description:  "Recover account with recovery shares",
+			ResponseBody: response.Response[models.PostRecoverResp]{},
+			RequestBody:  models.PostRecoverReq{},
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.GetDevices),
+			Path:         "/api/v1/devices",
+			Method:       http.MethodGet,
+			Description:  "Get devices",
+			ResponseBody: response.Response[models.GetDevicesResp]{},
+			Opts: append([]swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+			}, pageOpts(device.Sorts, device.DefaultSort)...),
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.DeleteDevice),
+			Path:         "/api/v1/devices/{id}",
+			Method:       http.MethodDelete,
+			Description:  "Remove device",
+			ResponseBody: response.Response[models.DeleteDeviceResp]{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+				swagger.PathOpt{
+					Name:        handler.DeviceIDPathVar,
+					Type:        swagger.String,
+					Required:    true,
+					Description: "Device identifier",
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.PostAPIKey),
+			Path:         "/api/v1/api-keys",
+			Method:       http.MethodPost,
+			Description:  "Create API key",
+			ResponseBody: response.Response[models.PostAPIKeyResp]{},
+			RequestBody:  models.PostAPIKeyReq{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+			},
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.GetAPIKeys),
+			Path:         "/api/v1/api-keys",
+			Method:       http.MethodGet,
+			Description:  "Get API keys",
+			ResponseBody: response.Response[models.GetAPIKeysResp]{},
+			Opts: append([]swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+			}, pageOpts([]string{apikey.SortCreated}, apikey.DefaultSort)...),
+		},
+		{
+			HandlerFunc:  mw.Auth(impl.DeleteAPIKey),
+			Path:         "/api/v1/api-keys/{id}",
+			Method:       http.MethodDelete,
+			Description:  "Revoke API key",
+			ResponseBody: response.Response[struct{}]{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+				swagger.PathOpt{
+					Name:        handler.APIKeyIDPathVar,
+					Type:        swagger.String,
+					Required:    true,
+					Description: "API key identifier",
+				},
+			},
+		},
+		{
+			HandlerFunc:      http.HandlerFunc(impl.GetJWKS),
+			Path:             "/.well-known/jwks.json",
+			Method:           http.MethodGet,
+			Description:      "Get the public keys verifying access tokens as a JSON Web Key Set, no authentication required",
+			ResponseBody:     keyring.JWKS{},
+			ResponseMimeType: swagger.MimeJson,
+		},
+		{
+			HandlerFunc:      mw.AuthAdmin(impl.GetHealth),
+			Path:             "/api/v1/admin/health",
+			Method:           http.MethodGet,
+			Description:      "Get the result of each dependency check (admin only)",
+			ResponseBody:     health.Report{},
+			ResponseMimeType: swagger.MimeJson,
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+			},
+			Tag: Hc,
+		},
+		{
+			HandlerFunc:  mw.AuthAdmin(impl.PutQuotaOverride),
+			Path:         "/api/v1/admin/users/{username}/quota",
+			Method:       http.MethodPut,
+			Description:  "Override the limits of a user's plan; omitted limits keep those of the plan (admin only)",
+			ResponseBody: response.Response[quota.Report]{},
+			RequestBody:  models.PutQuotaOverrideReq{},
+			Opts: []swagger.Option{
+				swagger.HeaderOpt{
+					Name:        middleware.HeaderAuth,
+					Type:        swagger.String,
+					Required:    true,
+					Description: `Required 'Bearer ' prefix`,
+				},
+				swagger.PathOpt{
+					Name:        handler.UsernamePathVar,
+					Type:        swagger.String,
+					Required:    true,
+					Description: "Username of the user",
+				},
+			},
+		},
 	}
 
 	// Create and return the new API router.
-	return NewAPI(AppName, appPort, isSwaggerCreated, handlers)
+	return NewAPI(AppName, appPort, host, isSwaggerCreated, handlers)
 }
@@ -0,0 +1,99 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/tools/logger"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// HeaderRequestID is the header carrying the ID of a request, accepted from clients and proxies
+// and returned in every response.
+const HeaderRequestID = "X-Request-ID"
+
+// validRequestID matches the request IDs accepted from clients; others are replaced by a generated one,
+// so that request IDs cannot be used to forge log entries.
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// AccessLogMid is a middleware logging each request with its method, route template, status code, latency,
+// user and request ID. It gives the request a request-scoped logger, returned by logger.Ctx, carrying
+// the request ID, method and route, and the user once the request is authenticated.
+//
+// Requests are logged at the info level, or at the error level if they failed with a server error;
+// the error the handler reported with RecordError is logged with them.
+func AccessLogMid(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(HeaderRequestID)
+		if !validRequestID.MatchString(requestID) {
+			requestID = uuid.NewString()
+		}
+		w.Header().Set(HeaderRequestID, requestID)
+
+		route := routeTemplate(r)
+		ctx := r.Context()
+		trace.SpanFromContext(ctx).SetAttributes(attribute.String("http.request_id", requestID))
+
+		aw := &accessWriter{statusWriter: statusWriter{ResponseWriter: w}}
+		ctx = context.WithValue(ctx, ctxKeyAccessLog, aw)
+		ctx = logger.With(ctx, "request_id", requestID, "method", r.Method, "route", route)
+
+		start := time.Now()
+		next.ServeHTTP(aw, r.WithContext(ctx))
+
+		l := logger.Ctx(ctx).With(
+			"status", aw.Status(),
+			"latency", time.Since(start),
+			"user", aw.user,
+		)
+		if aw.err != nil {
+			l = l.With("error", aw.err.Error())
+		}
+		if aw.Status() >= http.StatusInternalServerError {
+			l.Error("request")
+		} else {
+			l.Info("request")
+		}
+	})
+}
+
+// RecordError reports the error a request failed with, so that it is logged with the request.
+// It does nothing if w does not belong to a request logged by AccessLogMid.
+func RecordError(w http.ResponseWriter, err error) {
+	for {
+		switch rw := w.(type) {
+		case *accessWriter:
+			rw.err = err
+			return
+		case interface{ Unwrap() http.ResponseWriter }:
+			w = rw.Unwrap()
+		default:
+			return
+		}
+	}
+}
+
+// withUser returns a copy of ctx carrying the user a request is authenticated as,
+// and records the user in the request's logger and log entry.
+func withUser(ctx context.Context, username string) context.Context {
+	ctx = context.WithValue(ctx, CtxKeyUserID, username)
+
+	aw, ok := ctx.Value(ctxKeyAccessLog).(*accessWriter)
+	if !ok {
+		return ctx
+	}
+	aw.user = username
+	return logger.With(ctx, "user", username)
+}
+
+// accessWriter is the http.ResponseWriter of a request logged by AccessLogMid,
+// collecting the status code, user and error its log entry reports.
+type accessWriter struct {
+	statusWriter
+	user string
+	err  error
+}
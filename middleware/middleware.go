@@ -4,15 +4,25 @@
 package middleware
 
 import (
+	"bytes"
 	"context"
 	"crypto/ed25519"
+	"crypto/x509"
+	"encoding/base64"
 	"errors"
+	"io"
 	"net/http"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gleb-korostelev/GophKeeper/internal/handler/response"
+	"github.com/gleb-korostelev/GophKeeper/models/apikey"
+	"github.com/gleb-korostelev/GophKeeper/models/device"
+	"github.com/gleb-korostelev/GophKeeper/models/org"
 	auth "github.com/gleb-korostelev/GophKeeper/pkg/claims"
 	"github.com/gleb-korostelev/GophKeeper/tools/logger"
+	"github.com/gorilla/mux"
 	"go.uber.org/zap"
 )
 
@@ -21,25 +31,54 @@ type ctxKey uint8
 
 // Context keys for storing user-specific information.
 const (
-	CtxKeyUserID ctxKey = iota // The key for storing the user's ID.
-	ctxKeyRoles                // The key for storing the user's roles or abilities.
+	CtxKeyUserID      ctxKey = iota // The key for storing the user's ID.
+	ctxKeyRoles                     // The key for storing the user's roles or abilities.
+	ctxKeySessionID                 // The key for storing the session the token belongs to.
+	ctxKeyAPIKeyScope               // The key for storing the scope of the API key making the request.
+	ctxKeyAccessLog                 // The key for storing the access log entry of the request.
 )
 
+// SessionChecker reports whether the sign-in session a token belongs to is still valid
+// and returns the public key of the device the session is bound to, if any.
+type SessionChecker interface {
+	SessionActive(ctx context.Context, sessionID string) (active bool, deviceKey []byte, err error)
+}
+
+// APIKeyChecker reports whether a personal API key is valid and has not expired, returning its stored form.
+type APIKeyChecker interface {
+	AuthenticateAPIKey(ctx context.Context, key string) (k apikey.Key, ok bool, err error)
+}
+
+// ClientCertMapper maps the subject of a verified TLS client certificate to the user it authenticates.
+type ClientCertMapper interface {
+	User(cert *x509.Certificate) (username string, ok bool)
+}
+
 // CoreMW represents the core middleware for handling authentication and authorization.
 //
 // Fields:
 // - allowFake: A boolean to enable or disable fake authentication (for development or testing).
-// - publicKey: The public key used for verifying JWT tokens.
+// - publicKeys: Looks up the public keys used for verifying JWT tokens by key ID.
+// - sessions: Checks that tokens belong to sessions that were not revoked; nil disables the check.
+// - keys: Authenticates API keys; nil rejects them.
+// - certs: Maps client certificates to users; nil rejects them.
 type CoreMW struct {
-	allowFake bool
-	publicKey *ed25519.PublicKey
+	allowFake  bool
+	publicKeys auth.KeyFunc
+	sessions   SessionChecker
+	keys       APIKeyChecker
+	certs      ClientCertMapper
 }
 
 // NewCoreMW creates a new instance of CoreMW.
-func NewCoreMW(allowFake bool, publicKey *ed25519.PublicKey) *CoreMW {
+func NewCoreMW(allowFake bool, publicKeys auth.KeyFunc, sessions SessionChecker, keys APIKeyChecker,
+	certs ClientCertMapper) *CoreMW {
 	return &CoreMW{
-		allowFake: allowFake,
-		publicKey: publicKey,
+		allowFake:  allowFake,
+		publicKeys: publicKeys,
+		sessions:   sessions,
+		keys:       keys,
+		certs:      certs,
 	}
 }
 
@@ -53,13 +92,29 @@ const (
 
 // Header constants for request headers.
 const (
-	HeaderAuth = "Authorization" // Header for the authorization token.
+	HeaderAuth            = "Authorization"      // Header for the authorization token.
+	HeaderDeviceTimestamp = "X-Device-Timestamp" // Header for the Unix time a device signed the request at.
+	HeaderDeviceSignature = "X-Device-Signature" // Header for the base64-encoded device signature of the request.
+	HeaderContentSHA256   = "X-Content-SHA256"   // Header for the hex-encoded SHA-256 of the body a device signed.
 )
 
+// Authorization schemes accepted in the HeaderAuth header.
+const (
+	SchemeBearer = "Bearer" // A JWT access token.
+	SchemeAPIKey = "ApiKey" // A personal API key.
+)
+
+// OrgPathVar is the route variable holding the organization name in org-scoped endpoints.
+const OrgPathVar = "org"
+
 // Errors related to authentication and authorization.
 var (
-	ErrTokenInvalid    = errors.New("bearer token is not correct")
-	ErrNotEnoughRights = errors.New("not enough rights")
+	ErrTokenInvalid           = errors.New("bearer token is not correct")
+	ErrNotEnoughRights        = errors.New("not enough rights")
+	ErrDeviceSignatureInvalid = errors.New("device signature is not correct")
+	ErrAPIKeyInvalid          = errors.New("api key is not correct")
+	ErrAPIKeyNotAccepted      = errors.New("api keys are not accepted on this endpoint")
+	ErrAPIKeyReadOnly         = errors.New("api key is read-only")
 )
 
 // Auth wraps an HTTP handler with authentication middleware.
@@ -73,41 +128,178 @@ func (a *CoreMW) Auth(next http.HandlerFunc) http.HandlerFunc {
 			}
 		}
 
-		a.contextUpdate(next).ServeHTTP(w, r)
+		a.contextUpdate(next, false).ServeHTTP(w, r)
 	}
 }
 
-// contextUpdate validates the token and updates the request context with user information.
-func (a *CoreMW) contextUpdate(next http.Handler) http.Handler {
+// AuthKey wraps an HTTP handler with authentication middleware that accepts personal API keys and,
+// for requests without an Authorization header, mapped TLS client certificates besides access tokens.
+// Read-only keys are only accepted on GET requests.
+func (a *CoreMW) AuthKey(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if a.allowFake {
+			ctx, ok := a.FakeAuth(r)
+			if ok {
+				next.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
+		}
+
+		a.contextUpdate(next, true).ServeHTTP(w, r)
+	}
+}
+
+// AuthOrg wraps an HTTP handler with authentication middleware and only lets the request through
+// if the token carries a role of at least minRole in the organization named by the OrgPathVar route variable.
+func (a *CoreMW) AuthOrg(next http.HandlerFunc, minRole string) http.HandlerFunc {
+	return a.Auth(func(w http.ResponseWriter, r *http.Request) {
+		orgName := mux.Vars(r)[OrgPathVar]
+		abilities := GetAbilities(r.Context())
+
+		for _, role := range org.RolesAtLeast(minRole) {
+			if abilities.Includes(auth.OrgRole(role, orgName)) {
+				next.ServeHTTP(w, r)
+				return
+			}
+		}
+
+		response.Forbidden(w, ErrNotEnoughRights.Error())
+	})
+}
+
+// AuthAdmin wraps an HTTP handler with authentication middleware and only lets the request through
+// if the token carries the admin or superadmin role of the user.
+func (a *CoreMW) AuthAdmin(next http.HandlerFunc) http.HandlerFunc {
+	return a.Auth(func(w http.ResponseWriter, r *http.Request) {
+		issuer, _ := GetIssuer(r.Context())
+		abilities := GetAbilities(r.Context())
+
+		if abilities.Includes(auth.AdminRole(issuer)) || abilities.Includes(auth.SuperAdminRole(issuer)) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		response.Forbidden(w, ErrNotEnoughRights.Error())
+	})
+}
+
+// contextUpdate validates the token, or the API key if allowKeys is set, and updates the request context
+// with user information.
+func (a *CoreMW) contextUpdate(next http.Handler, allowKeys bool) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 
 		// Extract the token from the Authorization header.
 		bearerToken := r.Header.Get(HeaderAuth)
+
+		// Machine clients may authenticate with a client certificate instead where API keys are accepted.
+		if bearerToken == "" && allowKeys {
+			if username, ok := a.certUser(r); ok {
+				ctx = context.WithValue(ctx, ctxKeyRoles, auth.ToAbilities(auth.RoleAuthorized(username)))
+				ctx = withUser(ctx, username)
+				next.ServeHTTP(w, r.WithContext(ctx))
+				return
+			}
+		}
+
 		tokenParts := strings.Split(bearerToken, " ")
 		if len(tokenParts) != 2 {
-			logger.Error("incorrect token")
+			logger.Ctx(ctx).Error("incorrect token")
 			response.Unauthenticated(w, ErrTokenInvalid.Error())
 			return
 		}
 
 		token := tokenParts[1]
 
+		// API keys are only accepted on endpoints that opted in.
+		if tokenParts[0] == SchemeAPIKey {
+			if !allowKeys || a.keys == nil {
+				response.Forbidden(w, ErrAPIKeyNotAccepted.Error())
+				return
+			}
+			a.apiKeyUpdate(next, token).ServeHTTP(w, r)
+			return
+		}
+
 		// Parse and validate the token.
-		c, err := parseClaims(token, a.publicKey)
+		c, err := parseClaims(token, a.publicKeys)
 		if err != nil {
-			logger.Error("error on contextUpdate.parseClaims not ok", zap.Error(err))
+			logger.Ctx(ctx).Errorw("error on contextUpdate.parseClaims not ok", zap.Error(err))
 			response.Unauthenticated(w, ErrTokenInvalid.Error())
 			return
 		}
 
-		// Update the context with user roles and address.
+		// Reject tokens of revoked or expired sessions.
+		if a.sessions != nil {
+			if c.Id == "" {
+				response.Unauthenticated(w, ErrTokenInvalid.Error())
+				return
+			}
+
+			active, deviceKey, err := a.sessions.SessionActive(ctx, c.Id)
+			if err != nil {
+				logger.Ctx(ctx).Errorw("error on contextUpdate.SessionActive", zap.Error(err))
+				response.Internal(w, err.Error())
+				return
+			}
+			if !active {
+				response.Unauthenticated(w, ErrTokenInvalid.Error())
+				return
+			}
+
+			// Tokens of a session bound to a device are only accepted on requests the device signed.
+			if deviceKey != nil && !verifyDeviceSignature(r, deviceKey, c.Id) {
+				response.Unauthenticated(w, ErrDeviceSignatureInvalid.Error())
+				return
+			}
+		}
+
+		// Update the context with user roles, address and session.
 		ctx = context.WithValue(ctx, ctxKeyRoles, c.Role.Abilities)
-		ctx = context.WithValue(ctx, CtxKeyUserID, c.Name)
+		ctx = withUser(ctx, c.Name)
+		ctx = context.WithValue(ctx, ctxKeySessionID, c.Id)
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
+// apiKeyUpdate validates an API key and updates the request context with the user the key acts as
+// and the scope of the key.
+func (a *CoreMW) apiKeyUpdate(next http.Handler, key string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		k, ok, err := a.keys.AuthenticateAPIKey(ctx, key)
+		if err != nil {
+			logger.Ctx(ctx).Errorw("error on apiKeyUpdate.AuthenticateAPIKey", zap.Error(err))
+			response.Internal(w, err.Error())
+			return
+		}
+		if !ok {
+			response.Unauthenticated(w, ErrAPIKeyInvalid.Error())
+			return
+		}
+
+		if k.Scope.ReadOnly && r.Method != http.MethodGet && r.Method != http.MethodHead {
+			response.Forbidden(w, ErrAPIKeyReadOnly.Error())
+			return
+		}
+
+		// API keys never carry organization or card abilities.
+		ctx = context.WithValue(ctx, ctxKeyRoles, auth.ToAbilities(auth.RoleAuthorized(k.Username)))
+		ctx = withUser(ctx, k.Username)
+		ctx = context.WithValue(ctx, ctxKeyAPIKeyScope, &k.Scope)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// certUser returns the user the verified client certificate of a request is mapped to.
+func (a *CoreMW) certUser(r *http.Request) (username string, ok bool) {
+	if a.certs == nil || r.TLS == nil || len(r.TLS.VerifiedChains) == 0 || len(r.TLS.VerifiedChains[0]) == 0 {
+		return "", false
+	}
+	return a.certs.User(r.TLS.VerifiedChains[0][0])
+}
+
 // FakeAuth simulates authentication for testing or development purposes.
 func (a *CoreMW) FakeAuth(r *http.Request) (context.Context, bool) {
 	ctx := r.Context()
@@ -115,21 +307,60 @@ func (a *CoreMW) FakeAuth(r *http.Request) (context.Context, bool) {
 	fakeAcc := r.Header.Get(HeaderAuth)
 	fakeRoles := r.Header.Get(HeaderAuth)
 
-	if len(fakeAcc) == 0 {
+	// Real bearer tokens and API keys are always validated, so that their abilities and scopes reach the context.
+	if len(fakeAcc) == 0 || strings.HasPrefix(fakeAcc, SchemeBearer+" ") ||
+		strings.HasPrefix(fakeAcc, SchemeAPIKey+" ") {
 		return ctx, false
 	}
 
 	roles := strings.Split(fakeRoles, ",")
 
-	ctx = context.WithValue(ctx, CtxKeyUserID, fakeAcc)
+	ctx = withUser(ctx, fakeAcc)
 	ctx = context.WithValue(ctx, ctxKeyRoles, roles)
 	return ctx, true
 }
 
-// parseClaims validates and parses a JWT token using the provided public key.
-func parseClaims(token string, public *ed25519.PublicKey) (*auth.Claims, error) {
+// verifyDeviceSignature reports whether a request carries a recent signature by the device key
+// over the message built by device.RequestMessage, and whether its body matches the signed content hash.
+// The body is read to be hashed and replaced with a copy for the handlers.
+func verifyDeviceSignature(r *http.Request, deviceKey ed25519.PublicKey, sessionID string) bool {
+	timestamp := r.Header.Get(HeaderDeviceTimestamp)
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+
+	skew := time.Since(time.Unix(unix, 0))
+	if skew > device.SignatureMaxSkew || skew < -device.SignatureMaxSkew {
+		return false
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(r.Header.Get(HeaderDeviceSignature))
+	if err != nil || len(deviceKey) != ed25519.PublicKeySize {
+		return false
+	}
+
+	contentHash := r.Header.Get(HeaderContentSHA256)
+	message := device.RequestMessage(r.Method, r.URL.RequestURI(), timestamp, sessionID, contentHash)
+	if !ed25519.Verify(deviceKey, message, signature) {
+		return false
+	}
+
+	var body []byte
+	if r.Body != nil {
+		body, err = io.ReadAll(io.LimitReader(r.Body, device.MaxSignedBodySize+1))
+		if err != nil || len(body) > device.MaxSignedBodySize {
+			return false
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	return strings.EqualFold(device.ContentHash(body), contentHash)
+}
+
+// parseClaims validates and parses a JWT token using the public key of the key that signed it.
+func parseClaims(token string, publicKeys auth.KeyFunc) (*auth.Claims, error) {
 	claims := auth.Claims{}
-	err := claims.Parse(token, *public)
+	err := claims.Parse(token, publicKeys)
 	if err != nil {
 		return nil, err
 	}
@@ -144,3 +375,23 @@ func GetIssuer(ctx context.Context) (string, error) {
 	}
 	return issuer, nil
 }
+
+// GetAbilities retrieves the abilities carried by the validated token from the context.
+func GetAbilities(ctx context.Context) auth.Abilities {
+	abilities, _ := ctx.Value(ctxKeyRoles).(auth.Abilities)
+	return abilities
+}
+
+// GetSessionID retrieves the session the validated token belongs to from the context.
+// It is empty for requests authenticated without a session.
+func GetSessionID(ctx context.Context) string {
+	sessionID, _ := ctx.Value(ctxKeySessionID).(string)
+	return sessionID
+}
+
+// GetAPIKeyScope retrieves the scope of the API key making the request from the context.
+// It is nil for requests authenticated with an access token.
+func GetAPIKeyScope(ctx context.Context) *apikey.Scope {
+	scope, _ := ctx.Value(ctxKeyAPIKeyScope).(*apikey.Scope)
+	return scope
+}
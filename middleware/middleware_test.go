@@ -0,0 +1,159 @@
+package middleware
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/models/device"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthFake(t *testing.T) {
+	noKeys := func(string) (ed25519.PublicKey, bool) { return nil, false }
+
+	tests := []struct {
+		name           string
+		allowFake      bool
+		header         string
+		expectedStatus int
+		expectedIssuer string
+	}{
+		{
+			name:           "Bare username rejected",
+			header:         "john_doe",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "Bare username with roles rejected",
+			header:         "john_doe,admin",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "Missing header rejected",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "Bare username accepted with fake authentication",
+			allowFake:      true,
+			header:         "john_doe",
+			expectedStatus: http.StatusOK,
+			expectedIssuer: "john_doe",
+		},
+		{
+			name:           "Invalid bearer token rejected with fake authentication",
+			allowFake:      true,
+			header:         "Bearer john_doe",
+			expectedStatus: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mw := NewCoreMW(tt.allowFake, noKeys, nil, nil, nil)
+
+			var issuer string
+			next := func(w http.ResponseWriter, r *http.Request) {
+				issuer, _ = GetIssuer(r.Context())
+				w.WriteHeader(http.StatusOK)
+			}
+
+			for _, wrap := range []func(http.HandlerFunc) http.HandlerFunc{mw.Auth, mw.AuthKey} {
+				issuer = ""
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/user/cards", nil)
+				if tt.header != "" {
+					req.Header.Set(HeaderAuth, tt.header)
+				}
+				rr := httptest.NewRecorder()
+
+				wrap(next).ServeHTTP(rr, req)
+
+				assert.Equal(t, tt.expectedStatus, rr.Code)
+				assert.Equal(t, tt.expectedIssuer, issuer)
+			}
+		})
+	}
+}
+
+func TestVerifyDeviceSignature(t *testing.T) {
+	const (
+		sessionID = "session"
+		body      = `{"card_number":"4111111111111111"}`
+	)
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		signed    string // The body the device signed.
+		sent      string // The body the request carries.
+		hash      string // The content hash header, the hash of the signed body if empty.
+		timestamp time.Time
+		expected  bool
+	}{
+		{
+			name:      "Signed body",
+			signed:    body,
+			sent:      body,
+			timestamp: time.Now(),
+			expected:  true,
+		},
+		{
+			name:      "Signed empty body",
+			timestamp: time.Now(),
+			expected:  true,
+		},
+		{
+			name:      "Body replaced",
+			signed:    body,
+			sent:      `{"card_number":"5500000000000004"}`,
+			timestamp: time.Now(),
+		},
+		{
+			name:      "Content hash replaced with the body",
+			signed:    body,
+			sent:      "other",
+			hash:      device.ContentHash([]byte("other")),
+			timestamp: time.Now(),
+		},
+		{
+			name:      "Stale timestamp",
+			signed:    body,
+			sent:      body,
+			timestamp: time.Now().Add(-2 * device.SignatureMaxSkew),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/user/cards", strings.NewReader(tt.sent))
+			timestamp := strconv.FormatInt(tt.timestamp.Unix(), 10)
+			signedHash := device.ContentHash([]byte(tt.signed))
+			message := device.RequestMessage(req.Method, req.URL.RequestURI(), timestamp, sessionID, signedHash)
+
+			hash := tt.hash
+			if hash == "" {
+				hash = signedHash
+			}
+			req.Header.Set(HeaderDeviceTimestamp, timestamp)
+			req.Header.Set(HeaderDeviceSignature, base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, message)))
+			req.Header.Set(HeaderContentSHA256, hash)
+
+			assert.Equal(t, tt.expected, verifyDeviceSignature(req, publicKey, sessionID))
+
+			// The body stays readable for the handlers.
+			if tt.expected {
+				sent, err := io.ReadAll(req.Body)
+				require.NoError(t, err)
+				assert.Equal(t, tt.sent, string(sent))
+			}
+		})
+	}
+}
@@ -1,10 +1,11 @@
 package middleware
 
 import (
+	"fmt"
 	"net/http"
+	"runtime/debug"
 
 	"github.com/gleb-korostelev/GophKeeper/tools/logger"
-	"go.uber.org/zap"
 )
 
 // PanicMid is a middleware that recovers from panics in HTTP handlers,
@@ -12,11 +13,16 @@ import (
 func PanicMid(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		defer func() {
-			// Recover from any panic and handle the error.
-			err := recover()
-			if err != nil {
-				// Log the error along with the request URL and method.
-				logger.Error(err.(error).Error(), zap.String("url", r.RequestURI), zap.String("method", r.Method))
+			// Recover from any panic and handle the error. Panics may carry any value, not only errors.
+			p := recover()
+			if p != nil {
+				// Log the panic along with the request URL and the stack of the handler.
+				logger.Ctx(r.Context()).Errorw("panic in handler",
+					"panic", fmt.Sprint(p),
+					"url", r.RequestURI,
+					"stack", string(debug.Stack()),
+				)
+				RecordError(w, fmt.Errorf("panic: %v", p))
 
 				// Return an HTTP 500 Internal Server Error response to the client.
 				w.WriteHeader(http.StatusInternalServerError)
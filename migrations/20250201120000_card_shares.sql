@@ -0,0 +1,22 @@
+-- +goose Up
+ALTER TABLE auth.users ADD COLUMN public_key bytea;
+ALTER TABLE auth.cards ADD COLUMN item_key bytea;
+
+create table if not exists auth.card_shares
+(
+    id          bigint generated always as identity primary key,
+    card_id     bigint not null references auth.cards(id) on delete cascade,
+    grantee_id  bigint not null references auth.users(id) on delete cascade,
+    permission  text   not null,
+    wrapped_key bytea,
+    created_at  timestamp default (now() at time zone 'utc'),
+    updated_at  timestamp default (now() at time zone 'utc'),
+    constraint unique_card_grantee unique (card_id, grantee_id)
+);
+
+
+-- +goose Down
+
+DROP TABLE IF EXISTS auth.card_shares;
+ALTER TABLE auth.cards DROP COLUMN item_key;
+ALTER TABLE auth.users DROP COLUMN public_key;
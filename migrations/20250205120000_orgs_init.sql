@@ -0,0 +1,84 @@
+-- +goose Up
+create table if not exists auth.orgs
+(
+    id         bigint generated always as identity primary key,
+    name       text not null unique,
+    created_at timestamp default (now() at time zone 'utc')
+);
+
+create table if not exists auth.org_members
+(
+    org_id     bigint not null references auth.orgs(id) on delete cascade,
+    user_id    bigint not null references auth.users(id) on delete cascade,
+    role       text   not null,
+    created_at timestamp default (now() at time zone 'utc'),
+    updated_at timestamp default (now() at time zone 'utc'),
+    primary key (org_id, user_id)
+);
+
+create table if not exists auth.org_groups
+(
+    id         bigint generated always as identity primary key,
+    org_id     bigint not null references auth.orgs(id) on delete cascade,
+    name       text   not null,
+    created_at timestamp default (now() at time zone 'utc'),
+    constraint unique_org_group unique (org_id, name)
+);
+
+create table if not exists auth.org_group_members
+(
+    group_id bigint not null references auth.org_groups(id) on delete cascade,
+    user_id  bigint not null references auth.users(id) on delete cascade,
+    primary key (group_id, user_id)
+);
+
+create table if not exists auth.collections
+(
+    id         bigint generated always as identity primary key,
+    org_id     bigint not null references auth.orgs(id) on delete cascade,
+    name       text   not null,
+    created_at timestamp default (now() at time zone 'utc'),
+    constraint unique_org_collection unique (org_id, name)
+);
+
+create table if not exists auth.collection_groups
+(
+    collection_id bigint not null references auth.collections(id) on delete cascade,
+    group_id      bigint not null references auth.org_groups(id) on delete cascade,
+    primary key (collection_id, group_id)
+);
+
+create table if not exists auth.collection_cards
+(
+    collection_id bigint not null references auth.collections(id) on delete cascade,
+    card_id       bigint not null references auth.cards(id) on delete cascade,
+    primary key (collection_id, card_id)
+);
+
+-- Owners and admins see every collection of their organization,
+-- other members only the collections visible to one of their groups.
+create or replace view auth.org_visible_cards as
+select m.user_id, cc.card_id, col.org_id, col.name as collection, m.role
+from auth.collection_cards cc
+join auth.collections col on col.id = cc.collection_id
+join auth.org_members m on m.org_id = col.org_id
+where m.role in ('owner', 'admin')
+   or exists (
+        select 1
+        from auth.collection_groups cg
+        join auth.org_group_members gm on gm.group_id = cg.group_id
+        where cg.collection_id = col.id
+          and gm.user_id = m.user_id
+   );
+
+
+-- +goose Down
+
+DROP VIEW IF EXISTS auth.org_visible_cards;
+DROP TABLE IF EXISTS auth.collection_cards;
+DROP TABLE IF EXISTS auth.collection_groups;
+DROP TABLE IF EXISTS auth.collections;
+DROP TABLE IF EXISTS auth.org_group_members;
+DROP TABLE IF EXISTS auth.org_groups;
+DROP TABLE IF EXISTS auth.org_members;
+DROP TABLE IF EXISTS auth.orgs;
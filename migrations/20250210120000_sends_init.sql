@@ -0,0 +1,19 @@
+-- +goose Up
+create table if not exists auth.sends
+(
+    id            uuid primary key,
+    user_id       bigint    not null references auth.users(id) on delete cascade,
+    ciphertext    bytea     not null,
+    password_hash bytea,
+    max_views     int       not null,
+    views         int       not null default 0,
+    expires_at    timestamp not null,
+    created_at    timestamp default (now() at time zone 'utc')
+);
+
+create index if not exists sends_expires_at_idx on auth.sends (expires_at);
+
+
+-- +goose Down
+
+DROP TABLE IF EXISTS auth.sends;
@@ -0,0 +1,20 @@
+-- +goose Up
+create table if not exists auth.emergency_contacts
+(
+    id           bigint generated always as identity primary key,
+    grantor_id   bigint not null references auth.users(id) on delete cascade,
+    grantee_id   bigint not null references auth.users(id) on delete cascade,
+    wait_days    int    not null,
+    status       text   not null default 'idle',
+    requested_at timestamp,
+    created_at   timestamp default (now() at time zone 'utc'),
+    updated_at   timestamp default (now() at time zone 'utc'),
+    constraint unique_emergency_contact unique (grantor_id, grantee_id)
+);
+
+create index if not exists emergency_contacts_status_idx on auth.emergency_contacts (status);
+
+
+-- +goose Down
+
+DROP TABLE IF EXISTS auth.emergency_contacts;
@@ -0,0 +1,48 @@
+-- +goose Up
+create table if not exists auth.folders
+(
+    id         bigint generated always as identity primary key,
+    user_id    bigint not null references auth.users(id) on delete cascade,
+    parent_id  bigint references auth.folders(id) on delete cascade,
+    name       text   not null,
+    created_at timestamp default (now() at time zone 'utc')
+);
+
+create unique index if not exists folders_unique_name_idx
+    on auth.folders (user_id, coalesce(parent_id, 0), name);
+
+create table if not exists auth.tags
+(
+    id         bigint generated always as identity primary key,
+    user_id    bigint not null references auth.users(id) on delete cascade,
+    name       text   not null,
+    created_at timestamp default (now() at time zone 'utc'),
+    constraint unique_user_tag unique (user_id, name)
+);
+
+create table if not exists auth.card_tags
+(
+    card_id bigint not null references auth.cards(id) on delete cascade,
+    tag_id  bigint not null references auth.tags(id) on delete cascade,
+    primary key (card_id, tag_id)
+);
+
+alter table auth.cards add column if not exists name text not null default '';
+alter table auth.cards add column if not exists item_type text not null default 'card';
+alter table auth.cards add column if not exists folder_id bigint references auth.folders(id) on delete set null;
+
+create index if not exists cards_folder_idx on auth.cards (folder_id);
+create index if not exists cards_search_idx
+    on auth.cards using gin (to_tsvector('simple', name || ' ' || card_holder || ' ' || metadata));
+
+
+-- +goose Down
+
+DROP INDEX IF EXISTS auth.cards_search_idx;
+DROP INDEX IF EXISTS auth.cards_folder_idx;
+ALTER TABLE auth.cards DROP COLUMN IF EXISTS folder_id;
+ALTER TABLE auth.cards DROP COLUMN IF EXISTS item_type;
+ALTER TABLE auth.cards DROP COLUMN IF EXISTS name;
+DROP TABLE IF EXISTS auth.card_tags;
+DROP TABLE IF EXISTS auth.tags;
+DROP TABLE IF EXISTS auth.folders;
@@ -0,0 +1,30 @@
+-- +goose Up
+create table if not exists auth.attachments
+(
+    id           uuid primary key,
+    user_id      bigint    not null references auth.users(id) on delete cascade,
+    card_id      bigint    references auth.cards(id) on delete set null,
+    name         text      not null,
+    content_type text      not null default 'application/octet-stream',
+    size         bigint    not null check (size > 0),
+    received     bigint    not null default 0 check (received between 0 and size),
+    sha256       text      not null,
+    complete     boolean   not null default false,
+    created_at   timestamp default (now() at time zone 'utc'),
+    updated_at   timestamp default (now() at time zone 'utc')
+);
+
+create index if not exists attachments_user_id_idx on auth.attachments (user_id);
+create index if not exists attachments_card_id_idx on auth.attachments (card_id);
+
+create table if not exists auth.blobs
+(
+    key text primary key,
+    oid oid not null
+);
+
+
+-- +goose Down
+
+DROP TABLE IF EXISTS auth.blobs;
+DROP TABLE IF EXISTS auth.attachments;
@@ -0,0 +1,16 @@
+-- +goose Up
+alter table auth.cards
+    add column if not exists url      text not null default '',
+    add column if not exists login    text not null default '',
+    add column if not exists password text not null default '';
+
+create index if not exists cards_user_login_idx on auth.cards (user_id, url, login);
+
+
+-- +goose Down
+
+DROP INDEX IF EXISTS auth.cards_user_login_idx;
+ALTER TABLE auth.cards
+    DROP COLUMN IF EXISTS password,
+    DROP COLUMN IF EXISTS login,
+    DROP COLUMN IF EXISTS url;
@@ -0,0 +1,17 @@
+-- +goose Up
+create table if not exists auth.audit_log
+(
+    id         bigserial primary key,
+    user_id    bigint    references auth.users(id) on delete set null,
+    username   text      not null,
+    action     text      not null,
+    details    jsonb     not null default '{}',
+    created_at timestamp default (now() at time zone 'utc')
+);
+
+create index if not exists audit_log_user_id_idx on auth.audit_log (user_id, created_at);
+
+
+-- +goose Down
+
+DROP TABLE IF EXISTS auth.audit_log;
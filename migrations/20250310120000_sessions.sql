@@ -0,0 +1,16 @@
+-- +goose Up
+create table if not exists auth.sessions
+(
+    id         uuid primary key,
+    user_id    bigint    not null references auth.users(id) on delete cascade,
+    created_at timestamp default (now() at time zone 'utc'),
+    expires_at timestamp not null,
+    revoked_at timestamp
+);
+
+create index if not exists sessions_user_id_idx on auth.sessions (user_id);
+
+
+-- +goose Down
+
+DROP TABLE IF EXISTS auth.sessions;
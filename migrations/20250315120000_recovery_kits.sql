@@ -0,0 +1,15 @@
+-- +goose Up
+create table if not exists auth.recovery_kits
+(
+    user_id    bigint primary key references auth.users(id) on delete cascade,
+    kit_id     bigint   not null,
+    shares     smallint not null,
+    threshold  smallint not null,
+    verifier   bytea    not null,
+    created_at timestamp default (now() at time zone 'utc')
+);
+
+
+-- +goose Down
+
+DROP TABLE IF EXISTS auth.recovery_kits;
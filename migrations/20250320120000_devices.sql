@@ -0,0 +1,23 @@
+-- +goose Up
+create table if not exists auth.devices
+(
+    id           uuid primary key,
+    user_id      bigint not null references auth.users(id) on delete cascade,
+    name         text   not null,
+    platform     text   not null default '',
+    public_key   bytea  not null,
+    created_at   timestamp default (now() at time zone 'utc'),
+    last_seen_at timestamp default (now() at time zone 'utc'),
+    unique (user_id, public_key)
+);
+
+alter table auth.sessions
+    add column if not exists device_id uuid references auth.devices(id) on delete set null;
+
+create index if not exists sessions_device_id_idx on auth.sessions (device_id);
+
+
+-- +goose Down
+
+ALTER TABLE auth.sessions DROP COLUMN IF EXISTS device_id;
+DROP TABLE IF EXISTS auth.devices;
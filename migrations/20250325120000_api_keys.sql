@@ -0,0 +1,21 @@
+-- +goose Up
+create table if not exists auth.api_keys
+(
+    id           uuid primary key,
+    user_id      bigint    not null references auth.users(id) on delete cascade,
+    name         text      not null,
+    key_hash     bytea     not null,
+    items        text[]    not null default '{}',
+    folders      bigint[]  not null default '{}',
+    read_only    boolean   not null default false,
+    expires_at   timestamp not null,
+    created_at   timestamp default (now() at time zone 'utc'),
+    last_used_at timestamp
+);
+
+create index if not exists api_keys_user_id_idx on auth.api_keys (user_id);
+
+
+-- +goose Down
+
+DROP TABLE IF EXISTS auth.api_keys;
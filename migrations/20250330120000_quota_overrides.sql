@@ -0,0 +1,16 @@
+-- +goose Up
+create table if not exists auth.quota_overrides
+(
+    user_id         bigint primary key references auth.users(id) on delete cascade,
+    items           bigint,
+    bytes           bigint,
+    attachment_size bigint,
+    api_keys        bigint,
+    devices         bigint,
+    updated_at      timestamp default (now() at time zone 'utc')
+);
+
+
+-- +goose Down
+
+DROP TABLE IF EXISTS auth.quota_overrides;
@@ -0,0 +1,14 @@
+-- +goose Up
+-- Members removed from an organization used to keep their group memberships.
+delete from auth.org_group_members gm
+using auth.org_groups g
+where gm.group_id = g.id
+  and not exists (
+        select 1
+        from auth.org_members m
+        where m.org_id = g.org_id
+          and m.user_id = gm.user_id
+  );
+
+
+-- +goose Down
@@ -0,0 +1,8 @@
+-- +goose Up
+alter table auth.sends
+    add column if not exists failed_attempts int not null default 0;
+
+
+-- +goose Down
+
+ALTER TABLE auth.sends DROP COLUMN IF EXISTS failed_attempts;
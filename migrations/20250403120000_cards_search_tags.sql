@@ -0,0 +1,26 @@
+-- +goose Up
+-- The names of a card's tags are kept on the card, so that the search index covers
+-- the whole document searches match: the name, holder, metadata and tags of the card.
+alter table auth.cards add column if not exists tag_names text not null default '';
+
+update auth.cards c
+set tag_names = t.names
+from (
+    select ct.card_id, string_agg(t.name, ' ' order by t.name) as names
+    from auth.card_tags ct
+    join auth.tags t on t.id = ct.tag_id
+    group by ct.card_id
+) t
+where t.card_id = c.id;
+
+drop index if exists auth.cards_search_idx;
+create index if not exists cards_search_idx
+    on auth.cards using gin (to_tsvector('simple', name || ' ' || card_holder || ' ' || metadata || ' ' || tag_names));
+
+
+-- +goose Down
+
+DROP INDEX IF EXISTS auth.cards_search_idx;
+CREATE INDEX IF NOT EXISTS cards_search_idx
+    ON auth.cards USING gin (to_tsvector('simple', name || ' ' || card_holder || ' ' || metadata));
+ALTER TABLE auth.cards DROP COLUMN IF EXISTS tag_names;
@@ -0,0 +1,15 @@
+-- +goose Up
+create table if not exists auth.sign_in_failures
+(
+    user_id      bigint      not null references auth.users(id) on delete cascade,
+    client       text        not null,
+    failures     int         not null default 0,
+    locked_until timestamptz,
+    updated_at   timestamptz not null default now(),
+    primary key (user_id, client)
+);
+
+
+-- +goose Down
+
+DROP TABLE IF EXISTS auth.sign_in_failures;
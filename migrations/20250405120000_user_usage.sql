@@ -0,0 +1,98 @@
+-- +goose Up
+-- What each user stores, kept up to date by triggers in the transaction of every write,
+-- so that quota checks read a single row instead of summing all items and attachments of the user.
+create table if not exists auth.user_usage
+(
+    user_id bigint primary key references auth.users(id) on delete cascade,
+    items   bigint not null default 0,
+    bytes   bigint not null default 0
+);
+
+-- +goose StatementBegin
+create or replace function auth.card_bytes(c auth.cards) returns bigint
+    language sql immutable as
+$$
+select (octet_length(c.card_number) + octet_length(c.card_holder) + octet_length(c.cvv) +
+        octet_length(c.metadata) + octet_length(c.name) + octet_length(c.url) + octet_length(c.login) +
+        octet_length(c.password) + coalesce(octet_length(c.item_key), 0))::bigint
+$$;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+-- Users being deleted, or not restored yet, have no usage row to add to.
+create or replace function auth.add_usage(uid bigint, d_items bigint, d_bytes bigint) returns void
+    language sql as
+$$
+insert into auth.user_usage (user_id, items, bytes)
+select id, d_items, d_bytes
+from auth.users
+where id = uid
+on conflict (user_id) do update
+    set items = auth.user_usage.items + excluded.items,
+        bytes = auth.user_usage.bytes + excluded.bytes
+$$;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create or replace function auth.count_card_usage() returns trigger
+    language plpgsql as
+$$
+begin
+    if tg_op in ('UPDATE', 'DELETE') then
+        perform auth.add_usage(old.user_id, -1, -auth.card_bytes(old));
+    end if;
+    if tg_op in ('INSERT', 'UPDATE') then
+        perform auth.add_usage(new.user_id, 1, auth.card_bytes(new));
+    end if;
+    return null;
+end
+$$;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+create or replace function auth.count_attachment_usage() returns trigger
+    language plpgsql as
+$$
+begin
+    if tg_op in ('UPDATE', 'DELETE') then
+        perform auth.add_usage(old.user_id, 0, -old.size);
+    end if;
+    if tg_op in ('INSERT', 'UPDATE') then
+        perform auth.add_usage(new.user_id, 0, new.size);
+    end if;
+    return null;
+end
+$$;
+-- +goose StatementEnd
+
+create trigger cards_usage
+    after insert or delete or update of user_id, card_number, card_holder, cvv, metadata, name, url, login,
+        password, item_key
+    on auth.cards
+    for each row
+execute function auth.count_card_usage();
+
+create trigger attachments_usage
+    after insert or delete or update of user_id, size
+    on auth.attachments
+    for each row
+execute function auth.count_attachment_usage();
+
+insert into auth.user_usage (user_id, items, bytes)
+select u.id,
+       (select count(*) from auth.cards c where c.user_id = u.id),
+       (select coalesce(sum(auth.card_bytes(c)), 0) from auth.cards c where c.user_id = u.id) +
+       (select coalesce(sum(a.size), 0) from auth.attachments a where a.user_id = u.id)
+from auth.users u
+on conflict (user_id) do nothing;
+
+
+-- +goose Down
+
+DROP TRIGGER IF EXISTS attachments_usage ON auth.attachments;
+DROP TRIGGER IF EXISTS cards_usage ON auth.cards;
+DROP FUNCTION IF EXISTS auth.count_attachment_usage();
+DROP FUNCTION IF EXISTS auth.count_card_usage();
+DROP FUNCTION IF EXISTS auth.add_usage(bigint, bigint, bigint);
+DROP FUNCTION IF EXISTS auth.card_bytes(auth.cards);
+DROP TABLE IF EXISTS auth.user_usage;
@@ -0,0 +1,1579 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.3). DO NOT EDIT.
+
+package mock_service
+
+//go:generate minimock -i github.com/gleb-korostelev/GophKeeper/internal/handler.AccountSvc -o account_svc_mock.go -n AccountSvcMock -p mock_service
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gleb-korostelev/GophKeeper/models/account"
+	"github.com/gleb-korostelev/GophKeeper/models/quota"
+	"github.com/gojuno/minimock/v3"
+)
+
+// AccountSvcMock implements mm_handler.AccountSvc
+type AccountSvcMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcDeleteAccount          func(ctx context.Context, username string, password string) (err error)
+	funcDeleteAccountOrigin    string
+	inspectFuncDeleteAccount   func(ctx context.Context, username string, password string)
+	afterDeleteAccountCounter  uint64
+	beforeDeleteAccountCounter uint64
+	DeleteAccountMock          mAccountSvcMockDeleteAccount
+
+	funcGetPersonalData          func(ctx context.Context, username string) (p1 account.PersonalData, err error)
+	funcGetPersonalDataOrigin    string
+	inspectFuncGetPersonalData   func(ctx context.Context, username string)
+	afterGetPersonalDataCounter  uint64
+	beforeGetPersonalDataCounter uint64
+	GetPersonalDataMock          mAccountSvcMockGetPersonalData
+
+	funcGetUsage          func(ctx context.Context, username string) (r1 quota.Report, err error)
+	funcGetUsageOrigin    string
+	inspectFuncGetUsage   func(ctx context.Context, username string)
+	afterGetUsageCounter  uint64
+	beforeGetUsageCounter uint64
+	GetUsageMock          mAccountSvcMockGetUsage
+
+	funcSetQuotaOverride          func(ctx context.Context, admin string, username string, o quota.Override) (r1 quota.Report, err error)
+	funcSetQuotaOverrideOrigin    string
+	inspectFuncSetQuotaOverride   func(ctx context.Context, admin string, username string, o quota.Override)
+	afterSetQuotaOverrideCounter  uint64
+	beforeSetQuotaOverrideCounter uint64
+	SetQuotaOverrideMock          mAccountSvcMockSetQuotaOverride
+}
+
+// NewAccountSvcMock returns a mock for mm_handler.AccountSvc
+func NewAccountSvcMock(t minimock.Tester) *AccountSvcMock {
+	m := &AccountSvcMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DeleteAccountMock = mAccountSvcMockDeleteAccount{mock: m}
+	m.DeleteAccountMock.callArgs = []*AccountSvcMockDeleteAccountParams{}
+
+	m.GetPersonalDataMock = mAccountSvcMockGetPersonalData{mock: m}
+	m.GetPersonalDataMock.callArgs = []*AccountSvcMockGetPersonalDataParams{}
+
+	m.GetUsageMock = mAccountSvcMockGetUsage{mock: m}
+	m.GetUsageMock.callArgs = []*AccountSvcMockGetUsageParams{}
+
+	m.SetQuotaOverrideMock = mAccountSvcMockSetQuotaOverride{mock: m}
+	m.SetQuotaOverrideMock.callArgs = []*AccountSvcMockSetQuotaOverrideParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAccountSvcMockDeleteAccount struct {
+	optional           bool
+	mock               *AccountSvcMock
+	defaultExpectation *AccountSvcMockDeleteAccountExpectation
+	expectations       []*AccountSvcMockDeleteAccountExpectation
+
+	callArgs []*AccountSvcMockDeleteAccountParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AccountSvcMockDeleteAccountExpectation specifies expectation struct of the AccountSvc.DeleteAccount
+type AccountSvcMockDeleteAccountExpectation struct {
+	mock               *AccountSvcMock
+	params             *AccountSvcMockDeleteAccountParams
+	paramPtrs          *AccountSvcMockDeleteAccountParamPtrs
+	expectationOrigins AccountSvcMockDeleteAccountExpectationOrigins
+	results            *AccountSvcMockDeleteAccountResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AccountSvcMockDeleteAccountParams contains parameters of the AccountSvc.DeleteAccount
+type AccountSvcMockDeleteAccountParams struct {
+	ctx      context.Context
+	username string
+	password string
+}
+
+// AccountSvcMockDeleteAccountParamPtrs contains pointers to parameters of the AccountSvc.DeleteAccount
+type AccountSvcMockDeleteAccountParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	password *string
+}
+
+// AccountSvcMockDeleteAccountResults contains results of the AccountSvc.DeleteAccount
+type AccountSvcMockDeleteAccountResults struct {
+	err error
+}
+
+// AccountSvcMockDeleteAccountOrigins contains origins of expectations of the AccountSvc.DeleteAccount
+type AccountSvcMockDeleteAccountExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originPassword string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteAccount *mAccountSvcMockDeleteAccount) Optional() *mAccountSvcMockDeleteAccount {
+	mmDeleteAccount.optional = true
+	return mmDeleteAccount
+}
+
+// Expect sets up expected params for AccountSvc.DeleteAccount
+func (mmDeleteAccount *mAccountSvcMockDeleteAccount) Expect(ctx context.Context, username string, password string) *mAccountSvcMockDeleteAccount {
+	if mmDeleteAccount.mock.funcDeleteAccount != nil {
+		mmDeleteAccount.mock.t.Fatalf("AccountSvcMock.DeleteAccount mock is already set by Set")
+	}
+
+	if mmDeleteAccount.defaultExpectation == nil {
+		mmDeleteAccount.defaultExpectation = &AccountSvcMockDeleteAccountExpectation{}
+	}
+
+	if mmDeleteAccount.defaultExpectation.paramPtrs != nil {
+		mmDeleteAccount.mock.t.Fatalf("AccountSvcMock.DeleteAccount mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteAccount.defaultExpectation.params = &AccountSvcMockDeleteAccountParams{ctx, username, password}
+	mmDeleteAccount.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteAccount.expectations {
+		if minimock.Equal(e.params, mmDeleteAccount.defaultExpectation.params) {
+			mmDeleteAccount.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteAccount.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteAccount
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccountSvc.DeleteAccount
+func (mmDeleteAccount *mAccountSvcMockDeleteAccount) ExpectCtxParam1(ctx context.Context) *mAccountSvcMockDeleteAccount {
+	if mmDeleteAccount.mock.funcDeleteAccount != nil {
+		mmDeleteAccount.mock.t.Fatalf("AccountSvcMock.DeleteAccount mock is already set by Set")
+	}
+
+	if mmDeleteAccount.defaultExpectation == nil {
+		mmDeleteAccount.defaultExpectation = &AccountSvcMockDeleteAccountExpectation{}
+	}
+
+	if mmDeleteAccount.defaultExpectation.params != nil {
+		mmDeleteAccount.mock.t.Fatalf("AccountSvcMock.DeleteAccount mock is already set by Expect")
+	}
+
+	if mmDeleteAccount.defaultExpectation.paramPtrs == nil {
+		mmDeleteAccount.defaultExpectation.paramPtrs = &AccountSvcMockDeleteAccountParamPtrs{}
+	}
+	mmDeleteAccount.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteAccount.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteAccount
+}
+
+// ExpectUsernameParam2 sets up expected param username for AccountSvc.DeleteAccount
+func (mmDeleteAccount *mAccountSvcMockDeleteAccount) ExpectUsernameParam2(username string) *mAccountSvcMockDeleteAccount {
+	if mmDeleteAccount.mock.funcDeleteAccount != nil {
+		mmDeleteAccount.mock.t.Fatalf("AccountSvcMock.DeleteAccount mock is already set by Set")
+	}
+
+	if mmDeleteAccount.defaultExpectation == nil {
+		mmDeleteAccount.defaultExpectation = &AccountSvcMockDeleteAccountExpectation{}
+	}
+
+	if mmDeleteAccount.defaultExpectation.params != nil {
+		mmDeleteAccount.mock.t.Fatalf("AccountSvcMock.DeleteAccount mock is already set by Expect")
+	}
+
+	if mmDeleteAccount.defaultExpectation.paramPtrs == nil {
+		mmDeleteAccount.defaultExpectation.paramPtrs = &AccountSvcMockDeleteAccountParamPtrs{}
+	}
+	mmDeleteAccount.defaultExpectation.paramPtrs.username = &username
+	mmDeleteAccount.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmDeleteAccount
+}
+
+// ExpectPasswordParam3 sets up expected param password for AccountSvc.DeleteAccount
+func (mmDeleteAccount *mAccountSvcMockDeleteAccount) ExpectPasswordParam3(password string) *mAccountSvcMockDeleteAccount {
+	if mmDeleteAccount.mock.funcDeleteAccount != nil {
+		mmDeleteAccount.mock.t.Fatalf("AccountSvcMock.DeleteAccount mock is already set by Set")
+	}
+
+	if mmDeleteAccount.defaultExpectation == nil {
+		mmDeleteAccount.defaultExpectation = &AccountSvcMockDeleteAccountExpectation{}
+	}
+
+	if mmDeleteAccount.defaultExpectation.params != nil {
+		mmDeleteAccount.mock.t.Fatalf("AccountSvcMock.DeleteAccount mock is already set by Expect")
+	}
+
+	if mmDeleteAccount.defaultExpectation.paramPtrs == nil {
+		mmDeleteAccount.defaultExpectation.paramPtrs = &AccountSvcMockDeleteAccountParamPtrs{}
+	}
+	mmDeleteAccount.defaultExpectation.paramPtrs.password = &password
+	mmDeleteAccount.defaultExpectation.expectationOrigins.originPassword = minimock.CallerInfo(1)
+
+	return mmDeleteAccount
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccountSvc.DeleteAccount
+func (mmDeleteAccount *mAccountSvcMockDeleteAccount) Inspect(f func(ctx context.Context, username string, password string)) *mAccountSvcMockDeleteAccount {
+	if mmDeleteAccount.mock.inspectFuncDeleteAccount != nil {
+		mmDeleteAccount.mock.t.Fatalf("Inspect function is already set for AccountSvcMock.DeleteAccount")
+	}
+
+	mmDeleteAccount.mock.inspectFuncDeleteAccount = f
+
+	return mmDeleteAccount
+}
+
+// Return sets up results that will be returned by AccountSvc.DeleteAccount
+func (mmDeleteAccount *mAccountSvcMockDeleteAccount) Return(err error) *AccountSvcMock {
+	if mmDeleteAccount.mock.funcDeleteAccount != nil {
+		mmDeleteAccount.mock.t.Fatalf("AccountSvcMock.DeleteAccount mock is already set by Set")
+	}
+
+	if mmDeleteAccount.defaultExpectation == nil {
+		mmDeleteAccount.defaultExpectation = &AccountSvcMockDeleteAccountExpectation{mock: mmDeleteAccount.mock}
+	}
+	mmDeleteAccount.defaultExpectation.results = &AccountSvcMockDeleteAccountResults{err}
+	mmDeleteAccount.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteAccount.mock
+}
+
+// Set uses given function f to mock the AccountSvc.DeleteAccount method
+func (mmDeleteAccount *mAccountSvcMockDeleteAccount) Set(f func(ctx context.Context, username string, password string) (err error)) *AccountSvcMock {
+	if mmDeleteAccount.defaultExpectation != nil {
+		mmDeleteAccount.mock.t.Fatalf("Default expectation is already set for the AccountSvc.DeleteAccount method")
+	}
+
+	if len(mmDeleteAccount.expectations) > 0 {
+		mmDeleteAccount.mock.t.Fatalf("Some expectations are already set for the AccountSvc.DeleteAccount method")
+	}
+
+	mmDeleteAccount.mock.funcDeleteAccount = f
+	mmDeleteAccount.mock.funcDeleteAccountOrigin = minimock.CallerInfo(1)
+	return mmDeleteAccount.mock
+}
+
+// When sets expectation for the AccountSvc.DeleteAccount which will trigger the result defined by the following
+// Then helper
+func (mmDeleteAccount *mAccountSvcMockDeleteAccount) When(ctx context.Context, username string, password string) *AccountSvcMockDeleteAccountExpectation {
+	if mmDeleteAccount.mock.funcDeleteAccount != nil {
+		mmDeleteAccount.mock.t.Fatalf("AccountSvcMock.DeleteAccount mock is already set by Set")
+	}
+
+	expectation := &AccountSvcMockDeleteAccountExpectation{
+		mock:               mmDeleteAccount.mock,
+		params:             &AccountSvcMockDeleteAccountParams{ctx, username, password},
+		expectationOrigins: AccountSvcMockDeleteAccountExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteAccount.expectations = append(mmDeleteAccount.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccountSvc.DeleteAccount return parameters for the expectation previously defined by the When method
+func (e *AccountSvcMockDeleteAccountExpectation) Then(err error) *AccountSvcMock {
+	e.results = &AccountSvcMockDeleteAccountResults{err}
+	return e.mock
+}
+
+// Times sets number of times AccountSvc.DeleteAccount should be invoked
+func (mmDeleteAccount *mAccountSvcMockDeleteAccount) Times(n uint64) *mAccountSvcMockDeleteAccount {
+	if n == 0 {
+		mmDeleteAccount.mock.t.Fatalf("Times of AccountSvcMock.DeleteAccount mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteAccount.expectedInvocations, n)
+	mmDeleteAccount.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteAccount
+}
+
+func (mmDeleteAccount *mAccountSvcMockDeleteAccount) invocationsDone() bool {
+	if len(mmDeleteAccount.expectations) == 0 && mmDeleteAccount.defaultExpectation == nil && mmDeleteAccount.mock.funcDeleteAccount == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteAccount.mock.afterDeleteAccountCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteAccount.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteAccount implements mm_handler.AccountSvc
+func (mmDeleteAccount *AccountSvcMock) DeleteAccount(ctx context.Context, username string, password string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteAccount.beforeDeleteAccountCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteAccount.afterDeleteAccountCounter, 1)
+
+	mmDeleteAccount.t.Helper()
+
+	if mmDeleteAccount.inspectFuncDeleteAccount != nil {
+		mmDeleteAccount.inspectFuncDeleteAccount(ctx, username, password)
+	}
+
+	mm_params := AccountSvcMockDeleteAccountParams{ctx, username, password}
+
+	// Record call args
+	mmDeleteAccount.DeleteAccountMock.mutex.Lock()
+	mmDeleteAccount.DeleteAccountMock.callArgs = append(mmDeleteAccount.DeleteAccountMock.callArgs, &mm_params)
+	mmDeleteAccount.DeleteAccountMock.mutex.Unlock()
+
+	for _, e := range mmDeleteAccount.DeleteAccountMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteAccount.DeleteAccountMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteAccount.DeleteAccountMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteAccount.DeleteAccountMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteAccount.DeleteAccountMock.defaultExpectation.paramPtrs
+
+		mm_got := AccountSvcMockDeleteAccountParams{ctx, username, password}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteAccount.t.Errorf("AccountSvcMock.DeleteAccount got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteAccount.DeleteAccountMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmDeleteAccount.t.Errorf("AccountSvcMock.DeleteAccount got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteAccount.DeleteAccountMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.password != nil && !minimock.Equal(*mm_want_ptrs.password, mm_got.password) {
+				mmDeleteAccount.t.Errorf("AccountSvcMock.DeleteAccount got unexpected parameter password, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteAccount.DeleteAccountMock.defaultExpectation.expectationOrigins.originPassword, *mm_want_ptrs.password, mm_got.password, minimock.Diff(*mm_want_ptrs.password, mm_got.password))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteAccount.t.Errorf("AccountSvcMock.DeleteAccount got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteAccount.DeleteAccountMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteAccount.DeleteAccountMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteAccount.t.Fatal("No results are set for the AccountSvcMock.DeleteAccount")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteAccount.funcDeleteAccount != nil {
+		return mmDeleteAccount.funcDeleteAccount(ctx, username, password)
+	}
+	mmDeleteAccount.t.Fatalf("Unexpected call to AccountSvcMock.DeleteAccount. %v %v %v", ctx, username, password)
+	return
+}
+
+// DeleteAccountAfterCounter returns a count of finished AccountSvcMock.DeleteAccount invocations
+func (mmDeleteAccount *AccountSvcMock) DeleteAccountAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteAccount.afterDeleteAccountCounter)
+}
+
+// DeleteAccountBeforeCounter returns a count of AccountSvcMock.DeleteAccount invocations
+func (mmDeleteAccount *AccountSvcMock) DeleteAccountBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteAccount.beforeDeleteAccountCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccountSvcMock.DeleteAccount.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteAccount *mAccountSvcMockDeleteAccount) Calls() []*AccountSvcMockDeleteAccountParams {
+	mmDeleteAccount.mutex.RLock()
+
+	argCopy := make([]*AccountSvcMockDeleteAccountParams, len(mmDeleteAccount.callArgs))
+	copy(argCopy, mmDeleteAccount.callArgs)
+
+	mmDeleteAccount.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteAccountDone returns true if the count of the DeleteAccount invocations corresponds
+// the number of defined expectations
+func (m *AccountSvcMock) MinimockDeleteAccountDone() bool {
+	if m.DeleteAccountMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteAccountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteAccountMock.invocationsDone()
+}
+
+// MinimockDeleteAccountInspect logs each unmet expectation
+func (m *AccountSvcMock) MinimockDeleteAccountInspect() {
+	for _, e := range m.DeleteAccountMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccountSvcMock.DeleteAccount at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteAccountCounter := mm_atomic.LoadUint64(&m.afterDeleteAccountCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteAccountMock.defaultExpectation != nil && afterDeleteAccountCounter < 1 {
+		if m.DeleteAccountMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccountSvcMock.DeleteAccount at\n%s", m.DeleteAccountMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AccountSvcMock.DeleteAccount at\n%s with params: %#v", m.DeleteAccountMock.defaultExpectation.expectationOrigins.origin, *m.DeleteAccountMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteAccount != nil && afterDeleteAccountCounter < 1 {
+		m.t.Errorf("Expected call to AccountSvcMock.DeleteAccount at\n%s", m.funcDeleteAccountOrigin)
+	}
+
+	if !m.DeleteAccountMock.invocationsDone() && afterDeleteAccountCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccountSvcMock.DeleteAccount at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteAccountMock.expectedInvocations), m.DeleteAccountMock.expectedInvocationsOrigin, afterDeleteAccountCounter)
+	}
+}
+
+type mAccountSvcMockGetPersonalData struct {
+	optional           bool
+	mock               *AccountSvcMock
+	defaultExpectation *AccountSvcMockGetPersonalDataExpectation
+	expectations       []*AccountSvcMockGetPersonalDataExpectation
+
+	callArgs []*AccountSvcMockGetPersonalDataParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AccountSvcMockGetPersonalDataExpectation specifies expectation struct of the AccountSvc.GetPersonalData
+type AccountSvcMockGetPersonalDataExpectation struct {
+	mock               *AccountSvcMock
+	params             *AccountSvcMockGetPersonalDataParams
+	paramPtrs          *AccountSvcMockGetPersonalDataParamPtrs
+	expectationOrigins AccountSvcMockGetPersonalDataExpectationOrigins
+	results            *AccountSvcMockGetPersonalDataResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AccountSvcMockGetPersonalDataParams contains parameters of the AccountSvc.GetPersonalData
+type AccountSvcMockGetPersonalDataParams struct {
+	ctx      context.Context
+	username string
+}
+
+// AccountSvcMockGetPersonalDataParamPtrs contains pointers to parameters of the AccountSvc.GetPersonalData
+type AccountSvcMockGetPersonalDataParamPtrs struct {
+	ctx      *context.Context
+	username *string
+}
+
+// AccountSvcMockGetPersonalDataResults contains results of the AccountSvc.GetPersonalData
+type AccountSvcMockGetPersonalDataResults struct {
+	p1  account.PersonalData
+	err error
+}
+
+// AccountSvcMockGetPersonalDataOrigins contains origins of expectations of the AccountSvc.GetPersonalData
+type AccountSvcMockGetPersonalDataExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPersonalData *mAccountSvcMockGetPersonalData) Optional() *mAccountSvcMockGetPersonalData {
+	mmGetPersonalData.optional = true
+	return mmGetPersonalData
+}
+
+// Expect sets up expected params for AccountSvc.GetPersonalData
+func (mmGetPersonalData *mAccountSvcMockGetPersonalData) Expect(ctx context.Context, username string) *mAccountSvcMockGetPersonalData {
+	if mmGetPersonalData.mock.funcGetPersonalData != nil {
+		mmGetPersonalData.mock.t.Fatalf("AccountSvcMock.GetPersonalData mock is already set by Set")
+	}
+
+	if mmGetPersonalData.defaultExpectation == nil {
+		mmGetPersonalData.defaultExpectation = &AccountSvcMockGetPersonalDataExpectation{}
+	}
+
+	if mmGetPersonalData.defaultExpectation.paramPtrs != nil {
+		mmGetPersonalData.mock.t.Fatalf("AccountSvcMock.GetPersonalData mock is already set by ExpectParams functions")
+	}
+
+	mmGetPersonalData.defaultExpectation.params = &AccountSvcMockGetPersonalDataParams{ctx, username}
+	mmGetPersonalData.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPersonalData.expectations {
+		if minimock.Equal(e.params, mmGetPersonalData.defaultExpectation.params) {
+			mmGetPersonalData.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPersonalData.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPersonalData
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccountSvc.GetPersonalData
+func (mmGetPersonalData *mAccountSvcMockGetPersonalData) ExpectCtxParam1(ctx context.Context) *mAccountSvcMockGetPersonalData {
+	if mmGetPersonalData.mock.funcGetPersonalData != nil {
+		mmGetPersonalData.mock.t.Fatalf("AccountSvcMock.GetPersonalData mock is already set by Set")
+	}
+
+	if mmGetPersonalData.defaultExpectation == nil {
+		mmGetPersonalData.defaultExpectation = &AccountSvcMockGetPersonalDataExpectation{}
+	}
+
+	if mmGetPersonalData.defaultExpectation.params != nil {
+		mmGetPersonalData.mock.t.Fatalf("AccountSvcMock.GetPersonalData mock is already set by Expect")
+	}
+
+	if mmGetPersonalData.defaultExpectation.paramPtrs == nil {
+		mmGetPersonalData.defaultExpectation.paramPtrs = &AccountSvcMockGetPersonalDataParamPtrs{}
+	}
+	mmGetPersonalData.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPersonalData.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPersonalData
+}
+
+// ExpectUsernameParam2 sets up expected param username for AccountSvc.GetPersonalData
+func (mmGetPersonalData *mAccountSvcMockGetPersonalData) ExpectUsernameParam2(username string) *mAccountSvcMockGetPersonalData {
+	if mmGetPersonalData.mock.funcGetPersonalData != nil {
+		mmGetPersonalData.mock.t.Fatalf("AccountSvcMock.GetPersonalData mock is already set by Set")
+	}
+
+	if mmGetPersonalData.defaultExpectation == nil {
+		mmGetPersonalData.defaultExpectation = &AccountSvcMockGetPersonalDataExpectation{}
+	}
+
+	if mmGetPersonalData.defaultExpectation.params != nil {
+		mmGetPersonalData.mock.t.Fatalf("AccountSvcMock.GetPersonalData mock is already set by Expect")
+	}
+
+	if mmGetPersonalData.defaultExpectation.paramPtrs == nil {
+		mmGetPersonalData.defaultExpectation.paramPtrs = &AccountSvcMockGetPersonalDataParamPtrs{}
+	}
+	mmGetPersonalData.defaultExpectation.paramPtrs.username = &username
+	mmGetPersonalData.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetPersonalData
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccountSvc.GetPersonalData
+func (mmGetPersonalData *mAccountSvcMockGetPersonalData) Inspect(f func(ctx context.Context, username string)) *mAccountSvcMockGetPersonalData {
+	if mmGetPersonalData.mock.inspectFuncGetPersonalData != nil {
+		mmGetPersonalData.mock.t.Fatalf("Inspect function is already set for AccountSvcMock.GetPersonalData")
+	}
+
+	mmGetPersonalData.mock.inspectFuncGetPersonalData = f
+
+	return mmGetPersonalData
+}
+
+// Return sets up results that will be returned by AccountSvc.GetPersonalData
+func (mmGetPersonalData *mAccountSvcMockGetPersonalData) Return(p1 account.PersonalData, err error) *AccountSvcMock {
+	if mmGetPersonalData.mock.funcGetPersonalData != nil {
+		mmGetPersonalData.mock.t.Fatalf("AccountSvcMock.GetPersonalData mock is already set by Set")
+	}
+
+	if mmGetPersonalData.defaultExpectation == nil {
+		mmGetPersonalData.defaultExpectation = &AccountSvcMockGetPersonalDataExpectation{mock: mmGetPersonalData.mock}
+	}
+	mmGetPersonalData.defaultExpectation.results = &AccountSvcMockGetPersonalDataResults{p1, err}
+	mmGetPersonalData.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPersonalData.mock
+}
+
+// Set uses given function f to mock the AccountSvc.GetPersonalData method
+func (mmGetPersonalData *mAccountSvcMockGetPersonalData) Set(f func(ctx context.Context, username string) (p1 account.PersonalData, err error)) *AccountSvcMock {
+	if mmGetPersonalData.defaultExpectation != nil {
+		mmGetPersonalData.mock.t.Fatalf("Default expectation is already set for the AccountSvc.GetPersonalData method")
+	}
+
+	if len(mmGetPersonalData.expectations) > 0 {
+		mmGetPersonalData.mock.t.Fatalf("Some expectations are already set for the AccountSvc.GetPersonalData method")
+	}
+
+	mmGetPersonalData.mock.funcGetPersonalData = f
+	mmGetPersonalData.mock.funcGetPersonalDataOrigin = minimock.CallerInfo(1)
+	return mmGetPersonalData.mock
+}
+
+// When sets expectation for the AccountSvc.GetPersonalData which will trigger the result defined by the following
+// Then helper
+func (mmGetPersonalData *mAccountSvcMockGetPersonalData) When(ctx context.Context, username string) *AccountSvcMockGetPersonalDataExpectation {
+	if mmGetPersonalData.mock.funcGetPersonalData != nil {
+		mmGetPersonalData.mock.t.Fatalf("AccountSvcMock.GetPersonalData mock is already set by Set")
+	}
+
+	expectation := &AccountSvcMockGetPersonalDataExpectation{
+		mock:               mmGetPersonalData.mock,
+		params:             &AccountSvcMockGetPersonalDataParams{ctx, username},
+		expectationOrigins: AccountSvcMockGetPersonalDataExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPersonalData.expectations = append(mmGetPersonalData.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccountSvc.GetPersonalData return parameters for the expectation previously defined by the When method
+func (e *AccountSvcMockGetPersonalDataExpectation) Then(p1 account.PersonalData, err error) *AccountSvcMock {
+	e.results = &AccountSvcMockGetPersonalDataResults{p1, err}
+	return e.mock
+}
+
+// Times sets number of times AccountSvc.GetPersonalData should be invoked
+func (mmGetPersonalData *mAccountSvcMockGetPersonalData) Times(n uint64) *mAccountSvcMockGetPersonalData {
+	if n == 0 {
+		mmGetPersonalData.mock.t.Fatalf("Times of AccountSvcMock.GetPersonalData mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPersonalData.expectedInvocations, n)
+	mmGetPersonalData.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPersonalData
+}
+
+func (mmGetPersonalData *mAccountSvcMockGetPersonalData) invocationsDone() bool {
+	if len(mmGetPersonalData.expectations) == 0 && mmGetPersonalData.defaultExpectation == nil && mmGetPersonalData.mock.funcGetPersonalData == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPersonalData.mock.afterGetPersonalDataCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPersonalData.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPersonalData implements mm_handler.AccountSvc
+func (mmGetPersonalData *AccountSvcMock) GetPersonalData(ctx context.Context, username string) (p1 account.PersonalData, err error) {
+	mm_atomic.AddUint64(&mmGetPersonalData.beforeGetPersonalDataCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPersonalData.afterGetPersonalDataCounter, 1)
+
+	mmGetPersonalData.t.Helper()
+
+	if mmGetPersonalData.inspectFuncGetPersonalData != nil {
+		mmGetPersonalData.inspectFuncGetPersonalData(ctx, username)
+	}
+
+	mm_params := AccountSvcMockGetPersonalDataParams{ctx, username}
+
+	// Record call args
+	mmGetPersonalData.GetPersonalDataMock.mutex.Lock()
+	mmGetPersonalData.GetPersonalDataMock.callArgs = append(mmGetPersonalData.GetPersonalDataMock.callArgs, &mm_params)
+	mmGetPersonalData.GetPersonalDataMock.mutex.Unlock()
+
+	for _, e := range mmGetPersonalData.GetPersonalDataMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.p1, e.results.err
+		}
+	}
+
+	if mmGetPersonalData.GetPersonalDataMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPersonalData.GetPersonalDataMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPersonalData.GetPersonalDataMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPersonalData.GetPersonalDataMock.defaultExpectation.paramPtrs
+
+		mm_got := AccountSvcMockGetPersonalDataParams{ctx, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPersonalData.t.Errorf("AccountSvcMock.GetPersonalData got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPersonalData.GetPersonalDataMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetPersonalData.t.Errorf("AccountSvcMock.GetPersonalData got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPersonalData.GetPersonalDataMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPersonalData.t.Errorf("AccountSvcMock.GetPersonalData got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPersonalData.GetPersonalDataMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPersonalData.GetPersonalDataMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPersonalData.t.Fatal("No results are set for the AccountSvcMock.GetPersonalData")
+		}
+		return (*mm_results).p1, (*mm_results).err
+	}
+	if mmGetPersonalData.funcGetPersonalData != nil {
+		return mmGetPersonalData.funcGetPersonalData(ctx, username)
+	}
+	mmGetPersonalData.t.Fatalf("Unexpected call to AccountSvcMock.GetPersonalData. %v %v", ctx, username)
+	return
+}
+
+// GetPersonalDataAfterCounter returns a count of finished AccountSvcMock.GetPersonalData invocations
+func (mmGetPersonalData *AccountSvcMock) GetPersonalDataAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPersonalData.afterGetPersonalDataCounter)
+}
+
+// GetPersonalDataBeforeCounter returns a count of AccountSvcMock.GetPersonalData invocations
+func (mmGetPersonalData *AccountSvcMock) GetPersonalDataBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPersonalData.beforeGetPersonalDataCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccountSvcMock.GetPersonalData.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPersonalData *mAccountSvcMockGetPersonalData) Calls() []*AccountSvcMockGetPersonalDataParams {
+	mmGetPersonalData.mutex.RLock()
+
+	argCopy := make([]*AccountSvcMockGetPersonalDataParams, len(mmGetPersonalData.callArgs))
+	copy(argCopy, mmGetPersonalData.callArgs)
+
+	mmGetPersonalData.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPersonalDataDone returns true if the count of the GetPersonalData invocations corresponds
+// the number of defined expectations
+func (m *AccountSvcMock) MinimockGetPersonalDataDone() bool {
+	if m.GetPersonalDataMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPersonalDataMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPersonalDataMock.invocationsDone()
+}
+
+// MinimockGetPersonalDataInspect logs each unmet expectation
+func (m *AccountSvcMock) MinimockGetPersonalDataInspect() {
+	for _, e := range m.GetPersonalDataMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccountSvcMock.GetPersonalData at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPersonalDataCounter := mm_atomic.LoadUint64(&m.afterGetPersonalDataCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPersonalDataMock.defaultExpectation != nil && afterGetPersonalDataCounter < 1 {
+		if m.GetPersonalDataMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccountSvcMock.GetPersonalData at\n%s", m.GetPersonalDataMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AccountSvcMock.GetPersonalData at\n%s with params: %#v", m.GetPersonalDataMock.defaultExpectation.expectationOrigins.origin, *m.GetPersonalDataMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPersonalData != nil && afterGetPersonalDataCounter < 1 {
+		m.t.Errorf("Expected call to AccountSvcMock.GetPersonalData at\n%s", m.funcGetPersonalDataOrigin)
+	}
+
+	if !m.GetPersonalDataMock.invocationsDone() && afterGetPersonalDataCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccountSvcMock.GetPersonalData at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPersonalDataMock.expectedInvocations), m.GetPersonalDataMock.expectedInvocationsOrigin, afterGetPersonalDataCounter)
+	}
+}
+
+type mAccountSvcMockGetUsage struct {
+	optional           bool
+	mock               *AccountSvcMock
+	defaultExpectation *AccountSvcMockGetUsageExpectation
+	expectations       []*AccountSvcMockGetUsageExpectation
+
+	callArgs []*AccountSvcMockGetUsageParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AccountSvcMockGetUsageExpectation specifies expectation struct of the AccountSvc.GetUsage
+type AccountSvcMockGetUsageExpectation struct {
+	mock               *AccountSvcMock
+	params             *AccountSvcMockGetUsageParams
+	paramPtrs          *AccountSvcMockGetUsageParamPtrs
+	expectationOrigins AccountSvcMockGetUsageExpectationOrigins
+	results            *AccountSvcMockGetUsageResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AccountSvcMockGetUsageParams contains parameters of the AccountSvc.GetUsage
+type AccountSvcMockGetUsageParams struct {
+	ctx      context.Context
+	username string
+}
+
+// AccountSvcMockGetUsageParamPtrs contains pointers to parameters of the AccountSvc.GetUsage
+type AccountSvcMockGetUsageParamPtrs struct {
+	ctx      *context.Context
+	username *string
+}
+
+// AccountSvcMockGetUsageResults contains results of the AccountSvc.GetUsage
+type AccountSvcMockGetUsageResults struct {
+	r1  quota.Report
+	err error
+}
+
+// AccountSvcMockGetUsageOrigins contains origins of expectations of the AccountSvc.GetUsage
+type AccountSvcMockGetUsageExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUsage *mAccountSvcMockGetUsage) Optional() *mAccountSvcMockGetUsage {
+	mmGetUsage.optional = true
+	return mmGetUsage
+}
+
+// Expect sets up expected params for AccountSvc.GetUsage
+func (mmGetUsage *mAccountSvcMockGetUsage) Expect(ctx context.Context, username string) *mAccountSvcMockGetUsage {
+	if mmGetUsage.mock.funcGetUsage != nil {
+		mmGetUsage.mock.t.Fatalf("AccountSvcMock.GetUsage mock is already set by Set")
+	}
+
+	if mmGetUsage.defaultExpectation == nil {
+		mmGetUsage.defaultExpectation = &AccountSvcMockGetUsageExpectation{}
+	}
+
+	if mmGetUsage.defaultExpectation.paramPtrs != nil {
+		mmGetUsage.mock.t.Fatalf("AccountSvcMock.GetUsage mock is already set by ExpectParams functions")
+	}
+
+	mmGetUsage.defaultExpectation.params = &AccountSvcMockGetUsageParams{ctx, username}
+	mmGetUsage.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUsage.expectations {
+		if minimock.Equal(e.params, mmGetUsage.defaultExpectation.params) {
+			mmGetUsage.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUsage.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUsage
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccountSvc.GetUsage
+func (mmGetUsage *mAccountSvcMockGetUsage) ExpectCtxParam1(ctx context.Context) *mAccountSvcMockGetUsage {
+	if mmGetUsage.mock.funcGetUsage != nil {
+		mmGetUsage.mock.t.Fatalf("AccountSvcMock.GetUsage mock is already set by Set")
+	}
+
+	if mmGetUsage.defaultExpectation == nil {
+		mmGetUsage.defaultExpectation = &AccountSvcMockGetUsageExpectation{}
+	}
+
+	if mmGetUsage.defaultExpectation.params != nil {
+		mmGetUsage.mock.t.Fatalf("AccountSvcMock.GetUsage mock is already set by Expect")
+	}
+
+	if mmGetUsage.defaultExpectation.paramPtrs == nil {
+		mmGetUsage.defaultExpectation.paramPtrs = &AccountSvcMockGetUsageParamPtrs{}
+	}
+	mmGetUsage.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUsage.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetUsage
+}
+
+// ExpectUsernameParam2 sets up expected param username for AccountSvc.GetUsage
+func (mmGetUsage *mAccountSvcMockGetUsage) ExpectUsernameParam2(username string) *mAccountSvcMockGetUsage {
+	if mmGetUsage.mock.funcGetUsage != nil {
+		mmGetUsage.mock.t.Fatalf("AccountSvcMock.GetUsage mock is already set by Set")
+	}
+
+	if mmGetUsage.defaultExpectation == nil {
+		mmGetUsage.defaultExpectation = &AccountSvcMockGetUsageExpectation{}
+	}
+
+	if mmGetUsage.defaultExpectation.params != nil {
+		mmGetUsage.mock.t.Fatalf("AccountSvcMock.GetUsage mock is already set by Expect")
+	}
+
+	if mmGetUsage.defaultExpectation.paramPtrs == nil {
+		mmGetUsage.defaultExpectation.paramPtrs = &AccountSvcMockGetUsageParamPtrs{}
+	}
+	mmGetUsage.defaultExpectation.paramPtrs.username = &username
+	mmGetUsage.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetUsage
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccountSvc.GetUsage
+func (mmGetUsage *mAccountSvcMockGetUsage) Inspect(f func(ctx context.Context, username string)) *mAccountSvcMockGetUsage {
+	if mmGetUsage.mock.inspectFuncGetUsage != nil {
+		mmGetUsage.mock.t.Fatalf("Inspect function is already set for AccountSvcMock.GetUsage")
+	}
+
+	mmGetUsage.mock.inspectFuncGetUsage = f
+
+	return mmGetUsage
+}
+
+// Return sets up results that will be returned by AccountSvc.GetUsage
+func (mmGetUsage *mAccountSvcMockGetUsage) Return(r1 quota.Report, err error) *AccountSvcMock {
+	if mmGetUsage.mock.funcGetUsage != nil {
+		mmGetUsage.mock.t.Fatalf("AccountSvcMock.GetUsage mock is already set by Set")
+	}
+
+	if mmGetUsage.defaultExpectation == nil {
+		mmGetUsage.defaultExpectation = &AccountSvcMockGetUsageExpectation{mock: mmGetUsage.mock}
+	}
+	mmGetUsage.defaultExpectation.results = &AccountSvcMockGetUsageResults{r1, err}
+	mmGetUsage.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUsage.mock
+}
+
+// Set uses given function f to mock the AccountSvc.GetUsage method
+func (mmGetUsage *mAccountSvcMockGetUsage) Set(f func(ctx context.Context, username string) (r1 quota.Report, err error)) *AccountSvcMock {
+	if mmGetUsage.defaultExpectation != nil {
+		mmGetUsage.mock.t.Fatalf("Default expectation is already set for the AccountSvc.GetUsage method")
+	}
+
+	if len(mmGetUsage.expectations) > 0 {
+		mmGetUsage.mock.t.Fatalf("Some expectations are already set for the AccountSvc.GetUsage method")
+	}
+
+	mmGetUsage.mock.funcGetUsage = f
+	mmGetUsage.mock.funcGetUsageOrigin = minimock.CallerInfo(1)
+	return mmGetUsage.mock
+}
+
+// When sets expectation for the AccountSvc.GetUsage which will trigger the result defined by the following
+// Then helper
+func (mmGetUsage *mAccountSvcMockGetUsage) When(ctx context.Context, username string) *AccountSvcMockGetUsageExpectation {
+	if mmGetUsage.mock.funcGetUsage != nil {
+		mmGetUsage.mock.t.Fatalf("AccountSvcMock.GetUsage mock is already set by Set")
+	}
+
+	expectation := &AccountSvcMockGetUsageExpectation{
+		mock:               mmGetUsage.mock,
+		params:             &AccountSvcMockGetUsageParams{ctx, username},
+		expectationOrigins: AccountSvcMockGetUsageExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUsage.expectations = append(mmGetUsage.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccountSvc.GetUsage return parameters for the expectation previously defined by the When method
+func (e *AccountSvcMockGetUsageExpectation) Then(r1 quota.Report, err error) *AccountSvcMock {
+	e.results = &AccountSvcMockGetUsageResults{r1, err}
+	return e.mock
+}
+
+// Times sets number of times AccountSvc.GetUsage should be invoked
+func (mmGetUsage *mAccountSvcMockGetUsage) Times(n uint64) *mAccountSvcMockGetUsage {
+	if n == 0 {
+		mmGetUsage.mock.t.Fatalf("Times of AccountSvcMock.GetUsage mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUsage.expectedInvocations, n)
+	mmGetUsage.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUsage
+}
+
+func (mmGetUsage *mAccountSvcMockGetUsage) invocationsDone() bool {
+	if len(mmGetUsage.expectations) == 0 && mmGetUsage.defaultExpectation == nil && mmGetUsage.mock.funcGetUsage == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUsage.mock.afterGetUsageCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUsage.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUsage implements mm_handler.AccountSvc
+func (mmGetUsage *AccountSvcMock) GetUsage(ctx context.Context, username string) (r1 quota.Report, err error) {
+	mm_atomic.AddUint64(&mmGetUsage.beforeGetUsageCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUsage.afterGetUsageCounter, 1)
+
+	mmGetUsage.t.Helper()
+
+	if mmGetUsage.inspectFuncGetUsage != nil {
+		mmGetUsage.inspectFuncGetUsage(ctx, username)
+	}
+
+	mm_params := AccountSvcMockGetUsageParams{ctx, username}
+
+	// Record call args
+	mmGetUsage.GetUsageMock.mutex.Lock()
+	mmGetUsage.GetUsageMock.callArgs = append(mmGetUsage.GetUsageMock.callArgs, &mm_params)
+	mmGetUsage.GetUsageMock.mutex.Unlock()
+
+	for _, e := range mmGetUsage.GetUsageMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.r1, e.results.err
+		}
+	}
+
+	if mmGetUsage.GetUsageMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUsage.GetUsageMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUsage.GetUsageMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUsage.GetUsageMock.defaultExpectation.paramPtrs
+
+		mm_got := AccountSvcMockGetUsageParams{ctx, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUsage.t.Errorf("AccountSvcMock.GetUsage got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUsage.GetUsageMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetUsage.t.Errorf("AccountSvcMock.GetUsage got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUsage.GetUsageMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUsage.t.Errorf("AccountSvcMock.GetUsage got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUsage.GetUsageMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUsage.GetUsageMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUsage.t.Fatal("No results are set for the AccountSvcMock.GetUsage")
+		}
+		return (*mm_results).r1, (*mm_results).err
+	}
+	if mmGetUsage.funcGetUsage != nil {
+		return mmGetUsage.funcGetUsage(ctx, username)
+	}
+	mmGetUsage.t.Fatalf("Unexpected call to AccountSvcMock.GetUsage. %v %v", ctx, username)
+	return
+}
+
+// GetUsageAfterCounter returns a count of finished AccountSvcMock.GetUsage invocations
+func (mmGetUsage *AccountSvcMock) GetUsageAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUsage.afterGetUsageCounter)
+}
+
+// GetUsageBeforeCounter returns a count of AccountSvcMock.GetUsage invocations
+func (mmGetUsage *AccountSvcMock) GetUsageBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUsage.beforeGetUsageCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccountSvcMock.GetUsage.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUsage *mAccountSvcMockGetUsage) Calls() []*AccountSvcMockGetUsageParams {
+	mmGetUsage.mutex.RLock()
+
+	argCopy := make([]*AccountSvcMockGetUsageParams, len(mmGetUsage.callArgs))
+	copy(argCopy, mmGetUsage.callArgs)
+
+	mmGetUsage.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUsageDone returns true if the count of the GetUsage invocations corresponds
+// the number of defined expectations
+func (m *AccountSvcMock) MinimockGetUsageDone() bool {
+	if m.GetUsageMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUsageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUsageMock.invocationsDone()
+}
+
+// MinimockGetUsageInspect logs each unmet expectation
+func (m *AccountSvcMock) MinimockGetUsageInspect() {
+	for _, e := range m.GetUsageMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccountSvcMock.GetUsage at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUsageCounter := mm_atomic.LoadUint64(&m.afterGetUsageCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUsageMock.defaultExpectation != nil && afterGetUsageCounter < 1 {
+		if m.GetUsageMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccountSvcMock.GetUsage at\n%s", m.GetUsageMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AccountSvcMock.GetUsage at\n%s with params: %#v", m.GetUsageMock.defaultExpectation.expectationOrigins.origin, *m.GetUsageMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUsage != nil && afterGetUsageCounter < 1 {
+		m.t.Errorf("Expected call to AccountSvcMock.GetUsage at\n%s", m.funcGetUsageOrigin)
+	}
+
+	if !m.GetUsageMock.invocationsDone() && afterGetUsageCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccountSvcMock.GetUsage at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUsageMock.expectedInvocations), m.GetUsageMock.expectedInvocationsOrigin, afterGetUsageCounter)
+	}
+}
+
+type mAccountSvcMockSetQuotaOverride struct {
+	optional           bool
+	mock               *AccountSvcMock
+	defaultExpectation *AccountSvcMockSetQuotaOverrideExpectation
+	expectations       []*AccountSvcMockSetQuotaOverrideExpectation
+
+	callArgs []*AccountSvcMockSetQuotaOverrideParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AccountSvcMockSetQuotaOverrideExpectation specifies expectation struct of the AccountSvc.SetQuotaOverride
+type AccountSvcMockSetQuotaOverrideExpectation struct {
+	mock               *AccountSvcMock
+	params             *AccountSvcMockSetQuotaOverrideParams
+	paramPtrs          *AccountSvcMockSetQuotaOverrideParamPtrs
+	expectationOrigins AccountSvcMockSetQuotaOverrideExpectationOrigins
+	results            *AccountSvcMockSetQuotaOverrideResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AccountSvcMockSetQuotaOverrideParams contains parameters of the AccountSvc.SetQuotaOverride
+type AccountSvcMockSetQuotaOverrideParams struct {
+	ctx      context.Context
+	admin    string
+	username string
+	o        quota.Override
+}
+
+// AccountSvcMockSetQuotaOverrideParamPtrs contains pointers to parameters of the AccountSvc.SetQuotaOverride
+type AccountSvcMockSetQuotaOverrideParamPtrs struct {
+	ctx      *context.Context
+	admin    *string
+	username *string
+	o        *quota.Override
+}
+
+// AccountSvcMockSetQuotaOverrideResults contains results of the AccountSvc.SetQuotaOverride
+type AccountSvcMockSetQuotaOverrideResults struct {
+	r1  quota.Report
+	err error
+}
+
+// AccountSvcMockSetQuotaOverrideOrigins contains origins of expectations of the AccountSvc.SetQuotaOverride
+type AccountSvcMockSetQuotaOverrideExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originAdmin    string
+	originUsername string
+	originO        string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetQuotaOverride *mAccountSvcMockSetQuotaOverride) Optional() *mAccountSvcMockSetQuotaOverride {
+	mmSetQuotaOverride.optional = true
+	return mmSetQuotaOverride
+}
+
+// Expect sets up expected params for AccountSvc.SetQuotaOverride
+func (mmSetQuotaOverride *mAccountSvcMockSetQuotaOverride) Expect(ctx context.Context, admin string, username string, o quota.Override) *mAccountSvcMockSetQuotaOverride {
+	if mmSetQuotaOverride.mock.funcSetQuotaOverride != nil {
+		mmSetQuotaOverride.mock.t.Fatalf("AccountSvcMock.SetQuotaOverride mock is already set by Set")
+	}
+
+	if mmSetQuotaOverride.defaultExpectation == nil {
+		mmSetQuotaOverride.defaultExpectation = &AccountSvcMockSetQuotaOverrideExpectation{}
+	}
+
+	if mmSetQuotaOverride.defaultExpectation.paramPtrs != nil {
+		mmSetQuotaOverride.mock.t.Fatalf("AccountSvcMock.SetQuotaOverride mock is already set by ExpectParams functions")
+	}
+
+	mmSetQuotaOverride.defaultExpectation.params = &AccountSvcMockSetQuotaOverrideParams{ctx, admin, username, o}
+	mmSetQuotaOverride.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetQuotaOverride.expectations {
+		if minimock.Equal(e.params, mmSetQuotaOverride.defaultExpectation.params) {
+			mmSetQuotaOverride.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetQuotaOverride.defaultExpectation.params)
+		}
+	}
+
+	return mmSetQuotaOverride
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AccountSvc.SetQuotaOverride
+func (mmSetQuotaOverride *mAccountSvcMockSetQuotaOverride) ExpectCtxParam1(ctx context.Context) *mAccountSvcMockSetQuotaOverride {
+	if mmSetQuotaOverride.mock.funcSetQuotaOverride != nil {
+		mmSetQuotaOverride.mock.t.Fatalf("AccountSvcMock.SetQuotaOverride mock is already set by Set")
+	}
+
+	if mmSetQuotaOverride.defaultExpectation == nil {
+		mmSetQuotaOverride.defaultExpectation = &AccountSvcMockSetQuotaOverrideExpectation{}
+	}
+
+	if mmSetQuotaOverride.defaultExpectation.params != nil {
+		mmSetQuotaOverride.mock.t.Fatalf("AccountSvcMock.SetQuotaOverride mock is already set by Expect")
+	}
+
+	if mmSetQuotaOverride.defaultExpectation.paramPtrs == nil {
+		mmSetQuotaOverride.defaultExpectation.paramPtrs = &AccountSvcMockSetQuotaOverrideParamPtrs{}
+	}
+	mmSetQuotaOverride.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetQuotaOverride.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetQuotaOverride
+}
+
+// ExpectAdminParam2 sets up expected param admin for AccountSvc.SetQuotaOverride
+func (mmSetQuotaOverride *mAccountSvcMockSetQuotaOverride) ExpectAdminParam2(admin string) *mAccountSvcMockSetQuotaOverride {
+	if mmSetQuotaOverride.mock.funcSetQuotaOverride != nil {
+		mmSetQuotaOverride.mock.t.Fatalf("AccountSvcMock.SetQuotaOverride mock is already set by Set")
+	}
+
+	if mmSetQuotaOverride.defaultExpectation == nil {
+		mmSetQuotaOverride.defaultExpectation = &AccountSvcMockSetQuotaOverrideExpectation{}
+	}
+
+	if mmSetQuotaOverride.defaultExpectation.params != nil {
+		mmSetQuotaOverride.mock.t.Fatalf("AccountSvcMock.SetQuotaOverride mock is already set by Expect")
+	}
+
+	if mmSetQuotaOverride.defaultExpectation.paramPtrs == nil {
+		mmSetQuotaOverride.defaultExpectation.paramPtrs = &AccountSvcMockSetQuotaOverrideParamPtrs{}
+	}
+	mmSetQuotaOverride.defaultExpectation.paramPtrs.admin = &admin
+	mmSetQuotaOverride.defaultExpectation.expectationOrigins.originAdmin = minimock.CallerInfo(1)
+
+	return mmSetQuotaOverride
+}
+
+// ExpectUsernameParam3 sets up expected param username for AccountSvc.SetQuotaOverride
+func (mmSetQuotaOverride *mAccountSvcMockSetQuotaOverride) ExpectUsernameParam3(username string) *mAccountSvcMockSetQuotaOverride {
+	if mmSetQuotaOverride.mock.funcSetQuotaOverride != nil {
+		mmSetQuotaOverride.mock.t.Fatalf("AccountSvcMock.SetQuotaOverride mock is already set by Set")
+	}
+
+	if mmSetQuotaOverride.defaultExpectation == nil {
+		mmSetQuotaOverride.defaultExpectation = &AccountSvcMockSetQuotaOverrideExpectation{}
+	}
+
+	if mmSetQuotaOverride.defaultExpectation.params != nil {
+		mmSetQuotaOverride.mock.t.Fatalf("AccountSvcMock.SetQuotaOverride mock is already set by Expect")
+	}
+
+	if mmSetQuotaOverride.defaultExpectation.paramPtrs == nil {
+		mmSetQuotaOverride.defaultExpectation.paramPtrs = &AccountSvcMockSetQuotaOverrideParamPtrs{}
+	}
+	mmSetQuotaOverride.defaultExpectation.paramPtrs.username = &username
+	mmSetQuotaOverride.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmSetQuotaOverride
+}
+
+// ExpectOParam4 sets up expected param o for AccountSvc.SetQuotaOverride
+func (mmSetQuotaOverride *mAccountSvcMockSetQuotaOverride) ExpectOParam4(o quota.Override) *mAccountSvcMockSetQuotaOverride {
+	if mmSetQuotaOverride.mock.funcSetQuotaOverride != nil {
+		mmSetQuotaOverride.mock.t.Fatalf("AccountSvcMock.SetQuotaOverride mock is already set by Set")
+	}
+
+	if mmSetQuotaOverride.defaultExpectation == nil {
+		mmSetQuotaOverride.defaultExpectation = &AccountSvcMockSetQuotaOverrideExpectation{}
+	}
+
+	if mmSetQuotaOverride.defaultExpectation.params != nil {
+		mmSetQuotaOverride.mock.t.Fatalf("AccountSvcMock.SetQuotaOverride mock is already set by Expect")
+	}
+
+	if mmSetQuotaOverride.defaultExpectation.paramPtrs == nil {
+		mmSetQuotaOverride.defaultExpectation.paramPtrs = &AccountSvcMockSetQuotaOverrideParamPtrs{}
+	}
+	mmSetQuotaOverride.defaultExpectation.paramPtrs.o = &o
+	mmSetQuotaOverride.defaultExpectation.expectationOrigins.originO = minimock.CallerInfo(1)
+
+	return mmSetQuotaOverride
+}
+
+// Inspect accepts an inspector function that has same arguments as the AccountSvc.SetQuotaOverride
+func (mmSetQuotaOverride *mAccountSvcMockSetQuotaOverride) Inspect(f func(ctx context.Context, admin string, username string, o quota.Override)) *mAccountSvcMockSetQuotaOverride {
+	if mmSetQuotaOverride.mock.inspectFuncSetQuotaOverride != nil {
+		mmSetQuotaOverride.mock.t.Fatalf("Inspect function is already set for AccountSvcMock.SetQuotaOverride")
+	}
+
+	mmSetQuotaOverride.mock.inspectFuncSetQuotaOverride = f
+
+	return mmSetQuotaOverride
+}
+
+// Return sets up results that will be returned by AccountSvc.SetQuotaOverride
+func (mmSetQuotaOverride *mAccountSvcMockSetQuotaOverride) Return(r1 quota.Report, err error) *AccountSvcMock {
+	if mmSetQuotaOverride.mock.funcSetQuotaOverride != nil {
+		mmSetQuotaOverride.mock.t.Fatalf("AccountSvcMock.SetQuotaOverride mock is already set by Set")
+	}
+
+	if mmSetQuotaOverride.defaultExpectation == nil {
+		mmSetQuotaOverride.defaultExpectation = &AccountSvcMockSetQuotaOverrideExpectation{mock: mmSetQuotaOverride.mock}
+	}
+	mmSetQuotaOverride.defaultExpectation.results = &AccountSvcMockSetQuotaOverrideResults{r1, err}
+	mmSetQuotaOverride.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetQuotaOverride.mock
+}
+
+// Set uses given function f to mock the AccountSvc.SetQuotaOverride method
+func (mmSetQuotaOverride *mAccountSvcMockSetQuotaOverride) Set(f func(ctx context.Context, admin string, username string, o quota.Override) (r1 quota.Report, err error)) *AccountSvcMock {
+	if mmSetQuotaOverride.defaultExpectation != nil {
+		mmSetQuotaOverride.mock.t.Fatalf("Default expectation is already set for the AccountSvc.SetQuotaOverride method")
+	}
+
+	if len(mmSetQuotaOverride.expectations) > 0 {
+		mmSetQuotaOverride.mock.t.Fatalf("Some expectations are already set for the AccountSvc.SetQuotaOverride method")
+	}
+
+	mmSetQuotaOverride.mock.funcSetQuotaOverride = f
+	mmSetQuotaOverride.mock.funcSetQuotaOverrideOrigin = minimock.CallerInfo(1)
+	return mmSetQuotaOverride.mock
+}
+
+// When sets expectation for the AccountSvc.SetQuotaOverride which will trigger the result defined by the following
+// Then helper
+func (mmSetQuotaOverride *mAccountSvcMockSetQuotaOverride) When(ctx context.Context, admin string, username string, o quota.Override) *AccountSvcMockSetQuotaOverrideExpectation {
+	if mmSetQuotaOverride.mock.funcSetQuotaOverride != nil {
+		mmSetQuotaOverride.mock.t.Fatalf("AccountSvcMock.SetQuotaOverride mock is already set by Set")
+	}
+
+	expectation := &AccountSvcMockSetQuotaOverrideExpectation{
+		mock:               mmSetQuotaOverride.mock,
+		params:             &AccountSvcMockSetQuotaOverrideParams{ctx, admin, username, o},
+		expectationOrigins: AccountSvcMockSetQuotaOverrideExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetQuotaOverride.expectations = append(mmSetQuotaOverride.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AccountSvc.SetQuotaOverride return parameters for the expectation previously defined by the When method
+func (e *AccountSvcMockSetQuotaOverrideExpectation) Then(r1 quota.Report, err error) *AccountSvcMock {
+	e.results = &AccountSvcMockSetQuotaOverrideResults{r1, err}
+	return e.mock
+}
+
+// Times sets number of times AccountSvc.SetQuotaOverride should be invoked
+func (mmSetQuotaOverride *mAccountSvcMockSetQuotaOverride) Times(n uint64) *mAccountSvcMockSetQuotaOverride {
+	if n == 0 {
+		mmSetQuotaOverride.mock.t.Fatalf("Times of AccountSvcMock.SetQuotaOverride mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetQuotaOverride.expectedInvocations, n)
+	mmSetQuotaOverride.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetQuotaOverride
+}
+
+func (mmSetQuotaOverride *mAccountSvcMockSetQuotaOverride) invocationsDone() bool {
+	if len(mmSetQuotaOverride.expectations) == 0 && mmSetQuotaOverride.defaultExpectation == nil && mmSetQuotaOverride.mock.funcSetQuotaOverride == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetQuotaOverride.mock.afterSetQuotaOverrideCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetQuotaOverride.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetQuotaOverride implements mm_handler.AccountSvc
+func (mmSetQuotaOverride *AccountSvcMock) SetQuotaOverride(ctx context.Context, admin string, username string, o quota.Override) (r1 quota.Report, err error) {
+	mm_atomic.AddUint64(&mmSetQuotaOverride.beforeSetQuotaOverrideCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetQuotaOverride.afterSetQuotaOverrideCounter, 1)
+
+	mmSetQuotaOverride.t.Helper()
+
+	if mmSetQuotaOverride.inspectFuncSetQuotaOverride != nil {
+		mmSetQuotaOverride.inspectFuncSetQuotaOverride(ctx, admin, username, o)
+	}
+
+	mm_params := AccountSvcMockSetQuotaOverrideParams{ctx, admin, username, o}
+
+	// Record call args
+	mmSetQuotaOverride.SetQuotaOverrideMock.mutex.Lock()
+	mmSetQuotaOverride.SetQuotaOverrideMock.callArgs = append(mmSetQuotaOverride.SetQuotaOverrideMock.callArgs, &mm_params)
+	mmSetQuotaOverride.SetQuotaOverrideMock.mutex.Unlock()
+
+	for _, e := range mmSetQuotaOverride.SetQuotaOverrideMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.r1, e.results.err
+		}
+	}
+
+	if mmSetQuotaOverride.SetQuotaOverrideMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetQuotaOverride.SetQuotaOverrideMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetQuotaOverride.SetQuotaOverrideMock.defaultExpectation.params
+		mm_want_ptrs := mmSetQuotaOverride.SetQuotaOverrideMock.defaultExpectation.paramPtrs
+
+		mm_got := AccountSvcMockSetQuotaOverrideParams{ctx, admin, username, o}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetQuotaOverride.t.Errorf("AccountSvcMock.SetQuotaOverride got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetQuotaOverride.SetQuotaOverrideMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.admin != nil && !minimock.Equal(*mm_want_ptrs.admin, mm_got.admin) {
+				mmSetQuotaOverride.t.Errorf("AccountSvcMock.SetQuotaOverride got unexpected parameter admin, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetQuotaOverride.SetQuotaOverrideMock.defaultExpectation.expectationOrigins.originAdmin, *mm_want_ptrs.admin, mm_got.admin, minimock.Diff(*mm_want_ptrs.admin, mm_got.admin))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmSetQuotaOverride.t.Errorf("AccountSvcMock.SetQuotaOverride got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetQuotaOverride.SetQuotaOverrideMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.o != nil && !minimock.Equal(*mm_want_ptrs.o, mm_got.o) {
+				mmSetQuotaOverride.t.Errorf("AccountSvcMock.SetQuotaOverride got unexpected parameter o, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetQuotaOverride.SetQuotaOverrideMock.defaultExpectation.expectationOrigins.originO, *mm_want_ptrs.o, mm_got.o, minimock.Diff(*mm_want_ptrs.o, mm_got.o))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetQuotaOverride.t.Errorf("AccountSvcMock.SetQuotaOverride got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetQuotaOverride.SetQuotaOverrideMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetQuotaOverride.SetQuotaOverrideMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetQuotaOverride.t.Fatal("No results are set for the AccountSvcMock.SetQuotaOverride")
+		}
+		return (*mm_results).r1, (*mm_results).err
+	}
+	if mmSetQuotaOverride.funcSetQuotaOverride != nil {
+		return mmSetQuotaOverride.funcSetQuotaOverride(ctx, admin, username, o)
+	}
+	mmSetQuotaOverride.t.Fatalf("Unexpected call to AccountSvcMock.SetQuotaOverride. %v %v %v %v", ctx, admin, username, o)
+	return
+}
+
+// SetQuotaOverrideAfterCounter returns a count of finished AccountSvcMock.SetQuotaOverride invocations
+func (mmSetQuotaOverride *AccountSvcMock) SetQuotaOverrideAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetQuotaOverride.afterSetQuotaOverrideCounter)
+}
+
+// SetQuotaOverrideBeforeCounter returns a count of AccountSvcMock.SetQuotaOverride invocations
+func (mmSetQuotaOverride *AccountSvcMock) SetQuotaOverrideBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetQuotaOverride.beforeSetQuotaOverrideCounter)
+}
+
+// Calls returns a list of arguments used in each call to AccountSvcMock.SetQuotaOverride.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetQuotaOverride *mAccountSvcMockSetQuotaOverride) Calls() []*AccountSvcMockSetQuotaOverrideParams {
+	mmSetQuotaOverride.mutex.RLock()
+
+	argCopy := make([]*AccountSvcMockSetQuotaOverrideParams, len(mmSetQuotaOverride.callArgs))
+	copy(argCopy, mmSetQuotaOverride.callArgs)
+
+	mmSetQuotaOverride.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetQuotaOverrideDone returns true if the count of the SetQuotaOverride invocations corresponds
+// the number of defined expectations
+func (m *AccountSvcMock) MinimockSetQuotaOverrideDone() bool {
+	if m.SetQuotaOverrideMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetQuotaOverrideMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetQuotaOverrideMock.invocationsDone()
+}
+
+// MinimockSetQuotaOverrideInspect logs each unmet expectation
+func (m *AccountSvcMock) MinimockSetQuotaOverrideInspect() {
+	for _, e := range m.SetQuotaOverrideMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AccountSvcMock.SetQuotaOverride at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetQuotaOverrideCounter := mm_atomic.LoadUint64(&m.afterSetQuotaOverrideCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetQuotaOverrideMock.defaultExpectation != nil && afterSetQuotaOverrideCounter < 1 {
+		if m.SetQuotaOverrideMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AccountSvcMock.SetQuotaOverride at\n%s", m.SetQuotaOverrideMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AccountSvcMock.SetQuotaOverride at\n%s with params: %#v", m.SetQuotaOverrideMock.defaultExpectation.expectationOrigins.origin, *m.SetQuotaOverrideMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetQuotaOverride != nil && afterSetQuotaOverrideCounter < 1 {
+		m.t.Errorf("Expected call to AccountSvcMock.SetQuotaOverride at\n%s", m.funcSetQuotaOverrideOrigin)
+	}
+
+	if !m.SetQuotaOverrideMock.invocationsDone() && afterSetQuotaOverrideCounter > 0 {
+		m.t.Errorf("Expected %d calls to AccountSvcMock.SetQuotaOverride at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetQuotaOverrideMock.expectedInvocations), m.SetQuotaOverrideMock.expectedInvocationsOrigin, afterSetQuotaOverrideCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AccountSvcMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockDeleteAccountInspect()
+
+			m.MinimockGetPersonalDataInspect()
+
+			m.MinimockGetUsageInspect()
+
+			m.MinimockSetQuotaOverrideInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AccountSvcMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AccountSvcMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDeleteAccountDone() &&
+		m.MinimockGetPersonalDataDone() &&
+		m.MinimockGetUsageDone() &&
+		m.MinimockSetQuotaOverrideDone()
+}
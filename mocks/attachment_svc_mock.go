@@ -0,0 +1,2062 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.3). DO NOT EDIT.
+
+package mock_service
+
+//go:generate minimock -i github.com/gleb-korostelev/GophKeeper/internal/handler.AttachmentSvc -o attachment_svc_mock.go -n AttachmentSvcMock -p mock_service
+
+import (
+	"context"
+	"io"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gleb-korostelev/GophKeeper/models/attachment"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
+	"github.com/gojuno/minimock/v3"
+)
+
+// AttachmentSvcMock implements mm_handler.AttachmentSvc
+type AttachmentSvcMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateAttachment          func(ctx context.Context, a attachment.Attachment) (id string, err error)
+	funcCreateAttachmentOrigin    string
+	inspectFuncCreateAttachment   func(ctx context.Context, a attachment.Attachment)
+	afterCreateAttachmentCounter  uint64
+	beforeCreateAttachmentCounter uint64
+	CreateAttachmentMock          mAttachmentSvcMockCreateAttachment
+
+	funcDeleteAttachment          func(ctx context.Context, username string, id string) (err error)
+	funcDeleteAttachmentOrigin    string
+	inspectFuncDeleteAttachment   func(ctx context.Context, username string, id string)
+	afterDeleteAttachmentCounter  uint64
+	beforeDeleteAttachmentCounter uint64
+	DeleteAttachmentMock          mAttachmentSvcMockDeleteAttachment
+
+	funcGetAttachments          func(ctx context.Context, username string, cardNumber string, page pagination.Request) (attachments []attachment.Attachment, next string, err error)
+	funcGetAttachmentsOrigin    string
+	inspectFuncGetAttachments   func(ctx context.Context, username string, cardNumber string, page pagination.Request)
+	afterGetAttachmentsCounter  uint64
+	beforeGetAttachmentsCounter uint64
+	GetAttachmentsMock          mAttachmentSvcMockGetAttachments
+
+	funcOpenAttachment          func(ctx context.Context, username string, id string) (a1 attachment.Attachment, r1 io.ReadCloser, err error)
+	funcOpenAttachmentOrigin    string
+	inspectFuncOpenAttachment   func(ctx context.Context, username string, id string)
+	afterOpenAttachmentCounter  uint64
+	beforeOpenAttachmentCounter uint64
+	OpenAttachmentMock          mAttachmentSvcMockOpenAttachment
+
+	funcUploadChunk          func(ctx context.Context, username string, id string, rng attachment.Range, body io.Reader) (a1 attachment.Attachment, err error)
+	funcUploadChunkOrigin    string
+	inspectFuncUploadChunk   func(ctx context.Context, username string, id string, rng attachment.Range, body io.Reader)
+	afterUploadChunkCounter  uint64
+	beforeUploadChunkCounter uint64
+	UploadChunkMock          mAttachmentSvcMockUploadChunk
+}
+
+// NewAttachmentSvcMock returns a mock for mm_handler.AttachmentSvc
+func NewAttachmentSvcMock(t minimock.Tester) *AttachmentSvcMock {
+	m := &AttachmentSvcMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateAttachmentMock = mAttachmentSvcMockCreateAttachment{mock: m}
+	m.CreateAttachmentMock.callArgs = []*AttachmentSvcMockCreateAttachmentParams{}
+
+	m.DeleteAttachmentMock = mAttachmentSvcMockDeleteAttachment{mock: m}
+	m.DeleteAttachmentMock.callArgs = []*AttachmentSvcMockDeleteAttachmentParams{}
+
+	m.GetAttachmentsMock = mAttachmentSvcMockGetAttachments{mock: m}
+	m.GetAttachmentsMock.callArgs = []*AttachmentSvcMockGetAttachmentsParams{}
+
+	m.OpenAttachmentMock = mAttachmentSvcMockOpenAttachment{mock: m}
+	m.OpenAttachmentMock.callArgs = []*AttachmentSvcMockOpenAttachmentParams{}
+
+	m.UploadChunkMock = mAttachmentSvcMockUploadChunk{mock: m}
+	m.UploadChunkMock.callArgs = []*AttachmentSvcMockUploadChunkParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mAttachmentSvcMockCreateAttachment struct {
+	optional           bool
+	mock               *AttachmentSvcMock
+	defaultExpectation *AttachmentSvcMockCreateAttachmentExpectation
+	expectations       []*AttachmentSvcMockCreateAttachmentExpectation
+
+	callArgs []*AttachmentSvcMockCreateAttachmentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AttachmentSvcMockCreateAttachmentExpectation specifies expectation struct of the AttachmentSvc.CreateAttachment
+type AttachmentSvcMockCreateAttachmentExpectation struct {
+	mock               *AttachmentSvcMock
+	params             *AttachmentSvcMockCreateAttachmentParams
+	paramPtrs          *AttachmentSvcMockCreateAttachmentParamPtrs
+	expectationOrigins AttachmentSvcMockCreateAttachmentExpectationOrigins
+	results            *AttachmentSvcMockCreateAttachmentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AttachmentSvcMockCreateAttachmentParams contains parameters of the AttachmentSvc.CreateAttachment
+type AttachmentSvcMockCreateAttachmentParams struct {
+	ctx context.Context
+	a   attachment.Attachment
+}
+
+// AttachmentSvcMockCreateAttachmentParamPtrs contains pointers to parameters of the AttachmentSvc.CreateAttachment
+type AttachmentSvcMockCreateAttachmentParamPtrs struct {
+	ctx *context.Context
+	a   *attachment.Attachment
+}
+
+// AttachmentSvcMockCreateAttachmentResults contains results of the AttachmentSvc.CreateAttachment
+type AttachmentSvcMockCreateAttachmentResults struct {
+	id  string
+	err error
+}
+
+// AttachmentSvcMockCreateAttachmentOrigins contains origins of expectations of the AttachmentSvc.CreateAttachment
+type AttachmentSvcMockCreateAttachmentExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originA   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateAttachment *mAttachmentSvcMockCreateAttachment) Optional() *mAttachmentSvcMockCreateAttachment {
+	mmCreateAttachment.optional = true
+	return mmCreateAttachment
+}
+
+// Expect sets up expected params for AttachmentSvc.CreateAttachment
+func (mmCreateAttachment *mAttachmentSvcMockCreateAttachment) Expect(ctx context.Context, a attachment.Attachment) *mAttachmentSvcMockCreateAttachment {
+	if mmCreateAttachment.mock.funcCreateAttachment != nil {
+		mmCreateAttachment.mock.t.Fatalf("AttachmentSvcMock.CreateAttachment mock is already set by Set")
+	}
+
+	if mmCreateAttachment.defaultExpectation == nil {
+		mmCreateAttachment.defaultExpectation = &AttachmentSvcMockCreateAttachmentExpectation{}
+	}
+
+	if mmCreateAttachment.defaultExpectation.paramPtrs != nil {
+		mmCreateAttachment.mock.t.Fatalf("AttachmentSvcMock.CreateAttachment mock is already set by ExpectParams functions")
+	}
+
+	mmCreateAttachment.defaultExpectation.params = &AttachmentSvcMockCreateAttachmentParams{ctx, a}
+	mmCreateAttachment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateAttachment.expectations {
+		if minimock.Equal(e.params, mmCreateAttachment.defaultExpectation.params) {
+			mmCreateAttachment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateAttachment.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateAttachment
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AttachmentSvc.CreateAttachment
+func (mmCreateAttachment *mAttachmentSvcMockCreateAttachment) ExpectCtxParam1(ctx context.Context) *mAttachmentSvcMockCreateAttachment {
+	if mmCreateAttachment.mock.funcCreateAttachment != nil {
+		mmCreateAttachment.mock.t.Fatalf("AttachmentSvcMock.CreateAttachment mock is already set by Set")
+	}
+
+	if mmCreateAttachment.defaultExpectation == nil {
+		mmCreateAttachment.defaultExpectation = &AttachmentSvcMockCreateAttachmentExpectation{}
+	}
+
+	if mmCreateAttachment.defaultExpectation.params != nil {
+		mmCreateAttachment.mock.t.Fatalf("AttachmentSvcMock.CreateAttachment mock is already set by Expect")
+	}
+
+	if mmCreateAttachment.defaultExpectation.paramPtrs == nil {
+		mmCreateAttachment.defaultExpectation.paramPtrs = &AttachmentSvcMockCreateAttachmentParamPtrs{}
+	}
+	mmCreateAttachment.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateAttachment.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateAttachment
+}
+
+// ExpectAParam2 sets up expected param a for AttachmentSvc.CreateAttachment
+func (mmCreateAttachment *mAttachmentSvcMockCreateAttachment) ExpectAParam2(a attachment.Attachment) *mAttachmentSvcMockCreateAttachment {
+	if mmCreateAttachment.mock.funcCreateAttachment != nil {
+		mmCreateAttachment.mock.t.Fatalf("AttachmentSvcMock.CreateAttachment mock is already set by Set")
+	}
+
+	if mmCreateAttachment.defaultExpectation == nil {
+		mmCreateAttachment.defaultExpectation = &AttachmentSvcMockCreateAttachmentExpectation{}
+	}
+
+	if mmCreateAttachment.defaultExpectation.params != nil {
+		mmCreateAttachment.mock.t.Fatalf("AttachmentSvcMock.CreateAttachment mock is already set by Expect")
+	}
+
+	if mmCreateAttachment.defaultExpectation.paramPtrs == nil {
+		mmCreateAttachment.defaultExpectation.paramPtrs = &AttachmentSvcMockCreateAttachmentParamPtrs{}
+	}
+	mmCreateAttachment.defaultExpectation.paramPtrs.a = &a
+	mmCreateAttachment.defaultExpectation.expectationOrigins.originA = minimock.CallerInfo(1)
+
+	return mmCreateAttachment
+}
+
+// Inspect accepts an inspector function that has same arguments as the AttachmentSvc.CreateAttachment
+func (mmCreateAttachment *mAttachmentSvcMockCreateAttachment) Inspect(f func(ctx context.Context, a attachment.Attachment)) *mAttachmentSvcMockCreateAttachment {
+	if mmCreateAttachment.mock.inspectFuncCreateAttachment != nil {
+		mmCreateAttachment.mock.t.Fatalf("Inspect function is already set for AttachmentSvcMock.CreateAttachment")
+	}
+
+	mmCreateAttachment.mock.inspectFuncCreateAttachment = f
+
+	return mmCreateAttachment
+}
+
+// Return sets up results that will be returned by AttachmentSvc.CreateAttachment
+func (mmCreateAttachment *mAttachmentSvcMockCreateAttachment) Return(id string, err error) *AttachmentSvcMock {
+	if mmCreateAttachment.mock.funcCreateAttachment != nil {
+		mmCreateAttachment.mock.t.Fatalf("AttachmentSvcMock.CreateAttachment mock is already set by Set")
+	}
+
+	if mmCreateAttachment.defaultExpectation == nil {
+		mmCreateAttachment.defaultExpectation = &AttachmentSvcMockCreateAttachmentExpectation{mock: mmCreateAttachment.mock}
+	}
+	mmCreateAttachment.defaultExpectation.results = &AttachmentSvcMockCreateAttachmentResults{id, err}
+	mmCreateAttachment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateAttachment.mock
+}
+
+// Set uses given function f to mock the AttachmentSvc.CreateAttachment method
+func (mmCreateAttachment *mAttachmentSvcMockCreateAttachment) Set(f func(ctx context.Context, a attachment.Attachment) (id string, err error)) *AttachmentSvcMock {
+	if mmCreateAttachment.defaultExpectation != nil {
+		mmCreateAttachment.mock.t.Fatalf("Default expectation is already set for the AttachmentSvc.CreateAttachment method")
+	}
+
+	if len(mmCreateAttachment.expectations) > 0 {
+		mmCreateAttachment.mock.t.Fatalf("Some expectations are already set for the AttachmentSvc.CreateAttachment method")
+	}
+
+	mmCreateAttachment.mock.funcCreateAttachment = f
+	mmCreateAttachment.mock.funcCreateAttachmentOrigin = minimock.CallerInfo(1)
+	return mmCreateAttachment.mock
+}
+
+// When sets expectation for the AttachmentSvc.CreateAttachment which will trigger the result defined by the following
+// Then helper
+func (mmCreateAttachment *mAttachmentSvcMockCreateAttachment) When(ctx context.Context, a attachment.Attachment) *AttachmentSvcMockCreateAttachmentExpectation {
+	if mmCreateAttachment.mock.funcCreateAttachment != nil {
+		mmCreateAttachment.mock.t.Fatalf("AttachmentSvcMock.CreateAttachment mock is already set by Set")
+	}
+
+	expectation := &AttachmentSvcMockCreateAttachmentExpectation{
+		mock:               mmCreateAttachment.mock,
+		params:             &AttachmentSvcMockCreateAttachmentParams{ctx, a},
+		expectationOrigins: AttachmentSvcMockCreateAttachmentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateAttachment.expectations = append(mmCreateAttachment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AttachmentSvc.CreateAttachment return parameters for the expectation previously defined by the When method
+func (e *AttachmentSvcMockCreateAttachmentExpectation) Then(id string, err error) *AttachmentSvcMock {
+	e.results = &AttachmentSvcMockCreateAttachmentResults{id, err}
+	return e.mock
+}
+
+// Times sets number of times AttachmentSvc.CreateAttachment should be invoked
+func (mmCreateAttachment *mAttachmentSvcMockCreateAttachment) Times(n uint64) *mAttachmentSvcMockCreateAttachment {
+	if n == 0 {
+		mmCreateAttachment.mock.t.Fatalf("Times of AttachmentSvcMock.CreateAttachment mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateAttachment.expectedInvocations, n)
+	mmCreateAttachment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateAttachment
+}
+
+func (mmCreateAttachment *mAttachmentSvcMockCreateAttachment) invocationsDone() bool {
+	if len(mmCreateAttachment.expectations) == 0 && mmCreateAttachment.defaultExpectation == nil && mmCreateAttachment.mock.funcCreateAttachment == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateAttachment.mock.afterCreateAttachmentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateAttachment.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateAttachment implements mm_handler.AttachmentSvc
+func (mmCreateAttachment *AttachmentSvcMock) CreateAttachment(ctx context.Context, a attachment.Attachment) (id string, err error) {
+	mm_atomic.AddUint64(&mmCreateAttachment.beforeCreateAttachmentCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateAttachment.afterCreateAttachmentCounter, 1)
+
+	mmCreateAttachment.t.Helper()
+
+	if mmCreateAttachment.inspectFuncCreateAttachment != nil {
+		mmCreateAttachment.inspectFuncCreateAttachment(ctx, a)
+	}
+
+	mm_params := AttachmentSvcMockCreateAttachmentParams{ctx, a}
+
+	// Record call args
+	mmCreateAttachment.CreateAttachmentMock.mutex.Lock()
+	mmCreateAttachment.CreateAttachmentMock.callArgs = append(mmCreateAttachment.CreateAttachmentMock.callArgs, &mm_params)
+	mmCreateAttachment.CreateAttachmentMock.mutex.Unlock()
+
+	for _, e := range mmCreateAttachment.CreateAttachmentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.id, e.results.err
+		}
+	}
+
+	if mmCreateAttachment.CreateAttachmentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateAttachment.CreateAttachmentMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateAttachment.CreateAttachmentMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateAttachment.CreateAttachmentMock.defaultExpectation.paramPtrs
+
+		mm_got := AttachmentSvcMockCreateAttachmentParams{ctx, a}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateAttachment.t.Errorf("AttachmentSvcMock.CreateAttachment got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateAttachment.CreateAttachmentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.a != nil && !minimock.Equal(*mm_want_ptrs.a, mm_got.a) {
+				mmCreateAttachment.t.Errorf("AttachmentSvcMock.CreateAttachment got unexpected parameter a, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateAttachment.CreateAttachmentMock.defaultExpectation.expectationOrigins.originA, *mm_want_ptrs.a, mm_got.a, minimock.Diff(*mm_want_ptrs.a, mm_got.a))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateAttachment.t.Errorf("AttachmentSvcMock.CreateAttachment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateAttachment.CreateAttachmentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateAttachment.CreateAttachmentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateAttachment.t.Fatal("No results are set for the AttachmentSvcMock.CreateAttachment")
+		}
+		return (*mm_results).id, (*mm_results).err
+	}
+	if mmCreateAttachment.funcCreateAttachment != nil {
+		return mmCreateAttachment.funcCreateAttachment(ctx, a)
+	}
+	mmCreateAttachment.t.Fatalf("Unexpected call to AttachmentSvcMock.CreateAttachment. %v %v", ctx, a)
+	return
+}
+
+// CreateAttachmentAfterCounter returns a count of finished AttachmentSvcMock.CreateAttachment invocations
+func (mmCreateAttachment *AttachmentSvcMock) CreateAttachmentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAttachment.afterCreateAttachmentCounter)
+}
+
+// CreateAttachmentBeforeCounter returns a count of AttachmentSvcMock.CreateAttachment invocations
+func (mmCreateAttachment *AttachmentSvcMock) CreateAttachmentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAttachment.beforeCreateAttachmentCounter)
+}
+
+// Calls returns a list of arguments used in each call to AttachmentSvcMock.CreateAttachment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateAttachment *mAttachmentSvcMockCreateAttachment) Calls() []*AttachmentSvcMockCreateAttachmentParams {
+	mmCreateAttachment.mutex.RLock()
+
+	argCopy := make([]*AttachmentSvcMockCreateAttachmentParams, len(mmCreateAttachment.callArgs))
+	copy(argCopy, mmCreateAttachment.callArgs)
+
+	mmCreateAttachment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateAttachmentDone returns true if the count of the CreateAttachment invocations corresponds
+// the number of defined expectations
+func (m *AttachmentSvcMock) MinimockCreateAttachmentDone() bool {
+	if m.CreateAttachmentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateAttachmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateAttachmentMock.invocationsDone()
+}
+
+// MinimockCreateAttachmentInspect logs each unmet expectation
+func (m *AttachmentSvcMock) MinimockCreateAttachmentInspect() {
+	for _, e := range m.CreateAttachmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AttachmentSvcMock.CreateAttachment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateAttachmentCounter := mm_atomic.LoadUint64(&m.afterCreateAttachmentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateAttachmentMock.defaultExpectation != nil && afterCreateAttachmentCounter < 1 {
+		if m.CreateAttachmentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AttachmentSvcMock.CreateAttachment at\n%s", m.CreateAttachmentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AttachmentSvcMock.CreateAttachment at\n%s with params: %#v", m.CreateAttachmentMock.defaultExpectation.expectationOrigins.origin, *m.CreateAttachmentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateAttachment != nil && afterCreateAttachmentCounter < 1 {
+		m.t.Errorf("Expected call to AttachmentSvcMock.CreateAttachment at\n%s", m.funcCreateAttachmentOrigin)
+	}
+
+	if !m.CreateAttachmentMock.invocationsDone() && afterCreateAttachmentCounter > 0 {
+		m.t.Errorf("Expected %d calls to AttachmentSvcMock.CreateAttachment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateAttachmentMock.expectedInvocations), m.CreateAttachmentMock.expectedInvocationsOrigin, afterCreateAttachmentCounter)
+	}
+}
+
+type mAttachmentSvcMockDeleteAttachment struct {
+	optional           bool
+	mock               *AttachmentSvcMock
+	defaultExpectation *AttachmentSvcMockDeleteAttachmentExpectation
+	expectations       []*AttachmentSvcMockDeleteAttachmentExpectation
+
+	callArgs []*AttachmentSvcMockDeleteAttachmentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AttachmentSvcMockDeleteAttachmentExpectation specifies expectation struct of the AttachmentSvc.DeleteAttachment
+type AttachmentSvcMockDeleteAttachmentExpectation struct {
+	mock               *AttachmentSvcMock
+	params             *AttachmentSvcMockDeleteAttachmentParams
+	paramPtrs          *AttachmentSvcMockDeleteAttachmentParamPtrs
+	expectationOrigins AttachmentSvcMockDeleteAttachmentExpectationOrigins
+	results            *AttachmentSvcMockDeleteAttachmentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AttachmentSvcMockDeleteAttachmentParams contains parameters of the AttachmentSvc.DeleteAttachment
+type AttachmentSvcMockDeleteAttachmentParams struct {
+	ctx      context.Context
+	username string
+	id       string
+}
+
+// AttachmentSvcMockDeleteAttachmentParamPtrs contains pointers to parameters of the AttachmentSvc.DeleteAttachment
+type AttachmentSvcMockDeleteAttachmentParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	id       *string
+}
+
+// AttachmentSvcMockDeleteAttachmentResults contains results of the AttachmentSvc.DeleteAttachment
+type AttachmentSvcMockDeleteAttachmentResults struct {
+	err error
+}
+
+// AttachmentSvcMockDeleteAttachmentOrigins contains origins of expectations of the AttachmentSvc.DeleteAttachment
+type AttachmentSvcMockDeleteAttachmentExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originId       string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteAttachment *mAttachmentSvcMockDeleteAttachment) Optional() *mAttachmentSvcMockDeleteAttachment {
+	mmDeleteAttachment.optional = true
+	return mmDeleteAttachment
+}
+
+// Expect sets up expected params for AttachmentSvc.DeleteAttachment
+func (mmDeleteAttachment *mAttachmentSvcMockDeleteAttachment) Expect(ctx context.Context, username string, id string) *mAttachmentSvcMockDeleteAttachment {
+	if mmDeleteAttachment.mock.funcDeleteAttachment != nil {
+		mmDeleteAttachment.mock.t.Fatalf("AttachmentSvcMock.DeleteAttachment mock is already set by Set")
+	}
+
+	if mmDeleteAttachment.defaultExpectation == nil {
+		mmDeleteAttachment.defaultExpectation = &AttachmentSvcMockDeleteAttachmentExpectation{}
+	}
+
+	if mmDeleteAttachment.defaultExpectation.paramPtrs != nil {
+		mmDeleteAttachment.mock.t.Fatalf("AttachmentSvcMock.DeleteAttachment mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteAttachment.defaultExpectation.params = &AttachmentSvcMockDeleteAttachmentParams{ctx, username, id}
+	mmDeleteAttachment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteAttachment.expectations {
+		if minimock.Equal(e.params, mmDeleteAttachment.defaultExpectation.params) {
+			mmDeleteAttachment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteAttachment.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteAttachment
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AttachmentSvc.DeleteAttachment
+func (mmDeleteAttachment *mAttachmentSvcMockDeleteAttachment) ExpectCtxParam1(ctx context.Context) *mAttachmentSvcMockDeleteAttachment {
+	if mmDeleteAttachment.mock.funcDeleteAttachment != nil {
+		mmDeleteAttachment.mock.t.Fatalf("AttachmentSvcMock.DeleteAttachment mock is already set by Set")
+	}
+
+	if mmDeleteAttachment.defaultExpectation == nil {
+		mmDeleteAttachment.defaultExpectation = &AttachmentSvcMockDeleteAttachmentExpectation{}
+	}
+
+	if mmDeleteAttachment.defaultExpectation.params != nil {
+		mmDeleteAttachment.mock.t.Fatalf("AttachmentSvcMock.DeleteAttachment mock is already set by Expect")
+	}
+
+	if mmDeleteAttachment.defaultExpectation.paramPtrs == nil {
+		mmDeleteAttachment.defaultExpectation.paramPtrs = &AttachmentSvcMockDeleteAttachmentParamPtrs{}
+	}
+	mmDeleteAttachment.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteAttachment.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteAttachment
+}
+
+// ExpectUsernameParam2 sets up expected param username for AttachmentSvc.DeleteAttachment
+func (mmDeleteAttachment *mAttachmentSvcMockDeleteAttachment) ExpectUsernameParam2(username string) *mAttachmentSvcMockDeleteAttachment {
+	if mmDeleteAttachment.mock.funcDeleteAttachment != nil {
+		mmDeleteAttachment.mock.t.Fatalf("AttachmentSvcMock.DeleteAttachment mock is already set by Set")
+	}
+
+	if mmDeleteAttachment.defaultExpectation == nil {
+		mmDeleteAttachment.defaultExpectation = &AttachmentSvcMockDeleteAttachmentExpectation{}
+	}
+
+	if mmDeleteAttachment.defaultExpectation.params != nil {
+		mmDeleteAttachment.mock.t.Fatalf("AttachmentSvcMock.DeleteAttachment mock is already set by Expect")
+	}
+
+	if mmDeleteAttachment.defaultExpectation.paramPtrs == nil {
+		mmDeleteAttachment.defaultExpectation.paramPtrs = &AttachmentSvcMockDeleteAttachmentParamPtrs{}
+	}
+	mmDeleteAttachment.defaultExpectation.paramPtrs.username = &username
+	mmDeleteAttachment.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmDeleteAttachment
+}
+
+// ExpectIdParam3 sets up expected param id for AttachmentSvc.DeleteAttachment
+func (mmDeleteAttachment *mAttachmentSvcMockDeleteAttachment) ExpectIdParam3(id string) *mAttachmentSvcMockDeleteAttachment {
+	if mmDeleteAttachment.mock.funcDeleteAttachment != nil {
+		mmDeleteAttachment.mock.t.Fatalf("AttachmentSvcMock.DeleteAttachment mock is already set by Set")
+	}
+
+	if mmDeleteAttachment.defaultExpectation == nil {
+		mmDeleteAttachment.defaultExpectation = &AttachmentSvcMockDeleteAttachmentExpectation{}
+	}
+
+	if mmDeleteAttachment.defaultExpectation.params != nil {
+		mmDeleteAttachment.mock.t.Fatalf("AttachmentSvcMock.DeleteAttachment mock is already set by Expect")
+	}
+
+	if mmDeleteAttachment.defaultExpectation.paramPtrs == nil {
+		mmDeleteAttachment.defaultExpectation.paramPtrs = &AttachmentSvcMockDeleteAttachmentParamPtrs{}
+	}
+	mmDeleteAttachment.defaultExpectation.paramPtrs.id = &id
+	mmDeleteAttachment.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeleteAttachment
+}
+
+// Inspect accepts an inspector function that has same arguments as the AttachmentSvc.DeleteAttachment
+func (mmDeleteAttachment *mAttachmentSvcMockDeleteAttachment) Inspect(f func(ctx context.Context, username string, id string)) *mAttachmentSvcMockDeleteAttachment {
+	if mmDeleteAttachment.mock.inspectFuncDeleteAttachment != nil {
+		mmDeleteAttachment.mock.t.Fatalf("Inspect function is already set for AttachmentSvcMock.DeleteAttachment")
+	}
+
+	mmDeleteAttachment.mock.inspectFuncDeleteAttachment = f
+
+	return mmDeleteAttachment
+}
+
+// Return sets up results that will be returned by AttachmentSvc.DeleteAttachment
+func (mmDeleteAttachment *mAttachmentSvcMockDeleteAttachment) Return(err error) *AttachmentSvcMock {
+	if mmDeleteAttachment.mock.funcDeleteAttachment != nil {
+		mmDeleteAttachment.mock.t.Fatalf("AttachmentSvcMock.DeleteAttachment mock is already set by Set")
+	}
+
+	if mmDeleteAttachment.defaultExpectation == nil {
+		mmDeleteAttachment.defaultExpectation = &AttachmentSvcMockDeleteAttachmentExpectation{mock: mmDeleteAttachment.mock}
+	}
+	mmDeleteAttachment.defaultExpectation.results = &AttachmentSvcMockDeleteAttachmentResults{err}
+	mmDeleteAttachment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteAttachment.mock
+}
+
+// Set uses given function f to mock the AttachmentSvc.DeleteAttachment method
+func (mmDeleteAttachment *mAttachmentSvcMockDeleteAttachment) Set(f func(ctx context.Context, username string, id string) (err error)) *AttachmentSvcMock {
+	if mmDeleteAttachment.defaultExpectation != nil {
+		mmDeleteAttachment.mock.t.Fatalf("Default expectation is already set for the AttachmentSvc.DeleteAttachment method")
+	}
+
+	if len(mmDeleteAttachment.expectations) > 0 {
+		mmDeleteAttachment.mock.t.Fatalf("Some expectations are already set for the AttachmentSvc.DeleteAttachment method")
+	}
+
+	mmDeleteAttachment.mock.funcDeleteAttachment = f
+	mmDeleteAttachment.mock.funcDeleteAttachmentOrigin = minimock.CallerInfo(1)
+	return mmDeleteAttachment.mock
+}
+
+// When sets expectation for the AttachmentSvc.DeleteAttachment which will trigger the result defined by the following
+// Then helper
+func (mmDeleteAttachment *mAttachmentSvcMockDeleteAttachment) When(ctx context.Context, username string, id string) *AttachmentSvcMockDeleteAttachmentExpectation {
+	if mmDeleteAttachment.mock.funcDeleteAttachment != nil {
+		mmDeleteAttachment.mock.t.Fatalf("AttachmentSvcMock.DeleteAttachment mock is already set by Set")
+	}
+
+	expectation := &AttachmentSvcMockDeleteAttachmentExpectation{
+		mock:               mmDeleteAttachment.mock,
+		params:             &AttachmentSvcMockDeleteAttachmentParams{ctx, username, id},
+		expectationOrigins: AttachmentSvcMockDeleteAttachmentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteAttachment.expectations = append(mmDeleteAttachment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AttachmentSvc.DeleteAttachment return parameters for the expectation previously defined by the When method
+func (e *AttachmentSvcMockDeleteAttachmentExpectation) Then(err error) *AttachmentSvcMock {
+	e.results = &AttachmentSvcMockDeleteAttachmentResults{err}
+	return e.mock
+}
+
+// Times sets number of times AttachmentSvc.DeleteAttachment should be invoked
+func (mmDeleteAttachment *mAttachmentSvcMockDeleteAttachment) Times(n uint64) *mAttachmentSvcMockDeleteAttachment {
+	if n == 0 {
+		mmDeleteAttachment.mock.t.Fatalf("Times of AttachmentSvcMock.DeleteAttachment mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteAttachment.expectedInvocations, n)
+	mmDeleteAttachment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteAttachment
+}
+
+func (mmDeleteAttachment *mAttachmentSvcMockDeleteAttachment) invocationsDone() bool {
+	if len(mmDeleteAttachment.expectations) == 0 && mmDeleteAttachment.defaultExpectation == nil && mmDeleteAttachment.mock.funcDeleteAttachment == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteAttachment.mock.afterDeleteAttachmentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteAttachment.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteAttachment implements mm_handler.AttachmentSvc
+func (mmDeleteAttachment *AttachmentSvcMock) DeleteAttachment(ctx context.Context, username string, id string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteAttachment.beforeDeleteAttachmentCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteAttachment.afterDeleteAttachmentCounter, 1)
+
+	mmDeleteAttachment.t.Helper()
+
+	if mmDeleteAttachment.inspectFuncDeleteAttachment != nil {
+		mmDeleteAttachment.inspectFuncDeleteAttachment(ctx, username, id)
+	}
+
+	mm_params := AttachmentSvcMockDeleteAttachmentParams{ctx, username, id}
+
+	// Record call args
+	mmDeleteAttachment.DeleteAttachmentMock.mutex.Lock()
+	mmDeleteAttachment.DeleteAttachmentMock.callArgs = append(mmDeleteAttachment.DeleteAttachmentMock.callArgs, &mm_params)
+	mmDeleteAttachment.DeleteAttachmentMock.mutex.Unlock()
+
+	for _, e := range mmDeleteAttachment.DeleteAttachmentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteAttachment.DeleteAttachmentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteAttachment.DeleteAttachmentMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteAttachment.DeleteAttachmentMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteAttachment.DeleteAttachmentMock.defaultExpectation.paramPtrs
+
+		mm_got := AttachmentSvcMockDeleteAttachmentParams{ctx, username, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteAttachment.t.Errorf("AttachmentSvcMock.DeleteAttachment got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteAttachment.DeleteAttachmentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmDeleteAttachment.t.Errorf("AttachmentSvcMock.DeleteAttachment got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteAttachment.DeleteAttachmentMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteAttachment.t.Errorf("AttachmentSvcMock.DeleteAttachment got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteAttachment.DeleteAttachmentMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteAttachment.t.Errorf("AttachmentSvcMock.DeleteAttachment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteAttachment.DeleteAttachmentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteAttachment.DeleteAttachmentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteAttachment.t.Fatal("No results are set for the AttachmentSvcMock.DeleteAttachment")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteAttachment.funcDeleteAttachment != nil {
+		return mmDeleteAttachment.funcDeleteAttachment(ctx, username, id)
+	}
+	mmDeleteAttachment.t.Fatalf("Unexpected call to AttachmentSvcMock.DeleteAttachment. %v %v %v", ctx, username, id)
+	return
+}
+
+// DeleteAttachmentAfterCounter returns a count of finished AttachmentSvcMock.DeleteAttachment invocations
+func (mmDeleteAttachment *AttachmentSvcMock) DeleteAttachmentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteAttachment.afterDeleteAttachmentCounter)
+}
+
+// DeleteAttachmentBeforeCounter returns a count of AttachmentSvcMock.DeleteAttachment invocations
+func (mmDeleteAttachment *AttachmentSvcMock) DeleteAttachmentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteAttachment.beforeDeleteAttachmentCounter)
+}
+
+// Calls returns a list of arguments used in each call to AttachmentSvcMock.DeleteAttachment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteAttachment *mAttachmentSvcMockDeleteAttachment) Calls() []*AttachmentSvcMockDeleteAttachmentParams {
+	mmDeleteAttachment.mutex.RLock()
+
+	argCopy := make([]*AttachmentSvcMockDeleteAttachmentParams, len(mmDeleteAttachment.callArgs))
+	copy(argCopy, mmDeleteAttachment.callArgs)
+
+	mmDeleteAttachment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteAttachmentDone returns true if the count of the DeleteAttachment invocations corresponds
+// the number of defined expectations
+func (m *AttachmentSvcMock) MinimockDeleteAttachmentDone() bool {
+	if m.DeleteAttachmentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteAttachmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteAttachmentMock.invocationsDone()
+}
+
+// MinimockDeleteAttachmentInspect logs each unmet expectation
+func (m *AttachmentSvcMock) MinimockDeleteAttachmentInspect() {
+	for _, e := range m.DeleteAttachmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AttachmentSvcMock.DeleteAttachment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteAttachmentCounter := mm_atomic.LoadUint64(&m.afterDeleteAttachmentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteAttachmentMock.defaultExpectation != nil && afterDeleteAttachmentCounter < 1 {
+		if m.DeleteAttachmentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AttachmentSvcMock.DeleteAttachment at\n%s", m.DeleteAttachmentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AttachmentSvcMock.DeleteAttachment at\n%s with params: %#v", m.DeleteAttachmentMock.defaultExpectation.expectationOrigins.origin, *m.DeleteAttachmentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteAttachment != nil && afterDeleteAttachmentCounter < 1 {
+		m.t.Errorf("Expected call to AttachmentSvcMock.DeleteAttachment at\n%s", m.funcDeleteAttachmentOrigin)
+	}
+
+	if !m.DeleteAttachmentMock.invocationsDone() && afterDeleteAttachmentCounter > 0 {
+		m.t.Errorf("Expected %d calls to AttachmentSvcMock.DeleteAttachment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteAttachmentMock.expectedInvocations), m.DeleteAttachmentMock.expectedInvocationsOrigin, afterDeleteAttachmentCounter)
+	}
+}
+
+type mAttachmentSvcMockGetAttachments struct {
+	optional           bool
+	mock               *AttachmentSvcMock
+	defaultExpectation *AttachmentSvcMockGetAttachmentsExpectation
+	expectations       []*AttachmentSvcMockGetAttachmentsExpectation
+
+	callArgs []*AttachmentSvcMockGetAttachmentsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AttachmentSvcMockGetAttachmentsExpectation specifies expectation struct of the AttachmentSvc.GetAttachments
+type AttachmentSvcMockGetAttachmentsExpectation struct {
+	mock               *AttachmentSvcMock
+	params             *AttachmentSvcMockGetAttachmentsParams
+	paramPtrs          *AttachmentSvcMockGetAttachmentsParamPtrs
+	expectationOrigins AttachmentSvcMockGetAttachmentsExpectationOrigins
+	results            *AttachmentSvcMockGetAttachmentsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AttachmentSvcMockGetAttachmentsParams contains parameters of the AttachmentSvc.GetAttachments
+type AttachmentSvcMockGetAttachmentsParams struct {
+	ctx        context.Context
+	username   string
+	cardNumber string
+	page       pagination.Request
+}
+
+// AttachmentSvcMockGetAttachmentsParamPtrs contains pointers to parameters of the AttachmentSvc.GetAttachments
+type AttachmentSvcMockGetAttachmentsParamPtrs struct {
+	ctx        *context.Context
+	username   *string
+	cardNumber *string
+	page       *pagination.Request
+}
+
+// AttachmentSvcMockGetAttachmentsResults contains results of the AttachmentSvc.GetAttachments
+type AttachmentSvcMockGetAttachmentsResults struct {
+	attachments []attachment.Attachment
+	next        string
+	err         error
+}
+
+// AttachmentSvcMockGetAttachmentsOrigins contains origins of expectations of the AttachmentSvc.GetAttachments
+type AttachmentSvcMockGetAttachmentsExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originUsername   string
+	originCardNumber string
+	originPage       string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetAttachments *mAttachmentSvcMockGetAttachments) Optional() *mAttachmentSvcMockGetAttachments {
+	mmGetAttachments.optional = true
+	return mmGetAttachments
+}
+
+// Expect sets up expected params for AttachmentSvc.GetAttachments
+func (mmGetAttachments *mAttachmentSvcMockGetAttachments) Expect(ctx context.Context, username string, cardNumber string, page pagination.Request) *mAttachmentSvcMockGetAttachments {
+	if mmGetAttachments.mock.funcGetAttachments != nil {
+		mmGetAttachments.mock.t.Fatalf("AttachmentSvcMock.GetAttachments mock is already set by Set")
+	}
+
+	if mmGetAttachments.defaultExpectation == nil {
+		mmGetAttachments.defaultExpectation = &AttachmentSvcMockGetAttachmentsExpectation{}
+	}
+
+	if mmGetAttachments.defaultExpectation.paramPtrs != nil {
+		mmGetAttachments.mock.t.Fatalf("AttachmentSvcMock.GetAttachments mock is already set by ExpectParams functions")
+	}
+
+	mmGetAttachments.defaultExpectation.params = &AttachmentSvcMockGetAttachmentsParams{ctx, username, cardNumber, page}
+	mmGetAttachments.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetAttachments.expectations {
+		if minimock.Equal(e.params, mmGetAttachments.defaultExpectation.params) {
+			mmGetAttachments.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAttachments.defaultExpectation.params)
+		}
+	}
+
+	return mmGetAttachments
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AttachmentSvc.GetAttachments
+func (mmGetAttachments *mAttachmentSvcMockGetAttachments) ExpectCtxParam1(ctx context.Context) *mAttachmentSvcMockGetAttachments {
+	if mmGetAttachments.mock.funcGetAttachments != nil {
+		mmGetAttachments.mock.t.Fatalf("AttachmentSvcMock.GetAttachments mock is already set by Set")
+	}
+
+	if mmGetAttachments.defaultExpectation == nil {
+		mmGetAttachments.defaultExpectation = &AttachmentSvcMockGetAttachmentsExpectation{}
+	}
+
+	if mmGetAttachments.defaultExpectation.params != nil {
+		mmGetAttachments.mock.t.Fatalf("AttachmentSvcMock.GetAttachments mock is already set by Expect")
+	}
+
+	if mmGetAttachments.defaultExpectation.paramPtrs == nil {
+		mmGetAttachments.defaultExpectation.paramPtrs = &AttachmentSvcMockGetAttachmentsParamPtrs{}
+	}
+	mmGetAttachments.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetAttachments.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetAttachments
+}
+
+// ExpectUsernameParam2 sets up expected param username for AttachmentSvc.GetAttachments
+func (mmGetAttachments *mAttachmentSvcMockGetAttachments) ExpectUsernameParam2(username string) *mAttachmentSvcMockGetAttachments {
+	if mmGetAttachments.mock.funcGetAttachments != nil {
+		mmGetAttachments.mock.t.Fatalf("AttachmentSvcMock.GetAttachments mock is already set by Set")
+	}
+
+	if mmGetAttachments.defaultExpectation == nil {
+		mmGetAttachments.defaultExpectation = &AttachmentSvcMockGetAttachmentsExpectation{}
+	}
+
+	if mmGetAttachments.defaultExpectation.params != nil {
+		mmGetAttachments.mock.t.Fatalf("AttachmentSvcMock.GetAttachments mock is already set by Expect")
+	}
+
+	if mmGetAttachments.defaultExpectation.paramPtrs == nil {
+		mmGetAttachments.defaultExpectation.paramPtrs = &AttachmentSvcMockGetAttachmentsParamPtrs{}
+	}
+	mmGetAttachments.defaultExpectation.paramPtrs.username = &username
+	mmGetAttachments.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetAttachments
+}
+
+// ExpectCardNumberParam3 sets up expected param cardNumber for AttachmentSvc.GetAttachments
+func (mmGetAttachments *mAttachmentSvcMockGetAttachments) ExpectCardNumberParam3(cardNumber string) *mAttachmentSvcMockGetAttachments {
+	if mmGetAttachments.mock.funcGetAttachments != nil {
+		mmGetAttachments.mock.t.Fatalf("AttachmentSvcMock.GetAttachments mock is already set by Set")
+	}
+
+	if mmGetAttachments.defaultExpectation == nil {
+		mmGetAttachments.defaultExpectation = &AttachmentSvcMockGetAttachmentsExpectation{}
+	}
+
+	if mmGetAttachments.defaultExpectation.params != nil {
+		mmGetAttachments.mock.t.Fatalf("AttachmentSvcMock.GetAttachments mock is already set by Expect")
+	}
+
+	if mmGetAttachments.defaultExpectation.paramPtrs == nil {
+		mmGetAttachments.defaultExpectation.paramPtrs = &AttachmentSvcMockGetAttachmentsParamPtrs{}
+	}
+	mmGetAttachments.defaultExpectation.paramPtrs.cardNumber = &cardNumber
+	mmGetAttachments.defaultExpectation.expectationOrigins.originCardNumber = minimock.CallerInfo(1)
+
+	return mmGetAttachments
+}
+
+// ExpectPageParam4 sets up expected param page for AttachmentSvc.GetAttachments
+func (mmGetAttachments *mAttachmentSvcMockGetAttachments) ExpectPageParam4(page pagination.Request) *mAttachmentSvcMockGetAttachments {
+	if mmGetAttachments.mock.funcGetAttachments != nil {
+		mmGetAttachments.mock.t.Fatalf("AttachmentSvcMock.GetAttachments mock is already set by Set")
+	}
+
+	if mmGetAttachments.defaultExpectation == nil {
+		mmGetAttachments.defaultExpectation = &AttachmentSvcMockGetAttachmentsExpectation{}
+	}
+
+	if mmGetAttachments.defaultExpectation.params != nil {
+		mmGetAttachments.mock.t.Fatalf("AttachmentSvcMock.GetAttachments mock is already set by Expect")
+	}
+
+	if mmGetAttachments.defaultExpectation.paramPtrs == nil {
+		mmGetAttachments.defaultExpectation.paramPtrs = &AttachmentSvcMockGetAttachmentsParamPtrs{}
+	}
+	mmGetAttachments.defaultExpectation.paramPtrs.page = &page
+	mmGetAttachments.defaultExpectation.expectationOrigins.originPage = minimock.CallerInfo(1)
+
+	return mmGetAttachments
+}
+
+// Inspect accepts an inspector function that has same arguments as the AttachmentSvc.GetAttachments
+func (mmGetAttachments *mAttachmentSvcMockGetAttachments) Inspect(f func(ctx context.Context, username string, cardNumber string, page pagination.Request)) *mAttachmentSvcMockGetAttachments {
+	if mmGetAttachments.mock.inspectFuncGetAttachments != nil {
+		mmGetAttachments.mock.t.Fatalf("Inspect function is already set for AttachmentSvcMock.GetAttachments")
+	}
+
+	mmGetAttachments.mock.inspectFuncGetAttachments = f
+
+	return mmGetAttachments
+}
+
+// Return sets up results that will be returned by AttachmentSvc.GetAttachments
+func (mmGetAttachments *mAttachmentSvcMockGetAttachments) Return(attachments []attachment.Attachment, next string, err error) *AttachmentSvcMock {
+	if mmGetAttachments.mock.funcGetAttachments != nil {
+		mmGetAttachments.mock.t.Fatalf("AttachmentSvcMock.GetAttachments mock is already set by Set")
+	}
+
+	if mmGetAttachments.defaultExpectation == nil {
+		mmGetAttachments.defaultExpectation = &AttachmentSvcMockGetAttachmentsExpectation{mock: mmGetAttachments.mock}
+	}
+	mmGetAttachments.defaultExpectation.results = &AttachmentSvcMockGetAttachmentsResults{attachments, next, err}
+	mmGetAttachments.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetAttachments.mock
+}
+
+// Set uses given function f to mock the AttachmentSvc.GetAttachments method
+func (mmGetAttachments *mAttachmentSvcMockGetAttachments) Set(f func(ctx context.Context, username string, cardNumber string, page pagination.Request) (attachments []attachment.Attachment, next string, err error)) *AttachmentSvcMock {
+	if mmGetAttachments.defaultExpectation != nil {
+		mmGetAttachments.mock.t.Fatalf("Default expectation is already set for the AttachmentSvc.GetAttachments method")
+	}
+
+	if len(mmGetAttachments.expectations) > 0 {
+		mmGetAttachments.mock.t.Fatalf("Some expectations are already set for the AttachmentSvc.GetAttachments method")
+	}
+
+	mmGetAttachments.mock.funcGetAttachments = f
+	mmGetAttachments.mock.funcGetAttachmentsOrigin = minimock.CallerInfo(1)
+	return mmGetAttachments.mock
+}
+
+// When sets expectation for the AttachmentSvc.GetAttachments which will trigger the result defined by the following
+// Then helper
+func (mmGetAttachments *mAttachmentSvcMockGetAttachments) When(ctx context.Context, username string, cardNumber string, page pagination.Request) *AttachmentSvcMockGetAttachmentsExpectation {
+	if mmGetAttachments.mock.funcGetAttachments != nil {
+		mmGetAttachments.mock.t.Fatalf("AttachmentSvcMock.GetAttachments mock is already set by Set")
+	}
+
+	expectation := &AttachmentSvcMockGetAttachmentsExpectation{
+		mock:               mmGetAttachments.mock,
+		params:             &AttachmentSvcMockGetAttachmentsParams{ctx, username, cardNumber, page},
+		expectationOrigins: AttachmentSvcMockGetAttachmentsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetAttachments.expectations = append(mmGetAttachments.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AttachmentSvc.GetAttachments return parameters for the expectation previously defined by the When method
+func (e *AttachmentSvcMockGetAttachmentsExpectation) Then(attachments []attachment.Attachment, next string, err error) *AttachmentSvcMock {
+	e.results = &AttachmentSvcMockGetAttachmentsResults{attachments, next, err}
+	return e.mock
+}
+
+// Times sets number of times AttachmentSvc.GetAttachments should be invoked
+func (mmGetAttachments *mAttachmentSvcMockGetAttachments) Times(n uint64) *mAttachmentSvcMockGetAttachments {
+	if n == 0 {
+		mmGetAttachments.mock.t.Fatalf("Times of AttachmentSvcMock.GetAttachments mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetAttachments.expectedInvocations, n)
+	mmGetAttachments.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetAttachments
+}
+
+func (mmGetAttachments *mAttachmentSvcMockGetAttachments) invocationsDone() bool {
+	if len(mmGetAttachments.expectations) == 0 && mmGetAttachments.defaultExpectation == nil && mmGetAttachments.mock.funcGetAttachments == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetAttachments.mock.afterGetAttachmentsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetAttachments.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetAttachments implements mm_handler.AttachmentSvc
+func (mmGetAttachments *AttachmentSvcMock) GetAttachments(ctx context.Context, username string, cardNumber string, page pagination.Request) (attachments []attachment.Attachment, next string, err error) {
+	mm_atomic.AddUint64(&mmGetAttachments.beforeGetAttachmentsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetAttachments.afterGetAttachmentsCounter, 1)
+
+	mmGetAttachments.t.Helper()
+
+	if mmGetAttachments.inspectFuncGetAttachments != nil {
+		mmGetAttachments.inspectFuncGetAttachments(ctx, username, cardNumber, page)
+	}
+
+	mm_params := AttachmentSvcMockGetAttachmentsParams{ctx, username, cardNumber, page}
+
+	// Record call args
+	mmGetAttachments.GetAttachmentsMock.mutex.Lock()
+	mmGetAttachments.GetAttachmentsMock.callArgs = append(mmGetAttachments.GetAttachmentsMock.callArgs, &mm_params)
+	mmGetAttachments.GetAttachmentsMock.mutex.Unlock()
+
+	for _, e := range mmGetAttachments.GetAttachmentsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.attachments, e.results.next, e.results.err
+		}
+	}
+
+	if mmGetAttachments.GetAttachmentsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetAttachments.GetAttachmentsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetAttachments.GetAttachmentsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetAttachments.GetAttachmentsMock.defaultExpectation.paramPtrs
+
+		mm_got := AttachmentSvcMockGetAttachmentsParams{ctx, username, cardNumber, page}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetAttachments.t.Errorf("AttachmentSvcMock.GetAttachments got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAttachments.GetAttachmentsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetAttachments.t.Errorf("AttachmentSvcMock.GetAttachments got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAttachments.GetAttachmentsMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.cardNumber != nil && !minimock.Equal(*mm_want_ptrs.cardNumber, mm_got.cardNumber) {
+				mmGetAttachments.t.Errorf("AttachmentSvcMock.GetAttachments got unexpected parameter cardNumber, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAttachments.GetAttachmentsMock.defaultExpectation.expectationOrigins.originCardNumber, *mm_want_ptrs.cardNumber, mm_got.cardNumber, minimock.Diff(*mm_want_ptrs.cardNumber, mm_got.cardNumber))
+			}
+
+			if mm_want_ptrs.page != nil && !minimock.Equal(*mm_want_ptrs.page, mm_got.page) {
+				mmGetAttachments.t.Errorf("AttachmentSvcMock.GetAttachments got unexpected parameter page, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAttachments.GetAttachmentsMock.defaultExpectation.expectationOrigins.originPage, *mm_want_ptrs.page, mm_got.page, minimock.Diff(*mm_want_ptrs.page, mm_got.page))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetAttachments.t.Errorf("AttachmentSvcMock.GetAttachments got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetAttachments.GetAttachmentsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetAttachments.GetAttachmentsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetAttachments.t.Fatal("No results are set for the AttachmentSvcMock.GetAttachments")
+		}
+		return (*mm_results).attachments, (*mm_results).next, (*mm_results).err
+	}
+	if mmGetAttachments.funcGetAttachments != nil {
+		return mmGetAttachments.funcGetAttachments(ctx, username, cardNumber, page)
+	}
+	mmGetAttachments.t.Fatalf("Unexpected call to AttachmentSvcMock.GetAttachments. %v %v %v %v", ctx, username, cardNumber, page)
+	return
+}
+
+// GetAttachmentsAfterCounter returns a count of finished AttachmentSvcMock.GetAttachments invocations
+func (mmGetAttachments *AttachmentSvcMock) GetAttachmentsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAttachments.afterGetAttachmentsCounter)
+}
+
+// GetAttachmentsBeforeCounter returns a count of AttachmentSvcMock.GetAttachments invocations
+func (mmGetAttachments *AttachmentSvcMock) GetAttachmentsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAttachments.beforeGetAttachmentsCounter)
+}
+
+// Calls returns a list of arguments used in each call to AttachmentSvcMock.GetAttachments.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetAttachments *mAttachmentSvcMockGetAttachments) Calls() []*AttachmentSvcMockGetAttachmentsParams {
+	mmGetAttachments.mutex.RLock()
+
+	argCopy := make([]*AttachmentSvcMockGetAttachmentsParams, len(mmGetAttachments.callArgs))
+	copy(argCopy, mmGetAttachments.callArgs)
+
+	mmGetAttachments.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetAttachmentsDone returns true if the count of the GetAttachments invocations corresponds
+// the number of defined expectations
+func (m *AttachmentSvcMock) MinimockGetAttachmentsDone() bool {
+	if m.GetAttachmentsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetAttachmentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetAttachmentsMock.invocationsDone()
+}
+
+// MinimockGetAttachmentsInspect logs each unmet expectation
+func (m *AttachmentSvcMock) MinimockGetAttachmentsInspect() {
+	for _, e := range m.GetAttachmentsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AttachmentSvcMock.GetAttachments at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetAttachmentsCounter := mm_atomic.LoadUint64(&m.afterGetAttachmentsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetAttachmentsMock.defaultExpectation != nil && afterGetAttachmentsCounter < 1 {
+		if m.GetAttachmentsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AttachmentSvcMock.GetAttachments at\n%s", m.GetAttachmentsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AttachmentSvcMock.GetAttachments at\n%s with params: %#v", m.GetAttachmentsMock.defaultExpectation.expectationOrigins.origin, *m.GetAttachmentsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetAttachments != nil && afterGetAttachmentsCounter < 1 {
+		m.t.Errorf("Expected call to AttachmentSvcMock.GetAttachments at\n%s", m.funcGetAttachmentsOrigin)
+	}
+
+	if !m.GetAttachmentsMock.invocationsDone() && afterGetAttachmentsCounter > 0 {
+		m.t.Errorf("Expected %d calls to AttachmentSvcMock.GetAttachments at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetAttachmentsMock.expectedInvocations), m.GetAttachmentsMock.expectedInvocationsOrigin, afterGetAttachmentsCounter)
+	}
+}
+
+type mAttachmentSvcMockOpenAttachment struct {
+	optional           bool
+	mock               *AttachmentSvcMock
+	defaultExpectation *AttachmentSvcMockOpenAttachmentExpectation
+	expectations       []*AttachmentSvcMockOpenAttachmentExpectation
+
+	callArgs []*AttachmentSvcMockOpenAttachmentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AttachmentSvcMockOpenAttachmentExpectation specifies expectation struct of the AttachmentSvc.OpenAttachment
+type AttachmentSvcMockOpenAttachmentExpectation struct {
+	mock               *AttachmentSvcMock
+	params             *AttachmentSvcMockOpenAttachmentParams
+	paramPtrs          *AttachmentSvcMockOpenAttachmentParamPtrs
+	expectationOrigins AttachmentSvcMockOpenAttachmentExpectationOrigins
+	results            *AttachmentSvcMockOpenAttachmentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AttachmentSvcMockOpenAttachmentParams contains parameters of the AttachmentSvc.OpenAttachment
+type AttachmentSvcMockOpenAttachmentParams struct {
+	ctx      context.Context
+	username string
+	id       string
+}
+
+// AttachmentSvcMockOpenAttachmentParamPtrs contains pointers to parameters of the AttachmentSvc.OpenAttachment
+type AttachmentSvcMockOpenAttachmentParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	id       *string
+}
+
+// AttachmentSvcMockOpenAttachmentResults contains results of the AttachmentSvc.OpenAttachment
+type AttachmentSvcMockOpenAttachmentResults struct {
+	a1  attachment.Attachment
+	r1  io.ReadCloser
+	err error
+}
+
+// AttachmentSvcMockOpenAttachmentOrigins contains origins of expectations of the AttachmentSvc.OpenAttachment
+type AttachmentSvcMockOpenAttachmentExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originId       string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmOpenAttachment *mAttachmentSvcMockOpenAttachment) Optional() *mAttachmentSvcMockOpenAttachment {
+	mmOpenAttachment.optional = true
+	return mmOpenAttachment
+}
+
+// Expect sets up expected params for AttachmentSvc.OpenAttachment
+func (mmOpenAttachment *mAttachmentSvcMockOpenAttachment) Expect(ctx context.Context, username string, id string) *mAttachmentSvcMockOpenAttachment {
+	if mmOpenAttachment.mock.funcOpenAttachment != nil {
+		mmOpenAttachment.mock.t.Fatalf("AttachmentSvcMock.OpenAttachment mock is already set by Set")
+	}
+
+	if mmOpenAttachment.defaultExpectation == nil {
+		mmOpenAttachment.defaultExpectation = &AttachmentSvcMockOpenAttachmentExpectation{}
+	}
+
+	if mmOpenAttachment.defaultExpectation.paramPtrs != nil {
+		mmOpenAttachment.mock.t.Fatalf("AttachmentSvcMock.OpenAttachment mock is already set by ExpectParams functions")
+	}
+
+	mmOpenAttachment.defaultExpectation.params = &AttachmentSvcMockOpenAttachmentParams{ctx, username, id}
+	mmOpenAttachment.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmOpenAttachment.expectations {
+		if minimock.Equal(e.params, mmOpenAttachment.defaultExpectation.params) {
+			mmOpenAttachment.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmOpenAttachment.defaultExpectation.params)
+		}
+	}
+
+	return mmOpenAttachment
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AttachmentSvc.OpenAttachment
+func (mmOpenAttachment *mAttachmentSvcMockOpenAttachment) ExpectCtxParam1(ctx context.Context) *mAttachmentSvcMockOpenAttachment {
+	if mmOpenAttachment.mock.funcOpenAttachment != nil {
+		mmOpenAttachment.mock.t.Fatalf("AttachmentSvcMock.OpenAttachment mock is already set by Set")
+	}
+
+	if mmOpenAttachment.defaultExpectation == nil {
+		mmOpenAttachment.defaultExpectation = &AttachmentSvcMockOpenAttachmentExpectation{}
+	}
+
+	if mmOpenAttachment.defaultExpectation.params != nil {
+		mmOpenAttachment.mock.t.Fatalf("AttachmentSvcMock.OpenAttachment mock is already set by Expect")
+	}
+
+	if mmOpenAttachment.defaultExpectation.paramPtrs == nil {
+		mmOpenAttachment.defaultExpectation.paramPtrs = &AttachmentSvcMockOpenAttachmentParamPtrs{}
+	}
+	mmOpenAttachment.defaultExpectation.paramPtrs.ctx = &ctx
+	mmOpenAttachment.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmOpenAttachment
+}
+
+// ExpectUsernameParam2 sets up expected param username for AttachmentSvc.OpenAttachment
+func (mmOpenAttachment *mAttachmentSvcMockOpenAttachment) ExpectUsernameParam2(username string) *mAttachmentSvcMockOpenAttachment {
+	if mmOpenAttachment.mock.funcOpenAttachment != nil {
+		mmOpenAttachment.mock.t.Fatalf("AttachmentSvcMock.OpenAttachment mock is already set by Set")
+	}
+
+	if mmOpenAttachment.defaultExpectation == nil {
+		mmOpenAttachment.defaultExpectation = &AttachmentSvcMockOpenAttachmentExpectation{}
+	}
+
+	if mmOpenAttachment.defaultExpectation.params != nil {
+		mmOpenAttachment.mock.t.Fatalf("AttachmentSvcMock.OpenAttachment mock is already set by Expect")
+	}
+
+	if mmOpenAttachment.defaultExpectation.paramPtrs == nil {
+		mmOpenAttachment.defaultExpectation.paramPtrs = &AttachmentSvcMockOpenAttachmentParamPtrs{}
+	}
+	mmOpenAttachment.defaultExpectation.paramPtrs.username = &username
+	mmOpenAttachment.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmOpenAttachment
+}
+
+// ExpectIdParam3 sets up expected param id for AttachmentSvc.OpenAttachment
+func (mmOpenAttachment *mAttachmentSvcMockOpenAttachment) ExpectIdParam3(id string) *mAttachmentSvcMockOpenAttachment {
+	if mmOpenAttachment.mock.funcOpenAttachment != nil {
+		mmOpenAttachment.mock.t.Fatalf("AttachmentSvcMock.OpenAttachment mock is already set by Set")
+	}
+
+	if mmOpenAttachment.defaultExpectation == nil {
+		mmOpenAttachment.defaultExpectation = &AttachmentSvcMockOpenAttachmentExpectation{}
+	}
+
+	if mmOpenAttachment.defaultExpectation.params != nil {
+		mmOpenAttachment.mock.t.Fatalf("AttachmentSvcMock.OpenAttachment mock is already set by Expect")
+	}
+
+	if mmOpenAttachment.defaultExpectation.paramPtrs == nil {
+		mmOpenAttachment.defaultExpectation.paramPtrs = &AttachmentSvcMockOpenAttachmentParamPtrs{}
+	}
+	mmOpenAttachment.defaultExpectation.paramPtrs.id = &id
+	mmOpenAttachment.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmOpenAttachment
+}
+
+// Inspect accepts an inspector function that has same arguments as the AttachmentSvc.OpenAttachment
+func (mmOpenAttachment *mAttachmentSvcMockOpenAttachment) Inspect(f func(ctx context.Context, username string, id string)) *mAttachmentSvcMockOpenAttachment {
+	if mmOpenAttachment.mock.inspectFuncOpenAttachment != nil {
+		mmOpenAttachment.mock.t.Fatalf("Inspect function is already set for AttachmentSvcMock.OpenAttachment")
+	}
+
+	mmOpenAttachment.mock.inspectFuncOpenAttachment = f
+
+	return mmOpenAttachment
+}
+
+// Return sets up results that will be returned by AttachmentSvc.OpenAttachment
+func (mmOpenAttachment *mAttachmentSvcMockOpenAttachment) Return(a1 attachment.Attachment, r1 io.ReadCloser, err error) *AttachmentSvcMock {
+	if mmOpenAttachment.mock.funcOpenAttachment != nil {
+		mmOpenAttachment.mock.t.Fatalf("AttachmentSvcMock.OpenAttachment mock is already set by Set")
+	}
+
+	if mmOpenAttachment.defaultExpectation == nil {
+		mmOpenAttachment.defaultExpectation = &AttachmentSvcMockOpenAttachmentExpectation{mock: mmOpenAttachment.mock}
+	}
+	mmOpenAttachment.defaultExpectation.results = &AttachmentSvcMockOpenAttachmentResults{a1, r1, err}
+	mmOpenAttachment.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmOpenAttachment.mock
+}
+
+// Set uses given function f to mock the AttachmentSvc.OpenAttachment method
+func (mmOpenAttachment *mAttachmentSvcMockOpenAttachment) Set(f func(ctx context.Context, username string, id string) (a1 attachment.Attachment, r1 io.ReadCloser, err error)) *AttachmentSvcMock {
+	if mmOpenAttachment.defaultExpectation != nil {
+		mmOpenAttachment.mock.t.Fatalf("Default expectation is already set for the AttachmentSvc.OpenAttachment method")
+	}
+
+	if len(mmOpenAttachment.expectations) > 0 {
+		mmOpenAttachment.mock.t.Fatalf("Some expectations are already set for the AttachmentSvc.OpenAttachment method")
+	}
+
+	mmOpenAttachment.mock.funcOpenAttachment = f
+	mmOpenAttachment.mock.funcOpenAttachmentOrigin = minimock.CallerInfo(1)
+	return mmOpenAttachment.mock
+}
+
+// When sets expectation for the AttachmentSvc.OpenAttachment which will trigger the result defined by the following
+// Then helper
+func (mmOpenAttachment *mAttachmentSvcMockOpenAttachment) When(ctx context.Context, username string, id string) *AttachmentSvcMockOpenAttachmentExpectation {
+	if mmOpenAttachment.mock.funcOpenAttachment != nil {
+		mmOpenAttachment.mock.t.Fatalf("AttachmentSvcMock.OpenAttachment mock is already set by Set")
+	}
+
+	expectation := &AttachmentSvcMockOpenAttachmentExpectation{
+		mock:               mmOpenAttachment.mock,
+		params:             &AttachmentSvcMockOpenAttachmentParams{ctx, username, id},
+		expectationOrigins: AttachmentSvcMockOpenAttachmentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmOpenAttachment.expectations = append(mmOpenAttachment.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AttachmentSvc.OpenAttachment return parameters for the expectation previously defined by the When method
+func (e *AttachmentSvcMockOpenAttachmentExpectation) Then(a1 attachment.Attachment, r1 io.ReadCloser, err error) *AttachmentSvcMock {
+	e.results = &AttachmentSvcMockOpenAttachmentResults{a1, r1, err}
+	return e.mock
+}
+
+// Times sets number of times AttachmentSvc.OpenAttachment should be invoked
+func (mmOpenAttachment *mAttachmentSvcMockOpenAttachment) Times(n uint64) *mAttachmentSvcMockOpenAttachment {
+	if n == 0 {
+		mmOpenAttachment.mock.t.Fatalf("Times of AttachmentSvcMock.OpenAttachment mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmOpenAttachment.expectedInvocations, n)
+	mmOpenAttachment.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmOpenAttachment
+}
+
+func (mmOpenAttachment *mAttachmentSvcMockOpenAttachment) invocationsDone() bool {
+	if len(mmOpenAttachment.expectations) == 0 && mmOpenAttachment.defaultExpectation == nil && mmOpenAttachment.mock.funcOpenAttachment == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmOpenAttachment.mock.afterOpenAttachmentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmOpenAttachment.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// OpenAttachment implements mm_handler.AttachmentSvc
+func (mmOpenAttachment *AttachmentSvcMock) OpenAttachment(ctx context.Context, username string, id string) (a1 attachment.Attachment, r1 io.ReadCloser, err error) {
+	mm_atomic.AddUint64(&mmOpenAttachment.beforeOpenAttachmentCounter, 1)
+	defer mm_atomic.AddUint64(&mmOpenAttachment.afterOpenAttachmentCounter, 1)
+
+	mmOpenAttachment.t.Helper()
+
+	if mmOpenAttachment.inspectFuncOpenAttachment != nil {
+		mmOpenAttachment.inspectFuncOpenAttachment(ctx, username, id)
+	}
+
+	mm_params := AttachmentSvcMockOpenAttachmentParams{ctx, username, id}
+
+	// Record call args
+	mmOpenAttachment.OpenAttachmentMock.mutex.Lock()
+	mmOpenAttachment.OpenAttachmentMock.callArgs = append(mmOpenAttachment.OpenAttachmentMock.callArgs, &mm_params)
+	mmOpenAttachment.OpenAttachmentMock.mutex.Unlock()
+
+	for _, e := range mmOpenAttachment.OpenAttachmentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.a1, e.results.r1, e.results.err
+		}
+	}
+
+	if mmOpenAttachment.OpenAttachmentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmOpenAttachment.OpenAttachmentMock.defaultExpectation.Counter, 1)
+		mm_want := mmOpenAttachment.OpenAttachmentMock.defaultExpectation.params
+		mm_want_ptrs := mmOpenAttachment.OpenAttachmentMock.defaultExpectation.paramPtrs
+
+		mm_got := AttachmentSvcMockOpenAttachmentParams{ctx, username, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmOpenAttachment.t.Errorf("AttachmentSvcMock.OpenAttachment got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOpenAttachment.OpenAttachmentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmOpenAttachment.t.Errorf("AttachmentSvcMock.OpenAttachment got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOpenAttachment.OpenAttachmentMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmOpenAttachment.t.Errorf("AttachmentSvcMock.OpenAttachment got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOpenAttachment.OpenAttachmentMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmOpenAttachment.t.Errorf("AttachmentSvcMock.OpenAttachment got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmOpenAttachment.OpenAttachmentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmOpenAttachment.OpenAttachmentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmOpenAttachment.t.Fatal("No results are set for the AttachmentSvcMock.OpenAttachment")
+		}
+		return (*mm_results).a1, (*mm_results).r1, (*mm_results).err
+	}
+	if mmOpenAttachment.funcOpenAttachment != nil {
+		return mmOpenAttachment.funcOpenAttachment(ctx, username, id)
+	}
+	mmOpenAttachment.t.Fatalf("Unexpected call to AttachmentSvcMock.OpenAttachment. %v %v %v", ctx, username, id)
+	return
+}
+
+// OpenAttachmentAfterCounter returns a count of finished AttachmentSvcMock.OpenAttachment invocations
+func (mmOpenAttachment *AttachmentSvcMock) OpenAttachmentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOpenAttachment.afterOpenAttachmentCounter)
+}
+
+// OpenAttachmentBeforeCounter returns a count of AttachmentSvcMock.OpenAttachment invocations
+func (mmOpenAttachment *AttachmentSvcMock) OpenAttachmentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOpenAttachment.beforeOpenAttachmentCounter)
+}
+
+// Calls returns a list of arguments used in each call to AttachmentSvcMock.OpenAttachment.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmOpenAttachment *mAttachmentSvcMockOpenAttachment) Calls() []*AttachmentSvcMockOpenAttachmentParams {
+	mmOpenAttachment.mutex.RLock()
+
+	argCopy := make([]*AttachmentSvcMockOpenAttachmentParams, len(mmOpenAttachment.callArgs))
+	copy(argCopy, mmOpenAttachment.callArgs)
+
+	mmOpenAttachment.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockOpenAttachmentDone returns true if the count of the OpenAttachment invocations corresponds
+// the number of defined expectations
+func (m *AttachmentSvcMock) MinimockOpenAttachmentDone() bool {
+	if m.OpenAttachmentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.OpenAttachmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.OpenAttachmentMock.invocationsDone()
+}
+
+// MinimockOpenAttachmentInspect logs each unmet expectation
+func (m *AttachmentSvcMock) MinimockOpenAttachmentInspect() {
+	for _, e := range m.OpenAttachmentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AttachmentSvcMock.OpenAttachment at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterOpenAttachmentCounter := mm_atomic.LoadUint64(&m.afterOpenAttachmentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.OpenAttachmentMock.defaultExpectation != nil && afterOpenAttachmentCounter < 1 {
+		if m.OpenAttachmentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AttachmentSvcMock.OpenAttachment at\n%s", m.OpenAttachmentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AttachmentSvcMock.OpenAttachment at\n%s with params: %#v", m.OpenAttachmentMock.defaultExpectation.expectationOrigins.origin, *m.OpenAttachmentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcOpenAttachment != nil && afterOpenAttachmentCounter < 1 {
+		m.t.Errorf("Expected call to AttachmentSvcMock.OpenAttachment at\n%s", m.funcOpenAttachmentOrigin)
+	}
+
+	if !m.OpenAttachmentMock.invocationsDone() && afterOpenAttachmentCounter > 0 {
+		m.t.Errorf("Expected %d calls to AttachmentSvcMock.OpenAttachment at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.OpenAttachmentMock.expectedInvocations), m.OpenAttachmentMock.expectedInvocationsOrigin, afterOpenAttachmentCounter)
+	}
+}
+
+type mAttachmentSvcMockUploadChunk struct {
+	optional           bool
+	mock               *AttachmentSvcMock
+	defaultExpectation *AttachmentSvcMockUploadChunkExpectation
+	expectations       []*AttachmentSvcMockUploadChunkExpectation
+
+	callArgs []*AttachmentSvcMockUploadChunkParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AttachmentSvcMockUploadChunkExpectation specifies expectation struct of the AttachmentSvc.UploadChunk
+type AttachmentSvcMockUploadChunkExpectation struct {
+	mock               *AttachmentSvcMock
+	params             *AttachmentSvcMockUploadChunkParams
+	paramPtrs          *AttachmentSvcMockUploadChunkParamPtrs
+	expectationOrigins AttachmentSvcMockUploadChunkExpectationOrigins
+	results            *AttachmentSvcMockUploadChunkResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AttachmentSvcMockUploadChunkParams contains parameters of the AttachmentSvc.UploadChunk
+type AttachmentSvcMockUploadChunkParams struct {
+	ctx      context.Context
+	username string
+	id       string
+	rng      attachment.Range
+	body     io.Reader
+}
+
+// AttachmentSvcMockUploadChunkParamPtrs contains pointers to parameters of the AttachmentSvc.UploadChunk
+type AttachmentSvcMockUploadChunkParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	id       *string
+	rng      *attachment.Range
+	body     *io.Reader
+}
+
+// AttachmentSvcMockUploadChunkResults contains results of the AttachmentSvc.UploadChunk
+type AttachmentSvcMockUploadChunkResults struct {
+	a1  attachment.Attachment
+	err error
+}
+
+// AttachmentSvcMockUploadChunkOrigins contains origins of expectations of the AttachmentSvc.UploadChunk
+type AttachmentSvcMockUploadChunkExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originId       string
+	originRng      string
+	originBody     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmUploadChunk *mAttachmentSvcMockUploadChunk) Optional() *mAttachmentSvcMockUploadChunk {
+	mmUploadChunk.optional = true
+	return mmUploadChunk
+}
+
+// Expect sets up expected params for AttachmentSvc.UploadChunk
+func (mmUploadChunk *mAttachmentSvcMockUploadChunk) Expect(ctx context.Context, username string, id string, rng attachment.Range, body io.Reader) *mAttachmentSvcMockUploadChunk {
+	if mmUploadChunk.mock.funcUploadChunk != nil {
+		mmUploadChunk.mock.t.Fatalf("AttachmentSvcMock.UploadChunk mock is already set by Set")
+	}
+
+	if mmUploadChunk.defaultExpectation == nil {
+		mmUploadChunk.defaultExpectation = &AttachmentSvcMockUploadChunkExpectation{}
+	}
+
+	if mmUploadChunk.defaultExpectation.paramPtrs != nil {
+		mmUploadChunk.mock.t.Fatalf("AttachmentSvcMock.UploadChunk mock is already set by ExpectParams functions")
+	}
+
+	mmUploadChunk.defaultExpectation.params = &AttachmentSvcMockUploadChunkParams{ctx, username, id, rng, body}
+	mmUploadChunk.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmUploadChunk.expectations {
+		if minimock.Equal(e.params, mmUploadChunk.defaultExpectation.params) {
+			mmUploadChunk.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmUploadChunk.defaultExpectation.params)
+		}
+	}
+
+	return mmUploadChunk
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AttachmentSvc.UploadChunk
+func (mmUploadChunk *mAttachmentSvcMockUploadChunk) ExpectCtxParam1(ctx context.Context) *mAttachmentSvcMockUploadChunk {
+	if mmUploadChunk.mock.funcUploadChunk != nil {
+		mmUploadChunk.mock.t.Fatalf("AttachmentSvcMock.UploadChunk mock is already set by Set")
+	}
+
+	if mmUploadChunk.defaultExpectation == nil {
+		mmUploadChunk.defaultExpectation = &AttachmentSvcMockUploadChunkExpectation{}
+	}
+
+	if mmUploadChunk.defaultExpectation.params != nil {
+		mmUploadChunk.mock.t.Fatalf("AttachmentSvcMock.UploadChunk mock is already set by Expect")
+	}
+
+	if mmUploadChunk.defaultExpectation.paramPtrs == nil {
+		mmUploadChunk.defaultExpectation.paramPtrs = &AttachmentSvcMockUploadChunkParamPtrs{}
+	}
+	mmUploadChunk.defaultExpectation.paramPtrs.ctx = &ctx
+	mmUploadChunk.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmUploadChunk
+}
+
+// ExpectUsernameParam2 sets up expected param username for AttachmentSvc.UploadChunk
+func (mmUploadChunk *mAttachmentSvcMockUploadChunk) ExpectUsernameParam2(username string) *mAttachmentSvcMockUploadChunk {
+	if mmUploadChunk.mock.funcUploadChunk != nil {
+		mmUploadChunk.mock.t.Fatalf("AttachmentSvcMock.UploadChunk mock is already set by Set")
+	}
+
+	if mmUploadChunk.defaultExpectation == nil {
+		mmUploadChunk.defaultExpectation = &AttachmentSvcMockUploadChunkExpectation{}
+	}
+
+	if mmUploadChunk.defaultExpectation.params != nil {
+		mmUploadChunk.mock.t.Fatalf("AttachmentSvcMock.UploadChunk mock is already set by Expect")
+	}
+
+	if mmUploadChunk.defaultExpectation.paramPtrs == nil {
+		mmUploadChunk.defaultExpectation.paramPtrs = &AttachmentSvcMockUploadChunkParamPtrs{}
+	}
+	mmUploadChunk.defaultExpectation.paramPtrs.username = &username
+	mmUploadChunk.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmUploadChunk
+}
+
+// ExpectIdParam3 sets up expected param id for AttachmentSvc.UploadChunk
+func (mmUploadChunk *mAttachmentSvcMockUploadChunk) ExpectIdParam3(id string) *mAttachmentSvcMockUploadChunk {
+	if mmUploadChunk.mock.funcUploadChunk != nil {
+		mmUploadChunk.mock.t.Fatalf("AttachmentSvcMock.UploadChunk mock is already set by Set")
+	}
+
+	if mmUploadChunk.defaultExpectation == nil {
+		mmUploadChunk.defaultExpectation = &AttachmentSvcMockUploadChunkExpectation{}
+	}
+
+	if mmUploadChunk.defaultExpectation.params != nil {
+		mmUploadChunk.mock.t.Fatalf("AttachmentSvcMock.UploadChunk mock is already set by Expect")
+	}
+
+	if mmUploadChunk.defaultExpectation.paramPtrs == nil {
+		mmUploadChunk.defaultExpectation.paramPtrs = &AttachmentSvcMockUploadChunkParamPtrs{}
+	}
+	mmUploadChunk.defaultExpectation.paramPtrs.id = &id
+	mmUploadChunk.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmUploadChunk
+}
+
+// ExpectRngParam4 sets up expected param rng for AttachmentSvc.UploadChunk
+func (mmUploadChunk *mAttachmentSvcMockUploadChunk) ExpectRngParam4(rng attachment.Range) *mAttachmentSvcMockUploadChunk {
+	if mmUploadChunk.mock.funcUploadChunk != nil {
+		mmUploadChunk.mock.t.Fatalf("AttachmentSvcMock.UploadChunk mock is already set by Set")
+	}
+
+	if mmUploadChunk.defaultExpectation == nil {
+		mmUploadChunk.defaultExpectation = &AttachmentSvcMockUploadChunkExpectation{}
+	}
+
+	if mmUploadChunk.defaultExpectation.params != nil {
+		mmUploadChunk.mock.t.Fatalf("AttachmentSvcMock.UploadChunk mock is already set by Expect")
+	}
+
+	if mmUploadChunk.defaultExpectation.paramPtrs == nil {
+		mmUploadChunk.defaultExpectation.paramPtrs = &AttachmentSvcMockUploadChunkParamPtrs{}
+	}
+	mmUploadChunk.defaultExpectation.paramPtrs.rng = &rng
+	mmUploadChunk.defaultExpectation.expectationOrigins.originRng = minimock.CallerInfo(1)
+
+	return mmUploadChunk
+}
+
+// ExpectBodyParam5 sets up expected param body for AttachmentSvc.UploadChunk
+func (mmUploadChunk *mAttachmentSvcMockUploadChunk) ExpectBodyParam5(body io.Reader) *mAttachmentSvcMockUploadChunk {
+	if mmUploadChunk.mock.funcUploadChunk != nil {
+		mmUploadChunk.mock.t.Fatalf("AttachmentSvcMock.UploadChunk mock is already set by Set")
+	}
+
+	if mmUploadChunk.defaultExpectation == nil {
+		mmUploadChunk.defaultExpectation = &AttachmentSvcMockUploadChunkExpectation{}
+	}
+
+	if mmUploadChunk.defaultExpectation.params != nil {
+		mmUploadChunk.mock.t.Fatalf("AttachmentSvcMock.UploadChunk mock is already set by Expect")
+	}
+
+	if mmUploadChunk.defaultExpectation.paramPtrs == nil {
+		mmUploadChunk.defaultExpectation.paramPtrs = &AttachmentSvcMockUploadChunkParamPtrs{}
+	}
+	mmUploadChunk.defaultExpectation.paramPtrs.body = &body
+	mmUploadChunk.defaultExpectation.expectationOrigins.originBody = minimock.CallerInfo(1)
+
+	return mmUploadChunk
+}
+
+// Inspect accepts an inspector function that has same arguments as the AttachmentSvc.UploadChunk
+func (mmUploadChunk *mAttachmentSvcMockUploadChunk) Inspect(f func(ctx context.Context, username string, id string, rng attachment.Range, body io.Reader)) *mAttachmentSvcMockUploadChunk {
+	if mmUploadChunk.mock.inspectFuncUploadChunk != nil {
+		mmUploadChunk.mock.t.Fatalf("Inspect function is already set for AttachmentSvcMock.UploadChunk")
+	}
+
+	mmUploadChunk.mock.inspectFuncUploadChunk = f
+
+	return mmUploadChunk
+}
+
+// Return sets up results that will be returned by AttachmentSvc.UploadChunk
+func (mmUploadChunk *mAttachmentSvcMockUploadChunk) Return(a1 attachment.Attachment, err error) *AttachmentSvcMock {
+	if mmUploadChunk.mock.funcUploadChunk != nil {
+		mmUploadChunk.mock.t.Fatalf("AttachmentSvcMock.UploadChunk mock is already set by Set")
+	}
+
+	if mmUploadChunk.defaultExpectation == nil {
+		mmUploadChunk.defaultExpectation = &AttachmentSvcMockUploadChunkExpectation{mock: mmUploadChunk.mock}
+	}
+	mmUploadChunk.defaultExpectation.results = &AttachmentSvcMockUploadChunkResults{a1, err}
+	mmUploadChunk.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmUploadChunk.mock
+}
+
+// Set uses given function f to mock the AttachmentSvc.UploadChunk method
+func (mmUploadChunk *mAttachmentSvcMockUploadChunk) Set(f func(ctx context.Context, username string, id string, rng attachment.Range, body io.Reader) (a1 attachment.Attachment, err error)) *AttachmentSvcMock {
+	if mmUploadChunk.defaultExpectation != nil {
+		mmUploadChunk.mock.t.Fatalf("Default expectation is already set for the AttachmentSvc.UploadChunk method")
+	}
+
+	if len(mmUploadChunk.expectations) > 0 {
+		mmUploadChunk.mock.t.Fatalf("Some expectations are already set for the AttachmentSvc.UploadChunk method")
+	}
+
+	mmUploadChunk.mock.funcUploadChunk = f
+	mmUploadChunk.mock.funcUploadChunkOrigin = minimock.CallerInfo(1)
+	return mmUploadChunk.mock
+}
+
+// When sets expectation for the AttachmentSvc.UploadChunk which will trigger the result defined by the following
+// Then helper
+func (mmUploadChunk *mAttachmentSvcMockUploadChunk) When(ctx context.Context, username string, id string, rng attachment.Range, body io.Reader) *AttachmentSvcMockUploadChunkExpectation {
+	if mmUploadChunk.mock.funcUploadChunk != nil {
+		mmUploadChunk.mock.t.Fatalf("AttachmentSvcMock.UploadChunk mock is already set by Set")
+	}
+
+	expectation := &AttachmentSvcMockUploadChunkExpectation{
+		mock:               mmUploadChunk.mock,
+		params:             &AttachmentSvcMockUploadChunkParams{ctx, username, id, rng, body},
+		expectationOrigins: AttachmentSvcMockUploadChunkExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmUploadChunk.expectations = append(mmUploadChunk.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AttachmentSvc.UploadChunk return parameters for the expectation previously defined by the When method
+func (e *AttachmentSvcMockUploadChunkExpectation) Then(a1 attachment.Attachment, err error) *AttachmentSvcMock {
+	e.results = &AttachmentSvcMockUploadChunkResults{a1, err}
+	return e.mock
+}
+
+// Times sets number of times AttachmentSvc.UploadChunk should be invoked
+func (mmUploadChunk *mAttachmentSvcMockUploadChunk) Times(n uint64) *mAttachmentSvcMockUploadChunk {
+	if n == 0 {
+		mmUploadChunk.mock.t.Fatalf("Times of AttachmentSvcMock.UploadChunk mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmUploadChunk.expectedInvocations, n)
+	mmUploadChunk.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmUploadChunk
+}
+
+func (mmUploadChunk *mAttachmentSvcMockUploadChunk) invocationsDone() bool {
+	if len(mmUploadChunk.expectations) == 0 && mmUploadChunk.defaultExpectation == nil && mmUploadChunk.mock.funcUploadChunk == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmUploadChunk.mock.afterUploadChunkCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmUploadChunk.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// UploadChunk implements mm_handler.AttachmentSvc
+func (mmUploadChunk *AttachmentSvcMock) UploadChunk(ctx context.Context, username string, id string, rng attachment.Range, body io.Reader) (a1 attachment.Attachment, err error) {
+	mm_atomic.AddUint64(&mmUploadChunk.beforeUploadChunkCounter, 1)
+	defer mm_atomic.AddUint64(&mmUploadChunk.afterUploadChunkCounter, 1)
+
+	mmUploadChunk.t.Helper()
+
+	if mmUploadChunk.inspectFuncUploadChunk != nil {
+		mmUploadChunk.inspectFuncUploadChunk(ctx, username, id, rng, body)
+	}
+
+	mm_params := AttachmentSvcMockUploadChunkParams{ctx, username, id, rng, body}
+
+	// Record call args
+	mmUploadChunk.UploadChunkMock.mutex.Lock()
+	mmUploadChunk.UploadChunkMock.callArgs = append(mmUploadChunk.UploadChunkMock.callArgs, &mm_params)
+	mmUploadChunk.UploadChunkMock.mutex.Unlock()
+
+	for _, e := range mmUploadChunk.UploadChunkMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.a1, e.results.err
+		}
+	}
+
+	if mmUploadChunk.UploadChunkMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmUploadChunk.UploadChunkMock.defaultExpectation.Counter, 1)
+		mm_want := mmUploadChunk.UploadChunkMock.defaultExpectation.params
+		mm_want_ptrs := mmUploadChunk.UploadChunkMock.defaultExpectation.paramPtrs
+
+		mm_got := AttachmentSvcMockUploadChunkParams{ctx, username, id, rng, body}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmUploadChunk.t.Errorf("AttachmentSvcMock.UploadChunk got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUploadChunk.UploadChunkMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmUploadChunk.t.Errorf("AttachmentSvcMock.UploadChunk got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUploadChunk.UploadChunkMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmUploadChunk.t.Errorf("AttachmentSvcMock.UploadChunk got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUploadChunk.UploadChunkMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.rng != nil && !minimock.Equal(*mm_want_ptrs.rng, mm_got.rng) {
+				mmUploadChunk.t.Errorf("AttachmentSvcMock.UploadChunk got unexpected parameter rng, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUploadChunk.UploadChunkMock.defaultExpectation.expectationOrigins.originRng, *mm_want_ptrs.rng, mm_got.rng, minimock.Diff(*mm_want_ptrs.rng, mm_got.rng))
+			}
+
+			if mm_want_ptrs.body != nil && !minimock.Equal(*mm_want_ptrs.body, mm_got.body) {
+				mmUploadChunk.t.Errorf("AttachmentSvcMock.UploadChunk got unexpected parameter body, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUploadChunk.UploadChunkMock.defaultExpectation.expectationOrigins.originBody, *mm_want_ptrs.body, mm_got.body, minimock.Diff(*mm_want_ptrs.body, mm_got.body))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmUploadChunk.t.Errorf("AttachmentSvcMock.UploadChunk got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmUploadChunk.UploadChunkMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmUploadChunk.UploadChunkMock.defaultExpectation.results
+		if mm_results == nil {
+			mmUploadChunk.t.Fatal("No results are set for the AttachmentSvcMock.UploadChunk")
+		}
+		return (*mm_results).a1, (*mm_results).err
+	}
+	if mmUploadChunk.funcUploadChunk != nil {
+		return mmUploadChunk.funcUploadChunk(ctx, username, id, rng, body)
+	}
+	mmUploadChunk.t.Fatalf("Unexpected call to AttachmentSvcMock.UploadChunk. %v %v %v %v %v", ctx, username, id, rng, body)
+	return
+}
+
+// UploadChunkAfterCounter returns a count of finished AttachmentSvcMock.UploadChunk invocations
+func (mmUploadChunk *AttachmentSvcMock) UploadChunkAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUploadChunk.afterUploadChunkCounter)
+}
+
+// UploadChunkBeforeCounter returns a count of AttachmentSvcMock.UploadChunk invocations
+func (mmUploadChunk *AttachmentSvcMock) UploadChunkBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmUploadChunk.beforeUploadChunkCounter)
+}
+
+// Calls returns a list of arguments used in each call to AttachmentSvcMock.UploadChunk.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmUploadChunk *mAttachmentSvcMockUploadChunk) Calls() []*AttachmentSvcMockUploadChunkParams {
+	mmUploadChunk.mutex.RLock()
+
+	argCopy := make([]*AttachmentSvcMockUploadChunkParams, len(mmUploadChunk.callArgs))
+	copy(argCopy, mmUploadChunk.callArgs)
+
+	mmUploadChunk.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockUploadChunkDone returns true if the count of the UploadChunk invocations corresponds
+// the number of defined expectations
+func (m *AttachmentSvcMock) MinimockUploadChunkDone() bool {
+	if m.UploadChunkMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.UploadChunkMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.UploadChunkMock.invocationsDone()
+}
+
+// MinimockUploadChunkInspect logs each unmet expectation
+func (m *AttachmentSvcMock) MinimockUploadChunkInspect() {
+	for _, e := range m.UploadChunkMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AttachmentSvcMock.UploadChunk at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterUploadChunkCounter := mm_atomic.LoadUint64(&m.afterUploadChunkCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.UploadChunkMock.defaultExpectation != nil && afterUploadChunkCounter < 1 {
+		if m.UploadChunkMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AttachmentSvcMock.UploadChunk at\n%s", m.UploadChunkMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AttachmentSvcMock.UploadChunk at\n%s with params: %#v", m.UploadChunkMock.defaultExpectation.expectationOrigins.origin, *m.UploadChunkMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcUploadChunk != nil && afterUploadChunkCounter < 1 {
+		m.t.Errorf("Expected call to AttachmentSvcMock.UploadChunk at\n%s", m.funcUploadChunkOrigin)
+	}
+
+	if !m.UploadChunkMock.invocationsDone() && afterUploadChunkCounter > 0 {
+		m.t.Errorf("Expected %d calls to AttachmentSvcMock.UploadChunk at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.UploadChunkMock.expectedInvocations), m.UploadChunkMock.expectedInvocationsOrigin, afterUploadChunkCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *AttachmentSvcMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateAttachmentInspect()
+
+			m.MinimockDeleteAttachmentInspect()
+
+			m.MinimockGetAttachmentsInspect()
+
+			m.MinimockOpenAttachmentInspect()
+
+			m.MinimockUploadChunkInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *AttachmentSvcMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *AttachmentSvcMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateAttachmentDone() &&
+		m.MinimockDeleteAttachmentDone() &&
+		m.MinimockGetAttachmentsDone() &&
+		m.MinimockOpenAttachmentDone() &&
+		m.MinimockUploadChunkDone()
+}
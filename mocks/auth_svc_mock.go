@@ -11,6 +11,11 @@ import (
 	mm_time "time"
 
 	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/apikey"
+	"github.com/gleb-korostelev/GophKeeper/models/device"
+	"github.com/gleb-korostelev/GophKeeper/models/recovery"
+	"github.com/gleb-korostelev/GophKeeper/pkg/keyring"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
 	"github.com/gojuno/minimock/v3"
 )
 
@@ -19,6 +24,27 @@ type AuthSvcMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcAuthenticateAPIKey          func(ctx context.Context, token string) (key apikey.Key, ok bool, err error)
+	funcAuthenticateAPIKeyOrigin    string
+	inspectFuncAuthenticateAPIKey   func(ctx context.Context, token string)
+	afterAuthenticateAPIKeyCounter  uint64
+	beforeAuthenticateAPIKeyCounter uint64
+	AuthenticateAPIKeyMock          mAuthSvcMockAuthenticateAPIKey
+
+	funcChangePassword          func(ctx context.Context, username string, sessionID string, change models.PasswordChange) (revoked int64, err error)
+	funcChangePasswordOrigin    string
+	inspectFuncChangePassword   func(ctx context.Context, username string, sessionID string, change models.PasswordChange)
+	afterChangePasswordCounter  uint64
+	beforeChangePasswordCounter uint64
+	ChangePasswordMock          mAuthSvcMockChangePassword
+
+	funcCreateAPIKey          func(ctx context.Context, username string, k apikey.Key) (key apikey.Key, token string, err error)
+	funcCreateAPIKeyOrigin    string
+	inspectFuncCreateAPIKey   func(ctx context.Context, username string, k apikey.Key)
+	afterCreateAPIKeyCounter  uint64
+	beforeCreateAPIKeyCounter uint64
+	CreateAPIKeyMock          mAuthSvcMockCreateAPIKey
+
 	funcCreateProfile          func(ctx context.Context, profile models.Profile) (challenge string, err error)
 	funcCreateProfileOrigin    string
 	inspectFuncCreateProfile   func(ctx context.Context, profile models.Profile)
@@ -26,6 +52,34 @@ type AuthSvcMock struct {
 	beforeCreateProfileCounter uint64
 	CreateProfileMock          mAuthSvcMockCreateProfile
 
+	funcCreateRecoveryKit          func(ctx context.Context, username string, setup recovery.Setup) (kit recovery.Kit, shares []recovery.Share, err error)
+	funcCreateRecoveryKitOrigin    string
+	inspectFuncCreateRecoveryKit   func(ctx context.Context, username string, setup recovery.Setup)
+	afterCreateRecoveryKitCounter  uint64
+	beforeCreateRecoveryKitCounter uint64
+	CreateRecoveryKitMock          mAuthSvcMockCreateRecoveryKit
+
+	funcDeleteDevice          func(ctx context.Context, username string, id string) (revoked int64, err error)
+	funcDeleteDeviceOrigin    string
+	inspectFuncDeleteDevice   func(ctx context.Context, username string, id string)
+	afterDeleteDeviceCounter  uint64
+	beforeDeleteDeviceCounter uint64
+	DeleteDeviceMock          mAuthSvcMockDeleteDevice
+
+	funcDeleteRecoveryKit          func(ctx context.Context, username string) (err error)
+	funcDeleteRecoveryKitOrigin    string
+	inspectFuncDeleteRecoveryKit   func(ctx context.Context, username string)
+	afterDeleteRecoveryKitCounter  uint64
+	beforeDeleteRecoveryKitCounter uint64
+	DeleteRecoveryKitMock          mAuthSvcMockDeleteRecoveryKit
+
+	funcGetAPIKeys          func(ctx context.Context, username string, page pagination.Request) (keys []apikey.Key, next string, err error)
+	funcGetAPIKeysOrigin    string
+	inspectFuncGetAPIKeys   func(ctx context.Context, username string, page pagination.Request)
+	afterGetAPIKeysCounter  uint64
+	beforeGetAPIKeysCounter uint64
+	GetAPIKeysMock          mAuthSvcMockGetAPIKeys
+
 	funcGetAccountByUserName          func(ctx context.Context, username string) (acc models.Account, err error)
 	funcGetAccountByUserNameOrigin    string
 	inspectFuncGetAccountByUserName   func(ctx context.Context, username string)
@@ -40,9 +94,58 @@ type AuthSvcMock struct {
 	beforeGetChallengeCounter uint64
 	GetChallengeMock          mAuthSvcMockGetChallenge
 
-	funcSignIn          func(ctx context.Context, profile models.Profile, challenge string) (token string, refresh string, err error)
+	funcGetDevices          func(ctx context.Context, username string, sessionID string, page pagination.Request) (devices []device.Device, next string, err error)
+	funcGetDevicesOrigin    string
+	inspectFuncGetDevices   func(ctx context.Context, username string, sessionID string, page pagination.Request)
+	afterGetDevicesCounter  uint64
+	beforeGetDevicesCounter uint64
+	GetDevicesMock          mAuthSvcMockGetDevices
+
+	funcGetJWKS          func(ctx context.Context) (jwks keyring.JWKS, err error)
+	funcGetJWKSOrigin    string
+	inspectFuncGetJWKS   func(ctx context.Context)
+	afterGetJWKSCounter  uint64
+	beforeGetJWKSCounter uint64
+	GetJWKSMock          mAuthSvcMockGetJWKS
+
+	funcGetRecoveryKit          func(ctx context.Context, username string) (kit recovery.Kit, err error)
+	funcGetRecoveryKitOrigin    string
+	inspectFuncGetRecoveryKit   func(ctx context.Context, username string)
+	afterGetRecoveryKitCounter  uint64
+	beforeGetRecoveryKitCounter uint64
+	GetRecoveryKitMock          mAuthSvcMockGetRecoveryKit
+
+	funcRecover          func(ctx context.Context, username string, codes []string, change models.PasswordChange) (vaultKey []byte, revoked int64, err error)
+	funcRecoverOrigin    string
+	inspectFuncRecover   func(ctx context.Context, username string, codes []string, change models.PasswordChange)
+	afterRecoverCounter  uint64
+	beforeRecoverCounter uint64
+	RecoverMock          mAuthSvcMockRecover
+
+	funcRevokeAPIKey          func(ctx context.Context, username string, id string) (err error)
+	funcRevokeAPIKeyOrigin    string
+	inspectFuncRevokeAPIKey   func(ctx context.Context, username string, id string)
+	afterRevokeAPIKeyCounter  uint64
+	beforeRevokeAPIKeyCounter uint64
+	RevokeAPIKeyMock          mAuthSvcMockRevokeAPIKey
+
+	funcSessionActive          func(ctx context.Context, sessionID string) (active bool, deviceKey []byte, err error)
+	funcSessionActiveOrigin    string
+	inspectFuncSessionActive   func(ctx context.Context, sessionID string)
+	afterSessionActiveCounter  uint64
+	beforeSessionActiveCounter uint64
+	SessionActiveMock          mAuthSvcMockSessionActive
+
+	funcSetPublicKey          func(ctx context.Context, username string, publicKey []byte) (err error)
+	funcSetPublicKeyOrigin    string
+	inspectFuncSetPublicKey   func(ctx context.Context, username string, publicKey []byte)
+	afterSetPublicKeyCounter  uint64
+	beforeSetPublicKeyCounter uint64
+	SetPublicKeyMock          mAuthSvcMockSetPublicKey
+
+	funcSignIn          func(ctx context.Context, profile models.Profile, challenge string, client string, reg *device.Registration) (token string, refresh string, err error)
 	funcSignInOrigin    string
-	inspectFuncSignIn   func(ctx context.Context, profile models.Profile, challenge string)
+	inspectFuncSignIn   func(ctx context.Context, profile models.Profile, challenge string, client string, reg *device.Registration)
 	afterSignInCounter  uint64
 	beforeSignInCounter uint64
 	SignInMock          mAuthSvcMockSignIn
@@ -56,15 +159,57 @@ func NewAuthSvcMock(t minimock.Tester) *AuthSvcMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.AuthenticateAPIKeyMock = mAuthSvcMockAuthenticateAPIKey{mock: m}
+	m.AuthenticateAPIKeyMock.callArgs = []*AuthSvcMockAuthenticateAPIKeyParams{}
+
+	m.ChangePasswordMock = mAuthSvcMockChangePassword{mock: m}
+	m.ChangePasswordMock.callArgs = []*AuthSvcMockChangePasswordParams{}
+
+	m.CreateAPIKeyMock = mAuthSvcMockCreateAPIKey{mock: m}
+	m.CreateAPIKeyMock.callArgs = []*AuthSvcMockCreateAPIKeyParams{}
+
 	m.CreateProfileMock = mAuthSvcMockCreateProfile{mock: m}
 	m.CreateProfileMock.callArgs = []*AuthSvcMockCreateProfileParams{}
 
+	m.CreateRecoveryKitMock = mAuthSvcMockCreateRecoveryKit{mock: m}
+	m.CreateRecoveryKitMock.callArgs = []*AuthSvcMockCreateRecoveryKitParams{}
+
+	m.DeleteDeviceMock = mAuthSvcMockDeleteDevice{mock: m}
+	m.DeleteDeviceMock.callArgs = []*AuthSvcMockDeleteDeviceParams{}
+
+	m.DeleteRecoveryKitMock = mAuthSvcMockDeleteRecoveryKit{mock: m}
+	m.DeleteRecoveryKitMock.callArgs = []*AuthSvcMockDeleteRecoveryKitParams{}
+
+	m.GetAPIKeysMock = mAuthSvcMockGetAPIKeys{mock: m}
+	m.GetAPIKeysMock.callArgs = []*AuthSvcMockGetAPIKeysParams{}
+
 	m.GetAccountByUserNameMock = mAuthSvcMockGetAccountByUserName{mock: m}
 	m.GetAccountByUserNameMock.callArgs = []*AuthSvcMockGetAccountByUserNameParams{}
 
 	m.GetChallengeMock = mAuthSvcMockGetChallenge{mock: m}
 	m.GetChallengeMock.callArgs = []*AuthSvcMockGetChallengeParams{}
 
+	m.GetDevicesMock = mAuthSvcMockGetDevices{mock: m}
+	m.GetDevicesMock.callArgs = []*AuthSvcMockGetDevicesParams{}
+
+	m.GetJWKSMock = mAuthSvcMockGetJWKS{mock: m}
+	m.GetJWKSMock.callArgs = []*AuthSvcMockGetJWKSParams{}
+
+	m.GetRecoveryKitMock = mAuthSvcMockGetRecoveryKit{mock: m}
+	m.GetRecoveryKitMock.callArgs = []*AuthSvcMockGetRecoveryKitParams{}
+
+	m.RecoverMock = mAuthSvcMockRecover{mock: m}
+	m.RecoverMock.callArgs = []*AuthSvcMockRecoverParams{}
+
+	m.RevokeAPIKeyMock = mAuthSvcMockRevokeAPIKey{mock: m}
+	m.RevokeAPIKeyMock.callArgs = []*AuthSvcMockRevokeAPIKeyParams{}
+
+	m.SessionActiveMock = mAuthSvcMockSessionActive{mock: m}
+	m.SessionActiveMock.callArgs = []*AuthSvcMockSessionActiveParams{}
+
+	m.SetPublicKeyMock = mAuthSvcMockSetPublicKey{mock: m}
+	m.SetPublicKeyMock.callArgs = []*AuthSvcMockSetPublicKeyParams{}
+
 	m.SignInMock = mAuthSvcMockSignIn{mock: m}
 	m.SignInMock.callArgs = []*AuthSvcMockSignInParams{}
 
@@ -73,53 +218,54 @@ func NewAuthSvcMock(t minimock.Tester) *AuthSvcMock {
 	return m
 }
 
-type mAuthSvcMockCreateProfile struct {
+type mAuthSvcMockAuthenticateAPIKey struct {
 	optional           bool
 	mock               *AuthSvcMock
-	defaultExpectation *AuthSvcMockCreateProfileExpectation
-	expectations       []*AuthSvcMockCreateProfileExpectation
+	defaultExpectation *AuthSvcMockAuthenticateAPIKeyExpectation
+	expectations       []*AuthSvcMockAuthenticateAPIKeyExpectation
 
-	callArgs []*AuthSvcMockCreateProfileParams
+	callArgs []*AuthSvcMockAuthenticateAPIKeyParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// AuthSvcMockCreateProfileExpectation specifies expectation struct of the AuthSvc.CreateProfile
-type AuthSvcMockCreateProfileExpectation struct {
+// AuthSvcMockAuthenticateAPIKeyExpectation specifies expectation struct of the AuthSvc.AuthenticateAPIKey
+type AuthSvcMockAuthenticateAPIKeyExpectation struct {
 	mock               *AuthSvcMock
-	params             *AuthSvcMockCreateProfileParams
-	paramPtrs          *AuthSvcMockCreateProfileParamPtrs
-	expectationOrigins AuthSvcMockCreateProfileExpectationOrigins
-	results            *AuthSvcMockCreateProfileResults
+	params             *AuthSvcMockAuthenticateAPIKeyParams
+	paramPtrs          *AuthSvcMockAuthenticateAPIKeyParamPtrs
+	expectationOrigins AuthSvcMockAuthenticateAPIKeyExpectationOrigins
+	results            *AuthSvcMockAuthenticateAPIKeyResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// AuthSvcMockCreateProfileParams contains parameters of the AuthSvc.CreateProfile
-type AuthSvcMockCreateProfileParams struct {
-	ctx     context.Context
-	profile models.Profile
+// AuthSvcMockAuthenticateAPIKeyParams contains parameters of the AuthSvc.AuthenticateAPIKey
+type AuthSvcMockAuthenticateAPIKeyParams struct {
+	ctx   context.Context
+	token string
 }
 
-// AuthSvcMockCreateProfileParamPtrs contains pointers to parameters of the AuthSvc.CreateProfile
-type AuthSvcMockCreateProfileParamPtrs struct {
-	ctx     *context.Context
-	profile *models.Profile
+// AuthSvcMockAuthenticateAPIKeyParamPtrs contains pointers to parameters of the AuthSvc.AuthenticateAPIKey
+type AuthSvcMockAuthenticateAPIKeyParamPtrs struct {
+	ctx   *context.Context
+	token *string
 }
 
-// AuthSvcMockCreateProfileResults contains results of the AuthSvc.CreateProfile
-type AuthSvcMockCreateProfileResults struct {
-	challenge string
-	err       error
+// AuthSvcMockAuthenticateAPIKeyResults contains results of the AuthSvc.AuthenticateAPIKey
+type AuthSvcMockAuthenticateAPIKeyResults struct {
+	key apikey.Key
+	ok  bool
+	err error
 }
 
-// AuthSvcMockCreateProfileOrigins contains origins of expectations of the AuthSvc.CreateProfile
-type AuthSvcMockCreateProfileExpectationOrigins struct {
-	origin        string
-	originCtx     string
-	originProfile string
+// AuthSvcMockAuthenticateAPIKeyOrigins contains origins of expectations of the AuthSvc.AuthenticateAPIKey
+type AuthSvcMockAuthenticateAPIKeyExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originToken string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -127,342 +273,348 @@ type AuthSvcMockCreateProfileExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmCreateProfile *mAuthSvcMockCreateProfile) Optional() *mAuthSvcMockCreateProfile {
-	mmCreateProfile.optional = true
-	return mmCreateProfile
+func (mmAuthenticateAPIKey *mAuthSvcMockAuthenticateAPIKey) Optional() *mAuthSvcMockAuthenticateAPIKey {
+	mmAuthenticateAPIKey.optional = true
+	return mmAuthenticateAPIKey
 }
 
-// Expect sets up expected params for AuthSvc.CreateProfile
-func (mmCreateProfile *mAuthSvcMockCreateProfile) Expect(ctx context.Context, profile models.Profile) *mAuthSvcMockCreateProfile {
-	if mmCreateProfile.mock.funcCreateProfile != nil {
-		mmCreateProfile.mock.t.Fatalf("AuthSvcMock.CreateProfile mock is already set by Set")
+// Expect sets up expected params for AuthSvc.AuthenticateAPIKey
+func (mmAuthenticateAPIKey *mAuthSvcMockAuthenticateAPIKey) Expect(ctx context.Context, token string) *mAuthSvcMockAuthenticateAPIKey {
+	if mmAuthenticateAPIKey.mock.funcAuthenticateAPIKey != nil {
+		mmAuthenticateAPIKey.mock.t.Fatalf("AuthSvcMock.AuthenticateAPIKey mock is already set by Set")
 	}
 
-	if mmCreateProfile.defaultExpectation == nil {
-		mmCreateProfile.defaultExpectation = &AuthSvcMockCreateProfileExpectation{}
+	if mmAuthenticateAPIKey.defaultExpectation == nil {
+		mmAuthenticateAPIKey.defaultExpectation = &AuthSvcMockAuthenticateAPIKeyExpectation{}
 	}
 
-	if mmCreateProfile.defaultExpectation.paramPtrs != nil {
-		mmCreateProfile.mock.t.Fatalf("AuthSvcMock.CreateProfile mock is already set by ExpectParams functions")
+	if mmAuthenticateAPIKey.defaultExpectation.paramPtrs != nil {
+		mmAuthenticateAPIKey.mock.t.Fatalf("AuthSvcMock.AuthenticateAPIKey mock is already set by ExpectParams functions")
 	}
 
-	mmCreateProfile.defaultExpectation.params = &AuthSvcMockCreateProfileParams{ctx, profile}
-	mmCreateProfile.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmCreateProfile.expectations {
-		if minimock.Equal(e.params, mmCreateProfile.defaultExpectation.params) {
-			mmCreateProfile.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateProfile.defaultExpectation.params)
+	mmAuthenticateAPIKey.defaultExpectation.params = &AuthSvcMockAuthenticateAPIKeyParams{ctx, token}
+	mmAuthenticateAPIKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAuthenticateAPIKey.expectations {
+		if minimock.Equal(e.params, mmAuthenticateAPIKey.defaultExpectation.params) {
+			mmAuthenticateAPIKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAuthenticateAPIKey.defaultExpectation.params)
 		}
 	}
 
-	return mmCreateProfile
+	return mmAuthenticateAPIKey
 }
 
-// ExpectCtxParam1 sets up expected param ctx for AuthSvc.CreateProfile
-func (mmCreateProfile *mAuthSvcMockCreateProfile) ExpectCtxParam1(ctx context.Context) *mAuthSvcMockCreateProfile {
-	if mmCreateProfile.mock.funcCreateProfile != nil {
-		mmCreateProfile.mock.t.Fatalf("AuthSvcMock.CreateProfile mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for AuthSvc.AuthenticateAPIKey
+func (mmAuthenticateAPIKey *mAuthSvcMockAuthenticateAPIKey) ExpectCtxParam1(ctx context.Context) *mAuthSvcMockAuthenticateAPIKey {
+	if mmAuthenticateAPIKey.mock.funcAuthenticateAPIKey != nil {
+		mmAuthenticateAPIKey.mock.t.Fatalf("AuthSvcMock.AuthenticateAPIKey mock is already set by Set")
 	}
 
-	if mmCreateProfile.defaultExpectation == nil {
-		mmCreateProfile.defaultExpectation = &AuthSvcMockCreateProfileExpectation{}
+	if mmAuthenticateAPIKey.defaultExpectation == nil {
+		mmAuthenticateAPIKey.defaultExpectation = &AuthSvcMockAuthenticateAPIKeyExpectation{}
 	}
 
-	if mmCreateProfile.defaultExpectation.params != nil {
-		mmCreateProfile.mock.t.Fatalf("AuthSvcMock.CreateProfile mock is already set by Expect")
+	if mmAuthenticateAPIKey.defaultExpectation.params != nil {
+		mmAuthenticateAPIKey.mock.t.Fatalf("AuthSvcMock.AuthenticateAPIKey mock is already set by Expect")
 	}
 
-	if mmCreateProfile.defaultExpectation.paramPtrs == nil {
-		mmCreateProfile.defaultExpectation.paramPtrs = &AuthSvcMockCreateProfileParamPtrs{}
+	if mmAuthenticateAPIKey.defaultExpectation.paramPtrs == nil {
+		mmAuthenticateAPIKey.defaultExpectation.paramPtrs = &AuthSvcMockAuthenticateAPIKeyParamPtrs{}
 	}
-	mmCreateProfile.defaultExpectation.paramPtrs.ctx = &ctx
-	mmCreateProfile.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmAuthenticateAPIKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAuthenticateAPIKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmCreateProfile
+	return mmAuthenticateAPIKey
 }
 
-// ExpectProfileParam2 sets up expected param profile for AuthSvc.CreateProfile
-func (mmCreateProfile *mAuthSvcMockCreateProfile) ExpectProfileParam2(profile models.Profile) *mAuthSvcMockCreateProfile {
-	if mmCreateProfile.mock.funcCreateProfile != nil {
-		mmCreateProfile.mock.t.Fatalf("AuthSvcMock.CreateProfile mock is already set by Set")
+// ExpectTokenParam2 sets up expected param token for AuthSvc.AuthenticateAPIKey
+func (mmAuthenticateAPIKey *mAuthSvcMockAuthenticateAPIKey) ExpectTokenParam2(token string) *mAuthSvcMockAuthenticateAPIKey {
+	if mmAuthenticateAPIKey.mock.funcAuthenticateAPIKey != nil {
+		mmAuthenticateAPIKey.mock.t.Fatalf("AuthSvcMock.AuthenticateAPIKey mock is already set by Set")
 	}
 
-	if mmCreateProfile.defaultExpectation == nil {
-		mmCreateProfile.defaultExpectation = &AuthSvcMockCreateProfileExpectation{}
+	if mmAuthenticateAPIKey.defaultExpectation == nil {
+		mmAuthenticateAPIKey.defaultExpectation = &AuthSvcMockAuthenticateAPIKeyExpectation{}
 	}
 
-	if mmCreateProfile.defaultExpectation.params != nil {
-		mmCreateProfile.mock.t.Fatalf("AuthSvcMock.CreateProfile mock is already set by Expect")
+	if mmAuthenticateAPIKey.defaultExpectation.params != nil {
+		mmAuthenticateAPIKey.mock.t.Fatalf("AuthSvcMock.AuthenticateAPIKey mock is already set by Expect")
 	}
 
-	if mmCreateProfile.defaultExpectation.paramPtrs == nil {
-		mmCreateProfile.defaultExpectation.paramPtrs = &AuthSvcMockCreateProfileParamPtrs{}
+	if mmAuthenticateAPIKey.defaultExpectation.paramPtrs == nil {
+		mmAuthenticateAPIKey.defaultExpectation.paramPtrs = &AuthSvcMockAuthenticateAPIKeyParamPtrs{}
 	}
-	mmCreateProfile.defaultExpectation.paramPtrs.profile = &profile
-	mmCreateProfile.defaultExpectation.expectationOrigins.originProfile = minimock.CallerInfo(1)
+	mmAuthenticateAPIKey.defaultExpectation.paramPtrs.token = &token
+	mmAuthenticateAPIKey.defaultExpectation.expectationOrigins.originToken = minimock.CallerInfo(1)
 
-	return mmCreateProfile
+	return mmAuthenticateAPIKey
 }
 
-// Inspect accepts an inspector function that has same arguments as the AuthSvc.CreateProfile
-func (mmCreateProfile *mAuthSvcMockCreateProfile) Inspect(f func(ctx context.Context, profile models.Profile)) *mAuthSvcMockCreateProfile {
-	if mmCreateProfile.mock.inspectFuncCreateProfile != nil {
-		mmCreateProfile.mock.t.Fatalf("Inspect function is already set for AuthSvcMock.CreateProfile")
+// Inspect accepts an inspector function that has same arguments as the AuthSvc.AuthenticateAPIKey
+func (mmAuthenticateAPIKey *mAuthSvcMockAuthenticateAPIKey) Inspect(f func(ctx context.Context, token string)) *mAuthSvcMockAuthenticateAPIKey {
+	if mmAuthenticateAPIKey.mock.inspectFuncAuthenticateAPIKey != nil {
+		mmAuthenticateAPIKey.mock.t.Fatalf("Inspect function is already set for AuthSvcMock.AuthenticateAPIKey")
 	}
 
-	mmCreateProfile.mock.inspectFuncCreateProfile = f
+	mmAuthenticateAPIKey.mock.inspectFuncAuthenticateAPIKey = f
 
-	return mmCreateProfile
+	return mmAuthenticateAPIKey
 }
 
-// Return sets up results that will be returned by AuthSvc.CreateProfile
-func (mmCreateProfile *mAuthSvcMockCreateProfile) Return(challenge string, err error) *AuthSvcMock {
-	if mmCreateProfile.mock.funcCreateProfile != nil {
-		mmCreateProfile.mock.t.Fatalf("AuthSvcMock.CreateProfile mock is already set by Set")
+// Return sets up results that will be returned by AuthSvc.AuthenticateAPIKey
+func (mmAuthenticateAPIKey *mAuthSvcMockAuthenticateAPIKey) Return(key apikey.Key, ok bool, err error) *AuthSvcMock {
+	if mmAuthenticateAPIKey.mock.funcAuthenticateAPIKey != nil {
+		mmAuthenticateAPIKey.mock.t.Fatalf("AuthSvcMock.AuthenticateAPIKey mock is already set by Set")
 	}
 
-	if mmCreateProfile.defaultExpectation == nil {
-		mmCreateProfile.defaultExpectation = &AuthSvcMockCreateProfileExpectation{mock: mmCreateProfile.mock}
+	if mmAuthenticateAPIKey.defaultExpectation == nil {
+		mmAuthenticateAPIKey.defaultExpectation = &AuthSvcMockAuthenticateAPIKeyExpectation{mock: mmAuthenticateAPIKey.mock}
 	}
-	mmCreateProfile.defaultExpectation.results = &AuthSvcMockCreateProfileResults{challenge, err}
-	mmCreateProfile.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmCreateProfile.mock
+	mmAuthenticateAPIKey.defaultExpectation.results = &AuthSvcMockAuthenticateAPIKeyResults{key, ok, err}
+	mmAuthenticateAPIKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAuthenticateAPIKey.mock
 }
 
-// Set uses given function f to mock the AuthSvc.CreateProfile method
-func (mmCreateProfile *mAuthSvcMockCreateProfile) Set(f func(ctx context.Context, profile models.Profile) (challenge string, err error)) *AuthSvcMock {
-	if mmCreateProfile.defaultExpectation != nil {
-		mmCreateProfile.mock.t.Fatalf("Default expectation is already set for the AuthSvc.CreateProfile method")
+// Set uses given function f to mock the AuthSvc.AuthenticateAPIKey method
+func (mmAuthenticateAPIKey *mAuthSvcMockAuthenticateAPIKey) Set(f func(ctx context.Context, token string) (key apikey.Key, ok bool, err error)) *AuthSvcMock {
+	if mmAuthenticateAPIKey.defaultExpectation != nil {
+		mmAuthenticateAPIKey.mock.t.Fatalf("Default expectation is already set for the AuthSvc.AuthenticateAPIKey method")
 	}
 
-	if len(mmCreateProfile.expectations) > 0 {
-		mmCreateProfile.mock.t.Fatalf("Some expectations are already set for the AuthSvc.CreateProfile method")
+	if len(mmAuthenticateAPIKey.expectations) > 0 {
+		mmAuthenticateAPIKey.mock.t.Fatalf("Some expectations are already set for the AuthSvc.AuthenticateAPIKey method")
 	}
 
-	mmCreateProfile.mock.funcCreateProfile = f
-	mmCreateProfile.mock.funcCreateProfileOrigin = minimock.CallerInfo(1)
-	return mmCreateProfile.mock
+	mmAuthenticateAPIKey.mock.funcAuthenticateAPIKey = f
+	mmAuthenticateAPIKey.mock.funcAuthenticateAPIKeyOrigin = minimock.CallerInfo(1)
+	return mmAuthenticateAPIKey.mock
 }
 
-// When sets expectation for the AuthSvc.CreateProfile which will trigger the result defined by the following
+// When sets expectation for the AuthSvc.AuthenticateAPIKey which will trigger the result defined by the following
 // Then helper
-func (mmCreateProfile *mAuthSvcMockCreateProfile) When(ctx context.Context, profile models.Profile) *AuthSvcMockCreateProfileExpectation {
-	if mmCreateProfile.mock.funcCreateProfile != nil {
-		mmCreateProfile.mock.t.Fatalf("AuthSvcMock.CreateProfile mock is already set by Set")
+func (mmAuthenticateAPIKey *mAuthSvcMockAuthenticateAPIKey) When(ctx context.Context, token string) *AuthSvcMockAuthenticateAPIKeyExpectation {
+	if mmAuthenticateAPIKey.mock.funcAuthenticateAPIKey != nil {
+		mmAuthenticateAPIKey.mock.t.Fatalf("AuthSvcMock.AuthenticateAPIKey mock is already set by Set")
 	}
 
-	expectation := &AuthSvcMockCreateProfileExpectation{
-		mock:               mmCreateProfile.mock,
-		params:             &AuthSvcMockCreateProfileParams{ctx, profile},
-		expectationOrigins: AuthSvcMockCreateProfileExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &AuthSvcMockAuthenticateAPIKeyExpectation{
+		mock:               mmAuthenticateAPIKey.mock,
+		params:             &AuthSvcMockAuthenticateAPIKeyParams{ctx, token},
+		expectationOrigins: AuthSvcMockAuthenticateAPIKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmCreateProfile.expectations = append(mmCreateProfile.expectations, expectation)
+	mmAuthenticateAPIKey.expectations = append(mmAuthenticateAPIKey.expectations, expectation)
 	return expectation
 }
 
-// Then sets up AuthSvc.CreateProfile return parameters for the expectation previously defined by the When method
-func (e *AuthSvcMockCreateProfileExpectation) Then(challenge string, err error) *AuthSvcMock {
-	e.results = &AuthSvcMockCreateProfileResults{challenge, err}
+// Then sets up AuthSvc.AuthenticateAPIKey return parameters for the expectation previously defined by the When method
+func (e *AuthSvcMockAuthenticateAPIKeyExpectation) Then(key apikey.Key, ok bool, err error) *AuthSvcMock {
+	e.results = &AuthSvcMockAuthenticateAPIKeyResults{key, ok, err}
 	return e.mock
 }
 
-// Times sets number of times AuthSvc.CreateProfile should be invoked
-func (mmCreateProfile *mAuthSvcMockCreateProfile) Times(n uint64) *mAuthSvcMockCreateProfile {
+// Times sets number of times AuthSvc.AuthenticateAPIKey should be invoked
+func (mmAuthenticateAPIKey *mAuthSvcMockAuthenticateAPIKey) Times(n uint64) *mAuthSvcMockAuthenticateAPIKey {
 	if n == 0 {
-		mmCreateProfile.mock.t.Fatalf("Times of AuthSvcMock.CreateProfile mock can not be zero")
+		mmAuthenticateAPIKey.mock.t.Fatalf("Times of AuthSvcMock.AuthenticateAPIKey mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmCreateProfile.expectedInvocations, n)
-	mmCreateProfile.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmCreateProfile
+	mm_atomic.StoreUint64(&mmAuthenticateAPIKey.expectedInvocations, n)
+	mmAuthenticateAPIKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAuthenticateAPIKey
 }
 
-func (mmCreateProfile *mAuthSvcMockCreateProfile) invocationsDone() bool {
-	if len(mmCreateProfile.expectations) == 0 && mmCreateProfile.defaultExpectation == nil && mmCreateProfile.mock.funcCreateProfile == nil {
+func (mmAuthenticateAPIKey *mAuthSvcMockAuthenticateAPIKey) invocationsDone() bool {
+	if len(mmAuthenticateAPIKey.expectations) == 0 && mmAuthenticateAPIKey.defaultExpectation == nil && mmAuthenticateAPIKey.mock.funcAuthenticateAPIKey == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmCreateProfile.mock.afterCreateProfileCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmCreateProfile.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmAuthenticateAPIKey.mock.afterAuthenticateAPIKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAuthenticateAPIKey.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// CreateProfile implements mm_handler.AuthSvc
-func (mmCreateProfile *AuthSvcMock) CreateProfile(ctx context.Context, profile models.Profile) (challenge string, err error) {
-	mm_atomic.AddUint64(&mmCreateProfile.beforeCreateProfileCounter, 1)
-	defer mm_atomic.AddUint64(&mmCreateProfile.afterCreateProfileCounter, 1)
+// AuthenticateAPIKey implements mm_handler.AuthSvc
+func (mmAuthenticateAPIKey *AuthSvcMock) AuthenticateAPIKey(ctx context.Context, token string) (key apikey.Key, ok bool, err error) {
+	mm_atomic.AddUint64(&mmAuthenticateAPIKey.beforeAuthenticateAPIKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmAuthenticateAPIKey.afterAuthenticateAPIKeyCounter, 1)
 
-	mmCreateProfile.t.Helper()
+	mmAuthenticateAPIKey.t.Helper()
 
-	if mmCreateProfile.inspectFuncCreateProfile != nil {
-		mmCreateProfile.inspectFuncCreateProfile(ctx, profile)
+	if mmAuthenticateAPIKey.inspectFuncAuthenticateAPIKey != nil {
+		mmAuthenticateAPIKey.inspectFuncAuthenticateAPIKey(ctx, token)
 	}
 
-	mm_params := AuthSvcMockCreateProfileParams{ctx, profile}
+	mm_params := AuthSvcMockAuthenticateAPIKeyParams{ctx, token}
 
 	// Record call args
-	mmCreateProfile.CreateProfileMock.mutex.Lock()
-	mmCreateProfile.CreateProfileMock.callArgs = append(mmCreateProfile.CreateProfileMock.callArgs, &mm_params)
-	mmCreateProfile.CreateProfileMock.mutex.Unlock()
+	mmAuthenticateAPIKey.AuthenticateAPIKeyMock.mutex.Lock()
+	mmAuthenticateAPIKey.AuthenticateAPIKeyMock.callArgs = append(mmAuthenticateAPIKey.AuthenticateAPIKeyMock.callArgs, &mm_params)
+	mmAuthenticateAPIKey.AuthenticateAPIKeyMock.mutex.Unlock()
 
-	for _, e := range mmCreateProfile.CreateProfileMock.expectations {
+	for _, e := range mmAuthenticateAPIKey.AuthenticateAPIKeyMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.challenge, e.results.err
+			return e.results.key, e.results.ok, e.results.err
 		}
 	}
 
-	if mmCreateProfile.CreateProfileMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmCreateProfile.CreateProfileMock.defaultExpectation.Counter, 1)
-		mm_want := mmCreateProfile.CreateProfileMock.defaultExpectation.params
-		mm_want_ptrs := mmCreateProfile.CreateProfileMock.defaultExpectation.paramPtrs
+	if mmAuthenticateAPIKey.AuthenticateAPIKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAuthenticateAPIKey.AuthenticateAPIKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmAuthenticateAPIKey.AuthenticateAPIKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmAuthenticateAPIKey.AuthenticateAPIKeyMock.defaultExpectation.paramPtrs
 
-		mm_got := AuthSvcMockCreateProfileParams{ctx, profile}
+		mm_got := AuthSvcMockAuthenticateAPIKeyParams{ctx, token}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmCreateProfile.t.Errorf("AuthSvcMock.CreateProfile got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateProfile.CreateProfileMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmAuthenticateAPIKey.t.Errorf("AuthSvcMock.AuthenticateAPIKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAuthenticateAPIKey.AuthenticateAPIKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.profile != nil && !minimock.Equal(*mm_want_ptrs.profile, mm_got.profile) {
-				mmCreateProfile.t.Errorf("AuthSvcMock.CreateProfile got unexpected parameter profile, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmCreateProfile.CreateProfileMock.defaultExpectation.expectationOrigins.originProfile, *mm_want_ptrs.profile, mm_got.profile, minimock.Diff(*mm_want_ptrs.profile, mm_got.profile))
+			if mm_want_ptrs.token != nil && !minimock.Equal(*mm_want_ptrs.token, mm_got.token) {
+				mmAuthenticateAPIKey.t.Errorf("AuthSvcMock.AuthenticateAPIKey got unexpected parameter token, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAuthenticateAPIKey.AuthenticateAPIKeyMock.defaultExpectation.expectationOrigins.originToken, *mm_want_ptrs.token, mm_got.token, minimock.Diff(*mm_want_ptrs.token, mm_got.token))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmCreateProfile.t.Errorf("AuthSvcMock.CreateProfile got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmCreateProfile.CreateProfileMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmAuthenticateAPIKey.t.Errorf("AuthSvcMock.AuthenticateAPIKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAuthenticateAPIKey.AuthenticateAPIKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmCreateProfile.CreateProfileMock.defaultExpectation.results
+		mm_results := mmAuthenticateAPIKey.AuthenticateAPIKeyMock.defaultExpectation.results
 		if mm_results == nil {
-			mmCreateProfile.t.Fatal("No results are set for the AuthSvcMock.CreateProfile")
+			mmAuthenticateAPIKey.t.Fatal("No results are set for the AuthSvcMock.AuthenticateAPIKey")
 		}
-		return (*mm_results).challenge, (*mm_results).err
+		return (*mm_results).key, (*mm_results).ok, (*mm_results).err
 	}
-	if mmCreateProfile.funcCreateProfile != nil {
-		return mmCreateProfile.funcCreateProfile(ctx, profile)
+	if mmAuthenticateAPIKey.funcAuthenticateAPIKey != nil {
+		return mmAuthenticateAPIKey.funcAuthenticateAPIKey(ctx, token)
 	}
-	mmCreateProfile.t.Fatalf("Unexpected call to AuthSvcMock.CreateProfile. %v %v", ctx, profile)
+	mmAuthenticateAPIKey.t.Fatalf("Unexpected call to AuthSvcMock.AuthenticateAPIKey. %v %v", ctx, token)
 	return
 }
 
-// CreateProfileAfterCounter returns a count of finished AuthSvcMock.CreateProfile invocations
-func (mmCreateProfile *AuthSvcMock) CreateProfileAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateProfile.afterCreateProfileCounter)
+// AuthenticateAPIKeyAfterCounter returns a count of finished AuthSvcMock.AuthenticateAPIKey invocations
+func (mmAuthenticateAPIKey *AuthSvcMock) AuthenticateAPIKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAuthenticateAPIKey.afterAuthenticateAPIKeyCounter)
 }
 
-// CreateProfileBeforeCounter returns a count of AuthSvcMock.CreateProfile invocations
-func (mmCreateProfile *AuthSvcMock) CreateProfileBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmCreateProfile.beforeCreateProfileCounter)
+// AuthenticateAPIKeyBeforeCounter returns a count of AuthSvcMock.AuthenticateAPIKey invocations
+func (mmAuthenticateAPIKey *AuthSvcMock) AuthenticateAPIKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAuthenticateAPIKey.beforeAuthenticateAPIKeyCounter)
 }
 
-// Calls returns a list of arguments used in each call to AuthSvcMock.CreateProfile.
+// Calls returns a list of arguments used in each call to AuthSvcMock.AuthenticateAPIKey.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmCreateProfile *mAuthSvcMockCreateProfile) Calls() []*AuthSvcMockCreateProfileParams {
-	mmCreateProfile.mutex.RLock()
+func (mmAuthenticateAPIKey *mAuthSvcMockAuthenticateAPIKey) Calls() []*AuthSvcMockAuthenticateAPIKeyParams {
+	mmAuthenticateAPIKey.mutex.RLock()
 
-	argCopy := make([]*AuthSvcMockCreateProfileParams, len(mmCreateProfile.callArgs))
-	copy(argCopy, mmCreateProfile.callArgs)
+	argCopy := make([]*AuthSvcMockAuthenticateAPIKeyParams, len(mmAuthenticateAPIKey.callArgs))
+	copy(argCopy, mmAuthenticateAPIKey.callArgs)
 
-	mmCreateProfile.mutex.RUnlock()
+	mmAuthenticateAPIKey.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockCreateProfileDone returns true if the count of the CreateProfile invocations corresponds
+// MinimockAuthenticateAPIKeyDone returns true if the count of the AuthenticateAPIKey invocations corresponds
 // the number of defined expectations
-func (m *AuthSvcMock) MinimockCreateProfileDone() bool {
-	if m.CreateProfileMock.optional {
+func (m *AuthSvcMock) MinimockAuthenticateAPIKeyDone() bool {
+	if m.AuthenticateAPIKeyMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.CreateProfileMock.expectations {
+	for _, e := range m.AuthenticateAPIKeyMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.CreateProfileMock.invocationsDone()
+	return m.AuthenticateAPIKeyMock.invocationsDone()
 }
 
-// MinimockCreateProfileInspect logs each unmet expectation
-func (m *AuthSvcMock) MinimockCreateProfileInspect() {
-	for _, e := range m.CreateProfileMock.expectations {
+// MinimockAuthenticateAPIKeyInspect logs each unmet expectation
+func (m *AuthSvcMock) MinimockAuthenticateAPIKeyInspect() {
+	for _, e := range m.AuthenticateAPIKeyMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AuthSvcMock.CreateProfile at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to AuthSvcMock.AuthenticateAPIKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterCreateProfileCounter := mm_atomic.LoadUint64(&m.afterCreateProfileCounter)
+	afterAuthenticateAPIKeyCounter := mm_atomic.LoadUint64(&m.afterAuthenticateAPIKeyCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.CreateProfileMock.defaultExpectation != nil && afterCreateProfileCounter < 1 {
-		if m.CreateProfileMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to AuthSvcMock.CreateProfile at\n%s", m.CreateProfileMock.defaultExpectation.returnOrigin)
+	if m.AuthenticateAPIKeyMock.defaultExpectation != nil && afterAuthenticateAPIKeyCounter < 1 {
+		if m.AuthenticateAPIKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthSvcMock.AuthenticateAPIKey at\n%s", m.AuthenticateAPIKeyMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to AuthSvcMock.CreateProfile at\n%s with params: %#v", m.CreateProfileMock.defaultExpectation.expectationOrigins.origin, *m.CreateProfileMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to AuthSvcMock.AuthenticateAPIKey at\n%s with params: %#v", m.AuthenticateAPIKeyMock.defaultExpectation.expectationOrigins.origin, *m.AuthenticateAPIKeyMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcCreateProfile != nil && afterCreateProfileCounter < 1 {
-		m.t.Errorf("Expected call to AuthSvcMock.CreateProfile at\n%s", m.funcCreateProfileOrigin)
+	if m.funcAuthenticateAPIKey != nil && afterAuthenticateAPIKeyCounter < 1 {
+		m.t.Errorf("Expected call to AuthSvcMock.AuthenticateAPIKey at\n%s", m.funcAuthenticateAPIKeyOrigin)
 	}
 
-	if !m.CreateProfileMock.invocationsDone() && afterCreateProfileCounter > 0 {
-		m.t.Errorf("Expected %d calls to AuthSvcMock.CreateProfile at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.CreateProfileMock.expectedInvocations), m.CreateProfileMock.expectedInvocationsOrigin, afterCreateProfileCounter)
+	if !m.AuthenticateAPIKeyMock.invocationsDone() && afterAuthenticateAPIKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthSvcMock.AuthenticateAPIKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AuthenticateAPIKeyMock.expectedInvocations), m.AuthenticateAPIKeyMock.expectedInvocationsOrigin, afterAuthenticateAPIKeyCounter)
 	}
 }
 
-type mAuthSvcMockGetAccountByUserName struct {
+type mAuthSvcMockChangePassword struct {
 	optional           bool
 	mock               *AuthSvcMock
-	defaultExpectation *AuthSvcMockGetAccountByUserNameExpectation
-	expectations       []*AuthSvcMockGetAccountByUserNameExpectation
+	defaultExpectation *AuthSvcMockChangePasswordExpectation
+	expectations       []*AuthSvcMockChangePasswordExpectation
 
-	callArgs []*AuthSvcMockGetAccountByUserNameParams
+	callArgs []*AuthSvcMockChangePasswordParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// AuthSvcMockGetAccountByUserNameExpectation specifies expectation struct of the AuthSvc.GetAccountByUserName
-type AuthSvcMockGetAccountByUserNameExpectation struct {
+// AuthSvcMockChangePasswordExpectation specifies expectation struct of the AuthSvc.ChangePassword
+type AuthSvcMockChangePasswordExpectation struct {
 	mock               *AuthSvcMock
-	params             *AuthSvcMockGetAccountByUserNameParams
-	paramPtrs          *AuthSvcMockGetAccountByUserNameParamPtrs
-	expectationOrigins AuthSvcMockGetAccountByUserNameExpectationOrigins
-	results            *AuthSvcMockGetAccountByUserNameResults
+	params             *AuthSvcMockChangePasswordParams
+	paramPtrs          *AuthSvcMockChangePasswordParamPtrs
+	expectationOrigins AuthSvcMockChangePasswordExpectationOrigins
+	results            *AuthSvcMockChangePasswordResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// AuthSvcMockGetAccountByUserNameParams contains parameters of the AuthSvc.GetAccountByUserName
-type AuthSvcMockGetAccountByUserNameParams struct {
-	ctx      context.Context
-	username string
+// AuthSvcMockChangePasswordParams contains parameters of the AuthSvc.ChangePassword
+type AuthSvcMockChangePasswordParams struct {
+	ctx       context.Context
+	username  string
+	sessionID string
+	change    models.PasswordChange
 }
 
-// AuthSvcMockGetAccountByUserNameParamPtrs contains pointers to parameters of the AuthSvc.GetAccountByUserName
-type AuthSvcMockGetAccountByUserNameParamPtrs struct {
-	ctx      *context.Context
-	username *string
+// AuthSvcMockChangePasswordParamPtrs contains pointers to parameters of the AuthSvc.ChangePassword
+type AuthSvcMockChangePasswordParamPtrs struct {
+	ctx       *context.Context
+	username  *string
+	sessionID *string
+	change    *models.PasswordChange
 }
 
-// AuthSvcMockGetAccountByUserNameResults contains results of the AuthSvc.GetAccountByUserName
-type AuthSvcMockGetAccountByUserNameResults struct {
-	acc models.Account
-	err error
+// AuthSvcMockChangePasswordResults contains results of the AuthSvc.ChangePassword
+type AuthSvcMockChangePasswordResults struct {
+	revoked int64
+	err     error
 }
 
-// AuthSvcMockGetAccountByUserNameOrigins contains origins of expectations of the AuthSvc.GetAccountByUserName
-type AuthSvcMockGetAccountByUserNameExpectationOrigins struct {
-	origin         string
-	originCtx      string
-	originUsername string
+// AuthSvcMockChangePasswordOrigins contains origins of expectations of the AuthSvc.ChangePassword
+type AuthSvcMockChangePasswordExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUsername  string
+	originSessionID string
+	originChange    string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -470,342 +622,5454 @@ type AuthSvcMockGetAccountByUserNameExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetAccountByUserName *mAuthSvcMockGetAccountByUserName) Optional() *mAuthSvcMockGetAccountByUserName {
-	mmGetAccountByUserName.optional = true
-	return mmGetAccountByUserName
+func (mmChangePassword *mAuthSvcMockChangePassword) Optional() *mAuthSvcMockChangePassword {
+	mmChangePassword.optional = true
+	return mmChangePassword
 }
 
-// Expect sets up expected params for AuthSvc.GetAccountByUserName
-func (mmGetAccountByUserName *mAuthSvcMockGetAccountByUserName) Expect(ctx context.Context, username string) *mAuthSvcMockGetAccountByUserName {
-	if mmGetAccountByUserName.mock.funcGetAccountByUserName != nil {
-		mmGetAccountByUserName.mock.t.Fatalf("AuthSvcMock.GetAccountByUserName mock is already set by Set")
+// Expect sets up expected params for AuthSvc.ChangePassword
+func (mmChangePassword *mAuthSvcMockChangePassword) Expect(ctx context.Context, username string, sessionID string, change models.PasswordChange) *mAuthSvcMockChangePassword {
+	if mmChangePassword.mock.funcChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("AuthSvcMock.ChangePassword mock is already set by Set")
 	}
 
-	if mmGetAccountByUserName.defaultExpectation == nil {
-		mmGetAccountByUserName.defaultExpectation = &AuthSvcMockGetAccountByUserNameExpectation{}
+	if mmChangePassword.defaultExpectation == nil {
+		mmChangePassword.defaultExpectation = &AuthSvcMockChangePasswordExpectation{}
 	}
 
-	if mmGetAccountByUserName.defaultExpectation.paramPtrs != nil {
-		mmGetAccountByUserName.mock.t.Fatalf("AuthSvcMock.GetAccountByUserName mock is already set by ExpectParams functions")
+	if mmChangePassword.defaultExpectation.paramPtrs != nil {
+		mmChangePassword.mock.t.Fatalf("AuthSvcMock.ChangePassword mock is already set by ExpectParams functions")
 	}
 
-	mmGetAccountByUserName.defaultExpectation.params = &AuthSvcMockGetAccountByUserNameParams{ctx, username}
-	mmGetAccountByUserName.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetAccountByUserName.expectations {
-		if minimock.Equal(e.params, mmGetAccountByUserName.defaultExpectation.params) {
-			mmGetAccountByUserName.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAccountByUserName.defaultExpectation.params)
+	mmChangePassword.defaultExpectation.params = &AuthSvcMockChangePasswordParams{ctx, username, sessionID, change}
+	mmChangePassword.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmChangePassword.expectations {
+		if minimock.Equal(e.params, mmChangePassword.defaultExpectation.params) {
+			mmChangePassword.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmChangePassword.defaultExpectation.params)
 		}
 	}
 
-	return mmGetAccountByUserName
+	return mmChangePassword
 }
 
-// ExpectCtxParam1 sets up expected param ctx for AuthSvc.GetAccountByUserName
-func (mmGetAccountByUserName *mAuthSvcMockGetAccountByUserName) ExpectCtxParam1(ctx context.Context) *mAuthSvcMockGetAccountByUserName {
-	if mmGetAccountByUserName.mock.funcGetAccountByUserName != nil {
-		mmGetAccountByUserName.mock.t.Fatalf("AuthSvcMock.GetAccountByUserName mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for AuthSvc.ChangePassword
+func (mmChangePassword *mAuthSvcMockChangePassword) ExpectCtxParam1(ctx context.Context) *mAuthSvcMockChangePassword {
+	if mmChangePassword.mock.funcChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("AuthSvcMock.ChangePassword mock is already set by Set")
 	}
 
-	if mmGetAccountByUserName.defaultExpectation == nil {
-		mmGetAccountByUserName.defaultExpectation = &AuthSvcMockGetAccountByUserNameExpectation{}
+	if mmChangePassword.defaultExpectation == nil {
+		mmChangePassword.defaultExpectation = &AuthSvcMockChangePasswordExpectation{}
 	}
 
-	if mmGetAccountByUserName.defaultExpectation.params != nil {
-		mmGetAccountByUserName.mock.t.Fatalf("AuthSvcMock.GetAccountByUserName mock is already set by Expect")
+	if mmChangePassword.defaultExpectation.params != nil {
+		mmChangePassword.mock.t.Fatalf("AuthSvcMock.ChangePassword mock is already set by Expect")
 	}
 
-	if mmGetAccountByUserName.defaultExpectation.paramPtrs == nil {
-		mmGetAccountByUserName.defaultExpectation.paramPtrs = &AuthSvcMockGetAccountByUserNameParamPtrs{}
+	if mmChangePassword.defaultExpectation.paramPtrs == nil {
+		mmChangePassword.defaultExpectation.paramPtrs = &AuthSvcMockChangePasswordParamPtrs{}
 	}
-	mmGetAccountByUserName.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetAccountByUserName.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmChangePassword.defaultExpectation.paramPtrs.ctx = &ctx
+	mmChangePassword.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetAccountByUserName
+	return mmChangePassword
 }
 
-// ExpectUsernameParam2 sets up expected param username for AuthSvc.GetAccountByUserName
-func (mmGetAccountByUserName *mAuthSvcMockGetAccountByUserName) ExpectUsernameParam2(username string) *mAuthSvcMockGetAccountByUserName {
-	if mmGetAccountByUserName.mock.funcGetAccountByUserName != nil {
-		mmGetAccountByUserName.mock.t.Fatalf("AuthSvcMock.GetAccountByUserName mock is already set by Set")
+// ExpectUsernameParam2 sets up expected param username for AuthSvc.ChangePassword
+func (mmChangePassword *mAuthSvcMockChangePassword) ExpectUsernameParam2(username string) *mAuthSvcMockChangePassword {
+	if mmChangePassword.mock.funcChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("AuthSvcMock.ChangePassword mock is already set by Set")
 	}
 
-	if mmGetAccountByUserName.defaultExpectation == nil {
-		mmGetAccountByUserName.defaultExpectation = &AuthSvcMockGetAccountByUserNameExpectation{}
+	if mmChangePassword.defaultExpectation == nil {
+		mmChangePassword.defaultExpectation = &AuthSvcMockChangePasswordExpectation{}
 	}
 
-	if mmGetAccountByUserName.defaultExpectation.params != nil {
-		mmGetAccountByUserName.mock.t.Fatalf("AuthSvcMock.GetAccountByUserName mock is already set by Expect")
+	if mmChangePassword.defaultExpectation.params != nil {
+		mmChangePassword.mock.t.Fatalf("AuthSvcMock.ChangePassword mock is already set by Expect")
 	}
 
-	if mmGetAccountByUserName.defaultExpectation.paramPtrs == nil {
-		mmGetAccountByUserName.defaultExpectation.paramPtrs = &AuthSvcMockGetAccountByUserNameParamPtrs{}
+	if mmChangePassword.defaultExpectation.paramPtrs == nil {
+		mmChangePassword.defaultExpectation.paramPtrs = &AuthSvcMockChangePasswordParamPtrs{}
 	}
-	mmGetAccountByUserName.defaultExpectation.paramPtrs.username = &username
-	mmGetAccountByUserName.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+	mmChangePassword.defaultExpectation.paramPtrs.username = &username
+	mmChangePassword.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
 
-	return mmGetAccountByUserName
+	return mmChangePassword
 }
 
-// Inspect accepts an inspector function that has same arguments as the AuthSvc.GetAccountByUserName
-func (mmGetAccountByUserName *mAuthSvcMockGetAccountByUserName) Inspect(f func(ctx context.Context, username string)) *mAuthSvcMockGetAccountByUserName {
-	if mmGetAccountByUserName.mock.inspectFuncGetAccountByUserName != nil {
-		mmGetAccountByUserName.mock.t.Fatalf("Inspect function is already set for AuthSvcMock.GetAccountByUserName")
+// ExpectSessionIDParam3 sets up expected param sessionID for AuthSvc.ChangePassword
+func (mmChangePassword *mAuthSvcMockChangePassword) ExpectSessionIDParam3(sessionID string) *mAuthSvcMockChangePassword {
+	if mmChangePassword.mock.funcChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("AuthSvcMock.ChangePassword mock is already set by Set")
 	}
 
-	mmGetAccountByUserName.mock.inspectFuncGetAccountByUserName = f
+	if mmChangePassword.defaultExpectation == nil {
+		mmChangePassword.defaultExpectation = &AuthSvcMockChangePasswordExpectation{}
+	}
 
-	return mmGetAccountByUserName
+	if mmChangePassword.defaultExpectation.params != nil {
+		mmChangePassword.mock.t.Fatalf("AuthSvcMock.ChangePassword mock is already set by Expect")
+	}
+
+	if mmChangePassword.defaultExpectation.paramPtrs == nil {
+		mmChangePassword.defaultExpectation.paramPtrs = &AuthSvcMockChangePasswordParamPtrs{}
+	}
+	mmChangePassword.defaultExpectation.paramPtrs.sessionID = &sessionID
+	mmChangePassword.defaultExpectation.expectationOrigins.originSessionID = minimock.CallerInfo(1)
+
+	return mmChangePassword
 }
 
-// Return sets up results that will be returned by AuthSvc.GetAccountByUserName
-func (mmGetAccountByUserName *mAuthSvcMockGetAccountByUserName) Return(acc models.Account, err error) *AuthSvcMock {
-	if mmGetAccountByUserName.mock.funcGetAccountByUserName != nil {
-		mmGetAccountByUserName.mock.t.Fatalf("AuthSvcMock.GetAccountByUserName mock is already set by Set")
+// ExpectChangeParam4 sets up expected param change for AuthSvc.ChangePassword
+func (mmChangePassword *mAuthSvcMockChangePassword) ExpectChangeParam4(change models.PasswordChange) *mAuthSvcMockChangePassword {
+	if mmChangePassword.mock.funcChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("AuthSvcMock.ChangePassword mock is already set by Set")
 	}
 
-	if mmGetAccountByUserName.defaultExpectation == nil {
-		mmGetAccountByUserName.defaultExpectation = &AuthSvcMockGetAccountByUserNameExpectation{mock: mmGetAccountByUserName.mock}
+	if mmChangePassword.defaultExpectation == nil {
+		mmChangePassword.defaultExpectation = &AuthSvcMockChangePasswordExpectation{}
 	}
-	mmGetAccountByUserName.defaultExpectation.results = &AuthSvcMockGetAccountByUserNameResults{acc, err}
-	mmGetAccountByUserName.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetAccountByUserName.mock
+
+	if mmChangePassword.defaultExpectation.params != nil {
+		mmChangePassword.mock.t.Fatalf("AuthSvcMock.ChangePassword mock is already set by Expect")
+	}
+
+	if mmChangePassword.defaultExpectation.paramPtrs == nil {
+		mmChangePassword.defaultExpectation.paramPtrs = &AuthSvcMockChangePasswordParamPtrs{}
+	}
+	mmChangePassword.defaultExpectation.paramPtrs.change = &change
+	mmChangePassword.defaultExpectation.expectationOrigins.originChange = minimock.CallerInfo(1)
+
+	return mmChangePassword
 }
 
-// Set uses given function f to mock the AuthSvc.GetAccountByUserName method
-func (mmGetAccountByUserName *mAuthSvcMockGetAccountByUserName) Set(f func(ctx context.Context, username string) (acc models.Account, err error)) *AuthSvcMock {
-	if mmGetAccountByUserName.defaultExpectation != nil {
-		mmGetAccountByUserName.mock.t.Fatalf("Default expectation is already set for the AuthSvc.GetAccountByUserName method")
+// Inspect accepts an inspector function that has same arguments as the AuthSvc.ChangePassword
+func (mmChangePassword *mAuthSvcMockChangePassword) Inspect(f func(ctx context.Context, username string, sessionID string, change models.PasswordChange)) *mAuthSvcMockChangePassword {
+	if mmChangePassword.mock.inspectFuncChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("Inspect function is already set for AuthSvcMock.ChangePassword")
 	}
 
-	if len(mmGetAccountByUserName.expectations) > 0 {
-		mmGetAccountByUserName.mock.t.Fatalf("Some expectations are already set for the AuthSvc.GetAccountByUserName method")
+	mmChangePassword.mock.inspectFuncChangePassword = f
+
+	return mmChangePassword
+}
+
+// Return sets up results that will be returned by AuthSvc.ChangePassword
+func (mmChangePassword *mAuthSvcMockChangePassword) Return(revoked int64, err error) *AuthSvcMock {
+	if mmChangePassword.mock.funcChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("AuthSvcMock.ChangePassword mock is already set by Set")
 	}
 
-	mmGetAccountByUserName.mock.funcGetAccountByUserName = f
-	mmGetAccountByUserName.mock.funcGetAccountByUserNameOrigin = minimock.CallerInfo(1)
-	return mmGetAccountByUserName.mock
+	if mmChangePassword.defaultExpectation == nil {
+		mmChangePassword.defaultExpectation = &AuthSvcMockChangePasswordExpectation{mock: mmChangePassword.mock}
+	}
+	mmChangePassword.defaultExpectation.results = &AuthSvcMockChangePasswordResults{revoked, err}
+	mmChangePassword.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmChangePassword.mock
 }
 
-// When sets expectation for the AuthSvc.GetAccountByUserName which will trigger the result defined by the following
+// Set uses given function f to mock the AuthSvc.ChangePassword method
+func (mmChangePassword *mAuthSvcMockChangePassword) Set(f func(ctx context.Context, username string, sessionID string, change models.PasswordChange) (revoked int64, err error)) *AuthSvcMock {
+	if mmChangePassword.defaultExpectation != nil {
+		mmChangePassword.mock.t.Fatalf("Default expectation is already set for the AuthSvc.ChangePassword method")
+	}
+
+	if len(mmChangePassword.expectations) > 0 {
+		mmChangePassword.mock.t.Fatalf("Some expectations are already set for the AuthSvc.ChangePassword method")
+	}
+
+	mmChangePassword.mock.funcChangePassword = f
+	mmChangePassword.mock.funcChangePasswordOrigin = minimock.CallerInfo(1)
+	return mmChangePassword.mock
+}
+
+// When sets expectation for the AuthSvc.ChangePassword which will trigger the result defined by the following
 // Then helper
-func (mmGetAccountByUserName *mAuthSvcMockGetAccountByUserName) When(ctx context.Context, username string) *AuthSvcMockGetAccountByUserNameExpectation {
-	if mmGetAccountByUserName.mock.funcGetAccountByUserName != nil {
-		mmGetAccountByUserName.mock.t.Fatalf("AuthSvcMock.GetAccountByUserName mock is already set by Set")
+func (mmChangePassword *mAuthSvcMockChangePassword) When(ctx context.Context, username string, sessionID string, change models.PasswordChange) *AuthSvcMockChangePasswordExpectation {
+	if mmChangePassword.mock.funcChangePassword != nil {
+		mmChangePassword.mock.t.Fatalf("AuthSvcMock.ChangePassword mock is already set by Set")
 	}
 
-	expectation := &AuthSvcMockGetAccountByUserNameExpectation{
-		mock:               mmGetAccountByUserName.mock,
-		params:             &AuthSvcMockGetAccountByUserNameParams{ctx, username},
-		expectationOrigins: AuthSvcMockGetAccountByUserNameExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &AuthSvcMockChangePasswordExpectation{
+		mock:               mmChangePassword.mock,
+		params:             &AuthSvcMockChangePasswordParams{ctx, username, sessionID, change},
+		expectationOrigins: AuthSvcMockChangePasswordExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetAccountByUserName.expectations = append(mmGetAccountByUserName.expectations, expectation)
+	mmChangePassword.expectations = append(mmChangePassword.expectations, expectation)
 	return expectation
 }
 
-// Then sets up AuthSvc.GetAccountByUserName return parameters for the expectation previously defined by the When method
-func (e *AuthSvcMockGetAccountByUserNameExpectation) Then(acc models.Account, err error) *AuthSvcMock {
-	e.results = &AuthSvcMockGetAccountByUserNameResults{acc, err}
+// Then sets up AuthSvc.ChangePassword return parameters for the expectation previously defined by the When method
+func (e *AuthSvcMockChangePasswordExpectation) Then(revoked int64, err error) *AuthSvcMock {
+	e.results = &AuthSvcMockChangePasswordResults{revoked, err}
 	return e.mock
 }
 
-// Times sets number of times AuthSvc.GetAccountByUserName should be invoked
-func (mmGetAccountByUserName *mAuthSvcMockGetAccountByUserName) Times(n uint64) *mAuthSvcMockGetAccountByUserName {
+// Times sets number of times AuthSvc.ChangePassword should be invoked
+func (mmChangePassword *mAuthSvcMockChangePassword) Times(n uint64) *mAuthSvcMockChangePassword {
 	if n == 0 {
-		mmGetAccountByUserName.mock.t.Fatalf("Times of AuthSvcMock.GetAccountByUserName mock can not be zero")
+		mmChangePassword.mock.t.Fatalf("Times of AuthSvcMock.ChangePassword mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetAccountByUserName.expectedInvocations, n)
-	mmGetAccountByUserName.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetAccountByUserName
+	mm_atomic.StoreUint64(&mmChangePassword.expectedInvocations, n)
+	mmChangePassword.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmChangePassword
 }
 
-func (mmGetAccountByUserName *mAuthSvcMockGetAccountByUserName) invocationsDone() bool {
-	if len(mmGetAccountByUserName.expectations) == 0 && mmGetAccountByUserName.defaultExpectation == nil && mmGetAccountByUserName.mock.funcGetAccountByUserName == nil {
+func (mmChangePassword *mAuthSvcMockChangePassword) invocationsDone() bool {
+	if len(mmChangePassword.expectations) == 0 && mmChangePassword.defaultExpectation == nil && mmChangePassword.mock.funcChangePassword == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetAccountByUserName.mock.afterGetAccountByUserNameCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetAccountByUserName.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmChangePassword.mock.afterChangePasswordCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmChangePassword.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetAccountByUserName implements mm_handler.AuthSvc
-func (mmGetAccountByUserName *AuthSvcMock) GetAccountByUserName(ctx context.Context, username string) (acc models.Account, err error) {
-	mm_atomic.AddUint64(&mmGetAccountByUserName.beforeGetAccountByUserNameCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetAccountByUserName.afterGetAccountByUserNameCounter, 1)
+// ChangePassword implements mm_handler.AuthSvc
+func (mmChangePassword *AuthSvcMock) ChangePassword(ctx context.Context, username string, sessionID string, change models.PasswordChange) (revoked int64, err error) {
+	mm_atomic.AddUint64(&mmChangePassword.beforeChangePasswordCounter, 1)
+	defer mm_atomic.AddUint64(&mmChangePassword.afterChangePasswordCounter, 1)
 
-	mmGetAccountByUserName.t.Helper()
+	mmChangePassword.t.Helper()
 
-	if mmGetAccountByUserName.inspectFuncGetAccountByUserName != nil {
-		mmGetAccountByUserName.inspectFuncGetAccountByUserName(ctx, username)
+	if mmChangePassword.inspectFuncChangePassword != nil {
+		mmChangePassword.inspectFuncChangePassword(ctx, username, sessionID, change)
 	}
 
-	mm_params := AuthSvcMockGetAccountByUserNameParams{ctx, username}
+	mm_params := AuthSvcMockChangePasswordParams{ctx, username, sessionID, change}
 
 	// Record call args
-	mmGetAccountByUserName.GetAccountByUserNameMock.mutex.Lock()
-	mmGetAccountByUserName.GetAccountByUserNameMock.callArgs = append(mmGetAccountByUserName.GetAccountByUserNameMock.callArgs, &mm_params)
-	mmGetAccountByUserName.GetAccountByUserNameMock.mutex.Unlock()
+	mmChangePassword.ChangePasswordMock.mutex.Lock()
+	mmChangePassword.ChangePasswordMock.callArgs = append(mmChangePassword.ChangePasswordMock.callArgs, &mm_params)
+	mmChangePassword.ChangePasswordMock.mutex.Unlock()
 
-	for _, e := range mmGetAccountByUserName.GetAccountByUserNameMock.expectations {
+	for _, e := range mmChangePassword.ChangePasswordMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.acc, e.results.err
+			return e.results.revoked, e.results.err
 		}
 	}
 
-	if mmGetAccountByUserName.GetAccountByUserNameMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetAccountByUserName.GetAccountByUserNameMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetAccountByUserName.GetAccountByUserNameMock.defaultExpectation.params
-		mm_want_ptrs := mmGetAccountByUserName.GetAccountByUserNameMock.defaultExpectation.paramPtrs
+	if mmChangePassword.ChangePasswordMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmChangePassword.ChangePasswordMock.defaultExpectation.Counter, 1)
+		mm_want := mmChangePassword.ChangePasswordMock.defaultExpectation.params
+		mm_want_ptrs := mmChangePassword.ChangePasswordMock.defaultExpectation.paramPtrs
 
-		mm_got := AuthSvcMockGetAccountByUserNameParams{ctx, username}
+		mm_got := AuthSvcMockChangePasswordParams{ctx, username, sessionID, change}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetAccountByUserName.t.Errorf("AuthSvcMock.GetAccountByUserName got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetAccountByUserName.GetAccountByUserNameMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmChangePassword.t.Errorf("AuthSvcMock.ChangePassword got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmChangePassword.ChangePasswordMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
-				mmGetAccountByUserName.t.Errorf("AuthSvcMock.GetAccountByUserName got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetAccountByUserName.GetAccountByUserNameMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+				mmChangePassword.t.Errorf("AuthSvcMock.ChangePassword got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmChangePassword.ChangePasswordMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.sessionID != nil && !minimock.Equal(*mm_want_ptrs.sessionID, mm_got.sessionID) {
+				mmChangePassword.t.Errorf("AuthSvcMock.ChangePassword got unexpected parameter sessionID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmChangePassword.ChangePasswordMock.defaultExpectation.expectationOrigins.originSessionID, *mm_want_ptrs.sessionID, mm_got.sessionID, minimock.Diff(*mm_want_ptrs.sessionID, mm_got.sessionID))
+			}
+
+			if mm_want_ptrs.change != nil && !minimock.Equal(*mm_want_ptrs.change, mm_got.change) {
+				mmChangePassword.t.Errorf("AuthSvcMock.ChangePassword got unexpected parameter change, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmChangePassword.ChangePasswordMock.defaultExpectation.expectationOrigins.originChange, *mm_want_ptrs.change, mm_got.change, minimock.Diff(*mm_want_ptrs.change, mm_got.change))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetAccountByUserName.t.Errorf("AuthSvcMock.GetAccountByUserName got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetAccountByUserName.GetAccountByUserNameMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmChangePassword.t.Errorf("AuthSvcMock.ChangePassword got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmChangePassword.ChangePasswordMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetAccountByUserName.GetAccountByUserNameMock.defaultExpectation.results
+		mm_results := mmChangePassword.ChangePasswordMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetAccountByUserName.t.Fatal("No results are set for the AuthSvcMock.GetAccountByUserName")
+			mmChangePassword.t.Fatal("No results are set for the AuthSvcMock.ChangePassword")
 		}
-		return (*mm_results).acc, (*mm_results).err
+		return (*mm_results).revoked, (*mm_results).err
 	}
-	if mmGetAccountByUserName.funcGetAccountByUserName != nil {
-		return mmGetAccountByUserName.funcGetAccountByUserName(ctx, username)
+	if mmChangePassword.funcChangePassword != nil {
+		return mmChangePassword.funcChangePassword(ctx, username, sessionID, change)
 	}
-	mmGetAccountByUserName.t.Fatalf("Unexpected call to AuthSvcMock.GetAccountByUserName. %v %v", ctx, username)
+	mmChangePassword.t.Fatalf("Unexpected call to AuthSvcMock.ChangePassword. %v %v %v %v", ctx, username, sessionID, change)
 	return
 }
 
-// GetAccountByUserNameAfterCounter returns a count of finished AuthSvcMock.GetAccountByUserName invocations
-func (mmGetAccountByUserName *AuthSvcMock) GetAccountByUserNameAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetAccountByUserName.afterGetAccountByUserNameCounter)
+// ChangePasswordAfterCounter returns a count of finished AuthSvcMock.ChangePassword invocations
+func (mmChangePassword *AuthSvcMock) ChangePasswordAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmChangePassword.afterChangePasswordCounter)
 }
 
-// GetAccountByUserNameBeforeCounter returns a count of AuthSvcMock.GetAccountByUserName invocations
-func (mmGetAccountByUserName *AuthSvcMock) GetAccountByUserNameBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetAccountByUserName.beforeGetAccountByUserNameCounter)
+// ChangePasswordBeforeCounter returns a count of AuthSvcMock.ChangePassword invocations
+func (mmChangePassword *AuthSvcMock) ChangePasswordBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmChangePassword.beforeChangePasswordCounter)
 }
 
-// Calls returns a list of arguments used in each call to AuthSvcMock.GetAccountByUserName.
+// Calls returns a list of arguments used in each call to AuthSvcMock.ChangePassword.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetAccountByUserName *mAuthSvcMockGetAccountByUserName) Calls() []*AuthSvcMockGetAccountByUserNameParams {
-	mmGetAccountByUserName.mutex.RLock()
+func (mmChangePassword *mAuthSvcMockChangePassword) Calls() []*AuthSvcMockChangePasswordParams {
+	mmChangePassword.mutex.RLock()
 
-	argCopy := make([]*AuthSvcMockGetAccountByUserNameParams, len(mmGetAccountByUserName.callArgs))
-	copy(argCopy, mmGetAccountByUserName.callArgs)
+	argCopy := make([]*AuthSvcMockChangePasswordParams, len(mmChangePassword.callArgs))
+	copy(argCopy, mmChangePassword.callArgs)
 
-	mmGetAccountByUserName.mutex.RUnlock()
+	mmChangePassword.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetAccountByUserNameDone returns true if the count of the GetAccountByUserName invocations corresponds
+// MinimockChangePasswordDone returns true if the count of the ChangePassword invocations corresponds
 // the number of defined expectations
-func (m *AuthSvcMock) MinimockGetAccountByUserNameDone() bool {
-	if m.GetAccountByUserNameMock.optional {
+func (m *AuthSvcMock) MinimockChangePasswordDone() bool {
+	if m.ChangePasswordMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetAccountByUserNameMock.expectations {
+	for _, e := range m.ChangePasswordMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetAccountByUserNameMock.invocationsDone()
+	return m.ChangePasswordMock.invocationsDone()
 }
 
-// MinimockGetAccountByUserNameInspect logs each unmet expectation
-func (m *AuthSvcMock) MinimockGetAccountByUserNameInspect() {
-	for _, e := range m.GetAccountByUserNameMock.expectations {
+// MinimockChangePasswordInspect logs each unmet expectation
+func (m *AuthSvcMock) MinimockChangePasswordInspect() {
+	for _, e := range m.ChangePasswordMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AuthSvcMock.GetAccountByUserName at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to AuthSvcMock.ChangePassword at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetAccountByUserNameCounter := mm_atomic.LoadUint64(&m.afterGetAccountByUserNameCounter)
+	afterChangePasswordCounter := mm_atomic.LoadUint64(&m.afterChangePasswordCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetAccountByUserNameMock.defaultExpectation != nil && afterGetAccountByUserNameCounter < 1 {
-		if m.GetAccountByUserNameMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to AuthSvcMock.GetAccountByUserName at\n%s", m.GetAccountByUserNameMock.defaultExpectation.returnOrigin)
+	if m.ChangePasswordMock.defaultExpectation != nil && afterChangePasswordCounter < 1 {
+		if m.ChangePasswordMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthSvcMock.ChangePassword at\n%s", m.ChangePasswordMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to AuthSvcMock.GetAccountByUserName at\n%s with params: %#v", m.GetAccountByUserNameMock.defaultExpectation.expectationOrigins.origin, *m.GetAccountByUserNameMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to AuthSvcMock.ChangePassword at\n%s with params: %#v", m.ChangePasswordMock.defaultExpectation.expectationOrigins.origin, *m.ChangePasswordMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetAccountByUserName != nil && afterGetAccountByUserNameCounter < 1 {
-		m.t.Errorf("Expected call to AuthSvcMock.GetAccountByUserName at\n%s", m.funcGetAccountByUserNameOrigin)
+	if m.funcChangePassword != nil && afterChangePasswordCounter < 1 {
+		m.t.Errorf("Expected call to AuthSvcMock.ChangePassword at\n%s", m.funcChangePasswordOrigin)
+	}
+
+	if !m.ChangePasswordMock.invocationsDone() && afterChangePasswordCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthSvcMock.ChangePassword at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ChangePasswordMock.expectedInvocations), m.ChangePasswordMock.expectedInvocationsOrigin, afterChangePasswordCounter)
+	}
+}
+
+type mAuthSvcMockCreateAPIKey struct {
+	optional           bool
+	mock               *AuthSvcMock
+	defaultExpectation *AuthSvcMockCreateAPIKeyExpectation
+	expectations       []*AuthSvcMockCreateAPIKeyExpectation
+
+	callArgs []*AuthSvcMockCreateAPIKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthSvcMockCreateAPIKeyExpectation specifies expectation struct of the AuthSvc.CreateAPIKey
+type AuthSvcMockCreateAPIKeyExpectation struct {
+	mock               *AuthSvcMock
+	params             *AuthSvcMockCreateAPIKeyParams
+	paramPtrs          *AuthSvcMockCreateAPIKeyParamPtrs
+	expectationOrigins AuthSvcMockCreateAPIKeyExpectationOrigins
+	results            *AuthSvcMockCreateAPIKeyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthSvcMockCreateAPIKeyParams contains parameters of the AuthSvc.CreateAPIKey
+type AuthSvcMockCreateAPIKeyParams struct {
+	ctx      context.Context
+	username string
+	k        apikey.Key
+}
+
+// AuthSvcMockCreateAPIKeyParamPtrs contains pointers to parameters of the AuthSvc.CreateAPIKey
+type AuthSvcMockCreateAPIKeyParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	k        *apikey.Key
+}
+
+// AuthSvcMockCreateAPIKeyResults contains results of the AuthSvc.CreateAPIKey
+type AuthSvcMockCreateAPIKeyResults struct {
+	key   apikey.Key
+	token string
+	err   error
+}
+
+// AuthSvcMockCreateAPIKeyOrigins contains origins of expectations of the AuthSvc.CreateAPIKey
+type AuthSvcMockCreateAPIKeyExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originK        string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateAPIKey *mAuthSvcMockCreateAPIKey) Optional() *mAuthSvcMockCreateAPIKey {
+	mmCreateAPIKey.optional = true
+	return mmCreateAPIKey
+}
+
+// Expect sets up expected params for AuthSvc.CreateAPIKey
+func (mmCreateAPIKey *mAuthSvcMockCreateAPIKey) Expect(ctx context.Context, username string, k apikey.Key) *mAuthSvcMockCreateAPIKey {
+	if mmCreateAPIKey.mock.funcCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("AuthSvcMock.CreateAPIKey mock is already set by Set")
+	}
+
+	if mmCreateAPIKey.defaultExpectation == nil {
+		mmCreateAPIKey.defaultExpectation = &AuthSvcMockCreateAPIKeyExpectation{}
+	}
+
+	if mmCreateAPIKey.defaultExpectation.paramPtrs != nil {
+		mmCreateAPIKey.mock.t.Fatalf("AuthSvcMock.CreateAPIKey mock is already set by ExpectParams functions")
+	}
+
+	mmCreateAPIKey.defaultExpectation.params = &AuthSvcMockCreateAPIKeyParams{ctx, username, k}
+	mmCreateAPIKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateAPIKey.expectations {
+		if minimock.Equal(e.params, mmCreateAPIKey.defaultExpectation.params) {
+			mmCreateAPIKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateAPIKey.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateAPIKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthSvc.CreateAPIKey
+func (mmCreateAPIKey *mAuthSvcMockCreateAPIKey) ExpectCtxParam1(ctx context.Context) *mAuthSvcMockCreateAPIKey {
+	if mmCreateAPIKey.mock.funcCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("AuthSvcMock.CreateAPIKey mock is already set by Set")
+	}
+
+	if mmCreateAPIKey.defaultExpectation == nil {
+		mmCreateAPIKey.defaultExpectation = &AuthSvcMockCreateAPIKeyExpectation{}
+	}
+
+	if mmCreateAPIKey.defaultExpectation.params != nil {
+		mmCreateAPIKey.mock.t.Fatalf("AuthSvcMock.CreateAPIKey mock is already set by Expect")
+	}
+
+	if mmCreateAPIKey.defaultExpectation.paramPtrs == nil {
+		mmCreateAPIKey.defaultExpectation.paramPtrs = &AuthSvcMockCreateAPIKeyParamPtrs{}
+	}
+	mmCreateAPIKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateAPIKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateAPIKey
+}
+
+// ExpectUsernameParam2 sets up expected param username for AuthSvc.CreateAPIKey
+func (mmCreateAPIKey *mAuthSvcMockCreateAPIKey) ExpectUsernameParam2(username string) *mAuthSvcMockCreateAPIKey {
+	if mmCreateAPIKey.mock.funcCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("AuthSvcMock.CreateAPIKey mock is already set by Set")
+	}
+
+	if mmCreateAPIKey.defaultExpectation == nil {
+		mmCreateAPIKey.defaultExpectation = &AuthSvcMockCreateAPIKeyExpectation{}
+	}
+
+	if mmCreateAPIKey.defaultExpectation.params != nil {
+		mmCreateAPIKey.mock.t.Fatalf("AuthSvcMock.CreateAPIKey mock is already set by Expect")
+	}
+
+	if mmCreateAPIKey.defaultExpectation.paramPtrs == nil {
+		mmCreateAPIKey.defaultExpectation.paramPtrs = &AuthSvcMockCreateAPIKeyParamPtrs{}
+	}
+	mmCreateAPIKey.defaultExpectation.paramPtrs.username = &username
+	mmCreateAPIKey.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmCreateAPIKey
+}
+
+// ExpectKParam3 sets up expected param k for AuthSvc.CreateAPIKey
+func (mmCreateAPIKey *mAuthSvcMockCreateAPIKey) ExpectKParam3(k apikey.Key) *mAuthSvcMockCreateAPIKey {
+	if mmCreateAPIKey.mock.funcCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("AuthSvcMock.CreateAPIKey mock is already set by Set")
+	}
+
+	if mmCreateAPIKey.defaultExpectation == nil {
+		mmCreateAPIKey.defaultExpectation = &AuthSvcMockCreateAPIKeyExpectation{}
+	}
+
+	if mmCreateAPIKey.defaultExpectation.params != nil {
+		mmCreateAPIKey.mock.t.Fatalf("AuthSvcMock.CreateAPIKey mock is already set by Expect")
+	}
+
+	if mmCreateAPIKey.defaultExpectation.paramPtrs == nil {
+		mmCreateAPIKey.defaultExpectation.paramPtrs = &AuthSvcMockCreateAPIKeyParamPtrs{}
+	}
+	mmCreateAPIKey.defaultExpectation.paramPtrs.k = &k
+	mmCreateAPIKey.defaultExpectation.expectationOrigins.originK = minimock.CallerInfo(1)
+
+	return mmCreateAPIKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthSvc.CreateAPIKey
+func (mmCreateAPIKey *mAuthSvcMockCreateAPIKey) Inspect(f func(ctx context.Context, username string, k apikey.Key)) *mAuthSvcMockCreateAPIKey {
+	if mmCreateAPIKey.mock.inspectFuncCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("Inspect function is already set for AuthSvcMock.CreateAPIKey")
+	}
+
+	mmCreateAPIKey.mock.inspectFuncCreateAPIKey = f
+
+	return mmCreateAPIKey
+}
+
+// Return sets up results that will be returned by AuthSvc.CreateAPIKey
+func (mmCreateAPIKey *mAuthSvcMockCreateAPIKey) Return(key apikey.Key, token string, err error) *AuthSvcMock {
+	if mmCreateAPIKey.mock.funcCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("AuthSvcMock.CreateAPIKey mock is already set by Set")
+	}
+
+	if mmCreateAPIKey.defaultExpectation == nil {
+		mmCreateAPIKey.defaultExpectation = &AuthSvcMockCreateAPIKeyExpectation{mock: mmCreateAPIKey.mock}
+	}
+	mmCreateAPIKey.defaultExpectation.results = &AuthSvcMockCreateAPIKeyResults{key, token, err}
+	mmCreateAPIKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateAPIKey.mock
+}
+
+// Set uses given function f to mock the AuthSvc.CreateAPIKey method
+func (mmCreateAPIKey *mAuthSvcMockCreateAPIKey) Set(f func(ctx context.Context, username string, k apikey.Key) (key apikey.Key, token string, err error)) *AuthSvcMock {
+	if mmCreateAPIKey.defaultExpectation != nil {
+		mmCreateAPIKey.mock.t.Fatalf("Default expectation is already set for the AuthSvc.CreateAPIKey method")
+	}
+
+	if len(mmCreateAPIKey.expectations) > 0 {
+		mmCreateAPIKey.mock.t.Fatalf("Some expectations are already set for the AuthSvc.CreateAPIKey method")
+	}
+
+	mmCreateAPIKey.mock.funcCreateAPIKey = f
+	mmCreateAPIKey.mock.funcCreateAPIKeyOrigin = minimock.CallerInfo(1)
+	return mmCreateAPIKey.mock
+}
+
+// When sets expectation for the AuthSvc.CreateAPIKey which will trigger the result defined by the following
+// Then helper
+func (mmCreateAPIKey *mAuthSvcMockCreateAPIKey) When(ctx context.Context, username string, k apikey.Key) *AuthSvcMockCreateAPIKeyExpectation {
+	if mmCreateAPIKey.mock.funcCreateAPIKey != nil {
+		mmCreateAPIKey.mock.t.Fatalf("AuthSvcMock.CreateAPIKey mock is already set by Set")
+	}
+
+	expectation := &AuthSvcMockCreateAPIKeyExpectation{
+		mock:               mmCreateAPIKey.mock,
+		params:             &AuthSvcMockCreateAPIKeyParams{ctx, username, k},
+		expectationOrigins: AuthSvcMockCreateAPIKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateAPIKey.expectations = append(mmCreateAPIKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthSvc.CreateAPIKey return parameters for the expectation previously defined by the When method
+func (e *AuthSvcMockCreateAPIKeyExpectation) Then(key apikey.Key, token string, err error) *AuthSvcMock {
+	e.results = &AuthSvcMockCreateAPIKeyResults{key, token, err}
+	return e.mock
+}
+
+// Times sets number of times AuthSvc.CreateAPIKey should be invoked
+func (mmCreateAPIKey *mAuthSvcMockCreateAPIKey) Times(n uint64) *mAuthSvcMockCreateAPIKey {
+	if n == 0 {
+		mmCreateAPIKey.mock.t.Fatalf("Times of AuthSvcMock.CreateAPIKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateAPIKey.expectedInvocations, n)
+	mmCreateAPIKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateAPIKey
+}
+
+func (mmCreateAPIKey *mAuthSvcMockCreateAPIKey) invocationsDone() bool {
+	if len(mmCreateAPIKey.expectations) == 0 && mmCreateAPIKey.defaultExpectation == nil && mmCreateAPIKey.mock.funcCreateAPIKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateAPIKey.mock.afterCreateAPIKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateAPIKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateAPIKey implements mm_handler.AuthSvc
+func (mmCreateAPIKey *AuthSvcMock) CreateAPIKey(ctx context.Context, username string, k apikey.Key) (key apikey.Key, token string, err error) {
+	mm_atomic.AddUint64(&mmCreateAPIKey.beforeCreateAPIKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateAPIKey.afterCreateAPIKeyCounter, 1)
+
+	mmCreateAPIKey.t.Helper()
+
+	if mmCreateAPIKey.inspectFuncCreateAPIKey != nil {
+		mmCreateAPIKey.inspectFuncCreateAPIKey(ctx, username, k)
+	}
+
+	mm_params := AuthSvcMockCreateAPIKeyParams{ctx, username, k}
+
+	// Record call args
+	mmCreateAPIKey.CreateAPIKeyMock.mutex.Lock()
+	mmCreateAPIKey.CreateAPIKeyMock.callArgs = append(mmCreateAPIKey.CreateAPIKeyMock.callArgs, &mm_params)
+	mmCreateAPIKey.CreateAPIKeyMock.mutex.Unlock()
+
+	for _, e := range mmCreateAPIKey.CreateAPIKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.key, e.results.token, e.results.err
+		}
+	}
+
+	if mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthSvcMockCreateAPIKeyParams{ctx, username, k}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateAPIKey.t.Errorf("AuthSvcMock.CreateAPIKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmCreateAPIKey.t.Errorf("AuthSvcMock.CreateAPIKey got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.k != nil && !minimock.Equal(*mm_want_ptrs.k, mm_got.k) {
+				mmCreateAPIKey.t.Errorf("AuthSvcMock.CreateAPIKey got unexpected parameter k, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation.expectationOrigins.originK, *mm_want_ptrs.k, mm_got.k, minimock.Diff(*mm_want_ptrs.k, mm_got.k))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateAPIKey.t.Errorf("AuthSvcMock.CreateAPIKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateAPIKey.CreateAPIKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateAPIKey.t.Fatal("No results are set for the AuthSvcMock.CreateAPIKey")
+		}
+		return (*mm_results).key, (*mm_results).token, (*mm_results).err
+	}
+	if mmCreateAPIKey.funcCreateAPIKey != nil {
+		return mmCreateAPIKey.funcCreateAPIKey(ctx, username, k)
+	}
+	mmCreateAPIKey.t.Fatalf("Unexpected call to AuthSvcMock.CreateAPIKey. %v %v %v", ctx, username, k)
+	return
+}
+
+// CreateAPIKeyAfterCounter returns a count of finished AuthSvcMock.CreateAPIKey invocations
+func (mmCreateAPIKey *AuthSvcMock) CreateAPIKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAPIKey.afterCreateAPIKeyCounter)
+}
+
+// CreateAPIKeyBeforeCounter returns a count of AuthSvcMock.CreateAPIKey invocations
+func (mmCreateAPIKey *AuthSvcMock) CreateAPIKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateAPIKey.beforeCreateAPIKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthSvcMock.CreateAPIKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateAPIKey *mAuthSvcMockCreateAPIKey) Calls() []*AuthSvcMockCreateAPIKeyParams {
+	mmCreateAPIKey.mutex.RLock()
+
+	argCopy := make([]*AuthSvcMockCreateAPIKeyParams, len(mmCreateAPIKey.callArgs))
+	copy(argCopy, mmCreateAPIKey.callArgs)
+
+	mmCreateAPIKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateAPIKeyDone returns true if the count of the CreateAPIKey invocations corresponds
+// the number of defined expectations
+func (m *AuthSvcMock) MinimockCreateAPIKeyDone() bool {
+	if m.CreateAPIKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateAPIKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateAPIKeyMock.invocationsDone()
+}
+
+// MinimockCreateAPIKeyInspect logs each unmet expectation
+func (m *AuthSvcMock) MinimockCreateAPIKeyInspect() {
+	for _, e := range m.CreateAPIKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthSvcMock.CreateAPIKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateAPIKeyCounter := mm_atomic.LoadUint64(&m.afterCreateAPIKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateAPIKeyMock.defaultExpectation != nil && afterCreateAPIKeyCounter < 1 {
+		if m.CreateAPIKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthSvcMock.CreateAPIKey at\n%s", m.CreateAPIKeyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthSvcMock.CreateAPIKey at\n%s with params: %#v", m.CreateAPIKeyMock.defaultExpectation.expectationOrigins.origin, *m.CreateAPIKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateAPIKey != nil && afterCreateAPIKeyCounter < 1 {
+		m.t.Errorf("Expected call to AuthSvcMock.CreateAPIKey at\n%s", m.funcCreateAPIKeyOrigin)
+	}
+
+	if !m.CreateAPIKeyMock.invocationsDone() && afterCreateAPIKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthSvcMock.CreateAPIKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateAPIKeyMock.expectedInvocations), m.CreateAPIKeyMock.expectedInvocationsOrigin, afterCreateAPIKeyCounter)
+	}
+}
+
+type mAuthSvcMockCreateProfile struct {
+	optional           bool
+	mock               *AuthSvcMock
+	defaultExpectation *AuthSvcMockCreateProfileExpectation
+	expectations       []*AuthSvcMockCreateProfileExpectation
+
+	callArgs []*AuthSvcMockCreateProfileParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthSvcMockCreateProfileExpectation specifies expectation struct of the AuthSvc.CreateProfile
+type AuthSvcMockCreateProfileExpectation struct {
+	mock               *AuthSvcMock
+	params             *AuthSvcMockCreateProfileParams
+	paramPtrs          *AuthSvcMockCreateProfileParamPtrs
+	expectationOrigins AuthSvcMockCreateProfileExpectationOrigins
+	results            *AuthSvcMockCreateProfileResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthSvcMockCreateProfileParams contains parameters of the AuthSvc.CreateProfile
+type AuthSvcMockCreateProfileParams struct {
+	ctx     context.Context
+	profile models.Profile
+}
+
+// AuthSvcMockCreateProfileParamPtrs contains pointers to parameters of the AuthSvc.CreateProfile
+type AuthSvcMockCreateProfileParamPtrs struct {
+	ctx     *context.Context
+	profile *models.Profile
+}
+
+// AuthSvcMockCreateProfileResults contains results of the AuthSvc.CreateProfile
+type AuthSvcMockCreateProfileResults struct {
+	challenge string
+	err       error
+}
+
+// AuthSvcMockCreateProfileOrigins contains origins of expectations of the AuthSvc.CreateProfile
+type AuthSvcMockCreateProfileExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originProfile string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateProfile *mAuthSvcMockCreateProfile) Optional() *mAuthSvcMockCreateProfile {
+	mmCreateProfile.optional = true
+	return mmCreateProfile
+}
+
+// Expect sets up expected params for AuthSvc.CreateProfile
+func (mmCreateProfile *mAuthSvcMockCreateProfile) Expect(ctx context.Context, profile models.Profile) *mAuthSvcMockCreateProfile {
+	if mmCreateProfile.mock.funcCreateProfile != nil {
+		mmCreateProfile.mock.t.Fatalf("AuthSvcMock.CreateProfile mock is already set by Set")
+	}
+
+	if mmCreateProfile.defaultExpectation == nil {
+		mmCreateProfile.defaultExpectation = &AuthSvcMockCreateProfileExpectation{}
+	}
+
+	if mmCreateProfile.defaultExpectation.paramPtrs != nil {
+		mmCreateProfile.mock.t.Fatalf("AuthSvcMock.CreateProfile mock is already set by ExpectParams functions")
+	}
+
+	mmCreateProfile.defaultExpectation.params = &AuthSvcMockCreateProfileParams{ctx, profile}
+	mmCreateProfile.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateProfile.expectations {
+		if minimock.Equal(e.params, mmCreateProfile.defaultExpectation.params) {
+			mmCreateProfile.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateProfile.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateProfile
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthSvc.CreateProfile
+func (mmCreateProfile *mAuthSvcMockCreateProfile) ExpectCtxParam1(ctx context.Context) *mAuthSvcMockCreateProfile {
+	if mmCreateProfile.mock.funcCreateProfile != nil {
+		mmCreateProfile.mock.t.Fatalf("AuthSvcMock.CreateProfile mock is already set by Set")
+	}
+
+	if mmCreateProfile.defaultExpectation == nil {
+		mmCreateProfile.defaultExpectation = &AuthSvcMockCreateProfileExpectation{}
+	}
+
+	if mmCreateProfile.defaultExpectation.params != nil {
+		mmCreateProfile.mock.t.Fatalf("AuthSvcMock.CreateProfile mock is already set by Expect")
+	}
+
+	if mmCreateProfile.defaultExpectation.paramPtrs == nil {
+		mmCreateProfile.defaultExpectation.paramPtrs = &AuthSvcMockCreateProfileParamPtrs{}
+	}
+	mmCreateProfile.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateProfile.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateProfile
+}
+
+// ExpectProfileParam2 sets up expected param profile for AuthSvc.CreateProfile
+func (mmCreateProfile *mAuthSvcMockCreateProfile) ExpectProfileParam2(profile models.Profile) *mAuthSvcMockCreateProfile {
+	if mmCreateProfile.mock.funcCreateProfile != nil {
+		mmCreateProfile.mock.t.Fatalf("AuthSvcMock.CreateProfile mock is already set by Set")
+	}
+
+	if mmCreateProfile.defaultExpectation == nil {
+		mmCreateProfile.defaultExpectation = &AuthSvcMockCreateProfileExpectation{}
+	}
+
+	if mmCreateProfile.defaultExpectation.params != nil {
+		mmCreateProfile.mock.t.Fatalf("AuthSvcMock.CreateProfile mock is already set by Expect")
+	}
+
+	if mmCreateProfile.defaultExpectation.paramPtrs == nil {
+		mmCreateProfile.defaultExpectation.paramPtrs = &AuthSvcMockCreateProfileParamPtrs{}
+	}
+	mmCreateProfile.defaultExpectation.paramPtrs.profile = &profile
+	mmCreateProfile.defaultExpectation.expectationOrigins.originProfile = minimock.CallerInfo(1)
+
+	return mmCreateProfile
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthSvc.CreateProfile
+func (mmCreateProfile *mAuthSvcMockCreateProfile) Inspect(f func(ctx context.Context, profile models.Profile)) *mAuthSvcMockCreateProfile {
+	if mmCreateProfile.mock.inspectFuncCreateProfile != nil {
+		mmCreateProfile.mock.t.Fatalf("Inspect function is already set for AuthSvcMock.CreateProfile")
+	}
+
+	mmCreateProfile.mock.inspectFuncCreateProfile = f
+
+	return mmCreateProfile
+}
+
+// Return sets up results that will be returned by AuthSvc.CreateProfile
+func (mmCreateProfile *mAuthSvcMockCreateProfile) Return(challenge string, err error) *AuthSvcMock {
+	if mmCreateProfile.mock.funcCreateProfile != nil {
+		mmCreateProfile.mock.t.Fatalf("AuthSvcMock.CreateProfile mock is already set by Set")
+	}
+
+	if mmCreateProfile.defaultExpectation == nil {
+		mmCreateProfile.defaultExpectation = &AuthSvcMockCreateProfileExpectation{mock: mmCreateProfile.mock}
+	}
+	mmCreateProfile.defaultExpectation.results = &AuthSvcMockCreateProfileResults{challenge, err}
+	mmCreateProfile.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateProfile.mock
+}
+
+// Set uses given function f to mock the AuthSvc.CreateProfile method
+func (mmCreateProfile *mAuthSvcMockCreateProfile) Set(f func(ctx context.Context, profile models.Profile) (challenge string, err error)) *AuthSvcMock {
+	if mmCreateProfile.defaultExpectation != nil {
+		mmCreateProfile.mock.t.Fatalf("Default expectation is already set for the AuthSvc.CreateProfile method")
+	}
+
+	if len(mmCreateProfile.expectations) > 0 {
+		mmCreateProfile.mock.t.Fatalf("Some expectations are already set for the AuthSvc.CreateProfile method")
+	}
+
+	mmCreateProfile.mock.funcCreateProfile = f
+	mmCreateProfile.mock.funcCreateProfileOrigin = minimock.CallerInfo(1)
+	return mmCreateProfile.mock
+}
+
+// When sets expectation for the AuthSvc.CreateProfile which will trigger the result defined by the following
+// Then helper
+func (mmCreateProfile *mAuthSvcMockCreateProfile) When(ctx context.Context, profile models.Profile) *AuthSvcMockCreateProfileExpectation {
+	if mmCreateProfile.mock.funcCreateProfile != nil {
+		mmCreateProfile.mock.t.Fatalf("AuthSvcMock.CreateProfile mock is already set by Set")
+	}
+
+	expectation := &AuthSvcMockCreateProfileExpectation{
+		mock:               mmCreateProfile.mock,
+		params:             &AuthSvcMockCreateProfileParams{ctx, profile},
+		expectationOrigins: AuthSvcMockCreateProfileExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateProfile.expectations = append(mmCreateProfile.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthSvc.CreateProfile return parameters for the expectation previously defined by the When method
+func (e *AuthSvcMockCreateProfileExpectation) Then(challenge string, err error) *AuthSvcMock {
+	e.results = &AuthSvcMockCreateProfileResults{challenge, err}
+	return e.mock
+}
+
+// Times sets number of times AuthSvc.CreateProfile should be invoked
+func (mmCreateProfile *mAuthSvcMockCreateProfile) Times(n uint64) *mAuthSvcMockCreateProfile {
+	if n == 0 {
+		mmCreateProfile.mock.t.Fatalf("Times of AuthSvcMock.CreateProfile mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateProfile.expectedInvocations, n)
+	mmCreateProfile.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateProfile
+}
+
+func (mmCreateProfile *mAuthSvcMockCreateProfile) invocationsDone() bool {
+	if len(mmCreateProfile.expectations) == 0 && mmCreateProfile.defaultExpectation == nil && mmCreateProfile.mock.funcCreateProfile == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateProfile.mock.afterCreateProfileCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateProfile.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateProfile implements mm_handler.AuthSvc
+func (mmCreateProfile *AuthSvcMock) CreateProfile(ctx context.Context, profile models.Profile) (challenge string, err error) {
+	mm_atomic.AddUint64(&mmCreateProfile.beforeCreateProfileCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateProfile.afterCreateProfileCounter, 1)
+
+	mmCreateProfile.t.Helper()
+
+	if mmCreateProfile.inspectFuncCreateProfile != nil {
+		mmCreateProfile.inspectFuncCreateProfile(ctx, profile)
+	}
+
+	mm_params := AuthSvcMockCreateProfileParams{ctx, profile}
+
+	// Record call args
+	mmCreateProfile.CreateProfileMock.mutex.Lock()
+	mmCreateProfile.CreateProfileMock.callArgs = append(mmCreateProfile.CreateProfileMock.callArgs, &mm_params)
+	mmCreateProfile.CreateProfileMock.mutex.Unlock()
+
+	for _, e := range mmCreateProfile.CreateProfileMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.challenge, e.results.err
+		}
+	}
+
+	if mmCreateProfile.CreateProfileMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateProfile.CreateProfileMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateProfile.CreateProfileMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateProfile.CreateProfileMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthSvcMockCreateProfileParams{ctx, profile}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateProfile.t.Errorf("AuthSvcMock.CreateProfile got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateProfile.CreateProfileMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.profile != nil && !minimock.Equal(*mm_want_ptrs.profile, mm_got.profile) {
+				mmCreateProfile.t.Errorf("AuthSvcMock.CreateProfile got unexpected parameter profile, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateProfile.CreateProfileMock.defaultExpectation.expectationOrigins.originProfile, *mm_want_ptrs.profile, mm_got.profile, minimock.Diff(*mm_want_ptrs.profile, mm_got.profile))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateProfile.t.Errorf("AuthSvcMock.CreateProfile got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateProfile.CreateProfileMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateProfile.CreateProfileMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateProfile.t.Fatal("No results are set for the AuthSvcMock.CreateProfile")
+		}
+		return (*mm_results).challenge, (*mm_results).err
+	}
+	if mmCreateProfile.funcCreateProfile != nil {
+		return mmCreateProfile.funcCreateProfile(ctx, profile)
+	}
+	mmCreateProfile.t.Fatalf("Unexpected call to AuthSvcMock.CreateProfile. %v %v", ctx, profile)
+	return
+}
+
+// CreateProfileAfterCounter returns a count of finished AuthSvcMock.CreateProfile invocations
+func (mmCreateProfile *AuthSvcMock) CreateProfileAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateProfile.afterCreateProfileCounter)
+}
+
+// CreateProfileBeforeCounter returns a count of AuthSvcMock.CreateProfile invocations
+func (mmCreateProfile *AuthSvcMock) CreateProfileBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateProfile.beforeCreateProfileCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthSvcMock.CreateProfile.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateProfile *mAuthSvcMockCreateProfile) Calls() []*AuthSvcMockCreateProfileParams {
+	mmCreateProfile.mutex.RLock()
+
+	argCopy := make([]*AuthSvcMockCreateProfileParams, len(mmCreateProfile.callArgs))
+	copy(argCopy, mmCreateProfile.callArgs)
+
+	mmCreateProfile.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateProfileDone returns true if the count of the CreateProfile invocations corresponds
+// the number of defined expectations
+func (m *AuthSvcMock) MinimockCreateProfileDone() bool {
+	if m.CreateProfileMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateProfileMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateProfileMock.invocationsDone()
+}
+
+// MinimockCreateProfileInspect logs each unmet expectation
+func (m *AuthSvcMock) MinimockCreateProfileInspect() {
+	for _, e := range m.CreateProfileMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthSvcMock.CreateProfile at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateProfileCounter := mm_atomic.LoadUint64(&m.afterCreateProfileCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateProfileMock.defaultExpectation != nil && afterCreateProfileCounter < 1 {
+		if m.CreateProfileMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthSvcMock.CreateProfile at\n%s", m.CreateProfileMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthSvcMock.CreateProfile at\n%s with params: %#v", m.CreateProfileMock.defaultExpectation.expectationOrigins.origin, *m.CreateProfileMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateProfile != nil && afterCreateProfileCounter < 1 {
+		m.t.Errorf("Expected call to AuthSvcMock.CreateProfile at\n%s", m.funcCreateProfileOrigin)
+	}
+
+	if !m.CreateProfileMock.invocationsDone() && afterCreateProfileCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthSvcMock.CreateProfile at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateProfileMock.expectedInvocations), m.CreateProfileMock.expectedInvocationsOrigin, afterCreateProfileCounter)
+	}
+}
+
+type mAuthSvcMockCreateRecoveryKit struct {
+	optional           bool
+	mock               *AuthSvcMock
+	defaultExpectation *AuthSvcMockCreateRecoveryKitExpectation
+	expectations       []*AuthSvcMockCreateRecoveryKitExpectation
+
+	callArgs []*AuthSvcMockCreateRecoveryKitParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthSvcMockCreateRecoveryKitExpectation specifies expectation struct of the AuthSvc.CreateRecoveryKit
+type AuthSvcMockCreateRecoveryKitExpectation struct {
+	mock               *AuthSvcMock
+	params             *AuthSvcMockCreateRecoveryKitParams
+	paramPtrs          *AuthSvcMockCreateRecoveryKitParamPtrs
+	expectationOrigins AuthSvcMockCreateRecoveryKitExpectationOrigins
+	results            *AuthSvcMockCreateRecoveryKitResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthSvcMockCreateRecoveryKitParams contains parameters of the AuthSvc.CreateRecoveryKit
+type AuthSvcMockCreateRecoveryKitParams struct {
+	ctx      context.Context
+	username string
+	setup    recovery.Setup
+}
+
+// AuthSvcMockCreateRecoveryKitParamPtrs contains pointers to parameters of the AuthSvc.CreateRecoveryKit
+type AuthSvcMockCreateRecoveryKitParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	setup    *recovery.Setup
+}
+
+// AuthSvcMockCreateRecoveryKitResults contains results of the AuthSvc.CreateRecoveryKit
+type AuthSvcMockCreateRecoveryKitResults struct {
+	kit    recovery.Kit
+	shares []recovery.Share
+	err    error
+}
+
+// AuthSvcMockCreateRecoveryKitOrigins contains origins of expectations of the AuthSvc.CreateRecoveryKit
+type AuthSvcMockCreateRecoveryKitExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originSetup    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateRecoveryKit *mAuthSvcMockCreateRecoveryKit) Optional() *mAuthSvcMockCreateRecoveryKit {
+	mmCreateRecoveryKit.optional = true
+	return mmCreateRecoveryKit
+}
+
+// Expect sets up expected params for AuthSvc.CreateRecoveryKit
+func (mmCreateRecoveryKit *mAuthSvcMockCreateRecoveryKit) Expect(ctx context.Context, username string, setup recovery.Setup) *mAuthSvcMockCreateRecoveryKit {
+	if mmCreateRecoveryKit.mock.funcCreateRecoveryKit != nil {
+		mmCreateRecoveryKit.mock.t.Fatalf("AuthSvcMock.CreateRecoveryKit mock is already set by Set")
+	}
+
+	if mmCreateRecoveryKit.defaultExpectation == nil {
+		mmCreateRecoveryKit.defaultExpectation = &AuthSvcMockCreateRecoveryKitExpectation{}
+	}
+
+	if mmCreateRecoveryKit.defaultExpectation.paramPtrs != nil {
+		mmCreateRecoveryKit.mock.t.Fatalf("AuthSvcMock.CreateRecoveryKit mock is already set by ExpectParams functions")
+	}
+
+	mmCreateRecoveryKit.defaultExpectation.params = &AuthSvcMockCreateRecoveryKitParams{ctx, username, setup}
+	mmCreateRecoveryKit.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateRecoveryKit.expectations {
+		if minimock.Equal(e.params, mmCreateRecoveryKit.defaultExpectation.params) {
+			mmCreateRecoveryKit.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateRecoveryKit.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateRecoveryKit
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthSvc.CreateRecoveryKit
+func (mmCreateRecoveryKit *mAuthSvcMockCreateRecoveryKit) ExpectCtxParam1(ctx context.Context) *mAuthSvcMockCreateRecoveryKit {
+	if mmCreateRecoveryKit.mock.funcCreateRecoveryKit != nil {
+		mmCreateRecoveryKit.mock.t.Fatalf("AuthSvcMock.CreateRecoveryKit mock is already set by Set")
+	}
+
+	if mmCreateRecoveryKit.defaultExpectation == nil {
+		mmCreateRecoveryKit.defaultExpectation = &AuthSvcMockCreateRecoveryKitExpectation{}
+	}
+
+	if mmCreateRecoveryKit.defaultExpectation.params != nil {
+		mmCreateRecoveryKit.mock.t.Fatalf("AuthSvcMock.CreateRecoveryKit mock is already set by Expect")
+	}
+
+	if mmCreateRecoveryKit.defaultExpectation.paramPtrs == nil {
+		mmCreateRecoveryKit.defaultExpectation.paramPtrs = &AuthSvcMockCreateRecoveryKitParamPtrs{}
+	}
+	mmCreateRecoveryKit.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateRecoveryKit.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateRecoveryKit
+}
+
+// ExpectUsernameParam2 sets up expected param username for AuthSvc.CreateRecoveryKit
+func (mmCreateRecoveryKit *mAuthSvcMockCreateRecoveryKit) ExpectUsernameParam2(username string) *mAuthSvcMockCreateRecoveryKit {
+	if mmCreateRecoveryKit.mock.funcCreateRecoveryKit != nil {
+		mmCreateRecoveryKit.mock.t.Fatalf("AuthSvcMock.CreateRecoveryKit mock is already set by Set")
+	}
+
+	if mmCreateRecoveryKit.defaultExpectation == nil {
+		mmCreateRecoveryKit.defaultExpectation = &AuthSvcMockCreateRecoveryKitExpectation{}
+	}
+
+	if mmCreateRecoveryKit.defaultExpectation.params != nil {
+		mmCreateRecoveryKit.mock.t.Fatalf("AuthSvcMock.CreateRecoveryKit mock is already set by Expect")
+	}
+
+	if mmCreateRecoveryKit.defaultExpectation.paramPtrs == nil {
+		mmCreateRecoveryKit.defaultExpectation.paramPtrs = &AuthSvcMockCreateRecoveryKitParamPtrs{}
+	}
+	mmCreateRecoveryKit.defaultExpectation.paramPtrs.username = &username
+	mmCreateRecoveryKit.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmCreateRecoveryKit
+}
+
+// ExpectSetupParam3 sets up expected param setup for AuthSvc.CreateRecoveryKit
+func (mmCreateRecoveryKit *mAuthSvcMockCreateRecoveryKit) ExpectSetupParam3(setup recovery.Setup) *mAuthSvcMockCreateRecoveryKit {
+	if mmCreateRecoveryKit.mock.funcCreateRecoveryKit != nil {
+		mmCreateRecoveryKit.mock.t.Fatalf("AuthSvcMock.CreateRecoveryKit mock is already set by Set")
+	}
+
+	if mmCreateRecoveryKit.defaultExpectation == nil {
+		mmCreateRecoveryKit.defaultExpectation = &AuthSvcMockCreateRecoveryKitExpectation{}
+	}
+
+	if mmCreateRecoveryKit.defaultExpectation.params != nil {
+		mmCreateRecoveryKit.mock.t.Fatalf("AuthSvcMock.CreateRecoveryKit mock is already set by Expect")
+	}
+
+	if mmCreateRecoveryKit.defaultExpectation.paramPtrs == nil {
+		mmCreateRecoveryKit.defaultExpectation.paramPtrs = &AuthSvcMockCreateRecoveryKitParamPtrs{}
+	}
+	mmCreateRecoveryKit.defaultExpectation.paramPtrs.setup = &setup
+	mmCreateRecoveryKit.defaultExpectation.expectationOrigins.originSetup = minimock.CallerInfo(1)
+
+	return mmCreateRecoveryKit
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthSvc.CreateRecoveryKit
+func (mmCreateRecoveryKit *mAuthSvcMockCreateRecoveryKit) Inspect(f func(ctx context.Context, username string, setup recovery.Setup)) *mAuthSvcMockCreateRecoveryKit {
+	if mmCreateRecoveryKit.mock.inspectFuncCreateRecoveryKit != nil {
+		mmCreateRecoveryKit.mock.t.Fatalf("Inspect function is already set for AuthSvcMock.CreateRecoveryKit")
+	}
+
+	mmCreateRecoveryKit.mock.inspectFuncCreateRecoveryKit = f
+
+	return mmCreateRecoveryKit
+}
+
+// Return sets up results that will be returned by AuthSvc.CreateRecoveryKit
+func (mmCreateRecoveryKit *mAuthSvcMockCreateRecoveryKit) Return(kit recovery.Kit, shares []recovery.Share, err error) *AuthSvcMock {
+	if mmCreateRecoveryKit.mock.funcCreateRecoveryKit != nil {
+		mmCreateRecoveryKit.mock.t.Fatalf("AuthSvcMock.CreateRecoveryKit mock is already set by Set")
+	}
+
+	if mmCreateRecoveryKit.defaultExpectation == nil {
+		mmCreateRecoveryKit.defaultExpectation = &AuthSvcMockCreateRecoveryKitExpectation{mock: mmCreateRecoveryKit.mock}
+	}
+	mmCreateRecoveryKit.defaultExpectation.results = &AuthSvcMockCreateRecoveryKitResults{kit, shares, err}
+	mmCreateRecoveryKit.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateRecoveryKit.mock
+}
+
+// Set uses given function f to mock the AuthSvc.CreateRecoveryKit method
+func (mmCreateRecoveryKit *mAuthSvcMockCreateRecoveryKit) Set(f func(ctx context.Context, username string, setup recovery.Setup) (kit recovery.Kit, shares []recovery.Share, err error)) *AuthSvcMock {
+	if mmCreateRecoveryKit.defaultExpectation != nil {
+		mmCreateRecoveryKit.mock.t.Fatalf("Default expectation is already set for the AuthSvc.CreateRecoveryKit method")
+	}
+
+	if len(mmCreateRecoveryKit.expectations) > 0 {
+		mmCreateRecoveryKit.mock.t.Fatalf("Some expectations are already set for the AuthSvc.CreateRecoveryKit method")
+	}
+
+	mmCreateRecoveryKit.mock.funcCreateRecoveryKit = f
+	mmCreateRecoveryKit.mock.funcCreateRecoveryKitOrigin = minimock.CallerInfo(1)
+	return mmCreateRecoveryKit.mock
+}
+
+// When sets expectation for the AuthSvc.CreateRecoveryKit which will trigger the result defined by the following
+// Then helper
+func (mmCreateRecoveryKit *mAuthSvcMockCreateRecoveryKit) When(ctx context.Context, username string, setup recovery.Setup) *AuthSvcMockCreateRecoveryKitExpectation {
+	if mmCreateRecoveryKit.mock.funcCreateRecoveryKit != nil {
+		mmCreateRecoveryKit.mock.t.Fatalf("AuthSvcMock.CreateRecoveryKit mock is already set by Set")
+	}
+
+	expectation := &AuthSvcMockCreateRecoveryKitExpectation{
+		mock:               mmCreateRecoveryKit.mock,
+		params:             &AuthSvcMockCreateRecoveryKitParams{ctx, username, setup},
+		expectationOrigins: AuthSvcMockCreateRecoveryKitExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateRecoveryKit.expectations = append(mmCreateRecoveryKit.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthSvc.CreateRecoveryKit return parameters for the expectation previously defined by the When method
+func (e *AuthSvcMockCreateRecoveryKitExpectation) Then(kit recovery.Kit, shares []recovery.Share, err error) *AuthSvcMock {
+	e.results = &AuthSvcMockCreateRecoveryKitResults{kit, shares, err}
+	return e.mock
+}
+
+// Times sets number of times AuthSvc.CreateRecoveryKit should be invoked
+func (mmCreateRecoveryKit *mAuthSvcMockCreateRecoveryKit) Times(n uint64) *mAuthSvcMockCreateRecoveryKit {
+	if n == 0 {
+		mmCreateRecoveryKit.mock.t.Fatalf("Times of AuthSvcMock.CreateRecoveryKit mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateRecoveryKit.expectedInvocations, n)
+	mmCreateRecoveryKit.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateRecoveryKit
+}
+
+func (mmCreateRecoveryKit *mAuthSvcMockCreateRecoveryKit) invocationsDone() bool {
+	if len(mmCreateRecoveryKit.expectations) == 0 && mmCreateRecoveryKit.defaultExpectation == nil && mmCreateRecoveryKit.mock.funcCreateRecoveryKit == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateRecoveryKit.mock.afterCreateRecoveryKitCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateRecoveryKit.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateRecoveryKit implements mm_handler.AuthSvc
+func (mmCreateRecoveryKit *AuthSvcMock) CreateRecoveryKit(ctx context.Context, username string, setup recovery.Setup) (kit recovery.Kit, shares []recovery.Share, err error) {
+	mm_atomic.AddUint64(&mmCreateRecoveryKit.beforeCreateRecoveryKitCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateRecoveryKit.afterCreateRecoveryKitCounter, 1)
+
+	mmCreateRecoveryKit.t.Helper()
+
+	if mmCreateRecoveryKit.inspectFuncCreateRecoveryKit != nil {
+		mmCreateRecoveryKit.inspectFuncCreateRecoveryKit(ctx, username, setup)
+	}
+
+	mm_params := AuthSvcMockCreateRecoveryKitParams{ctx, username, setup}
+
+	// Record call args
+	mmCreateRecoveryKit.CreateRecoveryKitMock.mutex.Lock()
+	mmCreateRecoveryKit.CreateRecoveryKitMock.callArgs = append(mmCreateRecoveryKit.CreateRecoveryKitMock.callArgs, &mm_params)
+	mmCreateRecoveryKit.CreateRecoveryKitMock.mutex.Unlock()
+
+	for _, e := range mmCreateRecoveryKit.CreateRecoveryKitMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.kit, e.results.shares, e.results.err
+		}
+	}
+
+	if mmCreateRecoveryKit.CreateRecoveryKitMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateRecoveryKit.CreateRecoveryKitMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateRecoveryKit.CreateRecoveryKitMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateRecoveryKit.CreateRecoveryKitMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthSvcMockCreateRecoveryKitParams{ctx, username, setup}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateRecoveryKit.t.Errorf("AuthSvcMock.CreateRecoveryKit got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateRecoveryKit.CreateRecoveryKitMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmCreateRecoveryKit.t.Errorf("AuthSvcMock.CreateRecoveryKit got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateRecoveryKit.CreateRecoveryKitMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.setup != nil && !minimock.Equal(*mm_want_ptrs.setup, mm_got.setup) {
+				mmCreateRecoveryKit.t.Errorf("AuthSvcMock.CreateRecoveryKit got unexpected parameter setup, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateRecoveryKit.CreateRecoveryKitMock.defaultExpectation.expectationOrigins.originSetup, *mm_want_ptrs.setup, mm_got.setup, minimock.Diff(*mm_want_ptrs.setup, mm_got.setup))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateRecoveryKit.t.Errorf("AuthSvcMock.CreateRecoveryKit got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateRecoveryKit.CreateRecoveryKitMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateRecoveryKit.CreateRecoveryKitMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateRecoveryKit.t.Fatal("No results are set for the AuthSvcMock.CreateRecoveryKit")
+		}
+		return (*mm_results).kit, (*mm_results).shares, (*mm_results).err
+	}
+	if mmCreateRecoveryKit.funcCreateRecoveryKit != nil {
+		return mmCreateRecoveryKit.funcCreateRecoveryKit(ctx, username, setup)
+	}
+	mmCreateRecoveryKit.t.Fatalf("Unexpected call to AuthSvcMock.CreateRecoveryKit. %v %v %v", ctx, username, setup)
+	return
+}
+
+// CreateRecoveryKitAfterCounter returns a count of finished AuthSvcMock.CreateRecoveryKit invocations
+func (mmCreateRecoveryKit *AuthSvcMock) CreateRecoveryKitAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRecoveryKit.afterCreateRecoveryKitCounter)
+}
+
+// CreateRecoveryKitBeforeCounter returns a count of AuthSvcMock.CreateRecoveryKit invocations
+func (mmCreateRecoveryKit *AuthSvcMock) CreateRecoveryKitBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateRecoveryKit.beforeCreateRecoveryKitCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthSvcMock.CreateRecoveryKit.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateRecoveryKit *mAuthSvcMockCreateRecoveryKit) Calls() []*AuthSvcMockCreateRecoveryKitParams {
+	mmCreateRecoveryKit.mutex.RLock()
+
+	argCopy := make([]*AuthSvcMockCreateRecoveryKitParams, len(mmCreateRecoveryKit.callArgs))
+	copy(argCopy, mmCreateRecoveryKit.callArgs)
+
+	mmCreateRecoveryKit.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateRecoveryKitDone returns true if the count of the CreateRecoveryKit invocations corresponds
+// the number of defined expectations
+func (m *AuthSvcMock) MinimockCreateRecoveryKitDone() bool {
+	if m.CreateRecoveryKitMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateRecoveryKitMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateRecoveryKitMock.invocationsDone()
+}
+
+// MinimockCreateRecoveryKitInspect logs each unmet expectation
+func (m *AuthSvcMock) MinimockCreateRecoveryKitInspect() {
+	for _, e := range m.CreateRecoveryKitMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthSvcMock.CreateRecoveryKit at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateRecoveryKitCounter := mm_atomic.LoadUint64(&m.afterCreateRecoveryKitCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateRecoveryKitMock.defaultExpectation != nil && afterCreateRecoveryKitCounter < 1 {
+		if m.CreateRecoveryKitMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthSvcMock.CreateRecoveryKit at\n%s", m.CreateRecoveryKitMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthSvcMock.CreateRecoveryKit at\n%s with params: %#v", m.CreateRecoveryKitMock.defaultExpectation.expectationOrigins.origin, *m.CreateRecoveryKitMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateRecoveryKit != nil && afterCreateRecoveryKitCounter < 1 {
+		m.t.Errorf("Expected call to AuthSvcMock.CreateRecoveryKit at\n%s", m.funcCreateRecoveryKitOrigin)
+	}
+
+	if !m.CreateRecoveryKitMock.invocationsDone() && afterCreateRecoveryKitCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthSvcMock.CreateRecoveryKit at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateRecoveryKitMock.expectedInvocations), m.CreateRecoveryKitMock.expectedInvocationsOrigin, afterCreateRecoveryKitCounter)
+	}
+}
+
+type mAuthSvcMockDeleteDevice struct {
+	optional           bool
+	mock               *AuthSvcMock
+	defaultExpectation *AuthSvcMockDeleteDeviceExpectation
+	expectations       []*AuthSvcMockDeleteDeviceExpectation
+
+	callArgs []*AuthSvcMockDeleteDeviceParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthSvcMockDeleteDeviceExpectation specifies expectation struct of the AuthSvc.DeleteDevice
+type AuthSvcMockDeleteDeviceExpectation struct {
+	mock               *AuthSvcMock
+	params             *AuthSvcMockDeleteDeviceParams
+	paramPtrs          *AuthSvcMockDeleteDeviceParamPtrs
+	expectationOrigins AuthSvcMockDeleteDeviceExpectationOrigins
+	results            *AuthSvcMockDeleteDeviceResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthSvcMockDeleteDeviceParams contains parameters of the AuthSvc.DeleteDevice
+type AuthSvcMockDeleteDeviceParams struct {
+	ctx      context.Context
+	username string
+	id       string
+}
+
+// AuthSvcMockDeleteDeviceParamPtrs contains pointers to parameters of the AuthSvc.DeleteDevice
+type AuthSvcMockDeleteDeviceParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	id       *string
+}
+
+// AuthSvcMockDeleteDeviceResults contains results of the AuthSvc.DeleteDevice
+type AuthSvcMockDeleteDeviceResults struct {
+	revoked int64
+	err     error
+}
+
+// AuthSvcMockDeleteDeviceOrigins contains origins of expectations of the AuthSvc.DeleteDevice
+type AuthSvcMockDeleteDeviceExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originId       string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteDevice *mAuthSvcMockDeleteDevice) Optional() *mAuthSvcMockDeleteDevice {
+	mmDeleteDevice.optional = true
+	return mmDeleteDevice
+}
+
+// Expect sets up expected params for AuthSvc.DeleteDevice
+func (mmDeleteDevice *mAuthSvcMockDeleteDevice) Expect(ctx context.Context, username string, id string) *mAuthSvcMockDeleteDevice {
+	if mmDeleteDevice.mock.funcDeleteDevice != nil {
+		mmDeleteDevice.mock.t.Fatalf("AuthSvcMock.DeleteDevice mock is already set by Set")
+	}
+
+	if mmDeleteDevice.defaultExpectation == nil {
+		mmDeleteDevice.defaultExpectation = &AuthSvcMockDeleteDeviceExpectation{}
+	}
+
+	if mmDeleteDevice.defaultExpectation.paramPtrs != nil {
+		mmDeleteDevice.mock.t.Fatalf("AuthSvcMock.DeleteDevice mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteDevice.defaultExpectation.params = &AuthSvcMockDeleteDeviceParams{ctx, username, id}
+	mmDeleteDevice.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteDevice.expectations {
+		if minimock.Equal(e.params, mmDeleteDevice.defaultExpectation.params) {
+			mmDeleteDevice.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteDevice.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteDevice
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthSvc.DeleteDevice
+func (mmDeleteDevice *mAuthSvcMockDeleteDevice) ExpectCtxParam1(ctx context.Context) *mAuthSvcMockDeleteDevice {
+	if mmDeleteDevice.mock.funcDeleteDevice != nil {
+		mmDeleteDevice.mock.t.Fatalf("AuthSvcMock.DeleteDevice mock is already set by Set")
+	}
+
+	if mmDeleteDevice.defaultExpectation == nil {
+		mmDeleteDevice.defaultExpectation = &AuthSvcMockDeleteDeviceExpectation{}
+	}
+
+	if mmDeleteDevice.defaultExpectation.params != nil {
+		mmDeleteDevice.mock.t.Fatalf("AuthSvcMock.DeleteDevice mock is already set by Expect")
+	}
+
+	if mmDeleteDevice.defaultExpectation.paramPtrs == nil {
+		mmDeleteDevice.defaultExpectation.paramPtrs = &AuthSvcMockDeleteDeviceParamPtrs{}
+	}
+	mmDeleteDevice.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteDevice.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteDevice
+}
+
+// ExpectUsernameParam2 sets up expected param username for AuthSvc.DeleteDevice
+func (mmDeleteDevice *mAuthSvcMockDeleteDevice) ExpectUsernameParam2(username string) *mAuthSvcMockDeleteDevice {
+	if mmDeleteDevice.mock.funcDeleteDevice != nil {
+		mmDeleteDevice.mock.t.Fatalf("AuthSvcMock.DeleteDevice mock is already set by Set")
+	}
+
+	if mmDeleteDevice.defaultExpectation == nil {
+		mmDeleteDevice.defaultExpectation = &AuthSvcMockDeleteDeviceExpectation{}
+	}
+
+	if mmDeleteDevice.defaultExpectation.params != nil {
+		mmDeleteDevice.mock.t.Fatalf("AuthSvcMock.DeleteDevice mock is already set by Expect")
+	}
+
+	if mmDeleteDevice.defaultExpectation.paramPtrs == nil {
+		mmDeleteDevice.defaultExpectation.paramPtrs = &AuthSvcMockDeleteDeviceParamPtrs{}
+	}
+	mmDeleteDevice.defaultExpectation.paramPtrs.username = &username
+	mmDeleteDevice.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmDeleteDevice
+}
+
+// ExpectIdParam3 sets up expected param id for AuthSvc.DeleteDevice
+func (mmDeleteDevice *mAuthSvcMockDeleteDevice) ExpectIdParam3(id string) *mAuthSvcMockDeleteDevice {
+	if mmDeleteDevice.mock.funcDeleteDevice != nil {
+		mmDeleteDevice.mock.t.Fatalf("AuthSvcMock.DeleteDevice mock is already set by Set")
+	}
+
+	if mmDeleteDevice.defaultExpectation == nil {
+		mmDeleteDevice.defaultExpectation = &AuthSvcMockDeleteDeviceExpectation{}
+	}
+
+	if mmDeleteDevice.defaultExpectation.params != nil {
+		mmDeleteDevice.mock.t.Fatalf("AuthSvcMock.DeleteDevice mock is already set by Expect")
+	}
+
+	if mmDeleteDevice.defaultExpectation.paramPtrs == nil {
+		mmDeleteDevice.defaultExpectation.paramPtrs = &AuthSvcMockDeleteDeviceParamPtrs{}
+	}
+	mmDeleteDevice.defaultExpectation.paramPtrs.id = &id
+	mmDeleteDevice.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeleteDevice
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthSvc.DeleteDevice
+func (mmDeleteDevice *mAuthSvcMockDeleteDevice) Inspect(f func(ctx context.Context, username string, id string)) *mAuthSvcMockDeleteDevice {
+	if mmDeleteDevice.mock.inspectFuncDeleteDevice != nil {
+		mmDeleteDevice.mock.t.Fatalf("Inspect function is already set for AuthSvcMock.DeleteDevice")
+	}
+
+	mmDeleteDevice.mock.inspectFuncDeleteDevice = f
+
+	return mmDeleteDevice
+}
+
+// Return sets up results that will be returned by AuthSvc.DeleteDevice
+func (mmDeleteDevice *mAuthSvcMockDeleteDevice) Return(revoked int64, err error) *AuthSvcMock {
+	if mmDeleteDevice.mock.funcDeleteDevice != nil {
+		mmDeleteDevice.mock.t.Fatalf("AuthSvcMock.DeleteDevice mock is already set by Set")
+	}
+
+	if mmDeleteDevice.defaultExpectation == nil {
+		mmDeleteDevice.defaultExpectation = &AuthSvcMockDeleteDeviceExpectation{mock: mmDeleteDevice.mock}
+	}
+	mmDeleteDevice.defaultExpectation.results = &AuthSvcMockDeleteDeviceResults{revoked, err}
+	mmDeleteDevice.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteDevice.mock
+}
+
+// Set uses given function f to mock the AuthSvc.DeleteDevice method
+func (mmDeleteDevice *mAuthSvcMockDeleteDevice) Set(f func(ctx context.Context, username string, id string) (revoked int64, err error)) *AuthSvcMock {
+	if mmDeleteDevice.defaultExpectation != nil {
+		mmDeleteDevice.mock.t.Fatalf("Default expectation is already set for the AuthSvc.DeleteDevice method")
+	}
+
+	if len(mmDeleteDevice.expectations) > 0 {
+		mmDeleteDevice.mock.t.Fatalf("Some expectations are already set for the AuthSvc.DeleteDevice method")
+	}
+
+	mmDeleteDevice.mock.funcDeleteDevice = f
+	mmDeleteDevice.mock.funcDeleteDeviceOrigin = minimock.CallerInfo(1)
+	return mmDeleteDevice.mock
+}
+
+// When sets expectation for the AuthSvc.DeleteDevice which will trigger the result defined by the following
+// Then helper
+func (mmDeleteDevice *mAuthSvcMockDeleteDevice) When(ctx context.Context, username string, id string) *AuthSvcMockDeleteDeviceExpectation {
+	if mmDeleteDevice.mock.funcDeleteDevice != nil {
+		mmDeleteDevice.mock.t.Fatalf("AuthSvcMock.DeleteDevice mock is already set by Set")
+	}
+
+	expectation := &AuthSvcMockDeleteDeviceExpectation{
+		mock:               mmDeleteDevice.mock,
+		params:             &AuthSvcMockDeleteDeviceParams{ctx, username, id},
+		expectationOrigins: AuthSvcMockDeleteDeviceExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteDevice.expectations = append(mmDeleteDevice.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthSvc.DeleteDevice return parameters for the expectation previously defined by the When method
+func (e *AuthSvcMockDeleteDeviceExpectation) Then(revoked int64, err error) *AuthSvcMock {
+	e.results = &AuthSvcMockDeleteDeviceResults{revoked, err}
+	return e.mock
+}
+
+// Times sets number of times AuthSvc.DeleteDevice should be invoked
+func (mmDeleteDevice *mAuthSvcMockDeleteDevice) Times(n uint64) *mAuthSvcMockDeleteDevice {
+	if n == 0 {
+		mmDeleteDevice.mock.t.Fatalf("Times of AuthSvcMock.DeleteDevice mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteDevice.expectedInvocations, n)
+	mmDeleteDevice.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteDevice
+}
+
+func (mmDeleteDevice *mAuthSvcMockDeleteDevice) invocationsDone() bool {
+	if len(mmDeleteDevice.expectations) == 0 && mmDeleteDevice.defaultExpectation == nil && mmDeleteDevice.mock.funcDeleteDevice == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteDevice.mock.afterDeleteDeviceCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteDevice.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteDevice implements mm_handler.AuthSvc
+func (mmDeleteDevice *AuthSvcMock) DeleteDevice(ctx context.Context, username string, id string) (revoked int64, err error) {
+	mm_atomic.AddUint64(&mmDeleteDevice.beforeDeleteDeviceCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteDevice.afterDeleteDeviceCounter, 1)
+
+	mmDeleteDevice.t.Helper()
+
+	if mmDeleteDevice.inspectFuncDeleteDevice != nil {
+		mmDeleteDevice.inspectFuncDeleteDevice(ctx, username, id)
+	}
+
+	mm_params := AuthSvcMockDeleteDeviceParams{ctx, username, id}
+
+	// Record call args
+	mmDeleteDevice.DeleteDeviceMock.mutex.Lock()
+	mmDeleteDevice.DeleteDeviceMock.callArgs = append(mmDeleteDevice.DeleteDeviceMock.callArgs, &mm_params)
+	mmDeleteDevice.DeleteDeviceMock.mutex.Unlock()
+
+	for _, e := range mmDeleteDevice.DeleteDeviceMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.revoked, e.results.err
+		}
+	}
+
+	if mmDeleteDevice.DeleteDeviceMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteDevice.DeleteDeviceMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteDevice.DeleteDeviceMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteDevice.DeleteDeviceMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthSvcMockDeleteDeviceParams{ctx, username, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteDevice.t.Errorf("AuthSvcMock.DeleteDevice got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteDevice.DeleteDeviceMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmDeleteDevice.t.Errorf("AuthSvcMock.DeleteDevice got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteDevice.DeleteDeviceMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteDevice.t.Errorf("AuthSvcMock.DeleteDevice got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteDevice.DeleteDeviceMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteDevice.t.Errorf("AuthSvcMock.DeleteDevice got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteDevice.DeleteDeviceMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteDevice.DeleteDeviceMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteDevice.t.Fatal("No results are set for the AuthSvcMock.DeleteDevice")
+		}
+		return (*mm_results).revoked, (*mm_results).err
+	}
+	if mmDeleteDevice.funcDeleteDevice != nil {
+		return mmDeleteDevice.funcDeleteDevice(ctx, username, id)
+	}
+	mmDeleteDevice.t.Fatalf("Unexpected call to AuthSvcMock.DeleteDevice. %v %v %v", ctx, username, id)
+	return
+}
+
+// DeleteDeviceAfterCounter returns a count of finished AuthSvcMock.DeleteDevice invocations
+func (mmDeleteDevice *AuthSvcMock) DeleteDeviceAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteDevice.afterDeleteDeviceCounter)
+}
+
+// DeleteDeviceBeforeCounter returns a count of AuthSvcMock.DeleteDevice invocations
+func (mmDeleteDevice *AuthSvcMock) DeleteDeviceBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteDevice.beforeDeleteDeviceCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthSvcMock.DeleteDevice.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteDevice *mAuthSvcMockDeleteDevice) Calls() []*AuthSvcMockDeleteDeviceParams {
+	mmDeleteDevice.mutex.RLock()
+
+	argCopy := make([]*AuthSvcMockDeleteDeviceParams, len(mmDeleteDevice.callArgs))
+	copy(argCopy, mmDeleteDevice.callArgs)
+
+	mmDeleteDevice.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteDeviceDone returns true if the count of the DeleteDevice invocations corresponds
+// the number of defined expectations
+func (m *AuthSvcMock) MinimockDeleteDeviceDone() bool {
+	if m.DeleteDeviceMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteDeviceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteDeviceMock.invocationsDone()
+}
+
+// MinimockDeleteDeviceInspect logs each unmet expectation
+func (m *AuthSvcMock) MinimockDeleteDeviceInspect() {
+	for _, e := range m.DeleteDeviceMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthSvcMock.DeleteDevice at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteDeviceCounter := mm_atomic.LoadUint64(&m.afterDeleteDeviceCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteDeviceMock.defaultExpectation != nil && afterDeleteDeviceCounter < 1 {
+		if m.DeleteDeviceMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthSvcMock.DeleteDevice at\n%s", m.DeleteDeviceMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthSvcMock.DeleteDevice at\n%s with params: %#v", m.DeleteDeviceMock.defaultExpectation.expectationOrigins.origin, *m.DeleteDeviceMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteDevice != nil && afterDeleteDeviceCounter < 1 {
+		m.t.Errorf("Expected call to AuthSvcMock.DeleteDevice at\n%s", m.funcDeleteDeviceOrigin)
+	}
+
+	if !m.DeleteDeviceMock.invocationsDone() && afterDeleteDeviceCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthSvcMock.DeleteDevice at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteDeviceMock.expectedInvocations), m.DeleteDeviceMock.expectedInvocationsOrigin, afterDeleteDeviceCounter)
+	}
+}
+
+type mAuthSvcMockDeleteRecoveryKit struct {
+	optional           bool
+	mock               *AuthSvcMock
+	defaultExpectation *AuthSvcMockDeleteRecoveryKitExpectation
+	expectations       []*AuthSvcMockDeleteRecoveryKitExpectation
+
+	callArgs []*AuthSvcMockDeleteRecoveryKitParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthSvcMockDeleteRecoveryKitExpectation specifies expectation struct of the AuthSvc.DeleteRecoveryKit
+type AuthSvcMockDeleteRecoveryKitExpectation struct {
+	mock               *AuthSvcMock
+	params             *AuthSvcMockDeleteRecoveryKitParams
+	paramPtrs          *AuthSvcMockDeleteRecoveryKitParamPtrs
+	expectationOrigins AuthSvcMockDeleteRecoveryKitExpectationOrigins
+	results            *AuthSvcMockDeleteRecoveryKitResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthSvcMockDeleteRecoveryKitParams contains parameters of the AuthSvc.DeleteRecoveryKit
+type AuthSvcMockDeleteRecoveryKitParams struct {
+	ctx      context.Context
+	username string
+}
+
+// AuthSvcMockDeleteRecoveryKitParamPtrs contains pointers to parameters of the AuthSvc.DeleteRecoveryKit
+type AuthSvcMockDeleteRecoveryKitParamPtrs struct {
+	ctx      *context.Context
+	username *string
+}
+
+// AuthSvcMockDeleteRecoveryKitResults contains results of the AuthSvc.DeleteRecoveryKit
+type AuthSvcMockDeleteRecoveryKitResults struct {
+	err error
+}
+
+// AuthSvcMockDeleteRecoveryKitOrigins contains origins of expectations of the AuthSvc.DeleteRecoveryKit
+type AuthSvcMockDeleteRecoveryKitExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteRecoveryKit *mAuthSvcMockDeleteRecoveryKit) Optional() *mAuthSvcMockDeleteRecoveryKit {
+	mmDeleteRecoveryKit.optional = true
+	return mmDeleteRecoveryKit
+}
+
+// Expect sets up expected params for AuthSvc.DeleteRecoveryKit
+func (mmDeleteRecoveryKit *mAuthSvcMockDeleteRecoveryKit) Expect(ctx context.Context, username string) *mAuthSvcMockDeleteRecoveryKit {
+	if mmDeleteRecoveryKit.mock.funcDeleteRecoveryKit != nil {
+		mmDeleteRecoveryKit.mock.t.Fatalf("AuthSvcMock.DeleteRecoveryKit mock is already set by Set")
+	}
+
+	if mmDeleteRecoveryKit.defaultExpectation == nil {
+		mmDeleteRecoveryKit.defaultExpectation = &AuthSvcMockDeleteRecoveryKitExpectation{}
+	}
+
+	if mmDeleteRecoveryKit.defaultExpectation.paramPtrs != nil {
+		mmDeleteRecoveryKit.mock.t.Fatalf("AuthSvcMock.DeleteRecoveryKit mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteRecoveryKit.defaultExpectation.params = &AuthSvcMockDeleteRecoveryKitParams{ctx, username}
+	mmDeleteRecoveryKit.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteRecoveryKit.expectations {
+		if minimock.Equal(e.params, mmDeleteRecoveryKit.defaultExpectation.params) {
+			mmDeleteRecoveryKit.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteRecoveryKit.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteRecoveryKit
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthSvc.DeleteRecoveryKit
+func (mmDeleteRecoveryKit *mAuthSvcMockDeleteRecoveryKit) ExpectCtxParam1(ctx context.Context) *mAuthSvcMockDeleteRecoveryKit {
+	if mmDeleteRecoveryKit.mock.funcDeleteRecoveryKit != nil {
+		mmDeleteRecoveryKit.mock.t.Fatalf("AuthSvcMock.DeleteRecoveryKit mock is already set by Set")
+	}
+
+	if mmDeleteRecoveryKit.defaultExpectation == nil {
+		mmDeleteRecoveryKit.defaultExpectation = &AuthSvcMockDeleteRecoveryKitExpectation{}
+	}
+
+	if mmDeleteRecoveryKit.defaultExpectation.params != nil {
+		mmDeleteRecoveryKit.mock.t.Fatalf("AuthSvcMock.DeleteRecoveryKit mock is already set by Expect")
+	}
+
+	if mmDeleteRecoveryKit.defaultExpectation.paramPtrs == nil {
+		mmDeleteRecoveryKit.defaultExpectation.paramPtrs = &AuthSvcMockDeleteRecoveryKitParamPtrs{}
+	}
+	mmDeleteRecoveryKit.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteRecoveryKit.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteRecoveryKit
+}
+
+// ExpectUsernameParam2 sets up expected param username for AuthSvc.DeleteRecoveryKit
+func (mmDeleteRecoveryKit *mAuthSvcMockDeleteRecoveryKit) ExpectUsernameParam2(username string) *mAuthSvcMockDeleteRecoveryKit {
+	if mmDeleteRecoveryKit.mock.funcDeleteRecoveryKit != nil {
+		mmDeleteRecoveryKit.mock.t.Fatalf("AuthSvcMock.DeleteRecoveryKit mock is already set by Set")
+	}
+
+	if mmDeleteRecoveryKit.defaultExpectation == nil {
+		mmDeleteRecoveryKit.defaultExpectation = &AuthSvcMockDeleteRecoveryKitExpectation{}
+	}
+
+	if mmDeleteRecoveryKit.defaultExpectation.params != nil {
+		mmDeleteRecoveryKit.mock.t.Fatalf("AuthSvcMock.DeleteRecoveryKit mock is already set by Expect")
+	}
+
+	if mmDeleteRecoveryKit.defaultExpectation.paramPtrs == nil {
+		mmDeleteRecoveryKit.defaultExpectation.paramPtrs = &AuthSvcMockDeleteRecoveryKitParamPtrs{}
+	}
+	mmDeleteRecoveryKit.defaultExpectation.paramPtrs.username = &username
+	mmDeleteRecoveryKit.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmDeleteRecoveryKit
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthSvc.DeleteRecoveryKit
+func (mmDeleteRecoveryKit *mAuthSvcMockDeleteRecoveryKit) Inspect(f func(ctx context.Context, username string)) *mAuthSvcMockDeleteRecoveryKit {
+	if mmDeleteRecoveryKit.mock.inspectFuncDeleteRecoveryKit != nil {
+		mmDeleteRecoveryKit.mock.t.Fatalf("Inspect function is already set for AuthSvcMock.DeleteRecoveryKit")
+	}
+
+	mmDeleteRecoveryKit.mock.inspectFuncDeleteRecoveryKit = f
+
+	return mmDeleteRecoveryKit
+}
+
+// Return sets up results that will be returned by AuthSvc.DeleteRecoveryKit
+func (mmDeleteRecoveryKit *mAuthSvcMockDeleteRecoveryKit) Return(err error) *AuthSvcMock {
+	if mmDeleteRecoveryKit.mock.funcDeleteRecoveryKit != nil {
+		mmDeleteRecoveryKit.mock.t.Fatalf("AuthSvcMock.DeleteRecoveryKit mock is already set by Set")
+	}
+
+	if mmDeleteRecoveryKit.defaultExpectation == nil {
+		mmDeleteRecoveryKit.defaultExpectation = &AuthSvcMockDeleteRecoveryKitExpectation{mock: mmDeleteRecoveryKit.mock}
+	}
+	mmDeleteRecoveryKit.defaultExpectation.results = &AuthSvcMockDeleteRecoveryKitResults{err}
+	mmDeleteRecoveryKit.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteRecoveryKit.mock
+}
+
+// Set uses given function f to mock the AuthSvc.DeleteRecoveryKit method
+func (mmDeleteRecoveryKit *mAuthSvcMockDeleteRecoveryKit) Set(f func(ctx context.Context, username string) (err error)) *AuthSvcMock {
+	if mmDeleteRecoveryKit.defaultExpectation != nil {
+		mmDeleteRecoveryKit.mock.t.Fatalf("Default expectation is already set for the AuthSvc.DeleteRecoveryKit method")
+	}
+
+	if len(mmDeleteRecoveryKit.expectations) > 0 {
+		mmDeleteRecoveryKit.mock.t.Fatalf("Some expectations are already set for the AuthSvc.DeleteRecoveryKit method")
+	}
+
+	mmDeleteRecoveryKit.mock.funcDeleteRecoveryKit = f
+	mmDeleteRecoveryKit.mock.funcDeleteRecoveryKitOrigin = minimock.CallerInfo(1)
+	return mmDeleteRecoveryKit.mock
+}
+
+// When sets expectation for the AuthSvc.DeleteRecoveryKit which will trigger the result defined by the following
+// Then helper
+func (mmDeleteRecoveryKit *mAuthSvcMockDeleteRecoveryKit) When(ctx context.Context, username string) *AuthSvcMockDeleteRecoveryKitExpectation {
+	if mmDeleteRecoveryKit.mock.funcDeleteRecoveryKit != nil {
+		mmDeleteRecoveryKit.mock.t.Fatalf("AuthSvcMock.DeleteRecoveryKit mock is already set by Set")
+	}
+
+	expectation := &AuthSvcMockDeleteRecoveryKitExpectation{
+		mock:               mmDeleteRecoveryKit.mock,
+		params:             &AuthSvcMockDeleteRecoveryKitParams{ctx, username},
+		expectationOrigins: AuthSvcMockDeleteRecoveryKitExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteRecoveryKit.expectations = append(mmDeleteRecoveryKit.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthSvc.DeleteRecoveryKit return parameters for the expectation previously defined by the When method
+func (e *AuthSvcMockDeleteRecoveryKitExpectation) Then(err error) *AuthSvcMock {
+	e.results = &AuthSvcMockDeleteRecoveryKitResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthSvc.DeleteRecoveryKit should be invoked
+func (mmDeleteRecoveryKit *mAuthSvcMockDeleteRecoveryKit) Times(n uint64) *mAuthSvcMockDeleteRecoveryKit {
+	if n == 0 {
+		mmDeleteRecoveryKit.mock.t.Fatalf("Times of AuthSvcMock.DeleteRecoveryKit mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteRecoveryKit.expectedInvocations, n)
+	mmDeleteRecoveryKit.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteRecoveryKit
+}
+
+func (mmDeleteRecoveryKit *mAuthSvcMockDeleteRecoveryKit) invocationsDone() bool {
+	if len(mmDeleteRecoveryKit.expectations) == 0 && mmDeleteRecoveryKit.defaultExpectation == nil && mmDeleteRecoveryKit.mock.funcDeleteRecoveryKit == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteRecoveryKit.mock.afterDeleteRecoveryKitCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteRecoveryKit.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteRecoveryKit implements mm_handler.AuthSvc
+func (mmDeleteRecoveryKit *AuthSvcMock) DeleteRecoveryKit(ctx context.Context, username string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteRecoveryKit.beforeDeleteRecoveryKitCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteRecoveryKit.afterDeleteRecoveryKitCounter, 1)
+
+	mmDeleteRecoveryKit.t.Helper()
+
+	if mmDeleteRecoveryKit.inspectFuncDeleteRecoveryKit != nil {
+		mmDeleteRecoveryKit.inspectFuncDeleteRecoveryKit(ctx, username)
+	}
+
+	mm_params := AuthSvcMockDeleteRecoveryKitParams{ctx, username}
+
+	// Record call args
+	mmDeleteRecoveryKit.DeleteRecoveryKitMock.mutex.Lock()
+	mmDeleteRecoveryKit.DeleteRecoveryKitMock.callArgs = append(mmDeleteRecoveryKit.DeleteRecoveryKitMock.callArgs, &mm_params)
+	mmDeleteRecoveryKit.DeleteRecoveryKitMock.mutex.Unlock()
+
+	for _, e := range mmDeleteRecoveryKit.DeleteRecoveryKitMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteRecoveryKit.DeleteRecoveryKitMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteRecoveryKit.DeleteRecoveryKitMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteRecoveryKit.DeleteRecoveryKitMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteRecoveryKit.DeleteRecoveryKitMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthSvcMockDeleteRecoveryKitParams{ctx, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteRecoveryKit.t.Errorf("AuthSvcMock.DeleteRecoveryKit got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRecoveryKit.DeleteRecoveryKitMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmDeleteRecoveryKit.t.Errorf("AuthSvcMock.DeleteRecoveryKit got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteRecoveryKit.DeleteRecoveryKitMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteRecoveryKit.t.Errorf("AuthSvcMock.DeleteRecoveryKit got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteRecoveryKit.DeleteRecoveryKitMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteRecoveryKit.DeleteRecoveryKitMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteRecoveryKit.t.Fatal("No results are set for the AuthSvcMock.DeleteRecoveryKit")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteRecoveryKit.funcDeleteRecoveryKit != nil {
+		return mmDeleteRecoveryKit.funcDeleteRecoveryKit(ctx, username)
+	}
+	mmDeleteRecoveryKit.t.Fatalf("Unexpected call to AuthSvcMock.DeleteRecoveryKit. %v %v", ctx, username)
+	return
+}
+
+// DeleteRecoveryKitAfterCounter returns a count of finished AuthSvcMock.DeleteRecoveryKit invocations
+func (mmDeleteRecoveryKit *AuthSvcMock) DeleteRecoveryKitAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRecoveryKit.afterDeleteRecoveryKitCounter)
+}
+
+// DeleteRecoveryKitBeforeCounter returns a count of AuthSvcMock.DeleteRecoveryKit invocations
+func (mmDeleteRecoveryKit *AuthSvcMock) DeleteRecoveryKitBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteRecoveryKit.beforeDeleteRecoveryKitCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthSvcMock.DeleteRecoveryKit.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteRecoveryKit *mAuthSvcMockDeleteRecoveryKit) Calls() []*AuthSvcMockDeleteRecoveryKitParams {
+	mmDeleteRecoveryKit.mutex.RLock()
+
+	argCopy := make([]*AuthSvcMockDeleteRecoveryKitParams, len(mmDeleteRecoveryKit.callArgs))
+	copy(argCopy, mmDeleteRecoveryKit.callArgs)
+
+	mmDeleteRecoveryKit.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteRecoveryKitDone returns true if the count of the DeleteRecoveryKit invocations corresponds
+// the number of defined expectations
+func (m *AuthSvcMock) MinimockDeleteRecoveryKitDone() bool {
+	if m.DeleteRecoveryKitMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteRecoveryKitMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteRecoveryKitMock.invocationsDone()
+}
+
+// MinimockDeleteRecoveryKitInspect logs each unmet expectation
+func (m *AuthSvcMock) MinimockDeleteRecoveryKitInspect() {
+	for _, e := range m.DeleteRecoveryKitMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthSvcMock.DeleteRecoveryKit at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteRecoveryKitCounter := mm_atomic.LoadUint64(&m.afterDeleteRecoveryKitCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteRecoveryKitMock.defaultExpectation != nil && afterDeleteRecoveryKitCounter < 1 {
+		if m.DeleteRecoveryKitMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthSvcMock.DeleteRecoveryKit at\n%s", m.DeleteRecoveryKitMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthSvcMock.DeleteRecoveryKit at\n%s with params: %#v", m.DeleteRecoveryKitMock.defaultExpectation.expectationOrigins.origin, *m.DeleteRecoveryKitMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteRecoveryKit != nil && afterDeleteRecoveryKitCounter < 1 {
+		m.t.Errorf("Expected call to AuthSvcMock.DeleteRecoveryKit at\n%s", m.funcDeleteRecoveryKitOrigin)
+	}
+
+	if !m.DeleteRecoveryKitMock.invocationsDone() && afterDeleteRecoveryKitCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthSvcMock.DeleteRecoveryKit at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteRecoveryKitMock.expectedInvocations), m.DeleteRecoveryKitMock.expectedInvocationsOrigin, afterDeleteRecoveryKitCounter)
+	}
+}
+
+type mAuthSvcMockGetAPIKeys struct {
+	optional           bool
+	mock               *AuthSvcMock
+	defaultExpectation *AuthSvcMockGetAPIKeysExpectation
+	expectations       []*AuthSvcMockGetAPIKeysExpectation
+
+	callArgs []*AuthSvcMockGetAPIKeysParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthSvcMockGetAPIKeysExpectation specifies expectation struct of the AuthSvc.GetAPIKeys
+type AuthSvcMockGetAPIKeysExpectation struct {
+	mock               *AuthSvcMock
+	params             *AuthSvcMockGetAPIKeysParams
+	paramPtrs          *AuthSvcMockGetAPIKeysParamPtrs
+	expectationOrigins AuthSvcMockGetAPIKeysExpectationOrigins
+	results            *AuthSvcMockGetAPIKeysResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthSvcMockGetAPIKeysParams contains parameters of the AuthSvc.GetAPIKeys
+type AuthSvcMockGetAPIKeysParams struct {
+	ctx      context.Context
+	username string
+	page     pagination.Request
+}
+
+// AuthSvcMockGetAPIKeysParamPtrs contains pointers to parameters of the AuthSvc.GetAPIKeys
+type AuthSvcMockGetAPIKeysParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	page     *pagination.Request
+}
+
+// AuthSvcMockGetAPIKeysResults contains results of the AuthSvc.GetAPIKeys
+type AuthSvcMockGetAPIKeysResults struct {
+	keys []apikey.Key
+	next string
+	err  error
+}
+
+// AuthSvcMockGetAPIKeysOrigins contains origins of expectations of the AuthSvc.GetAPIKeys
+type AuthSvcMockGetAPIKeysExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originPage     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetAPIKeys *mAuthSvcMockGetAPIKeys) Optional() *mAuthSvcMockGetAPIKeys {
+	mmGetAPIKeys.optional = true
+	return mmGetAPIKeys
+}
+
+// Expect sets up expected params for AuthSvc.GetAPIKeys
+func (mmGetAPIKeys *mAuthSvcMockGetAPIKeys) Expect(ctx context.Context, username string, page pagination.Request) *mAuthSvcMockGetAPIKeys {
+	if mmGetAPIKeys.mock.funcGetAPIKeys != nil {
+		mmGetAPIKeys.mock.t.Fatalf("AuthSvcMock.GetAPIKeys mock is already set by Set")
+	}
+
+	if mmGetAPIKeys.defaultExpectation == nil {
+		mmGetAPIKeys.defaultExpectation = &AuthSvcMockGetAPIKeysExpectation{}
+	}
+
+	if mmGetAPIKeys.defaultExpectation.paramPtrs != nil {
+		mmGetAPIKeys.mock.t.Fatalf("AuthSvcMock.GetAPIKeys mock is already set by ExpectParams functions")
+	}
+
+	mmGetAPIKeys.defaultExpectation.params = &AuthSvcMockGetAPIKeysParams{ctx, username, page}
+	mmGetAPIKeys.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetAPIKeys.expectations {
+		if minimock.Equal(e.params, mmGetAPIKeys.defaultExpectation.params) {
+			mmGetAPIKeys.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAPIKeys.defaultExpectation.params)
+		}
+	}
+
+	return mmGetAPIKeys
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthSvc.GetAPIKeys
+func (mmGetAPIKeys *mAuthSvcMockGetAPIKeys) ExpectCtxParam1(ctx context.Context) *mAuthSvcMockGetAPIKeys {
+	if mmGetAPIKeys.mock.funcGetAPIKeys != nil {
+		mmGetAPIKeys.mock.t.Fatalf("AuthSvcMock.GetAPIKeys mock is already set by Set")
+	}
+
+	if mmGetAPIKeys.defaultExpectation == nil {
+		mmGetAPIKeys.defaultExpectation = &AuthSvcMockGetAPIKeysExpectation{}
+	}
+
+	if mmGetAPIKeys.defaultExpectation.params != nil {
+		mmGetAPIKeys.mock.t.Fatalf("AuthSvcMock.GetAPIKeys mock is already set by Expect")
+	}
+
+	if mmGetAPIKeys.defaultExpectation.paramPtrs == nil {
+		mmGetAPIKeys.defaultExpectation.paramPtrs = &AuthSvcMockGetAPIKeysParamPtrs{}
+	}
+	mmGetAPIKeys.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetAPIKeys.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetAPIKeys
+}
+
+// ExpectUsernameParam2 sets up expected param username for AuthSvc.GetAPIKeys
+func (mmGetAPIKeys *mAuthSvcMockGetAPIKeys) ExpectUsernameParam2(username string) *mAuthSvcMockGetAPIKeys {
+	if mmGetAPIKeys.mock.funcGetAPIKeys != nil {
+		mmGetAPIKeys.mock.t.Fatalf("AuthSvcMock.GetAPIKeys mock is already set by Set")
+	}
+
+	if mmGetAPIKeys.defaultExpectation == nil {
+		mmGetAPIKeys.defaultExpectation = &AuthSvcMockGetAPIKeysExpectation{}
+	}
+
+	if mmGetAPIKeys.defaultExpectation.params != nil {
+		mmGetAPIKeys.mock.t.Fatalf("AuthSvcMock.GetAPIKeys mock is already set by Expect")
+	}
+
+	if mmGetAPIKeys.defaultExpectation.paramPtrs == nil {
+		mmGetAPIKeys.defaultExpectation.paramPtrs = &AuthSvcMockGetAPIKeysParamPtrs{}
+	}
+	mmGetAPIKeys.defaultExpectation.paramPtrs.username = &username
+	mmGetAPIKeys.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetAPIKeys
+}
+
+// ExpectPageParam3 sets up expected param page for AuthSvc.GetAPIKeys
+func (mmGetAPIKeys *mAuthSvcMockGetAPIKeys) ExpectPageParam3(page pagination.Request) *mAuthSvcMockGetAPIKeys {
+	if mmGetAPIKeys.mock.funcGetAPIKeys != nil {
+		mmGetAPIKeys.mock.t.Fatalf("AuthSvcMock.GetAPIKeys mock is already set by Set")
+	}
+
+	if mmGetAPIKeys.defaultExpectation == nil {
+		mmGetAPIKeys.defaultExpectation = &AuthSvcMockGetAPIKeysExpectation{}
+	}
+
+	if mmGetAPIKeys.defaultExpectation.params != nil {
+		mmGetAPIKeys.mock.t.Fatalf("AuthSvcMock.GetAPIKeys mock is already set by Expect")
+	}
+
+	if mmGetAPIKeys.defaultExpectation.paramPtrs == nil {
+		mmGetAPIKeys.defaultExpectation.paramPtrs = &AuthSvcMockGetAPIKeysParamPtrs{}
+	}
+	mmGetAPIKeys.defaultExpectation.paramPtrs.page = &page
+	mmGetAPIKeys.defaultExpectation.expectationOrigins.originPage = minimock.CallerInfo(1)
+
+	return mmGetAPIKeys
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthSvc.GetAPIKeys
+func (mmGetAPIKeys *mAuthSvcMockGetAPIKeys) Inspect(f func(ctx context.Context, username string, page pagination.Request)) *mAuthSvcMockGetAPIKeys {
+	if mmGetAPIKeys.mock.inspectFuncGetAPIKeys != nil {
+		mmGetAPIKeys.mock.t.Fatalf("Inspect function is already set for AuthSvcMock.GetAPIKeys")
+	}
+
+	mmGetAPIKeys.mock.inspectFuncGetAPIKeys = f
+
+	return mmGetAPIKeys
+}
+
+// Return sets up results that will be returned by AuthSvc.GetAPIKeys
+func (mmGetAPIKeys *mAuthSvcMockGetAPIKeys) Return(keys []apikey.Key, next string, err error) *AuthSvcMock {
+	if mmGetAPIKeys.mock.funcGetAPIKeys != nil {
+		mmGetAPIKeys.mock.t.Fatalf("AuthSvcMock.GetAPIKeys mock is already set by Set")
+	}
+
+	if mmGetAPIKeys.defaultExpectation == nil {
+		mmGetAPIKeys.defaultExpectation = &AuthSvcMockGetAPIKeysExpectation{mock: mmGetAPIKeys.mock}
+	}
+	mmGetAPIKeys.defaultExpectation.results = &AuthSvcMockGetAPIKeysResults{keys, next, err}
+	mmGetAPIKeys.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetAPIKeys.mock
+}
+
+// Set uses given function f to mock the AuthSvc.GetAPIKeys method
+func (mmGetAPIKeys *mAuthSvcMockGetAPIKeys) Set(f func(ctx context.Context, username string, page pagination.Request) (keys []apikey.Key, next string, err error)) *AuthSvcMock {
+	if mmGetAPIKeys.defaultExpectation != nil {
+		mmGetAPIKeys.mock.t.Fatalf("Default expectation is already set for the AuthSvc.GetAPIKeys method")
+	}
+
+	if len(mmGetAPIKeys.expectations) > 0 {
+		mmGetAPIKeys.mock.t.Fatalf("Some expectations are already set for the AuthSvc.GetAPIKeys method")
+	}
+
+	mmGetAPIKeys.mock.funcGetAPIKeys = f
+	mmGetAPIKeys.mock.funcGetAPIKeysOrigin = minimock.CallerInfo(1)
+	return mmGetAPIKeys.mock
+}
+
+// When sets expectation for the AuthSvc.GetAPIKeys which will trigger the result defined by the following
+// Then helper
+func (mmGetAPIKeys *mAuthSvcMockGetAPIKeys) When(ctx context.Context, username string, page pagination.Request) *AuthSvcMockGetAPIKeysExpectation {
+	if mmGetAPIKeys.mock.funcGetAPIKeys != nil {
+		mmGetAPIKeys.mock.t.Fatalf("AuthSvcMock.GetAPIKeys mock is already set by Set")
+	}
+
+	expectation := &AuthSvcMockGetAPIKeysExpectation{
+		mock:               mmGetAPIKeys.mock,
+		params:             &AuthSvcMockGetAPIKeysParams{ctx, username, page},
+		expectationOrigins: AuthSvcMockGetAPIKeysExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetAPIKeys.expectations = append(mmGetAPIKeys.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthSvc.GetAPIKeys return parameters for the expectation previously defined by the When method
+func (e *AuthSvcMockGetAPIKeysExpectation) Then(keys []apikey.Key, next string, err error) *AuthSvcMock {
+	e.results = &AuthSvcMockGetAPIKeysResults{keys, next, err}
+	return e.mock
+}
+
+// Times sets number of times AuthSvc.GetAPIKeys should be invoked
+func (mmGetAPIKeys *mAuthSvcMockGetAPIKeys) Times(n uint64) *mAuthSvcMockGetAPIKeys {
+	if n == 0 {
+		mmGetAPIKeys.mock.t.Fatalf("Times of AuthSvcMock.GetAPIKeys mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetAPIKeys.expectedInvocations, n)
+	mmGetAPIKeys.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetAPIKeys
+}
+
+func (mmGetAPIKeys *mAuthSvcMockGetAPIKeys) invocationsDone() bool {
+	if len(mmGetAPIKeys.expectations) == 0 && mmGetAPIKeys.defaultExpectation == nil && mmGetAPIKeys.mock.funcGetAPIKeys == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetAPIKeys.mock.afterGetAPIKeysCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetAPIKeys.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetAPIKeys implements mm_handler.AuthSvc
+func (mmGetAPIKeys *AuthSvcMock) GetAPIKeys(ctx context.Context, username string, page pagination.Request) (keys []apikey.Key, next string, err error) {
+	mm_atomic.AddUint64(&mmGetAPIKeys.beforeGetAPIKeysCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetAPIKeys.afterGetAPIKeysCounter, 1)
+
+	mmGetAPIKeys.t.Helper()
+
+	if mmGetAPIKeys.inspectFuncGetAPIKeys != nil {
+		mmGetAPIKeys.inspectFuncGetAPIKeys(ctx, username, page)
+	}
+
+	mm_params := AuthSvcMockGetAPIKeysParams{ctx, username, page}
+
+	// Record call args
+	mmGetAPIKeys.GetAPIKeysMock.mutex.Lock()
+	mmGetAPIKeys.GetAPIKeysMock.callArgs = append(mmGetAPIKeys.GetAPIKeysMock.callArgs, &mm_params)
+	mmGetAPIKeys.GetAPIKeysMock.mutex.Unlock()
+
+	for _, e := range mmGetAPIKeys.GetAPIKeysMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.keys, e.results.next, e.results.err
+		}
+	}
+
+	if mmGetAPIKeys.GetAPIKeysMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetAPIKeys.GetAPIKeysMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetAPIKeys.GetAPIKeysMock.defaultExpectation.params
+		mm_want_ptrs := mmGetAPIKeys.GetAPIKeysMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthSvcMockGetAPIKeysParams{ctx, username, page}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetAPIKeys.t.Errorf("AuthSvcMock.GetAPIKeys got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAPIKeys.GetAPIKeysMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetAPIKeys.t.Errorf("AuthSvcMock.GetAPIKeys got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAPIKeys.GetAPIKeysMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.page != nil && !minimock.Equal(*mm_want_ptrs.page, mm_got.page) {
+				mmGetAPIKeys.t.Errorf("AuthSvcMock.GetAPIKeys got unexpected parameter page, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAPIKeys.GetAPIKeysMock.defaultExpectation.expectationOrigins.originPage, *mm_want_ptrs.page, mm_got.page, minimock.Diff(*mm_want_ptrs.page, mm_got.page))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetAPIKeys.t.Errorf("AuthSvcMock.GetAPIKeys got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetAPIKeys.GetAPIKeysMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetAPIKeys.GetAPIKeysMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetAPIKeys.t.Fatal("No results are set for the AuthSvcMock.GetAPIKeys")
+		}
+		return (*mm_results).keys, (*mm_results).next, (*mm_results).err
+	}
+	if mmGetAPIKeys.funcGetAPIKeys != nil {
+		return mmGetAPIKeys.funcGetAPIKeys(ctx, username, page)
+	}
+	mmGetAPIKeys.t.Fatalf("Unexpected call to AuthSvcMock.GetAPIKeys. %v %v %v", ctx, username, page)
+	return
+}
+
+// GetAPIKeysAfterCounter returns a count of finished AuthSvcMock.GetAPIKeys invocations
+func (mmGetAPIKeys *AuthSvcMock) GetAPIKeysAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAPIKeys.afterGetAPIKeysCounter)
+}
+
+// GetAPIKeysBeforeCounter returns a count of AuthSvcMock.GetAPIKeys invocations
+func (mmGetAPIKeys *AuthSvcMock) GetAPIKeysBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAPIKeys.beforeGetAPIKeysCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthSvcMock.GetAPIKeys.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetAPIKeys *mAuthSvcMockGetAPIKeys) Calls() []*AuthSvcMockGetAPIKeysParams {
+	mmGetAPIKeys.mutex.RLock()
+
+	argCopy := make([]*AuthSvcMockGetAPIKeysParams, len(mmGetAPIKeys.callArgs))
+	copy(argCopy, mmGetAPIKeys.callArgs)
+
+	mmGetAPIKeys.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetAPIKeysDone returns true if the count of the GetAPIKeys invocations corresponds
+// the number of defined expectations
+func (m *AuthSvcMock) MinimockGetAPIKeysDone() bool {
+	if m.GetAPIKeysMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetAPIKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetAPIKeysMock.invocationsDone()
+}
+
+// MinimockGetAPIKeysInspect logs each unmet expectation
+func (m *AuthSvcMock) MinimockGetAPIKeysInspect() {
+	for _, e := range m.GetAPIKeysMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthSvcMock.GetAPIKeys at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetAPIKeysCounter := mm_atomic.LoadUint64(&m.afterGetAPIKeysCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetAPIKeysMock.defaultExpectation != nil && afterGetAPIKeysCounter < 1 {
+		if m.GetAPIKeysMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthSvcMock.GetAPIKeys at\n%s", m.GetAPIKeysMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthSvcMock.GetAPIKeys at\n%s with params: %#v", m.GetAPIKeysMock.defaultExpectation.expectationOrigins.origin, *m.GetAPIKeysMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetAPIKeys != nil && afterGetAPIKeysCounter < 1 {
+		m.t.Errorf("Expected call to AuthSvcMock.GetAPIKeys at\n%s", m.funcGetAPIKeysOrigin)
+	}
+
+	if !m.GetAPIKeysMock.invocationsDone() && afterGetAPIKeysCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthSvcMock.GetAPIKeys at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetAPIKeysMock.expectedInvocations), m.GetAPIKeysMock.expectedInvocationsOrigin, afterGetAPIKeysCounter)
+	}
+}
+
+type mAuthSvcMockGetAccountByUserName struct {
+	optional           bool
+	mock               *AuthSvcMock
+	defaultExpectation *AuthSvcMockGetAccountByUserNameExpectation
+	expectations       []*AuthSvcMockGetAccountByUserNameExpectation
+
+	callArgs []*AuthSvcMockGetAccountByUserNameParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthSvcMockGetAccountByUserNameExpectation specifies expectation struct of the AuthSvc.GetAccountByUserName
+type AuthSvcMockGetAccountByUserNameExpectation struct {
+	mock               *AuthSvcMock
+	params             *AuthSvcMockGetAccountByUserNameParams
+	paramPtrs          *AuthSvcMockGetAccountByUserNameParamPtrs
+	expectationOrigins AuthSvcMockGetAccountByUserNameExpectationOrigins
+	results            *AuthSvcMockGetAccountByUserNameResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthSvcMockGetAccountByUserNameParams contains parameters of the AuthSvc.GetAccountByUserName
+type AuthSvcMockGetAccountByUserNameParams struct {
+	ctx      context.Context
+	username string
+}
+
+// AuthSvcMockGetAccountByUserNameParamPtrs contains pointers to parameters of the AuthSvc.GetAccountByUserName
+type AuthSvcMockGetAccountByUserNameParamPtrs struct {
+	ctx      *context.Context
+	username *string
+}
+
+// AuthSvcMockGetAccountByUserNameResults contains results of the AuthSvc.GetAccountByUserName
+type AuthSvcMockGetAccountByUserNameResults struct {
+	acc models.Account
+	err error
+}
+
+// AuthSvcMockGetAccountByUserNameOrigins contains origins of expectations of the AuthSvc.GetAccountByUserName
+type AuthSvcMockGetAccountByUserNameExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetAccountByUserName *mAuthSvcMockGetAccountByUserName) Optional() *mAuthSvcMockGetAccountByUserName {
+	mmGetAccountByUserName.optional = true
+	return mmGetAccountByUserName
+}
+
+// Expect sets up expected params for AuthSvc.GetAccountByUserName
+func (mmGetAccountByUserName *mAuthSvcMockGetAccountByUserName) Expect(ctx context.Context, username string) *mAuthSvcMockGetAccountByUserName {
+	if mmGetAccountByUserName.mock.funcGetAccountByUserName != nil {
+		mmGetAccountByUserName.mock.t.Fatalf("AuthSvcMock.GetAccountByUserName mock is already set by Set")
+	}
+
+	if mmGetAccountByUserName.defaultExpectation == nil {
+		mmGetAccountByUserName.defaultExpectation = &AuthSvcMockGetAccountByUserNameExpectation{}
+	}
+
+	if mmGetAccountByUserName.defaultExpectation.paramPtrs != nil {
+		mmGetAccountByUserName.mock.t.Fatalf("AuthSvcMock.GetAccountByUserName mock is already set by ExpectParams functions")
+	}
+
+	mmGetAccountByUserName.defaultExpectation.params = &AuthSvcMockGetAccountByUserNameParams{ctx, username}
+	mmGetAccountByUserName.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetAccountByUserName.expectations {
+		if minimock.Equal(e.params, mmGetAccountByUserName.defaultExpectation.params) {
+			mmGetAccountByUserName.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAccountByUserName.defaultExpectation.params)
+		}
+	}
+
+	return mmGetAccountByUserName
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthSvc.GetAccountByUserName
+func (mmGetAccountByUserName *mAuthSvcMockGetAccountByUserName) ExpectCtxParam1(ctx context.Context) *mAuthSvcMockGetAccountByUserName {
+	if mmGetAccountByUserName.mock.funcGetAccountByUserName != nil {
+		mmGetAccountByUserName.mock.t.Fatalf("AuthSvcMock.GetAccountByUserName mock is already set by Set")
+	}
+
+	if mmGetAccountByUserName.defaultExpectation == nil {
+		mmGetAccountByUserName.defaultExpectation = &AuthSvcMockGetAccountByUserNameExpectation{}
+	}
+
+	if mmGetAccountByUserName.defaultExpectation.params != nil {
+		mmGetAccountByUserName.mock.t.Fatalf("AuthSvcMock.GetAccountByUserName mock is already set by Expect")
+	}
+
+	if mmGetAccountByUserName.defaultExpectation.paramPtrs == nil {
+		mmGetAccountByUserName.defaultExpectation.paramPtrs = &AuthSvcMockGetAccountByUserNameParamPtrs{}
+	}
+	mmGetAccountByUserName.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetAccountByUserName.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetAccountByUserName
+}
+
+// ExpectUsernameParam2 sets up expected param username for AuthSvc.GetAccountByUserName
+func (mmGetAccountByUserName *mAuthSvcMockGetAccountByUserName) ExpectUsernameParam2(username string) *mAuthSvcMockGetAccountByUserName {
+	if mmGetAccountByUserName.mock.funcGetAccountByUserName != nil {
+		mmGetAccountByUserName.mock.t.Fatalf("AuthSvcMock.GetAccountByUserName mock is already set by Set")
+	}
+
+	if mmGetAccountByUserName.defaultExpectation == nil {
+		mmGetAccountByUserName.defaultExpectation = &AuthSvcMockGetAccountByUserNameExpectation{}
+	}
+
+	if mmGetAccountByUserName.defaultExpectation.params != nil {
+		mmGetAccountByUserName.mock.t.Fatalf("AuthSvcMock.GetAccountByUserName mock is already set by Expect")
+	}
+
+	if mmGetAccountByUserName.defaultExpectation.paramPtrs == nil {
+		mmGetAccountByUserName.defaultExpectation.paramPtrs = &AuthSvcMockGetAccountByUserNameParamPtrs{}
+	}
+	mmGetAccountByUserName.defaultExpectation.paramPtrs.username = &username
+	mmGetAccountByUserName.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetAccountByUserName
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthSvc.GetAccountByUserName
+func (mmGetAccountByUserName *mAuthSvcMockGetAccountByUserName) Inspect(f func(ctx context.Context, username string)) *mAuthSvcMockGetAccountByUserName {
+	if mmGetAccountByUserName.mock.inspectFuncGetAccountByUserName != nil {
+		mmGetAccountByUserName.mock.t.Fatalf("Inspect function is already set for AuthSvcMock.GetAccountByUserName")
+	}
+
+	mmGetAccountByUserName.mock.inspectFuncGetAccountByUserName = f
+
+	return mmGetAccountByUserName
+}
+
+// Return sets up results that will be returned by AuthSvc.GetAccountByUserName
+func (mmGetAccountByUserName *mAuthSvcMockGetAccountByUserName) Return(acc models.Account, err error) *AuthSvcMock {
+	if mmGetAccountByUserName.mock.funcGetAccountByUserName != nil {
+		mmGetAccountByUserName.mock.t.Fatalf("AuthSvcMock.GetAccountByUserName mock is already set by Set")
+	}
+
+	if mmGetAccountByUserName.defaultExpectation == nil {
+		mmGetAccountByUserName.defaultExpectation = &AuthSvcMockGetAccountByUserNameExpectation{mock: mmGetAccountByUserName.mock}
+	}
+	mmGetAccountByUserName.defaultExpectation.results = &AuthSvcMockGetAccountByUserNameResults{acc, err}
+	mmGetAccountByUserName.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetAccountByUserName.mock
+}
+
+// Set uses given function f to mock the AuthSvc.GetAccountByUserName method
+func (mmGetAccountByUserName *mAuthSvcMockGetAccountByUserName) Set(f func(ctx context.Context, username string) (acc models.Account, err error)) *AuthSvcMock {
+	if mmGetAccountByUserName.defaultExpectation != nil {
+		mmGetAccountByUserName.mock.t.Fatalf("Default expectation is already set for the AuthSvc.GetAccountByUserName method")
+	}
+
+	if len(mmGetAccountByUserName.expectations) > 0 {
+		mmGetAccountByUserName.mock.t.Fatalf("Some expectations are already set for the AuthSvc.GetAccountByUserName method")
+	}
+
+	mmGetAccountByUserName.mock.funcGetAccountByUserName = f
+	mmGetAccountByUserName.mock.funcGetAccountByUserNameOrigin = minimock.CallerInfo(1)
+	return mmGetAccountByUserName.mock
+}
+
+// When sets expectation for the AuthSvc.GetAccountByUserName which will trigger the result defined by the following
+// Then helper
+func (mmGetAccountByUserName *mAuthSvcMockGetAccountByUserName) When(ctx context.Context, username string) *AuthSvcMockGetAccountByUserNameExpectation {
+	if mmGetAccountByUserName.mock.funcGetAccountByUserName != nil {
+		mmGetAccountByUserName.mock.t.Fatalf("AuthSvcMock.GetAccountByUserName mock is already set by Set")
+	}
+
+	expectation := &AuthSvcMockGetAccountByUserNameExpectation{
+		mock:               mmGetAccountByUserName.mock,
+		params:             &AuthSvcMockGetAccountByUserNameParams{ctx, username},
+		expectationOrigins: AuthSvcMockGetAccountByUserNameExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetAccountByUserName.expectations = append(mmGetAccountByUserName.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthSvc.GetAccountByUserName return parameters for the expectation previously defined by the When method
+func (e *AuthSvcMockGetAccountByUserNameExpectation) Then(acc models.Account, err error) *AuthSvcMock {
+	e.results = &AuthSvcMockGetAccountByUserNameResults{acc, err}
+	return e.mock
+}
+
+// Times sets number of times AuthSvc.GetAccountByUserName should be invoked
+func (mmGetAccountByUserName *mAuthSvcMockGetAccountByUserName) Times(n uint64) *mAuthSvcMockGetAccountByUserName {
+	if n == 0 {
+		mmGetAccountByUserName.mock.t.Fatalf("Times of AuthSvcMock.GetAccountByUserName mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetAccountByUserName.expectedInvocations, n)
+	mmGetAccountByUserName.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetAccountByUserName
+}
+
+func (mmGetAccountByUserName *mAuthSvcMockGetAccountByUserName) invocationsDone() bool {
+	if len(mmGetAccountByUserName.expectations) == 0 && mmGetAccountByUserName.defaultExpectation == nil && mmGetAccountByUserName.mock.funcGetAccountByUserName == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetAccountByUserName.mock.afterGetAccountByUserNameCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetAccountByUserName.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetAccountByUserName implements mm_handler.AuthSvc
+func (mmGetAccountByUserName *AuthSvcMock) GetAccountByUserName(ctx context.Context, username string) (acc models.Account, err error) {
+	mm_atomic.AddUint64(&mmGetAccountByUserName.beforeGetAccountByUserNameCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetAccountByUserName.afterGetAccountByUserNameCounter, 1)
+
+	mmGetAccountByUserName.t.Helper()
+
+	if mmGetAccountByUserName.inspectFuncGetAccountByUserName != nil {
+		mmGetAccountByUserName.inspectFuncGetAccountByUserName(ctx, username)
+	}
+
+	mm_params := AuthSvcMockGetAccountByUserNameParams{ctx, username}
+
+	// Record call args
+	mmGetAccountByUserName.GetAccountByUserNameMock.mutex.Lock()
+	mmGetAccountByUserName.GetAccountByUserNameMock.callArgs = append(mmGetAccountByUserName.GetAccountByUserNameMock.callArgs, &mm_params)
+	mmGetAccountByUserName.GetAccountByUserNameMock.mutex.Unlock()
+
+	for _, e := range mmGetAccountByUserName.GetAccountByUserNameMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.acc, e.results.err
+		}
+	}
+
+	if mmGetAccountByUserName.GetAccountByUserNameMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetAccountByUserName.GetAccountByUserNameMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetAccountByUserName.GetAccountByUserNameMock.defaultExpectation.params
+		mm_want_ptrs := mmGetAccountByUserName.GetAccountByUserNameMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthSvcMockGetAccountByUserNameParams{ctx, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetAccountByUserName.t.Errorf("AuthSvcMock.GetAccountByUserName got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAccountByUserName.GetAccountByUserNameMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetAccountByUserName.t.Errorf("AuthSvcMock.GetAccountByUserName got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAccountByUserName.GetAccountByUserNameMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetAccountByUserName.t.Errorf("AuthSvcMock.GetAccountByUserName got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetAccountByUserName.GetAccountByUserNameMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetAccountByUserName.GetAccountByUserNameMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetAccountByUserName.t.Fatal("No results are set for the AuthSvcMock.GetAccountByUserName")
+		}
+		return (*mm_results).acc, (*mm_results).err
+	}
+	if mmGetAccountByUserName.funcGetAccountByUserName != nil {
+		return mmGetAccountByUserName.funcGetAccountByUserName(ctx, username)
+	}
+	mmGetAccountByUserName.t.Fatalf("Unexpected call to AuthSvcMock.GetAccountByUserName. %v %v", ctx, username)
+	return
+}
+
+// GetAccountByUserNameAfterCounter returns a count of finished AuthSvcMock.GetAccountByUserName invocations
+func (mmGetAccountByUserName *AuthSvcMock) GetAccountByUserNameAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAccountByUserName.afterGetAccountByUserNameCounter)
+}
+
+// GetAccountByUserNameBeforeCounter returns a count of AuthSvcMock.GetAccountByUserName invocations
+func (mmGetAccountByUserName *AuthSvcMock) GetAccountByUserNameBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAccountByUserName.beforeGetAccountByUserNameCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthSvcMock.GetAccountByUserName.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetAccountByUserName *mAuthSvcMockGetAccountByUserName) Calls() []*AuthSvcMockGetAccountByUserNameParams {
+	mmGetAccountByUserName.mutex.RLock()
+
+	argCopy := make([]*AuthSvcMockGetAccountByUserNameParams, len(mmGetAccountByUserName.callArgs))
+	copy(argCopy, mmGetAccountByUserName.callArgs)
+
+	mmGetAccountByUserName.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetAccountByUserNameDone returns true if the count of the GetAccountByUserName invocations corresponds
+// the number of defined expectations
+func (m *AuthSvcMock) MinimockGetAccountByUserNameDone() bool {
+	if m.GetAccountByUserNameMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetAccountByUserNameMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetAccountByUserNameMock.invocationsDone()
+}
+
+// MinimockGetAccountByUserNameInspect logs each unmet expectation
+func (m *AuthSvcMock) MinimockGetAccountByUserNameInspect() {
+	for _, e := range m.GetAccountByUserNameMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthSvcMock.GetAccountByUserName at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetAccountByUserNameCounter := mm_atomic.LoadUint64(&m.afterGetAccountByUserNameCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetAccountByUserNameMock.defaultExpectation != nil && afterGetAccountByUserNameCounter < 1 {
+		if m.GetAccountByUserNameMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthSvcMock.GetAccountByUserName at\n%s", m.GetAccountByUserNameMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthSvcMock.GetAccountByUserName at\n%s with params: %#v", m.GetAccountByUserNameMock.defaultExpectation.expectationOrigins.origin, *m.GetAccountByUserNameMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetAccountByUserName != nil && afterGetAccountByUserNameCounter < 1 {
+		m.t.Errorf("Expected call to AuthSvcMock.GetAccountByUserName at\n%s", m.funcGetAccountByUserNameOrigin)
+	}
+
+	if !m.GetAccountByUserNameMock.invocationsDone() && afterGetAccountByUserNameCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthSvcMock.GetAccountByUserName at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetAccountByUserNameMock.expectedInvocations), m.GetAccountByUserNameMock.expectedInvocationsOrigin, afterGetAccountByUserNameCounter)
+	}
+}
+
+type mAuthSvcMockGetChallenge struct {
+	optional           bool
+	mock               *AuthSvcMock
+	defaultExpectation *AuthSvcMockGetChallengeExpectation
+	expectations       []*AuthSvcMockGetChallengeExpectation
+
+	callArgs []*AuthSvcMockGetChallengeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthSvcMockGetChallengeExpectation specifies expectation struct of the AuthSvc.GetChallenge
+type AuthSvcMockGetChallengeExpectation struct {
+	mock               *AuthSvcMock
+	params             *AuthSvcMockGetChallengeParams
+	paramPtrs          *AuthSvcMockGetChallengeParamPtrs
+	expectationOrigins AuthSvcMockGetChallengeExpectationOrigins
+	results            *AuthSvcMockGetChallengeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthSvcMockGetChallengeParams contains parameters of the AuthSvc.GetChallenge
+type AuthSvcMockGetChallengeParams struct {
+	ctx     context.Context
+	profile models.Profile
+}
+
+// AuthSvcMockGetChallengeParamPtrs contains pointers to parameters of the AuthSvc.GetChallenge
+type AuthSvcMockGetChallengeParamPtrs struct {
+	ctx     *context.Context
+	profile *models.Profile
+}
+
+// AuthSvcMockGetChallengeResults contains results of the AuthSvc.GetChallenge
+type AuthSvcMockGetChallengeResults struct {
+	challenge string
+	err       error
+}
+
+// AuthSvcMockGetChallengeOrigins contains origins of expectations of the AuthSvc.GetChallenge
+type AuthSvcMockGetChallengeExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originProfile string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetChallenge *mAuthSvcMockGetChallenge) Optional() *mAuthSvcMockGetChallenge {
+	mmGetChallenge.optional = true
+	return mmGetChallenge
+}
+
+// Expect sets up expected params for AuthSvc.GetChallenge
+func (mmGetChallenge *mAuthSvcMockGetChallenge) Expect(ctx context.Context, profile models.Profile) *mAuthSvcMockGetChallenge {
+	if mmGetChallenge.mock.funcGetChallenge != nil {
+		mmGetChallenge.mock.t.Fatalf("AuthSvcMock.GetChallenge mock is already set by Set")
+	}
+
+	if mmGetChallenge.defaultExpectation == nil {
+		mmGetChallenge.defaultExpectation = &AuthSvcMockGetChallengeExpectation{}
+	}
+
+	if mmGetChallenge.defaultExpectation.paramPtrs != nil {
+		mmGetChallenge.mock.t.Fatalf("AuthSvcMock.GetChallenge mock is already set by ExpectParams functions")
+	}
+
+	mmGetChallenge.defaultExpectation.params = &AuthSvcMockGetChallengeParams{ctx, profile}
+	mmGetChallenge.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetChallenge.expectations {
+		if minimock.Equal(e.params, mmGetChallenge.defaultExpectation.params) {
+			mmGetChallenge.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetChallenge.defaultExpectation.params)
+		}
+	}
+
+	return mmGetChallenge
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthSvc.GetChallenge
+func (mmGetChallenge *mAuthSvcMockGetChallenge) ExpectCtxParam1(ctx context.Context) *mAuthSvcMockGetChallenge {
+	if mmGetChallenge.mock.funcGetChallenge != nil {
+		mmGetChallenge.mock.t.Fatalf("AuthSvcMock.GetChallenge mock is already set by Set")
+	}
+
+	if mmGetChallenge.defaultExpectation == nil {
+		mmGetChallenge.defaultExpectation = &AuthSvcMockGetChallengeExpectation{}
+	}
+
+	if mmGetChallenge.defaultExpectation.params != nil {
+		mmGetChallenge.mock.t.Fatalf("AuthSvcMock.GetChallenge mock is already set by Expect")
+	}
+
+	if mmGetChallenge.defaultExpectation.paramPtrs == nil {
+		mmGetChallenge.defaultExpectation.paramPtrs = &AuthSvcMockGetChallengeParamPtrs{}
+	}
+	mmGetChallenge.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetChallenge.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetChallenge
+}
+
+// ExpectProfileParam2 sets up expected param profile for AuthSvc.GetChallenge
+func (mmGetChallenge *mAuthSvcMockGetChallenge) ExpectProfileParam2(profile models.Profile) *mAuthSvcMockGetChallenge {
+	if mmGetChallenge.mock.funcGetChallenge != nil {
+		mmGetChallenge.mock.t.Fatalf("AuthSvcMock.GetChallenge mock is already set by Set")
+	}
+
+	if mmGetChallenge.defaultExpectation == nil {
+		mmGetChallenge.defaultExpectation = &AuthSvcMockGetChallengeExpectation{}
+	}
+
+	if mmGetChallenge.defaultExpectation.params != nil {
+		mmGetChallenge.mock.t.Fatalf("AuthSvcMock.GetChallenge mock is already set by Expect")
+	}
+
+	if mmGetChallenge.defaultExpectation.paramPtrs == nil {
+		mmGetChallenge.defaultExpectation.paramPtrs = &AuthSvcMockGetChallengeParamPtrs{}
+	}
+	mmGetChallenge.defaultExpectation.paramPtrs.profile = &profile
+	mmGetChallenge.defaultExpectation.expectationOrigins.originProfile = minimock.CallerInfo(1)
+
+	return mmGetChallenge
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthSvc.GetChallenge
+func (mmGetChallenge *mAuthSvcMockGetChallenge) Inspect(f func(ctx context.Context, profile models.Profile)) *mAuthSvcMockGetChallenge {
+	if mmGetChallenge.mock.inspectFuncGetChallenge != nil {
+		mmGetChallenge.mock.t.Fatalf("Inspect function is already set for AuthSvcMock.GetChallenge")
+	}
+
+	mmGetChallenge.mock.inspectFuncGetChallenge = f
+
+	return mmGetChallenge
+}
+
+// Return sets up results that will be returned by AuthSvc.GetChallenge
+func (mmGetChallenge *mAuthSvcMockGetChallenge) Return(challenge string, err error) *AuthSvcMock {
+	if mmGetChallenge.mock.funcGetChallenge != nil {
+		mmGetChallenge.mock.t.Fatalf("AuthSvcMock.GetChallenge mock is already set by Set")
+	}
+
+	if mmGetChallenge.defaultExpectation == nil {
+		mmGetChallenge.defaultExpectation = &AuthSvcMockGetChallengeExpectation{mock: mmGetChallenge.mock}
+	}
+	mmGetChallenge.defaultExpectation.results = &AuthSvcMockGetChallengeResults{challenge, err}
+	mmGetChallenge.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetChallenge.mock
+}
+
+// Set uses given function f to mock the AuthSvc.GetChallenge method
+func (mmGetChallenge *mAuthSvcMockGetChallenge) Set(f func(ctx context.Context, profile models.Profile) (challenge string, err error)) *AuthSvcMock {
+	if mmGetChallenge.defaultExpectation != nil {
+		mmGetChallenge.mock.t.Fatalf("Default expectation is already set for the AuthSvc.GetChallenge method")
+	}
+
+	if len(mmGetChallenge.expectations) > 0 {
+		mmGetChallenge.mock.t.Fatalf("Some expectations are already set for the AuthSvc.GetChallenge method")
+	}
+
+	mmGetChallenge.mock.funcGetChallenge = f
+	mmGetChallenge.mock.funcGetChallengeOrigin = minimock.CallerInfo(1)
+	return mmGetChallenge.mock
+}
+
+// When sets expectation for the AuthSvc.GetChallenge which will trigger the result defined by the following
+// Then helper
+func (mmGetChallenge *mAuthSvcMockGetChallenge) When(ctx context.Context, profile models.Profile) *AuthSvcMockGetChallengeExpectation {
+	if mmGetChallenge.mock.funcGetChallenge != nil {
+		mmGetChallenge.mock.t.Fatalf("AuthSvcMock.GetChallenge mock is already set by Set")
+	}
+
+	expectation := &AuthSvcMockGetChallengeExpectation{
+		mock:               mmGetChallenge.mock,
+		params:             &AuthSvcMockGetChallengeParams{ctx, profile},
+		expectationOrigins: AuthSvcMockGetChallengeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetChallenge.expectations = append(mmGetChallenge.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthSvc.GetChallenge return parameters for the expectation previously defined by the When method
+func (e *AuthSvcMockGetChallengeExpectation) Then(challenge string, err error) *AuthSvcMock {
+	e.results = &AuthSvcMockGetChallengeResults{challenge, err}
+	return e.mock
+}
+
+// Times sets number of times AuthSvc.GetChallenge should be invoked
+func (mmGetChallenge *mAuthSvcMockGetChallenge) Times(n uint64) *mAuthSvcMockGetChallenge {
+	if n == 0 {
+		mmGetChallenge.mock.t.Fatalf("Times of AuthSvcMock.GetChallenge mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetChallenge.expectedInvocations, n)
+	mmGetChallenge.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetChallenge
+}
+
+func (mmGetChallenge *mAuthSvcMockGetChallenge) invocationsDone() bool {
+	if len(mmGetChallenge.expectations) == 0 && mmGetChallenge.defaultExpectation == nil && mmGetChallenge.mock.funcGetChallenge == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetChallenge.mock.afterGetChallengeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetChallenge.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetChallenge implements mm_handler.AuthSvc
+func (mmGetChallenge *AuthSvcMock) GetChallenge(ctx context.Context, profile models.Profile) (challenge string, err error) {
+	mm_atomic.AddUint64(&mmGetChallenge.beforeGetChallengeCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetChallenge.afterGetChallengeCounter, 1)
+
+	mmGetChallenge.t.Helper()
+
+	if mmGetChallenge.inspectFuncGetChallenge != nil {
+		mmGetChallenge.inspectFuncGetChallenge(ctx, profile)
+	}
+
+	mm_params := AuthSvcMockGetChallengeParams{ctx, profile}
+
+	// Record call args
+	mmGetChallenge.GetChallengeMock.mutex.Lock()
+	mmGetChallenge.GetChallengeMock.callArgs = append(mmGetChallenge.GetChallengeMock.callArgs, &mm_params)
+	mmGetChallenge.GetChallengeMock.mutex.Unlock()
+
+	for _, e := range mmGetChallenge.GetChallengeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.challenge, e.results.err
+		}
+	}
+
+	if mmGetChallenge.GetChallengeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetChallenge.GetChallengeMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetChallenge.GetChallengeMock.defaultExpectation.params
+		mm_want_ptrs := mmGetChallenge.GetChallengeMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthSvcMockGetChallengeParams{ctx, profile}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetChallenge.t.Errorf("AuthSvcMock.GetChallenge got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetChallenge.GetChallengeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.profile != nil && !minimock.Equal(*mm_want_ptrs.profile, mm_got.profile) {
+				mmGetChallenge.t.Errorf("AuthSvcMock.GetChallenge got unexpected parameter profile, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetChallenge.GetChallengeMock.defaultExpectation.expectationOrigins.originProfile, *mm_want_ptrs.profile, mm_got.profile, minimock.Diff(*mm_want_ptrs.profile, mm_got.profile))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetChallenge.t.Errorf("AuthSvcMock.GetChallenge got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetChallenge.GetChallengeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetChallenge.GetChallengeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetChallenge.t.Fatal("No results are set for the AuthSvcMock.GetChallenge")
+		}
+		return (*mm_results).challenge, (*mm_results).err
+	}
+	if mmGetChallenge.funcGetChallenge != nil {
+		return mmGetChallenge.funcGetChallenge(ctx, profile)
+	}
+	mmGetChallenge.t.Fatalf("Unexpected call to AuthSvcMock.GetChallenge. %v %v", ctx, profile)
+	return
+}
+
+// GetChallengeAfterCounter returns a count of finished AuthSvcMock.GetChallenge invocations
+func (mmGetChallenge *AuthSvcMock) GetChallengeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetChallenge.afterGetChallengeCounter)
+}
+
+// GetChallengeBeforeCounter returns a count of AuthSvcMock.GetChallenge invocations
+func (mmGetChallenge *AuthSvcMock) GetChallengeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetChallenge.beforeGetChallengeCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthSvcMock.GetChallenge.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetChallenge *mAuthSvcMockGetChallenge) Calls() []*AuthSvcMockGetChallengeParams {
+	mmGetChallenge.mutex.RLock()
+
+	argCopy := make([]*AuthSvcMockGetChallengeParams, len(mmGetChallenge.callArgs))
+	copy(argCopy, mmGetChallenge.callArgs)
+
+	mmGetChallenge.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetChallengeDone returns true if the count of the GetChallenge invocations corresponds
+// the number of defined expectations
+func (m *AuthSvcMock) MinimockGetChallengeDone() bool {
+	if m.GetChallengeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetChallengeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetChallengeMock.invocationsDone()
+}
+
+// MinimockGetChallengeInspect logs each unmet expectation
+func (m *AuthSvcMock) MinimockGetChallengeInspect() {
+	for _, e := range m.GetChallengeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthSvcMock.GetChallenge at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetChallengeCounter := mm_atomic.LoadUint64(&m.afterGetChallengeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetChallengeMock.defaultExpectation != nil && afterGetChallengeCounter < 1 {
+		if m.GetChallengeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthSvcMock.GetChallenge at\n%s", m.GetChallengeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthSvcMock.GetChallenge at\n%s with params: %#v", m.GetChallengeMock.defaultExpectation.expectationOrigins.origin, *m.GetChallengeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetChallenge != nil && afterGetChallengeCounter < 1 {
+		m.t.Errorf("Expected call to AuthSvcMock.GetChallenge at\n%s", m.funcGetChallengeOrigin)
+	}
+
+	if !m.GetChallengeMock.invocationsDone() && afterGetChallengeCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthSvcMock.GetChallenge at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetChallengeMock.expectedInvocations), m.GetChallengeMock.expectedInvocationsOrigin, afterGetChallengeCounter)
+	}
+}
+
+type mAuthSvcMockGetDevices struct {
+	optional           bool
+	mock               *AuthSvcMock
+	defaultExpectation *AuthSvcMockGetDevicesExpectation
+	expectations       []*AuthSvcMockGetDevicesExpectation
+
+	callArgs []*AuthSvcMockGetDevicesParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthSvcMockGetDevicesExpectation specifies expectation struct of the AuthSvc.GetDevices
+type AuthSvcMockGetDevicesExpectation struct {
+	mock               *AuthSvcMock
+	params             *AuthSvcMockGetDevicesParams
+	paramPtrs          *AuthSvcMockGetDevicesParamPtrs
+	expectationOrigins AuthSvcMockGetDevicesExpectationOrigins
+	results            *AuthSvcMockGetDevicesResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthSvcMockGetDevicesParams contains parameters of the AuthSvc.GetDevices
+type AuthSvcMockGetDevicesParams struct {
+	ctx       context.Context
+	username  string
+	sessionID string
+	page      pagination.Request
+}
+
+// AuthSvcMockGetDevicesParamPtrs contains pointers to parameters of the AuthSvc.GetDevices
+type AuthSvcMockGetDevicesParamPtrs struct {
+	ctx       *context.Context
+	username  *string
+	sessionID *string
+	page      *pagination.Request
+}
+
+// AuthSvcMockGetDevicesResults contains results of the AuthSvc.GetDevices
+type AuthSvcMockGetDevicesResults struct {
+	devices []device.Device
+	next    string
+	err     error
+}
+
+// AuthSvcMockGetDevicesOrigins contains origins of expectations of the AuthSvc.GetDevices
+type AuthSvcMockGetDevicesExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUsername  string
+	originSessionID string
+	originPage      string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetDevices *mAuthSvcMockGetDevices) Optional() *mAuthSvcMockGetDevices {
+	mmGetDevices.optional = true
+	return mmGetDevices
+}
+
+// Expect sets up expected params for AuthSvc.GetDevices
+func (mmGetDevices *mAuthSvcMockGetDevices) Expect(ctx context.Context, username string, sessionID string, page pagination.Request) *mAuthSvcMockGetDevices {
+	if mmGetDevices.mock.funcGetDevices != nil {
+		mmGetDevices.mock.t.Fatalf("AuthSvcMock.GetDevices mock is already set by Set")
+	}
+
+	if mmGetDevices.defaultExpectation == nil {
+		mmGetDevices.defaultExpectation = &AuthSvcMockGetDevicesExpectation{}
+	}
+
+	if mmGetDevices.defaultExpectation.paramPtrs != nil {
+		mmGetDevices.mock.t.Fatalf("AuthSvcMock.GetDevices mock is already set by ExpectParams functions")
+	}
+
+	mmGetDevices.defaultExpectation.params = &AuthSvcMockGetDevicesParams{ctx, username, sessionID, page}
+	mmGetDevices.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetDevices.expectations {
+		if minimock.Equal(e.params, mmGetDevices.defaultExpectation.params) {
+			mmGetDevices.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetDevices.defaultExpectation.params)
+		}
+	}
+
+	return mmGetDevices
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthSvc.GetDevices
+func (mmGetDevices *mAuthSvcMockGetDevices) ExpectCtxParam1(ctx context.Context) *mAuthSvcMockGetDevices {
+	if mmGetDevices.mock.funcGetDevices != nil {
+		mmGetDevices.mock.t.Fatalf("AuthSvcMock.GetDevices mock is already set by Set")
+	}
+
+	if mmGetDevices.defaultExpectation == nil {
+		mmGetDevices.defaultExpectation = &AuthSvcMockGetDevicesExpectation{}
+	}
+
+	if mmGetDevices.defaultExpectation.params != nil {
+		mmGetDevices.mock.t.Fatalf("AuthSvcMock.GetDevices mock is already set by Expect")
+	}
+
+	if mmGetDevices.defaultExpectation.paramPtrs == nil {
+		mmGetDevices.defaultExpectation.paramPtrs = &AuthSvcMockGetDevicesParamPtrs{}
+	}
+	mmGetDevices.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetDevices.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetDevices
+}
+
+// ExpectUsernameParam2 sets up expected param username for AuthSvc.GetDevices
+func (mmGetDevices *mAuthSvcMockGetDevices) ExpectUsernameParam2(username string) *mAuthSvcMockGetDevices {
+	if mmGetDevices.mock.funcGetDevices != nil {
+		mmGetDevices.mock.t.Fatalf("AuthSvcMock.GetDevices mock is already set by Set")
+	}
+
+	if mmGetDevices.defaultExpectation == nil {
+		mmGetDevices.defaultExpectation = &AuthSvcMockGetDevicesExpectation{}
+	}
+
+	if mmGetDevices.defaultExpectation.params != nil {
+		mmGetDevices.mock.t.Fatalf("AuthSvcMock.GetDevices mock is already set by Expect")
+	}
+
+	if mmGetDevices.defaultExpectation.paramPtrs == nil {
+		mmGetDevices.defaultExpectation.paramPtrs = &AuthSvcMockGetDevicesParamPtrs{}
+	}
+	mmGetDevices.defaultExpectation.paramPtrs.username = &username
+	mmGetDevices.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetDevices
+}
+
+// ExpectSessionIDParam3 sets up expected param sessionID for AuthSvc.GetDevices
+func (mmGetDevices *mAuthSvcMockGetDevices) ExpectSessionIDParam3(sessionID string) *mAuthSvcMockGetDevices {
+	if mmGetDevices.mock.funcGetDevices != nil {
+		mmGetDevices.mock.t.Fatalf("AuthSvcMock.GetDevices mock is already set by Set")
+	}
+
+	if mmGetDevices.defaultExpectation == nil {
+		mmGetDevices.defaultExpectation = &AuthSvcMockGetDevicesExpectation{}
+	}
+
+	if mmGetDevices.defaultExpectation.params != nil {
+		mmGetDevices.mock.t.Fatalf("AuthSvcMock.GetDevices mock is already set by Expect")
+	}
+
+	if mmGetDevices.defaultExpectation.paramPtrs == nil {
+		mmGetDevices.defaultExpectation.paramPtrs = &AuthSvcMockGetDevicesParamPtrs{}
+	}
+	mmGetDevices.defaultExpectation.paramPtrs.sessionID = &sessionID
+	mmGetDevices.defaultExpectation.expectationOrigins.originSessionID = minimock.CallerInfo(1)
+
+	return mmGetDevices
+}
+
+// ExpectPageParam4 sets up expected param page for AuthSvc.GetDevices
+func (mmGetDevices *mAuthSvcMockGetDevices) ExpectPageParam4(page pagination.Request) *mAuthSvcMockGetDevices {
+	if mmGetDevices.mock.funcGetDevices != nil {
+		mmGetDevices.mock.t.Fatalf("AuthSvcMock.GetDevices mock is already set by Set")
+	}
+
+	if mmGetDevices.defaultExpectation == nil {
+		mmGetDevices.defaultExpectation = &AuthSvcMockGetDevicesExpectation{}
+	}
+
+	if mmGetDevices.defaultExpectation.params != nil {
+		mmGetDevices.mock.t.Fatalf("AuthSvcMock.GetDevices mock is already set by Expect")
+	}
+
+	if mmGetDevices.defaultExpectation.paramPtrs == nil {
+		mmGetDevices.defaultExpectation.paramPtrs = &AuthSvcMockGetDevicesParamPtrs{}
+	}
+	mmGetDevices.defaultExpectation.paramPtrs.page = &page
+	mmGetDevices.defaultExpectation.expectationOrigins.originPage = minimock.CallerInfo(1)
+
+	return mmGetDevices
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthSvc.GetDevices
+func (mmGetDevices *mAuthSvcMockGetDevices) Inspect(f func(ctx context.Context, username string, sessionID string, page pagination.Request)) *mAuthSvcMockGetDevices {
+	if mmGetDevices.mock.inspectFuncGetDevices != nil {
+		mmGetDevices.mock.t.Fatalf("Inspect function is already set for AuthSvcMock.GetDevices")
+	}
+
+	mmGetDevices.mock.inspectFuncGetDevices = f
+
+	return mmGetDevices
+}
+
+// Return sets up results that will be returned by AuthSvc.GetDevices
+func (mmGetDevices *mAuthSvcMockGetDevices) Return(devices []device.Device, next string, err error) *AuthSvcMock {
+	if mmGetDevices.mock.funcGetDevices != nil {
+		mmGetDevices.mock.t.Fatalf("AuthSvcMock.GetDevices mock is already set by Set")
+	}
+
+	if mmGetDevices.defaultExpectation == nil {
+		mmGetDevices.defaultExpectation = &AuthSvcMockGetDevicesExpectation{mock: mmGetDevices.mock}
+	}
+	mmGetDevices.defaultExpectation.results = &AuthSvcMockGetDevicesResults{devices, next, err}
+	mmGetDevices.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetDevices.mock
+}
+
+// Set uses given function f to mock the AuthSvc.GetDevices method
+func (mmGetDevices *mAuthSvcMockGetDevices) Set(f func(ctx context.Context, username string, sessionID string, page pagination.Request) (devices []device.Device, next string, err error)) *AuthSvcMock {
+	if mmGetDevices.defaultExpectation != nil {
+		mmGetDevices.mock.t.Fatalf("Default expectation is already set for the AuthSvc.GetDevices method")
+	}
+
+	if len(mmGetDevices.expectations) > 0 {
+		mmGetDevices.mock.t.Fatalf("Some expectations are already set for the AuthSvc.GetDevices method")
+	}
+
+	mmGetDevices.mock.funcGetDevices = f
+	mmGetDevices.mock.funcGetDevicesOrigin = minimock.CallerInfo(1)
+	return mmGetDevices.mock
+}
+
+// When sets expectation for the AuthSvc.GetDevices which will trigger the result defined by the following
+// Then helper
+func (mmGetDevices *mAuthSvcMockGetDevices) When(ctx context.Context, username string, sessionID string, page pagination.Request) *AuthSvcMockGetDevicesExpectation {
+	if mmGetDevices.mock.funcGetDevices != nil {
+		mmGetDevices.mock.t.Fatalf("AuthSvcMock.GetDevices mock is already set by Set")
+	}
+
+	expectation := &AuthSvcMockGetDevicesExpectation{
+		mock:               mmGetDevices.mock,
+		params:             &AuthSvcMockGetDevicesParams{ctx, username, sessionID, page},
+		expectationOrigins: AuthSvcMockGetDevicesExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetDevices.expectations = append(mmGetDevices.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthSvc.GetDevices return parameters for the expectation previously defined by the When method
+func (e *AuthSvcMockGetDevicesExpectation) Then(devices []device.Device, next string, err error) *AuthSvcMock {
+	e.results = &AuthSvcMockGetDevicesResults{devices, next, err}
+	return e.mock
+}
+
+// Times sets number of times AuthSvc.GetDevices should be invoked
+func (mmGetDevices *mAuthSvcMockGetDevices) Times(n uint64) *mAuthSvcMockGetDevices {
+	if n == 0 {
+		mmGetDevices.mock.t.Fatalf("Times of AuthSvcMock.GetDevices mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetDevices.expectedInvocations, n)
+	mmGetDevices.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetDevices
+}
+
+func (mmGetDevices *mAuthSvcMockGetDevices) invocationsDone() bool {
+	if len(mmGetDevices.expectations) == 0 && mmGetDevices.defaultExpectation == nil && mmGetDevices.mock.funcGetDevices == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetDevices.mock.afterGetDevicesCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetDevices.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetDevices implements mm_handler.AuthSvc
+func (mmGetDevices *AuthSvcMock) GetDevices(ctx context.Context, username string, sessionID string, page pagination.Request) (devices []device.Device, next string, err error) {
+	mm_atomic.AddUint64(&mmGetDevices.beforeGetDevicesCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetDevices.afterGetDevicesCounter, 1)
+
+	mmGetDevices.t.Helper()
+
+	if mmGetDevices.inspectFuncGetDevices != nil {
+		mmGetDevices.inspectFuncGetDevices(ctx, username, sessionID, page)
+	}
+
+	mm_params := AuthSvcMockGetDevicesParams{ctx, username, sessionID, page}
+
+	// Record call args
+	mmGetDevices.GetDevicesMock.mutex.Lock()
+	mmGetDevices.GetDevicesMock.callArgs = append(mmGetDevices.GetDevicesMock.callArgs, &mm_params)
+	mmGetDevices.GetDevicesMock.mutex.Unlock()
+
+	for _, e := range mmGetDevices.GetDevicesMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.devices, e.results.next, e.results.err
+		}
+	}
+
+	if mmGetDevices.GetDevicesMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetDevices.GetDevicesMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetDevices.GetDevicesMock.defaultExpectation.params
+		mm_want_ptrs := mmGetDevices.GetDevicesMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthSvcMockGetDevicesParams{ctx, username, sessionID, page}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetDevices.t.Errorf("AuthSvcMock.GetDevices got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDevices.GetDevicesMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetDevices.t.Errorf("AuthSvcMock.GetDevices got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDevices.GetDevicesMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.sessionID != nil && !minimock.Equal(*mm_want_ptrs.sessionID, mm_got.sessionID) {
+				mmGetDevices.t.Errorf("AuthSvcMock.GetDevices got unexpected parameter sessionID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDevices.GetDevicesMock.defaultExpectation.expectationOrigins.originSessionID, *mm_want_ptrs.sessionID, mm_got.sessionID, minimock.Diff(*mm_want_ptrs.sessionID, mm_got.sessionID))
+			}
+
+			if mm_want_ptrs.page != nil && !minimock.Equal(*mm_want_ptrs.page, mm_got.page) {
+				mmGetDevices.t.Errorf("AuthSvcMock.GetDevices got unexpected parameter page, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetDevices.GetDevicesMock.defaultExpectation.expectationOrigins.originPage, *mm_want_ptrs.page, mm_got.page, minimock.Diff(*mm_want_ptrs.page, mm_got.page))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetDevices.t.Errorf("AuthSvcMock.GetDevices got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetDevices.GetDevicesMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetDevices.GetDevicesMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetDevices.t.Fatal("No results are set for the AuthSvcMock.GetDevices")
+		}
+		return (*mm_results).devices, (*mm_results).next, (*mm_results).err
+	}
+	if mmGetDevices.funcGetDevices != nil {
+		return mmGetDevices.funcGetDevices(ctx, username, sessionID, page)
+	}
+	mmGetDevices.t.Fatalf("Unexpected call to AuthSvcMock.GetDevices. %v %v %v %v", ctx, username, sessionID, page)
+	return
+}
+
+// GetDevicesAfterCounter returns a count of finished AuthSvcMock.GetDevices invocations
+func (mmGetDevices *AuthSvcMock) GetDevicesAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDevices.afterGetDevicesCounter)
+}
+
+// GetDevicesBeforeCounter returns a count of AuthSvcMock.GetDevices invocations
+func (mmGetDevices *AuthSvcMock) GetDevicesBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetDevices.beforeGetDevicesCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthSvcMock.GetDevices.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetDevices *mAuthSvcMockGetDevices) Calls() []*AuthSvcMockGetDevicesParams {
+	mmGetDevices.mutex.RLock()
+
+	argCopy := make([]*AuthSvcMockGetDevicesParams, len(mmGetDevices.callArgs))
+	copy(argCopy, mmGetDevices.callArgs)
+
+	mmGetDevices.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetDevicesDone returns true if the count of the GetDevices invocations corresponds
+// the number of defined expectations
+func (m *AuthSvcMock) MinimockGetDevicesDone() bool {
+	if m.GetDevicesMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetDevicesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetDevicesMock.invocationsDone()
+}
+
+// MinimockGetDevicesInspect logs each unmet expectation
+func (m *AuthSvcMock) MinimockGetDevicesInspect() {
+	for _, e := range m.GetDevicesMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthSvcMock.GetDevices at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetDevicesCounter := mm_atomic.LoadUint64(&m.afterGetDevicesCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetDevicesMock.defaultExpectation != nil && afterGetDevicesCounter < 1 {
+		if m.GetDevicesMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthSvcMock.GetDevices at\n%s", m.GetDevicesMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthSvcMock.GetDevices at\n%s with params: %#v", m.GetDevicesMock.defaultExpectation.expectationOrigins.origin, *m.GetDevicesMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetDevices != nil && afterGetDevicesCounter < 1 {
+		m.t.Errorf("Expected call to AuthSvcMock.GetDevices at\n%s", m.funcGetDevicesOrigin)
+	}
+
+	if !m.GetDevicesMock.invocationsDone() && afterGetDevicesCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthSvcMock.GetDevices at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetDevicesMock.expectedInvocations), m.GetDevicesMock.expectedInvocationsOrigin, afterGetDevicesCounter)
+	}
+}
+
+type mAuthSvcMockGetJWKS struct {
+	optional           bool
+	mock               *AuthSvcMock
+	defaultExpectation *AuthSvcMockGetJWKSExpectation
+	expectations       []*AuthSvcMockGetJWKSExpectation
+
+	callArgs []*AuthSvcMockGetJWKSParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthSvcMockGetJWKSExpectation specifies expectation struct of the AuthSvc.GetJWKS
+type AuthSvcMockGetJWKSExpectation struct {
+	mock               *AuthSvcMock
+	params             *AuthSvcMockGetJWKSParams
+	paramPtrs          *AuthSvcMockGetJWKSParamPtrs
+	expectationOrigins AuthSvcMockGetJWKSExpectationOrigins
+	results            *AuthSvcMockGetJWKSResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthSvcMockGetJWKSParams contains parameters of the AuthSvc.GetJWKS
+type AuthSvcMockGetJWKSParams struct {
+	ctx context.Context
+}
+
+// AuthSvcMockGetJWKSParamPtrs contains pointers to parameters of the AuthSvc.GetJWKS
+type AuthSvcMockGetJWKSParamPtrs struct {
+	ctx *context.Context
+}
+
+// AuthSvcMockGetJWKSResults contains results of the AuthSvc.GetJWKS
+type AuthSvcMockGetJWKSResults struct {
+	jwks keyring.JWKS
+	err  error
+}
+
+// AuthSvcMockGetJWKSOrigins contains origins of expectations of the AuthSvc.GetJWKS
+type AuthSvcMockGetJWKSExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetJWKS *mAuthSvcMockGetJWKS) Optional() *mAuthSvcMockGetJWKS {
+	mmGetJWKS.optional = true
+	return mmGetJWKS
+}
+
+// Expect sets up expected params for AuthSvc.GetJWKS
+func (mmGetJWKS *mAuthSvcMockGetJWKS) Expect(ctx context.Context) *mAuthSvcMockGetJWKS {
+	if mmGetJWKS.mock.funcGetJWKS != nil {
+		mmGetJWKS.mock.t.Fatalf("AuthSvcMock.GetJWKS mock is already set by Set")
+	}
+
+	if mmGetJWKS.defaultExpectation == nil {
+		mmGetJWKS.defaultExpectation = &AuthSvcMockGetJWKSExpectation{}
+	}
+
+	if mmGetJWKS.defaultExpectation.paramPtrs != nil {
+		mmGetJWKS.mock.t.Fatalf("AuthSvcMock.GetJWKS mock is already set by ExpectParams functions")
+	}
+
+	mmGetJWKS.defaultExpectation.params = &AuthSvcMockGetJWKSParams{ctx}
+	mmGetJWKS.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetJWKS.expectations {
+		if minimock.Equal(e.params, mmGetJWKS.defaultExpectation.params) {
+			mmGetJWKS.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetJWKS.defaultExpectation.params)
+		}
+	}
+
+	return mmGetJWKS
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthSvc.GetJWKS
+func (mmGetJWKS *mAuthSvcMockGetJWKS) ExpectCtxParam1(ctx context.Context) *mAuthSvcMockGetJWKS {
+	if mmGetJWKS.mock.funcGetJWKS != nil {
+		mmGetJWKS.mock.t.Fatalf("AuthSvcMock.GetJWKS mock is already set by Set")
+	}
+
+	if mmGetJWKS.defaultExpectation == nil {
+		mmGetJWKS.defaultExpectation = &AuthSvcMockGetJWKSExpectation{}
+	}
+
+	if mmGetJWKS.defaultExpectation.params != nil {
+		mmGetJWKS.mock.t.Fatalf("AuthSvcMock.GetJWKS mock is already set by Expect")
+	}
+
+	if mmGetJWKS.defaultExpectation.paramPtrs == nil {
+		mmGetJWKS.defaultExpectation.paramPtrs = &AuthSvcMockGetJWKSParamPtrs{}
+	}
+	mmGetJWKS.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetJWKS.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetJWKS
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthSvc.GetJWKS
+func (mmGetJWKS *mAuthSvcMockGetJWKS) Inspect(f func(ctx context.Context)) *mAuthSvcMockGetJWKS {
+	if mmGetJWKS.mock.inspectFuncGetJWKS != nil {
+		mmGetJWKS.mock.t.Fatalf("Inspect function is already set for AuthSvcMock.GetJWKS")
+	}
+
+	mmGetJWKS.mock.inspectFuncGetJWKS = f
+
+	return mmGetJWKS
+}
+
+// Return sets up results that will be returned by AuthSvc.GetJWKS
+func (mmGetJWKS *mAuthSvcMockGetJWKS) Return(jwks keyring.JWKS, err error) *AuthSvcMock {
+	if mmGetJWKS.mock.funcGetJWKS != nil {
+		mmGetJWKS.mock.t.Fatalf("AuthSvcMock.GetJWKS mock is already set by Set")
+	}
+
+	if mmGetJWKS.defaultExpectation == nil {
+		mmGetJWKS.defaultExpectation = &AuthSvcMockGetJWKSExpectation{mock: mmGetJWKS.mock}
+	}
+	mmGetJWKS.defaultExpectation.results = &AuthSvcMockGetJWKSResults{jwks, err}
+	mmGetJWKS.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetJWKS.mock
+}
+
+// Set uses given function f to mock the AuthSvc.GetJWKS method
+func (mmGetJWKS *mAuthSvcMockGetJWKS) Set(f func(ctx context.Context) (jwks keyring.JWKS, err error)) *AuthSvcMock {
+	if mmGetJWKS.defaultExpectation != nil {
+		mmGetJWKS.mock.t.Fatalf("Default expectation is already set for the AuthSvc.GetJWKS method")
+	}
+
+	if len(mmGetJWKS.expectations) > 0 {
+		mmGetJWKS.mock.t.Fatalf("Some expectations are already set for the AuthSvc.GetJWKS method")
+	}
+
+	mmGetJWKS.mock.funcGetJWKS = f
+	mmGetJWKS.mock.funcGetJWKSOrigin = minimock.CallerInfo(1)
+	return mmGetJWKS.mock
+}
+
+// When sets expectation for the AuthSvc.GetJWKS which will trigger the result defined by the following
+// Then helper
+func (mmGetJWKS *mAuthSvcMockGetJWKS) When(ctx context.Context) *AuthSvcMockGetJWKSExpectation {
+	if mmGetJWKS.mock.funcGetJWKS != nil {
+		mmGetJWKS.mock.t.Fatalf("AuthSvcMock.GetJWKS mock is already set by Set")
+	}
+
+	expectation := &AuthSvcMockGetJWKSExpectation{
+		mock:               mmGetJWKS.mock,
+		params:             &AuthSvcMockGetJWKSParams{ctx},
+		expectationOrigins: AuthSvcMockGetJWKSExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetJWKS.expectations = append(mmGetJWKS.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthSvc.GetJWKS return parameters for the expectation previously defined by the When method
+func (e *AuthSvcMockGetJWKSExpectation) Then(jwks keyring.JWKS, err error) *AuthSvcMock {
+	e.results = &AuthSvcMockGetJWKSResults{jwks, err}
+	return e.mock
+}
+
+// Times sets number of times AuthSvc.GetJWKS should be invoked
+func (mmGetJWKS *mAuthSvcMockGetJWKS) Times(n uint64) *mAuthSvcMockGetJWKS {
+	if n == 0 {
+		mmGetJWKS.mock.t.Fatalf("Times of AuthSvcMock.GetJWKS mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetJWKS.expectedInvocations, n)
+	mmGetJWKS.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetJWKS
+}
+
+func (mmGetJWKS *mAuthSvcMockGetJWKS) invocationsDone() bool {
+	if len(mmGetJWKS.expectations) == 0 && mmGetJWKS.defaultExpectation == nil && mmGetJWKS.mock.funcGetJWKS == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetJWKS.mock.afterGetJWKSCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetJWKS.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetJWKS implements mm_handler.AuthSvc
+func (mmGetJWKS *AuthSvcMock) GetJWKS(ctx context.Context) (jwks keyring.JWKS, err error) {
+	mm_atomic.AddUint64(&mmGetJWKS.beforeGetJWKSCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetJWKS.afterGetJWKSCounter, 1)
+
+	mmGetJWKS.t.Helper()
+
+	if mmGetJWKS.inspectFuncGetJWKS != nil {
+		mmGetJWKS.inspectFuncGetJWKS(ctx)
+	}
+
+	mm_params := AuthSvcMockGetJWKSParams{ctx}
+
+	// Record call args
+	mmGetJWKS.GetJWKSMock.mutex.Lock()
+	mmGetJWKS.GetJWKSMock.callArgs = append(mmGetJWKS.GetJWKSMock.callArgs, &mm_params)
+	mmGetJWKS.GetJWKSMock.mutex.Unlock()
+
+	for _, e := range mmGetJWKS.GetJWKSMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.jwks, e.results.err
+		}
+	}
+
+	if mmGetJWKS.GetJWKSMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetJWKS.GetJWKSMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetJWKS.GetJWKSMock.defaultExpectation.params
+		mm_want_ptrs := mmGetJWKS.GetJWKSMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthSvcMockGetJWKSParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetJWKS.t.Errorf("AuthSvcMock.GetJWKS got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetJWKS.GetJWKSMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetJWKS.t.Errorf("AuthSvcMock.GetJWKS got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetJWKS.GetJWKSMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetJWKS.GetJWKSMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetJWKS.t.Fatal("No results are set for the AuthSvcMock.GetJWKS")
+		}
+		return (*mm_results).jwks, (*mm_results).err
+	}
+	if mmGetJWKS.funcGetJWKS != nil {
+		return mmGetJWKS.funcGetJWKS(ctx)
+	}
+	mmGetJWKS.t.Fatalf("Unexpected call to AuthSvcMock.GetJWKS. %v", ctx)
+	return
+}
+
+// GetJWKSAfterCounter returns a count of finished AuthSvcMock.GetJWKS invocations
+func (mmGetJWKS *AuthSvcMock) GetJWKSAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetJWKS.afterGetJWKSCounter)
+}
+
+// GetJWKSBeforeCounter returns a count of AuthSvcMock.GetJWKS invocations
+func (mmGetJWKS *AuthSvcMock) GetJWKSBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetJWKS.beforeGetJWKSCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthSvcMock.GetJWKS.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetJWKS *mAuthSvcMockGetJWKS) Calls() []*AuthSvcMockGetJWKSParams {
+	mmGetJWKS.mutex.RLock()
+
+	argCopy := make([]*AuthSvcMockGetJWKSParams, len(mmGetJWKS.callArgs))
+	copy(argCopy, mmGetJWKS.callArgs)
+
+	mmGetJWKS.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetJWKSDone returns true if the count of the GetJWKS invocations corresponds
+// the number of defined expectations
+func (m *AuthSvcMock) MinimockGetJWKSDone() bool {
+	if m.GetJWKSMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetJWKSMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetJWKSMock.invocationsDone()
+}
+
+// MinimockGetJWKSInspect logs each unmet expectation
+func (m *AuthSvcMock) MinimockGetJWKSInspect() {
+	for _, e := range m.GetJWKSMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthSvcMock.GetJWKS at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetJWKSCounter := mm_atomic.LoadUint64(&m.afterGetJWKSCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetJWKSMock.defaultExpectation != nil && afterGetJWKSCounter < 1 {
+		if m.GetJWKSMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthSvcMock.GetJWKS at\n%s", m.GetJWKSMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthSvcMock.GetJWKS at\n%s with params: %#v", m.GetJWKSMock.defaultExpectation.expectationOrigins.origin, *m.GetJWKSMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetJWKS != nil && afterGetJWKSCounter < 1 {
+		m.t.Errorf("Expected call to AuthSvcMock.GetJWKS at\n%s", m.funcGetJWKSOrigin)
+	}
+
+	if !m.GetJWKSMock.invocationsDone() && afterGetJWKSCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthSvcMock.GetJWKS at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetJWKSMock.expectedInvocations), m.GetJWKSMock.expectedInvocationsOrigin, afterGetJWKSCounter)
+	}
+}
+
+type mAuthSvcMockGetRecoveryKit struct {
+	optional           bool
+	mock               *AuthSvcMock
+	defaultExpectation *AuthSvcMockGetRecoveryKitExpectation
+	expectations       []*AuthSvcMockGetRecoveryKitExpectation
+
+	callArgs []*AuthSvcMockGetRecoveryKitParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthSvcMockGetRecoveryKitExpectation specifies expectation struct of the AuthSvc.GetRecoveryKit
+type AuthSvcMockGetRecoveryKitExpectation struct {
+	mock               *AuthSvcMock
+	params             *AuthSvcMockGetRecoveryKitParams
+	paramPtrs          *AuthSvcMockGetRecoveryKitParamPtrs
+	expectationOrigins AuthSvcMockGetRecoveryKitExpectationOrigins
+	results            *AuthSvcMockGetRecoveryKitResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthSvcMockGetRecoveryKitParams contains parameters of the AuthSvc.GetRecoveryKit
+type AuthSvcMockGetRecoveryKitParams struct {
+	ctx      context.Context
+	username string
+}
+
+// AuthSvcMockGetRecoveryKitParamPtrs contains pointers to parameters of the AuthSvc.GetRecoveryKit
+type AuthSvcMockGetRecoveryKitParamPtrs struct {
+	ctx      *context.Context
+	username *string
+}
+
+// AuthSvcMockGetRecoveryKitResults contains results of the AuthSvc.GetRecoveryKit
+type AuthSvcMockGetRecoveryKitResults struct {
+	kit recovery.Kit
+	err error
+}
+
+// AuthSvcMockGetRecoveryKitOrigins contains origins of expectations of the AuthSvc.GetRecoveryKit
+type AuthSvcMockGetRecoveryKitExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetRecoveryKit *mAuthSvcMockGetRecoveryKit) Optional() *mAuthSvcMockGetRecoveryKit {
+	mmGetRecoveryKit.optional = true
+	return mmGetRecoveryKit
+}
+
+// Expect sets up expected params for AuthSvc.GetRecoveryKit
+func (mmGetRecoveryKit *mAuthSvcMockGetRecoveryKit) Expect(ctx context.Context, username string) *mAuthSvcMockGetRecoveryKit {
+	if mmGetRecoveryKit.mock.funcGetRecoveryKit != nil {
+		mmGetRecoveryKit.mock.t.Fatalf("AuthSvcMock.GetRecoveryKit mock is already set by Set")
+	}
+
+	if mmGetRecoveryKit.defaultExpectation == nil {
+		mmGetRecoveryKit.defaultExpectation = &AuthSvcMockGetRecoveryKitExpectation{}
+	}
+
+	if mmGetRecoveryKit.defaultExpectation.paramPtrs != nil {
+		mmGetRecoveryKit.mock.t.Fatalf("AuthSvcMock.GetRecoveryKit mock is already set by ExpectParams functions")
+	}
+
+	mmGetRecoveryKit.defaultExpectation.params = &AuthSvcMockGetRecoveryKitParams{ctx, username}
+	mmGetRecoveryKit.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetRecoveryKit.expectations {
+		if minimock.Equal(e.params, mmGetRecoveryKit.defaultExpectation.params) {
+			mmGetRecoveryKit.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRecoveryKit.defaultExpectation.params)
+		}
+	}
+
+	return mmGetRecoveryKit
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthSvc.GetRecoveryKit
+func (mmGetRecoveryKit *mAuthSvcMockGetRecoveryKit) ExpectCtxParam1(ctx context.Context) *mAuthSvcMockGetRecoveryKit {
+	if mmGetRecoveryKit.mock.funcGetRecoveryKit != nil {
+		mmGetRecoveryKit.mock.t.Fatalf("AuthSvcMock.GetRecoveryKit mock is already set by Set")
+	}
+
+	if mmGetRecoveryKit.defaultExpectation == nil {
+		mmGetRecoveryKit.defaultExpectation = &AuthSvcMockGetRecoveryKitExpectation{}
+	}
+
+	if mmGetRecoveryKit.defaultExpectation.params != nil {
+		mmGetRecoveryKit.mock.t.Fatalf("AuthSvcMock.GetRecoveryKit mock is already set by Expect")
+	}
+
+	if mmGetRecoveryKit.defaultExpectation.paramPtrs == nil {
+		mmGetRecoveryKit.defaultExpectation.paramPtrs = &AuthSvcMockGetRecoveryKitParamPtrs{}
+	}
+	mmGetRecoveryKit.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetRecoveryKit.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetRecoveryKit
+}
+
+// ExpectUsernameParam2 sets up expected param username for AuthSvc.GetRecoveryKit
+func (mmGetRecoveryKit *mAuthSvcMockGetRecoveryKit) ExpectUsernameParam2(username string) *mAuthSvcMockGetRecoveryKit {
+	if mmGetRecoveryKit.mock.funcGetRecoveryKit != nil {
+		mmGetRecoveryKit.mock.t.Fatalf("AuthSvcMock.GetRecoveryKit mock is already set by Set")
+	}
+
+	if mmGetRecoveryKit.defaultExpectation == nil {
+		mmGetRecoveryKit.defaultExpectation = &AuthSvcMockGetRecoveryKitExpectation{}
+	}
+
+	if mmGetRecoveryKit.defaultExpectation.params != nil {
+		mmGetRecoveryKit.mock.t.Fatalf("AuthSvcMock.GetRecoveryKit mock is already set by Expect")
+	}
+
+	if mmGetRecoveryKit.defaultExpectation.paramPtrs == nil {
+		mmGetRecoveryKit.defaultExpectation.paramPtrs = &AuthSvcMockGetRecoveryKitParamPtrs{}
+	}
+	mmGetRecoveryKit.defaultExpectation.paramPtrs.username = &username
+	mmGetRecoveryKit.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetRecoveryKit
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthSvc.GetRecoveryKit
+func (mmGetRecoveryKit *mAuthSvcMockGetRecoveryKit) Inspect(f func(ctx context.Context, username string)) *mAuthSvcMockGetRecoveryKit {
+	if mmGetRecoveryKit.mock.inspectFuncGetRecoveryKit != nil {
+		mmGetRecoveryKit.mock.t.Fatalf("Inspect function is already set for AuthSvcMock.GetRecoveryKit")
+	}
+
+	mmGetRecoveryKit.mock.inspectFuncGetRecoveryKit = f
+
+	return mmGetRecoveryKit
+}
+
+// Return sets up results that will be returned by AuthSvc.GetRecoveryKit
+func (mmGetRecoveryKit *mAuthSvcMockGetRecoveryKit) Return(kit recovery.Kit, err error) *AuthSvcMock {
+	if mmGetRecoveryKit.mock.funcGetRecoveryKit != nil {
+		mmGetRecoveryKit.mock.t.Fatalf("AuthSvcMock.GetRecoveryKit mock is already set by Set")
+	}
+
+	if mmGetRecoveryKit.defaultExpectation == nil {
+		mmGetRecoveryKit.defaultExpectation = &AuthSvcMockGetRecoveryKitExpectation{mock: mmGetRecoveryKit.mock}
+	}
+	mmGetRecoveryKit.defaultExpectation.results = &AuthSvcMockGetRecoveryKitResults{kit, err}
+	mmGetRecoveryKit.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetRecoveryKit.mock
+}
+
+// Set uses given function f to mock the AuthSvc.GetRecoveryKit method
+func (mmGetRecoveryKit *mAuthSvcMockGetRecoveryKit) Set(f func(ctx context.Context, username string) (kit recovery.Kit, err error)) *AuthSvcMock {
+	if mmGetRecoveryKit.defaultExpectation != nil {
+		mmGetRecoveryKit.mock.t.Fatalf("Default expectation is already set for the AuthSvc.GetRecoveryKit method")
+	}
+
+	if len(mmGetRecoveryKit.expectations) > 0 {
+		mmGetRecoveryKit.mock.t.Fatalf("Some expectations are already set for the AuthSvc.GetRecoveryKit method")
+	}
+
+	mmGetRecoveryKit.mock.funcGetRecoveryKit = f
+	mmGetRecoveryKit.mock.funcGetRecoveryKitOrigin = minimock.CallerInfo(1)
+	return mmGetRecoveryKit.mock
+}
+
+// When sets expectation for the AuthSvc.GetRecoveryKit which will trigger the result defined by the following
+// Then helper
+func (mmGetRecoveryKit *mAuthSvcMockGetRecoveryKit) When(ctx context.Context, username string) *AuthSvcMockGetRecoveryKitExpectation {
+	if mmGetRecoveryKit.mock.funcGetRecoveryKit != nil {
+		mmGetRecoveryKit.mock.t.Fatalf("AuthSvcMock.GetRecoveryKit mock is already set by Set")
+	}
+
+	expectation := &AuthSvcMockGetRecoveryKitExpectation{
+		mock:               mmGetRecoveryKit.mock,
+		params:             &AuthSvcMockGetRecoveryKitParams{ctx, username},
+		expectationOrigins: AuthSvcMockGetRecoveryKitExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetRecoveryKit.expectations = append(mmGetRecoveryKit.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthSvc.GetRecoveryKit return parameters for the expectation previously defined by the When method
+func (e *AuthSvcMockGetRecoveryKitExpectation) Then(kit recovery.Kit, err error) *AuthSvcMock {
+	e.results = &AuthSvcMockGetRecoveryKitResults{kit, err}
+	return e.mock
+}
+
+// Times sets number of times AuthSvc.GetRecoveryKit should be invoked
+func (mmGetRecoveryKit *mAuthSvcMockGetRecoveryKit) Times(n uint64) *mAuthSvcMockGetRecoveryKit {
+	if n == 0 {
+		mmGetRecoveryKit.mock.t.Fatalf("Times of AuthSvcMock.GetRecoveryKit mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetRecoveryKit.expectedInvocations, n)
+	mmGetRecoveryKit.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetRecoveryKit
+}
+
+func (mmGetRecoveryKit *mAuthSvcMockGetRecoveryKit) invocationsDone() bool {
+	if len(mmGetRecoveryKit.expectations) == 0 && mmGetRecoveryKit.defaultExpectation == nil && mmGetRecoveryKit.mock.funcGetRecoveryKit == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetRecoveryKit.mock.afterGetRecoveryKitCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetRecoveryKit.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetRecoveryKit implements mm_handler.AuthSvc
+func (mmGetRecoveryKit *AuthSvcMock) GetRecoveryKit(ctx context.Context, username string) (kit recovery.Kit, err error) {
+	mm_atomic.AddUint64(&mmGetRecoveryKit.beforeGetRecoveryKitCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetRecoveryKit.afterGetRecoveryKitCounter, 1)
+
+	mmGetRecoveryKit.t.Helper()
+
+	if mmGetRecoveryKit.inspectFuncGetRecoveryKit != nil {
+		mmGetRecoveryKit.inspectFuncGetRecoveryKit(ctx, username)
+	}
+
+	mm_params := AuthSvcMockGetRecoveryKitParams{ctx, username}
+
+	// Record call args
+	mmGetRecoveryKit.GetRecoveryKitMock.mutex.Lock()
+	mmGetRecoveryKit.GetRecoveryKitMock.callArgs = append(mmGetRecoveryKit.GetRecoveryKitMock.callArgs, &mm_params)
+	mmGetRecoveryKit.GetRecoveryKitMock.mutex.Unlock()
+
+	for _, e := range mmGetRecoveryKit.GetRecoveryKitMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.kit, e.results.err
+		}
+	}
+
+	if mmGetRecoveryKit.GetRecoveryKitMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetRecoveryKit.GetRecoveryKitMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetRecoveryKit.GetRecoveryKitMock.defaultExpectation.params
+		mm_want_ptrs := mmGetRecoveryKit.GetRecoveryKitMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthSvcMockGetRecoveryKitParams{ctx, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetRecoveryKit.t.Errorf("AuthSvcMock.GetRecoveryKit got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRecoveryKit.GetRecoveryKitMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetRecoveryKit.t.Errorf("AuthSvcMock.GetRecoveryKit got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRecoveryKit.GetRecoveryKitMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetRecoveryKit.t.Errorf("AuthSvcMock.GetRecoveryKit got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetRecoveryKit.GetRecoveryKitMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetRecoveryKit.GetRecoveryKitMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetRecoveryKit.t.Fatal("No results are set for the AuthSvcMock.GetRecoveryKit")
+		}
+		return (*mm_results).kit, (*mm_results).err
+	}
+	if mmGetRecoveryKit.funcGetRecoveryKit != nil {
+		return mmGetRecoveryKit.funcGetRecoveryKit(ctx, username)
+	}
+	mmGetRecoveryKit.t.Fatalf("Unexpected call to AuthSvcMock.GetRecoveryKit. %v %v", ctx, username)
+	return
+}
+
+// GetRecoveryKitAfterCounter returns a count of finished AuthSvcMock.GetRecoveryKit invocations
+func (mmGetRecoveryKit *AuthSvcMock) GetRecoveryKitAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRecoveryKit.afterGetRecoveryKitCounter)
+}
+
+// GetRecoveryKitBeforeCounter returns a count of AuthSvcMock.GetRecoveryKit invocations
+func (mmGetRecoveryKit *AuthSvcMock) GetRecoveryKitBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRecoveryKit.beforeGetRecoveryKitCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthSvcMock.GetRecoveryKit.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetRecoveryKit *mAuthSvcMockGetRecoveryKit) Calls() []*AuthSvcMockGetRecoveryKitParams {
+	mmGetRecoveryKit.mutex.RLock()
+
+	argCopy := make([]*AuthSvcMockGetRecoveryKitParams, len(mmGetRecoveryKit.callArgs))
+	copy(argCopy, mmGetRecoveryKit.callArgs)
+
+	mmGetRecoveryKit.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetRecoveryKitDone returns true if the count of the GetRecoveryKit invocations corresponds
+// the number of defined expectations
+func (m *AuthSvcMock) MinimockGetRecoveryKitDone() bool {
+	if m.GetRecoveryKitMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetRecoveryKitMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetRecoveryKitMock.invocationsDone()
+}
+
+// MinimockGetRecoveryKitInspect logs each unmet expectation
+func (m *AuthSvcMock) MinimockGetRecoveryKitInspect() {
+	for _, e := range m.GetRecoveryKitMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthSvcMock.GetRecoveryKit at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetRecoveryKitCounter := mm_atomic.LoadUint64(&m.afterGetRecoveryKitCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRecoveryKitMock.defaultExpectation != nil && afterGetRecoveryKitCounter < 1 {
+		if m.GetRecoveryKitMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthSvcMock.GetRecoveryKit at\n%s", m.GetRecoveryKitMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthSvcMock.GetRecoveryKit at\n%s with params: %#v", m.GetRecoveryKitMock.defaultExpectation.expectationOrigins.origin, *m.GetRecoveryKitMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRecoveryKit != nil && afterGetRecoveryKitCounter < 1 {
+		m.t.Errorf("Expected call to AuthSvcMock.GetRecoveryKit at\n%s", m.funcGetRecoveryKitOrigin)
+	}
+
+	if !m.GetRecoveryKitMock.invocationsDone() && afterGetRecoveryKitCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthSvcMock.GetRecoveryKit at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetRecoveryKitMock.expectedInvocations), m.GetRecoveryKitMock.expectedInvocationsOrigin, afterGetRecoveryKitCounter)
+	}
+}
+
+type mAuthSvcMockRecover struct {
+	optional           bool
+	mock               *AuthSvcMock
+	defaultExpectation *AuthSvcMockRecoverExpectation
+	expectations       []*AuthSvcMockRecoverExpectation
+
+	callArgs []*AuthSvcMockRecoverParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthSvcMockRecoverExpectation specifies expectation struct of the AuthSvc.Recover
+type AuthSvcMockRecoverExpectation struct {
+	mock               *AuthSvcMock
+	params             *AuthSvcMockRecoverParams
+	paramPtrs          *AuthSvcMockRecoverParamPtrs
+	expectationOrigins AuthSvcMockRecoverExpectationOrigins
+	results            *AuthSvcMockRecoverResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthSvcMockRecoverParams contains parameters of the AuthSvc.Recover
+type AuthSvcMockRecoverParams struct {
+	ctx      context.Context
+	username string
+	codes    []string
+	change   models.PasswordChange
+}
+
+// AuthSvcMockRecoverParamPtrs contains pointers to parameters of the AuthSvc.Recover
+type AuthSvcMockRecoverParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	codes    *[]string
+	change   *models.PasswordChange
+}
+
+// AuthSvcMockRecoverResults contains results of the AuthSvc.Recover
+type AuthSvcMockRecoverResults struct {
+	vaultKey []byte
+	revoked  int64
+	err      error
+}
+
+// AuthSvcMockRecoverOrigins contains origins of expectations of the AuthSvc.Recover
+type AuthSvcMockRecoverExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originCodes    string
+	originChange   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRecover *mAuthSvcMockRecover) Optional() *mAuthSvcMockRecover {
+	mmRecover.optional = true
+	return mmRecover
+}
+
+// Expect sets up expected params for AuthSvc.Recover
+func (mmRecover *mAuthSvcMockRecover) Expect(ctx context.Context, username string, codes []string, change models.PasswordChange) *mAuthSvcMockRecover {
+	if mmRecover.mock.funcRecover != nil {
+		mmRecover.mock.t.Fatalf("AuthSvcMock.Recover mock is already set by Set")
+	}
+
+	if mmRecover.defaultExpectation == nil {
+		mmRecover.defaultExpectation = &AuthSvcMockRecoverExpectation{}
+	}
+
+	if mmRecover.defaultExpectation.paramPtrs != nil {
+		mmRecover.mock.t.Fatalf("AuthSvcMock.Recover mock is already set by ExpectParams functions")
+	}
+
+	mmRecover.defaultExpectation.params = &AuthSvcMockRecoverParams{ctx, username, codes, change}
+	mmRecover.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRecover.expectations {
+		if minimock.Equal(e.params, mmRecover.defaultExpectation.params) {
+			mmRecover.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRecover.defaultExpectation.params)
+		}
+	}
+
+	return mmRecover
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthSvc.Recover
+func (mmRecover *mAuthSvcMockRecover) ExpectCtxParam1(ctx context.Context) *mAuthSvcMockRecover {
+	if mmRecover.mock.funcRecover != nil {
+		mmRecover.mock.t.Fatalf("AuthSvcMock.Recover mock is already set by Set")
+	}
+
+	if mmRecover.defaultExpectation == nil {
+		mmRecover.defaultExpectation = &AuthSvcMockRecoverExpectation{}
+	}
+
+	if mmRecover.defaultExpectation.params != nil {
+		mmRecover.mock.t.Fatalf("AuthSvcMock.Recover mock is already set by Expect")
+	}
+
+	if mmRecover.defaultExpectation.paramPtrs == nil {
+		mmRecover.defaultExpectation.paramPtrs = &AuthSvcMockRecoverParamPtrs{}
+	}
+	mmRecover.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRecover.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRecover
+}
+
+// ExpectUsernameParam2 sets up expected param username for AuthSvc.Recover
+func (mmRecover *mAuthSvcMockRecover) ExpectUsernameParam2(username string) *mAuthSvcMockRecover {
+	if mmRecover.mock.funcRecover != nil {
+		mmRecover.mock.t.Fatalf("AuthSvcMock.Recover mock is already set by Set")
+	}
+
+	if mmRecover.defaultExpectation == nil {
+		mmRecover.defaultExpectation = &AuthSvcMockRecoverExpectation{}
+	}
+
+	if mmRecover.defaultExpectation.params != nil {
+		mmRecover.mock.t.Fatalf("AuthSvcMock.Recover mock is already set by Expect")
+	}
+
+	if mmRecover.defaultExpectation.paramPtrs == nil {
+		mmRecover.defaultExpectation.paramPtrs = &AuthSvcMockRecoverParamPtrs{}
+	}
+	mmRecover.defaultExpectation.paramPtrs.username = &username
+	mmRecover.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmRecover
+}
+
+// ExpectCodesParam3 sets up expected param codes for AuthSvc.Recover
+func (mmRecover *mAuthSvcMockRecover) ExpectCodesParam3(codes []string) *mAuthSvcMockRecover {
+	if mmRecover.mock.funcRecover != nil {
+		mmRecover.mock.t.Fatalf("AuthSvcMock.Recover mock is already set by Set")
+	}
+
+	if mmRecover.defaultExpectation == nil {
+		mmRecover.defaultExpectation = &AuthSvcMockRecoverExpectation{}
+	}
+
+	if mmRecover.defaultExpectation.params != nil {
+		mmRecover.mock.t.Fatalf("AuthSvcMock.Recover mock is already set by Expect")
+	}
+
+	if mmRecover.defaultExpectation.paramPtrs == nil {
+		mmRecover.defaultExpectation.paramPtrs = &AuthSvcMockRecoverParamPtrs{}
+	}
+	mmRecover.defaultExpectation.paramPtrs.codes = &codes
+	mmRecover.defaultExpectation.expectationOrigins.originCodes = minimock.CallerInfo(1)
+
+	return mmRecover
+}
+
+// ExpectChangeParam4 sets up expected param change for AuthSvc.Recover
+func (mmRecover *mAuthSvcMockRecover) ExpectChangeParam4(change models.PasswordChange) *mAuthSvcMockRecover {
+	if mmRecover.mock.funcRecover != nil {
+		mmRecover.mock.t.Fatalf("AuthSvcMock.Recover mock is already set by Set")
+	}
+
+	if mmRecover.defaultExpectation == nil {
+		mmRecover.defaultExpectation = &AuthSvcMockRecoverExpectation{}
+	}
+
+	if mmRecover.defaultExpectation.params != nil {
+		mmRecover.mock.t.Fatalf("AuthSvcMock.Recover mock is already set by Expect")
+	}
+
+	if mmRecover.defaultExpectation.paramPtrs == nil {
+		mmRecover.defaultExpectation.paramPtrs = &AuthSvcMockRecoverParamPtrs{}
+	}
+	mmRecover.defaultExpectation.paramPtrs.change = &change
+	mmRecover.defaultExpectation.expectationOrigins.originChange = minimock.CallerInfo(1)
+
+	return mmRecover
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthSvc.Recover
+func (mmRecover *mAuthSvcMockRecover) Inspect(f func(ctx context.Context, username string, codes []string, change models.PasswordChange)) *mAuthSvcMockRecover {
+	if mmRecover.mock.inspectFuncRecover != nil {
+		mmRecover.mock.t.Fatalf("Inspect function is already set for AuthSvcMock.Recover")
+	}
+
+	mmRecover.mock.inspectFuncRecover = f
+
+	return mmRecover
+}
+
+// Return sets up results that will be returned by AuthSvc.Recover
+func (mmRecover *mAuthSvcMockRecover) Return(vaultKey []byte, revoked int64, err error) *AuthSvcMock {
+	if mmRecover.mock.funcRecover != nil {
+		mmRecover.mock.t.Fatalf("AuthSvcMock.Recover mock is already set by Set")
+	}
+
+	if mmRecover.defaultExpectation == nil {
+		mmRecover.defaultExpectation = &AuthSvcMockRecoverExpectation{mock: mmRecover.mock}
+	}
+	mmRecover.defaultExpectation.results = &AuthSvcMockRecoverResults{vaultKey, revoked, err}
+	mmRecover.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRecover.mock
+}
+
+// Set uses given function f to mock the AuthSvc.Recover method
+func (mmRecover *mAuthSvcMockRecover) Set(f func(ctx context.Context, username string, codes []string, change models.PasswordChange) (vaultKey []byte, revoked int64, err error)) *AuthSvcMock {
+	if mmRecover.defaultExpectation != nil {
+		mmRecover.mock.t.Fatalf("Default expectation is already set for the AuthSvc.Recover method")
+	}
+
+	if len(mmRecover.expectations) > 0 {
+		mmRecover.mock.t.Fatalf("Some expectations are already set for the AuthSvc.Recover method")
+	}
+
+	mmRecover.mock.funcRecover = f
+	mmRecover.mock.funcRecoverOrigin = minimock.CallerInfo(1)
+	return mmRecover.mock
+}
+
+// When sets expectation for the AuthSvc.Recover which will trigger the result defined by the following
+// Then helper
+func (mmRecover *mAuthSvcMockRecover) When(ctx context.Context, username string, codes []string, change models.PasswordChange) *AuthSvcMockRecoverExpectation {
+	if mmRecover.mock.funcRecover != nil {
+		mmRecover.mock.t.Fatalf("AuthSvcMock.Recover mock is already set by Set")
+	}
+
+	expectation := &AuthSvcMockRecoverExpectation{
+		mock:               mmRecover.mock,
+		params:             &AuthSvcMockRecoverParams{ctx, username, codes, change},
+		expectationOrigins: AuthSvcMockRecoverExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRecover.expectations = append(mmRecover.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthSvc.Recover return parameters for the expectation previously defined by the When method
+func (e *AuthSvcMockRecoverExpectation) Then(vaultKey []byte, revoked int64, err error) *AuthSvcMock {
+	e.results = &AuthSvcMockRecoverResults{vaultKey, revoked, err}
+	return e.mock
+}
+
+// Times sets number of times AuthSvc.Recover should be invoked
+func (mmRecover *mAuthSvcMockRecover) Times(n uint64) *mAuthSvcMockRecover {
+	if n == 0 {
+		mmRecover.mock.t.Fatalf("Times of AuthSvcMock.Recover mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRecover.expectedInvocations, n)
+	mmRecover.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRecover
+}
+
+func (mmRecover *mAuthSvcMockRecover) invocationsDone() bool {
+	if len(mmRecover.expectations) == 0 && mmRecover.defaultExpectation == nil && mmRecover.mock.funcRecover == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRecover.mock.afterRecoverCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRecover.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Recover implements mm_handler.AuthSvc
+func (mmRecover *AuthSvcMock) Recover(ctx context.Context, username string, codes []string, change models.PasswordChange) (vaultKey []byte, revoked int64, err error) {
+	mm_atomic.AddUint64(&mmRecover.beforeRecoverCounter, 1)
+	defer mm_atomic.AddUint64(&mmRecover.afterRecoverCounter, 1)
+
+	mmRecover.t.Helper()
+
+	if mmRecover.inspectFuncRecover != nil {
+		mmRecover.inspectFuncRecover(ctx, username, codes, change)
+	}
+
+	mm_params := AuthSvcMockRecoverParams{ctx, username, codes, change}
+
+	// Record call args
+	mmRecover.RecoverMock.mutex.Lock()
+	mmRecover.RecoverMock.callArgs = append(mmRecover.RecoverMock.callArgs, &mm_params)
+	mmRecover.RecoverMock.mutex.Unlock()
+
+	for _, e := range mmRecover.RecoverMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.vaultKey, e.results.revoked, e.results.err
+		}
+	}
+
+	if mmRecover.RecoverMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRecover.RecoverMock.defaultExpectation.Counter, 1)
+		mm_want := mmRecover.RecoverMock.defaultExpectation.params
+		mm_want_ptrs := mmRecover.RecoverMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthSvcMockRecoverParams{ctx, username, codes, change}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRecover.t.Errorf("AuthSvcMock.Recover got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecover.RecoverMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmRecover.t.Errorf("AuthSvcMock.Recover got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecover.RecoverMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.codes != nil && !minimock.Equal(*mm_want_ptrs.codes, mm_got.codes) {
+				mmRecover.t.Errorf("AuthSvcMock.Recover got unexpected parameter codes, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecover.RecoverMock.defaultExpectation.expectationOrigins.originCodes, *mm_want_ptrs.codes, mm_got.codes, minimock.Diff(*mm_want_ptrs.codes, mm_got.codes))
+			}
+
+			if mm_want_ptrs.change != nil && !minimock.Equal(*mm_want_ptrs.change, mm_got.change) {
+				mmRecover.t.Errorf("AuthSvcMock.Recover got unexpected parameter change, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRecover.RecoverMock.defaultExpectation.expectationOrigins.originChange, *mm_want_ptrs.change, mm_got.change, minimock.Diff(*mm_want_ptrs.change, mm_got.change))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRecover.t.Errorf("AuthSvcMock.Recover got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRecover.RecoverMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRecover.RecoverMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRecover.t.Fatal("No results are set for the AuthSvcMock.Recover")
+		}
+		return (*mm_results).vaultKey, (*mm_results).revoked, (*mm_results).err
+	}
+	if mmRecover.funcRecover != nil {
+		return mmRecover.funcRecover(ctx, username, codes, change)
+	}
+	mmRecover.t.Fatalf("Unexpected call to AuthSvcMock.Recover. %v %v %v %v", ctx, username, codes, change)
+	return
+}
+
+// RecoverAfterCounter returns a count of finished AuthSvcMock.Recover invocations
+func (mmRecover *AuthSvcMock) RecoverAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecover.afterRecoverCounter)
+}
+
+// RecoverBeforeCounter returns a count of AuthSvcMock.Recover invocations
+func (mmRecover *AuthSvcMock) RecoverBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRecover.beforeRecoverCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthSvcMock.Recover.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRecover *mAuthSvcMockRecover) Calls() []*AuthSvcMockRecoverParams {
+	mmRecover.mutex.RLock()
+
+	argCopy := make([]*AuthSvcMockRecoverParams, len(mmRecover.callArgs))
+	copy(argCopy, mmRecover.callArgs)
+
+	mmRecover.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRecoverDone returns true if the count of the Recover invocations corresponds
+// the number of defined expectations
+func (m *AuthSvcMock) MinimockRecoverDone() bool {
+	if m.RecoverMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RecoverMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RecoverMock.invocationsDone()
+}
+
+// MinimockRecoverInspect logs each unmet expectation
+func (m *AuthSvcMock) MinimockRecoverInspect() {
+	for _, e := range m.RecoverMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthSvcMock.Recover at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRecoverCounter := mm_atomic.LoadUint64(&m.afterRecoverCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RecoverMock.defaultExpectation != nil && afterRecoverCounter < 1 {
+		if m.RecoverMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthSvcMock.Recover at\n%s", m.RecoverMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthSvcMock.Recover at\n%s with params: %#v", m.RecoverMock.defaultExpectation.expectationOrigins.origin, *m.RecoverMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRecover != nil && afterRecoverCounter < 1 {
+		m.t.Errorf("Expected call to AuthSvcMock.Recover at\n%s", m.funcRecoverOrigin)
+	}
+
+	if !m.RecoverMock.invocationsDone() && afterRecoverCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthSvcMock.Recover at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RecoverMock.expectedInvocations), m.RecoverMock.expectedInvocationsOrigin, afterRecoverCounter)
+	}
+}
+
+type mAuthSvcMockRevokeAPIKey struct {
+	optional           bool
+	mock               *AuthSvcMock
+	defaultExpectation *AuthSvcMockRevokeAPIKeyExpectation
+	expectations       []*AuthSvcMockRevokeAPIKeyExpectation
+
+	callArgs []*AuthSvcMockRevokeAPIKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthSvcMockRevokeAPIKeyExpectation specifies expectation struct of the AuthSvc.RevokeAPIKey
+type AuthSvcMockRevokeAPIKeyExpectation struct {
+	mock               *AuthSvcMock
+	params             *AuthSvcMockRevokeAPIKeyParams
+	paramPtrs          *AuthSvcMockRevokeAPIKeyParamPtrs
+	expectationOrigins AuthSvcMockRevokeAPIKeyExpectationOrigins
+	results            *AuthSvcMockRevokeAPIKeyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthSvcMockRevokeAPIKeyParams contains parameters of the AuthSvc.RevokeAPIKey
+type AuthSvcMockRevokeAPIKeyParams struct {
+	ctx      context.Context
+	username string
+	id       string
+}
+
+// AuthSvcMockRevokeAPIKeyParamPtrs contains pointers to parameters of the AuthSvc.RevokeAPIKey
+type AuthSvcMockRevokeAPIKeyParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	id       *string
+}
+
+// AuthSvcMockRevokeAPIKeyResults contains results of the AuthSvc.RevokeAPIKey
+type AuthSvcMockRevokeAPIKeyResults struct {
+	err error
+}
+
+// AuthSvcMockRevokeAPIKeyOrigins contains origins of expectations of the AuthSvc.RevokeAPIKey
+type AuthSvcMockRevokeAPIKeyExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originId       string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevokeAPIKey *mAuthSvcMockRevokeAPIKey) Optional() *mAuthSvcMockRevokeAPIKey {
+	mmRevokeAPIKey.optional = true
+	return mmRevokeAPIKey
+}
+
+// Expect sets up expected params for AuthSvc.RevokeAPIKey
+func (mmRevokeAPIKey *mAuthSvcMockRevokeAPIKey) Expect(ctx context.Context, username string, id string) *mAuthSvcMockRevokeAPIKey {
+	if mmRevokeAPIKey.mock.funcRevokeAPIKey != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("AuthSvcMock.RevokeAPIKey mock is already set by Set")
+	}
+
+	if mmRevokeAPIKey.defaultExpectation == nil {
+		mmRevokeAPIKey.defaultExpectation = &AuthSvcMockRevokeAPIKeyExpectation{}
+	}
+
+	if mmRevokeAPIKey.defaultExpectation.paramPtrs != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("AuthSvcMock.RevokeAPIKey mock is already set by ExpectParams functions")
+	}
+
+	mmRevokeAPIKey.defaultExpectation.params = &AuthSvcMockRevokeAPIKeyParams{ctx, username, id}
+	mmRevokeAPIKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRevokeAPIKey.expectations {
+		if minimock.Equal(e.params, mmRevokeAPIKey.defaultExpectation.params) {
+			mmRevokeAPIKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeAPIKey.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeAPIKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthSvc.RevokeAPIKey
+func (mmRevokeAPIKey *mAuthSvcMockRevokeAPIKey) ExpectCtxParam1(ctx context.Context) *mAuthSvcMockRevokeAPIKey {
+	if mmRevokeAPIKey.mock.funcRevokeAPIKey != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("AuthSvcMock.RevokeAPIKey mock is already set by Set")
+	}
+
+	if mmRevokeAPIKey.defaultExpectation == nil {
+		mmRevokeAPIKey.defaultExpectation = &AuthSvcMockRevokeAPIKeyExpectation{}
+	}
+
+	if mmRevokeAPIKey.defaultExpectation.params != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("AuthSvcMock.RevokeAPIKey mock is already set by Expect")
+	}
+
+	if mmRevokeAPIKey.defaultExpectation.paramPtrs == nil {
+		mmRevokeAPIKey.defaultExpectation.paramPtrs = &AuthSvcMockRevokeAPIKeyParamPtrs{}
+	}
+	mmRevokeAPIKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRevokeAPIKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRevokeAPIKey
+}
+
+// ExpectUsernameParam2 sets up expected param username for AuthSvc.RevokeAPIKey
+func (mmRevokeAPIKey *mAuthSvcMockRevokeAPIKey) ExpectUsernameParam2(username string) *mAuthSvcMockRevokeAPIKey {
+	if mmRevokeAPIKey.mock.funcRevokeAPIKey != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("AuthSvcMock.RevokeAPIKey mock is already set by Set")
+	}
+
+	if mmRevokeAPIKey.defaultExpectation == nil {
+		mmRevokeAPIKey.defaultExpectation = &AuthSvcMockRevokeAPIKeyExpectation{}
+	}
+
+	if mmRevokeAPIKey.defaultExpectation.params != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("AuthSvcMock.RevokeAPIKey mock is already set by Expect")
+	}
+
+	if mmRevokeAPIKey.defaultExpectation.paramPtrs == nil {
+		mmRevokeAPIKey.defaultExpectation.paramPtrs = &AuthSvcMockRevokeAPIKeyParamPtrs{}
+	}
+	mmRevokeAPIKey.defaultExpectation.paramPtrs.username = &username
+	mmRevokeAPIKey.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmRevokeAPIKey
+}
+
+// ExpectIdParam3 sets up expected param id for AuthSvc.RevokeAPIKey
+func (mmRevokeAPIKey *mAuthSvcMockRevokeAPIKey) ExpectIdParam3(id string) *mAuthSvcMockRevokeAPIKey {
+	if mmRevokeAPIKey.mock.funcRevokeAPIKey != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("AuthSvcMock.RevokeAPIKey mock is already set by Set")
+	}
+
+	if mmRevokeAPIKey.defaultExpectation == nil {
+		mmRevokeAPIKey.defaultExpectation = &AuthSvcMockRevokeAPIKeyExpectation{}
+	}
+
+	if mmRevokeAPIKey.defaultExpectation.params != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("AuthSvcMock.RevokeAPIKey mock is already set by Expect")
+	}
+
+	if mmRevokeAPIKey.defaultExpectation.paramPtrs == nil {
+		mmRevokeAPIKey.defaultExpectation.paramPtrs = &AuthSvcMockRevokeAPIKeyParamPtrs{}
+	}
+	mmRevokeAPIKey.defaultExpectation.paramPtrs.id = &id
+	mmRevokeAPIKey.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmRevokeAPIKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthSvc.RevokeAPIKey
+func (mmRevokeAPIKey *mAuthSvcMockRevokeAPIKey) Inspect(f func(ctx context.Context, username string, id string)) *mAuthSvcMockRevokeAPIKey {
+	if mmRevokeAPIKey.mock.inspectFuncRevokeAPIKey != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("Inspect function is already set for AuthSvcMock.RevokeAPIKey")
+	}
+
+	mmRevokeAPIKey.mock.inspectFuncRevokeAPIKey = f
+
+	return mmRevokeAPIKey
+}
+
+// Return sets up results that will be returned by AuthSvc.RevokeAPIKey
+func (mmRevokeAPIKey *mAuthSvcMockRevokeAPIKey) Return(err error) *AuthSvcMock {
+	if mmRevokeAPIKey.mock.funcRevokeAPIKey != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("AuthSvcMock.RevokeAPIKey mock is already set by Set")
+	}
+
+	if mmRevokeAPIKey.defaultExpectation == nil {
+		mmRevokeAPIKey.defaultExpectation = &AuthSvcMockRevokeAPIKeyExpectation{mock: mmRevokeAPIKey.mock}
+	}
+	mmRevokeAPIKey.defaultExpectation.results = &AuthSvcMockRevokeAPIKeyResults{err}
+	mmRevokeAPIKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRevokeAPIKey.mock
+}
+
+// Set uses given function f to mock the AuthSvc.RevokeAPIKey method
+func (mmRevokeAPIKey *mAuthSvcMockRevokeAPIKey) Set(f func(ctx context.Context, username string, id string) (err error)) *AuthSvcMock {
+	if mmRevokeAPIKey.defaultExpectation != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("Default expectation is already set for the AuthSvc.RevokeAPIKey method")
+	}
+
+	if len(mmRevokeAPIKey.expectations) > 0 {
+		mmRevokeAPIKey.mock.t.Fatalf("Some expectations are already set for the AuthSvc.RevokeAPIKey method")
+	}
+
+	mmRevokeAPIKey.mock.funcRevokeAPIKey = f
+	mmRevokeAPIKey.mock.funcRevokeAPIKeyOrigin = minimock.CallerInfo(1)
+	return mmRevokeAPIKey.mock
+}
+
+// When sets expectation for the AuthSvc.RevokeAPIKey which will trigger the result defined by the following
+// Then helper
+func (mmRevokeAPIKey *mAuthSvcMockRevokeAPIKey) When(ctx context.Context, username string, id string) *AuthSvcMockRevokeAPIKeyExpectation {
+	if mmRevokeAPIKey.mock.funcRevokeAPIKey != nil {
+		mmRevokeAPIKey.mock.t.Fatalf("AuthSvcMock.RevokeAPIKey mock is already set by Set")
+	}
+
+	expectation := &AuthSvcMockRevokeAPIKeyExpectation{
+		mock:               mmRevokeAPIKey.mock,
+		params:             &AuthSvcMockRevokeAPIKeyParams{ctx, username, id},
+		expectationOrigins: AuthSvcMockRevokeAPIKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRevokeAPIKey.expectations = append(mmRevokeAPIKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthSvc.RevokeAPIKey return parameters for the expectation previously defined by the When method
+func (e *AuthSvcMockRevokeAPIKeyExpectation) Then(err error) *AuthSvcMock {
+	e.results = &AuthSvcMockRevokeAPIKeyResults{err}
+	return e.mock
+}
+
+// Times sets number of times AuthSvc.RevokeAPIKey should be invoked
+func (mmRevokeAPIKey *mAuthSvcMockRevokeAPIKey) Times(n uint64) *mAuthSvcMockRevokeAPIKey {
+	if n == 0 {
+		mmRevokeAPIKey.mock.t.Fatalf("Times of AuthSvcMock.RevokeAPIKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevokeAPIKey.expectedInvocations, n)
+	mmRevokeAPIKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRevokeAPIKey
+}
+
+func (mmRevokeAPIKey *mAuthSvcMockRevokeAPIKey) invocationsDone() bool {
+	if len(mmRevokeAPIKey.expectations) == 0 && mmRevokeAPIKey.defaultExpectation == nil && mmRevokeAPIKey.mock.funcRevokeAPIKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokeAPIKey.mock.afterRevokeAPIKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokeAPIKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RevokeAPIKey implements mm_handler.AuthSvc
+func (mmRevokeAPIKey *AuthSvcMock) RevokeAPIKey(ctx context.Context, username string, id string) (err error) {
+	mm_atomic.AddUint64(&mmRevokeAPIKey.beforeRevokeAPIKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeAPIKey.afterRevokeAPIKeyCounter, 1)
+
+	mmRevokeAPIKey.t.Helper()
+
+	if mmRevokeAPIKey.inspectFuncRevokeAPIKey != nil {
+		mmRevokeAPIKey.inspectFuncRevokeAPIKey(ctx, username, id)
+	}
+
+	mm_params := AuthSvcMockRevokeAPIKeyParams{ctx, username, id}
+
+	// Record call args
+	mmRevokeAPIKey.RevokeAPIKeyMock.mutex.Lock()
+	mmRevokeAPIKey.RevokeAPIKeyMock.callArgs = append(mmRevokeAPIKey.RevokeAPIKeyMock.callArgs, &mm_params)
+	mmRevokeAPIKey.RevokeAPIKeyMock.mutex.Unlock()
+
+	for _, e := range mmRevokeAPIKey.RevokeAPIKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevokeAPIKey.RevokeAPIKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeAPIKey.RevokeAPIKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeAPIKey.RevokeAPIKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeAPIKey.RevokeAPIKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthSvcMockRevokeAPIKeyParams{ctx, username, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokeAPIKey.t.Errorf("AuthSvcMock.RevokeAPIKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeAPIKey.RevokeAPIKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmRevokeAPIKey.t.Errorf("AuthSvcMock.RevokeAPIKey got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeAPIKey.RevokeAPIKeyMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmRevokeAPIKey.t.Errorf("AuthSvcMock.RevokeAPIKey got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeAPIKey.RevokeAPIKeyMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokeAPIKey.t.Errorf("AuthSvcMock.RevokeAPIKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRevokeAPIKey.RevokeAPIKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokeAPIKey.RevokeAPIKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokeAPIKey.t.Fatal("No results are set for the AuthSvcMock.RevokeAPIKey")
+		}
+		return (*mm_results).err
+	}
+	if mmRevokeAPIKey.funcRevokeAPIKey != nil {
+		return mmRevokeAPIKey.funcRevokeAPIKey(ctx, username, id)
+	}
+	mmRevokeAPIKey.t.Fatalf("Unexpected call to AuthSvcMock.RevokeAPIKey. %v %v %v", ctx, username, id)
+	return
+}
+
+// RevokeAPIKeyAfterCounter returns a count of finished AuthSvcMock.RevokeAPIKey invocations
+func (mmRevokeAPIKey *AuthSvcMock) RevokeAPIKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeAPIKey.afterRevokeAPIKeyCounter)
+}
+
+// RevokeAPIKeyBeforeCounter returns a count of AuthSvcMock.RevokeAPIKey invocations
+func (mmRevokeAPIKey *AuthSvcMock) RevokeAPIKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeAPIKey.beforeRevokeAPIKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthSvcMock.RevokeAPIKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokeAPIKey *mAuthSvcMockRevokeAPIKey) Calls() []*AuthSvcMockRevokeAPIKeyParams {
+	mmRevokeAPIKey.mutex.RLock()
+
+	argCopy := make([]*AuthSvcMockRevokeAPIKeyParams, len(mmRevokeAPIKey.callArgs))
+	copy(argCopy, mmRevokeAPIKey.callArgs)
+
+	mmRevokeAPIKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeAPIKeyDone returns true if the count of the RevokeAPIKey invocations corresponds
+// the number of defined expectations
+func (m *AuthSvcMock) MinimockRevokeAPIKeyDone() bool {
+	if m.RevokeAPIKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeAPIKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeAPIKeyMock.invocationsDone()
+}
+
+// MinimockRevokeAPIKeyInspect logs each unmet expectation
+func (m *AuthSvcMock) MinimockRevokeAPIKeyInspect() {
+	for _, e := range m.RevokeAPIKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthSvcMock.RevokeAPIKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRevokeAPIKeyCounter := mm_atomic.LoadUint64(&m.afterRevokeAPIKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeAPIKeyMock.defaultExpectation != nil && afterRevokeAPIKeyCounter < 1 {
+		if m.RevokeAPIKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthSvcMock.RevokeAPIKey at\n%s", m.RevokeAPIKeyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthSvcMock.RevokeAPIKey at\n%s with params: %#v", m.RevokeAPIKeyMock.defaultExpectation.expectationOrigins.origin, *m.RevokeAPIKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeAPIKey != nil && afterRevokeAPIKeyCounter < 1 {
+		m.t.Errorf("Expected call to AuthSvcMock.RevokeAPIKey at\n%s", m.funcRevokeAPIKeyOrigin)
+	}
+
+	if !m.RevokeAPIKeyMock.invocationsDone() && afterRevokeAPIKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthSvcMock.RevokeAPIKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeAPIKeyMock.expectedInvocations), m.RevokeAPIKeyMock.expectedInvocationsOrigin, afterRevokeAPIKeyCounter)
+	}
+}
+
+type mAuthSvcMockSessionActive struct {
+	optional           bool
+	mock               *AuthSvcMock
+	defaultExpectation *AuthSvcMockSessionActiveExpectation
+	expectations       []*AuthSvcMockSessionActiveExpectation
+
+	callArgs []*AuthSvcMockSessionActiveParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// AuthSvcMockSessionActiveExpectation specifies expectation struct of the AuthSvc.SessionActive
+type AuthSvcMockSessionActiveExpectation struct {
+	mock               *AuthSvcMock
+	params             *AuthSvcMockSessionActiveParams
+	paramPtrs          *AuthSvcMockSessionActiveParamPtrs
+	expectationOrigins AuthSvcMockSessionActiveExpectationOrigins
+	results            *AuthSvcMockSessionActiveResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// AuthSvcMockSessionActiveParams contains parameters of the AuthSvc.SessionActive
+type AuthSvcMockSessionActiveParams struct {
+	ctx       context.Context
+	sessionID string
+}
+
+// AuthSvcMockSessionActiveParamPtrs contains pointers to parameters of the AuthSvc.SessionActive
+type AuthSvcMockSessionActiveParamPtrs struct {
+	ctx       *context.Context
+	sessionID *string
+}
+
+// AuthSvcMockSessionActiveResults contains results of the AuthSvc.SessionActive
+type AuthSvcMockSessionActiveResults struct {
+	active    bool
+	deviceKey []byte
+	err       error
+}
+
+// AuthSvcMockSessionActiveOrigins contains origins of expectations of the AuthSvc.SessionActive
+type AuthSvcMockSessionActiveExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originSessionID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSessionActive *mAuthSvcMockSessionActive) Optional() *mAuthSvcMockSessionActive {
+	mmSessionActive.optional = true
+	return mmSessionActive
+}
+
+// Expect sets up expected params for AuthSvc.SessionActive
+func (mmSessionActive *mAuthSvcMockSessionActive) Expect(ctx context.Context, sessionID string) *mAuthSvcMockSessionActive {
+	if mmSessionActive.mock.funcSessionActive != nil {
+		mmSessionActive.mock.t.Fatalf("AuthSvcMock.SessionActive mock is already set by Set")
+	}
+
+	if mmSessionActive.defaultExpectation == nil {
+		mmSessionActive.defaultExpectation = &AuthSvcMockSessionActiveExpectation{}
+	}
+
+	if mmSessionActive.defaultExpectation.paramPtrs != nil {
+		mmSessionActive.mock.t.Fatalf("AuthSvcMock.SessionActive mock is already set by ExpectParams functions")
+	}
+
+	mmSessionActive.defaultExpectation.params = &AuthSvcMockSessionActiveParams{ctx, sessionID}
+	mmSessionActive.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSessionActive.expectations {
+		if minimock.Equal(e.params, mmSessionActive.defaultExpectation.params) {
+			mmSessionActive.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSessionActive.defaultExpectation.params)
+		}
+	}
+
+	return mmSessionActive
+}
+
+// ExpectCtxParam1 sets up expected param ctx for AuthSvc.SessionActive
+func (mmSessionActive *mAuthSvcMockSessionActive) ExpectCtxParam1(ctx context.Context) *mAuthSvcMockSessionActive {
+	if mmSessionActive.mock.funcSessionActive != nil {
+		mmSessionActive.mock.t.Fatalf("AuthSvcMock.SessionActive mock is already set by Set")
+	}
+
+	if mmSessionActive.defaultExpectation == nil {
+		mmSessionActive.defaultExpectation = &AuthSvcMockSessionActiveExpectation{}
+	}
+
+	if mmSessionActive.defaultExpectation.params != nil {
+		mmSessionActive.mock.t.Fatalf("AuthSvcMock.SessionActive mock is already set by Expect")
+	}
+
+	if mmSessionActive.defaultExpectation.paramPtrs == nil {
+		mmSessionActive.defaultExpectation.paramPtrs = &AuthSvcMockSessionActiveParamPtrs{}
+	}
+	mmSessionActive.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSessionActive.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSessionActive
+}
+
+// ExpectSessionIDParam2 sets up expected param sessionID for AuthSvc.SessionActive
+func (mmSessionActive *mAuthSvcMockSessionActive) ExpectSessionIDParam2(sessionID string) *mAuthSvcMockSessionActive {
+	if mmSessionActive.mock.funcSessionActive != nil {
+		mmSessionActive.mock.t.Fatalf("AuthSvcMock.SessionActive mock is already set by Set")
+	}
+
+	if mmSessionActive.defaultExpectation == nil {
+		mmSessionActive.defaultExpectation = &AuthSvcMockSessionActiveExpectation{}
+	}
+
+	if mmSessionActive.defaultExpectation.params != nil {
+		mmSessionActive.mock.t.Fatalf("AuthSvcMock.SessionActive mock is already set by Expect")
+	}
+
+	if mmSessionActive.defaultExpectation.paramPtrs == nil {
+		mmSessionActive.defaultExpectation.paramPtrs = &AuthSvcMockSessionActiveParamPtrs{}
+	}
+	mmSessionActive.defaultExpectation.paramPtrs.sessionID = &sessionID
+	mmSessionActive.defaultExpectation.expectationOrigins.originSessionID = minimock.CallerInfo(1)
+
+	return mmSessionActive
+}
+
+// Inspect accepts an inspector function that has same arguments as the AuthSvc.SessionActive
+func (mmSessionActive *mAuthSvcMockSessionActive) Inspect(f func(ctx context.Context, sessionID string)) *mAuthSvcMockSessionActive {
+	if mmSessionActive.mock.inspectFuncSessionActive != nil {
+		mmSessionActive.mock.t.Fatalf("Inspect function is already set for AuthSvcMock.SessionActive")
+	}
+
+	mmSessionActive.mock.inspectFuncSessionActive = f
+
+	return mmSessionActive
+}
+
+// Return sets up results that will be returned by AuthSvc.SessionActive
+func (mmSessionActive *mAuthSvcMockSessionActive) Return(active bool, deviceKey []byte, err error) *AuthSvcMock {
+	if mmSessionActive.mock.funcSessionActive != nil {
+		mmSessionActive.mock.t.Fatalf("AuthSvcMock.SessionActive mock is already set by Set")
+	}
+
+	if mmSessionActive.defaultExpectation == nil {
+		mmSessionActive.defaultExpectation = &AuthSvcMockSessionActiveExpectation{mock: mmSessionActive.mock}
+	}
+	mmSessionActive.defaultExpectation.results = &AuthSvcMockSessionActiveResults{active, deviceKey, err}
+	mmSessionActive.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSessionActive.mock
+}
+
+// Set uses given function f to mock the AuthSvc.SessionActive method
+func (mmSessionActive *mAuthSvcMockSessionActive) Set(f func(ctx context.Context, sessionID string) (active bool, deviceKey []byte, err error)) *AuthSvcMock {
+	if mmSessionActive.defaultExpectation != nil {
+		mmSessionActive.mock.t.Fatalf("Default expectation is already set for the AuthSvc.SessionActive method")
+	}
+
+	if len(mmSessionActive.expectations) > 0 {
+		mmSessionActive.mock.t.Fatalf("Some expectations are already set for the AuthSvc.SessionActive method")
+	}
+
+	mmSessionActive.mock.funcSessionActive = f
+	mmSessionActive.mock.funcSessionActiveOrigin = minimock.CallerInfo(1)
+	return mmSessionActive.mock
+}
+
+// When sets expectation for the AuthSvc.SessionActive which will trigger the result defined by the following
+// Then helper
+func (mmSessionActive *mAuthSvcMockSessionActive) When(ctx context.Context, sessionID string) *AuthSvcMockSessionActiveExpectation {
+	if mmSessionActive.mock.funcSessionActive != nil {
+		mmSessionActive.mock.t.Fatalf("AuthSvcMock.SessionActive mock is already set by Set")
+	}
+
+	expectation := &AuthSvcMockSessionActiveExpectation{
+		mock:               mmSessionActive.mock,
+		params:             &AuthSvcMockSessionActiveParams{ctx, sessionID},
+		expectationOrigins: AuthSvcMockSessionActiveExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSessionActive.expectations = append(mmSessionActive.expectations, expectation)
+	return expectation
+}
+
+// Then sets up AuthSvc.SessionActive return parameters for the expectation previously defined by the When method
+func (e *AuthSvcMockSessionActiveExpectation) Then(active bool, deviceKey []byte, err error) *AuthSvcMock {
+	e.results = &AuthSvcMockSessionActiveResults{active, deviceKey, err}
+	return e.mock
+}
+
+// Times sets number of times AuthSvc.SessionActive should be invoked
+func (mmSessionActive *mAuthSvcMockSessionActive) Times(n uint64) *mAuthSvcMockSessionActive {
+	if n == 0 {
+		mmSessionActive.mock.t.Fatalf("Times of AuthSvcMock.SessionActive mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSessionActive.expectedInvocations, n)
+	mmSessionActive.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSessionActive
+}
+
+func (mmSessionActive *mAuthSvcMockSessionActive) invocationsDone() bool {
+	if len(mmSessionActive.expectations) == 0 && mmSessionActive.defaultExpectation == nil && mmSessionActive.mock.funcSessionActive == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSessionActive.mock.afterSessionActiveCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSessionActive.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SessionActive implements mm_handler.AuthSvc
+func (mmSessionActive *AuthSvcMock) SessionActive(ctx context.Context, sessionID string) (active bool, deviceKey []byte, err error) {
+	mm_atomic.AddUint64(&mmSessionActive.beforeSessionActiveCounter, 1)
+	defer mm_atomic.AddUint64(&mmSessionActive.afterSessionActiveCounter, 1)
+
+	mmSessionActive.t.Helper()
+
+	if mmSessionActive.inspectFuncSessionActive != nil {
+		mmSessionActive.inspectFuncSessionActive(ctx, sessionID)
+	}
+
+	mm_params := AuthSvcMockSessionActiveParams{ctx, sessionID}
+
+	// Record call args
+	mmSessionActive.SessionActiveMock.mutex.Lock()
+	mmSessionActive.SessionActiveMock.callArgs = append(mmSessionActive.SessionActiveMock.callArgs, &mm_params)
+	mmSessionActive.SessionActiveMock.mutex.Unlock()
+
+	for _, e := range mmSessionActive.SessionActiveMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.active, e.results.deviceKey, e.results.err
+		}
+	}
+
+	if mmSessionActive.SessionActiveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSessionActive.SessionActiveMock.defaultExpectation.Counter, 1)
+		mm_want := mmSessionActive.SessionActiveMock.defaultExpectation.params
+		mm_want_ptrs := mmSessionActive.SessionActiveMock.defaultExpectation.paramPtrs
+
+		mm_got := AuthSvcMockSessionActiveParams{ctx, sessionID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSessionActive.t.Errorf("AuthSvcMock.SessionActive got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSessionActive.SessionActiveMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.sessionID != nil && !minimock.Equal(*mm_want_ptrs.sessionID, mm_got.sessionID) {
+				mmSessionActive.t.Errorf("AuthSvcMock.SessionActive got unexpected parameter sessionID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSessionActive.SessionActiveMock.defaultExpectation.expectationOrigins.originSessionID, *mm_want_ptrs.sessionID, mm_got.sessionID, minimock.Diff(*mm_want_ptrs.sessionID, mm_got.sessionID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSessionActive.t.Errorf("AuthSvcMock.SessionActive got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSessionActive.SessionActiveMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSessionActive.SessionActiveMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSessionActive.t.Fatal("No results are set for the AuthSvcMock.SessionActive")
+		}
+		return (*mm_results).active, (*mm_results).deviceKey, (*mm_results).err
+	}
+	if mmSessionActive.funcSessionActive != nil {
+		return mmSessionActive.funcSessionActive(ctx, sessionID)
+	}
+	mmSessionActive.t.Fatalf("Unexpected call to AuthSvcMock.SessionActive. %v %v", ctx, sessionID)
+	return
+}
+
+// SessionActiveAfterCounter returns a count of finished AuthSvcMock.SessionActive invocations
+func (mmSessionActive *AuthSvcMock) SessionActiveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSessionActive.afterSessionActiveCounter)
+}
+
+// SessionActiveBeforeCounter returns a count of AuthSvcMock.SessionActive invocations
+func (mmSessionActive *AuthSvcMock) SessionActiveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSessionActive.beforeSessionActiveCounter)
+}
+
+// Calls returns a list of arguments used in each call to AuthSvcMock.SessionActive.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSessionActive *mAuthSvcMockSessionActive) Calls() []*AuthSvcMockSessionActiveParams {
+	mmSessionActive.mutex.RLock()
+
+	argCopy := make([]*AuthSvcMockSessionActiveParams, len(mmSessionActive.callArgs))
+	copy(argCopy, mmSessionActive.callArgs)
+
+	mmSessionActive.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSessionActiveDone returns true if the count of the SessionActive invocations corresponds
+// the number of defined expectations
+func (m *AuthSvcMock) MinimockSessionActiveDone() bool {
+	if m.SessionActiveMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SessionActiveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SessionActiveMock.invocationsDone()
+}
+
+// MinimockSessionActiveInspect logs each unmet expectation
+func (m *AuthSvcMock) MinimockSessionActiveInspect() {
+	for _, e := range m.SessionActiveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to AuthSvcMock.SessionActive at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSessionActiveCounter := mm_atomic.LoadUint64(&m.afterSessionActiveCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SessionActiveMock.defaultExpectation != nil && afterSessionActiveCounter < 1 {
+		if m.SessionActiveMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthSvcMock.SessionActive at\n%s", m.SessionActiveMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to AuthSvcMock.SessionActive at\n%s with params: %#v", m.SessionActiveMock.defaultExpectation.expectationOrigins.origin, *m.SessionActiveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSessionActive != nil && afterSessionActiveCounter < 1 {
+		m.t.Errorf("Expected call to AuthSvcMock.SessionActive at\n%s", m.funcSessionActiveOrigin)
 	}
 
-	if !m.GetAccountByUserNameMock.invocationsDone() && afterGetAccountByUserNameCounter > 0 {
-		m.t.Errorf("Expected %d calls to AuthSvcMock.GetAccountByUserName at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetAccountByUserNameMock.expectedInvocations), m.GetAccountByUserNameMock.expectedInvocationsOrigin, afterGetAccountByUserNameCounter)
+	if !m.SessionActiveMock.invocationsDone() && afterSessionActiveCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthSvcMock.SessionActive at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SessionActiveMock.expectedInvocations), m.SessionActiveMock.expectedInvocationsOrigin, afterSessionActiveCounter)
 	}
 }
 
-type mAuthSvcMockGetChallenge struct {
+type mAuthSvcMockSetPublicKey struct {
 	optional           bool
 	mock               *AuthSvcMock
-	defaultExpectation *AuthSvcMockGetChallengeExpectation
-	expectations       []*AuthSvcMockGetChallengeExpectation
+	defaultExpectation *AuthSvcMockSetPublicKeyExpectation
+	expectations       []*AuthSvcMockSetPublicKeyExpectation
 
-	callArgs []*AuthSvcMockGetChallengeParams
+	callArgs []*AuthSvcMockSetPublicKeyParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// AuthSvcMockGetChallengeExpectation specifies expectation struct of the AuthSvc.GetChallenge
-type AuthSvcMockGetChallengeExpectation struct {
+// AuthSvcMockSetPublicKeyExpectation specifies expectation struct of the AuthSvc.SetPublicKey
+type AuthSvcMockSetPublicKeyExpectation struct {
 	mock               *AuthSvcMock
-	params             *AuthSvcMockGetChallengeParams
-	paramPtrs          *AuthSvcMockGetChallengeParamPtrs
-	expectationOrigins AuthSvcMockGetChallengeExpectationOrigins
-	results            *AuthSvcMockGetChallengeResults
+	params             *AuthSvcMockSetPublicKeyParams
+	paramPtrs          *AuthSvcMockSetPublicKeyParamPtrs
+	expectationOrigins AuthSvcMockSetPublicKeyExpectationOrigins
+	results            *AuthSvcMockSetPublicKeyResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// AuthSvcMockGetChallengeParams contains parameters of the AuthSvc.GetChallenge
-type AuthSvcMockGetChallengeParams struct {
-	ctx     context.Context
-	profile models.Profile
+// AuthSvcMockSetPublicKeyParams contains parameters of the AuthSvc.SetPublicKey
+type AuthSvcMockSetPublicKeyParams struct {
+	ctx       context.Context
+	username  string
+	publicKey []byte
 }
 
-// AuthSvcMockGetChallengeParamPtrs contains pointers to parameters of the AuthSvc.GetChallenge
-type AuthSvcMockGetChallengeParamPtrs struct {
-	ctx     *context.Context
-	profile *models.Profile
+// AuthSvcMockSetPublicKeyParamPtrs contains pointers to parameters of the AuthSvc.SetPublicKey
+type AuthSvcMockSetPublicKeyParamPtrs struct {
+	ctx       *context.Context
+	username  *string
+	publicKey *[]byte
 }
 
-// AuthSvcMockGetChallengeResults contains results of the AuthSvc.GetChallenge
-type AuthSvcMockGetChallengeResults struct {
-	challenge string
-	err       error
+// AuthSvcMockSetPublicKeyResults contains results of the AuthSvc.SetPublicKey
+type AuthSvcMockSetPublicKeyResults struct {
+	err error
 }
 
-// AuthSvcMockGetChallengeOrigins contains origins of expectations of the AuthSvc.GetChallenge
-type AuthSvcMockGetChallengeExpectationOrigins struct {
-	origin        string
-	originCtx     string
-	originProfile string
+// AuthSvcMockSetPublicKeyOrigins contains origins of expectations of the AuthSvc.SetPublicKey
+type AuthSvcMockSetPublicKeyExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUsername  string
+	originPublicKey string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -813,292 +6077,320 @@ type AuthSvcMockGetChallengeExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetChallenge *mAuthSvcMockGetChallenge) Optional() *mAuthSvcMockGetChallenge {
-	mmGetChallenge.optional = true
-	return mmGetChallenge
+func (mmSetPublicKey *mAuthSvcMockSetPublicKey) Optional() *mAuthSvcMockSetPublicKey {
+	mmSetPublicKey.optional = true
+	return mmSetPublicKey
 }
 
-// Expect sets up expected params for AuthSvc.GetChallenge
-func (mmGetChallenge *mAuthSvcMockGetChallenge) Expect(ctx context.Context, profile models.Profile) *mAuthSvcMockGetChallenge {
-	if mmGetChallenge.mock.funcGetChallenge != nil {
-		mmGetChallenge.mock.t.Fatalf("AuthSvcMock.GetChallenge mock is already set by Set")
+// Expect sets up expected params for AuthSvc.SetPublicKey
+func (mmSetPublicKey *mAuthSvcMockSetPublicKey) Expect(ctx context.Context, username string, publicKey []byte) *mAuthSvcMockSetPublicKey {
+	if mmSetPublicKey.mock.funcSetPublicKey != nil {
+		mmSetPublicKey.mock.t.Fatalf("AuthSvcMock.SetPublicKey mock is already set by Set")
 	}
 
-	if mmGetChallenge.defaultExpectation == nil {
-		mmGetChallenge.defaultExpectation = &AuthSvcMockGetChallengeExpectation{}
+	if mmSetPublicKey.defaultExpectation == nil {
+		mmSetPublicKey.defaultExpectation = &AuthSvcMockSetPublicKeyExpectation{}
 	}
 
-	if mmGetChallenge.defaultExpectation.paramPtrs != nil {
-		mmGetChallenge.mock.t.Fatalf("AuthSvcMock.GetChallenge mock is already set by ExpectParams functions")
+	if mmSetPublicKey.defaultExpectation.paramPtrs != nil {
+		mmSetPublicKey.mock.t.Fatalf("AuthSvcMock.SetPublicKey mock is already set by ExpectParams functions")
 	}
 
-	mmGetChallenge.defaultExpectation.params = &AuthSvcMockGetChallengeParams{ctx, profile}
-	mmGetChallenge.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetChallenge.expectations {
-		if minimock.Equal(e.params, mmGetChallenge.defaultExpectation.params) {
-			mmGetChallenge.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetChallenge.defaultExpectation.params)
+	mmSetPublicKey.defaultExpectation.params = &AuthSvcMockSetPublicKeyParams{ctx, username, publicKey}
+	mmSetPublicKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetPublicKey.expectations {
+		if minimock.Equal(e.params, mmSetPublicKey.defaultExpectation.params) {
+			mmSetPublicKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetPublicKey.defaultExpectation.params)
 		}
 	}
 
-	return mmGetChallenge
+	return mmSetPublicKey
 }
 
-// ExpectCtxParam1 sets up expected param ctx for AuthSvc.GetChallenge
-func (mmGetChallenge *mAuthSvcMockGetChallenge) ExpectCtxParam1(ctx context.Context) *mAuthSvcMockGetChallenge {
-	if mmGetChallenge.mock.funcGetChallenge != nil {
-		mmGetChallenge.mock.t.Fatalf("AuthSvcMock.GetChallenge mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for AuthSvc.SetPublicKey
+func (mmSetPublicKey *mAuthSvcMockSetPublicKey) ExpectCtxParam1(ctx context.Context) *mAuthSvcMockSetPublicKey {
+	if mmSetPublicKey.mock.funcSetPublicKey != nil {
+		mmSetPublicKey.mock.t.Fatalf("AuthSvcMock.SetPublicKey mock is already set by Set")
 	}
 
-	if mmGetChallenge.defaultExpectation == nil {
-		mmGetChallenge.defaultExpectation = &AuthSvcMockGetChallengeExpectation{}
+	if mmSetPublicKey.defaultExpectation == nil {
+		mmSetPublicKey.defaultExpectation = &AuthSvcMockSetPublicKeyExpectation{}
 	}
 
-	if mmGetChallenge.defaultExpectation.params != nil {
-		mmGetChallenge.mock.t.Fatalf("AuthSvcMock.GetChallenge mock is already set by Expect")
+	if mmSetPublicKey.defaultExpectation.params != nil {
+		mmSetPublicKey.mock.t.Fatalf("AuthSvcMock.SetPublicKey mock is already set by Expect")
 	}
 
-	if mmGetChallenge.defaultExpectation.paramPtrs == nil {
-		mmGetChallenge.defaultExpectation.paramPtrs = &AuthSvcMockGetChallengeParamPtrs{}
+	if mmSetPublicKey.defaultExpectation.paramPtrs == nil {
+		mmSetPublicKey.defaultExpectation.paramPtrs = &AuthSvcMockSetPublicKeyParamPtrs{}
 	}
-	mmGetChallenge.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetChallenge.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmSetPublicKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetPublicKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetChallenge
+	return mmSetPublicKey
 }
 
-// ExpectProfileParam2 sets up expected param profile for AuthSvc.GetChallenge
-func (mmGetChallenge *mAuthSvcMockGetChallenge) ExpectProfileParam2(profile models.Profile) *mAuthSvcMockGetChallenge {
-	if mmGetChallenge.mock.funcGetChallenge != nil {
-		mmGetChallenge.mock.t.Fatalf("AuthSvcMock.GetChallenge mock is already set by Set")
+// ExpectUsernameParam2 sets up expected param username for AuthSvc.SetPublicKey
+func (mmSetPublicKey *mAuthSvcMockSetPublicKey) ExpectUsernameParam2(username string) *mAuthSvcMockSetPublicKey {
+	if mmSetPublicKey.mock.funcSetPublicKey != nil {
+		mmSetPublicKey.mock.t.Fatalf("AuthSvcMock.SetPublicKey mock is already set by Set")
 	}
 
-	if mmGetChallenge.defaultExpectation == nil {
-		mmGetChallenge.defaultExpectation = &AuthSvcMockGetChallengeExpectation{}
+	if mmSetPublicKey.defaultExpectation == nil {
+		mmSetPublicKey.defaultExpectation = &AuthSvcMockSetPublicKeyExpectation{}
 	}
 
-	if mmGetChallenge.defaultExpectation.params != nil {
-		mmGetChallenge.mock.t.Fatalf("AuthSvcMock.GetChallenge mock is already set by Expect")
+	if mmSetPublicKey.defaultExpectation.params != nil {
+		mmSetPublicKey.mock.t.Fatalf("AuthSvcMock.SetPublicKey mock is already set by Expect")
 	}
 
-	if mmGetChallenge.defaultExpectation.paramPtrs == nil {
-		mmGetChallenge.defaultExpectation.paramPtrs = &AuthSvcMockGetChallengeParamPtrs{}
+	if mmSetPublicKey.defaultExpectation.paramPtrs == nil {
+		mmSetPublicKey.defaultExpectation.paramPtrs = &AuthSvcMockSetPublicKeyParamPtrs{}
 	}
-	mmGetChallenge.defaultExpectation.paramPtrs.profile = &profile
-	mmGetChallenge.defaultExpectation.expectationOrigins.originProfile = minimock.CallerInfo(1)
+	mmSetPublicKey.defaultExpectation.paramPtrs.username = &username
+	mmSetPublicKey.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
 
-	return mmGetChallenge
+	return mmSetPublicKey
 }
 
-// Inspect accepts an inspector function that has same arguments as the AuthSvc.GetChallenge
-func (mmGetChallenge *mAuthSvcMockGetChallenge) Inspect(f func(ctx context.Context, profile models.Profile)) *mAuthSvcMockGetChallenge {
-	if mmGetChallenge.mock.inspectFuncGetChallenge != nil {
-		mmGetChallenge.mock.t.Fatalf("Inspect function is already set for AuthSvcMock.GetChallenge")
+// ExpectPublicKeyParam3 sets up expected param publicKey for AuthSvc.SetPublicKey
+func (mmSetPublicKey *mAuthSvcMockSetPublicKey) ExpectPublicKeyParam3(publicKey []byte) *mAuthSvcMockSetPublicKey {
+	if mmSetPublicKey.mock.funcSetPublicKey != nil {
+		mmSetPublicKey.mock.t.Fatalf("AuthSvcMock.SetPublicKey mock is already set by Set")
 	}
 
-	mmGetChallenge.mock.inspectFuncGetChallenge = f
+	if mmSetPublicKey.defaultExpectation == nil {
+		mmSetPublicKey.defaultExpectation = &AuthSvcMockSetPublicKeyExpectation{}
+	}
 
-	return mmGetChallenge
+	if mmSetPublicKey.defaultExpectation.params != nil {
+		mmSetPublicKey.mock.t.Fatalf("AuthSvcMock.SetPublicKey mock is already set by Expect")
+	}
+
+	if mmSetPublicKey.defaultExpectation.paramPtrs == nil {
+		mmSetPublicKey.defaultExpectation.paramPtrs = &AuthSvcMockSetPublicKeyParamPtrs{}
+	}
+	mmSetPublicKey.defaultExpectation.paramPtrs.publicKey = &publicKey
+	mmSetPublicKey.defaultExpectation.expectationOrigins.originPublicKey = minimock.CallerInfo(1)
+
+	return mmSetPublicKey
 }
 
-// Return sets up results that will be returned by AuthSvc.GetChallenge
-func (mmGetChallenge *mAuthSvcMockGetChallenge) Return(challenge string, err error) *AuthSvcMock {
-	if mmGetChallenge.mock.funcGetChallenge != nil {
-		mmGetChallenge.mock.t.Fatalf("AuthSvcMock.GetChallenge mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the AuthSvc.SetPublicKey
+func (mmSetPublicKey *mAuthSvcMockSetPublicKey) Inspect(f func(ctx context.Context, username string, publicKey []byte)) *mAuthSvcMockSetPublicKey {
+	if mmSetPublicKey.mock.inspectFuncSetPublicKey != nil {
+		mmSetPublicKey.mock.t.Fatalf("Inspect function is already set for AuthSvcMock.SetPublicKey")
 	}
 
-	if mmGetChallenge.defaultExpectation == nil {
-		mmGetChallenge.defaultExpectation = &AuthSvcMockGetChallengeExpectation{mock: mmGetChallenge.mock}
+	mmSetPublicKey.mock.inspectFuncSetPublicKey = f
+
+	return mmSetPublicKey
+}
+
+// Return sets up results that will be returned by AuthSvc.SetPublicKey
+func (mmSetPublicKey *mAuthSvcMockSetPublicKey) Return(err error) *AuthSvcMock {
+	if mmSetPublicKey.mock.funcSetPublicKey != nil {
+		mmSetPublicKey.mock.t.Fatalf("AuthSvcMock.SetPublicKey mock is already set by Set")
 	}
-	mmGetChallenge.defaultExpectation.results = &AuthSvcMockGetChallengeResults{challenge, err}
-	mmGetChallenge.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetChallenge.mock
+
+	if mmSetPublicKey.defaultExpectation == nil {
+		mmSetPublicKey.defaultExpectation = &AuthSvcMockSetPublicKeyExpectation{mock: mmSetPublicKey.mock}
+	}
+	mmSetPublicKey.defaultExpectation.results = &AuthSvcMockSetPublicKeyResults{err}
+	mmSetPublicKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetPublicKey.mock
 }
 
-// Set uses given function f to mock the AuthSvc.GetChallenge method
-func (mmGetChallenge *mAuthSvcMockGetChallenge) Set(f func(ctx context.Context, profile models.Profile) (challenge string, err error)) *AuthSvcMock {
-	if mmGetChallenge.defaultExpectation != nil {
-		mmGetChallenge.mock.t.Fatalf("Default expectation is already set for the AuthSvc.GetChallenge method")
+// Set uses given function f to mock the AuthSvc.SetPublicKey method
+func (mmSetPublicKey *mAuthSvcMockSetPublicKey) Set(f func(ctx context.Context, username string, publicKey []byte) (err error)) *AuthSvcMock {
+	if mmSetPublicKey.defaultExpectation != nil {
+		mmSetPublicKey.mock.t.Fatalf("Default expectation is already set for the AuthSvc.SetPublicKey method")
 	}
 
-	if len(mmGetChallenge.expectations) > 0 {
-		mmGetChallenge.mock.t.Fatalf("Some expectations are already set for the AuthSvc.GetChallenge method")
+	if len(mmSetPublicKey.expectations) > 0 {
+		mmSetPublicKey.mock.t.Fatalf("Some expectations are already set for the AuthSvc.SetPublicKey method")
 	}
 
-	mmGetChallenge.mock.funcGetChallenge = f
-	mmGetChallenge.mock.funcGetChallengeOrigin = minimock.CallerInfo(1)
-	return mmGetChallenge.mock
+	mmSetPublicKey.mock.funcSetPublicKey = f
+	mmSetPublicKey.mock.funcSetPublicKeyOrigin = minimock.CallerInfo(1)
+	return mmSetPublicKey.mock
 }
 
-// When sets expectation for the AuthSvc.GetChallenge which will trigger the result defined by the following
+// When sets expectation for the AuthSvc.SetPublicKey which will trigger the result defined by the following
 // Then helper
-func (mmGetChallenge *mAuthSvcMockGetChallenge) When(ctx context.Context, profile models.Profile) *AuthSvcMockGetChallengeExpectation {
-	if mmGetChallenge.mock.funcGetChallenge != nil {
-		mmGetChallenge.mock.t.Fatalf("AuthSvcMock.GetChallenge mock is already set by Set")
+func (mmSetPublicKey *mAuthSvcMockSetPublicKey) When(ctx context.Context, username string, publicKey []byte) *AuthSvcMockSetPublicKeyExpectation {
+	if mmSetPublicKey.mock.funcSetPublicKey != nil {
+		mmSetPublicKey.mock.t.Fatalf("AuthSvcMock.SetPublicKey mock is already set by Set")
 	}
 
-	expectation := &AuthSvcMockGetChallengeExpectation{
-		mock:               mmGetChallenge.mock,
-		params:             &AuthSvcMockGetChallengeParams{ctx, profile},
-		expectationOrigins: AuthSvcMockGetChallengeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &AuthSvcMockSetPublicKeyExpectation{
+		mock:               mmSetPublicKey.mock,
+		params:             &AuthSvcMockSetPublicKeyParams{ctx, username, publicKey},
+		expectationOrigins: AuthSvcMockSetPublicKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetChallenge.expectations = append(mmGetChallenge.expectations, expectation)
+	mmSetPublicKey.expectations = append(mmSetPublicKey.expectations, expectation)
 	return expectation
 }
 
-// Then sets up AuthSvc.GetChallenge return parameters for the expectation previously defined by the When method
-func (e *AuthSvcMockGetChallengeExpectation) Then(challenge string, err error) *AuthSvcMock {
-	e.results = &AuthSvcMockGetChallengeResults{challenge, err}
+// Then sets up AuthSvc.SetPublicKey return parameters for the expectation previously defined by the When method
+func (e *AuthSvcMockSetPublicKeyExpectation) Then(err error) *AuthSvcMock {
+	e.results = &AuthSvcMockSetPublicKeyResults{err}
 	return e.mock
 }
 
-// Times sets number of times AuthSvc.GetChallenge should be invoked
-func (mmGetChallenge *mAuthSvcMockGetChallenge) Times(n uint64) *mAuthSvcMockGetChallenge {
+// Times sets number of times AuthSvc.SetPublicKey should be invoked
+func (mmSetPublicKey *mAuthSvcMockSetPublicKey) Times(n uint64) *mAuthSvcMockSetPublicKey {
 	if n == 0 {
-		mmGetChallenge.mock.t.Fatalf("Times of AuthSvcMock.GetChallenge mock can not be zero")
+		mmSetPublicKey.mock.t.Fatalf("Times of AuthSvcMock.SetPublicKey mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetChallenge.expectedInvocations, n)
-	mmGetChallenge.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetChallenge
+	mm_atomic.StoreUint64(&mmSetPublicKey.expectedInvocations, n)
+	mmSetPublicKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetPublicKey
 }
 
-func (mmGetChallenge *mAuthSvcMockGetChallenge) invocationsDone() bool {
-	if len(mmGetChallenge.expectations) == 0 && mmGetChallenge.defaultExpectation == nil && mmGetChallenge.mock.funcGetChallenge == nil {
+func (mmSetPublicKey *mAuthSvcMockSetPublicKey) invocationsDone() bool {
+	if len(mmSetPublicKey.expectations) == 0 && mmSetPublicKey.defaultExpectation == nil && mmSetPublicKey.mock.funcSetPublicKey == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetChallenge.mock.afterGetChallengeCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetChallenge.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSetPublicKey.mock.afterSetPublicKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetPublicKey.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetChallenge implements mm_handler.AuthSvc
-func (mmGetChallenge *AuthSvcMock) GetChallenge(ctx context.Context, profile models.Profile) (challenge string, err error) {
-	mm_atomic.AddUint64(&mmGetChallenge.beforeGetChallengeCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetChallenge.afterGetChallengeCounter, 1)
+// SetPublicKey implements mm_handler.AuthSvc
+func (mmSetPublicKey *AuthSvcMock) SetPublicKey(ctx context.Context, username string, publicKey []byte) (err error) {
+	mm_atomic.AddUint64(&mmSetPublicKey.beforeSetPublicKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetPublicKey.afterSetPublicKeyCounter, 1)
 
-	mmGetChallenge.t.Helper()
+	mmSetPublicKey.t.Helper()
 
-	if mmGetChallenge.inspectFuncGetChallenge != nil {
-		mmGetChallenge.inspectFuncGetChallenge(ctx, profile)
+	if mmSetPublicKey.inspectFuncSetPublicKey != nil {
+		mmSetPublicKey.inspectFuncSetPublicKey(ctx, username, publicKey)
 	}
 
-	mm_params := AuthSvcMockGetChallengeParams{ctx, profile}
+	mm_params := AuthSvcMockSetPublicKeyParams{ctx, username, publicKey}
 
 	// Record call args
-	mmGetChallenge.GetChallengeMock.mutex.Lock()
-	mmGetChallenge.GetChallengeMock.callArgs = append(mmGetChallenge.GetChallengeMock.callArgs, &mm_params)
-	mmGetChallenge.GetChallengeMock.mutex.Unlock()
+	mmSetPublicKey.SetPublicKeyMock.mutex.Lock()
+	mmSetPublicKey.SetPublicKeyMock.callArgs = append(mmSetPublicKey.SetPublicKeyMock.callArgs, &mm_params)
+	mmSetPublicKey.SetPublicKeyMock.mutex.Unlock()
 
-	for _, e := range mmGetChallenge.GetChallengeMock.expectations {
+	for _, e := range mmSetPublicKey.SetPublicKeyMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.challenge, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGetChallenge.GetChallengeMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetChallenge.GetChallengeMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetChallenge.GetChallengeMock.defaultExpectation.params
-		mm_want_ptrs := mmGetChallenge.GetChallengeMock.defaultExpectation.paramPtrs
+	if mmSetPublicKey.SetPublicKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetPublicKey.SetPublicKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetPublicKey.SetPublicKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmSetPublicKey.SetPublicKeyMock.defaultExpectation.paramPtrs
 
-		mm_got := AuthSvcMockGetChallengeParams{ctx, profile}
+		mm_got := AuthSvcMockSetPublicKeyParams{ctx, username, publicKey}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetChallenge.t.Errorf("AuthSvcMock.GetChallenge got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetChallenge.GetChallengeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmSetPublicKey.t.Errorf("AuthSvcMock.SetPublicKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetPublicKey.SetPublicKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.profile != nil && !minimock.Equal(*mm_want_ptrs.profile, mm_got.profile) {
-				mmGetChallenge.t.Errorf("AuthSvcMock.GetChallenge got unexpected parameter profile, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetChallenge.GetChallengeMock.defaultExpectation.expectationOrigins.originProfile, *mm_want_ptrs.profile, mm_got.profile, minimock.Diff(*mm_want_ptrs.profile, mm_got.profile))
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmSetPublicKey.t.Errorf("AuthSvcMock.SetPublicKey got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetPublicKey.SetPublicKeyMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.publicKey != nil && !minimock.Equal(*mm_want_ptrs.publicKey, mm_got.publicKey) {
+				mmSetPublicKey.t.Errorf("AuthSvcMock.SetPublicKey got unexpected parameter publicKey, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetPublicKey.SetPublicKeyMock.defaultExpectation.expectationOrigins.originPublicKey, *mm_want_ptrs.publicKey, mm_got.publicKey, minimock.Diff(*mm_want_ptrs.publicKey, mm_got.publicKey))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetChallenge.t.Errorf("AuthSvcMock.GetChallenge got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetChallenge.GetChallengeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSetPublicKey.t.Errorf("AuthSvcMock.SetPublicKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetPublicKey.SetPublicKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetChallenge.GetChallengeMock.defaultExpectation.results
+		mm_results := mmSetPublicKey.SetPublicKeyMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetChallenge.t.Fatal("No results are set for the AuthSvcMock.GetChallenge")
+			mmSetPublicKey.t.Fatal("No results are set for the AuthSvcMock.SetPublicKey")
 		}
-		return (*mm_results).challenge, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetChallenge.funcGetChallenge != nil {
-		return mmGetChallenge.funcGetChallenge(ctx, profile)
+	if mmSetPublicKey.funcSetPublicKey != nil {
+		return mmSetPublicKey.funcSetPublicKey(ctx, username, publicKey)
 	}
-	mmGetChallenge.t.Fatalf("Unexpected call to AuthSvcMock.GetChallenge. %v %v", ctx, profile)
+	mmSetPublicKey.t.Fatalf("Unexpected call to AuthSvcMock.SetPublicKey. %v %v %v", ctx, username, publicKey)
 	return
 }
 
-// GetChallengeAfterCounter returns a count of finished AuthSvcMock.GetChallenge invocations
-func (mmGetChallenge *AuthSvcMock) GetChallengeAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetChallenge.afterGetChallengeCounter)
+// SetPublicKeyAfterCounter returns a count of finished AuthSvcMock.SetPublicKey invocations
+func (mmSetPublicKey *AuthSvcMock) SetPublicKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetPublicKey.afterSetPublicKeyCounter)
 }
 
-// GetChallengeBeforeCounter returns a count of AuthSvcMock.GetChallenge invocations
-func (mmGetChallenge *AuthSvcMock) GetChallengeBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetChallenge.beforeGetChallengeCounter)
+// SetPublicKeyBeforeCounter returns a count of AuthSvcMock.SetPublicKey invocations
+func (mmSetPublicKey *AuthSvcMock) SetPublicKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetPublicKey.beforeSetPublicKeyCounter)
 }
 
-// Calls returns a list of arguments used in each call to AuthSvcMock.GetChallenge.
+// Calls returns a list of arguments used in each call to AuthSvcMock.SetPublicKey.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetChallenge *mAuthSvcMockGetChallenge) Calls() []*AuthSvcMockGetChallengeParams {
-	mmGetChallenge.mutex.RLock()
+func (mmSetPublicKey *mAuthSvcMockSetPublicKey) Calls() []*AuthSvcMockSetPublicKeyParams {
+	mmSetPublicKey.mutex.RLock()
 
-	argCopy := make([]*AuthSvcMockGetChallengeParams, len(mmGetChallenge.callArgs))
-	copy(argCopy, mmGetChallenge.callArgs)
+	argCopy := make([]*AuthSvcMockSetPublicKeyParams, len(mmSetPublicKey.callArgs))
+	copy(argCopy, mmSetPublicKey.callArgs)
 
-	mmGetChallenge.mutex.RUnlock()
+	mmSetPublicKey.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetChallengeDone returns true if the count of the GetChallenge invocations corresponds
+// MinimockSetPublicKeyDone returns true if the count of the SetPublicKey invocations corresponds
 // the number of defined expectations
-func (m *AuthSvcMock) MinimockGetChallengeDone() bool {
-	if m.GetChallengeMock.optional {
+func (m *AuthSvcMock) MinimockSetPublicKeyDone() bool {
+	if m.SetPublicKeyMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetChallengeMock.expectations {
+	for _, e := range m.SetPublicKeyMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetChallengeMock.invocationsDone()
+	return m.SetPublicKeyMock.invocationsDone()
 }
 
-// MinimockGetChallengeInspect logs each unmet expectation
-func (m *AuthSvcMock) MinimockGetChallengeInspect() {
-	for _, e := range m.GetChallengeMock.expectations {
+// MinimockSetPublicKeyInspect logs each unmet expectation
+func (m *AuthSvcMock) MinimockSetPublicKeyInspect() {
+	for _, e := range m.SetPublicKeyMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to AuthSvcMock.GetChallenge at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to AuthSvcMock.SetPublicKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetChallengeCounter := mm_atomic.LoadUint64(&m.afterGetChallengeCounter)
+	afterSetPublicKeyCounter := mm_atomic.LoadUint64(&m.afterSetPublicKeyCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetChallengeMock.defaultExpectation != nil && afterGetChallengeCounter < 1 {
-		if m.GetChallengeMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to AuthSvcMock.GetChallenge at\n%s", m.GetChallengeMock.defaultExpectation.returnOrigin)
+	if m.SetPublicKeyMock.defaultExpectation != nil && afterSetPublicKeyCounter < 1 {
+		if m.SetPublicKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to AuthSvcMock.SetPublicKey at\n%s", m.SetPublicKeyMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to AuthSvcMock.GetChallenge at\n%s with params: %#v", m.GetChallengeMock.defaultExpectation.expectationOrigins.origin, *m.GetChallengeMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to AuthSvcMock.SetPublicKey at\n%s with params: %#v", m.SetPublicKeyMock.defaultExpectation.expectationOrigins.origin, *m.SetPublicKeyMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetChallenge != nil && afterGetChallengeCounter < 1 {
-		m.t.Errorf("Expected call to AuthSvcMock.GetChallenge at\n%s", m.funcGetChallengeOrigin)
+	if m.funcSetPublicKey != nil && afterSetPublicKeyCounter < 1 {
+		m.t.Errorf("Expected call to AuthSvcMock.SetPublicKey at\n%s", m.funcSetPublicKeyOrigin)
 	}
 
-	if !m.GetChallengeMock.invocationsDone() && afterGetChallengeCounter > 0 {
-		m.t.Errorf("Expected %d calls to AuthSvcMock.GetChallenge at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetChallengeMock.expectedInvocations), m.GetChallengeMock.expectedInvocationsOrigin, afterGetChallengeCounter)
+	if !m.SetPublicKeyMock.invocationsDone() && afterSetPublicKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to AuthSvcMock.SetPublicKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetPublicKeyMock.expectedInvocations), m.SetPublicKeyMock.expectedInvocationsOrigin, afterSetPublicKeyCounter)
 	}
 }
 
@@ -1131,6 +6423,8 @@ type AuthSvcMockSignInParams struct {
 	ctx       context.Context
 	profile   models.Profile
 	challenge string
+	client    string
+	reg       *device.Registration
 }
 
 // AuthSvcMockSignInParamPtrs contains pointers to parameters of the AuthSvc.SignIn
@@ -1138,6 +6432,8 @@ type AuthSvcMockSignInParamPtrs struct {
 	ctx       *context.Context
 	profile   *models.Profile
 	challenge *string
+	client    *string
+	reg       **device.Registration
 }
 
 // AuthSvcMockSignInResults contains results of the AuthSvc.SignIn
@@ -1153,6 +6449,8 @@ type AuthSvcMockSignInExpectationOrigins struct {
 	originCtx       string
 	originProfile   string
 	originChallenge string
+	originClient    string
+	originReg       string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1166,7 +6464,7 @@ func (mmSignIn *mAuthSvcMockSignIn) Optional() *mAuthSvcMockSignIn {
 }
 
 // Expect sets up expected params for AuthSvc.SignIn
-func (mmSignIn *mAuthSvcMockSignIn) Expect(ctx context.Context, profile models.Profile, challenge string) *mAuthSvcMockSignIn {
+func (mmSignIn *mAuthSvcMockSignIn) Expect(ctx context.Context, profile models.Profile, challenge string, client string, reg *device.Registration) *mAuthSvcMockSignIn {
 	if mmSignIn.mock.funcSignIn != nil {
 		mmSignIn.mock.t.Fatalf("AuthSvcMock.SignIn mock is already set by Set")
 	}
@@ -1179,7 +6477,7 @@ func (mmSignIn *mAuthSvcMockSignIn) Expect(ctx context.Context, profile models.P
 		mmSignIn.mock.t.Fatalf("AuthSvcMock.SignIn mock is already set by ExpectParams functions")
 	}
 
-	mmSignIn.defaultExpectation.params = &AuthSvcMockSignInParams{ctx, profile, challenge}
+	mmSignIn.defaultExpectation.params = &AuthSvcMockSignInParams{ctx, profile, challenge, client, reg}
 	mmSignIn.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmSignIn.expectations {
 		if minimock.Equal(e.params, mmSignIn.defaultExpectation.params) {
@@ -1259,8 +6557,54 @@ func (mmSignIn *mAuthSvcMockSignIn) ExpectChallengeParam3(challenge string) *mAu
 	return mmSignIn
 }
 
+// ExpectClientParam4 sets up expected param client for AuthSvc.SignIn
+func (mmSignIn *mAuthSvcMockSignIn) ExpectClientParam4(client string) *mAuthSvcMockSignIn {
+	if mmSignIn.mock.funcSignIn != nil {
+		mmSignIn.mock.t.Fatalf("AuthSvcMock.SignIn mock is already set by Set")
+	}
+
+	if mmSignIn.defaultExpectation == nil {
+		mmSignIn.defaultExpectation = &AuthSvcMockSignInExpectation{}
+	}
+
+	if mmSignIn.defaultExpectation.params != nil {
+		mmSignIn.mock.t.Fatalf("AuthSvcMock.SignIn mock is already set by Expect")
+	}
+
+	if mmSignIn.defaultExpectation.paramPtrs == nil {
+		mmSignIn.defaultExpectation.paramPtrs = &AuthSvcMockSignInParamPtrs{}
+	}
+	mmSignIn.defaultExpectation.paramPtrs.client = &client
+	mmSignIn.defaultExpectation.expectationOrigins.originClient = minimock.CallerInfo(1)
+
+	return mmSignIn
+}
+
+// ExpectRegParam5 sets up expected param reg for AuthSvc.SignIn
+func (mmSignIn *mAuthSvcMockSignIn) ExpectRegParam5(reg *device.Registration) *mAuthSvcMockSignIn {
+	if mmSignIn.mock.funcSignIn != nil {
+		mmSignIn.mock.t.Fatalf("AuthSvcMock.SignIn mock is already set by Set")
+	}
+
+	if mmSignIn.defaultExpectation == nil {
+		mmSignIn.defaultExpectation = &AuthSvcMockSignInExpectation{}
+	}
+
+	if mmSignIn.defaultExpectation.params != nil {
+		mmSignIn.mock.t.Fatalf("AuthSvcMock.SignIn mock is already set by Expect")
+	}
+
+	if mmSignIn.defaultExpectation.paramPtrs == nil {
+		mmSignIn.defaultExpectation.paramPtrs = &AuthSvcMockSignInParamPtrs{}
+	}
+	mmSignIn.defaultExpectation.paramPtrs.reg = &reg
+	mmSignIn.defaultExpectation.expectationOrigins.originReg = minimock.CallerInfo(1)
+
+	return mmSignIn
+}
+
 // Inspect accepts an inspector function that has same arguments as the AuthSvc.SignIn
-func (mmSignIn *mAuthSvcMockSignIn) Inspect(f func(ctx context.Context, profile models.Profile, challenge string)) *mAuthSvcMockSignIn {
+func (mmSignIn *mAuthSvcMockSignIn) Inspect(f func(ctx context.Context, profile models.Profile, challenge string, client string, reg *device.Registration)) *mAuthSvcMockSignIn {
 	if mmSignIn.mock.inspectFuncSignIn != nil {
 		mmSignIn.mock.t.Fatalf("Inspect function is already set for AuthSvcMock.SignIn")
 	}
@@ -1285,7 +6629,7 @@ func (mmSignIn *mAuthSvcMockSignIn) Return(token string, refresh string, err err
 }
 
 // Set uses given function f to mock the AuthSvc.SignIn method
-func (mmSignIn *mAuthSvcMockSignIn) Set(f func(ctx context.Context, profile models.Profile, challenge string) (token string, refresh string, err error)) *AuthSvcMock {
+func (mmSignIn *mAuthSvcMockSignIn) Set(f func(ctx context.Context, profile models.Profile, challenge string, client string, reg *device.Registration) (token string, refresh string, err error)) *AuthSvcMock {
 	if mmSignIn.defaultExpectation != nil {
 		mmSignIn.mock.t.Fatalf("Default expectation is already set for the AuthSvc.SignIn method")
 	}
@@ -1301,14 +6645,14 @@ func (mmSignIn *mAuthSvcMockSignIn) Set(f func(ctx context.Context, profile mode
 
 // When sets expectation for the AuthSvc.SignIn which will trigger the result defined by the following
 // Then helper
-func (mmSignIn *mAuthSvcMockSignIn) When(ctx context.Context, profile models.Profile, challenge string) *AuthSvcMockSignInExpectation {
+func (mmSignIn *mAuthSvcMockSignIn) When(ctx context.Context, profile models.Profile, challenge string, client string, reg *device.Registration) *AuthSvcMockSignInExpectation {
 	if mmSignIn.mock.funcSignIn != nil {
 		mmSignIn.mock.t.Fatalf("AuthSvcMock.SignIn mock is already set by Set")
 	}
 
 	expectation := &AuthSvcMockSignInExpectation{
 		mock:               mmSignIn.mock,
-		params:             &AuthSvcMockSignInParams{ctx, profile, challenge},
+		params:             &AuthSvcMockSignInParams{ctx, profile, challenge, client, reg},
 		expectationOrigins: AuthSvcMockSignInExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmSignIn.expectations = append(mmSignIn.expectations, expectation)
@@ -1343,17 +6687,17 @@ func (mmSignIn *mAuthSvcMockSignIn) invocationsDone() bool {
 }
 
 // SignIn implements mm_handler.AuthSvc
-func (mmSignIn *AuthSvcMock) SignIn(ctx context.Context, profile models.Profile, challenge string) (token string, refresh string, err error) {
+func (mmSignIn *AuthSvcMock) SignIn(ctx context.Context, profile models.Profile, challenge string, client string, reg *device.Registration) (token string, refresh string, err error) {
 	mm_atomic.AddUint64(&mmSignIn.beforeSignInCounter, 1)
 	defer mm_atomic.AddUint64(&mmSignIn.afterSignInCounter, 1)
 
 	mmSignIn.t.Helper()
 
 	if mmSignIn.inspectFuncSignIn != nil {
-		mmSignIn.inspectFuncSignIn(ctx, profile, challenge)
+		mmSignIn.inspectFuncSignIn(ctx, profile, challenge, client, reg)
 	}
 
-	mm_params := AuthSvcMockSignInParams{ctx, profile, challenge}
+	mm_params := AuthSvcMockSignInParams{ctx, profile, challenge, client, reg}
 
 	// Record call args
 	mmSignIn.SignInMock.mutex.Lock()
@@ -1372,7 +6716,7 @@ func (mmSignIn *AuthSvcMock) SignIn(ctx context.Context, profile models.Profile,
 		mm_want := mmSignIn.SignInMock.defaultExpectation.params
 		mm_want_ptrs := mmSignIn.SignInMock.defaultExpectation.paramPtrs
 
-		mm_got := AuthSvcMockSignInParams{ctx, profile, challenge}
+		mm_got := AuthSvcMockSignInParams{ctx, profile, challenge, client, reg}
 
 		if mm_want_ptrs != nil {
 
@@ -1391,6 +6735,16 @@ func (mmSignIn *AuthSvcMock) SignIn(ctx context.Context, profile models.Profile,
 					mmSignIn.SignInMock.defaultExpectation.expectationOrigins.originChallenge, *mm_want_ptrs.challenge, mm_got.challenge, minimock.Diff(*mm_want_ptrs.challenge, mm_got.challenge))
 			}
 
+			if mm_want_ptrs.client != nil && !minimock.Equal(*mm_want_ptrs.client, mm_got.client) {
+				mmSignIn.t.Errorf("AuthSvcMock.SignIn got unexpected parameter client, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSignIn.SignInMock.defaultExpectation.expectationOrigins.originClient, *mm_want_ptrs.client, mm_got.client, minimock.Diff(*mm_want_ptrs.client, mm_got.client))
+			}
+
+			if mm_want_ptrs.reg != nil && !minimock.Equal(*mm_want_ptrs.reg, mm_got.reg) {
+				mmSignIn.t.Errorf("AuthSvcMock.SignIn got unexpected parameter reg, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSignIn.SignInMock.defaultExpectation.expectationOrigins.originReg, *mm_want_ptrs.reg, mm_got.reg, minimock.Diff(*mm_want_ptrs.reg, mm_got.reg))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmSignIn.t.Errorf("AuthSvcMock.SignIn got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmSignIn.SignInMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -1403,9 +6757,9 @@ func (mmSignIn *AuthSvcMock) SignIn(ctx context.Context, profile models.Profile,
 		return (*mm_results).token, (*mm_results).refresh, (*mm_results).err
 	}
 	if mmSignIn.funcSignIn != nil {
-		return mmSignIn.funcSignIn(ctx, profile, challenge)
+		return mmSignIn.funcSignIn(ctx, profile, challenge, client, reg)
 	}
-	mmSignIn.t.Fatalf("Unexpected call to AuthSvcMock.SignIn. %v %v %v", ctx, profile, challenge)
+	mmSignIn.t.Fatalf("Unexpected call to AuthSvcMock.SignIn. %v %v %v %v %v", ctx, profile, challenge, client, reg)
 	return
 }
 
@@ -1481,12 +6835,40 @@ func (m *AuthSvcMock) MinimockSignInInspect() {
 func (m *AuthSvcMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockAuthenticateAPIKeyInspect()
+
+			m.MinimockChangePasswordInspect()
+
+			m.MinimockCreateAPIKeyInspect()
+
 			m.MinimockCreateProfileInspect()
 
+			m.MinimockCreateRecoveryKitInspect()
+
+			m.MinimockDeleteDeviceInspect()
+
+			m.MinimockDeleteRecoveryKitInspect()
+
+			m.MinimockGetAPIKeysInspect()
+
 			m.MinimockGetAccountByUserNameInspect()
 
 			m.MinimockGetChallengeInspect()
 
+			m.MinimockGetDevicesInspect()
+
+			m.MinimockGetJWKSInspect()
+
+			m.MinimockGetRecoveryKitInspect()
+
+			m.MinimockRecoverInspect()
+
+			m.MinimockRevokeAPIKeyInspect()
+
+			m.MinimockSessionActiveInspect()
+
+			m.MinimockSetPublicKeyInspect()
+
 			m.MinimockSignInInspect()
 		}
 	})
@@ -1511,8 +6893,22 @@ func (m *AuthSvcMock) MinimockWait(timeout mm_time.Duration) {
 func (m *AuthSvcMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockAuthenticateAPIKeyDone() &&
+		m.MinimockChangePasswordDone() &&
+		m.MinimockCreateAPIKeyDone() &&
 		m.MinimockCreateProfileDone() &&
+		m.MinimockCreateRecoveryKitDone() &&
+		m.MinimockDeleteDeviceDone() &&
+		m.MinimockDeleteRecoveryKitDone() &&
+		m.MinimockGetAPIKeysDone() &&
 		m.MinimockGetAccountByUserNameDone() &&
 		m.MinimockGetChallengeDone() &&
+		m.MinimockGetDevicesDone() &&
+		m.MinimockGetJWKSDone() &&
+		m.MinimockGetRecoveryKitDone() &&
+		m.MinimockRecoverDone() &&
+		m.MinimockRevokeAPIKeyDone() &&
+		m.MinimockSessionActiveDone() &&
+		m.MinimockSetPublicKeyDone() &&
 		m.MinimockSignInDone()
 }
@@ -0,0 +1,2738 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.3). DO NOT EDIT.
+
+package mock_service
+
+//go:generate minimock -i github.com/gleb-korostelev/GophKeeper/internal/handler.EmergencySvc -o emergency_svc_mock.go -n EmergencySvcMock -p mock_service
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gleb-korostelev/GophKeeper/models/emergency"
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
+	"github.com/gojuno/minimock/v3"
+)
+
+// EmergencySvcMock implements mm_handler.EmergencySvc
+type EmergencySvcMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddContact          func(ctx context.Context, contact emergency.Contact) (err error)
+	funcAddContactOrigin    string
+	inspectFuncAddContact   func(ctx context.Context, contact emergency.Contact)
+	afterAddContactCounter  uint64
+	beforeAddContactCounter uint64
+	AddContactMock          mEmergencySvcMockAddContact
+
+	funcApprove          func(ctx context.Context, grantor string, grantee string) (err error)
+	funcApproveOrigin    string
+	inspectFuncApprove   func(ctx context.Context, grantor string, grantee string)
+	afterApproveCounter  uint64
+	beforeApproveCounter uint64
+	ApproveMock          mEmergencySvcMockApprove
+
+	funcGetContacts          func(ctx context.Context, username string) (ca1 []emergency.Contact, err error)
+	funcGetContactsOrigin    string
+	inspectFuncGetContacts   func(ctx context.Context, username string)
+	afterGetContactsCounter  uint64
+	beforeGetContactsCounter uint64
+	GetContactsMock          mEmergencySvcMockGetContacts
+
+	funcGetGrantorCards          func(ctx context.Context, grantee string, grantor string, page pagination.Request) (cards []profile.CardInfo, next string, err error)
+	funcGetGrantorCardsOrigin    string
+	inspectFuncGetGrantorCards   func(ctx context.Context, grantee string, grantor string, page pagination.Request)
+	afterGetGrantorCardsCounter  uint64
+	beforeGetGrantorCardsCounter uint64
+	GetGrantorCardsMock          mEmergencySvcMockGetGrantorCards
+
+	funcReject          func(ctx context.Context, grantor string, grantee string) (err error)
+	funcRejectOrigin    string
+	inspectFuncReject   func(ctx context.Context, grantor string, grantee string)
+	afterRejectCounter  uint64
+	beforeRejectCounter uint64
+	RejectMock          mEmergencySvcMockReject
+
+	funcRemoveContact          func(ctx context.Context, grantor string, grantee string) (err error)
+	funcRemoveContactOrigin    string
+	inspectFuncRemoveContact   func(ctx context.Context, grantor string, grantee string)
+	afterRemoveContactCounter  uint64
+	beforeRemoveContactCounter uint64
+	RemoveContactMock          mEmergencySvcMockRemoveContact
+
+	funcRequestAccess          func(ctx context.Context, grantee string, grantor string) (err error)
+	funcRequestAccessOrigin    string
+	inspectFuncRequestAccess   func(ctx context.Context, grantee string, grantor string)
+	afterRequestAccessCounter  uint64
+	beforeRequestAccessCounter uint64
+	RequestAccessMock          mEmergencySvcMockRequestAccess
+}
+
+// NewEmergencySvcMock returns a mock for mm_handler.EmergencySvc
+func NewEmergencySvcMock(t minimock.Tester) *EmergencySvcMock {
+	m := &EmergencySvcMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddContactMock = mEmergencySvcMockAddContact{mock: m}
+	m.AddContactMock.callArgs = []*EmergencySvcMockAddContactParams{}
+
+	m.ApproveMock = mEmergencySvcMockApprove{mock: m}
+	m.ApproveMock.callArgs = []*EmergencySvcMockApproveParams{}
+
+	m.GetContactsMock = mEmergencySvcMockGetContacts{mock: m}
+	m.GetContactsMock.callArgs = []*EmergencySvcMockGetContactsParams{}
+
+	m.GetGrantorCardsMock = mEmergencySvcMockGetGrantorCards{mock: m}
+	m.GetGrantorCardsMock.callArgs = []*EmergencySvcMockGetGrantorCardsParams{}
+
+	m.RejectMock = mEmergencySvcMockReject{mock: m}
+	m.RejectMock.callArgs = []*EmergencySvcMockRejectParams{}
+
+	m.RemoveContactMock = mEmergencySvcMockRemoveContact{mock: m}
+	m.RemoveContactMock.callArgs = []*EmergencySvcMockRemoveContactParams{}
+
+	m.RequestAccessMock = mEmergencySvcMockRequestAccess{mock: m}
+	m.RequestAccessMock.callArgs = []*EmergencySvcMockRequestAccessParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mEmergencySvcMockAddContact struct {
+	optional           bool
+	mock               *EmergencySvcMock
+	defaultExpectation *EmergencySvcMockAddContactExpectation
+	expectations       []*EmergencySvcMockAddContactExpectation
+
+	callArgs []*EmergencySvcMockAddContactParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EmergencySvcMockAddContactExpectation specifies expectation struct of the EmergencySvc.AddContact
+type EmergencySvcMockAddContactExpectation struct {
+	mock               *EmergencySvcMock
+	params             *EmergencySvcMockAddContactParams
+	paramPtrs          *EmergencySvcMockAddContactParamPtrs
+	expectationOrigins EmergencySvcMockAddContactExpectationOrigins
+	results            *EmergencySvcMockAddContactResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EmergencySvcMockAddContactParams contains parameters of the EmergencySvc.AddContact
+type EmergencySvcMockAddContactParams struct {
+	ctx     context.Context
+	contact emergency.Contact
+}
+
+// EmergencySvcMockAddContactParamPtrs contains pointers to parameters of the EmergencySvc.AddContact
+type EmergencySvcMockAddContactParamPtrs struct {
+	ctx     *context.Context
+	contact *emergency.Contact
+}
+
+// EmergencySvcMockAddContactResults contains results of the EmergencySvc.AddContact
+type EmergencySvcMockAddContactResults struct {
+	err error
+}
+
+// EmergencySvcMockAddContactOrigins contains origins of expectations of the EmergencySvc.AddContact
+type EmergencySvcMockAddContactExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originContact string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddContact *mEmergencySvcMockAddContact) Optional() *mEmergencySvcMockAddContact {
+	mmAddContact.optional = true
+	return mmAddContact
+}
+
+// Expect sets up expected params for EmergencySvc.AddContact
+func (mmAddContact *mEmergencySvcMockAddContact) Expect(ctx context.Context, contact emergency.Contact) *mEmergencySvcMockAddContact {
+	if mmAddContact.mock.funcAddContact != nil {
+		mmAddContact.mock.t.Fatalf("EmergencySvcMock.AddContact mock is already set by Set")
+	}
+
+	if mmAddContact.defaultExpectation == nil {
+		mmAddContact.defaultExpectation = &EmergencySvcMockAddContactExpectation{}
+	}
+
+	if mmAddContact.defaultExpectation.paramPtrs != nil {
+		mmAddContact.mock.t.Fatalf("EmergencySvcMock.AddContact mock is already set by ExpectParams functions")
+	}
+
+	mmAddContact.defaultExpectation.params = &EmergencySvcMockAddContactParams{ctx, contact}
+	mmAddContact.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddContact.expectations {
+		if minimock.Equal(e.params, mmAddContact.defaultExpectation.params) {
+			mmAddContact.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddContact.defaultExpectation.params)
+		}
+	}
+
+	return mmAddContact
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EmergencySvc.AddContact
+func (mmAddContact *mEmergencySvcMockAddContact) ExpectCtxParam1(ctx context.Context) *mEmergencySvcMockAddContact {
+	if mmAddContact.mock.funcAddContact != nil {
+		mmAddContact.mock.t.Fatalf("EmergencySvcMock.AddContact mock is already set by Set")
+	}
+
+	if mmAddContact.defaultExpectation == nil {
+		mmAddContact.defaultExpectation = &EmergencySvcMockAddContactExpectation{}
+	}
+
+	if mmAddContact.defaultExpectation.params != nil {
+		mmAddContact.mock.t.Fatalf("EmergencySvcMock.AddContact mock is already set by Expect")
+	}
+
+	if mmAddContact.defaultExpectation.paramPtrs == nil {
+		mmAddContact.defaultExpectation.paramPtrs = &EmergencySvcMockAddContactParamPtrs{}
+	}
+	mmAddContact.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddContact.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddContact
+}
+
+// ExpectContactParam2 sets up expected param contact for EmergencySvc.AddContact
+func (mmAddContact *mEmergencySvcMockAddContact) ExpectContactParam2(contact emergency.Contact) *mEmergencySvcMockAddContact {
+	if mmAddContact.mock.funcAddContact != nil {
+		mmAddContact.mock.t.Fatalf("EmergencySvcMock.AddContact mock is already set by Set")
+	}
+
+	if mmAddContact.defaultExpectation == nil {
+		mmAddContact.defaultExpectation = &EmergencySvcMockAddContactExpectation{}
+	}
+
+	if mmAddContact.defaultExpectation.params != nil {
+		mmAddContact.mock.t.Fatalf("EmergencySvcMock.AddContact mock is already set by Expect")
+	}
+
+	if mmAddContact.defaultExpectation.paramPtrs == nil {
+		mmAddContact.defaultExpectation.paramPtrs = &EmergencySvcMockAddContactParamPtrs{}
+	}
+	mmAddContact.defaultExpectation.paramPtrs.contact = &contact
+	mmAddContact.defaultExpectation.expectationOrigins.originContact = minimock.CallerInfo(1)
+
+	return mmAddContact
+}
+
+// Inspect accepts an inspector function that has same arguments as the EmergencySvc.AddContact
+func (mmAddContact *mEmergencySvcMockAddContact) Inspect(f func(ctx context.Context, contact emergency.Contact)) *mEmergencySvcMockAddContact {
+	if mmAddContact.mock.inspectFuncAddContact != nil {
+		mmAddContact.mock.t.Fatalf("Inspect function is already set for EmergencySvcMock.AddContact")
+	}
+
+	mmAddContact.mock.inspectFuncAddContact = f
+
+	return mmAddContact
+}
+
+// Return sets up results that will be returned by EmergencySvc.AddContact
+func (mmAddContact *mEmergencySvcMockAddContact) Return(err error) *EmergencySvcMock {
+	if mmAddContact.mock.funcAddContact != nil {
+		mmAddContact.mock.t.Fatalf("EmergencySvcMock.AddContact mock is already set by Set")
+	}
+
+	if mmAddContact.defaultExpectation == nil {
+		mmAddContact.defaultExpectation = &EmergencySvcMockAddContactExpectation{mock: mmAddContact.mock}
+	}
+	mmAddContact.defaultExpectation.results = &EmergencySvcMockAddContactResults{err}
+	mmAddContact.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddContact.mock
+}
+
+// Set uses given function f to mock the EmergencySvc.AddContact method
+func (mmAddContact *mEmergencySvcMockAddContact) Set(f func(ctx context.Context, contact emergency.Contact) (err error)) *EmergencySvcMock {
+	if mmAddContact.defaultExpectation != nil {
+		mmAddContact.mock.t.Fatalf("Default expectation is already set for the EmergencySvc.AddContact method")
+	}
+
+	if len(mmAddContact.expectations) > 0 {
+		mmAddContact.mock.t.Fatalf("Some expectations are already set for the EmergencySvc.AddContact method")
+	}
+
+	mmAddContact.mock.funcAddContact = f
+	mmAddContact.mock.funcAddContactOrigin = minimock.CallerInfo(1)
+	return mmAddContact.mock
+}
+
+// When sets expectation for the EmergencySvc.AddContact which will trigger the result defined by the following
+// Then helper
+func (mmAddContact *mEmergencySvcMockAddContact) When(ctx context.Context, contact emergency.Contact) *EmergencySvcMockAddContactExpectation {
+	if mmAddContact.mock.funcAddContact != nil {
+		mmAddContact.mock.t.Fatalf("EmergencySvcMock.AddContact mock is already set by Set")
+	}
+
+	expectation := &EmergencySvcMockAddContactExpectation{
+		mock:               mmAddContact.mock,
+		params:             &EmergencySvcMockAddContactParams{ctx, contact},
+		expectationOrigins: EmergencySvcMockAddContactExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddContact.expectations = append(mmAddContact.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EmergencySvc.AddContact return parameters for the expectation previously defined by the When method
+func (e *EmergencySvcMockAddContactExpectation) Then(err error) *EmergencySvcMock {
+	e.results = &EmergencySvcMockAddContactResults{err}
+	return e.mock
+}
+
+// Times sets number of times EmergencySvc.AddContact should be invoked
+func (mmAddContact *mEmergencySvcMockAddContact) Times(n uint64) *mEmergencySvcMockAddContact {
+	if n == 0 {
+		mmAddContact.mock.t.Fatalf("Times of EmergencySvcMock.AddContact mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddContact.expectedInvocations, n)
+	mmAddContact.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddContact
+}
+
+func (mmAddContact *mEmergencySvcMockAddContact) invocationsDone() bool {
+	if len(mmAddContact.expectations) == 0 && mmAddContact.defaultExpectation == nil && mmAddContact.mock.funcAddContact == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddContact.mock.afterAddContactCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddContact.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddContact implements mm_handler.EmergencySvc
+func (mmAddContact *EmergencySvcMock) AddContact(ctx context.Context, contact emergency.Contact) (err error) {
+	mm_atomic.AddUint64(&mmAddContact.beforeAddContactCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddContact.afterAddContactCounter, 1)
+
+	mmAddContact.t.Helper()
+
+	if mmAddContact.inspectFuncAddContact != nil {
+		mmAddContact.inspectFuncAddContact(ctx, contact)
+	}
+
+	mm_params := EmergencySvcMockAddContactParams{ctx, contact}
+
+	// Record call args
+	mmAddContact.AddContactMock.mutex.Lock()
+	mmAddContact.AddContactMock.callArgs = append(mmAddContact.AddContactMock.callArgs, &mm_params)
+	mmAddContact.AddContactMock.mutex.Unlock()
+
+	for _, e := range mmAddContact.AddContactMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddContact.AddContactMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddContact.AddContactMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddContact.AddContactMock.defaultExpectation.params
+		mm_want_ptrs := mmAddContact.AddContactMock.defaultExpectation.paramPtrs
+
+		mm_got := EmergencySvcMockAddContactParams{ctx, contact}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddContact.t.Errorf("EmergencySvcMock.AddContact got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddContact.AddContactMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.contact != nil && !minimock.Equal(*mm_want_ptrs.contact, mm_got.contact) {
+				mmAddContact.t.Errorf("EmergencySvcMock.AddContact got unexpected parameter contact, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddContact.AddContactMock.defaultExpectation.expectationOrigins.originContact, *mm_want_ptrs.contact, mm_got.contact, minimock.Diff(*mm_want_ptrs.contact, mm_got.contact))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddContact.t.Errorf("EmergencySvcMock.AddContact got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddContact.AddContactMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddContact.AddContactMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddContact.t.Fatal("No results are set for the EmergencySvcMock.AddContact")
+		}
+		return (*mm_results).err
+	}
+	if mmAddContact.funcAddContact != nil {
+		return mmAddContact.funcAddContact(ctx, contact)
+	}
+	mmAddContact.t.Fatalf("Unexpected call to EmergencySvcMock.AddContact. %v %v", ctx, contact)
+	return
+}
+
+// AddContactAfterCounter returns a count of finished EmergencySvcMock.AddContact invocations
+func (mmAddContact *EmergencySvcMock) AddContactAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddContact.afterAddContactCounter)
+}
+
+// AddContactBeforeCounter returns a count of EmergencySvcMock.AddContact invocations
+func (mmAddContact *EmergencySvcMock) AddContactBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddContact.beforeAddContactCounter)
+}
+
+// Calls returns a list of arguments used in each call to EmergencySvcMock.AddContact.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddContact *mEmergencySvcMockAddContact) Calls() []*EmergencySvcMockAddContactParams {
+	mmAddContact.mutex.RLock()
+
+	argCopy := make([]*EmergencySvcMockAddContactParams, len(mmAddContact.callArgs))
+	copy(argCopy, mmAddContact.callArgs)
+
+	mmAddContact.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddContactDone returns true if the count of the AddContact invocations corresponds
+// the number of defined expectations
+func (m *EmergencySvcMock) MinimockAddContactDone() bool {
+	if m.AddContactMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddContactMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddContactMock.invocationsDone()
+}
+
+// MinimockAddContactInspect logs each unmet expectation
+func (m *EmergencySvcMock) MinimockAddContactInspect() {
+	for _, e := range m.AddContactMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EmergencySvcMock.AddContact at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddContactCounter := mm_atomic.LoadUint64(&m.afterAddContactCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddContactMock.defaultExpectation != nil && afterAddContactCounter < 1 {
+		if m.AddContactMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EmergencySvcMock.AddContact at\n%s", m.AddContactMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EmergencySvcMock.AddContact at\n%s with params: %#v", m.AddContactMock.defaultExpectation.expectationOrigins.origin, *m.AddContactMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddContact != nil && afterAddContactCounter < 1 {
+		m.t.Errorf("Expected call to EmergencySvcMock.AddContact at\n%s", m.funcAddContactOrigin)
+	}
+
+	if !m.AddContactMock.invocationsDone() && afterAddContactCounter > 0 {
+		m.t.Errorf("Expected %d calls to EmergencySvcMock.AddContact at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddContactMock.expectedInvocations), m.AddContactMock.expectedInvocationsOrigin, afterAddContactCounter)
+	}
+}
+
+type mEmergencySvcMockApprove struct {
+	optional           bool
+	mock               *EmergencySvcMock
+	defaultExpectation *EmergencySvcMockApproveExpectation
+	expectations       []*EmergencySvcMockApproveExpectation
+
+	callArgs []*EmergencySvcMockApproveParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EmergencySvcMockApproveExpectation specifies expectation struct of the EmergencySvc.Approve
+type EmergencySvcMockApproveExpectation struct {
+	mock               *EmergencySvcMock
+	params             *EmergencySvcMockApproveParams
+	paramPtrs          *EmergencySvcMockApproveParamPtrs
+	expectationOrigins EmergencySvcMockApproveExpectationOrigins
+	results            *EmergencySvcMockApproveResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EmergencySvcMockApproveParams contains parameters of the EmergencySvc.Approve
+type EmergencySvcMockApproveParams struct {
+	ctx     context.Context
+	grantor string
+	grantee string
+}
+
+// EmergencySvcMockApproveParamPtrs contains pointers to parameters of the EmergencySvc.Approve
+type EmergencySvcMockApproveParamPtrs struct {
+	ctx     *context.Context
+	grantor *string
+	grantee *string
+}
+
+// EmergencySvcMockApproveResults contains results of the EmergencySvc.Approve
+type EmergencySvcMockApproveResults struct {
+	err error
+}
+
+// EmergencySvcMockApproveOrigins contains origins of expectations of the EmergencySvc.Approve
+type EmergencySvcMockApproveExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originGrantor string
+	originGrantee string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmApprove *mEmergencySvcMockApprove) Optional() *mEmergencySvcMockApprove {
+	mmApprove.optional = true
+	return mmApprove
+}
+
+// Expect sets up expected params for EmergencySvc.Approve
+func (mmApprove *mEmergencySvcMockApprove) Expect(ctx context.Context, grantor string, grantee string) *mEmergencySvcMockApprove {
+	if mmApprove.mock.funcApprove != nil {
+		mmApprove.mock.t.Fatalf("EmergencySvcMock.Approve mock is already set by Set")
+	}
+
+	if mmApprove.defaultExpectation == nil {
+		mmApprove.defaultExpectation = &EmergencySvcMockApproveExpectation{}
+	}
+
+	if mmApprove.defaultExpectation.paramPtrs != nil {
+		mmApprove.mock.t.Fatalf("EmergencySvcMock.Approve mock is already set by ExpectParams functions")
+	}
+
+	mmApprove.defaultExpectation.params = &EmergencySvcMockApproveParams{ctx, grantor, grantee}
+	mmApprove.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmApprove.expectations {
+		if minimock.Equal(e.params, mmApprove.defaultExpectation.params) {
+			mmApprove.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmApprove.defaultExpectation.params)
+		}
+	}
+
+	return mmApprove
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EmergencySvc.Approve
+func (mmApprove *mEmergencySvcMockApprove) ExpectCtxParam1(ctx context.Context) *mEmergencySvcMockApprove {
+	if mmApprove.mock.funcApprove != nil {
+		mmApprove.mock.t.Fatalf("EmergencySvcMock.Approve mock is already set by Set")
+	}
+
+	if mmApprove.defaultExpectation == nil {
+		mmApprove.defaultExpectation = &EmergencySvcMockApproveExpectation{}
+	}
+
+	if mmApprove.defaultExpectation.params != nil {
+		mmApprove.mock.t.Fatalf("EmergencySvcMock.Approve mock is already set by Expect")
+	}
+
+	if mmApprove.defaultExpectation.paramPtrs == nil {
+		mmApprove.defaultExpectation.paramPtrs = &EmergencySvcMockApproveParamPtrs{}
+	}
+	mmApprove.defaultExpectation.paramPtrs.ctx = &ctx
+	mmApprove.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmApprove
+}
+
+// ExpectGrantorParam2 sets up expected param grantor for EmergencySvc.Approve
+func (mmApprove *mEmergencySvcMockApprove) ExpectGrantorParam2(grantor string) *mEmergencySvcMockApprove {
+	if mmApprove.mock.funcApprove != nil {
+		mmApprove.mock.t.Fatalf("EmergencySvcMock.Approve mock is already set by Set")
+	}
+
+	if mmApprove.defaultExpectation == nil {
+		mmApprove.defaultExpectation = &EmergencySvcMockApproveExpectation{}
+	}
+
+	if mmApprove.defaultExpectation.params != nil {
+		mmApprove.mock.t.Fatalf("EmergencySvcMock.Approve mock is already set by Expect")
+	}
+
+	if mmApprove.defaultExpectation.paramPtrs == nil {
+		mmApprove.defaultExpectation.paramPtrs = &EmergencySvcMockApproveParamPtrs{}
+	}
+	mmApprove.defaultExpectation.paramPtrs.grantor = &grantor
+	mmApprove.defaultExpectation.expectationOrigins.originGrantor = minimock.CallerInfo(1)
+
+	return mmApprove
+}
+
+// ExpectGranteeParam3 sets up expected param grantee for EmergencySvc.Approve
+func (mmApprove *mEmergencySvcMockApprove) ExpectGranteeParam3(grantee string) *mEmergencySvcMockApprove {
+	if mmApprove.mock.funcApprove != nil {
+		mmApprove.mock.t.Fatalf("EmergencySvcMock.Approve mock is already set by Set")
+	}
+
+	if mmApprove.defaultExpectation == nil {
+		mmApprove.defaultExpectation = &EmergencySvcMockApproveExpectation{}
+	}
+
+	if mmApprove.defaultExpectation.params != nil {
+		mmApprove.mock.t.Fatalf("EmergencySvcMock.Approve mock is already set by Expect")
+	}
+
+	if mmApprove.defaultExpectation.paramPtrs == nil {
+		mmApprove.defaultExpectation.paramPtrs = &EmergencySvcMockApproveParamPtrs{}
+	}
+	mmApprove.defaultExpectation.paramPtrs.grantee = &grantee
+	mmApprove.defaultExpectation.expectationOrigins.originGrantee = minimock.CallerInfo(1)
+
+	return mmApprove
+}
+
+// Inspect accepts an inspector function that has same arguments as the EmergencySvc.Approve
+func (mmApprove *mEmergencySvcMockApprove) Inspect(f func(ctx context.Context, grantor string, grantee string)) *mEmergencySvcMockApprove {
+	if mmApprove.mock.inspectFuncApprove != nil {
+		mmApprove.mock.t.Fatalf("Inspect function is already set for EmergencySvcMock.Approve")
+	}
+
+	mmApprove.mock.inspectFuncApprove = f
+
+	return mmApprove
+}
+
+// Return sets up results that will be returned by EmergencySvc.Approve
+func (mmApprove *mEmergencySvcMockApprove) Return(err error) *EmergencySvcMock {
+	if mmApprove.mock.funcApprove != nil {
+		mmApprove.mock.t.Fatalf("EmergencySvcMock.Approve mock is already set by Set")
+	}
+
+	if mmApprove.defaultExpectation == nil {
+		mmApprove.defaultExpectation = &EmergencySvcMockApproveExpectation{mock: mmApprove.mock}
+	}
+	mmApprove.defaultExpectation.results = &EmergencySvcMockApproveResults{err}
+	mmApprove.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmApprove.mock
+}
+
+// Set uses given function f to mock the EmergencySvc.Approve method
+func (mmApprove *mEmergencySvcMockApprove) Set(f func(ctx context.Context, grantor string, grantee string) (err error)) *EmergencySvcMock {
+	if mmApprove.defaultExpectation != nil {
+		mmApprove.mock.t.Fatalf("Default expectation is already set for the EmergencySvc.Approve method")
+	}
+
+	if len(mmApprove.expectations) > 0 {
+		mmApprove.mock.t.Fatalf("Some expectations are already set for the EmergencySvc.Approve method")
+	}
+
+	mmApprove.mock.funcApprove = f
+	mmApprove.mock.funcApproveOrigin = minimock.CallerInfo(1)
+	return mmApprove.mock
+}
+
+// When sets expectation for the EmergencySvc.Approve which will trigger the result defined by the following
+// Then helper
+func (mmApprove *mEmergencySvcMockApprove) When(ctx context.Context, grantor string, grantee string) *EmergencySvcMockApproveExpectation {
+	if mmApprove.mock.funcApprove != nil {
+		mmApprove.mock.t.Fatalf("EmergencySvcMock.Approve mock is already set by Set")
+	}
+
+	expectation := &EmergencySvcMockApproveExpectation{
+		mock:               mmApprove.mock,
+		params:             &EmergencySvcMockApproveParams{ctx, grantor, grantee},
+		expectationOrigins: EmergencySvcMockApproveExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmApprove.expectations = append(mmApprove.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EmergencySvc.Approve return parameters for the expectation previously defined by the When method
+func (e *EmergencySvcMockApproveExpectation) Then(err error) *EmergencySvcMock {
+	e.results = &EmergencySvcMockApproveResults{err}
+	return e.mock
+}
+
+// Times sets number of times EmergencySvc.Approve should be invoked
+func (mmApprove *mEmergencySvcMockApprove) Times(n uint64) *mEmergencySvcMockApprove {
+	if n == 0 {
+		mmApprove.mock.t.Fatalf("Times of EmergencySvcMock.Approve mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmApprove.expectedInvocations, n)
+	mmApprove.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmApprove
+}
+
+func (mmApprove *mEmergencySvcMockApprove) invocationsDone() bool {
+	if len(mmApprove.expectations) == 0 && mmApprove.defaultExpectation == nil && mmApprove.mock.funcApprove == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmApprove.mock.afterApproveCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmApprove.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Approve implements mm_handler.EmergencySvc
+func (mmApprove *EmergencySvcMock) Approve(ctx context.Context, grantor string, grantee string) (err error) {
+	mm_atomic.AddUint64(&mmApprove.beforeApproveCounter, 1)
+	defer mm_atomic.AddUint64(&mmApprove.afterApproveCounter, 1)
+
+	mmApprove.t.Helper()
+
+	if mmApprove.inspectFuncApprove != nil {
+		mmApprove.inspectFuncApprove(ctx, grantor, grantee)
+	}
+
+	mm_params := EmergencySvcMockApproveParams{ctx, grantor, grantee}
+
+	// Record call args
+	mmApprove.ApproveMock.mutex.Lock()
+	mmApprove.ApproveMock.callArgs = append(mmApprove.ApproveMock.callArgs, &mm_params)
+	mmApprove.ApproveMock.mutex.Unlock()
+
+	for _, e := range mmApprove.ApproveMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmApprove.ApproveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmApprove.ApproveMock.defaultExpectation.Counter, 1)
+		mm_want := mmApprove.ApproveMock.defaultExpectation.params
+		mm_want_ptrs := mmApprove.ApproveMock.defaultExpectation.paramPtrs
+
+		mm_got := EmergencySvcMockApproveParams{ctx, grantor, grantee}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmApprove.t.Errorf("EmergencySvcMock.Approve got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApprove.ApproveMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.grantor != nil && !minimock.Equal(*mm_want_ptrs.grantor, mm_got.grantor) {
+				mmApprove.t.Errorf("EmergencySvcMock.Approve got unexpected parameter grantor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApprove.ApproveMock.defaultExpectation.expectationOrigins.originGrantor, *mm_want_ptrs.grantor, mm_got.grantor, minimock.Diff(*mm_want_ptrs.grantor, mm_got.grantor))
+			}
+
+			if mm_want_ptrs.grantee != nil && !minimock.Equal(*mm_want_ptrs.grantee, mm_got.grantee) {
+				mmApprove.t.Errorf("EmergencySvcMock.Approve got unexpected parameter grantee, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApprove.ApproveMock.defaultExpectation.expectationOrigins.originGrantee, *mm_want_ptrs.grantee, mm_got.grantee, minimock.Diff(*mm_want_ptrs.grantee, mm_got.grantee))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmApprove.t.Errorf("EmergencySvcMock.Approve got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmApprove.ApproveMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmApprove.ApproveMock.defaultExpectation.results
+		if mm_results == nil {
+			mmApprove.t.Fatal("No results are set for the EmergencySvcMock.Approve")
+		}
+		return (*mm_results).err
+	}
+	if mmApprove.funcApprove != nil {
+		return mmApprove.funcApprove(ctx, grantor, grantee)
+	}
+	mmApprove.t.Fatalf("Unexpected call to EmergencySvcMock.Approve. %v %v %v", ctx, grantor, grantee)
+	return
+}
+
+// ApproveAfterCounter returns a count of finished EmergencySvcMock.Approve invocations
+func (mmApprove *EmergencySvcMock) ApproveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApprove.afterApproveCounter)
+}
+
+// ApproveBeforeCounter returns a count of EmergencySvcMock.Approve invocations
+func (mmApprove *EmergencySvcMock) ApproveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApprove.beforeApproveCounter)
+}
+
+// Calls returns a list of arguments used in each call to EmergencySvcMock.Approve.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmApprove *mEmergencySvcMockApprove) Calls() []*EmergencySvcMockApproveParams {
+	mmApprove.mutex.RLock()
+
+	argCopy := make([]*EmergencySvcMockApproveParams, len(mmApprove.callArgs))
+	copy(argCopy, mmApprove.callArgs)
+
+	mmApprove.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockApproveDone returns true if the count of the Approve invocations corresponds
+// the number of defined expectations
+func (m *EmergencySvcMock) MinimockApproveDone() bool {
+	if m.ApproveMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ApproveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ApproveMock.invocationsDone()
+}
+
+// MinimockApproveInspect logs each unmet expectation
+func (m *EmergencySvcMock) MinimockApproveInspect() {
+	for _, e := range m.ApproveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EmergencySvcMock.Approve at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterApproveCounter := mm_atomic.LoadUint64(&m.afterApproveCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ApproveMock.defaultExpectation != nil && afterApproveCounter < 1 {
+		if m.ApproveMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EmergencySvcMock.Approve at\n%s", m.ApproveMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EmergencySvcMock.Approve at\n%s with params: %#v", m.ApproveMock.defaultExpectation.expectationOrigins.origin, *m.ApproveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcApprove != nil && afterApproveCounter < 1 {
+		m.t.Errorf("Expected call to EmergencySvcMock.Approve at\n%s", m.funcApproveOrigin)
+	}
+
+	if !m.ApproveMock.invocationsDone() && afterApproveCounter > 0 {
+		m.t.Errorf("Expected %d calls to EmergencySvcMock.Approve at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ApproveMock.expectedInvocations), m.ApproveMock.expectedInvocationsOrigin, afterApproveCounter)
+	}
+}
+
+type mEmergencySvcMockGetContacts struct {
+	optional           bool
+	mock               *EmergencySvcMock
+	defaultExpectation *EmergencySvcMockGetContactsExpectation
+	expectations       []*EmergencySvcMockGetContactsExpectation
+
+	callArgs []*EmergencySvcMockGetContactsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EmergencySvcMockGetContactsExpectation specifies expectation struct of the EmergencySvc.GetContacts
+type EmergencySvcMockGetContactsExpectation struct {
+	mock               *EmergencySvcMock
+	params             *EmergencySvcMockGetContactsParams
+	paramPtrs          *EmergencySvcMockGetContactsParamPtrs
+	expectationOrigins EmergencySvcMockGetContactsExpectationOrigins
+	results            *EmergencySvcMockGetContactsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EmergencySvcMockGetContactsParams contains parameters of the EmergencySvc.GetContacts
+type EmergencySvcMockGetContactsParams struct {
+	ctx      context.Context
+	username string
+}
+
+// EmergencySvcMockGetContactsParamPtrs contains pointers to parameters of the EmergencySvc.GetContacts
+type EmergencySvcMockGetContactsParamPtrs struct {
+	ctx      *context.Context
+	username *string
+}
+
+// EmergencySvcMockGetContactsResults contains results of the EmergencySvc.GetContacts
+type EmergencySvcMockGetContactsResults struct {
+	ca1 []emergency.Contact
+	err error
+}
+
+// EmergencySvcMockGetContactsOrigins contains origins of expectations of the EmergencySvc.GetContacts
+type EmergencySvcMockGetContactsExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetContacts *mEmergencySvcMockGetContacts) Optional() *mEmergencySvcMockGetContacts {
+	mmGetContacts.optional = true
+	return mmGetContacts
+}
+
+// Expect sets up expected params for EmergencySvc.GetContacts
+func (mmGetContacts *mEmergencySvcMockGetContacts) Expect(ctx context.Context, username string) *mEmergencySvcMockGetContacts {
+	if mmGetContacts.mock.funcGetContacts != nil {
+		mmGetContacts.mock.t.Fatalf("EmergencySvcMock.GetContacts mock is already set by Set")
+	}
+
+	if mmGetContacts.defaultExpectation == nil {
+		mmGetContacts.defaultExpectation = &EmergencySvcMockGetContactsExpectation{}
+	}
+
+	if mmGetContacts.defaultExpectation.paramPtrs != nil {
+		mmGetContacts.mock.t.Fatalf("EmergencySvcMock.GetContacts mock is already set by ExpectParams functions")
+	}
+
+	mmGetContacts.defaultExpectation.params = &EmergencySvcMockGetContactsParams{ctx, username}
+	mmGetContacts.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetContacts.expectations {
+		if minimock.Equal(e.params, mmGetContacts.defaultExpectation.params) {
+			mmGetContacts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetContacts.defaultExpectation.params)
+		}
+	}
+
+	return mmGetContacts
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EmergencySvc.GetContacts
+func (mmGetContacts *mEmergencySvcMockGetContacts) ExpectCtxParam1(ctx context.Context) *mEmergencySvcMockGetContacts {
+	if mmGetContacts.mock.funcGetContacts != nil {
+		mmGetContacts.mock.t.Fatalf("EmergencySvcMock.GetContacts mock is already set by Set")
+	}
+
+	if mmGetContacts.defaultExpectation == nil {
+		mmGetContacts.defaultExpectation = &EmergencySvcMockGetContactsExpectation{}
+	}
+
+	if mmGetContacts.defaultExpectation.params != nil {
+		mmGetContacts.mock.t.Fatalf("EmergencySvcMock.GetContacts mock is already set by Expect")
+	}
+
+	if mmGetContacts.defaultExpectation.paramPtrs == nil {
+		mmGetContacts.defaultExpectation.paramPtrs = &EmergencySvcMockGetContactsParamPtrs{}
+	}
+	mmGetContacts.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetContacts.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetContacts
+}
+
+// ExpectUsernameParam2 sets up expected param username for EmergencySvc.GetContacts
+func (mmGetContacts *mEmergencySvcMockGetContacts) ExpectUsernameParam2(username string) *mEmergencySvcMockGetContacts {
+	if mmGetContacts.mock.funcGetContacts != nil {
+		mmGetContacts.mock.t.Fatalf("EmergencySvcMock.GetContacts mock is already set by Set")
+	}
+
+	if mmGetContacts.defaultExpectation == nil {
+		mmGetContacts.defaultExpectation = &EmergencySvcMockGetContactsExpectation{}
+	}
+
+	if mmGetContacts.defaultExpectation.params != nil {
+		mmGetContacts.mock.t.Fatalf("EmergencySvcMock.GetContacts mock is already set by Expect")
+	}
+
+	if mmGetContacts.defaultExpectation.paramPtrs == nil {
+		mmGetContacts.defaultExpectation.paramPtrs = &EmergencySvcMockGetContactsParamPtrs{}
+	}
+	mmGetContacts.defaultExpectation.paramPtrs.username = &username
+	mmGetContacts.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetContacts
+}
+
+// Inspect accepts an inspector function that has same arguments as the EmergencySvc.GetContacts
+func (mmGetContacts *mEmergencySvcMockGetContacts) Inspect(f func(ctx context.Context, username string)) *mEmergencySvcMockGetContacts {
+	if mmGetContacts.mock.inspectFuncGetContacts != nil {
+		mmGetContacts.mock.t.Fatalf("Inspect function is already set for EmergencySvcMock.GetContacts")
+	}
+
+	mmGetContacts.mock.inspectFuncGetContacts = f
+
+	return mmGetContacts
+}
+
+// Return sets up results that will be returned by EmergencySvc.GetContacts
+func (mmGetContacts *mEmergencySvcMockGetContacts) Return(ca1 []emergency.Contact, err error) *EmergencySvcMock {
+	if mmGetContacts.mock.funcGetContacts != nil {
+		mmGetContacts.mock.t.Fatalf("EmergencySvcMock.GetContacts mock is already set by Set")
+	}
+
+	if mmGetContacts.defaultExpectation == nil {
+		mmGetContacts.defaultExpectation = &EmergencySvcMockGetContactsExpectation{mock: mmGetContacts.mock}
+	}
+	mmGetContacts.defaultExpectation.results = &EmergencySvcMockGetContactsResults{ca1, err}
+	mmGetContacts.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetContacts.mock
+}
+
+// Set uses given function f to mock the EmergencySvc.GetContacts method
+func (mmGetContacts *mEmergencySvcMockGetContacts) Set(f func(ctx context.Context, username string) (ca1 []emergency.Contact, err error)) *EmergencySvcMock {
+	if mmGetContacts.defaultExpectation != nil {
+		mmGetContacts.mock.t.Fatalf("Default expectation is already set for the EmergencySvc.GetContacts method")
+	}
+
+	if len(mmGetContacts.expectations) > 0 {
+		mmGetContacts.mock.t.Fatalf("Some expectations are already set for the EmergencySvc.GetContacts method")
+	}
+
+	mmGetContacts.mock.funcGetContacts = f
+	mmGetContacts.mock.funcGetContactsOrigin = minimock.CallerInfo(1)
+	return mmGetContacts.mock
+}
+
+// When sets expectation for the EmergencySvc.GetContacts which will trigger the result defined by the following
+// Then helper
+func (mmGetContacts *mEmergencySvcMockGetContacts) When(ctx context.Context, username string) *EmergencySvcMockGetContactsExpectation {
+	if mmGetContacts.mock.funcGetContacts != nil {
+		mmGetContacts.mock.t.Fatalf("EmergencySvcMock.GetContacts mock is already set by Set")
+	}
+
+	expectation := &EmergencySvcMockGetContactsExpectation{
+		mock:               mmGetContacts.mock,
+		params:             &EmergencySvcMockGetContactsParams{ctx, username},
+		expectationOrigins: EmergencySvcMockGetContactsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetContacts.expectations = append(mmGetContacts.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EmergencySvc.GetContacts return parameters for the expectation previously defined by the When method
+func (e *EmergencySvcMockGetContactsExpectation) Then(ca1 []emergency.Contact, err error) *EmergencySvcMock {
+	e.results = &EmergencySvcMockGetContactsResults{ca1, err}
+	return e.mock
+}
+
+// Times sets number of times EmergencySvc.GetContacts should be invoked
+func (mmGetContacts *mEmergencySvcMockGetContacts) Times(n uint64) *mEmergencySvcMockGetContacts {
+	if n == 0 {
+		mmGetContacts.mock.t.Fatalf("Times of EmergencySvcMock.GetContacts mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetContacts.expectedInvocations, n)
+	mmGetContacts.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetContacts
+}
+
+func (mmGetContacts *mEmergencySvcMockGetContacts) invocationsDone() bool {
+	if len(mmGetContacts.expectations) == 0 && mmGetContacts.defaultExpectation == nil && mmGetContacts.mock.funcGetContacts == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetContacts.mock.afterGetContactsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetContacts.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetContacts implements mm_handler.EmergencySvc
+func (mmGetContacts *EmergencySvcMock) GetContacts(ctx context.Context, username string) (ca1 []emergency.Contact, err error) {
+	mm_atomic.AddUint64(&mmGetContacts.beforeGetContactsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetContacts.afterGetContactsCounter, 1)
+
+	mmGetContacts.t.Helper()
+
+	if mmGetContacts.inspectFuncGetContacts != nil {
+		mmGetContacts.inspectFuncGetContacts(ctx, username)
+	}
+
+	mm_params := EmergencySvcMockGetContactsParams{ctx, username}
+
+	// Record call args
+	mmGetContacts.GetContactsMock.mutex.Lock()
+	mmGetContacts.GetContactsMock.callArgs = append(mmGetContacts.GetContactsMock.callArgs, &mm_params)
+	mmGetContacts.GetContactsMock.mutex.Unlock()
+
+	for _, e := range mmGetContacts.GetContactsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ca1, e.results.err
+		}
+	}
+
+	if mmGetContacts.GetContactsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetContacts.GetContactsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetContacts.GetContactsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetContacts.GetContactsMock.defaultExpectation.paramPtrs
+
+		mm_got := EmergencySvcMockGetContactsParams{ctx, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetContacts.t.Errorf("EmergencySvcMock.GetContacts got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetContacts.GetContactsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetContacts.t.Errorf("EmergencySvcMock.GetContacts got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetContacts.GetContactsMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetContacts.t.Errorf("EmergencySvcMock.GetContacts got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetContacts.GetContactsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetContacts.GetContactsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetContacts.t.Fatal("No results are set for the EmergencySvcMock.GetContacts")
+		}
+		return (*mm_results).ca1, (*mm_results).err
+	}
+	if mmGetContacts.funcGetContacts != nil {
+		return mmGetContacts.funcGetContacts(ctx, username)
+	}
+	mmGetContacts.t.Fatalf("Unexpected call to EmergencySvcMock.GetContacts. %v %v", ctx, username)
+	return
+}
+
+// GetContactsAfterCounter returns a count of finished EmergencySvcMock.GetContacts invocations
+func (mmGetContacts *EmergencySvcMock) GetContactsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetContacts.afterGetContactsCounter)
+}
+
+// GetContactsBeforeCounter returns a count of EmergencySvcMock.GetContacts invocations
+func (mmGetContacts *EmergencySvcMock) GetContactsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetContacts.beforeGetContactsCounter)
+}
+
+// Calls returns a list of arguments used in each call to EmergencySvcMock.GetContacts.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetContacts *mEmergencySvcMockGetContacts) Calls() []*EmergencySvcMockGetContactsParams {
+	mmGetContacts.mutex.RLock()
+
+	argCopy := make([]*EmergencySvcMockGetContactsParams, len(mmGetContacts.callArgs))
+	copy(argCopy, mmGetContacts.callArgs)
+
+	mmGetContacts.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetContactsDone returns true if the count of the GetContacts invocations corresponds
+// the number of defined expectations
+func (m *EmergencySvcMock) MinimockGetContactsDone() bool {
+	if m.GetContactsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetContactsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetContactsMock.invocationsDone()
+}
+
+// MinimockGetContactsInspect logs each unmet expectation
+func (m *EmergencySvcMock) MinimockGetContactsInspect() {
+	for _, e := range m.GetContactsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EmergencySvcMock.GetContacts at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetContactsCounter := mm_atomic.LoadUint64(&m.afterGetContactsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetContactsMock.defaultExpectation != nil && afterGetContactsCounter < 1 {
+		if m.GetContactsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EmergencySvcMock.GetContacts at\n%s", m.GetContactsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EmergencySvcMock.GetContacts at\n%s with params: %#v", m.GetContactsMock.defaultExpectation.expectationOrigins.origin, *m.GetContactsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetContacts != nil && afterGetContactsCounter < 1 {
+		m.t.Errorf("Expected call to EmergencySvcMock.GetContacts at\n%s", m.funcGetContactsOrigin)
+	}
+
+	if !m.GetContactsMock.invocationsDone() && afterGetContactsCounter > 0 {
+		m.t.Errorf("Expected %d calls to EmergencySvcMock.GetContacts at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetContactsMock.expectedInvocations), m.GetContactsMock.expectedInvocationsOrigin, afterGetContactsCounter)
+	}
+}
+
+type mEmergencySvcMockGetGrantorCards struct {
+	optional           bool
+	mock               *EmergencySvcMock
+	defaultExpectation *EmergencySvcMockGetGrantorCardsExpectation
+	expectations       []*EmergencySvcMockGetGrantorCardsExpectation
+
+	callArgs []*EmergencySvcMockGetGrantorCardsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EmergencySvcMockGetGrantorCardsExpectation specifies expectation struct of the EmergencySvc.GetGrantorCards
+type EmergencySvcMockGetGrantorCardsExpectation struct {
+	mock               *EmergencySvcMock
+	params             *EmergencySvcMockGetGrantorCardsParams
+	paramPtrs          *EmergencySvcMockGetGrantorCardsParamPtrs
+	expectationOrigins EmergencySvcMockGetGrantorCardsExpectationOrigins
+	results            *EmergencySvcMockGetGrantorCardsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EmergencySvcMockGetGrantorCardsParams contains parameters of the EmergencySvc.GetGrantorCards
+type EmergencySvcMockGetGrantorCardsParams struct {
+	ctx     context.Context
+	grantee string
+	grantor string
+	page    pagination.Request
+}
+
+// EmergencySvcMockGetGrantorCardsParamPtrs contains pointers to parameters of the EmergencySvc.GetGrantorCards
+type EmergencySvcMockGetGrantorCardsParamPtrs struct {
+	ctx     *context.Context
+	grantee *string
+	grantor *string
+	page    *pagination.Request
+}
+
+// EmergencySvcMockGetGrantorCardsResults contains results of the EmergencySvc.GetGrantorCards
+type EmergencySvcMockGetGrantorCardsResults struct {
+	cards []profile.CardInfo
+	next  string
+	err   error
+}
+
+// EmergencySvcMockGetGrantorCardsOrigins contains origins of expectations of the EmergencySvc.GetGrantorCards
+type EmergencySvcMockGetGrantorCardsExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originGrantee string
+	originGrantor string
+	originPage    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetGrantorCards *mEmergencySvcMockGetGrantorCards) Optional() *mEmergencySvcMockGetGrantorCards {
+	mmGetGrantorCards.optional = true
+	return mmGetGrantorCards
+}
+
+// Expect sets up expected params for EmergencySvc.GetGrantorCards
+func (mmGetGrantorCards *mEmergencySvcMockGetGrantorCards) Expect(ctx context.Context, grantee string, grantor string, page pagination.Request) *mEmergencySvcMockGetGrantorCards {
+	if mmGetGrantorCards.mock.funcGetGrantorCards != nil {
+		mmGetGrantorCards.mock.t.Fatalf("EmergencySvcMock.GetGrantorCards mock is already set by Set")
+	}
+
+	if mmGetGrantorCards.defaultExpectation == nil {
+		mmGetGrantorCards.defaultExpectation = &EmergencySvcMockGetGrantorCardsExpectation{}
+	}
+
+	if mmGetGrantorCards.defaultExpectation.paramPtrs != nil {
+		mmGetGrantorCards.mock.t.Fatalf("EmergencySvcMock.GetGrantorCards mock is already set by ExpectParams functions")
+	}
+
+	mmGetGrantorCards.defaultExpectation.params = &EmergencySvcMockGetGrantorCardsParams{ctx, grantee, grantor, page}
+	mmGetGrantorCards.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetGrantorCards.expectations {
+		if minimock.Equal(e.params, mmGetGrantorCards.defaultExpectation.params) {
+			mmGetGrantorCards.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetGrantorCards.defaultExpectation.params)
+		}
+	}
+
+	return mmGetGrantorCards
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EmergencySvc.GetGrantorCards
+func (mmGetGrantorCards *mEmergencySvcMockGetGrantorCards) ExpectCtxParam1(ctx context.Context) *mEmergencySvcMockGetGrantorCards {
+	if mmGetGrantorCards.mock.funcGetGrantorCards != nil {
+		mmGetGrantorCards.mock.t.Fatalf("EmergencySvcMock.GetGrantorCards mock is already set by Set")
+	}
+
+	if mmGetGrantorCards.defaultExpectation == nil {
+		mmGetGrantorCards.defaultExpectation = &EmergencySvcMockGetGrantorCardsExpectation{}
+	}
+
+	if mmGetGrantorCards.defaultExpectation.params != nil {
+		mmGetGrantorCards.mock.t.Fatalf("EmergencySvcMock.GetGrantorCards mock is already set by Expect")
+	}
+
+	if mmGetGrantorCards.defaultExpectation.paramPtrs == nil {
+		mmGetGrantorCards.defaultExpectation.paramPtrs = &EmergencySvcMockGetGrantorCardsParamPtrs{}
+	}
+	mmGetGrantorCards.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetGrantorCards.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetGrantorCards
+}
+
+// ExpectGranteeParam2 sets up expected param grantee for EmergencySvc.GetGrantorCards
+func (mmGetGrantorCards *mEmergencySvcMockGetGrantorCards) ExpectGranteeParam2(grantee string) *mEmergencySvcMockGetGrantorCards {
+	if mmGetGrantorCards.mock.funcGetGrantorCards != nil {
+		mmGetGrantorCards.mock.t.Fatalf("EmergencySvcMock.GetGrantorCards mock is already set by Set")
+	}
+
+	if mmGetGrantorCards.defaultExpectation == nil {
+		mmGetGrantorCards.defaultExpectation = &EmergencySvcMockGetGrantorCardsExpectation{}
+	}
+
+	if mmGetGrantorCards.defaultExpectation.params != nil {
+		mmGetGrantorCards.mock.t.Fatalf("EmergencySvcMock.GetGrantorCards mock is already set by Expect")
+	}
+
+	if mmGetGrantorCards.defaultExpectation.paramPtrs == nil {
+		mmGetGrantorCards.defaultExpectation.paramPtrs = &EmergencySvcMockGetGrantorCardsParamPtrs{}
+	}
+	mmGetGrantorCards.defaultExpectation.paramPtrs.grantee = &grantee
+	mmGetGrantorCards.defaultExpectation.expectationOrigins.originGrantee = minimock.CallerInfo(1)
+
+	return mmGetGrantorCards
+}
+
+// ExpectGrantorParam3 sets up expected param grantor for EmergencySvc.GetGrantorCards
+func (mmGetGrantorCards *mEmergencySvcMockGetGrantorCards) ExpectGrantorParam3(grantor string) *mEmergencySvcMockGetGrantorCards {
+	if mmGetGrantorCards.mock.funcGetGrantorCards != nil {
+		mmGetGrantorCards.mock.t.Fatalf("EmergencySvcMock.GetGrantorCards mock is already set by Set")
+	}
+
+	if mmGetGrantorCards.defaultExpectation == nil {
+		mmGetGrantorCards.defaultExpectation = &EmergencySvcMockGetGrantorCardsExpectation{}
+	}
+
+	if mmGetGrantorCards.defaultExpectation.params != nil {
+		mmGetGrantorCards.mock.t.Fatalf("EmergencySvcMock.GetGrantorCards mock is already set by Expect")
+	}
+
+	if mmGetGrantorCards.defaultExpectation.paramPtrs == nil {
+		mmGetGrantorCards.defaultExpectation.paramPtrs = &EmergencySvcMockGetGrantorCardsParamPtrs{}
+	}
+	mmGetGrantorCards.defaultExpectation.paramPtrs.grantor = &grantor
+	mmGetGrantorCards.defaultExpectation.expectationOrigins.originGrantor = minimock.CallerInfo(1)
+
+	return mmGetGrantorCards
+}
+
+// ExpectPageParam4 sets up expected param page for EmergencySvc.GetGrantorCards
+func (mmGetGrantorCards *mEmergencySvcMockGetGrantorCards) ExpectPageParam4(page pagination.Request) *mEmergencySvcMockGetGrantorCards {
+	if mmGetGrantorCards.mock.funcGetGrantorCards != nil {
+		mmGetGrantorCards.mock.t.Fatalf("EmergencySvcMock.GetGrantorCards mock is already set by Set")
+	}
+
+	if mmGetGrantorCards.defaultExpectation == nil {
+		mmGetGrantorCards.defaultExpectation = &EmergencySvcMockGetGrantorCardsExpectation{}
+	}
+
+	if mmGetGrantorCards.defaultExpectation.params != nil {
+		mmGetGrantorCards.mock.t.Fatalf("EmergencySvcMock.GetGrantorCards mock is already set by Expect")
+	}
+
+	if mmGetGrantorCards.defaultExpectation.paramPtrs == nil {
+		mmGetGrantorCards.defaultExpectation.paramPtrs = &EmergencySvcMockGetGrantorCardsParamPtrs{}
+	}
+	mmGetGrantorCards.defaultExpectation.paramPtrs.page = &page
+	mmGetGrantorCards.defaultExpectation.expectationOrigins.originPage = minimock.CallerInfo(1)
+
+	return mmGetGrantorCards
+}
+
+// Inspect accepts an inspector function that has same arguments as the EmergencySvc.GetGrantorCards
+func (mmGetGrantorCards *mEmergencySvcMockGetGrantorCards) Inspect(f func(ctx context.Context, grantee string, grantor string, page pagination.Request)) *mEmergencySvcMockGetGrantorCards {
+	if mmGetGrantorCards.mock.inspectFuncGetGrantorCards != nil {
+		mmGetGrantorCards.mock.t.Fatalf("Inspect function is already set for EmergencySvcMock.GetGrantorCards")
+	}
+
+	mmGetGrantorCards.mock.inspectFuncGetGrantorCards = f
+
+	return mmGetGrantorCards
+}
+
+// Return sets up results that will be returned by EmergencySvc.GetGrantorCards
+func (mmGetGrantorCards *mEmergencySvcMockGetGrantorCards) Return(cards []profile.CardInfo, next string, err error) *EmergencySvcMock {
+	if mmGetGrantorCards.mock.funcGetGrantorCards != nil {
+		mmGetGrantorCards.mock.t.Fatalf("EmergencySvcMock.GetGrantorCards mock is already set by Set")
+	}
+
+	if mmGetGrantorCards.defaultExpectation == nil {
+		mmGetGrantorCards.defaultExpectation = &EmergencySvcMockGetGrantorCardsExpectation{mock: mmGetGrantorCards.mock}
+	}
+	mmGetGrantorCards.defaultExpectation.results = &EmergencySvcMockGetGrantorCardsResults{cards, next, err}
+	mmGetGrantorCards.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetGrantorCards.mock
+}
+
+// Set uses given function f to mock the EmergencySvc.GetGrantorCards method
+func (mmGetGrantorCards *mEmergencySvcMockGetGrantorCards) Set(f func(ctx context.Context, grantee string, grantor string, page pagination.Request) (cards []profile.CardInfo, next string, err error)) *EmergencySvcMock {
+	if mmGetGrantorCards.defaultExpectation != nil {
+		mmGetGrantorCards.mock.t.Fatalf("Default expectation is already set for the EmergencySvc.GetGrantorCards method")
+	}
+
+	if len(mmGetGrantorCards.expectations) > 0 {
+		mmGetGrantorCards.mock.t.Fatalf("Some expectations are already set for the EmergencySvc.GetGrantorCards method")
+	}
+
+	mmGetGrantorCards.mock.funcGetGrantorCards = f
+	mmGetGrantorCards.mock.funcGetGrantorCardsOrigin = minimock.CallerInfo(1)
+	return mmGetGrantorCards.mock
+}
+
+// When sets expectation for the EmergencySvc.GetGrantorCards which will trigger the result defined by the following
+// Then helper
+func (mmGetGrantorCards *mEmergencySvcMockGetGrantorCards) When(ctx context.Context, grantee string, grantor string, page pagination.Request) *EmergencySvcMockGetGrantorCardsExpectation {
+	if mmGetGrantorCards.mock.funcGetGrantorCards != nil {
+		mmGetGrantorCards.mock.t.Fatalf("EmergencySvcMock.GetGrantorCards mock is already set by Set")
+	}
+
+	expectation := &EmergencySvcMockGetGrantorCardsExpectation{
+		mock:               mmGetGrantorCards.mock,
+		params:             &EmergencySvcMockGetGrantorCardsParams{ctx, grantee, grantor, page},
+		expectationOrigins: EmergencySvcMockGetGrantorCardsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetGrantorCards.expectations = append(mmGetGrantorCards.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EmergencySvc.GetGrantorCards return parameters for the expectation previously defined by the When method
+func (e *EmergencySvcMockGetGrantorCardsExpectation) Then(cards []profile.CardInfo, next string, err error) *EmergencySvcMock {
+	e.results = &EmergencySvcMockGetGrantorCardsResults{cards, next, err}
+	return e.mock
+}
+
+// Times sets number of times EmergencySvc.GetGrantorCards should be invoked
+func (mmGetGrantorCards *mEmergencySvcMockGetGrantorCards) Times(n uint64) *mEmergencySvcMockGetGrantorCards {
+	if n == 0 {
+		mmGetGrantorCards.mock.t.Fatalf("Times of EmergencySvcMock.GetGrantorCards mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetGrantorCards.expectedInvocations, n)
+	mmGetGrantorCards.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetGrantorCards
+}
+
+func (mmGetGrantorCards *mEmergencySvcMockGetGrantorCards) invocationsDone() bool {
+	if len(mmGetGrantorCards.expectations) == 0 && mmGetGrantorCards.defaultExpectation == nil && mmGetGrantorCards.mock.funcGetGrantorCards == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetGrantorCards.mock.afterGetGrantorCardsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetGrantorCards.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetGrantorCards implements mm_handler.EmergencySvc
+func (mmGetGrantorCards *EmergencySvcMock) GetGrantorCards(ctx context.Context, grantee string, grantor string, page pagination.Request) (cards []profile.CardInfo, next string, err error) {
+	mm_atomic.AddUint64(&mmGetGrantorCards.beforeGetGrantorCardsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetGrantorCards.afterGetGrantorCardsCounter, 1)
+
+	mmGetGrantorCards.t.Helper()
+
+	if mmGetGrantorCards.inspectFuncGetGrantorCards != nil {
+		mmGetGrantorCards.inspectFuncGetGrantorCards(ctx, grantee, grantor, page)
+	}
+
+	mm_params := EmergencySvcMockGetGrantorCardsParams{ctx, grantee, grantor, page}
+
+	// Record call args
+	mmGetGrantorCards.GetGrantorCardsMock.mutex.Lock()
+	mmGetGrantorCards.GetGrantorCardsMock.callArgs = append(mmGetGrantorCards.GetGrantorCardsMock.callArgs, &mm_params)
+	mmGetGrantorCards.GetGrantorCardsMock.mutex.Unlock()
+
+	for _, e := range mmGetGrantorCards.GetGrantorCardsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cards, e.results.next, e.results.err
+		}
+	}
+
+	if mmGetGrantorCards.GetGrantorCardsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetGrantorCards.GetGrantorCardsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetGrantorCards.GetGrantorCardsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetGrantorCards.GetGrantorCardsMock.defaultExpectation.paramPtrs
+
+		mm_got := EmergencySvcMockGetGrantorCardsParams{ctx, grantee, grantor, page}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetGrantorCards.t.Errorf("EmergencySvcMock.GetGrantorCards got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetGrantorCards.GetGrantorCardsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.grantee != nil && !minimock.Equal(*mm_want_ptrs.grantee, mm_got.grantee) {
+				mmGetGrantorCards.t.Errorf("EmergencySvcMock.GetGrantorCards got unexpected parameter grantee, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetGrantorCards.GetGrantorCardsMock.defaultExpectation.expectationOrigins.originGrantee, *mm_want_ptrs.grantee, mm_got.grantee, minimock.Diff(*mm_want_ptrs.grantee, mm_got.grantee))
+			}
+
+			if mm_want_ptrs.grantor != nil && !minimock.Equal(*mm_want_ptrs.grantor, mm_got.grantor) {
+				mmGetGrantorCards.t.Errorf("EmergencySvcMock.GetGrantorCards got unexpected parameter grantor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetGrantorCards.GetGrantorCardsMock.defaultExpectation.expectationOrigins.originGrantor, *mm_want_ptrs.grantor, mm_got.grantor, minimock.Diff(*mm_want_ptrs.grantor, mm_got.grantor))
+			}
+
+			if mm_want_ptrs.page != nil && !minimock.Equal(*mm_want_ptrs.page, mm_got.page) {
+				mmGetGrantorCards.t.Errorf("EmergencySvcMock.GetGrantorCards got unexpected parameter page, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetGrantorCards.GetGrantorCardsMock.defaultExpectation.expectationOrigins.originPage, *mm_want_ptrs.page, mm_got.page, minimock.Diff(*mm_want_ptrs.page, mm_got.page))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetGrantorCards.t.Errorf("EmergencySvcMock.GetGrantorCards got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetGrantorCards.GetGrantorCardsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetGrantorCards.GetGrantorCardsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetGrantorCards.t.Fatal("No results are set for the EmergencySvcMock.GetGrantorCards")
+		}
+		return (*mm_results).cards, (*mm_results).next, (*mm_results).err
+	}
+	if mmGetGrantorCards.funcGetGrantorCards != nil {
+		return mmGetGrantorCards.funcGetGrantorCards(ctx, grantee, grantor, page)
+	}
+	mmGetGrantorCards.t.Fatalf("Unexpected call to EmergencySvcMock.GetGrantorCards. %v %v %v %v", ctx, grantee, grantor, page)
+	return
+}
+
+// GetGrantorCardsAfterCounter returns a count of finished EmergencySvcMock.GetGrantorCards invocations
+func (mmGetGrantorCards *EmergencySvcMock) GetGrantorCardsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetGrantorCards.afterGetGrantorCardsCounter)
+}
+
+// GetGrantorCardsBeforeCounter returns a count of EmergencySvcMock.GetGrantorCards invocations
+func (mmGetGrantorCards *EmergencySvcMock) GetGrantorCardsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetGrantorCards.beforeGetGrantorCardsCounter)
+}
+
+// Calls returns a list of arguments used in each call to EmergencySvcMock.GetGrantorCards.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetGrantorCards *mEmergencySvcMockGetGrantorCards) Calls() []*EmergencySvcMockGetGrantorCardsParams {
+	mmGetGrantorCards.mutex.RLock()
+
+	argCopy := make([]*EmergencySvcMockGetGrantorCardsParams, len(mmGetGrantorCards.callArgs))
+	copy(argCopy, mmGetGrantorCards.callArgs)
+
+	mmGetGrantorCards.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetGrantorCardsDone returns true if the count of the GetGrantorCards invocations corresponds
+// the number of defined expectations
+func (m *EmergencySvcMock) MinimockGetGrantorCardsDone() bool {
+	if m.GetGrantorCardsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetGrantorCardsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetGrantorCardsMock.invocationsDone()
+}
+
+// MinimockGetGrantorCardsInspect logs each unmet expectation
+func (m *EmergencySvcMock) MinimockGetGrantorCardsInspect() {
+	for _, e := range m.GetGrantorCardsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EmergencySvcMock.GetGrantorCards at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetGrantorCardsCounter := mm_atomic.LoadUint64(&m.afterGetGrantorCardsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetGrantorCardsMock.defaultExpectation != nil && afterGetGrantorCardsCounter < 1 {
+		if m.GetGrantorCardsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EmergencySvcMock.GetGrantorCards at\n%s", m.GetGrantorCardsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EmergencySvcMock.GetGrantorCards at\n%s with params: %#v", m.GetGrantorCardsMock.defaultExpectation.expectationOrigins.origin, *m.GetGrantorCardsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetGrantorCards != nil && afterGetGrantorCardsCounter < 1 {
+		m.t.Errorf("Expected call to EmergencySvcMock.GetGrantorCards at\n%s", m.funcGetGrantorCardsOrigin)
+	}
+
+	if !m.GetGrantorCardsMock.invocationsDone() && afterGetGrantorCardsCounter > 0 {
+		m.t.Errorf("Expected %d calls to EmergencySvcMock.GetGrantorCards at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetGrantorCardsMock.expectedInvocations), m.GetGrantorCardsMock.expectedInvocationsOrigin, afterGetGrantorCardsCounter)
+	}
+}
+
+type mEmergencySvcMockReject struct {
+	optional           bool
+	mock               *EmergencySvcMock
+	defaultExpectation *EmergencySvcMockRejectExpectation
+	expectations       []*EmergencySvcMockRejectExpectation
+
+	callArgs []*EmergencySvcMockRejectParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EmergencySvcMockRejectExpectation specifies expectation struct of the EmergencySvc.Reject
+type EmergencySvcMockRejectExpectation struct {
+	mock               *EmergencySvcMock
+	params             *EmergencySvcMockRejectParams
+	paramPtrs          *EmergencySvcMockRejectParamPtrs
+	expectationOrigins EmergencySvcMockRejectExpectationOrigins
+	results            *EmergencySvcMockRejectResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EmergencySvcMockRejectParams contains parameters of the EmergencySvc.Reject
+type EmergencySvcMockRejectParams struct {
+	ctx     context.Context
+	grantor string
+	grantee string
+}
+
+// EmergencySvcMockRejectParamPtrs contains pointers to parameters of the EmergencySvc.Reject
+type EmergencySvcMockRejectParamPtrs struct {
+	ctx     *context.Context
+	grantor *string
+	grantee *string
+}
+
+// EmergencySvcMockRejectResults contains results of the EmergencySvc.Reject
+type EmergencySvcMockRejectResults struct {
+	err error
+}
+
+// EmergencySvcMockRejectOrigins contains origins of expectations of the EmergencySvc.Reject
+type EmergencySvcMockRejectExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originGrantor string
+	originGrantee string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReject *mEmergencySvcMockReject) Optional() *mEmergencySvcMockReject {
+	mmReject.optional = true
+	return mmReject
+}
+
+// Expect sets up expected params for EmergencySvc.Reject
+func (mmReject *mEmergencySvcMockReject) Expect(ctx context.Context, grantor string, grantee string) *mEmergencySvcMockReject {
+	if mmReject.mock.funcReject != nil {
+		mmReject.mock.t.Fatalf("EmergencySvcMock.Reject mock is already set by Set")
+	}
+
+	if mmReject.defaultExpectation == nil {
+		mmReject.defaultExpectation = &EmergencySvcMockRejectExpectation{}
+	}
+
+	if mmReject.defaultExpectation.paramPtrs != nil {
+		mmReject.mock.t.Fatalf("EmergencySvcMock.Reject mock is already set by ExpectParams functions")
+	}
+
+	mmReject.defaultExpectation.params = &EmergencySvcMockRejectParams{ctx, grantor, grantee}
+	mmReject.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReject.expectations {
+		if minimock.Equal(e.params, mmReject.defaultExpectation.params) {
+			mmReject.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReject.defaultExpectation.params)
+		}
+	}
+
+	return mmReject
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EmergencySvc.Reject
+func (mmReject *mEmergencySvcMockReject) ExpectCtxParam1(ctx context.Context) *mEmergencySvcMockReject {
+	if mmReject.mock.funcReject != nil {
+		mmReject.mock.t.Fatalf("EmergencySvcMock.Reject mock is already set by Set")
+	}
+
+	if mmReject.defaultExpectation == nil {
+		mmReject.defaultExpectation = &EmergencySvcMockRejectExpectation{}
+	}
+
+	if mmReject.defaultExpectation.params != nil {
+		mmReject.mock.t.Fatalf("EmergencySvcMock.Reject mock is already set by Expect")
+	}
+
+	if mmReject.defaultExpectation.paramPtrs == nil {
+		mmReject.defaultExpectation.paramPtrs = &EmergencySvcMockRejectParamPtrs{}
+	}
+	mmReject.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReject.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReject
+}
+
+// ExpectGrantorParam2 sets up expected param grantor for EmergencySvc.Reject
+func (mmReject *mEmergencySvcMockReject) ExpectGrantorParam2(grantor string) *mEmergencySvcMockReject {
+	if mmReject.mock.funcReject != nil {
+		mmReject.mock.t.Fatalf("EmergencySvcMock.Reject mock is already set by Set")
+	}
+
+	if mmReject.defaultExpectation == nil {
+		mmReject.defaultExpectation = &EmergencySvcMockRejectExpectation{}
+	}
+
+	if mmReject.defaultExpectation.params != nil {
+		mmReject.mock.t.Fatalf("EmergencySvcMock.Reject mock is already set by Expect")
+	}
+
+	if mmReject.defaultExpectation.paramPtrs == nil {
+		mmReject.defaultExpectation.paramPtrs = &EmergencySvcMockRejectParamPtrs{}
+	}
+	mmReject.defaultExpectation.paramPtrs.grantor = &grantor
+	mmReject.defaultExpectation.expectationOrigins.originGrantor = minimock.CallerInfo(1)
+
+	return mmReject
+}
+
+// ExpectGranteeParam3 sets up expected param grantee for EmergencySvc.Reject
+func (mmReject *mEmergencySvcMockReject) ExpectGranteeParam3(grantee string) *mEmergencySvcMockReject {
+	if mmReject.mock.funcReject != nil {
+		mmReject.mock.t.Fatalf("EmergencySvcMock.Reject mock is already set by Set")
+	}
+
+	if mmReject.defaultExpectation == nil {
+		mmReject.defaultExpectation = &EmergencySvcMockRejectExpectation{}
+	}
+
+	if mmReject.defaultExpectation.params != nil {
+		mmReject.mock.t.Fatalf("EmergencySvcMock.Reject mock is already set by Expect")
+	}
+
+	if mmReject.defaultExpectation.paramPtrs == nil {
+		mmReject.defaultExpectation.paramPtrs = &EmergencySvcMockRejectParamPtrs{}
+	}
+	mmReject.defaultExpectation.paramPtrs.grantee = &grantee
+	mmReject.defaultExpectation.expectationOrigins.originGrantee = minimock.CallerInfo(1)
+
+	return mmReject
+}
+
+// Inspect accepts an inspector function that has same arguments as the EmergencySvc.Reject
+func (mmReject *mEmergencySvcMockReject) Inspect(f func(ctx context.Context, grantor string, grantee string)) *mEmergencySvcMockReject {
+	if mmReject.mock.inspectFuncReject != nil {
+		mmReject.mock.t.Fatalf("Inspect function is already set for EmergencySvcMock.Reject")
+	}
+
+	mmReject.mock.inspectFuncReject = f
+
+	return mmReject
+}
+
+// Return sets up results that will be returned by EmergencySvc.Reject
+func (mmReject *mEmergencySvcMockReject) Return(err error) *EmergencySvcMock {
+	if mmReject.mock.funcReject != nil {
+		mmReject.mock.t.Fatalf("EmergencySvcMock.Reject mock is already set by Set")
+	}
+
+	if mmReject.defaultExpectation == nil {
+		mmReject.defaultExpectation = &EmergencySvcMockRejectExpectation{mock: mmReject.mock}
+	}
+	mmReject.defaultExpectation.results = &EmergencySvcMockRejectResults{err}
+	mmReject.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReject.mock
+}
+
+// Set uses given function f to mock the EmergencySvc.Reject method
+func (mmReject *mEmergencySvcMockReject) Set(f func(ctx context.Context, grantor string, grantee string) (err error)) *EmergencySvcMock {
+	if mmReject.defaultExpectation != nil {
+		mmReject.mock.t.Fatalf("Default expectation is already set for the EmergencySvc.Reject method")
+	}
+
+	if len(mmReject.expectations) > 0 {
+		mmReject.mock.t.Fatalf("Some expectations are already set for the EmergencySvc.Reject method")
+	}
+
+	mmReject.mock.funcReject = f
+	mmReject.mock.funcRejectOrigin = minimock.CallerInfo(1)
+	return mmReject.mock
+}
+
+// When sets expectation for the EmergencySvc.Reject which will trigger the result defined by the following
+// Then helper
+func (mmReject *mEmergencySvcMockReject) When(ctx context.Context, grantor string, grantee string) *EmergencySvcMockRejectExpectation {
+	if mmReject.mock.funcReject != nil {
+		mmReject.mock.t.Fatalf("EmergencySvcMock.Reject mock is already set by Set")
+	}
+
+	expectation := &EmergencySvcMockRejectExpectation{
+		mock:               mmReject.mock,
+		params:             &EmergencySvcMockRejectParams{ctx, grantor, grantee},
+		expectationOrigins: EmergencySvcMockRejectExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReject.expectations = append(mmReject.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EmergencySvc.Reject return parameters for the expectation previously defined by the When method
+func (e *EmergencySvcMockRejectExpectation) Then(err error) *EmergencySvcMock {
+	e.results = &EmergencySvcMockRejectResults{err}
+	return e.mock
+}
+
+// Times sets number of times EmergencySvc.Reject should be invoked
+func (mmReject *mEmergencySvcMockReject) Times(n uint64) *mEmergencySvcMockReject {
+	if n == 0 {
+		mmReject.mock.t.Fatalf("Times of EmergencySvcMock.Reject mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReject.expectedInvocations, n)
+	mmReject.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReject
+}
+
+func (mmReject *mEmergencySvcMockReject) invocationsDone() bool {
+	if len(mmReject.expectations) == 0 && mmReject.defaultExpectation == nil && mmReject.mock.funcReject == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReject.mock.afterRejectCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReject.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Reject implements mm_handler.EmergencySvc
+func (mmReject *EmergencySvcMock) Reject(ctx context.Context, grantor string, grantee string) (err error) {
+	mm_atomic.AddUint64(&mmReject.beforeRejectCounter, 1)
+	defer mm_atomic.AddUint64(&mmReject.afterRejectCounter, 1)
+
+	mmReject.t.Helper()
+
+	if mmReject.inspectFuncReject != nil {
+		mmReject.inspectFuncReject(ctx, grantor, grantee)
+	}
+
+	mm_params := EmergencySvcMockRejectParams{ctx, grantor, grantee}
+
+	// Record call args
+	mmReject.RejectMock.mutex.Lock()
+	mmReject.RejectMock.callArgs = append(mmReject.RejectMock.callArgs, &mm_params)
+	mmReject.RejectMock.mutex.Unlock()
+
+	for _, e := range mmReject.RejectMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReject.RejectMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReject.RejectMock.defaultExpectation.Counter, 1)
+		mm_want := mmReject.RejectMock.defaultExpectation.params
+		mm_want_ptrs := mmReject.RejectMock.defaultExpectation.paramPtrs
+
+		mm_got := EmergencySvcMockRejectParams{ctx, grantor, grantee}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReject.t.Errorf("EmergencySvcMock.Reject got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReject.RejectMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.grantor != nil && !minimock.Equal(*mm_want_ptrs.grantor, mm_got.grantor) {
+				mmReject.t.Errorf("EmergencySvcMock.Reject got unexpected parameter grantor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReject.RejectMock.defaultExpectation.expectationOrigins.originGrantor, *mm_want_ptrs.grantor, mm_got.grantor, minimock.Diff(*mm_want_ptrs.grantor, mm_got.grantor))
+			}
+
+			if mm_want_ptrs.grantee != nil && !minimock.Equal(*mm_want_ptrs.grantee, mm_got.grantee) {
+				mmReject.t.Errorf("EmergencySvcMock.Reject got unexpected parameter grantee, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReject.RejectMock.defaultExpectation.expectationOrigins.originGrantee, *mm_want_ptrs.grantee, mm_got.grantee, minimock.Diff(*mm_want_ptrs.grantee, mm_got.grantee))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReject.t.Errorf("EmergencySvcMock.Reject got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReject.RejectMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReject.RejectMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReject.t.Fatal("No results are set for the EmergencySvcMock.Reject")
+		}
+		return (*mm_results).err
+	}
+	if mmReject.funcReject != nil {
+		return mmReject.funcReject(ctx, grantor, grantee)
+	}
+	mmReject.t.Fatalf("Unexpected call to EmergencySvcMock.Reject. %v %v %v", ctx, grantor, grantee)
+	return
+}
+
+// RejectAfterCounter returns a count of finished EmergencySvcMock.Reject invocations
+func (mmReject *EmergencySvcMock) RejectAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReject.afterRejectCounter)
+}
+
+// RejectBeforeCounter returns a count of EmergencySvcMock.Reject invocations
+func (mmReject *EmergencySvcMock) RejectBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReject.beforeRejectCounter)
+}
+
+// Calls returns a list of arguments used in each call to EmergencySvcMock.Reject.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReject *mEmergencySvcMockReject) Calls() []*EmergencySvcMockRejectParams {
+	mmReject.mutex.RLock()
+
+	argCopy := make([]*EmergencySvcMockRejectParams, len(mmReject.callArgs))
+	copy(argCopy, mmReject.callArgs)
+
+	mmReject.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRejectDone returns true if the count of the Reject invocations corresponds
+// the number of defined expectations
+func (m *EmergencySvcMock) MinimockRejectDone() bool {
+	if m.RejectMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RejectMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RejectMock.invocationsDone()
+}
+
+// MinimockRejectInspect logs each unmet expectation
+func (m *EmergencySvcMock) MinimockRejectInspect() {
+	for _, e := range m.RejectMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EmergencySvcMock.Reject at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRejectCounter := mm_atomic.LoadUint64(&m.afterRejectCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RejectMock.defaultExpectation != nil && afterRejectCounter < 1 {
+		if m.RejectMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EmergencySvcMock.Reject at\n%s", m.RejectMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EmergencySvcMock.Reject at\n%s with params: %#v", m.RejectMock.defaultExpectation.expectationOrigins.origin, *m.RejectMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReject != nil && afterRejectCounter < 1 {
+		m.t.Errorf("Expected call to EmergencySvcMock.Reject at\n%s", m.funcRejectOrigin)
+	}
+
+	if !m.RejectMock.invocationsDone() && afterRejectCounter > 0 {
+		m.t.Errorf("Expected %d calls to EmergencySvcMock.Reject at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RejectMock.expectedInvocations), m.RejectMock.expectedInvocationsOrigin, afterRejectCounter)
+	}
+}
+
+type mEmergencySvcMockRemoveContact struct {
+	optional           bool
+	mock               *EmergencySvcMock
+	defaultExpectation *EmergencySvcMockRemoveContactExpectation
+	expectations       []*EmergencySvcMockRemoveContactExpectation
+
+	callArgs []*EmergencySvcMockRemoveContactParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EmergencySvcMockRemoveContactExpectation specifies expectation struct of the EmergencySvc.RemoveContact
+type EmergencySvcMockRemoveContactExpectation struct {
+	mock               *EmergencySvcMock
+	params             *EmergencySvcMockRemoveContactParams
+	paramPtrs          *EmergencySvcMockRemoveContactParamPtrs
+	expectationOrigins EmergencySvcMockRemoveContactExpectationOrigins
+	results            *EmergencySvcMockRemoveContactResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EmergencySvcMockRemoveContactParams contains parameters of the EmergencySvc.RemoveContact
+type EmergencySvcMockRemoveContactParams struct {
+	ctx     context.Context
+	grantor string
+	grantee string
+}
+
+// EmergencySvcMockRemoveContactParamPtrs contains pointers to parameters of the EmergencySvc.RemoveContact
+type EmergencySvcMockRemoveContactParamPtrs struct {
+	ctx     *context.Context
+	grantor *string
+	grantee *string
+}
+
+// EmergencySvcMockRemoveContactResults contains results of the EmergencySvc.RemoveContact
+type EmergencySvcMockRemoveContactResults struct {
+	err error
+}
+
+// EmergencySvcMockRemoveContactOrigins contains origins of expectations of the EmergencySvc.RemoveContact
+type EmergencySvcMockRemoveContactExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originGrantor string
+	originGrantee string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveContact *mEmergencySvcMockRemoveContact) Optional() *mEmergencySvcMockRemoveContact {
+	mmRemoveContact.optional = true
+	return mmRemoveContact
+}
+
+// Expect sets up expected params for EmergencySvc.RemoveContact
+func (mmRemoveContact *mEmergencySvcMockRemoveContact) Expect(ctx context.Context, grantor string, grantee string) *mEmergencySvcMockRemoveContact {
+	if mmRemoveContact.mock.funcRemoveContact != nil {
+		mmRemoveContact.mock.t.Fatalf("EmergencySvcMock.RemoveContact mock is already set by Set")
+	}
+
+	if mmRemoveContact.defaultExpectation == nil {
+		mmRemoveContact.defaultExpectation = &EmergencySvcMockRemoveContactExpectation{}
+	}
+
+	if mmRemoveContact.defaultExpectation.paramPtrs != nil {
+		mmRemoveContact.mock.t.Fatalf("EmergencySvcMock.RemoveContact mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveContact.defaultExpectation.params = &EmergencySvcMockRemoveContactParams{ctx, grantor, grantee}
+	mmRemoveContact.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemoveContact.expectations {
+		if minimock.Equal(e.params, mmRemoveContact.defaultExpectation.params) {
+			mmRemoveContact.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveContact.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveContact
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EmergencySvc.RemoveContact
+func (mmRemoveContact *mEmergencySvcMockRemoveContact) ExpectCtxParam1(ctx context.Context) *mEmergencySvcMockRemoveContact {
+	if mmRemoveContact.mock.funcRemoveContact != nil {
+		mmRemoveContact.mock.t.Fatalf("EmergencySvcMock.RemoveContact mock is already set by Set")
+	}
+
+	if mmRemoveContact.defaultExpectation == nil {
+		mmRemoveContact.defaultExpectation = &EmergencySvcMockRemoveContactExpectation{}
+	}
+
+	if mmRemoveContact.defaultExpectation.params != nil {
+		mmRemoveContact.mock.t.Fatalf("EmergencySvcMock.RemoveContact mock is already set by Expect")
+	}
+
+	if mmRemoveContact.defaultExpectation.paramPtrs == nil {
+		mmRemoveContact.defaultExpectation.paramPtrs = &EmergencySvcMockRemoveContactParamPtrs{}
+	}
+	mmRemoveContact.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemoveContact.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemoveContact
+}
+
+// ExpectGrantorParam2 sets up expected param grantor for EmergencySvc.RemoveContact
+func (mmRemoveContact *mEmergencySvcMockRemoveContact) ExpectGrantorParam2(grantor string) *mEmergencySvcMockRemoveContact {
+	if mmRemoveContact.mock.funcRemoveContact != nil {
+		mmRemoveContact.mock.t.Fatalf("EmergencySvcMock.RemoveContact mock is already set by Set")
+	}
+
+	if mmRemoveContact.defaultExpectation == nil {
+		mmRemoveContact.defaultExpectation = &EmergencySvcMockRemoveContactExpectation{}
+	}
+
+	if mmRemoveContact.defaultExpectation.params != nil {
+		mmRemoveContact.mock.t.Fatalf("EmergencySvcMock.RemoveContact mock is already set by Expect")
+	}
+
+	if mmRemoveContact.defaultExpectation.paramPtrs == nil {
+		mmRemoveContact.defaultExpectation.paramPtrs = &EmergencySvcMockRemoveContactParamPtrs{}
+	}
+	mmRemoveContact.defaultExpectation.paramPtrs.grantor = &grantor
+	mmRemoveContact.defaultExpectation.expectationOrigins.originGrantor = minimock.CallerInfo(1)
+
+	return mmRemoveContact
+}
+
+// ExpectGranteeParam3 sets up expected param grantee for EmergencySvc.RemoveContact
+func (mmRemoveContact *mEmergencySvcMockRemoveContact) ExpectGranteeParam3(grantee string) *mEmergencySvcMockRemoveContact {
+	if mmRemoveContact.mock.funcRemoveContact != nil {
+		mmRemoveContact.mock.t.Fatalf("EmergencySvcMock.RemoveContact mock is already set by Set")
+	}
+
+	if mmRemoveContact.defaultExpectation == nil {
+		mmRemoveContact.defaultExpectation = &EmergencySvcMockRemoveContactExpectation{}
+	}
+
+	if mmRemoveContact.defaultExpectation.params != nil {
+		mmRemoveContact.mock.t.Fatalf("EmergencySvcMock.RemoveContact mock is already set by Expect")
+	}
+
+	if mmRemoveContact.defaultExpectation.paramPtrs == nil {
+		mmRemoveContact.defaultExpectation.paramPtrs = &EmergencySvcMockRemoveContactParamPtrs{}
+	}
+	mmRemoveContact.defaultExpectation.paramPtrs.grantee = &grantee
+	mmRemoveContact.defaultExpectation.expectationOrigins.originGrantee = minimock.CallerInfo(1)
+
+	return mmRemoveContact
+}
+
+// Inspect accepts an inspector function that has same arguments as the EmergencySvc.RemoveContact
+func (mmRemoveContact *mEmergencySvcMockRemoveContact) Inspect(f func(ctx context.Context, grantor string, grantee string)) *mEmergencySvcMockRemoveContact {
+	if mmRemoveContact.mock.inspectFuncRemoveContact != nil {
+		mmRemoveContact.mock.t.Fatalf("Inspect function is already set for EmergencySvcMock.RemoveContact")
+	}
+
+	mmRemoveContact.mock.inspectFuncRemoveContact = f
+
+	return mmRemoveContact
+}
+
+// Return sets up results that will be returned by EmergencySvc.RemoveContact
+func (mmRemoveContact *mEmergencySvcMockRemoveContact) Return(err error) *EmergencySvcMock {
+	if mmRemoveContact.mock.funcRemoveContact != nil {
+		mmRemoveContact.mock.t.Fatalf("EmergencySvcMock.RemoveContact mock is already set by Set")
+	}
+
+	if mmRemoveContact.defaultExpectation == nil {
+		mmRemoveContact.defaultExpectation = &EmergencySvcMockRemoveContactExpectation{mock: mmRemoveContact.mock}
+	}
+	mmRemoveContact.defaultExpectation.results = &EmergencySvcMockRemoveContactResults{err}
+	mmRemoveContact.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemoveContact.mock
+}
+
+// Set uses given function f to mock the EmergencySvc.RemoveContact method
+func (mmRemoveContact *mEmergencySvcMockRemoveContact) Set(f func(ctx context.Context, grantor string, grantee string) (err error)) *EmergencySvcMock {
+	if mmRemoveContact.defaultExpectation != nil {
+		mmRemoveContact.mock.t.Fatalf("Default expectation is already set for the EmergencySvc.RemoveContact method")
+	}
+
+	if len(mmRemoveContact.expectations) > 0 {
+		mmRemoveContact.mock.t.Fatalf("Some expectations are already set for the EmergencySvc.RemoveContact method")
+	}
+
+	mmRemoveContact.mock.funcRemoveContact = f
+	mmRemoveContact.mock.funcRemoveContactOrigin = minimock.CallerInfo(1)
+	return mmRemoveContact.mock
+}
+
+// When sets expectation for the EmergencySvc.RemoveContact which will trigger the result defined by the following
+// Then helper
+func (mmRemoveContact *mEmergencySvcMockRemoveContact) When(ctx context.Context, grantor string, grantee string) *EmergencySvcMockRemoveContactExpectation {
+	if mmRemoveContact.mock.funcRemoveContact != nil {
+		mmRemoveContact.mock.t.Fatalf("EmergencySvcMock.RemoveContact mock is already set by Set")
+	}
+
+	expectation := &EmergencySvcMockRemoveContactExpectation{
+		mock:               mmRemoveContact.mock,
+		params:             &EmergencySvcMockRemoveContactParams{ctx, grantor, grantee},
+		expectationOrigins: EmergencySvcMockRemoveContactExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemoveContact.expectations = append(mmRemoveContact.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EmergencySvc.RemoveContact return parameters for the expectation previously defined by the When method
+func (e *EmergencySvcMockRemoveContactExpectation) Then(err error) *EmergencySvcMock {
+	e.results = &EmergencySvcMockRemoveContactResults{err}
+	return e.mock
+}
+
+// Times sets number of times EmergencySvc.RemoveContact should be invoked
+func (mmRemoveContact *mEmergencySvcMockRemoveContact) Times(n uint64) *mEmergencySvcMockRemoveContact {
+	if n == 0 {
+		mmRemoveContact.mock.t.Fatalf("Times of EmergencySvcMock.RemoveContact mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveContact.expectedInvocations, n)
+	mmRemoveContact.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemoveContact
+}
+
+func (mmRemoveContact *mEmergencySvcMockRemoveContact) invocationsDone() bool {
+	if len(mmRemoveContact.expectations) == 0 && mmRemoveContact.defaultExpectation == nil && mmRemoveContact.mock.funcRemoveContact == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveContact.mock.afterRemoveContactCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveContact.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveContact implements mm_handler.EmergencySvc
+func (mmRemoveContact *EmergencySvcMock) RemoveContact(ctx context.Context, grantor string, grantee string) (err error) {
+	mm_atomic.AddUint64(&mmRemoveContact.beforeRemoveContactCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveContact.afterRemoveContactCounter, 1)
+
+	mmRemoveContact.t.Helper()
+
+	if mmRemoveContact.inspectFuncRemoveContact != nil {
+		mmRemoveContact.inspectFuncRemoveContact(ctx, grantor, grantee)
+	}
+
+	mm_params := EmergencySvcMockRemoveContactParams{ctx, grantor, grantee}
+
+	// Record call args
+	mmRemoveContact.RemoveContactMock.mutex.Lock()
+	mmRemoveContact.RemoveContactMock.callArgs = append(mmRemoveContact.RemoveContactMock.callArgs, &mm_params)
+	mmRemoveContact.RemoveContactMock.mutex.Unlock()
+
+	for _, e := range mmRemoveContact.RemoveContactMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemoveContact.RemoveContactMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveContact.RemoveContactMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveContact.RemoveContactMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveContact.RemoveContactMock.defaultExpectation.paramPtrs
+
+		mm_got := EmergencySvcMockRemoveContactParams{ctx, grantor, grantee}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveContact.t.Errorf("EmergencySvcMock.RemoveContact got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveContact.RemoveContactMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.grantor != nil && !minimock.Equal(*mm_want_ptrs.grantor, mm_got.grantor) {
+				mmRemoveContact.t.Errorf("EmergencySvcMock.RemoveContact got unexpected parameter grantor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveContact.RemoveContactMock.defaultExpectation.expectationOrigins.originGrantor, *mm_want_ptrs.grantor, mm_got.grantor, minimock.Diff(*mm_want_ptrs.grantor, mm_got.grantor))
+			}
+
+			if mm_want_ptrs.grantee != nil && !minimock.Equal(*mm_want_ptrs.grantee, mm_got.grantee) {
+				mmRemoveContact.t.Errorf("EmergencySvcMock.RemoveContact got unexpected parameter grantee, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveContact.RemoveContactMock.defaultExpectation.expectationOrigins.originGrantee, *mm_want_ptrs.grantee, mm_got.grantee, minimock.Diff(*mm_want_ptrs.grantee, mm_got.grantee))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveContact.t.Errorf("EmergencySvcMock.RemoveContact got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemoveContact.RemoveContactMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveContact.RemoveContactMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveContact.t.Fatal("No results are set for the EmergencySvcMock.RemoveContact")
+		}
+		return (*mm_results).err
+	}
+	if mmRemoveContact.funcRemoveContact != nil {
+		return mmRemoveContact.funcRemoveContact(ctx, grantor, grantee)
+	}
+	mmRemoveContact.t.Fatalf("Unexpected call to EmergencySvcMock.RemoveContact. %v %v %v", ctx, grantor, grantee)
+	return
+}
+
+// RemoveContactAfterCounter returns a count of finished EmergencySvcMock.RemoveContact invocations
+func (mmRemoveContact *EmergencySvcMock) RemoveContactAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveContact.afterRemoveContactCounter)
+}
+
+// RemoveContactBeforeCounter returns a count of EmergencySvcMock.RemoveContact invocations
+func (mmRemoveContact *EmergencySvcMock) RemoveContactBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveContact.beforeRemoveContactCounter)
+}
+
+// Calls returns a list of arguments used in each call to EmergencySvcMock.RemoveContact.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveContact *mEmergencySvcMockRemoveContact) Calls() []*EmergencySvcMockRemoveContactParams {
+	mmRemoveContact.mutex.RLock()
+
+	argCopy := make([]*EmergencySvcMockRemoveContactParams, len(mmRemoveContact.callArgs))
+	copy(argCopy, mmRemoveContact.callArgs)
+
+	mmRemoveContact.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveContactDone returns true if the count of the RemoveContact invocations corresponds
+// the number of defined expectations
+func (m *EmergencySvcMock) MinimockRemoveContactDone() bool {
+	if m.RemoveContactMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveContactMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveContactMock.invocationsDone()
+}
+
+// MinimockRemoveContactInspect logs each unmet expectation
+func (m *EmergencySvcMock) MinimockRemoveContactInspect() {
+	for _, e := range m.RemoveContactMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EmergencySvcMock.RemoveContact at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemoveContactCounter := mm_atomic.LoadUint64(&m.afterRemoveContactCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveContactMock.defaultExpectation != nil && afterRemoveContactCounter < 1 {
+		if m.RemoveContactMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EmergencySvcMock.RemoveContact at\n%s", m.RemoveContactMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EmergencySvcMock.RemoveContact at\n%s with params: %#v", m.RemoveContactMock.defaultExpectation.expectationOrigins.origin, *m.RemoveContactMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveContact != nil && afterRemoveContactCounter < 1 {
+		m.t.Errorf("Expected call to EmergencySvcMock.RemoveContact at\n%s", m.funcRemoveContactOrigin)
+	}
+
+	if !m.RemoveContactMock.invocationsDone() && afterRemoveContactCounter > 0 {
+		m.t.Errorf("Expected %d calls to EmergencySvcMock.RemoveContact at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveContactMock.expectedInvocations), m.RemoveContactMock.expectedInvocationsOrigin, afterRemoveContactCounter)
+	}
+}
+
+type mEmergencySvcMockRequestAccess struct {
+	optional           bool
+	mock               *EmergencySvcMock
+	defaultExpectation *EmergencySvcMockRequestAccessExpectation
+	expectations       []*EmergencySvcMockRequestAccessExpectation
+
+	callArgs []*EmergencySvcMockRequestAccessParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EmergencySvcMockRequestAccessExpectation specifies expectation struct of the EmergencySvc.RequestAccess
+type EmergencySvcMockRequestAccessExpectation struct {
+	mock               *EmergencySvcMock
+	params             *EmergencySvcMockRequestAccessParams
+	paramPtrs          *EmergencySvcMockRequestAccessParamPtrs
+	expectationOrigins EmergencySvcMockRequestAccessExpectationOrigins
+	results            *EmergencySvcMockRequestAccessResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EmergencySvcMockRequestAccessParams contains parameters of the EmergencySvc.RequestAccess
+type EmergencySvcMockRequestAccessParams struct {
+	ctx     context.Context
+	grantee string
+	grantor string
+}
+
+// EmergencySvcMockRequestAccessParamPtrs contains pointers to parameters of the EmergencySvc.RequestAccess
+type EmergencySvcMockRequestAccessParamPtrs struct {
+	ctx     *context.Context
+	grantee *string
+	grantor *string
+}
+
+// EmergencySvcMockRequestAccessResults contains results of the EmergencySvc.RequestAccess
+type EmergencySvcMockRequestAccessResults struct {
+	err error
+}
+
+// EmergencySvcMockRequestAccessOrigins contains origins of expectations of the EmergencySvc.RequestAccess
+type EmergencySvcMockRequestAccessExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originGrantee string
+	originGrantor string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRequestAccess *mEmergencySvcMockRequestAccess) Optional() *mEmergencySvcMockRequestAccess {
+	mmRequestAccess.optional = true
+	return mmRequestAccess
+}
+
+// Expect sets up expected params for EmergencySvc.RequestAccess
+func (mmRequestAccess *mEmergencySvcMockRequestAccess) Expect(ctx context.Context, grantee string, grantor string) *mEmergencySvcMockRequestAccess {
+	if mmRequestAccess.mock.funcRequestAccess != nil {
+		mmRequestAccess.mock.t.Fatalf("EmergencySvcMock.RequestAccess mock is already set by Set")
+	}
+
+	if mmRequestAccess.defaultExpectation == nil {
+		mmRequestAccess.defaultExpectation = &EmergencySvcMockRequestAccessExpectation{}
+	}
+
+	if mmRequestAccess.defaultExpectation.paramPtrs != nil {
+		mmRequestAccess.mock.t.Fatalf("EmergencySvcMock.RequestAccess mock is already set by ExpectParams functions")
+	}
+
+	mmRequestAccess.defaultExpectation.params = &EmergencySvcMockRequestAccessParams{ctx, grantee, grantor}
+	mmRequestAccess.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRequestAccess.expectations {
+		if minimock.Equal(e.params, mmRequestAccess.defaultExpectation.params) {
+			mmRequestAccess.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRequestAccess.defaultExpectation.params)
+		}
+	}
+
+	return mmRequestAccess
+}
+
+// ExpectCtxParam1 sets up expected param ctx for EmergencySvc.RequestAccess
+func (mmRequestAccess *mEmergencySvcMockRequestAccess) ExpectCtxParam1(ctx context.Context) *mEmergencySvcMockRequestAccess {
+	if mmRequestAccess.mock.funcRequestAccess != nil {
+		mmRequestAccess.mock.t.Fatalf("EmergencySvcMock.RequestAccess mock is already set by Set")
+	}
+
+	if mmRequestAccess.defaultExpectation == nil {
+		mmRequestAccess.defaultExpectation = &EmergencySvcMockRequestAccessExpectation{}
+	}
+
+	if mmRequestAccess.defaultExpectation.params != nil {
+		mmRequestAccess.mock.t.Fatalf("EmergencySvcMock.RequestAccess mock is already set by Expect")
+	}
+
+	if mmRequestAccess.defaultExpectation.paramPtrs == nil {
+		mmRequestAccess.defaultExpectation.paramPtrs = &EmergencySvcMockRequestAccessParamPtrs{}
+	}
+	mmRequestAccess.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRequestAccess.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRequestAccess
+}
+
+// ExpectGranteeParam2 sets up expected param grantee for EmergencySvc.RequestAccess
+func (mmRequestAccess *mEmergencySvcMockRequestAccess) ExpectGranteeParam2(grantee string) *mEmergencySvcMockRequestAccess {
+	if mmRequestAccess.mock.funcRequestAccess != nil {
+		mmRequestAccess.mock.t.Fatalf("EmergencySvcMock.RequestAccess mock is already set by Set")
+	}
+
+	if mmRequestAccess.defaultExpectation == nil {
+		mmRequestAccess.defaultExpectation = &EmergencySvcMockRequestAccessExpectation{}
+	}
+
+	if mmRequestAccess.defaultExpectation.params != nil {
+		mmRequestAccess.mock.t.Fatalf("EmergencySvcMock.RequestAccess mock is already set by Expect")
+	}
+
+	if mmRequestAccess.defaultExpectation.paramPtrs == nil {
+		mmRequestAccess.defaultExpectation.paramPtrs = &EmergencySvcMockRequestAccessParamPtrs{}
+	}
+	mmRequestAccess.defaultExpectation.paramPtrs.grantee = &grantee
+	mmRequestAccess.defaultExpectation.expectationOrigins.originGrantee = minimock.CallerInfo(1)
+
+	return mmRequestAccess
+}
+
+// ExpectGrantorParam3 sets up expected param grantor for EmergencySvc.RequestAccess
+func (mmRequestAccess *mEmergencySvcMockRequestAccess) ExpectGrantorParam3(grantor string) *mEmergencySvcMockRequestAccess {
+	if mmRequestAccess.mock.funcRequestAccess != nil {
+		mmRequestAccess.mock.t.Fatalf("EmergencySvcMock.RequestAccess mock is already set by Set")
+	}
+
+	if mmRequestAccess.defaultExpectation == nil {
+		mmRequestAccess.defaultExpectation = &EmergencySvcMockRequestAccessExpectation{}
+	}
+
+	if mmRequestAccess.defaultExpectation.params != nil {
+		mmRequestAccess.mock.t.Fatalf("EmergencySvcMock.RequestAccess mock is already set by Expect")
+	}
+
+	if mmRequestAccess.defaultExpectation.paramPtrs == nil {
+		mmRequestAccess.defaultExpectation.paramPtrs = &EmergencySvcMockRequestAccessParamPtrs{}
+	}
+	mmRequestAccess.defaultExpectation.paramPtrs.grantor = &grantor
+	mmRequestAccess.defaultExpectation.expectationOrigins.originGrantor = minimock.CallerInfo(1)
+
+	return mmRequestAccess
+}
+
+// Inspect accepts an inspector function that has same arguments as the EmergencySvc.RequestAccess
+func (mmRequestAccess *mEmergencySvcMockRequestAccess) Inspect(f func(ctx context.Context, grantee string, grantor string)) *mEmergencySvcMockRequestAccess {
+	if mmRequestAccess.mock.inspectFuncRequestAccess != nil {
+		mmRequestAccess.mock.t.Fatalf("Inspect function is already set for EmergencySvcMock.RequestAccess")
+	}
+
+	mmRequestAccess.mock.inspectFuncRequestAccess = f
+
+	return mmRequestAccess
+}
+
+// Return sets up results that will be returned by EmergencySvc.RequestAccess
+func (mmRequestAccess *mEmergencySvcMockRequestAccess) Return(err error) *EmergencySvcMock {
+	if mmRequestAccess.mock.funcRequestAccess != nil {
+		mmRequestAccess.mock.t.Fatalf("EmergencySvcMock.RequestAccess mock is already set by Set")
+	}
+
+	if mmRequestAccess.defaultExpectation == nil {
+		mmRequestAccess.defaultExpectation = &EmergencySvcMockRequestAccessExpectation{mock: mmRequestAccess.mock}
+	}
+	mmRequestAccess.defaultExpectation.results = &EmergencySvcMockRequestAccessResults{err}
+	mmRequestAccess.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRequestAccess.mock
+}
+
+// Set uses given function f to mock the EmergencySvc.RequestAccess method
+func (mmRequestAccess *mEmergencySvcMockRequestAccess) Set(f func(ctx context.Context, grantee string, grantor string) (err error)) *EmergencySvcMock {
+	if mmRequestAccess.defaultExpectation != nil {
+		mmRequestAccess.mock.t.Fatalf("Default expectation is already set for the EmergencySvc.RequestAccess method")
+	}
+
+	if len(mmRequestAccess.expectations) > 0 {
+		mmRequestAccess.mock.t.Fatalf("Some expectations are already set for the EmergencySvc.RequestAccess method")
+	}
+
+	mmRequestAccess.mock.funcRequestAccess = f
+	mmRequestAccess.mock.funcRequestAccessOrigin = minimock.CallerInfo(1)
+	return mmRequestAccess.mock
+}
+
+// When sets expectation for the EmergencySvc.RequestAccess which will trigger the result defined by the following
+// Then helper
+func (mmRequestAccess *mEmergencySvcMockRequestAccess) When(ctx context.Context, grantee string, grantor string) *EmergencySvcMockRequestAccessExpectation {
+	if mmRequestAccess.mock.funcRequestAccess != nil {
+		mmRequestAccess.mock.t.Fatalf("EmergencySvcMock.RequestAccess mock is already set by Set")
+	}
+
+	expectation := &EmergencySvcMockRequestAccessExpectation{
+		mock:               mmRequestAccess.mock,
+		params:             &EmergencySvcMockRequestAccessParams{ctx, grantee, grantor},
+		expectationOrigins: EmergencySvcMockRequestAccessExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRequestAccess.expectations = append(mmRequestAccess.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EmergencySvc.RequestAccess return parameters for the expectation previously defined by the When method
+func (e *EmergencySvcMockRequestAccessExpectation) Then(err error) *EmergencySvcMock {
+	e.results = &EmergencySvcMockRequestAccessResults{err}
+	return e.mock
+}
+
+// Times sets number of times EmergencySvc.RequestAccess should be invoked
+func (mmRequestAccess *mEmergencySvcMockRequestAccess) Times(n uint64) *mEmergencySvcMockRequestAccess {
+	if n == 0 {
+		mmRequestAccess.mock.t.Fatalf("Times of EmergencySvcMock.RequestAccess mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRequestAccess.expectedInvocations, n)
+	mmRequestAccess.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRequestAccess
+}
+
+func (mmRequestAccess *mEmergencySvcMockRequestAccess) invocationsDone() bool {
+	if len(mmRequestAccess.expectations) == 0 && mmRequestAccess.defaultExpectation == nil && mmRequestAccess.mock.funcRequestAccess == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRequestAccess.mock.afterRequestAccessCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRequestAccess.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RequestAccess implements mm_handler.EmergencySvc
+func (mmRequestAccess *EmergencySvcMock) RequestAccess(ctx context.Context, grantee string, grantor string) (err error) {
+	mm_atomic.AddUint64(&mmRequestAccess.beforeRequestAccessCounter, 1)
+	defer mm_atomic.AddUint64(&mmRequestAccess.afterRequestAccessCounter, 1)
+
+	mmRequestAccess.t.Helper()
+
+	if mmRequestAccess.inspectFuncRequestAccess != nil {
+		mmRequestAccess.inspectFuncRequestAccess(ctx, grantee, grantor)
+	}
+
+	mm_params := EmergencySvcMockRequestAccessParams{ctx, grantee, grantor}
+
+	// Record call args
+	mmRequestAccess.RequestAccessMock.mutex.Lock()
+	mmRequestAccess.RequestAccessMock.callArgs = append(mmRequestAccess.RequestAccessMock.callArgs, &mm_params)
+	mmRequestAccess.RequestAccessMock.mutex.Unlock()
+
+	for _, e := range mmRequestAccess.RequestAccessMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRequestAccess.RequestAccessMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRequestAccess.RequestAccessMock.defaultExpectation.Counter, 1)
+		mm_want := mmRequestAccess.RequestAccessMock.defaultExpectation.params
+		mm_want_ptrs := mmRequestAccess.RequestAccessMock.defaultExpectation.paramPtrs
+
+		mm_got := EmergencySvcMockRequestAccessParams{ctx, grantee, grantor}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRequestAccess.t.Errorf("EmergencySvcMock.RequestAccess got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRequestAccess.RequestAccessMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.grantee != nil && !minimock.Equal(*mm_want_ptrs.grantee, mm_got.grantee) {
+				mmRequestAccess.t.Errorf("EmergencySvcMock.RequestAccess got unexpected parameter grantee, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRequestAccess.RequestAccessMock.defaultExpectation.expectationOrigins.originGrantee, *mm_want_ptrs.grantee, mm_got.grantee, minimock.Diff(*mm_want_ptrs.grantee, mm_got.grantee))
+			}
+
+			if mm_want_ptrs.grantor != nil && !minimock.Equal(*mm_want_ptrs.grantor, mm_got.grantor) {
+				mmRequestAccess.t.Errorf("EmergencySvcMock.RequestAccess got unexpected parameter grantor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRequestAccess.RequestAccessMock.defaultExpectation.expectationOrigins.originGrantor, *mm_want_ptrs.grantor, mm_got.grantor, minimock.Diff(*mm_want_ptrs.grantor, mm_got.grantor))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRequestAccess.t.Errorf("EmergencySvcMock.RequestAccess got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRequestAccess.RequestAccessMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRequestAccess.RequestAccessMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRequestAccess.t.Fatal("No results are set for the EmergencySvcMock.RequestAccess")
+		}
+		return (*mm_results).err
+	}
+	if mmRequestAccess.funcRequestAccess != nil {
+		return mmRequestAccess.funcRequestAccess(ctx, grantee, grantor)
+	}
+	mmRequestAccess.t.Fatalf("Unexpected call to EmergencySvcMock.RequestAccess. %v %v %v", ctx, grantee, grantor)
+	return
+}
+
+// RequestAccessAfterCounter returns a count of finished EmergencySvcMock.RequestAccess invocations
+func (mmRequestAccess *EmergencySvcMock) RequestAccessAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequestAccess.afterRequestAccessCounter)
+}
+
+// RequestAccessBeforeCounter returns a count of EmergencySvcMock.RequestAccess invocations
+func (mmRequestAccess *EmergencySvcMock) RequestAccessBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRequestAccess.beforeRequestAccessCounter)
+}
+
+// Calls returns a list of arguments used in each call to EmergencySvcMock.RequestAccess.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRequestAccess *mEmergencySvcMockRequestAccess) Calls() []*EmergencySvcMockRequestAccessParams {
+	mmRequestAccess.mutex.RLock()
+
+	argCopy := make([]*EmergencySvcMockRequestAccessParams, len(mmRequestAccess.callArgs))
+	copy(argCopy, mmRequestAccess.callArgs)
+
+	mmRequestAccess.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRequestAccessDone returns true if the count of the RequestAccess invocations corresponds
+// the number of defined expectations
+func (m *EmergencySvcMock) MinimockRequestAccessDone() bool {
+	if m.RequestAccessMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RequestAccessMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RequestAccessMock.invocationsDone()
+}
+
+// MinimockRequestAccessInspect logs each unmet expectation
+func (m *EmergencySvcMock) MinimockRequestAccessInspect() {
+	for _, e := range m.RequestAccessMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EmergencySvcMock.RequestAccess at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRequestAccessCounter := mm_atomic.LoadUint64(&m.afterRequestAccessCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RequestAccessMock.defaultExpectation != nil && afterRequestAccessCounter < 1 {
+		if m.RequestAccessMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EmergencySvcMock.RequestAccess at\n%s", m.RequestAccessMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EmergencySvcMock.RequestAccess at\n%s with params: %#v", m.RequestAccessMock.defaultExpectation.expectationOrigins.origin, *m.RequestAccessMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRequestAccess != nil && afterRequestAccessCounter < 1 {
+		m.t.Errorf("Expected call to EmergencySvcMock.RequestAccess at\n%s", m.funcRequestAccessOrigin)
+	}
+
+	if !m.RequestAccessMock.invocationsDone() && afterRequestAccessCounter > 0 {
+		m.t.Errorf("Expected %d calls to EmergencySvcMock.RequestAccess at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RequestAccessMock.expectedInvocations), m.RequestAccessMock.expectedInvocationsOrigin, afterRequestAccessCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *EmergencySvcMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddContactInspect()
+
+			m.MinimockApproveInspect()
+
+			m.MinimockGetContactsInspect()
+
+			m.MinimockGetGrantorCardsInspect()
+
+			m.MinimockRejectInspect()
+
+			m.MinimockRemoveContactInspect()
+
+			m.MinimockRequestAccessInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *EmergencySvcMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *EmergencySvcMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddContactDone() &&
+		m.MinimockApproveDone() &&
+		m.MinimockGetContactsDone() &&
+		m.MinimockGetGrantorCardsDone() &&
+		m.MinimockRejectDone() &&
+		m.MinimockRemoveContactDone() &&
+		m.MinimockRequestAccessDone()
+}
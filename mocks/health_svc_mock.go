@@ -0,0 +1,386 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.3). DO NOT EDIT.
+
+package mock_service
+
+//go:generate minimock -i github.com/gleb-korostelev/GophKeeper/internal/handler.HealthSvc -o health_svc_mock.go -n HealthSvcMock -p mock_service
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gleb-korostelev/GophKeeper/tools/health"
+	"github.com/gojuno/minimock/v3"
+)
+
+// HealthSvcMock implements mm_handler.HealthSvc
+type HealthSvcMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCheck          func(ctx context.Context) (r1 health.Report)
+	funcCheckOrigin    string
+	inspectFuncCheck   func(ctx context.Context)
+	afterCheckCounter  uint64
+	beforeCheckCounter uint64
+	CheckMock          mHealthSvcMockCheck
+}
+
+// NewHealthSvcMock returns a mock for mm_handler.HealthSvc
+func NewHealthSvcMock(t minimock.Tester) *HealthSvcMock {
+	m := &HealthSvcMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CheckMock = mHealthSvcMockCheck{mock: m}
+	m.CheckMock.callArgs = []*HealthSvcMockCheckParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mHealthSvcMockCheck struct {
+	optional           bool
+	mock               *HealthSvcMock
+	defaultExpectation *HealthSvcMockCheckExpectation
+	expectations       []*HealthSvcMockCheckExpectation
+
+	callArgs []*HealthSvcMockCheckParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// HealthSvcMockCheckExpectation specifies expectation struct of the HealthSvc.Check
+type HealthSvcMockCheckExpectation struct {
+	mock               *HealthSvcMock
+	params             *HealthSvcMockCheckParams
+	paramPtrs          *HealthSvcMockCheckParamPtrs
+	expectationOrigins HealthSvcMockCheckExpectationOrigins
+	results            *HealthSvcMockCheckResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// HealthSvcMockCheckParams contains parameters of the HealthSvc.Check
+type HealthSvcMockCheckParams struct {
+	ctx context.Context
+}
+
+// HealthSvcMockCheckParamPtrs contains pointers to parameters of the HealthSvc.Check
+type HealthSvcMockCheckParamPtrs struct {
+	ctx *context.Context
+}
+
+// HealthSvcMockCheckResults contains results of the HealthSvc.Check
+type HealthSvcMockCheckResults struct {
+	r1 health.Report
+}
+
+// HealthSvcMockCheckOrigins contains origins of expectations of the HealthSvc.Check
+type HealthSvcMockCheckExpectationOrigins struct {
+	origin    string
+	originCtx string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheck *mHealthSvcMockCheck) Optional() *mHealthSvcMockCheck {
+	mmCheck.optional = true
+	return mmCheck
+}
+
+// Expect sets up expected params for HealthSvc.Check
+func (mmCheck *mHealthSvcMockCheck) Expect(ctx context.Context) *mHealthSvcMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("HealthSvcMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &HealthSvcMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs != nil {
+		mmCheck.mock.t.Fatalf("HealthSvcMock.Check mock is already set by ExpectParams functions")
+	}
+
+	mmCheck.defaultExpectation.params = &HealthSvcMockCheckParams{ctx}
+	mmCheck.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCheck.expectations {
+		if minimock.Equal(e.params, mmCheck.defaultExpectation.params) {
+			mmCheck.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheck.defaultExpectation.params)
+		}
+	}
+
+	return mmCheck
+}
+
+// ExpectCtxParam1 sets up expected param ctx for HealthSvc.Check
+func (mmCheck *mHealthSvcMockCheck) ExpectCtxParam1(ctx context.Context) *mHealthSvcMockCheck {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("HealthSvcMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &HealthSvcMockCheckExpectation{}
+	}
+
+	if mmCheck.defaultExpectation.params != nil {
+		mmCheck.mock.t.Fatalf("HealthSvcMock.Check mock is already set by Expect")
+	}
+
+	if mmCheck.defaultExpectation.paramPtrs == nil {
+		mmCheck.defaultExpectation.paramPtrs = &HealthSvcMockCheckParamPtrs{}
+	}
+	mmCheck.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCheck.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCheck
+}
+
+// Inspect accepts an inspector function that has same arguments as the HealthSvc.Check
+func (mmCheck *mHealthSvcMockCheck) Inspect(f func(ctx context.Context)) *mHealthSvcMockCheck {
+	if mmCheck.mock.inspectFuncCheck != nil {
+		mmCheck.mock.t.Fatalf("Inspect function is already set for HealthSvcMock.Check")
+	}
+
+	mmCheck.mock.inspectFuncCheck = f
+
+	return mmCheck
+}
+
+// Return sets up results that will be returned by HealthSvc.Check
+func (mmCheck *mHealthSvcMockCheck) Return(r1 health.Report) *HealthSvcMock {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("HealthSvcMock.Check mock is already set by Set")
+	}
+
+	if mmCheck.defaultExpectation == nil {
+		mmCheck.defaultExpectation = &HealthSvcMockCheckExpectation{mock: mmCheck.mock}
+	}
+	mmCheck.defaultExpectation.results = &HealthSvcMockCheckResults{r1}
+	mmCheck.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCheck.mock
+}
+
+// Set uses given function f to mock the HealthSvc.Check method
+func (mmCheck *mHealthSvcMockCheck) Set(f func(ctx context.Context) (r1 health.Report)) *HealthSvcMock {
+	if mmCheck.defaultExpectation != nil {
+		mmCheck.mock.t.Fatalf("Default expectation is already set for the HealthSvc.Check method")
+	}
+
+	if len(mmCheck.expectations) > 0 {
+		mmCheck.mock.t.Fatalf("Some expectations are already set for the HealthSvc.Check method")
+	}
+
+	mmCheck.mock.funcCheck = f
+	mmCheck.mock.funcCheckOrigin = minimock.CallerInfo(1)
+	return mmCheck.mock
+}
+
+// When sets expectation for the HealthSvc.Check which will trigger the result defined by the following
+// Then helper
+func (mmCheck *mHealthSvcMockCheck) When(ctx context.Context) *HealthSvcMockCheckExpectation {
+	if mmCheck.mock.funcCheck != nil {
+		mmCheck.mock.t.Fatalf("HealthSvcMock.Check mock is already set by Set")
+	}
+
+	expectation := &HealthSvcMockCheckExpectation{
+		mock:               mmCheck.mock,
+		params:             &HealthSvcMockCheckParams{ctx},
+		expectationOrigins: HealthSvcMockCheckExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCheck.expectations = append(mmCheck.expectations, expectation)
+	return expectation
+}
+
+// Then sets up HealthSvc.Check return parameters for the expectation previously defined by the When method
+func (e *HealthSvcMockCheckExpectation) Then(r1 health.Report) *HealthSvcMock {
+	e.results = &HealthSvcMockCheckResults{r1}
+	return e.mock
+}
+
+// Times sets number of times HealthSvc.Check should be invoked
+func (mmCheck *mHealthSvcMockCheck) Times(n uint64) *mHealthSvcMockCheck {
+	if n == 0 {
+		mmCheck.mock.t.Fatalf("Times of HealthSvcMock.Check mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheck.expectedInvocations, n)
+	mmCheck.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCheck
+}
+
+func (mmCheck *mHealthSvcMockCheck) invocationsDone() bool {
+	if len(mmCheck.expectations) == 0 && mmCheck.defaultExpectation == nil && mmCheck.mock.funcCheck == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheck.mock.afterCheckCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheck.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Check implements mm_handler.HealthSvc
+func (mmCheck *HealthSvcMock) Check(ctx context.Context) (r1 health.Report) {
+	mm_atomic.AddUint64(&mmCheck.beforeCheckCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheck.afterCheckCounter, 1)
+
+	mmCheck.t.Helper()
+
+	if mmCheck.inspectFuncCheck != nil {
+		mmCheck.inspectFuncCheck(ctx)
+	}
+
+	mm_params := HealthSvcMockCheckParams{ctx}
+
+	// Record call args
+	mmCheck.CheckMock.mutex.Lock()
+	mmCheck.CheckMock.callArgs = append(mmCheck.CheckMock.callArgs, &mm_params)
+	mmCheck.CheckMock.mutex.Unlock()
+
+	for _, e := range mmCheck.CheckMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.r1
+		}
+	}
+
+	if mmCheck.CheckMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheck.CheckMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheck.CheckMock.defaultExpectation.params
+		mm_want_ptrs := mmCheck.CheckMock.defaultExpectation.paramPtrs
+
+		mm_got := HealthSvcMockCheckParams{ctx}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheck.t.Errorf("HealthSvcMock.Check got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheck.CheckMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheck.t.Errorf("HealthSvcMock.Check got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCheck.CheckMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheck.CheckMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheck.t.Fatal("No results are set for the HealthSvcMock.Check")
+		}
+		return (*mm_results).r1
+	}
+	if mmCheck.funcCheck != nil {
+		return mmCheck.funcCheck(ctx)
+	}
+	mmCheck.t.Fatalf("Unexpected call to HealthSvcMock.Check. %v", ctx)
+	return
+}
+
+// CheckAfterCounter returns a count of finished HealthSvcMock.Check invocations
+func (mmCheck *HealthSvcMock) CheckAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheck.afterCheckCounter)
+}
+
+// CheckBeforeCounter returns a count of HealthSvcMock.Check invocations
+func (mmCheck *HealthSvcMock) CheckBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheck.beforeCheckCounter)
+}
+
+// Calls returns a list of arguments used in each call to HealthSvcMock.Check.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheck *mHealthSvcMockCheck) Calls() []*HealthSvcMockCheckParams {
+	mmCheck.mutex.RLock()
+
+	argCopy := make([]*HealthSvcMockCheckParams, len(mmCheck.callArgs))
+	copy(argCopy, mmCheck.callArgs)
+
+	mmCheck.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckDone returns true if the count of the Check invocations corresponds
+// the number of defined expectations
+func (m *HealthSvcMock) MinimockCheckDone() bool {
+	if m.CheckMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckMock.invocationsDone()
+}
+
+// MinimockCheckInspect logs each unmet expectation
+func (m *HealthSvcMock) MinimockCheckInspect() {
+	for _, e := range m.CheckMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to HealthSvcMock.Check at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCheckCounter := mm_atomic.LoadUint64(&m.afterCheckCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckMock.defaultExpectation != nil && afterCheckCounter < 1 {
+		if m.CheckMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to HealthSvcMock.Check at\n%s", m.CheckMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to HealthSvcMock.Check at\n%s with params: %#v", m.CheckMock.defaultExpectation.expectationOrigins.origin, *m.CheckMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheck != nil && afterCheckCounter < 1 {
+		m.t.Errorf("Expected call to HealthSvcMock.Check at\n%s", m.funcCheckOrigin)
+	}
+
+	if !m.CheckMock.invocationsDone() && afterCheckCounter > 0 {
+		m.t.Errorf("Expected %d calls to HealthSvcMock.Check at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckMock.expectedInvocations), m.CheckMock.expectedInvocationsOrigin, afterCheckCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *HealthSvcMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCheckInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *HealthSvcMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *HealthSvcMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCheckDone()
+}
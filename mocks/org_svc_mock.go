@@ -0,0 +1,3186 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.3). DO NOT EDIT.
+
+package mock_service
+
+//go:generate minimock -i github.com/gleb-korostelev/GophKeeper/internal/handler.OrgSvc -o org_svc_mock.go -n OrgSvcMock -p mock_service
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gleb-korostelev/GophKeeper/models/org"
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
+	"github.com/gojuno/minimock/v3"
+)
+
+// OrgSvcMock implements mm_handler.OrgSvc
+type OrgSvcMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcAddCollectionCard          func(ctx context.Context, username string, orgName string, collection string, cardNumber string) (err error)
+	funcAddCollectionCardOrigin    string
+	inspectFuncAddCollectionCard   func(ctx context.Context, username string, orgName string, collection string, cardNumber string)
+	afterAddCollectionCardCounter  uint64
+	beforeAddCollectionCardCounter uint64
+	AddCollectionCardMock          mOrgSvcMockAddCollectionCard
+
+	funcCreateOrg          func(ctx context.Context, username string, name string) (err error)
+	funcCreateOrgOrigin    string
+	inspectFuncCreateOrg   func(ctx context.Context, username string, name string)
+	afterCreateOrgCounter  uint64
+	beforeCreateOrgCounter uint64
+	CreateOrgMock          mOrgSvcMockCreateOrg
+
+	funcGetOrgCards          func(ctx context.Context, username string, orgName string, page pagination.Request) (cards []profile.CardInfo, next string, err error)
+	funcGetOrgCardsOrigin    string
+	inspectFuncGetOrgCards   func(ctx context.Context, username string, orgName string, page pagination.Request)
+	afterGetOrgCardsCounter  uint64
+	beforeGetOrgCardsCounter uint64
+	GetOrgCardsMock          mOrgSvcMockGetOrgCards
+
+	funcGetUserOrgs          func(ctx context.Context, username string) (ma1 []org.Member, err error)
+	funcGetUserOrgsOrigin    string
+	inspectFuncGetUserOrgs   func(ctx context.Context, username string)
+	afterGetUserOrgsCounter  uint64
+	beforeGetUserOrgsCounter uint64
+	GetUserOrgsMock          mOrgSvcMockGetUserOrgs
+
+	funcRemoveMember          func(ctx context.Context, actor string, orgName string, username string) (err error)
+	funcRemoveMemberOrigin    string
+	inspectFuncRemoveMember   func(ctx context.Context, actor string, orgName string, username string)
+	afterRemoveMemberCounter  uint64
+	beforeRemoveMemberCounter uint64
+	RemoveMemberMock          mOrgSvcMockRemoveMember
+
+	funcSetCollection          func(ctx context.Context, collection org.Collection) (err error)
+	funcSetCollectionOrigin    string
+	inspectFuncSetCollection   func(ctx context.Context, collection org.Collection)
+	afterSetCollectionCounter  uint64
+	beforeSetCollectionCounter uint64
+	SetCollectionMock          mOrgSvcMockSetCollection
+
+	funcSetGroup          func(ctx context.Context, group org.Group) (err error)
+	funcSetGroupOrigin    string
+	inspectFuncSetGroup   func(ctx context.Context, group org.Group)
+	afterSetGroupCounter  uint64
+	beforeSetGroupCounter uint64
+	SetGroupMock          mOrgSvcMockSetGroup
+
+	funcSetMember          func(ctx context.Context, actor string, member org.Member) (err error)
+	funcSetMemberOrigin    string
+	inspectFuncSetMember   func(ctx context.Context, actor string, member org.Member)
+	afterSetMemberCounter  uint64
+	beforeSetMemberCounter uint64
+	SetMemberMock          mOrgSvcMockSetMember
+}
+
+// NewOrgSvcMock returns a mock for mm_handler.OrgSvc
+func NewOrgSvcMock(t minimock.Tester) *OrgSvcMock {
+	m := &OrgSvcMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.AddCollectionCardMock = mOrgSvcMockAddCollectionCard{mock: m}
+	m.AddCollectionCardMock.callArgs = []*OrgSvcMockAddCollectionCardParams{}
+
+	m.CreateOrgMock = mOrgSvcMockCreateOrg{mock: m}
+	m.CreateOrgMock.callArgs = []*OrgSvcMockCreateOrgParams{}
+
+	m.GetOrgCardsMock = mOrgSvcMockGetOrgCards{mock: m}
+	m.GetOrgCardsMock.callArgs = []*OrgSvcMockGetOrgCardsParams{}
+
+	m.GetUserOrgsMock = mOrgSvcMockGetUserOrgs{mock: m}
+	m.GetUserOrgsMock.callArgs = []*OrgSvcMockGetUserOrgsParams{}
+
+	m.RemoveMemberMock = mOrgSvcMockRemoveMember{mock: m}
+	m.RemoveMemberMock.callArgs = []*OrgSvcMockRemoveMemberParams{}
+
+	m.SetCollectionMock = mOrgSvcMockSetCollection{mock: m}
+	m.SetCollectionMock.callArgs = []*OrgSvcMockSetCollectionParams{}
+
+	m.SetGroupMock = mOrgSvcMockSetGroup{mock: m}
+	m.SetGroupMock.callArgs = []*OrgSvcMockSetGroupParams{}
+
+	m.SetMemberMock = mOrgSvcMockSetMember{mock: m}
+	m.SetMemberMock.callArgs = []*OrgSvcMockSetMemberParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mOrgSvcMockAddCollectionCard struct {
+	optional           bool
+	mock               *OrgSvcMock
+	defaultExpectation *OrgSvcMockAddCollectionCardExpectation
+	expectations       []*OrgSvcMockAddCollectionCardExpectation
+
+	callArgs []*OrgSvcMockAddCollectionCardParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrgSvcMockAddCollectionCardExpectation specifies expectation struct of the OrgSvc.AddCollectionCard
+type OrgSvcMockAddCollectionCardExpectation struct {
+	mock               *OrgSvcMock
+	params             *OrgSvcMockAddCollectionCardParams
+	paramPtrs          *OrgSvcMockAddCollectionCardParamPtrs
+	expectationOrigins OrgSvcMockAddCollectionCardExpectationOrigins
+	results            *OrgSvcMockAddCollectionCardResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrgSvcMockAddCollectionCardParams contains parameters of the OrgSvc.AddCollectionCard
+type OrgSvcMockAddCollectionCardParams struct {
+	ctx        context.Context
+	username   string
+	orgName    string
+	collection string
+	cardNumber string
+}
+
+// OrgSvcMockAddCollectionCardParamPtrs contains pointers to parameters of the OrgSvc.AddCollectionCard
+type OrgSvcMockAddCollectionCardParamPtrs struct {
+	ctx        *context.Context
+	username   *string
+	orgName    *string
+	collection *string
+	cardNumber *string
+}
+
+// OrgSvcMockAddCollectionCardResults contains results of the OrgSvc.AddCollectionCard
+type OrgSvcMockAddCollectionCardResults struct {
+	err error
+}
+
+// OrgSvcMockAddCollectionCardOrigins contains origins of expectations of the OrgSvc.AddCollectionCard
+type OrgSvcMockAddCollectionCardExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originUsername   string
+	originOrgName    string
+	originCollection string
+	originCardNumber string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmAddCollectionCard *mOrgSvcMockAddCollectionCard) Optional() *mOrgSvcMockAddCollectionCard {
+	mmAddCollectionCard.optional = true
+	return mmAddCollectionCard
+}
+
+// Expect sets up expected params for OrgSvc.AddCollectionCard
+func (mmAddCollectionCard *mOrgSvcMockAddCollectionCard) Expect(ctx context.Context, username string, orgName string, collection string, cardNumber string) *mOrgSvcMockAddCollectionCard {
+	if mmAddCollectionCard.mock.funcAddCollectionCard != nil {
+		mmAddCollectionCard.mock.t.Fatalf("OrgSvcMock.AddCollectionCard mock is already set by Set")
+	}
+
+	if mmAddCollectionCard.defaultExpectation == nil {
+		mmAddCollectionCard.defaultExpectation = &OrgSvcMockAddCollectionCardExpectation{}
+	}
+
+	if mmAddCollectionCard.defaultExpectation.paramPtrs != nil {
+		mmAddCollectionCard.mock.t.Fatalf("OrgSvcMock.AddCollectionCard mock is already set by ExpectParams functions")
+	}
+
+	mmAddCollectionCard.defaultExpectation.params = &OrgSvcMockAddCollectionCardParams{ctx, username, orgName, collection, cardNumber}
+	mmAddCollectionCard.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddCollectionCard.expectations {
+		if minimock.Equal(e.params, mmAddCollectionCard.defaultExpectation.params) {
+			mmAddCollectionCard.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddCollectionCard.defaultExpectation.params)
+		}
+	}
+
+	return mmAddCollectionCard
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrgSvc.AddCollectionCard
+func (mmAddCollectionCard *mOrgSvcMockAddCollectionCard) ExpectCtxParam1(ctx context.Context) *mOrgSvcMockAddCollectionCard {
+	if mmAddCollectionCard.mock.funcAddCollectionCard != nil {
+		mmAddCollectionCard.mock.t.Fatalf("OrgSvcMock.AddCollectionCard mock is already set by Set")
+	}
+
+	if mmAddCollectionCard.defaultExpectation == nil {
+		mmAddCollectionCard.defaultExpectation = &OrgSvcMockAddCollectionCardExpectation{}
+	}
+
+	if mmAddCollectionCard.defaultExpectation.params != nil {
+		mmAddCollectionCard.mock.t.Fatalf("OrgSvcMock.AddCollectionCard mock is already set by Expect")
+	}
+
+	if mmAddCollectionCard.defaultExpectation.paramPtrs == nil {
+		mmAddCollectionCard.defaultExpectation.paramPtrs = &OrgSvcMockAddCollectionCardParamPtrs{}
+	}
+	mmAddCollectionCard.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddCollectionCard.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddCollectionCard
+}
+
+// ExpectUsernameParam2 sets up expected param username for OrgSvc.AddCollectionCard
+func (mmAddCollectionCard *mOrgSvcMockAddCollectionCard) ExpectUsernameParam2(username string) *mOrgSvcMockAddCollectionCard {
+	if mmAddCollectionCard.mock.funcAddCollectionCard != nil {
+		mmAddCollectionCard.mock.t.Fatalf("OrgSvcMock.AddCollectionCard mock is already set by Set")
+	}
+
+	if mmAddCollectionCard.defaultExpectation == nil {
+		mmAddCollectionCard.defaultExpectation = &OrgSvcMockAddCollectionCardExpectation{}
+	}
+
+	if mmAddCollectionCard.defaultExpectation.params != nil {
+		mmAddCollectionCard.mock.t.Fatalf("OrgSvcMock.AddCollectionCard mock is already set by Expect")
+	}
+
+	if mmAddCollectionCard.defaultExpectation.paramPtrs == nil {
+		mmAddCollectionCard.defaultExpectation.paramPtrs = &OrgSvcMockAddCollectionCardParamPtrs{}
+	}
+	mmAddCollectionCard.defaultExpectation.paramPtrs.username = &username
+	mmAddCollectionCard.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmAddCollectionCard
+}
+
+// ExpectOrgNameParam3 sets up expected param orgName for OrgSvc.AddCollectionCard
+func (mmAddCollectionCard *mOrgSvcMockAddCollectionCard) ExpectOrgNameParam3(orgName string) *mOrgSvcMockAddCollectionCard {
+	if mmAddCollectionCard.mock.funcAddCollectionCard != nil {
+		mmAddCollectionCard.mock.t.Fatalf("OrgSvcMock.AddCollectionCard mock is already set by Set")
+	}
+
+	if mmAddCollectionCard.defaultExpectation == nil {
+		mmAddCollectionCard.defaultExpectation = &OrgSvcMockAddCollectionCardExpectation{}
+	}
+
+	if mmAddCollectionCard.defaultExpectation.params != nil {
+		mmAddCollectionCard.mock.t.Fatalf("OrgSvcMock.AddCollectionCard mock is already set by Expect")
+	}
+
+	if mmAddCollectionCard.defaultExpectation.paramPtrs == nil {
+		mmAddCollectionCard.defaultExpectation.paramPtrs = &OrgSvcMockAddCollectionCardParamPtrs{}
+	}
+	mmAddCollectionCard.defaultExpectation.paramPtrs.orgName = &orgName
+	mmAddCollectionCard.defaultExpectation.expectationOrigins.originOrgName = minimock.CallerInfo(1)
+
+	return mmAddCollectionCard
+}
+
+// ExpectCollectionParam4 sets up expected param collection for OrgSvc.AddCollectionCard
+func (mmAddCollectionCard *mOrgSvcMockAddCollectionCard) ExpectCollectionParam4(collection string) *mOrgSvcMockAddCollectionCard {
+	if mmAddCollectionCard.mock.funcAddCollectionCard != nil {
+		mmAddCollectionCard.mock.t.Fatalf("OrgSvcMock.AddCollectionCard mock is already set by Set")
+	}
+
+	if mmAddCollectionCard.defaultExpectation == nil {
+		mmAddCollectionCard.defaultExpectation = &OrgSvcMockAddCollectionCardExpectation{}
+	}
+
+	if mmAddCollectionCard.defaultExpectation.params != nil {
+		mmAddCollectionCard.mock.t.Fatalf("OrgSvcMock.AddCollectionCard mock is already set by Expect")
+	}
+
+	if mmAddCollectionCard.defaultExpectation.paramPtrs == nil {
+		mmAddCollectionCard.defaultExpectation.paramPtrs = &OrgSvcMockAddCollectionCardParamPtrs{}
+	}
+	mmAddCollectionCard.defaultExpectation.paramPtrs.collection = &collection
+	mmAddCollectionCard.defaultExpectation.expectationOrigins.originCollection = minimock.CallerInfo(1)
+
+	return mmAddCollectionCard
+}
+
+// ExpectCardNumberParam5 sets up expected param cardNumber for OrgSvc.AddCollectionCard
+func (mmAddCollectionCard *mOrgSvcMockAddCollectionCard) ExpectCardNumberParam5(cardNumber string) *mOrgSvcMockAddCollectionCard {
+	if mmAddCollectionCard.mock.funcAddCollectionCard != nil {
+		mmAddCollectionCard.mock.t.Fatalf("OrgSvcMock.AddCollectionCard mock is already set by Set")
+	}
+
+	if mmAddCollectionCard.defaultExpectation == nil {
+		mmAddCollectionCard.defaultExpectation = &OrgSvcMockAddCollectionCardExpectation{}
+	}
+
+	if mmAddCollectionCard.defaultExpectation.params != nil {
+		mmAddCollectionCard.mock.t.Fatalf("OrgSvcMock.AddCollectionCard mock is already set by Expect")
+	}
+
+	if mmAddCollectionCard.defaultExpectation.paramPtrs == nil {
+		mmAddCollectionCard.defaultExpectation.paramPtrs = &OrgSvcMockAddCollectionCardParamPtrs{}
+	}
+	mmAddCollectionCard.defaultExpectation.paramPtrs.cardNumber = &cardNumber
+	mmAddCollectionCard.defaultExpectation.expectationOrigins.originCardNumber = minimock.CallerInfo(1)
+
+	return mmAddCollectionCard
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrgSvc.AddCollectionCard
+func (mmAddCollectionCard *mOrgSvcMockAddCollectionCard) Inspect(f func(ctx context.Context, username string, orgName string, collection string, cardNumber string)) *mOrgSvcMockAddCollectionCard {
+	if mmAddCollectionCard.mock.inspectFuncAddCollectionCard != nil {
+		mmAddCollectionCard.mock.t.Fatalf("Inspect function is already set for OrgSvcMock.AddCollectionCard")
+	}
+
+	mmAddCollectionCard.mock.inspectFuncAddCollectionCard = f
+
+	return mmAddCollectionCard
+}
+
+// Return sets up results that will be returned by OrgSvc.AddCollectionCard
+func (mmAddCollectionCard *mOrgSvcMockAddCollectionCard) Return(err error) *OrgSvcMock {
+	if mmAddCollectionCard.mock.funcAddCollectionCard != nil {
+		mmAddCollectionCard.mock.t.Fatalf("OrgSvcMock.AddCollectionCard mock is already set by Set")
+	}
+
+	if mmAddCollectionCard.defaultExpectation == nil {
+		mmAddCollectionCard.defaultExpectation = &OrgSvcMockAddCollectionCardExpectation{mock: mmAddCollectionCard.mock}
+	}
+	mmAddCollectionCard.defaultExpectation.results = &OrgSvcMockAddCollectionCardResults{err}
+	mmAddCollectionCard.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddCollectionCard.mock
+}
+
+// Set uses given function f to mock the OrgSvc.AddCollectionCard method
+func (mmAddCollectionCard *mOrgSvcMockAddCollectionCard) Set(f func(ctx context.Context, username string, orgName string, collection string, cardNumber string) (err error)) *OrgSvcMock {
+	if mmAddCollectionCard.defaultExpectation != nil {
+		mmAddCollectionCard.mock.t.Fatalf("Default expectation is already set for the OrgSvc.AddCollectionCard method")
+	}
+
+	if len(mmAddCollectionCard.expectations) > 0 {
+		mmAddCollectionCard.mock.t.Fatalf("Some expectations are already set for the OrgSvc.AddCollectionCard method")
+	}
+
+	mmAddCollectionCard.mock.funcAddCollectionCard = f
+	mmAddCollectionCard.mock.funcAddCollectionCardOrigin = minimock.CallerInfo(1)
+	return mmAddCollectionCard.mock
+}
+
+// When sets expectation for the OrgSvc.AddCollectionCard which will trigger the result defined by the following
+// Then helper
+func (mmAddCollectionCard *mOrgSvcMockAddCollectionCard) When(ctx context.Context, username string, orgName string, collection string, cardNumber string) *OrgSvcMockAddCollectionCardExpectation {
+	if mmAddCollectionCard.mock.funcAddCollectionCard != nil {
+		mmAddCollectionCard.mock.t.Fatalf("OrgSvcMock.AddCollectionCard mock is already set by Set")
+	}
+
+	expectation := &OrgSvcMockAddCollectionCardExpectation{
+		mock:               mmAddCollectionCard.mock,
+		params:             &OrgSvcMockAddCollectionCardParams{ctx, username, orgName, collection, cardNumber},
+		expectationOrigins: OrgSvcMockAddCollectionCardExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmAddCollectionCard.expectations = append(mmAddCollectionCard.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrgSvc.AddCollectionCard return parameters for the expectation previously defined by the When method
+func (e *OrgSvcMockAddCollectionCardExpectation) Then(err error) *OrgSvcMock {
+	e.results = &OrgSvcMockAddCollectionCardResults{err}
+	return e.mock
+}
+
+// Times sets number of times OrgSvc.AddCollectionCard should be invoked
+func (mmAddCollectionCard *mOrgSvcMockAddCollectionCard) Times(n uint64) *mOrgSvcMockAddCollectionCard {
+	if n == 0 {
+		mmAddCollectionCard.mock.t.Fatalf("Times of OrgSvcMock.AddCollectionCard mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmAddCollectionCard.expectedInvocations, n)
+	mmAddCollectionCard.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddCollectionCard
+}
+
+func (mmAddCollectionCard *mOrgSvcMockAddCollectionCard) invocationsDone() bool {
+	if len(mmAddCollectionCard.expectations) == 0 && mmAddCollectionCard.defaultExpectation == nil && mmAddCollectionCard.mock.funcAddCollectionCard == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmAddCollectionCard.mock.afterAddCollectionCardCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddCollectionCard.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// AddCollectionCard implements mm_handler.OrgSvc
+func (mmAddCollectionCard *OrgSvcMock) AddCollectionCard(ctx context.Context, username string, orgName string, collection string, cardNumber string) (err error) {
+	mm_atomic.AddUint64(&mmAddCollectionCard.beforeAddCollectionCardCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddCollectionCard.afterAddCollectionCardCounter, 1)
+
+	mmAddCollectionCard.t.Helper()
+
+	if mmAddCollectionCard.inspectFuncAddCollectionCard != nil {
+		mmAddCollectionCard.inspectFuncAddCollectionCard(ctx, username, orgName, collection, cardNumber)
+	}
+
+	mm_params := OrgSvcMockAddCollectionCardParams{ctx, username, orgName, collection, cardNumber}
+
+	// Record call args
+	mmAddCollectionCard.AddCollectionCardMock.mutex.Lock()
+	mmAddCollectionCard.AddCollectionCardMock.callArgs = append(mmAddCollectionCard.AddCollectionCardMock.callArgs, &mm_params)
+	mmAddCollectionCard.AddCollectionCardMock.mutex.Unlock()
+
+	for _, e := range mmAddCollectionCard.AddCollectionCardMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmAddCollectionCard.AddCollectionCardMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddCollectionCard.AddCollectionCardMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddCollectionCard.AddCollectionCardMock.defaultExpectation.params
+		mm_want_ptrs := mmAddCollectionCard.AddCollectionCardMock.defaultExpectation.paramPtrs
+
+		mm_got := OrgSvcMockAddCollectionCardParams{ctx, username, orgName, collection, cardNumber}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddCollectionCard.t.Errorf("OrgSvcMock.AddCollectionCard got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddCollectionCard.AddCollectionCardMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmAddCollectionCard.t.Errorf("OrgSvcMock.AddCollectionCard got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddCollectionCard.AddCollectionCardMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.orgName != nil && !minimock.Equal(*mm_want_ptrs.orgName, mm_got.orgName) {
+				mmAddCollectionCard.t.Errorf("OrgSvcMock.AddCollectionCard got unexpected parameter orgName, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddCollectionCard.AddCollectionCardMock.defaultExpectation.expectationOrigins.originOrgName, *mm_want_ptrs.orgName, mm_got.orgName, minimock.Diff(*mm_want_ptrs.orgName, mm_got.orgName))
+			}
+
+			if mm_want_ptrs.collection != nil && !minimock.Equal(*mm_want_ptrs.collection, mm_got.collection) {
+				mmAddCollectionCard.t.Errorf("OrgSvcMock.AddCollectionCard got unexpected parameter collection, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddCollectionCard.AddCollectionCardMock.defaultExpectation.expectationOrigins.originCollection, *mm_want_ptrs.collection, mm_got.collection, minimock.Diff(*mm_want_ptrs.collection, mm_got.collection))
+			}
+
+			if mm_want_ptrs.cardNumber != nil && !minimock.Equal(*mm_want_ptrs.cardNumber, mm_got.cardNumber) {
+				mmAddCollectionCard.t.Errorf("OrgSvcMock.AddCollectionCard got unexpected parameter cardNumber, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddCollectionCard.AddCollectionCardMock.defaultExpectation.expectationOrigins.originCardNumber, *mm_want_ptrs.cardNumber, mm_got.cardNumber, minimock.Diff(*mm_want_ptrs.cardNumber, mm_got.cardNumber))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmAddCollectionCard.t.Errorf("OrgSvcMock.AddCollectionCard got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddCollectionCard.AddCollectionCardMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmAddCollectionCard.AddCollectionCardMock.defaultExpectation.results
+		if mm_results == nil {
+			mmAddCollectionCard.t.Fatal("No results are set for the OrgSvcMock.AddCollectionCard")
+		}
+		return (*mm_results).err
+	}
+	if mmAddCollectionCard.funcAddCollectionCard != nil {
+		return mmAddCollectionCard.funcAddCollectionCard(ctx, username, orgName, collection, cardNumber)
+	}
+	mmAddCollectionCard.t.Fatalf("Unexpected call to OrgSvcMock.AddCollectionCard. %v %v %v %v %v", ctx, username, orgName, collection, cardNumber)
+	return
+}
+
+// AddCollectionCardAfterCounter returns a count of finished OrgSvcMock.AddCollectionCard invocations
+func (mmAddCollectionCard *OrgSvcMock) AddCollectionCardAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddCollectionCard.afterAddCollectionCardCounter)
+}
+
+// AddCollectionCardBeforeCounter returns a count of OrgSvcMock.AddCollectionCard invocations
+func (mmAddCollectionCard *OrgSvcMock) AddCollectionCardBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddCollectionCard.beforeAddCollectionCardCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrgSvcMock.AddCollectionCard.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmAddCollectionCard *mOrgSvcMockAddCollectionCard) Calls() []*OrgSvcMockAddCollectionCardParams {
+	mmAddCollectionCard.mutex.RLock()
+
+	argCopy := make([]*OrgSvcMockAddCollectionCardParams, len(mmAddCollectionCard.callArgs))
+	copy(argCopy, mmAddCollectionCard.callArgs)
+
+	mmAddCollectionCard.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockAddCollectionCardDone returns true if the count of the AddCollectionCard invocations corresponds
+// the number of defined expectations
+func (m *OrgSvcMock) MinimockAddCollectionCardDone() bool {
+	if m.AddCollectionCardMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.AddCollectionCardMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.AddCollectionCardMock.invocationsDone()
+}
+
+// MinimockAddCollectionCardInspect logs each unmet expectation
+func (m *OrgSvcMock) MinimockAddCollectionCardInspect() {
+	for _, e := range m.AddCollectionCardMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrgSvcMock.AddCollectionCard at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterAddCollectionCardCounter := mm_atomic.LoadUint64(&m.afterAddCollectionCardCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.AddCollectionCardMock.defaultExpectation != nil && afterAddCollectionCardCounter < 1 {
+		if m.AddCollectionCardMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrgSvcMock.AddCollectionCard at\n%s", m.AddCollectionCardMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrgSvcMock.AddCollectionCard at\n%s with params: %#v", m.AddCollectionCardMock.defaultExpectation.expectationOrigins.origin, *m.AddCollectionCardMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcAddCollectionCard != nil && afterAddCollectionCardCounter < 1 {
+		m.t.Errorf("Expected call to OrgSvcMock.AddCollectionCard at\n%s", m.funcAddCollectionCardOrigin)
+	}
+
+	if !m.AddCollectionCardMock.invocationsDone() && afterAddCollectionCardCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrgSvcMock.AddCollectionCard at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddCollectionCardMock.expectedInvocations), m.AddCollectionCardMock.expectedInvocationsOrigin, afterAddCollectionCardCounter)
+	}
+}
+
+type mOrgSvcMockCreateOrg struct {
+	optional           bool
+	mock               *OrgSvcMock
+	defaultExpectation *OrgSvcMockCreateOrgExpectation
+	expectations       []*OrgSvcMockCreateOrgExpectation
+
+	callArgs []*OrgSvcMockCreateOrgParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrgSvcMockCreateOrgExpectation specifies expectation struct of the OrgSvc.CreateOrg
+type OrgSvcMockCreateOrgExpectation struct {
+	mock               *OrgSvcMock
+	params             *OrgSvcMockCreateOrgParams
+	paramPtrs          *OrgSvcMockCreateOrgParamPtrs
+	expectationOrigins OrgSvcMockCreateOrgExpectationOrigins
+	results            *OrgSvcMockCreateOrgResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrgSvcMockCreateOrgParams contains parameters of the OrgSvc.CreateOrg
+type OrgSvcMockCreateOrgParams struct {
+	ctx      context.Context
+	username string
+	name     string
+}
+
+// OrgSvcMockCreateOrgParamPtrs contains pointers to parameters of the OrgSvc.CreateOrg
+type OrgSvcMockCreateOrgParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	name     *string
+}
+
+// OrgSvcMockCreateOrgResults contains results of the OrgSvc.CreateOrg
+type OrgSvcMockCreateOrgResults struct {
+	err error
+}
+
+// OrgSvcMockCreateOrgOrigins contains origins of expectations of the OrgSvc.CreateOrg
+type OrgSvcMockCreateOrgExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originName     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateOrg *mOrgSvcMockCreateOrg) Optional() *mOrgSvcMockCreateOrg {
+	mmCreateOrg.optional = true
+	return mmCreateOrg
+}
+
+// Expect sets up expected params for OrgSvc.CreateOrg
+func (mmCreateOrg *mOrgSvcMockCreateOrg) Expect(ctx context.Context, username string, name string) *mOrgSvcMockCreateOrg {
+	if mmCreateOrg.mock.funcCreateOrg != nil {
+		mmCreateOrg.mock.t.Fatalf("OrgSvcMock.CreateOrg mock is already set by Set")
+	}
+
+	if mmCreateOrg.defaultExpectation == nil {
+		mmCreateOrg.defaultExpectation = &OrgSvcMockCreateOrgExpectation{}
+	}
+
+	if mmCreateOrg.defaultExpectation.paramPtrs != nil {
+		mmCreateOrg.mock.t.Fatalf("OrgSvcMock.CreateOrg mock is already set by ExpectParams functions")
+	}
+
+	mmCreateOrg.defaultExpectation.params = &OrgSvcMockCreateOrgParams{ctx, username, name}
+	mmCreateOrg.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateOrg.expectations {
+		if minimock.Equal(e.params, mmCreateOrg.defaultExpectation.params) {
+			mmCreateOrg.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateOrg.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateOrg
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrgSvc.CreateOrg
+func (mmCreateOrg *mOrgSvcMockCreateOrg) ExpectCtxParam1(ctx context.Context) *mOrgSvcMockCreateOrg {
+	if mmCreateOrg.mock.funcCreateOrg != nil {
+		mmCreateOrg.mock.t.Fatalf("OrgSvcMock.CreateOrg mock is already set by Set")
+	}
+
+	if mmCreateOrg.defaultExpectation == nil {
+		mmCreateOrg.defaultExpectation = &OrgSvcMockCreateOrgExpectation{}
+	}
+
+	if mmCreateOrg.defaultExpectation.params != nil {
+		mmCreateOrg.mock.t.Fatalf("OrgSvcMock.CreateOrg mock is already set by Expect")
+	}
+
+	if mmCreateOrg.defaultExpectation.paramPtrs == nil {
+		mmCreateOrg.defaultExpectation.paramPtrs = &OrgSvcMockCreateOrgParamPtrs{}
+	}
+	mmCreateOrg.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateOrg.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateOrg
+}
+
+// ExpectUsernameParam2 sets up expected param username for OrgSvc.CreateOrg
+func (mmCreateOrg *mOrgSvcMockCreateOrg) ExpectUsernameParam2(username string) *mOrgSvcMockCreateOrg {
+	if mmCreateOrg.mock.funcCreateOrg != nil {
+		mmCreateOrg.mock.t.Fatalf("OrgSvcMock.CreateOrg mock is already set by Set")
+	}
+
+	if mmCreateOrg.defaultExpectation == nil {
+		mmCreateOrg.defaultExpectation = &OrgSvcMockCreateOrgExpectation{}
+	}
+
+	if mmCreateOrg.defaultExpectation.params != nil {
+		mmCreateOrg.mock.t.Fatalf("OrgSvcMock.CreateOrg mock is already set by Expect")
+	}
+
+	if mmCreateOrg.defaultExpectation.paramPtrs == nil {
+		mmCreateOrg.defaultExpectation.paramPtrs = &OrgSvcMockCreateOrgParamPtrs{}
+	}
+	mmCreateOrg.defaultExpectation.paramPtrs.username = &username
+	mmCreateOrg.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmCreateOrg
+}
+
+// ExpectNameParam3 sets up expected param name for OrgSvc.CreateOrg
+func (mmCreateOrg *mOrgSvcMockCreateOrg) ExpectNameParam3(name string) *mOrgSvcMockCreateOrg {
+	if mmCreateOrg.mock.funcCreateOrg != nil {
+		mmCreateOrg.mock.t.Fatalf("OrgSvcMock.CreateOrg mock is already set by Set")
+	}
+
+	if mmCreateOrg.defaultExpectation == nil {
+		mmCreateOrg.defaultExpectation = &OrgSvcMockCreateOrgExpectation{}
+	}
+
+	if mmCreateOrg.defaultExpectation.params != nil {
+		mmCreateOrg.mock.t.Fatalf("OrgSvcMock.CreateOrg mock is already set by Expect")
+	}
+
+	if mmCreateOrg.defaultExpectation.paramPtrs == nil {
+		mmCreateOrg.defaultExpectation.paramPtrs = &OrgSvcMockCreateOrgParamPtrs{}
+	}
+	mmCreateOrg.defaultExpectation.paramPtrs.name = &name
+	mmCreateOrg.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmCreateOrg
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrgSvc.CreateOrg
+func (mmCreateOrg *mOrgSvcMockCreateOrg) Inspect(f func(ctx context.Context, username string, name string)) *mOrgSvcMockCreateOrg {
+	if mmCreateOrg.mock.inspectFuncCreateOrg != nil {
+		mmCreateOrg.mock.t.Fatalf("Inspect function is already set for OrgSvcMock.CreateOrg")
+	}
+
+	mmCreateOrg.mock.inspectFuncCreateOrg = f
+
+	return mmCreateOrg
+}
+
+// Return sets up results that will be returned by OrgSvc.CreateOrg
+func (mmCreateOrg *mOrgSvcMockCreateOrg) Return(err error) *OrgSvcMock {
+	if mmCreateOrg.mock.funcCreateOrg != nil {
+		mmCreateOrg.mock.t.Fatalf("OrgSvcMock.CreateOrg mock is already set by Set")
+	}
+
+	if mmCreateOrg.defaultExpectation == nil {
+		mmCreateOrg.defaultExpectation = &OrgSvcMockCreateOrgExpectation{mock: mmCreateOrg.mock}
+	}
+	mmCreateOrg.defaultExpectation.results = &OrgSvcMockCreateOrgResults{err}
+	mmCreateOrg.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateOrg.mock
+}
+
+// Set uses given function f to mock the OrgSvc.CreateOrg method
+func (mmCreateOrg *mOrgSvcMockCreateOrg) Set(f func(ctx context.Context, username string, name string) (err error)) *OrgSvcMock {
+	if mmCreateOrg.defaultExpectation != nil {
+		mmCreateOrg.mock.t.Fatalf("Default expectation is already set for the OrgSvc.CreateOrg method")
+	}
+
+	if len(mmCreateOrg.expectations) > 0 {
+		mmCreateOrg.mock.t.Fatalf("Some expectations are already set for the OrgSvc.CreateOrg method")
+	}
+
+	mmCreateOrg.mock.funcCreateOrg = f
+	mmCreateOrg.mock.funcCreateOrgOrigin = minimock.CallerInfo(1)
+	return mmCreateOrg.mock
+}
+
+// When sets expectation for the OrgSvc.CreateOrg which will trigger the result defined by the following
+// Then helper
+func (mmCreateOrg *mOrgSvcMockCreateOrg) When(ctx context.Context, username string, name string) *OrgSvcMockCreateOrgExpectation {
+	if mmCreateOrg.mock.funcCreateOrg != nil {
+		mmCreateOrg.mock.t.Fatalf("OrgSvcMock.CreateOrg mock is already set by Set")
+	}
+
+	expectation := &OrgSvcMockCreateOrgExpectation{
+		mock:               mmCreateOrg.mock,
+		params:             &OrgSvcMockCreateOrgParams{ctx, username, name},
+		expectationOrigins: OrgSvcMockCreateOrgExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateOrg.expectations = append(mmCreateOrg.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrgSvc.CreateOrg return parameters for the expectation previously defined by the When method
+func (e *OrgSvcMockCreateOrgExpectation) Then(err error) *OrgSvcMock {
+	e.results = &OrgSvcMockCreateOrgResults{err}
+	return e.mock
+}
+
+// Times sets number of times OrgSvc.CreateOrg should be invoked
+func (mmCreateOrg *mOrgSvcMockCreateOrg) Times(n uint64) *mOrgSvcMockCreateOrg {
+	if n == 0 {
+		mmCreateOrg.mock.t.Fatalf("Times of OrgSvcMock.CreateOrg mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateOrg.expectedInvocations, n)
+	mmCreateOrg.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateOrg
+}
+
+func (mmCreateOrg *mOrgSvcMockCreateOrg) invocationsDone() bool {
+	if len(mmCreateOrg.expectations) == 0 && mmCreateOrg.defaultExpectation == nil && mmCreateOrg.mock.funcCreateOrg == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateOrg.mock.afterCreateOrgCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateOrg.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateOrg implements mm_handler.OrgSvc
+func (mmCreateOrg *OrgSvcMock) CreateOrg(ctx context.Context, username string, name string) (err error) {
+	mm_atomic.AddUint64(&mmCreateOrg.beforeCreateOrgCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateOrg.afterCreateOrgCounter, 1)
+
+	mmCreateOrg.t.Helper()
+
+	if mmCreateOrg.inspectFuncCreateOrg != nil {
+		mmCreateOrg.inspectFuncCreateOrg(ctx, username, name)
+	}
+
+	mm_params := OrgSvcMockCreateOrgParams{ctx, username, name}
+
+	// Record call args
+	mmCreateOrg.CreateOrgMock.mutex.Lock()
+	mmCreateOrg.CreateOrgMock.callArgs = append(mmCreateOrg.CreateOrgMock.callArgs, &mm_params)
+	mmCreateOrg.CreateOrgMock.mutex.Unlock()
+
+	for _, e := range mmCreateOrg.CreateOrgMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCreateOrg.CreateOrgMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateOrg.CreateOrgMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateOrg.CreateOrgMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateOrg.CreateOrgMock.defaultExpectation.paramPtrs
+
+		mm_got := OrgSvcMockCreateOrgParams{ctx, username, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateOrg.t.Errorf("OrgSvcMock.CreateOrg got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateOrg.CreateOrgMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmCreateOrg.t.Errorf("OrgSvcMock.CreateOrg got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateOrg.CreateOrgMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmCreateOrg.t.Errorf("OrgSvcMock.CreateOrg got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateOrg.CreateOrgMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateOrg.t.Errorf("OrgSvcMock.CreateOrg got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateOrg.CreateOrgMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateOrg.CreateOrgMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateOrg.t.Fatal("No results are set for the OrgSvcMock.CreateOrg")
+		}
+		return (*mm_results).err
+	}
+	if mmCreateOrg.funcCreateOrg != nil {
+		return mmCreateOrg.funcCreateOrg(ctx, username, name)
+	}
+	mmCreateOrg.t.Fatalf("Unexpected call to OrgSvcMock.CreateOrg. %v %v %v", ctx, username, name)
+	return
+}
+
+// CreateOrgAfterCounter returns a count of finished OrgSvcMock.CreateOrg invocations
+func (mmCreateOrg *OrgSvcMock) CreateOrgAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateOrg.afterCreateOrgCounter)
+}
+
+// CreateOrgBeforeCounter returns a count of OrgSvcMock.CreateOrg invocations
+func (mmCreateOrg *OrgSvcMock) CreateOrgBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateOrg.beforeCreateOrgCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrgSvcMock.CreateOrg.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateOrg *mOrgSvcMockCreateOrg) Calls() []*OrgSvcMockCreateOrgParams {
+	mmCreateOrg.mutex.RLock()
+
+	argCopy := make([]*OrgSvcMockCreateOrgParams, len(mmCreateOrg.callArgs))
+	copy(argCopy, mmCreateOrg.callArgs)
+
+	mmCreateOrg.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateOrgDone returns true if the count of the CreateOrg invocations corresponds
+// the number of defined expectations
+func (m *OrgSvcMock) MinimockCreateOrgDone() bool {
+	if m.CreateOrgMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateOrgMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateOrgMock.invocationsDone()
+}
+
+// MinimockCreateOrgInspect logs each unmet expectation
+func (m *OrgSvcMock) MinimockCreateOrgInspect() {
+	for _, e := range m.CreateOrgMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrgSvcMock.CreateOrg at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateOrgCounter := mm_atomic.LoadUint64(&m.afterCreateOrgCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateOrgMock.defaultExpectation != nil && afterCreateOrgCounter < 1 {
+		if m.CreateOrgMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrgSvcMock.CreateOrg at\n%s", m.CreateOrgMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrgSvcMock.CreateOrg at\n%s with params: %#v", m.CreateOrgMock.defaultExpectation.expectationOrigins.origin, *m.CreateOrgMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateOrg != nil && afterCreateOrgCounter < 1 {
+		m.t.Errorf("Expected call to OrgSvcMock.CreateOrg at\n%s", m.funcCreateOrgOrigin)
+	}
+
+	if !m.CreateOrgMock.invocationsDone() && afterCreateOrgCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrgSvcMock.CreateOrg at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateOrgMock.expectedInvocations), m.CreateOrgMock.expectedInvocationsOrigin, afterCreateOrgCounter)
+	}
+}
+
+type mOrgSvcMockGetOrgCards struct {
+	optional           bool
+	mock               *OrgSvcMock
+	defaultExpectation *OrgSvcMockGetOrgCardsExpectation
+	expectations       []*OrgSvcMockGetOrgCardsExpectation
+
+	callArgs []*OrgSvcMockGetOrgCardsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrgSvcMockGetOrgCardsExpectation specifies expectation struct of the OrgSvc.GetOrgCards
+type OrgSvcMockGetOrgCardsExpectation struct {
+	mock               *OrgSvcMock
+	params             *OrgSvcMockGetOrgCardsParams
+	paramPtrs          *OrgSvcMockGetOrgCardsParamPtrs
+	expectationOrigins OrgSvcMockGetOrgCardsExpectationOrigins
+	results            *OrgSvcMockGetOrgCardsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrgSvcMockGetOrgCardsParams contains parameters of the OrgSvc.GetOrgCards
+type OrgSvcMockGetOrgCardsParams struct {
+	ctx      context.Context
+	username string
+	orgName  string
+	page     pagination.Request
+}
+
+// OrgSvcMockGetOrgCardsParamPtrs contains pointers to parameters of the OrgSvc.GetOrgCards
+type OrgSvcMockGetOrgCardsParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	orgName  *string
+	page     *pagination.Request
+}
+
+// OrgSvcMockGetOrgCardsResults contains results of the OrgSvc.GetOrgCards
+type OrgSvcMockGetOrgCardsResults struct {
+	cards []profile.CardInfo
+	next  string
+	err   error
+}
+
+// OrgSvcMockGetOrgCardsOrigins contains origins of expectations of the OrgSvc.GetOrgCards
+type OrgSvcMockGetOrgCardsExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originOrgName  string
+	originPage     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetOrgCards *mOrgSvcMockGetOrgCards) Optional() *mOrgSvcMockGetOrgCards {
+	mmGetOrgCards.optional = true
+	return mmGetOrgCards
+}
+
+// Expect sets up expected params for OrgSvc.GetOrgCards
+func (mmGetOrgCards *mOrgSvcMockGetOrgCards) Expect(ctx context.Context, username string, orgName string, page pagination.Request) *mOrgSvcMockGetOrgCards {
+	if mmGetOrgCards.mock.funcGetOrgCards != nil {
+		mmGetOrgCards.mock.t.Fatalf("OrgSvcMock.GetOrgCards mock is already set by Set")
+	}
+
+	if mmGetOrgCards.defaultExpectation == nil {
+		mmGetOrgCards.defaultExpectation = &OrgSvcMockGetOrgCardsExpectation{}
+	}
+
+	if mmGetOrgCards.defaultExpectation.paramPtrs != nil {
+		mmGetOrgCards.mock.t.Fatalf("OrgSvcMock.GetOrgCards mock is already set by ExpectParams functions")
+	}
+
+	mmGetOrgCards.defaultExpectation.params = &OrgSvcMockGetOrgCardsParams{ctx, username, orgName, page}
+	mmGetOrgCards.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetOrgCards.expectations {
+		if minimock.Equal(e.params, mmGetOrgCards.defaultExpectation.params) {
+			mmGetOrgCards.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOrgCards.defaultExpectation.params)
+		}
+	}
+
+	return mmGetOrgCards
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrgSvc.GetOrgCards
+func (mmGetOrgCards *mOrgSvcMockGetOrgCards) ExpectCtxParam1(ctx context.Context) *mOrgSvcMockGetOrgCards {
+	if mmGetOrgCards.mock.funcGetOrgCards != nil {
+		mmGetOrgCards.mock.t.Fatalf("OrgSvcMock.GetOrgCards mock is already set by Set")
+	}
+
+	if mmGetOrgCards.defaultExpectation == nil {
+		mmGetOrgCards.defaultExpectation = &OrgSvcMockGetOrgCardsExpectation{}
+	}
+
+	if mmGetOrgCards.defaultExpectation.params != nil {
+		mmGetOrgCards.mock.t.Fatalf("OrgSvcMock.GetOrgCards mock is already set by Expect")
+	}
+
+	if mmGetOrgCards.defaultExpectation.paramPtrs == nil {
+		mmGetOrgCards.defaultExpectation.paramPtrs = &OrgSvcMockGetOrgCardsParamPtrs{}
+	}
+	mmGetOrgCards.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetOrgCards.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetOrgCards
+}
+
+// ExpectUsernameParam2 sets up expected param username for OrgSvc.GetOrgCards
+func (mmGetOrgCards *mOrgSvcMockGetOrgCards) ExpectUsernameParam2(username string) *mOrgSvcMockGetOrgCards {
+	if mmGetOrgCards.mock.funcGetOrgCards != nil {
+		mmGetOrgCards.mock.t.Fatalf("OrgSvcMock.GetOrgCards mock is already set by Set")
+	}
+
+	if mmGetOrgCards.defaultExpectation == nil {
+		mmGetOrgCards.defaultExpectation = &OrgSvcMockGetOrgCardsExpectation{}
+	}
+
+	if mmGetOrgCards.defaultExpectation.params != nil {
+		mmGetOrgCards.mock.t.Fatalf("OrgSvcMock.GetOrgCards mock is already set by Expect")
+	}
+
+	if mmGetOrgCards.defaultExpectation.paramPtrs == nil {
+		mmGetOrgCards.defaultExpectation.paramPtrs = &OrgSvcMockGetOrgCardsParamPtrs{}
+	}
+	mmGetOrgCards.defaultExpectation.paramPtrs.username = &username
+	mmGetOrgCards.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetOrgCards
+}
+
+// ExpectOrgNameParam3 sets up expected param orgName for OrgSvc.GetOrgCards
+func (mmGetOrgCards *mOrgSvcMockGetOrgCards) ExpectOrgNameParam3(orgName string) *mOrgSvcMockGetOrgCards {
+	if mmGetOrgCards.mock.funcGetOrgCards != nil {
+		mmGetOrgCards.mock.t.Fatalf("OrgSvcMock.GetOrgCards mock is already set by Set")
+	}
+
+	if mmGetOrgCards.defaultExpectation == nil {
+		mmGetOrgCards.defaultExpectation = &OrgSvcMockGetOrgCardsExpectation{}
+	}
+
+	if mmGetOrgCards.defaultExpectation.params != nil {
+		mmGetOrgCards.mock.t.Fatalf("OrgSvcMock.GetOrgCards mock is already set by Expect")
+	}
+
+	if mmGetOrgCards.defaultExpectation.paramPtrs == nil {
+		mmGetOrgCards.defaultExpectation.paramPtrs = &OrgSvcMockGetOrgCardsParamPtrs{}
+	}
+	mmGetOrgCards.defaultExpectation.paramPtrs.orgName = &orgName
+	mmGetOrgCards.defaultExpectation.expectationOrigins.originOrgName = minimock.CallerInfo(1)
+
+	return mmGetOrgCards
+}
+
+// ExpectPageParam4 sets up expected param page for OrgSvc.GetOrgCards
+func (mmGetOrgCards *mOrgSvcMockGetOrgCards) ExpectPageParam4(page pagination.Request) *mOrgSvcMockGetOrgCards {
+	if mmGetOrgCards.mock.funcGetOrgCards != nil {
+		mmGetOrgCards.mock.t.Fatalf("OrgSvcMock.GetOrgCards mock is already set by Set")
+	}
+
+	if mmGetOrgCards.defaultExpectation == nil {
+		mmGetOrgCards.defaultExpectation = &OrgSvcMockGetOrgCardsExpectation{}
+	}
+
+	if mmGetOrgCards.defaultExpectation.params != nil {
+		mmGetOrgCards.mock.t.Fatalf("OrgSvcMock.GetOrgCards mock is already set by Expect")
+	}
+
+	if mmGetOrgCards.defaultExpectation.paramPtrs == nil {
+		mmGetOrgCards.defaultExpectation.paramPtrs = &OrgSvcMockGetOrgCardsParamPtrs{}
+	}
+	mmGetOrgCards.defaultExpectation.paramPtrs.page = &page
+	mmGetOrgCards.defaultExpectation.expectationOrigins.originPage = minimock.CallerInfo(1)
+
+	return mmGetOrgCards
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrgSvc.GetOrgCards
+func (mmGetOrgCards *mOrgSvcMockGetOrgCards) Inspect(f func(ctx context.Context, username string, orgName string, page pagination.Request)) *mOrgSvcMockGetOrgCards {
+	if mmGetOrgCards.mock.inspectFuncGetOrgCards != nil {
+		mmGetOrgCards.mock.t.Fatalf("Inspect function is already set for OrgSvcMock.GetOrgCards")
+	}
+
+	mmGetOrgCards.mock.inspectFuncGetOrgCards = f
+
+	return mmGetOrgCards
+}
+
+// Return sets up results that will be returned by OrgSvc.GetOrgCards
+func (mmGetOrgCards *mOrgSvcMockGetOrgCards) Return(cards []profile.CardInfo, next string, err error) *OrgSvcMock {
+	if mmGetOrgCards.mock.funcGetOrgCards != nil {
+		mmGetOrgCards.mock.t.Fatalf("OrgSvcMock.GetOrgCards mock is already set by Set")
+	}
+
+	if mmGetOrgCards.defaultExpectation == nil {
+		mmGetOrgCards.defaultExpectation = &OrgSvcMockGetOrgCardsExpectation{mock: mmGetOrgCards.mock}
+	}
+	mmGetOrgCards.defaultExpectation.results = &OrgSvcMockGetOrgCardsResults{cards, next, err}
+	mmGetOrgCards.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetOrgCards.mock
+}
+
+// Set uses given function f to mock the OrgSvc.GetOrgCards method
+func (mmGetOrgCards *mOrgSvcMockGetOrgCards) Set(f func(ctx context.Context, username string, orgName string, page pagination.Request) (cards []profile.CardInfo, next string, err error)) *OrgSvcMock {
+	if mmGetOrgCards.defaultExpectation != nil {
+		mmGetOrgCards.mock.t.Fatalf("Default expectation is already set for the OrgSvc.GetOrgCards method")
+	}
+
+	if len(mmGetOrgCards.expectations) > 0 {
+		mmGetOrgCards.mock.t.Fatalf("Some expectations are already set for the OrgSvc.GetOrgCards method")
+	}
+
+	mmGetOrgCards.mock.funcGetOrgCards = f
+	mmGetOrgCards.mock.funcGetOrgCardsOrigin = minimock.CallerInfo(1)
+	return mmGetOrgCards.mock
+}
+
+// When sets expectation for the OrgSvc.GetOrgCards which will trigger the result defined by the following
+// Then helper
+func (mmGetOrgCards *mOrgSvcMockGetOrgCards) When(ctx context.Context, username string, orgName string, page pagination.Request) *OrgSvcMockGetOrgCardsExpectation {
+	if mmGetOrgCards.mock.funcGetOrgCards != nil {
+		mmGetOrgCards.mock.t.Fatalf("OrgSvcMock.GetOrgCards mock is already set by Set")
+	}
+
+	expectation := &OrgSvcMockGetOrgCardsExpectation{
+		mock:               mmGetOrgCards.mock,
+		params:             &OrgSvcMockGetOrgCardsParams{ctx, username, orgName, page},
+		expectationOrigins: OrgSvcMockGetOrgCardsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetOrgCards.expectations = append(mmGetOrgCards.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrgSvc.GetOrgCards return parameters for the expectation previously defined by the When method
+func (e *OrgSvcMockGetOrgCardsExpectation) Then(cards []profile.CardInfo, next string, err error) *OrgSvcMock {
+	e.results = &OrgSvcMockGetOrgCardsResults{cards, next, err}
+	return e.mock
+}
+
+// Times sets number of times OrgSvc.GetOrgCards should be invoked
+func (mmGetOrgCards *mOrgSvcMockGetOrgCards) Times(n uint64) *mOrgSvcMockGetOrgCards {
+	if n == 0 {
+		mmGetOrgCards.mock.t.Fatalf("Times of OrgSvcMock.GetOrgCards mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetOrgCards.expectedInvocations, n)
+	mmGetOrgCards.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetOrgCards
+}
+
+func (mmGetOrgCards *mOrgSvcMockGetOrgCards) invocationsDone() bool {
+	if len(mmGetOrgCards.expectations) == 0 && mmGetOrgCards.defaultExpectation == nil && mmGetOrgCards.mock.funcGetOrgCards == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetOrgCards.mock.afterGetOrgCardsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetOrgCards.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetOrgCards implements mm_handler.OrgSvc
+func (mmGetOrgCards *OrgSvcMock) GetOrgCards(ctx context.Context, username string, orgName string, page pagination.Request) (cards []profile.CardInfo, next string, err error) {
+	mm_atomic.AddUint64(&mmGetOrgCards.beforeGetOrgCardsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetOrgCards.afterGetOrgCardsCounter, 1)
+
+	mmGetOrgCards.t.Helper()
+
+	if mmGetOrgCards.inspectFuncGetOrgCards != nil {
+		mmGetOrgCards.inspectFuncGetOrgCards(ctx, username, orgName, page)
+	}
+
+	mm_params := OrgSvcMockGetOrgCardsParams{ctx, username, orgName, page}
+
+	// Record call args
+	mmGetOrgCards.GetOrgCardsMock.mutex.Lock()
+	mmGetOrgCards.GetOrgCardsMock.callArgs = append(mmGetOrgCards.GetOrgCardsMock.callArgs, &mm_params)
+	mmGetOrgCards.GetOrgCardsMock.mutex.Unlock()
+
+	for _, e := range mmGetOrgCards.GetOrgCardsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cards, e.results.next, e.results.err
+		}
+	}
+
+	if mmGetOrgCards.GetOrgCardsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetOrgCards.GetOrgCardsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetOrgCards.GetOrgCardsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetOrgCards.GetOrgCardsMock.defaultExpectation.paramPtrs
+
+		mm_got := OrgSvcMockGetOrgCardsParams{ctx, username, orgName, page}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetOrgCards.t.Errorf("OrgSvcMock.GetOrgCards got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrgCards.GetOrgCardsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetOrgCards.t.Errorf("OrgSvcMock.GetOrgCards got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrgCards.GetOrgCardsMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.orgName != nil && !minimock.Equal(*mm_want_ptrs.orgName, mm_got.orgName) {
+				mmGetOrgCards.t.Errorf("OrgSvcMock.GetOrgCards got unexpected parameter orgName, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrgCards.GetOrgCardsMock.defaultExpectation.expectationOrigins.originOrgName, *mm_want_ptrs.orgName, mm_got.orgName, minimock.Diff(*mm_want_ptrs.orgName, mm_got.orgName))
+			}
+
+			if mm_want_ptrs.page != nil && !minimock.Equal(*mm_want_ptrs.page, mm_got.page) {
+				mmGetOrgCards.t.Errorf("OrgSvcMock.GetOrgCards got unexpected parameter page, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrgCards.GetOrgCardsMock.defaultExpectation.expectationOrigins.originPage, *mm_want_ptrs.page, mm_got.page, minimock.Diff(*mm_want_ptrs.page, mm_got.page))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetOrgCards.t.Errorf("OrgSvcMock.GetOrgCards got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetOrgCards.GetOrgCardsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetOrgCards.GetOrgCardsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetOrgCards.t.Fatal("No results are set for the OrgSvcMock.GetOrgCards")
+		}
+		return (*mm_results).cards, (*mm_results).next, (*mm_results).err
+	}
+	if mmGetOrgCards.funcGetOrgCards != nil {
+		return mmGetOrgCards.funcGetOrgCards(ctx, username, orgName, page)
+	}
+	mmGetOrgCards.t.Fatalf("Unexpected call to OrgSvcMock.GetOrgCards. %v %v %v %v", ctx, username, orgName, page)
+	return
+}
+
+// GetOrgCardsAfterCounter returns a count of finished OrgSvcMock.GetOrgCards invocations
+func (mmGetOrgCards *OrgSvcMock) GetOrgCardsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrgCards.afterGetOrgCardsCounter)
+}
+
+// GetOrgCardsBeforeCounter returns a count of OrgSvcMock.GetOrgCards invocations
+func (mmGetOrgCards *OrgSvcMock) GetOrgCardsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrgCards.beforeGetOrgCardsCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrgSvcMock.GetOrgCards.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetOrgCards *mOrgSvcMockGetOrgCards) Calls() []*OrgSvcMockGetOrgCardsParams {
+	mmGetOrgCards.mutex.RLock()
+
+	argCopy := make([]*OrgSvcMockGetOrgCardsParams, len(mmGetOrgCards.callArgs))
+	copy(argCopy, mmGetOrgCards.callArgs)
+
+	mmGetOrgCards.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetOrgCardsDone returns true if the count of the GetOrgCards invocations corresponds
+// the number of defined expectations
+func (m *OrgSvcMock) MinimockGetOrgCardsDone() bool {
+	if m.GetOrgCardsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetOrgCardsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetOrgCardsMock.invocationsDone()
+}
+
+// MinimockGetOrgCardsInspect logs each unmet expectation
+func (m *OrgSvcMock) MinimockGetOrgCardsInspect() {
+	for _, e := range m.GetOrgCardsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrgSvcMock.GetOrgCards at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetOrgCardsCounter := mm_atomic.LoadUint64(&m.afterGetOrgCardsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOrgCardsMock.defaultExpectation != nil && afterGetOrgCardsCounter < 1 {
+		if m.GetOrgCardsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrgSvcMock.GetOrgCards at\n%s", m.GetOrgCardsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrgSvcMock.GetOrgCards at\n%s with params: %#v", m.GetOrgCardsMock.defaultExpectation.expectationOrigins.origin, *m.GetOrgCardsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOrgCards != nil && afterGetOrgCardsCounter < 1 {
+		m.t.Errorf("Expected call to OrgSvcMock.GetOrgCards at\n%s", m.funcGetOrgCardsOrigin)
+	}
+
+	if !m.GetOrgCardsMock.invocationsDone() && afterGetOrgCardsCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrgSvcMock.GetOrgCards at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetOrgCardsMock.expectedInvocations), m.GetOrgCardsMock.expectedInvocationsOrigin, afterGetOrgCardsCounter)
+	}
+}
+
+type mOrgSvcMockGetUserOrgs struct {
+	optional           bool
+	mock               *OrgSvcMock
+	defaultExpectation *OrgSvcMockGetUserOrgsExpectation
+	expectations       []*OrgSvcMockGetUserOrgsExpectation
+
+	callArgs []*OrgSvcMockGetUserOrgsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrgSvcMockGetUserOrgsExpectation specifies expectation struct of the OrgSvc.GetUserOrgs
+type OrgSvcMockGetUserOrgsExpectation struct {
+	mock               *OrgSvcMock
+	params             *OrgSvcMockGetUserOrgsParams
+	paramPtrs          *OrgSvcMockGetUserOrgsParamPtrs
+	expectationOrigins OrgSvcMockGetUserOrgsExpectationOrigins
+	results            *OrgSvcMockGetUserOrgsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrgSvcMockGetUserOrgsParams contains parameters of the OrgSvc.GetUserOrgs
+type OrgSvcMockGetUserOrgsParams struct {
+	ctx      context.Context
+	username string
+}
+
+// OrgSvcMockGetUserOrgsParamPtrs contains pointers to parameters of the OrgSvc.GetUserOrgs
+type OrgSvcMockGetUserOrgsParamPtrs struct {
+	ctx      *context.Context
+	username *string
+}
+
+// OrgSvcMockGetUserOrgsResults contains results of the OrgSvc.GetUserOrgs
+type OrgSvcMockGetUserOrgsResults struct {
+	ma1 []org.Member
+	err error
+}
+
+// OrgSvcMockGetUserOrgsOrigins contains origins of expectations of the OrgSvc.GetUserOrgs
+type OrgSvcMockGetUserOrgsExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUserOrgs *mOrgSvcMockGetUserOrgs) Optional() *mOrgSvcMockGetUserOrgs {
+	mmGetUserOrgs.optional = true
+	return mmGetUserOrgs
+}
+
+// Expect sets up expected params for OrgSvc.GetUserOrgs
+func (mmGetUserOrgs *mOrgSvcMockGetUserOrgs) Expect(ctx context.Context, username string) *mOrgSvcMockGetUserOrgs {
+	if mmGetUserOrgs.mock.funcGetUserOrgs != nil {
+		mmGetUserOrgs.mock.t.Fatalf("OrgSvcMock.GetUserOrgs mock is already set by Set")
+	}
+
+	if mmGetUserOrgs.defaultExpectation == nil {
+		mmGetUserOrgs.defaultExpectation = &OrgSvcMockGetUserOrgsExpectation{}
+	}
+
+	if mmGetUserOrgs.defaultExpectation.paramPtrs != nil {
+		mmGetUserOrgs.mock.t.Fatalf("OrgSvcMock.GetUserOrgs mock is already set by ExpectParams functions")
+	}
+
+	mmGetUserOrgs.defaultExpectation.params = &OrgSvcMockGetUserOrgsParams{ctx, username}
+	mmGetUserOrgs.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUserOrgs.expectations {
+		if minimock.Equal(e.params, mmGetUserOrgs.defaultExpectation.params) {
+			mmGetUserOrgs.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserOrgs.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUserOrgs
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrgSvc.GetUserOrgs
+func (mmGetUserOrgs *mOrgSvcMockGetUserOrgs) ExpectCtxParam1(ctx context.Context) *mOrgSvcMockGetUserOrgs {
+	if mmGetUserOrgs.mock.funcGetUserOrgs != nil {
+		mmGetUserOrgs.mock.t.Fatalf("OrgSvcMock.GetUserOrgs mock is already set by Set")
+	}
+
+	if mmGetUserOrgs.defaultExpectation == nil {
+		mmGetUserOrgs.defaultExpectation = &OrgSvcMockGetUserOrgsExpectation{}
+	}
+
+	if mmGetUserOrgs.defaultExpectation.params != nil {
+		mmGetUserOrgs.mock.t.Fatalf("OrgSvcMock.GetUserOrgs mock is already set by Expect")
+	}
+
+	if mmGetUserOrgs.defaultExpectation.paramPtrs == nil {
+		mmGetUserOrgs.defaultExpectation.paramPtrs = &OrgSvcMockGetUserOrgsParamPtrs{}
+	}
+	mmGetUserOrgs.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUserOrgs.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetUserOrgs
+}
+
+// ExpectUsernameParam2 sets up expected param username for OrgSvc.GetUserOrgs
+func (mmGetUserOrgs *mOrgSvcMockGetUserOrgs) ExpectUsernameParam2(username string) *mOrgSvcMockGetUserOrgs {
+	if mmGetUserOrgs.mock.funcGetUserOrgs != nil {
+		mmGetUserOrgs.mock.t.Fatalf("OrgSvcMock.GetUserOrgs mock is already set by Set")
+	}
+
+	if mmGetUserOrgs.defaultExpectation == nil {
+		mmGetUserOrgs.defaultExpectation = &OrgSvcMockGetUserOrgsExpectation{}
+	}
+
+	if mmGetUserOrgs.defaultExpectation.params != nil {
+		mmGetUserOrgs.mock.t.Fatalf("OrgSvcMock.GetUserOrgs mock is already set by Expect")
+	}
+
+	if mmGetUserOrgs.defaultExpectation.paramPtrs == nil {
+		mmGetUserOrgs.defaultExpectation.paramPtrs = &OrgSvcMockGetUserOrgsParamPtrs{}
+	}
+	mmGetUserOrgs.defaultExpectation.paramPtrs.username = &username
+	mmGetUserOrgs.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetUserOrgs
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrgSvc.GetUserOrgs
+func (mmGetUserOrgs *mOrgSvcMockGetUserOrgs) Inspect(f func(ctx context.Context, username string)) *mOrgSvcMockGetUserOrgs {
+	if mmGetUserOrgs.mock.inspectFuncGetUserOrgs != nil {
+		mmGetUserOrgs.mock.t.Fatalf("Inspect function is already set for OrgSvcMock.GetUserOrgs")
+	}
+
+	mmGetUserOrgs.mock.inspectFuncGetUserOrgs = f
+
+	return mmGetUserOrgs
+}
+
+// Return sets up results that will be returned by OrgSvc.GetUserOrgs
+func (mmGetUserOrgs *mOrgSvcMockGetUserOrgs) Return(ma1 []org.Member, err error) *OrgSvcMock {
+	if mmGetUserOrgs.mock.funcGetUserOrgs != nil {
+		mmGetUserOrgs.mock.t.Fatalf("OrgSvcMock.GetUserOrgs mock is already set by Set")
+	}
+
+	if mmGetUserOrgs.defaultExpectation == nil {
+		mmGetUserOrgs.defaultExpectation = &OrgSvcMockGetUserOrgsExpectation{mock: mmGetUserOrgs.mock}
+	}
+	mmGetUserOrgs.defaultExpectation.results = &OrgSvcMockGetUserOrgsResults{ma1, err}
+	mmGetUserOrgs.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUserOrgs.mock
+}
+
+// Set uses given function f to mock the OrgSvc.GetUserOrgs method
+func (mmGetUserOrgs *mOrgSvcMockGetUserOrgs) Set(f func(ctx context.Context, username string) (ma1 []org.Member, err error)) *OrgSvcMock {
+	if mmGetUserOrgs.defaultExpectation != nil {
+		mmGetUserOrgs.mock.t.Fatalf("Default expectation is already set for the OrgSvc.GetUserOrgs method")
+	}
+
+	if len(mmGetUserOrgs.expectations) > 0 {
+		mmGetUserOrgs.mock.t.Fatalf("Some expectations are already set for the OrgSvc.GetUserOrgs method")
+	}
+
+	mmGetUserOrgs.mock.funcGetUserOrgs = f
+	mmGetUserOrgs.mock.funcGetUserOrgsOrigin = minimock.CallerInfo(1)
+	return mmGetUserOrgs.mock
+}
+
+// When sets expectation for the OrgSvc.GetUserOrgs which will trigger the result defined by the following
+// Then helper
+func (mmGetUserOrgs *mOrgSvcMockGetUserOrgs) When(ctx context.Context, username string) *OrgSvcMockGetUserOrgsExpectation {
+	if mmGetUserOrgs.mock.funcGetUserOrgs != nil {
+		mmGetUserOrgs.mock.t.Fatalf("OrgSvcMock.GetUserOrgs mock is already set by Set")
+	}
+
+	expectation := &OrgSvcMockGetUserOrgsExpectation{
+		mock:               mmGetUserOrgs.mock,
+		params:             &OrgSvcMockGetUserOrgsParams{ctx, username},
+		expectationOrigins: OrgSvcMockGetUserOrgsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUserOrgs.expectations = append(mmGetUserOrgs.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrgSvc.GetUserOrgs return parameters for the expectation previously defined by the When method
+func (e *OrgSvcMockGetUserOrgsExpectation) Then(ma1 []org.Member, err error) *OrgSvcMock {
+	e.results = &OrgSvcMockGetUserOrgsResults{ma1, err}
+	return e.mock
+}
+
+// Times sets number of times OrgSvc.GetUserOrgs should be invoked
+func (mmGetUserOrgs *mOrgSvcMockGetUserOrgs) Times(n uint64) *mOrgSvcMockGetUserOrgs {
+	if n == 0 {
+		mmGetUserOrgs.mock.t.Fatalf("Times of OrgSvcMock.GetUserOrgs mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUserOrgs.expectedInvocations, n)
+	mmGetUserOrgs.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUserOrgs
+}
+
+func (mmGetUserOrgs *mOrgSvcMockGetUserOrgs) invocationsDone() bool {
+	if len(mmGetUserOrgs.expectations) == 0 && mmGetUserOrgs.defaultExpectation == nil && mmGetUserOrgs.mock.funcGetUserOrgs == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUserOrgs.mock.afterGetUserOrgsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserOrgs.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUserOrgs implements mm_handler.OrgSvc
+func (mmGetUserOrgs *OrgSvcMock) GetUserOrgs(ctx context.Context, username string) (ma1 []org.Member, err error) {
+	mm_atomic.AddUint64(&mmGetUserOrgs.beforeGetUserOrgsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUserOrgs.afterGetUserOrgsCounter, 1)
+
+	mmGetUserOrgs.t.Helper()
+
+	if mmGetUserOrgs.inspectFuncGetUserOrgs != nil {
+		mmGetUserOrgs.inspectFuncGetUserOrgs(ctx, username)
+	}
+
+	mm_params := OrgSvcMockGetUserOrgsParams{ctx, username}
+
+	// Record call args
+	mmGetUserOrgs.GetUserOrgsMock.mutex.Lock()
+	mmGetUserOrgs.GetUserOrgsMock.callArgs = append(mmGetUserOrgs.GetUserOrgsMock.callArgs, &mm_params)
+	mmGetUserOrgs.GetUserOrgsMock.mutex.Unlock()
+
+	for _, e := range mmGetUserOrgs.GetUserOrgsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ma1, e.results.err
+		}
+	}
+
+	if mmGetUserOrgs.GetUserOrgsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUserOrgs.GetUserOrgsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUserOrgs.GetUserOrgsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUserOrgs.GetUserOrgsMock.defaultExpectation.paramPtrs
+
+		mm_got := OrgSvcMockGetUserOrgsParams{ctx, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUserOrgs.t.Errorf("OrgSvcMock.GetUserOrgs got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserOrgs.GetUserOrgsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetUserOrgs.t.Errorf("OrgSvcMock.GetUserOrgs got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserOrgs.GetUserOrgsMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUserOrgs.t.Errorf("OrgSvcMock.GetUserOrgs got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUserOrgs.GetUserOrgsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUserOrgs.GetUserOrgsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUserOrgs.t.Fatal("No results are set for the OrgSvcMock.GetUserOrgs")
+		}
+		return (*mm_results).ma1, (*mm_results).err
+	}
+	if mmGetUserOrgs.funcGetUserOrgs != nil {
+		return mmGetUserOrgs.funcGetUserOrgs(ctx, username)
+	}
+	mmGetUserOrgs.t.Fatalf("Unexpected call to OrgSvcMock.GetUserOrgs. %v %v", ctx, username)
+	return
+}
+
+// GetUserOrgsAfterCounter returns a count of finished OrgSvcMock.GetUserOrgs invocations
+func (mmGetUserOrgs *OrgSvcMock) GetUserOrgsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserOrgs.afterGetUserOrgsCounter)
+}
+
+// GetUserOrgsBeforeCounter returns a count of OrgSvcMock.GetUserOrgs invocations
+func (mmGetUserOrgs *OrgSvcMock) GetUserOrgsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserOrgs.beforeGetUserOrgsCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrgSvcMock.GetUserOrgs.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUserOrgs *mOrgSvcMockGetUserOrgs) Calls() []*OrgSvcMockGetUserOrgsParams {
+	mmGetUserOrgs.mutex.RLock()
+
+	argCopy := make([]*OrgSvcMockGetUserOrgsParams, len(mmGetUserOrgs.callArgs))
+	copy(argCopy, mmGetUserOrgs.callArgs)
+
+	mmGetUserOrgs.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserOrgsDone returns true if the count of the GetUserOrgs invocations corresponds
+// the number of defined expectations
+func (m *OrgSvcMock) MinimockGetUserOrgsDone() bool {
+	if m.GetUserOrgsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserOrgsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserOrgsMock.invocationsDone()
+}
+
+// MinimockGetUserOrgsInspect logs each unmet expectation
+func (m *OrgSvcMock) MinimockGetUserOrgsInspect() {
+	for _, e := range m.GetUserOrgsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrgSvcMock.GetUserOrgs at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUserOrgsCounter := mm_atomic.LoadUint64(&m.afterGetUserOrgsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserOrgsMock.defaultExpectation != nil && afterGetUserOrgsCounter < 1 {
+		if m.GetUserOrgsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrgSvcMock.GetUserOrgs at\n%s", m.GetUserOrgsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrgSvcMock.GetUserOrgs at\n%s with params: %#v", m.GetUserOrgsMock.defaultExpectation.expectationOrigins.origin, *m.GetUserOrgsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUserOrgs != nil && afterGetUserOrgsCounter < 1 {
+		m.t.Errorf("Expected call to OrgSvcMock.GetUserOrgs at\n%s", m.funcGetUserOrgsOrigin)
+	}
+
+	if !m.GetUserOrgsMock.invocationsDone() && afterGetUserOrgsCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrgSvcMock.GetUserOrgs at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserOrgsMock.expectedInvocations), m.GetUserOrgsMock.expectedInvocationsOrigin, afterGetUserOrgsCounter)
+	}
+}
+
+type mOrgSvcMockRemoveMember struct {
+	optional           bool
+	mock               *OrgSvcMock
+	defaultExpectation *OrgSvcMockRemoveMemberExpectation
+	expectations       []*OrgSvcMockRemoveMemberExpectation
+
+	callArgs []*OrgSvcMockRemoveMemberParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrgSvcMockRemoveMemberExpectation specifies expectation struct of the OrgSvc.RemoveMember
+type OrgSvcMockRemoveMemberExpectation struct {
+	mock               *OrgSvcMock
+	params             *OrgSvcMockRemoveMemberParams
+	paramPtrs          *OrgSvcMockRemoveMemberParamPtrs
+	expectationOrigins OrgSvcMockRemoveMemberExpectationOrigins
+	results            *OrgSvcMockRemoveMemberResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrgSvcMockRemoveMemberParams contains parameters of the OrgSvc.RemoveMember
+type OrgSvcMockRemoveMemberParams struct {
+	ctx      context.Context
+	actor    string
+	orgName  string
+	username string
+}
+
+// OrgSvcMockRemoveMemberParamPtrs contains pointers to parameters of the OrgSvc.RemoveMember
+type OrgSvcMockRemoveMemberParamPtrs struct {
+	ctx      *context.Context
+	actor    *string
+	orgName  *string
+	username *string
+}
+
+// OrgSvcMockRemoveMemberResults contains results of the OrgSvc.RemoveMember
+type OrgSvcMockRemoveMemberResults struct {
+	err error
+}
+
+// OrgSvcMockRemoveMemberOrigins contains origins of expectations of the OrgSvc.RemoveMember
+type OrgSvcMockRemoveMemberExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originActor    string
+	originOrgName  string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemoveMember *mOrgSvcMockRemoveMember) Optional() *mOrgSvcMockRemoveMember {
+	mmRemoveMember.optional = true
+	return mmRemoveMember
+}
+
+// Expect sets up expected params for OrgSvc.RemoveMember
+func (mmRemoveMember *mOrgSvcMockRemoveMember) Expect(ctx context.Context, actor string, orgName string, username string) *mOrgSvcMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("OrgSvcMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &OrgSvcMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs != nil {
+		mmRemoveMember.mock.t.Fatalf("OrgSvcMock.RemoveMember mock is already set by ExpectParams functions")
+	}
+
+	mmRemoveMember.defaultExpectation.params = &OrgSvcMockRemoveMemberParams{ctx, actor, orgName, username}
+	mmRemoveMember.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemoveMember.expectations {
+		if minimock.Equal(e.params, mmRemoveMember.defaultExpectation.params) {
+			mmRemoveMember.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemoveMember.defaultExpectation.params)
+		}
+	}
+
+	return mmRemoveMember
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrgSvc.RemoveMember
+func (mmRemoveMember *mOrgSvcMockRemoveMember) ExpectCtxParam1(ctx context.Context) *mOrgSvcMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("OrgSvcMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &OrgSvcMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.params != nil {
+		mmRemoveMember.mock.t.Fatalf("OrgSvcMock.RemoveMember mock is already set by Expect")
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveMember.defaultExpectation.paramPtrs = &OrgSvcMockRemoveMemberParamPtrs{}
+	}
+	mmRemoveMember.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemoveMember.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemoveMember
+}
+
+// ExpectActorParam2 sets up expected param actor for OrgSvc.RemoveMember
+func (mmRemoveMember *mOrgSvcMockRemoveMember) ExpectActorParam2(actor string) *mOrgSvcMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("OrgSvcMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &OrgSvcMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.params != nil {
+		mmRemoveMember.mock.t.Fatalf("OrgSvcMock.RemoveMember mock is already set by Expect")
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveMember.defaultExpectation.paramPtrs = &OrgSvcMockRemoveMemberParamPtrs{}
+	}
+	mmRemoveMember.defaultExpectation.paramPtrs.actor = &actor
+	mmRemoveMember.defaultExpectation.expectationOrigins.originActor = minimock.CallerInfo(1)
+
+	return mmRemoveMember
+}
+
+// ExpectOrgNameParam3 sets up expected param orgName for OrgSvc.RemoveMember
+func (mmRemoveMember *mOrgSvcMockRemoveMember) ExpectOrgNameParam3(orgName string) *mOrgSvcMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("OrgSvcMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &OrgSvcMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.params != nil {
+		mmRemoveMember.mock.t.Fatalf("OrgSvcMock.RemoveMember mock is already set by Expect")
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveMember.defaultExpectation.paramPtrs = &OrgSvcMockRemoveMemberParamPtrs{}
+	}
+	mmRemoveMember.defaultExpectation.paramPtrs.orgName = &orgName
+	mmRemoveMember.defaultExpectation.expectationOrigins.originOrgName = minimock.CallerInfo(1)
+
+	return mmRemoveMember
+}
+
+// ExpectUsernameParam4 sets up expected param username for OrgSvc.RemoveMember
+func (mmRemoveMember *mOrgSvcMockRemoveMember) ExpectUsernameParam4(username string) *mOrgSvcMockRemoveMember {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("OrgSvcMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &OrgSvcMockRemoveMemberExpectation{}
+	}
+
+	if mmRemoveMember.defaultExpectation.params != nil {
+		mmRemoveMember.mock.t.Fatalf("OrgSvcMock.RemoveMember mock is already set by Expect")
+	}
+
+	if mmRemoveMember.defaultExpectation.paramPtrs == nil {
+		mmRemoveMember.defaultExpectation.paramPtrs = &OrgSvcMockRemoveMemberParamPtrs{}
+	}
+	mmRemoveMember.defaultExpectation.paramPtrs.username = &username
+	mmRemoveMember.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmRemoveMember
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrgSvc.RemoveMember
+func (mmRemoveMember *mOrgSvcMockRemoveMember) Inspect(f func(ctx context.Context, actor string, orgName string, username string)) *mOrgSvcMockRemoveMember {
+	if mmRemoveMember.mock.inspectFuncRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("Inspect function is already set for OrgSvcMock.RemoveMember")
+	}
+
+	mmRemoveMember.mock.inspectFuncRemoveMember = f
+
+	return mmRemoveMember
+}
+
+// Return sets up results that will be returned by OrgSvc.RemoveMember
+func (mmRemoveMember *mOrgSvcMockRemoveMember) Return(err error) *OrgSvcMock {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("OrgSvcMock.RemoveMember mock is already set by Set")
+	}
+
+	if mmRemoveMember.defaultExpectation == nil {
+		mmRemoveMember.defaultExpectation = &OrgSvcMockRemoveMemberExpectation{mock: mmRemoveMember.mock}
+	}
+	mmRemoveMember.defaultExpectation.results = &OrgSvcMockRemoveMemberResults{err}
+	mmRemoveMember.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemoveMember.mock
+}
+
+// Set uses given function f to mock the OrgSvc.RemoveMember method
+func (mmRemoveMember *mOrgSvcMockRemoveMember) Set(f func(ctx context.Context, actor string, orgName string, username string) (err error)) *OrgSvcMock {
+	if mmRemoveMember.defaultExpectation != nil {
+		mmRemoveMember.mock.t.Fatalf("Default expectation is already set for the OrgSvc.RemoveMember method")
+	}
+
+	if len(mmRemoveMember.expectations) > 0 {
+		mmRemoveMember.mock.t.Fatalf("Some expectations are already set for the OrgSvc.RemoveMember method")
+	}
+
+	mmRemoveMember.mock.funcRemoveMember = f
+	mmRemoveMember.mock.funcRemoveMemberOrigin = minimock.CallerInfo(1)
+	return mmRemoveMember.mock
+}
+
+// When sets expectation for the OrgSvc.RemoveMember which will trigger the result defined by the following
+// Then helper
+func (mmRemoveMember *mOrgSvcMockRemoveMember) When(ctx context.Context, actor string, orgName string, username string) *OrgSvcMockRemoveMemberExpectation {
+	if mmRemoveMember.mock.funcRemoveMember != nil {
+		mmRemoveMember.mock.t.Fatalf("OrgSvcMock.RemoveMember mock is already set by Set")
+	}
+
+	expectation := &OrgSvcMockRemoveMemberExpectation{
+		mock:               mmRemoveMember.mock,
+		params:             &OrgSvcMockRemoveMemberParams{ctx, actor, orgName, username},
+		expectationOrigins: OrgSvcMockRemoveMemberExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRemoveMember.expectations = append(mmRemoveMember.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrgSvc.RemoveMember return parameters for the expectation previously defined by the When method
+func (e *OrgSvcMockRemoveMemberExpectation) Then(err error) *OrgSvcMock {
+	e.results = &OrgSvcMockRemoveMemberResults{err}
+	return e.mock
+}
+
+// Times sets number of times OrgSvc.RemoveMember should be invoked
+func (mmRemoveMember *mOrgSvcMockRemoveMember) Times(n uint64) *mOrgSvcMockRemoveMember {
+	if n == 0 {
+		mmRemoveMember.mock.t.Fatalf("Times of OrgSvcMock.RemoveMember mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRemoveMember.expectedInvocations, n)
+	mmRemoveMember.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemoveMember
+}
+
+func (mmRemoveMember *mOrgSvcMockRemoveMember) invocationsDone() bool {
+	if len(mmRemoveMember.expectations) == 0 && mmRemoveMember.defaultExpectation == nil && mmRemoveMember.mock.funcRemoveMember == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRemoveMember.mock.afterRemoveMemberCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemoveMember.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RemoveMember implements mm_handler.OrgSvc
+func (mmRemoveMember *OrgSvcMock) RemoveMember(ctx context.Context, actor string, orgName string, username string) (err error) {
+	mm_atomic.AddUint64(&mmRemoveMember.beforeRemoveMemberCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemoveMember.afterRemoveMemberCounter, 1)
+
+	mmRemoveMember.t.Helper()
+
+	if mmRemoveMember.inspectFuncRemoveMember != nil {
+		mmRemoveMember.inspectFuncRemoveMember(ctx, actor, orgName, username)
+	}
+
+	mm_params := OrgSvcMockRemoveMemberParams{ctx, actor, orgName, username}
+
+	// Record call args
+	mmRemoveMember.RemoveMemberMock.mutex.Lock()
+	mmRemoveMember.RemoveMemberMock.callArgs = append(mmRemoveMember.RemoveMemberMock.callArgs, &mm_params)
+	mmRemoveMember.RemoveMemberMock.mutex.Unlock()
+
+	for _, e := range mmRemoveMember.RemoveMemberMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRemoveMember.RemoveMemberMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemoveMember.RemoveMemberMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemoveMember.RemoveMemberMock.defaultExpectation.params
+		mm_want_ptrs := mmRemoveMember.RemoveMemberMock.defaultExpectation.paramPtrs
+
+		mm_got := OrgSvcMockRemoveMemberParams{ctx, actor, orgName, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveMember.t.Errorf("OrgSvcMock.RemoveMember got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveMember.RemoveMemberMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.actor != nil && !minimock.Equal(*mm_want_ptrs.actor, mm_got.actor) {
+				mmRemoveMember.t.Errorf("OrgSvcMock.RemoveMember got unexpected parameter actor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveMember.RemoveMemberMock.defaultExpectation.expectationOrigins.originActor, *mm_want_ptrs.actor, mm_got.actor, minimock.Diff(*mm_want_ptrs.actor, mm_got.actor))
+			}
+
+			if mm_want_ptrs.orgName != nil && !minimock.Equal(*mm_want_ptrs.orgName, mm_got.orgName) {
+				mmRemoveMember.t.Errorf("OrgSvcMock.RemoveMember got unexpected parameter orgName, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveMember.RemoveMemberMock.defaultExpectation.expectationOrigins.originOrgName, *mm_want_ptrs.orgName, mm_got.orgName, minimock.Diff(*mm_want_ptrs.orgName, mm_got.orgName))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmRemoveMember.t.Errorf("OrgSvcMock.RemoveMember got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveMember.RemoveMemberMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRemoveMember.t.Errorf("OrgSvcMock.RemoveMember got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemoveMember.RemoveMemberMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRemoveMember.RemoveMemberMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRemoveMember.t.Fatal("No results are set for the OrgSvcMock.RemoveMember")
+		}
+		return (*mm_results).err
+	}
+	if mmRemoveMember.funcRemoveMember != nil {
+		return mmRemoveMember.funcRemoveMember(ctx, actor, orgName, username)
+	}
+	mmRemoveMember.t.Fatalf("Unexpected call to OrgSvcMock.RemoveMember. %v %v %v %v", ctx, actor, orgName, username)
+	return
+}
+
+// RemoveMemberAfterCounter returns a count of finished OrgSvcMock.RemoveMember invocations
+func (mmRemoveMember *OrgSvcMock) RemoveMemberAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveMember.afterRemoveMemberCounter)
+}
+
+// RemoveMemberBeforeCounter returns a count of OrgSvcMock.RemoveMember invocations
+func (mmRemoveMember *OrgSvcMock) RemoveMemberBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemoveMember.beforeRemoveMemberCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrgSvcMock.RemoveMember.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRemoveMember *mOrgSvcMockRemoveMember) Calls() []*OrgSvcMockRemoveMemberParams {
+	mmRemoveMember.mutex.RLock()
+
+	argCopy := make([]*OrgSvcMockRemoveMemberParams, len(mmRemoveMember.callArgs))
+	copy(argCopy, mmRemoveMember.callArgs)
+
+	mmRemoveMember.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRemoveMemberDone returns true if the count of the RemoveMember invocations corresponds
+// the number of defined expectations
+func (m *OrgSvcMock) MinimockRemoveMemberDone() bool {
+	if m.RemoveMemberMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RemoveMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RemoveMemberMock.invocationsDone()
+}
+
+// MinimockRemoveMemberInspect logs each unmet expectation
+func (m *OrgSvcMock) MinimockRemoveMemberInspect() {
+	for _, e := range m.RemoveMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrgSvcMock.RemoveMember at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRemoveMemberCounter := mm_atomic.LoadUint64(&m.afterRemoveMemberCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RemoveMemberMock.defaultExpectation != nil && afterRemoveMemberCounter < 1 {
+		if m.RemoveMemberMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrgSvcMock.RemoveMember at\n%s", m.RemoveMemberMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrgSvcMock.RemoveMember at\n%s with params: %#v", m.RemoveMemberMock.defaultExpectation.expectationOrigins.origin, *m.RemoveMemberMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRemoveMember != nil && afterRemoveMemberCounter < 1 {
+		m.t.Errorf("Expected call to OrgSvcMock.RemoveMember at\n%s", m.funcRemoveMemberOrigin)
+	}
+
+	if !m.RemoveMemberMock.invocationsDone() && afterRemoveMemberCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrgSvcMock.RemoveMember at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemoveMemberMock.expectedInvocations), m.RemoveMemberMock.expectedInvocationsOrigin, afterRemoveMemberCounter)
+	}
+}
+
+type mOrgSvcMockSetCollection struct {
+	optional           bool
+	mock               *OrgSvcMock
+	defaultExpectation *OrgSvcMockSetCollectionExpectation
+	expectations       []*OrgSvcMockSetCollectionExpectation
+
+	callArgs []*OrgSvcMockSetCollectionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrgSvcMockSetCollectionExpectation specifies expectation struct of the OrgSvc.SetCollection
+type OrgSvcMockSetCollectionExpectation struct {
+	mock               *OrgSvcMock
+	params             *OrgSvcMockSetCollectionParams
+	paramPtrs          *OrgSvcMockSetCollectionParamPtrs
+	expectationOrigins OrgSvcMockSetCollectionExpectationOrigins
+	results            *OrgSvcMockSetCollectionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrgSvcMockSetCollectionParams contains parameters of the OrgSvc.SetCollection
+type OrgSvcMockSetCollectionParams struct {
+	ctx        context.Context
+	collection org.Collection
+}
+
+// OrgSvcMockSetCollectionParamPtrs contains pointers to parameters of the OrgSvc.SetCollection
+type OrgSvcMockSetCollectionParamPtrs struct {
+	ctx        *context.Context
+	collection *org.Collection
+}
+
+// OrgSvcMockSetCollectionResults contains results of the OrgSvc.SetCollection
+type OrgSvcMockSetCollectionResults struct {
+	err error
+}
+
+// OrgSvcMockSetCollectionOrigins contains origins of expectations of the OrgSvc.SetCollection
+type OrgSvcMockSetCollectionExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originCollection string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetCollection *mOrgSvcMockSetCollection) Optional() *mOrgSvcMockSetCollection {
+	mmSetCollection.optional = true
+	return mmSetCollection
+}
+
+// Expect sets up expected params for OrgSvc.SetCollection
+func (mmSetCollection *mOrgSvcMockSetCollection) Expect(ctx context.Context, collection org.Collection) *mOrgSvcMockSetCollection {
+	if mmSetCollection.mock.funcSetCollection != nil {
+		mmSetCollection.mock.t.Fatalf("OrgSvcMock.SetCollection mock is already set by Set")
+	}
+
+	if mmSetCollection.defaultExpectation == nil {
+		mmSetCollection.defaultExpectation = &OrgSvcMockSetCollectionExpectation{}
+	}
+
+	if mmSetCollection.defaultExpectation.paramPtrs != nil {
+		mmSetCollection.mock.t.Fatalf("OrgSvcMock.SetCollection mock is already set by ExpectParams functions")
+	}
+
+	mmSetCollection.defaultExpectation.params = &OrgSvcMockSetCollectionParams{ctx, collection}
+	mmSetCollection.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetCollection.expectations {
+		if minimock.Equal(e.params, mmSetCollection.defaultExpectation.params) {
+			mmSetCollection.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetCollection.defaultExpectation.params)
+		}
+	}
+
+	return mmSetCollection
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrgSvc.SetCollection
+func (mmSetCollection *mOrgSvcMockSetCollection) ExpectCtxParam1(ctx context.Context) *mOrgSvcMockSetCollection {
+	if mmSetCollection.mock.funcSetCollection != nil {
+		mmSetCollection.mock.t.Fatalf("OrgSvcMock.SetCollection mock is already set by Set")
+	}
+
+	if mmSetCollection.defaultExpectation == nil {
+		mmSetCollection.defaultExpectation = &OrgSvcMockSetCollectionExpectation{}
+	}
+
+	if mmSetCollection.defaultExpectation.params != nil {
+		mmSetCollection.mock.t.Fatalf("OrgSvcMock.SetCollection mock is already set by Expect")
+	}
+
+	if mmSetCollection.defaultExpectation.paramPtrs == nil {
+		mmSetCollection.defaultExpectation.paramPtrs = &OrgSvcMockSetCollectionParamPtrs{}
+	}
+	mmSetCollection.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetCollection.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetCollection
+}
+
+// ExpectCollectionParam2 sets up expected param collection for OrgSvc.SetCollection
+func (mmSetCollection *mOrgSvcMockSetCollection) ExpectCollectionParam2(collection org.Collection) *mOrgSvcMockSetCollection {
+	if mmSetCollection.mock.funcSetCollection != nil {
+		mmSetCollection.mock.t.Fatalf("OrgSvcMock.SetCollection mock is already set by Set")
+	}
+
+	if mmSetCollection.defaultExpectation == nil {
+		mmSetCollection.defaultExpectation = &OrgSvcMockSetCollectionExpectation{}
+	}
+
+	if mmSetCollection.defaultExpectation.params != nil {
+		mmSetCollection.mock.t.Fatalf("OrgSvcMock.SetCollection mock is already set by Expect")
+	}
+
+	if mmSetCollection.defaultExpectation.paramPtrs == nil {
+		mmSetCollection.defaultExpectation.paramPtrs = &OrgSvcMockSetCollectionParamPtrs{}
+	}
+	mmSetCollection.defaultExpectation.paramPtrs.collection = &collection
+	mmSetCollection.defaultExpectation.expectationOrigins.originCollection = minimock.CallerInfo(1)
+
+	return mmSetCollection
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrgSvc.SetCollection
+func (mmSetCollection *mOrgSvcMockSetCollection) Inspect(f func(ctx context.Context, collection org.Collection)) *mOrgSvcMockSetCollection {
+	if mmSetCollection.mock.inspectFuncSetCollection != nil {
+		mmSetCollection.mock.t.Fatalf("Inspect function is already set for OrgSvcMock.SetCollection")
+	}
+
+	mmSetCollection.mock.inspectFuncSetCollection = f
+
+	return mmSetCollection
+}
+
+// Return sets up results that will be returned by OrgSvc.SetCollection
+func (mmSetCollection *mOrgSvcMockSetCollection) Return(err error) *OrgSvcMock {
+	if mmSetCollection.mock.funcSetCollection != nil {
+		mmSetCollection.mock.t.Fatalf("OrgSvcMock.SetCollection mock is already set by Set")
+	}
+
+	if mmSetCollection.defaultExpectation == nil {
+		mmSetCollection.defaultExpectation = &OrgSvcMockSetCollectionExpectation{mock: mmSetCollection.mock}
+	}
+	mmSetCollection.defaultExpectation.results = &OrgSvcMockSetCollectionResults{err}
+	mmSetCollection.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetCollection.mock
+}
+
+// Set uses given function f to mock the OrgSvc.SetCollection method
+func (mmSetCollection *mOrgSvcMockSetCollection) Set(f func(ctx context.Context, collection org.Collection) (err error)) *OrgSvcMock {
+	if mmSetCollection.defaultExpectation != nil {
+		mmSetCollection.mock.t.Fatalf("Default expectation is already set for the OrgSvc.SetCollection method")
+	}
+
+	if len(mmSetCollection.expectations) > 0 {
+		mmSetCollection.mock.t.Fatalf("Some expectations are already set for the OrgSvc.SetCollection method")
+	}
+
+	mmSetCollection.mock.funcSetCollection = f
+	mmSetCollection.mock.funcSetCollectionOrigin = minimock.CallerInfo(1)
+	return mmSetCollection.mock
+}
+
+// When sets expectation for the OrgSvc.SetCollection which will trigger the result defined by the following
+// Then helper
+func (mmSetCollection *mOrgSvcMockSetCollection) When(ctx context.Context, collection org.Collection) *OrgSvcMockSetCollectionExpectation {
+	if mmSetCollection.mock.funcSetCollection != nil {
+		mmSetCollection.mock.t.Fatalf("OrgSvcMock.SetCollection mock is already set by Set")
+	}
+
+	expectation := &OrgSvcMockSetCollectionExpectation{
+		mock:               mmSetCollection.mock,
+		params:             &OrgSvcMockSetCollectionParams{ctx, collection},
+		expectationOrigins: OrgSvcMockSetCollectionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetCollection.expectations = append(mmSetCollection.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrgSvc.SetCollection return parameters for the expectation previously defined by the When method
+func (e *OrgSvcMockSetCollectionExpectation) Then(err error) *OrgSvcMock {
+	e.results = &OrgSvcMockSetCollectionResults{err}
+	return e.mock
+}
+
+// Times sets number of times OrgSvc.SetCollection should be invoked
+func (mmSetCollection *mOrgSvcMockSetCollection) Times(n uint64) *mOrgSvcMockSetCollection {
+	if n == 0 {
+		mmSetCollection.mock.t.Fatalf("Times of OrgSvcMock.SetCollection mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetCollection.expectedInvocations, n)
+	mmSetCollection.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetCollection
+}
+
+func (mmSetCollection *mOrgSvcMockSetCollection) invocationsDone() bool {
+	if len(mmSetCollection.expectations) == 0 && mmSetCollection.defaultExpectation == nil && mmSetCollection.mock.funcSetCollection == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetCollection.mock.afterSetCollectionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetCollection.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetCollection implements mm_handler.OrgSvc
+func (mmSetCollection *OrgSvcMock) SetCollection(ctx context.Context, collection org.Collection) (err error) {
+	mm_atomic.AddUint64(&mmSetCollection.beforeSetCollectionCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetCollection.afterSetCollectionCounter, 1)
+
+	mmSetCollection.t.Helper()
+
+	if mmSetCollection.inspectFuncSetCollection != nil {
+		mmSetCollection.inspectFuncSetCollection(ctx, collection)
+	}
+
+	mm_params := OrgSvcMockSetCollectionParams{ctx, collection}
+
+	// Record call args
+	mmSetCollection.SetCollectionMock.mutex.Lock()
+	mmSetCollection.SetCollectionMock.callArgs = append(mmSetCollection.SetCollectionMock.callArgs, &mm_params)
+	mmSetCollection.SetCollectionMock.mutex.Unlock()
+
+	for _, e := range mmSetCollection.SetCollectionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetCollection.SetCollectionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetCollection.SetCollectionMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetCollection.SetCollectionMock.defaultExpectation.params
+		mm_want_ptrs := mmSetCollection.SetCollectionMock.defaultExpectation.paramPtrs
+
+		mm_got := OrgSvcMockSetCollectionParams{ctx, collection}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetCollection.t.Errorf("OrgSvcMock.SetCollection got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetCollection.SetCollectionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.collection != nil && !minimock.Equal(*mm_want_ptrs.collection, mm_got.collection) {
+				mmSetCollection.t.Errorf("OrgSvcMock.SetCollection got unexpected parameter collection, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetCollection.SetCollectionMock.defaultExpectation.expectationOrigins.originCollection, *mm_want_ptrs.collection, mm_got.collection, minimock.Diff(*mm_want_ptrs.collection, mm_got.collection))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetCollection.t.Errorf("OrgSvcMock.SetCollection got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetCollection.SetCollectionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetCollection.SetCollectionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetCollection.t.Fatal("No results are set for the OrgSvcMock.SetCollection")
+		}
+		return (*mm_results).err
+	}
+	if mmSetCollection.funcSetCollection != nil {
+		return mmSetCollection.funcSetCollection(ctx, collection)
+	}
+	mmSetCollection.t.Fatalf("Unexpected call to OrgSvcMock.SetCollection. %v %v", ctx, collection)
+	return
+}
+
+// SetCollectionAfterCounter returns a count of finished OrgSvcMock.SetCollection invocations
+func (mmSetCollection *OrgSvcMock) SetCollectionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetCollection.afterSetCollectionCounter)
+}
+
+// SetCollectionBeforeCounter returns a count of OrgSvcMock.SetCollection invocations
+func (mmSetCollection *OrgSvcMock) SetCollectionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetCollection.beforeSetCollectionCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrgSvcMock.SetCollection.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetCollection *mOrgSvcMockSetCollection) Calls() []*OrgSvcMockSetCollectionParams {
+	mmSetCollection.mutex.RLock()
+
+	argCopy := make([]*OrgSvcMockSetCollectionParams, len(mmSetCollection.callArgs))
+	copy(argCopy, mmSetCollection.callArgs)
+
+	mmSetCollection.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetCollectionDone returns true if the count of the SetCollection invocations corresponds
+// the number of defined expectations
+func (m *OrgSvcMock) MinimockSetCollectionDone() bool {
+	if m.SetCollectionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetCollectionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetCollectionMock.invocationsDone()
+}
+
+// MinimockSetCollectionInspect logs each unmet expectation
+func (m *OrgSvcMock) MinimockSetCollectionInspect() {
+	for _, e := range m.SetCollectionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrgSvcMock.SetCollection at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetCollectionCounter := mm_atomic.LoadUint64(&m.afterSetCollectionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetCollectionMock.defaultExpectation != nil && afterSetCollectionCounter < 1 {
+		if m.SetCollectionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrgSvcMock.SetCollection at\n%s", m.SetCollectionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrgSvcMock.SetCollection at\n%s with params: %#v", m.SetCollectionMock.defaultExpectation.expectationOrigins.origin, *m.SetCollectionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetCollection != nil && afterSetCollectionCounter < 1 {
+		m.t.Errorf("Expected call to OrgSvcMock.SetCollection at\n%s", m.funcSetCollectionOrigin)
+	}
+
+	if !m.SetCollectionMock.invocationsDone() && afterSetCollectionCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrgSvcMock.SetCollection at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetCollectionMock.expectedInvocations), m.SetCollectionMock.expectedInvocationsOrigin, afterSetCollectionCounter)
+	}
+}
+
+type mOrgSvcMockSetGroup struct {
+	optional           bool
+	mock               *OrgSvcMock
+	defaultExpectation *OrgSvcMockSetGroupExpectation
+	expectations       []*OrgSvcMockSetGroupExpectation
+
+	callArgs []*OrgSvcMockSetGroupParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrgSvcMockSetGroupExpectation specifies expectation struct of the OrgSvc.SetGroup
+type OrgSvcMockSetGroupExpectation struct {
+	mock               *OrgSvcMock
+	params             *OrgSvcMockSetGroupParams
+	paramPtrs          *OrgSvcMockSetGroupParamPtrs
+	expectationOrigins OrgSvcMockSetGroupExpectationOrigins
+	results            *OrgSvcMockSetGroupResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrgSvcMockSetGroupParams contains parameters of the OrgSvc.SetGroup
+type OrgSvcMockSetGroupParams struct {
+	ctx   context.Context
+	group org.Group
+}
+
+// OrgSvcMockSetGroupParamPtrs contains pointers to parameters of the OrgSvc.SetGroup
+type OrgSvcMockSetGroupParamPtrs struct {
+	ctx   *context.Context
+	group *org.Group
+}
+
+// OrgSvcMockSetGroupResults contains results of the OrgSvc.SetGroup
+type OrgSvcMockSetGroupResults struct {
+	err error
+}
+
+// OrgSvcMockSetGroupOrigins contains origins of expectations of the OrgSvc.SetGroup
+type OrgSvcMockSetGroupExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originGroup string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetGroup *mOrgSvcMockSetGroup) Optional() *mOrgSvcMockSetGroup {
+	mmSetGroup.optional = true
+	return mmSetGroup
+}
+
+// Expect sets up expected params for OrgSvc.SetGroup
+func (mmSetGroup *mOrgSvcMockSetGroup) Expect(ctx context.Context, group org.Group) *mOrgSvcMockSetGroup {
+	if mmSetGroup.mock.funcSetGroup != nil {
+		mmSetGroup.mock.t.Fatalf("OrgSvcMock.SetGroup mock is already set by Set")
+	}
+
+	if mmSetGroup.defaultExpectation == nil {
+		mmSetGroup.defaultExpectation = &OrgSvcMockSetGroupExpectation{}
+	}
+
+	if mmSetGroup.defaultExpectation.paramPtrs != nil {
+		mmSetGroup.mock.t.Fatalf("OrgSvcMock.SetGroup mock is already set by ExpectParams functions")
+	}
+
+	mmSetGroup.defaultExpectation.params = &OrgSvcMockSetGroupParams{ctx, group}
+	mmSetGroup.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetGroup.expectations {
+		if minimock.Equal(e.params, mmSetGroup.defaultExpectation.params) {
+			mmSetGroup.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetGroup.defaultExpectation.params)
+		}
+	}
+
+	return mmSetGroup
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrgSvc.SetGroup
+func (mmSetGroup *mOrgSvcMockSetGroup) ExpectCtxParam1(ctx context.Context) *mOrgSvcMockSetGroup {
+	if mmSetGroup.mock.funcSetGroup != nil {
+		mmSetGroup.mock.t.Fatalf("OrgSvcMock.SetGroup mock is already set by Set")
+	}
+
+	if mmSetGroup.defaultExpectation == nil {
+		mmSetGroup.defaultExpectation = &OrgSvcMockSetGroupExpectation{}
+	}
+
+	if mmSetGroup.defaultExpectation.params != nil {
+		mmSetGroup.mock.t.Fatalf("OrgSvcMock.SetGroup mock is already set by Expect")
+	}
+
+	if mmSetGroup.defaultExpectation.paramPtrs == nil {
+		mmSetGroup.defaultExpectation.paramPtrs = &OrgSvcMockSetGroupParamPtrs{}
+	}
+	mmSetGroup.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetGroup.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetGroup
+}
+
+// ExpectGroupParam2 sets up expected param group for OrgSvc.SetGroup
+func (mmSetGroup *mOrgSvcMockSetGroup) ExpectGroupParam2(group org.Group) *mOrgSvcMockSetGroup {
+	if mmSetGroup.mock.funcSetGroup != nil {
+		mmSetGroup.mock.t.Fatalf("OrgSvcMock.SetGroup mock is already set by Set")
+	}
+
+	if mmSetGroup.defaultExpectation == nil {
+		mmSetGroup.defaultExpectation = &OrgSvcMockSetGroupExpectation{}
+	}
+
+	if mmSetGroup.defaultExpectation.params != nil {
+		mmSetGroup.mock.t.Fatalf("OrgSvcMock.SetGroup mock is already set by Expect")
+	}
+
+	if mmSetGroup.defaultExpectation.paramPtrs == nil {
+		mmSetGroup.defaultExpectation.paramPtrs = &OrgSvcMockSetGroupParamPtrs{}
+	}
+	mmSetGroup.defaultExpectation.paramPtrs.group = &group
+	mmSetGroup.defaultExpectation.expectationOrigins.originGroup = minimock.CallerInfo(1)
+
+	return mmSetGroup
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrgSvc.SetGroup
+func (mmSetGroup *mOrgSvcMockSetGroup) Inspect(f func(ctx context.Context, group org.Group)) *mOrgSvcMockSetGroup {
+	if mmSetGroup.mock.inspectFuncSetGroup != nil {
+		mmSetGroup.mock.t.Fatalf("Inspect function is already set for OrgSvcMock.SetGroup")
+	}
+
+	mmSetGroup.mock.inspectFuncSetGroup = f
+
+	return mmSetGroup
+}
+
+// Return sets up results that will be returned by OrgSvc.SetGroup
+func (mmSetGroup *mOrgSvcMockSetGroup) Return(err error) *OrgSvcMock {
+	if mmSetGroup.mock.funcSetGroup != nil {
+		mmSetGroup.mock.t.Fatalf("OrgSvcMock.SetGroup mock is already set by Set")
+	}
+
+	if mmSetGroup.defaultExpectation == nil {
+		mmSetGroup.defaultExpectation = &OrgSvcMockSetGroupExpectation{mock: mmSetGroup.mock}
+	}
+	mmSetGroup.defaultExpectation.results = &OrgSvcMockSetGroupResults{err}
+	mmSetGroup.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetGroup.mock
+}
+
+// Set uses given function f to mock the OrgSvc.SetGroup method
+func (mmSetGroup *mOrgSvcMockSetGroup) Set(f func(ctx context.Context, group org.Group) (err error)) *OrgSvcMock {
+	if mmSetGroup.defaultExpectation != nil {
+		mmSetGroup.mock.t.Fatalf("Default expectation is already set for the OrgSvc.SetGroup method")
+	}
+
+	if len(mmSetGroup.expectations) > 0 {
+		mmSetGroup.mock.t.Fatalf("Some expectations are already set for the OrgSvc.SetGroup method")
+	}
+
+	mmSetGroup.mock.funcSetGroup = f
+	mmSetGroup.mock.funcSetGroupOrigin = minimock.CallerInfo(1)
+	return mmSetGroup.mock
+}
+
+// When sets expectation for the OrgSvc.SetGroup which will trigger the result defined by the following
+// Then helper
+func (mmSetGroup *mOrgSvcMockSetGroup) When(ctx context.Context, group org.Group) *OrgSvcMockSetGroupExpectation {
+	if mmSetGroup.mock.funcSetGroup != nil {
+		mmSetGroup.mock.t.Fatalf("OrgSvcMock.SetGroup mock is already set by Set")
+	}
+
+	expectation := &OrgSvcMockSetGroupExpectation{
+		mock:               mmSetGroup.mock,
+		params:             &OrgSvcMockSetGroupParams{ctx, group},
+		expectationOrigins: OrgSvcMockSetGroupExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetGroup.expectations = append(mmSetGroup.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrgSvc.SetGroup return parameters for the expectation previously defined by the When method
+func (e *OrgSvcMockSetGroupExpectation) Then(err error) *OrgSvcMock {
+	e.results = &OrgSvcMockSetGroupResults{err}
+	return e.mock
+}
+
+// Times sets number of times OrgSvc.SetGroup should be invoked
+func (mmSetGroup *mOrgSvcMockSetGroup) Times(n uint64) *mOrgSvcMockSetGroup {
+	if n == 0 {
+		mmSetGroup.mock.t.Fatalf("Times of OrgSvcMock.SetGroup mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetGroup.expectedInvocations, n)
+	mmSetGroup.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetGroup
+}
+
+func (mmSetGroup *mOrgSvcMockSetGroup) invocationsDone() bool {
+	if len(mmSetGroup.expectations) == 0 && mmSetGroup.defaultExpectation == nil && mmSetGroup.mock.funcSetGroup == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetGroup.mock.afterSetGroupCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetGroup.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetGroup implements mm_handler.OrgSvc
+func (mmSetGroup *OrgSvcMock) SetGroup(ctx context.Context, group org.Group) (err error) {
+	mm_atomic.AddUint64(&mmSetGroup.beforeSetGroupCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetGroup.afterSetGroupCounter, 1)
+
+	mmSetGroup.t.Helper()
+
+	if mmSetGroup.inspectFuncSetGroup != nil {
+		mmSetGroup.inspectFuncSetGroup(ctx, group)
+	}
+
+	mm_params := OrgSvcMockSetGroupParams{ctx, group}
+
+	// Record call args
+	mmSetGroup.SetGroupMock.mutex.Lock()
+	mmSetGroup.SetGroupMock.callArgs = append(mmSetGroup.SetGroupMock.callArgs, &mm_params)
+	mmSetGroup.SetGroupMock.mutex.Unlock()
+
+	for _, e := range mmSetGroup.SetGroupMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetGroup.SetGroupMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetGroup.SetGroupMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetGroup.SetGroupMock.defaultExpectation.params
+		mm_want_ptrs := mmSetGroup.SetGroupMock.defaultExpectation.paramPtrs
+
+		mm_got := OrgSvcMockSetGroupParams{ctx, group}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetGroup.t.Errorf("OrgSvcMock.SetGroup got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetGroup.SetGroupMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.group != nil && !minimock.Equal(*mm_want_ptrs.group, mm_got.group) {
+				mmSetGroup.t.Errorf("OrgSvcMock.SetGroup got unexpected parameter group, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetGroup.SetGroupMock.defaultExpectation.expectationOrigins.originGroup, *mm_want_ptrs.group, mm_got.group, minimock.Diff(*mm_want_ptrs.group, mm_got.group))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetGroup.t.Errorf("OrgSvcMock.SetGroup got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetGroup.SetGroupMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetGroup.SetGroupMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetGroup.t.Fatal("No results are set for the OrgSvcMock.SetGroup")
+		}
+		return (*mm_results).err
+	}
+	if mmSetGroup.funcSetGroup != nil {
+		return mmSetGroup.funcSetGroup(ctx, group)
+	}
+	mmSetGroup.t.Fatalf("Unexpected call to OrgSvcMock.SetGroup. %v %v", ctx, group)
+	return
+}
+
+// SetGroupAfterCounter returns a count of finished OrgSvcMock.SetGroup invocations
+func (mmSetGroup *OrgSvcMock) SetGroupAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetGroup.afterSetGroupCounter)
+}
+
+// SetGroupBeforeCounter returns a count of OrgSvcMock.SetGroup invocations
+func (mmSetGroup *OrgSvcMock) SetGroupBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetGroup.beforeSetGroupCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrgSvcMock.SetGroup.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetGroup *mOrgSvcMockSetGroup) Calls() []*OrgSvcMockSetGroupParams {
+	mmSetGroup.mutex.RLock()
+
+	argCopy := make([]*OrgSvcMockSetGroupParams, len(mmSetGroup.callArgs))
+	copy(argCopy, mmSetGroup.callArgs)
+
+	mmSetGroup.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetGroupDone returns true if the count of the SetGroup invocations corresponds
+// the number of defined expectations
+func (m *OrgSvcMock) MinimockSetGroupDone() bool {
+	if m.SetGroupMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetGroupMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetGroupMock.invocationsDone()
+}
+
+// MinimockSetGroupInspect logs each unmet expectation
+func (m *OrgSvcMock) MinimockSetGroupInspect() {
+	for _, e := range m.SetGroupMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrgSvcMock.SetGroup at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetGroupCounter := mm_atomic.LoadUint64(&m.afterSetGroupCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetGroupMock.defaultExpectation != nil && afterSetGroupCounter < 1 {
+		if m.SetGroupMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrgSvcMock.SetGroup at\n%s", m.SetGroupMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrgSvcMock.SetGroup at\n%s with params: %#v", m.SetGroupMock.defaultExpectation.expectationOrigins.origin, *m.SetGroupMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetGroup != nil && afterSetGroupCounter < 1 {
+		m.t.Errorf("Expected call to OrgSvcMock.SetGroup at\n%s", m.funcSetGroupOrigin)
+	}
+
+	if !m.SetGroupMock.invocationsDone() && afterSetGroupCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrgSvcMock.SetGroup at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetGroupMock.expectedInvocations), m.SetGroupMock.expectedInvocationsOrigin, afterSetGroupCounter)
+	}
+}
+
+type mOrgSvcMockSetMember struct {
+	optional           bool
+	mock               *OrgSvcMock
+	defaultExpectation *OrgSvcMockSetMemberExpectation
+	expectations       []*OrgSvcMockSetMemberExpectation
+
+	callArgs []*OrgSvcMockSetMemberParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrgSvcMockSetMemberExpectation specifies expectation struct of the OrgSvc.SetMember
+type OrgSvcMockSetMemberExpectation struct {
+	mock               *OrgSvcMock
+	params             *OrgSvcMockSetMemberParams
+	paramPtrs          *OrgSvcMockSetMemberParamPtrs
+	expectationOrigins OrgSvcMockSetMemberExpectationOrigins
+	results            *OrgSvcMockSetMemberResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrgSvcMockSetMemberParams contains parameters of the OrgSvc.SetMember
+type OrgSvcMockSetMemberParams struct {
+	ctx    context.Context
+	actor  string
+	member org.Member
+}
+
+// OrgSvcMockSetMemberParamPtrs contains pointers to parameters of the OrgSvc.SetMember
+type OrgSvcMockSetMemberParamPtrs struct {
+	ctx    *context.Context
+	actor  *string
+	member *org.Member
+}
+
+// OrgSvcMockSetMemberResults contains results of the OrgSvc.SetMember
+type OrgSvcMockSetMemberResults struct {
+	err error
+}
+
+// OrgSvcMockSetMemberOrigins contains origins of expectations of the OrgSvc.SetMember
+type OrgSvcMockSetMemberExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originActor  string
+	originMember string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSetMember *mOrgSvcMockSetMember) Optional() *mOrgSvcMockSetMember {
+	mmSetMember.optional = true
+	return mmSetMember
+}
+
+// Expect sets up expected params for OrgSvc.SetMember
+func (mmSetMember *mOrgSvcMockSetMember) Expect(ctx context.Context, actor string, member org.Member) *mOrgSvcMockSetMember {
+	if mmSetMember.mock.funcSetMember != nil {
+		mmSetMember.mock.t.Fatalf("OrgSvcMock.SetMember mock is already set by Set")
+	}
+
+	if mmSetMember.defaultExpectation == nil {
+		mmSetMember.defaultExpectation = &OrgSvcMockSetMemberExpectation{}
+	}
+
+	if mmSetMember.defaultExpectation.paramPtrs != nil {
+		mmSetMember.mock.t.Fatalf("OrgSvcMock.SetMember mock is already set by ExpectParams functions")
+	}
+
+	mmSetMember.defaultExpectation.params = &OrgSvcMockSetMemberParams{ctx, actor, member}
+	mmSetMember.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetMember.expectations {
+		if minimock.Equal(e.params, mmSetMember.defaultExpectation.params) {
+			mmSetMember.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetMember.defaultExpectation.params)
+		}
+	}
+
+	return mmSetMember
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrgSvc.SetMember
+func (mmSetMember *mOrgSvcMockSetMember) ExpectCtxParam1(ctx context.Context) *mOrgSvcMockSetMember {
+	if mmSetMember.mock.funcSetMember != nil {
+		mmSetMember.mock.t.Fatalf("OrgSvcMock.SetMember mock is already set by Set")
+	}
+
+	if mmSetMember.defaultExpectation == nil {
+		mmSetMember.defaultExpectation = &OrgSvcMockSetMemberExpectation{}
+	}
+
+	if mmSetMember.defaultExpectation.params != nil {
+		mmSetMember.mock.t.Fatalf("OrgSvcMock.SetMember mock is already set by Expect")
+	}
+
+	if mmSetMember.defaultExpectation.paramPtrs == nil {
+		mmSetMember.defaultExpectation.paramPtrs = &OrgSvcMockSetMemberParamPtrs{}
+	}
+	mmSetMember.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetMember.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetMember
+}
+
+// ExpectActorParam2 sets up expected param actor for OrgSvc.SetMember
+func (mmSetMember *mOrgSvcMockSetMember) ExpectActorParam2(actor string) *mOrgSvcMockSetMember {
+	if mmSetMember.mock.funcSetMember != nil {
+		mmSetMember.mock.t.Fatalf("OrgSvcMock.SetMember mock is already set by Set")
+	}
+
+	if mmSetMember.defaultExpectation == nil {
+		mmSetMember.defaultExpectation = &OrgSvcMockSetMemberExpectation{}
+	}
+
+	if mmSetMember.defaultExpectation.params != nil {
+		mmSetMember.mock.t.Fatalf("OrgSvcMock.SetMember mock is already set by Expect")
+	}
+
+	if mmSetMember.defaultExpectation.paramPtrs == nil {
+		mmSetMember.defaultExpectation.paramPtrs = &OrgSvcMockSetMemberParamPtrs{}
+	}
+	mmSetMember.defaultExpectation.paramPtrs.actor = &actor
+	mmSetMember.defaultExpectation.expectationOrigins.originActor = minimock.CallerInfo(1)
+
+	return mmSetMember
+}
+
+// ExpectMemberParam3 sets up expected param member for OrgSvc.SetMember
+func (mmSetMember *mOrgSvcMockSetMember) ExpectMemberParam3(member org.Member) *mOrgSvcMockSetMember {
+	if mmSetMember.mock.funcSetMember != nil {
+		mmSetMember.mock.t.Fatalf("OrgSvcMock.SetMember mock is already set by Set")
+	}
+
+	if mmSetMember.defaultExpectation == nil {
+		mmSetMember.defaultExpectation = &OrgSvcMockSetMemberExpectation{}
+	}
+
+	if mmSetMember.defaultExpectation.params != nil {
+		mmSetMember.mock.t.Fatalf("OrgSvcMock.SetMember mock is already set by Expect")
+	}
+
+	if mmSetMember.defaultExpectation.paramPtrs == nil {
+		mmSetMember.defaultExpectation.paramPtrs = &OrgSvcMockSetMemberParamPtrs{}
+	}
+	mmSetMember.defaultExpectation.paramPtrs.member = &member
+	mmSetMember.defaultExpectation.expectationOrigins.originMember = minimock.CallerInfo(1)
+
+	return mmSetMember
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrgSvc.SetMember
+func (mmSetMember *mOrgSvcMockSetMember) Inspect(f func(ctx context.Context, actor string, member org.Member)) *mOrgSvcMockSetMember {
+	if mmSetMember.mock.inspectFuncSetMember != nil {
+		mmSetMember.mock.t.Fatalf("Inspect function is already set for OrgSvcMock.SetMember")
+	}
+
+	mmSetMember.mock.inspectFuncSetMember = f
+
+	return mmSetMember
+}
+
+// Return sets up results that will be returned by OrgSvc.SetMember
+func (mmSetMember *mOrgSvcMockSetMember) Return(err error) *OrgSvcMock {
+	if mmSetMember.mock.funcSetMember != nil {
+		mmSetMember.mock.t.Fatalf("OrgSvcMock.SetMember mock is already set by Set")
+	}
+
+	if mmSetMember.defaultExpectation == nil {
+		mmSetMember.defaultExpectation = &OrgSvcMockSetMemberExpectation{mock: mmSetMember.mock}
+	}
+	mmSetMember.defaultExpectation.results = &OrgSvcMockSetMemberResults{err}
+	mmSetMember.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetMember.mock
+}
+
+// Set uses given function f to mock the OrgSvc.SetMember method
+func (mmSetMember *mOrgSvcMockSetMember) Set(f func(ctx context.Context, actor string, member org.Member) (err error)) *OrgSvcMock {
+	if mmSetMember.defaultExpectation != nil {
+		mmSetMember.mock.t.Fatalf("Default expectation is already set for the OrgSvc.SetMember method")
+	}
+
+	if len(mmSetMember.expectations) > 0 {
+		mmSetMember.mock.t.Fatalf("Some expectations are already set for the OrgSvc.SetMember method")
+	}
+
+	mmSetMember.mock.funcSetMember = f
+	mmSetMember.mock.funcSetMemberOrigin = minimock.CallerInfo(1)
+	return mmSetMember.mock
+}
+
+// When sets expectation for the OrgSvc.SetMember which will trigger the result defined by the following
+// Then helper
+func (mmSetMember *mOrgSvcMockSetMember) When(ctx context.Context, actor string, member org.Member) *OrgSvcMockSetMemberExpectation {
+	if mmSetMember.mock.funcSetMember != nil {
+		mmSetMember.mock.t.Fatalf("OrgSvcMock.SetMember mock is already set by Set")
+	}
+
+	expectation := &OrgSvcMockSetMemberExpectation{
+		mock:               mmSetMember.mock,
+		params:             &OrgSvcMockSetMemberParams{ctx, actor, member},
+		expectationOrigins: OrgSvcMockSetMemberExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSetMember.expectations = append(mmSetMember.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrgSvc.SetMember return parameters for the expectation previously defined by the When method
+func (e *OrgSvcMockSetMemberExpectation) Then(err error) *OrgSvcMock {
+	e.results = &OrgSvcMockSetMemberResults{err}
+	return e.mock
+}
+
+// Times sets number of times OrgSvc.SetMember should be invoked
+func (mmSetMember *mOrgSvcMockSetMember) Times(n uint64) *mOrgSvcMockSetMember {
+	if n == 0 {
+		mmSetMember.mock.t.Fatalf("Times of OrgSvcMock.SetMember mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSetMember.expectedInvocations, n)
+	mmSetMember.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetMember
+}
+
+func (mmSetMember *mOrgSvcMockSetMember) invocationsDone() bool {
+	if len(mmSetMember.expectations) == 0 && mmSetMember.defaultExpectation == nil && mmSetMember.mock.funcSetMember == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSetMember.mock.afterSetMemberCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetMember.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SetMember implements mm_handler.OrgSvc
+func (mmSetMember *OrgSvcMock) SetMember(ctx context.Context, actor string, member org.Member) (err error) {
+	mm_atomic.AddUint64(&mmSetMember.beforeSetMemberCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetMember.afterSetMemberCounter, 1)
+
+	mmSetMember.t.Helper()
+
+	if mmSetMember.inspectFuncSetMember != nil {
+		mmSetMember.inspectFuncSetMember(ctx, actor, member)
+	}
+
+	mm_params := OrgSvcMockSetMemberParams{ctx, actor, member}
+
+	// Record call args
+	mmSetMember.SetMemberMock.mutex.Lock()
+	mmSetMember.SetMemberMock.callArgs = append(mmSetMember.SetMemberMock.callArgs, &mm_params)
+	mmSetMember.SetMemberMock.mutex.Unlock()
+
+	for _, e := range mmSetMember.SetMemberMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSetMember.SetMemberMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetMember.SetMemberMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetMember.SetMemberMock.defaultExpectation.params
+		mm_want_ptrs := mmSetMember.SetMemberMock.defaultExpectation.paramPtrs
+
+		mm_got := OrgSvcMockSetMemberParams{ctx, actor, member}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetMember.t.Errorf("OrgSvcMock.SetMember got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMember.SetMemberMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.actor != nil && !minimock.Equal(*mm_want_ptrs.actor, mm_got.actor) {
+				mmSetMember.t.Errorf("OrgSvcMock.SetMember got unexpected parameter actor, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMember.SetMemberMock.defaultExpectation.expectationOrigins.originActor, *mm_want_ptrs.actor, mm_got.actor, minimock.Diff(*mm_want_ptrs.actor, mm_got.actor))
+			}
+
+			if mm_want_ptrs.member != nil && !minimock.Equal(*mm_want_ptrs.member, mm_got.member) {
+				mmSetMember.t.Errorf("OrgSvcMock.SetMember got unexpected parameter member, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetMember.SetMemberMock.defaultExpectation.expectationOrigins.originMember, *mm_want_ptrs.member, mm_got.member, minimock.Diff(*mm_want_ptrs.member, mm_got.member))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSetMember.t.Errorf("OrgSvcMock.SetMember got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetMember.SetMemberMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSetMember.SetMemberMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSetMember.t.Fatal("No results are set for the OrgSvcMock.SetMember")
+		}
+		return (*mm_results).err
+	}
+	if mmSetMember.funcSetMember != nil {
+		return mmSetMember.funcSetMember(ctx, actor, member)
+	}
+	mmSetMember.t.Fatalf("Unexpected call to OrgSvcMock.SetMember. %v %v %v", ctx, actor, member)
+	return
+}
+
+// SetMemberAfterCounter returns a count of finished OrgSvcMock.SetMember invocations
+func (mmSetMember *OrgSvcMock) SetMemberAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetMember.afterSetMemberCounter)
+}
+
+// SetMemberBeforeCounter returns a count of OrgSvcMock.SetMember invocations
+func (mmSetMember *OrgSvcMock) SetMemberBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetMember.beforeSetMemberCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrgSvcMock.SetMember.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSetMember *mOrgSvcMockSetMember) Calls() []*OrgSvcMockSetMemberParams {
+	mmSetMember.mutex.RLock()
+
+	argCopy := make([]*OrgSvcMockSetMemberParams, len(mmSetMember.callArgs))
+	copy(argCopy, mmSetMember.callArgs)
+
+	mmSetMember.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSetMemberDone returns true if the count of the SetMember invocations corresponds
+// the number of defined expectations
+func (m *OrgSvcMock) MinimockSetMemberDone() bool {
+	if m.SetMemberMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SetMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SetMemberMock.invocationsDone()
+}
+
+// MinimockSetMemberInspect logs each unmet expectation
+func (m *OrgSvcMock) MinimockSetMemberInspect() {
+	for _, e := range m.SetMemberMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrgSvcMock.SetMember at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSetMemberCounter := mm_atomic.LoadUint64(&m.afterSetMemberCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SetMemberMock.defaultExpectation != nil && afterSetMemberCounter < 1 {
+		if m.SetMemberMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrgSvcMock.SetMember at\n%s", m.SetMemberMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrgSvcMock.SetMember at\n%s with params: %#v", m.SetMemberMock.defaultExpectation.expectationOrigins.origin, *m.SetMemberMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSetMember != nil && afterSetMemberCounter < 1 {
+		m.t.Errorf("Expected call to OrgSvcMock.SetMember at\n%s", m.funcSetMemberOrigin)
+	}
+
+	if !m.SetMemberMock.invocationsDone() && afterSetMemberCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrgSvcMock.SetMember at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetMemberMock.expectedInvocations), m.SetMemberMock.expectedInvocationsOrigin, afterSetMemberCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *OrgSvcMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockAddCollectionCardInspect()
+
+			m.MinimockCreateOrgInspect()
+
+			m.MinimockGetOrgCardsInspect()
+
+			m.MinimockGetUserOrgsInspect()
+
+			m.MinimockRemoveMemberInspect()
+
+			m.MinimockSetCollectionInspect()
+
+			m.MinimockSetGroupInspect()
+
+			m.MinimockSetMemberInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *OrgSvcMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *OrgSvcMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockAddCollectionCardDone() &&
+		m.MinimockCreateOrgDone() &&
+		m.MinimockGetOrgCardsDone() &&
+		m.MinimockGetUserOrgsDone() &&
+		m.MinimockRemoveMemberDone() &&
+		m.MinimockSetCollectionDone() &&
+		m.MinimockSetGroupDone() &&
+		m.MinimockSetMemberDone()
+}
@@ -10,7 +10,9 @@ import (
 	mm_atomic "sync/atomic"
 	mm_time "time"
 
+	"github.com/gleb-korostelev/GophKeeper/models/apikey"
 	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
 	"github.com/gojuno/minimock/v3"
 )
 
@@ -19,23 +21,79 @@ type ProfileSvcMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcDeleteCard          func(ctx context.Context, username string, cardNumber string) (err error)
+	funcCreateFolder          func(ctx context.Context, folder profile.Folder) (id int64, err error)
+	funcCreateFolderOrigin    string
+	inspectFuncCreateFolder   func(ctx context.Context, folder profile.Folder)
+	afterCreateFolderCounter  uint64
+	beforeCreateFolderCounter uint64
+	CreateFolderMock          mProfileSvcMockCreateFolder
+
+	funcDeleteCard          func(ctx context.Context, username string, cardNumber string, scope *apikey.Scope) (err error)
 	funcDeleteCardOrigin    string
-	inspectFuncDeleteCard   func(ctx context.Context, username string, cardNumber string)
+	inspectFuncDeleteCard   func(ctx context.Context, username string, cardNumber string, scope *apikey.Scope)
 	afterDeleteCardCounter  uint64
 	beforeDeleteCardCounter uint64
 	DeleteCardMock          mProfileSvcMockDeleteCard
 
-	funcGetUserCards          func(ctx context.Context, username string) (ca1 []profile.CardInfo, err error)
+	funcDeleteFolder          func(ctx context.Context, username string, id int64) (err error)
+	funcDeleteFolderOrigin    string
+	inspectFuncDeleteFolder   func(ctx context.Context, username string, id int64)
+	afterDeleteFolderCounter  uint64
+	beforeDeleteFolderCounter uint64
+	DeleteFolderMock          mProfileSvcMockDeleteFolder
+
+	funcDeleteTag          func(ctx context.Context, username string, name string) (err error)
+	funcDeleteTagOrigin    string
+	inspectFuncDeleteTag   func(ctx context.Context, username string, name string)
+	afterDeleteTagCounter  uint64
+	beforeDeleteTagCounter uint64
+	DeleteTagMock          mProfileSvcMockDeleteTag
+
+	funcGetFolders          func(ctx context.Context, username string) (fa1 []profile.Folder, err error)
+	funcGetFoldersOrigin    string
+	inspectFuncGetFolders   func(ctx context.Context, username string)
+	afterGetFoldersCounter  uint64
+	beforeGetFoldersCounter uint64
+	GetFoldersMock          mProfileSvcMockGetFolders
+
+	funcGetTags          func(ctx context.Context, username string) (ta1 []profile.Tag, err error)
+	funcGetTagsOrigin    string
+	inspectFuncGetTags   func(ctx context.Context, username string)
+	afterGetTagsCounter  uint64
+	beforeGetTagsCounter uint64
+	GetTagsMock          mProfileSvcMockGetTags
+
+	funcGetUserCards          func(ctx context.Context, username string, filter profile.Filter, page pagination.Request) (cards []profile.CardInfo, next string, err error)
 	funcGetUserCardsOrigin    string
-	inspectFuncGetUserCards   func(ctx context.Context, username string)
+	inspectFuncGetUserCards   func(ctx context.Context, username string, filter profile.Filter, page pagination.Request)
 	afterGetUserCardsCounter  uint64
 	beforeGetUserCardsCounter uint64
 	GetUserCardsMock          mProfileSvcMockGetUserCards
 
-	funcUploadInfo          func(ctx context.Context, profile profile.CardInfo) (err error)
+	funcRevokeShare          func(ctx context.Context, owner string, grantee string, cardNumber string) (err error)
+	funcRevokeShareOrigin    string
+	inspectFuncRevokeShare   func(ctx context.Context, owner string, grantee string, cardNumber string)
+	afterRevokeShareCounter  uint64
+	beforeRevokeShareCounter uint64
+	RevokeShareMock          mProfileSvcMockRevokeShare
+
+	funcSearch          func(ctx context.Context, username string, text string, page pagination.Request) (cards []profile.CardInfo, next string, err error)
+	funcSearchOrigin    string
+	inspectFuncSearch   func(ctx context.Context, username string, text string, page pagination.Request)
+	afterSearchCounter  uint64
+	beforeSearchCounter uint64
+	SearchMock          mProfileSvcMockSearch
+
+	funcShareCard          func(ctx context.Context, share profile.CardShare) (err error)
+	funcShareCardOrigin    string
+	inspectFuncShareCard   func(ctx context.Context, share profile.CardShare)
+	afterShareCardCounter  uint64
+	beforeShareCardCounter uint64
+	ShareCardMock          mProfileSvcMockShareCard
+
+	funcUploadInfo          func(ctx context.Context, profile profile.CardInfo, scope *apikey.Scope) (err error)
 	funcUploadInfoOrigin    string
-	inspectFuncUploadInfo   func(ctx context.Context, profile profile.CardInfo)
+	inspectFuncUploadInfo   func(ctx context.Context, profile profile.CardInfo, scope *apikey.Scope)
 	afterUploadInfoCounter  uint64
 	beforeUploadInfoCounter uint64
 	UploadInfoMock          mProfileSvcMockUploadInfo
@@ -49,12 +107,36 @@ func NewProfileSvcMock(t minimock.Tester) *ProfileSvcMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.CreateFolderMock = mProfileSvcMockCreateFolder{mock: m}
+	m.CreateFolderMock.callArgs = []*ProfileSvcMockCreateFolderParams{}
+
 	m.DeleteCardMock = mProfileSvcMockDeleteCard{mock: m}
 	m.DeleteCardMock.callArgs = []*ProfileSvcMockDeleteCardParams{}
 
+	m.DeleteFolderMock = mProfileSvcMockDeleteFolder{mock: m}
+	m.DeleteFolderMock.callArgs = []*ProfileSvcMockDeleteFolderParams{}
+
+	m.DeleteTagMock = mProfileSvcMockDeleteTag{mock: m}
+	m.DeleteTagMock.callArgs = []*ProfileSvcMockDeleteTagParams{}
+
+	m.GetFoldersMock = mProfileSvcMockGetFolders{mock: m}
+	m.GetFoldersMock.callArgs = []*ProfileSvcMockGetFoldersParams{}
+
+	m.GetTagsMock = mProfileSvcMockGetTags{mock: m}
+	m.GetTagsMock.callArgs = []*ProfileSvcMockGetTagsParams{}
+
 	m.GetUserCardsMock = mProfileSvcMockGetUserCards{mock: m}
 	m.GetUserCardsMock.callArgs = []*ProfileSvcMockGetUserCardsParams{}
 
+	m.RevokeShareMock = mProfileSvcMockRevokeShare{mock: m}
+	m.RevokeShareMock.callArgs = []*ProfileSvcMockRevokeShareParams{}
+
+	m.SearchMock = mProfileSvcMockSearch{mock: m}
+	m.SearchMock.callArgs = []*ProfileSvcMockSearchParams{}
+
+	m.ShareCardMock = mProfileSvcMockShareCard{mock: m}
+	m.ShareCardMock.callArgs = []*ProfileSvcMockShareCardParams{}
+
 	m.UploadInfoMock = mProfileSvcMockUploadInfo{mock: m}
 	m.UploadInfoMock.callArgs = []*ProfileSvcMockUploadInfoParams{}
 
@@ -63,6 +145,349 @@ func NewProfileSvcMock(t minimock.Tester) *ProfileSvcMock {
 	return m
 }
 
+type mProfileSvcMockCreateFolder struct {
+	optional           bool
+	mock               *ProfileSvcMock
+	defaultExpectation *ProfileSvcMockCreateFolderExpectation
+	expectations       []*ProfileSvcMockCreateFolderExpectation
+
+	callArgs []*ProfileSvcMockCreateFolderParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ProfileSvcMockCreateFolderExpectation specifies expectation struct of the ProfileSvc.CreateFolder
+type ProfileSvcMockCreateFolderExpectation struct {
+	mock               *ProfileSvcMock
+	params             *ProfileSvcMockCreateFolderParams
+	paramPtrs          *ProfileSvcMockCreateFolderParamPtrs
+	expectationOrigins ProfileSvcMockCreateFolderExpectationOrigins
+	results            *ProfileSvcMockCreateFolderResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ProfileSvcMockCreateFolderParams contains parameters of the ProfileSvc.CreateFolder
+type ProfileSvcMockCreateFolderParams struct {
+	ctx    context.Context
+	folder profile.Folder
+}
+
+// ProfileSvcMockCreateFolderParamPtrs contains pointers to parameters of the ProfileSvc.CreateFolder
+type ProfileSvcMockCreateFolderParamPtrs struct {
+	ctx    *context.Context
+	folder *profile.Folder
+}
+
+// ProfileSvcMockCreateFolderResults contains results of the ProfileSvc.CreateFolder
+type ProfileSvcMockCreateFolderResults struct {
+	id  int64
+	err error
+}
+
+// ProfileSvcMockCreateFolderOrigins contains origins of expectations of the ProfileSvc.CreateFolder
+type ProfileSvcMockCreateFolderExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFolder string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateFolder *mProfileSvcMockCreateFolder) Optional() *mProfileSvcMockCreateFolder {
+	mmCreateFolder.optional = true
+	return mmCreateFolder
+}
+
+// Expect sets up expected params for ProfileSvc.CreateFolder
+func (mmCreateFolder *mProfileSvcMockCreateFolder) Expect(ctx context.Context, folder profile.Folder) *mProfileSvcMockCreateFolder {
+	if mmCreateFolder.mock.funcCreateFolder != nil {
+		mmCreateFolder.mock.t.Fatalf("ProfileSvcMock.CreateFolder mock is already set by Set")
+	}
+
+	if mmCreateFolder.defaultExpectation == nil {
+		mmCreateFolder.defaultExpectation = &ProfileSvcMockCreateFolderExpectation{}
+	}
+
+	if mmCreateFolder.defaultExpectation.paramPtrs != nil {
+		mmCreateFolder.mock.t.Fatalf("ProfileSvcMock.CreateFolder mock is already set by ExpectParams functions")
+	}
+
+	mmCreateFolder.defaultExpectation.params = &ProfileSvcMockCreateFolderParams{ctx, folder}
+	mmCreateFolder.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateFolder.expectations {
+		if minimock.Equal(e.params, mmCreateFolder.defaultExpectation.params) {
+			mmCreateFolder.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateFolder.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateFolder
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ProfileSvc.CreateFolder
+func (mmCreateFolder *mProfileSvcMockCreateFolder) ExpectCtxParam1(ctx context.Context) *mProfileSvcMockCreateFolder {
+	if mmCreateFolder.mock.funcCreateFolder != nil {
+		mmCreateFolder.mock.t.Fatalf("ProfileSvcMock.CreateFolder mock is already set by Set")
+	}
+
+	if mmCreateFolder.defaultExpectation == nil {
+		mmCreateFolder.defaultExpectation = &ProfileSvcMockCreateFolderExpectation{}
+	}
+
+	if mmCreateFolder.defaultExpectation.params != nil {
+		mmCreateFolder.mock.t.Fatalf("ProfileSvcMock.CreateFolder mock is already set by Expect")
+	}
+
+	if mmCreateFolder.defaultExpectation.paramPtrs == nil {
+		mmCreateFolder.defaultExpectation.paramPtrs = &ProfileSvcMockCreateFolderParamPtrs{}
+	}
+	mmCreateFolder.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateFolder.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateFolder
+}
+
+// ExpectFolderParam2 sets up expected param folder for ProfileSvc.CreateFolder
+func (mmCreateFolder *mProfileSvcMockCreateFolder) ExpectFolderParam2(folder profile.Folder) *mProfileSvcMockCreateFolder {
+	if mmCreateFolder.mock.funcCreateFolder != nil {
+		mmCreateFolder.mock.t.Fatalf("ProfileSvcMock.CreateFolder mock is already set by Set")
+	}
+
+	if mmCreateFolder.defaultExpectation == nil {
+		mmCreateFolder.defaultExpectation = &ProfileSvcMockCreateFolderExpectation{}
+	}
+
+	if mmCreateFolder.defaultExpectation.params != nil {
+		mmCreateFolder.mock.t.Fatalf("ProfileSvcMock.CreateFolder mock is already set by Expect")
+	}
+
+	if mmCreateFolder.defaultExpectation.paramPtrs == nil {
+		mmCreateFolder.defaultExpectation.paramPtrs = &ProfileSvcMockCreateFolderParamPtrs{}
+	}
+	mmCreateFolder.defaultExpectation.paramPtrs.folder = &folder
+	mmCreateFolder.defaultExpectation.expectationOrigins.originFolder = minimock.CallerInfo(1)
+
+	return mmCreateFolder
+}
+
+// Inspect accepts an inspector function that has same arguments as the ProfileSvc.CreateFolder
+func (mmCreateFolder *mProfileSvcMockCreateFolder) Inspect(f func(ctx context.Context, folder profile.Folder)) *mProfileSvcMockCreateFolder {
+	if mmCreateFolder.mock.inspectFuncCreateFolder != nil {
+		mmCreateFolder.mock.t.Fatalf("Inspect function is already set for ProfileSvcMock.CreateFolder")
+	}
+
+	mmCreateFolder.mock.inspectFuncCreateFolder = f
+
+	return mmCreateFolder
+}
+
+// Return sets up results that will be returned by ProfileSvc.CreateFolder
+func (mmCreateFolder *mProfileSvcMockCreateFolder) Return(id int64, err error) *ProfileSvcMock {
+	if mmCreateFolder.mock.funcCreateFolder != nil {
+		mmCreateFolder.mock.t.Fatalf("ProfileSvcMock.CreateFolder mock is already set by Set")
+	}
+
+	if mmCreateFolder.defaultExpectation == nil {
+		mmCreateFolder.defaultExpectation = &ProfileSvcMockCreateFolderExpectation{mock: mmCreateFolder.mock}
+	}
+	mmCreateFolder.defaultExpectation.results = &ProfileSvcMockCreateFolderResults{id, err}
+	mmCreateFolder.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateFolder.mock
+}
+
+// Set uses given function f to mock the ProfileSvc.CreateFolder method
+func (mmCreateFolder *mProfileSvcMockCreateFolder) Set(f func(ctx context.Context, folder profile.Folder) (id int64, err error)) *ProfileSvcMock {
+	if mmCreateFolder.defaultExpectation != nil {
+		mmCreateFolder.mock.t.Fatalf("Default expectation is already set for the ProfileSvc.CreateFolder method")
+	}
+
+	if len(mmCreateFolder.expectations) > 0 {
+		mmCreateFolder.mock.t.Fatalf("Some expectations are already set for the ProfileSvc.CreateFolder method")
+	}
+
+	mmCreateFolder.mock.funcCreateFolder = f
+	mmCreateFolder.mock.funcCreateFolderOrigin = minimock.CallerInfo(1)
+	return mmCreateFolder.mock
+}
+
+// When sets expectation for the ProfileSvc.CreateFolder which will trigger the result defined by the following
+// Then helper
+func (mmCreateFolder *mProfileSvcMockCreateFolder) When(ctx context.Context, folder profile.Folder) *ProfileSvcMockCreateFolderExpectation {
+	if mmCreateFolder.mock.funcCreateFolder != nil {
+		mmCreateFolder.mock.t.Fatalf("ProfileSvcMock.CreateFolder mock is already set by Set")
+	}
+
+	expectation := &ProfileSvcMockCreateFolderExpectation{
+		mock:               mmCreateFolder.mock,
+		params:             &ProfileSvcMockCreateFolderParams{ctx, folder},
+		expectationOrigins: ProfileSvcMockCreateFolderExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateFolder.expectations = append(mmCreateFolder.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ProfileSvc.CreateFolder return parameters for the expectation previously defined by the When method
+func (e *ProfileSvcMockCreateFolderExpectation) Then(id int64, err error) *ProfileSvcMock {
+	e.results = &ProfileSvcMockCreateFolderResults{id, err}
+	return e.mock
+}
+
+// Times sets number of times ProfileSvc.CreateFolder should be invoked
+func (mmCreateFolder *mProfileSvcMockCreateFolder) Times(n uint64) *mProfileSvcMockCreateFolder {
+	if n == 0 {
+		mmCreateFolder.mock.t.Fatalf("Times of ProfileSvcMock.CreateFolder mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateFolder.expectedInvocations, n)
+	mmCreateFolder.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateFolder
+}
+
+func (mmCreateFolder *mProfileSvcMockCreateFolder) invocationsDone() bool {
+	if len(mmCreateFolder.expectations) == 0 && mmCreateFolder.defaultExpectation == nil && mmCreateFolder.mock.funcCreateFolder == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateFolder.mock.afterCreateFolderCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateFolder.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateFolder implements mm_handler.ProfileSvc
+func (mmCreateFolder *ProfileSvcMock) CreateFolder(ctx context.Context, folder profile.Folder) (id int64, err error) {
+	mm_atomic.AddUint64(&mmCreateFolder.beforeCreateFolderCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateFolder.afterCreateFolderCounter, 1)
+
+	mmCreateFolder.t.Helper()
+
+	if mmCreateFolder.inspectFuncCreateFolder != nil {
+		mmCreateFolder.inspectFuncCreateFolder(ctx, folder)
+	}
+
+	mm_params := ProfileSvcMockCreateFolderParams{ctx, folder}
+
+	// Record call args
+	mmCreateFolder.CreateFolderMock.mutex.Lock()
+	mmCreateFolder.CreateFolderMock.callArgs = append(mmCreateFolder.CreateFolderMock.callArgs, &mm_params)
+	mmCreateFolder.CreateFolderMock.mutex.Unlock()
+
+	for _, e := range mmCreateFolder.CreateFolderMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.id, e.results.err
+		}
+	}
+
+	if mmCreateFolder.CreateFolderMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateFolder.CreateFolderMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateFolder.CreateFolderMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateFolder.CreateFolderMock.defaultExpectation.paramPtrs
+
+		mm_got := ProfileSvcMockCreateFolderParams{ctx, folder}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateFolder.t.Errorf("ProfileSvcMock.CreateFolder got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateFolder.CreateFolderMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.folder != nil && !minimock.Equal(*mm_want_ptrs.folder, mm_got.folder) {
+				mmCreateFolder.t.Errorf("ProfileSvcMock.CreateFolder got unexpected parameter folder, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateFolder.CreateFolderMock.defaultExpectation.expectationOrigins.originFolder, *mm_want_ptrs.folder, mm_got.folder, minimock.Diff(*mm_want_ptrs.folder, mm_got.folder))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateFolder.t.Errorf("ProfileSvcMock.CreateFolder got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateFolder.CreateFolderMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateFolder.CreateFolderMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateFolder.t.Fatal("No results are set for the ProfileSvcMock.CreateFolder")
+		}
+		return (*mm_results).id, (*mm_results).err
+	}
+	if mmCreateFolder.funcCreateFolder != nil {
+		return mmCreateFolder.funcCreateFolder(ctx, folder)
+	}
+	mmCreateFolder.t.Fatalf("Unexpected call to ProfileSvcMock.CreateFolder. %v %v", ctx, folder)
+	return
+}
+
+// CreateFolderAfterCounter returns a count of finished ProfileSvcMock.CreateFolder invocations
+func (mmCreateFolder *ProfileSvcMock) CreateFolderAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateFolder.afterCreateFolderCounter)
+}
+
+// CreateFolderBeforeCounter returns a count of ProfileSvcMock.CreateFolder invocations
+func (mmCreateFolder *ProfileSvcMock) CreateFolderBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateFolder.beforeCreateFolderCounter)
+}
+
+// Calls returns a list of arguments used in each call to ProfileSvcMock.CreateFolder.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateFolder *mProfileSvcMockCreateFolder) Calls() []*ProfileSvcMockCreateFolderParams {
+	mmCreateFolder.mutex.RLock()
+
+	argCopy := make([]*ProfileSvcMockCreateFolderParams, len(mmCreateFolder.callArgs))
+	copy(argCopy, mmCreateFolder.callArgs)
+
+	mmCreateFolder.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateFolderDone returns true if the count of the CreateFolder invocations corresponds
+// the number of defined expectations
+func (m *ProfileSvcMock) MinimockCreateFolderDone() bool {
+	if m.CreateFolderMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateFolderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateFolderMock.invocationsDone()
+}
+
+// MinimockCreateFolderInspect logs each unmet expectation
+func (m *ProfileSvcMock) MinimockCreateFolderInspect() {
+	for _, e := range m.CreateFolderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ProfileSvcMock.CreateFolder at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateFolderCounter := mm_atomic.LoadUint64(&m.afterCreateFolderCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateFolderMock.defaultExpectation != nil && afterCreateFolderCounter < 1 {
+		if m.CreateFolderMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ProfileSvcMock.CreateFolder at\n%s", m.CreateFolderMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ProfileSvcMock.CreateFolder at\n%s with params: %#v", m.CreateFolderMock.defaultExpectation.expectationOrigins.origin, *m.CreateFolderMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateFolder != nil && afterCreateFolderCounter < 1 {
+		m.t.Errorf("Expected call to ProfileSvcMock.CreateFolder at\n%s", m.funcCreateFolderOrigin)
+	}
+
+	if !m.CreateFolderMock.invocationsDone() && afterCreateFolderCounter > 0 {
+		m.t.Errorf("Expected %d calls to ProfileSvcMock.CreateFolder at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateFolderMock.expectedInvocations), m.CreateFolderMock.expectedInvocationsOrigin, afterCreateFolderCounter)
+	}
+}
+
 type mProfileSvcMockDeleteCard struct {
 	optional           bool
 	mock               *ProfileSvcMock
@@ -92,6 +517,7 @@ type ProfileSvcMockDeleteCardParams struct {
 	ctx        context.Context
 	username   string
 	cardNumber string
+	scope      *apikey.Scope
 }
 
 // ProfileSvcMockDeleteCardParamPtrs contains pointers to parameters of the ProfileSvc.DeleteCard
@@ -99,6 +525,7 @@ type ProfileSvcMockDeleteCardParamPtrs struct {
 	ctx        *context.Context
 	username   *string
 	cardNumber *string
+	scope      **apikey.Scope
 }
 
 // ProfileSvcMockDeleteCardResults contains results of the ProfileSvc.DeleteCard
@@ -112,6 +539,7 @@ type ProfileSvcMockDeleteCardExpectationOrigins struct {
 	originCtx        string
 	originUsername   string
 	originCardNumber string
+	originScope      string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -125,7 +553,7 @@ func (mmDeleteCard *mProfileSvcMockDeleteCard) Optional() *mProfileSvcMockDelete
 }
 
 // Expect sets up expected params for ProfileSvc.DeleteCard
-func (mmDeleteCard *mProfileSvcMockDeleteCard) Expect(ctx context.Context, username string, cardNumber string) *mProfileSvcMockDeleteCard {
+func (mmDeleteCard *mProfileSvcMockDeleteCard) Expect(ctx context.Context, username string, cardNumber string, scope *apikey.Scope) *mProfileSvcMockDeleteCard {
 	if mmDeleteCard.mock.funcDeleteCard != nil {
 		mmDeleteCard.mock.t.Fatalf("ProfileSvcMock.DeleteCard mock is already set by Set")
 	}
@@ -138,7 +566,7 @@ func (mmDeleteCard *mProfileSvcMockDeleteCard) Expect(ctx context.Context, usern
 		mmDeleteCard.mock.t.Fatalf("ProfileSvcMock.DeleteCard mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteCard.defaultExpectation.params = &ProfileSvcMockDeleteCardParams{ctx, username, cardNumber}
+	mmDeleteCard.defaultExpectation.params = &ProfileSvcMockDeleteCardParams{ctx, username, cardNumber, scope}
 	mmDeleteCard.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmDeleteCard.expectations {
 		if minimock.Equal(e.params, mmDeleteCard.defaultExpectation.params) {
@@ -218,8 +646,31 @@ func (mmDeleteCard *mProfileSvcMockDeleteCard) ExpectCardNumberParam3(cardNumber
 	return mmDeleteCard
 }
 
+// ExpectScopeParam4 sets up expected param scope for ProfileSvc.DeleteCard
+func (mmDeleteCard *mProfileSvcMockDeleteCard) ExpectScopeParam4(scope *apikey.Scope) *mProfileSvcMockDeleteCard {
+	if mmDeleteCard.mock.funcDeleteCard != nil {
+		mmDeleteCard.mock.t.Fatalf("ProfileSvcMock.DeleteCard mock is already set by Set")
+	}
+
+	if mmDeleteCard.defaultExpectation == nil {
+		mmDeleteCard.defaultExpectation = &ProfileSvcMockDeleteCardExpectation{}
+	}
+
+	if mmDeleteCard.defaultExpectation.params != nil {
+		mmDeleteCard.mock.t.Fatalf("ProfileSvcMock.DeleteCard mock is already set by Expect")
+	}
+
+	if mmDeleteCard.defaultExpectation.paramPtrs == nil {
+		mmDeleteCard.defaultExpectation.paramPtrs = &ProfileSvcMockDeleteCardParamPtrs{}
+	}
+	mmDeleteCard.defaultExpectation.paramPtrs.scope = &scope
+	mmDeleteCard.defaultExpectation.expectationOrigins.originScope = minimock.CallerInfo(1)
+
+	return mmDeleteCard
+}
+
 // Inspect accepts an inspector function that has same arguments as the ProfileSvc.DeleteCard
-func (mmDeleteCard *mProfileSvcMockDeleteCard) Inspect(f func(ctx context.Context, username string, cardNumber string)) *mProfileSvcMockDeleteCard {
+func (mmDeleteCard *mProfileSvcMockDeleteCard) Inspect(f func(ctx context.Context, username string, cardNumber string, scope *apikey.Scope)) *mProfileSvcMockDeleteCard {
 	if mmDeleteCard.mock.inspectFuncDeleteCard != nil {
 		mmDeleteCard.mock.t.Fatalf("Inspect function is already set for ProfileSvcMock.DeleteCard")
 	}
@@ -244,7 +695,7 @@ func (mmDeleteCard *mProfileSvcMockDeleteCard) Return(err error) *ProfileSvcMock
 }
 
 // Set uses given function f to mock the ProfileSvc.DeleteCard method
-func (mmDeleteCard *mProfileSvcMockDeleteCard) Set(f func(ctx context.Context, username string, cardNumber string) (err error)) *ProfileSvcMock {
+func (mmDeleteCard *mProfileSvcMockDeleteCard) Set(f func(ctx context.Context, username string, cardNumber string, scope *apikey.Scope) (err error)) *ProfileSvcMock {
 	if mmDeleteCard.defaultExpectation != nil {
 		mmDeleteCard.mock.t.Fatalf("Default expectation is already set for the ProfileSvc.DeleteCard method")
 	}
@@ -260,14 +711,14 @@ func (mmDeleteCard *mProfileSvcMockDeleteCard) Set(f func(ctx context.Context, u
 
 // When sets expectation for the ProfileSvc.DeleteCard which will trigger the result defined by the following
 // Then helper
-func (mmDeleteCard *mProfileSvcMockDeleteCard) When(ctx context.Context, username string, cardNumber string) *ProfileSvcMockDeleteCardExpectation {
+func (mmDeleteCard *mProfileSvcMockDeleteCard) When(ctx context.Context, username string, cardNumber string, scope *apikey.Scope) *ProfileSvcMockDeleteCardExpectation {
 	if mmDeleteCard.mock.funcDeleteCard != nil {
 		mmDeleteCard.mock.t.Fatalf("ProfileSvcMock.DeleteCard mock is already set by Set")
 	}
 
 	expectation := &ProfileSvcMockDeleteCardExpectation{
 		mock:               mmDeleteCard.mock,
-		params:             &ProfileSvcMockDeleteCardParams{ctx, username, cardNumber},
+		params:             &ProfileSvcMockDeleteCardParams{ctx, username, cardNumber, scope},
 		expectationOrigins: ProfileSvcMockDeleteCardExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmDeleteCard.expectations = append(mmDeleteCard.expectations, expectation)
@@ -285,204 +736,2856 @@ func (mmDeleteCard *mProfileSvcMockDeleteCard) Times(n uint64) *mProfileSvcMockD
 	if n == 0 {
 		mmDeleteCard.mock.t.Fatalf("Times of ProfileSvcMock.DeleteCard mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteCard.expectedInvocations, n)
-	mmDeleteCard.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteCard
+	mm_atomic.StoreUint64(&mmDeleteCard.expectedInvocations, n)
+	mmDeleteCard.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteCard
+}
+
+func (mmDeleteCard *mProfileSvcMockDeleteCard) invocationsDone() bool {
+	if len(mmDeleteCard.expectations) == 0 && mmDeleteCard.defaultExpectation == nil && mmDeleteCard.mock.funcDeleteCard == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteCard.mock.afterDeleteCardCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteCard.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteCard implements mm_handler.ProfileSvc
+func (mmDeleteCard *ProfileSvcMock) DeleteCard(ctx context.Context, username string, cardNumber string, scope *apikey.Scope) (err error) {
+	mm_atomic.AddUint64(&mmDeleteCard.beforeDeleteCardCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteCard.afterDeleteCardCounter, 1)
+
+	mmDeleteCard.t.Helper()
+
+	if mmDeleteCard.inspectFuncDeleteCard != nil {
+		mmDeleteCard.inspectFuncDeleteCard(ctx, username, cardNumber, scope)
+	}
+
+	mm_params := ProfileSvcMockDeleteCardParams{ctx, username, cardNumber, scope}
+
+	// Record call args
+	mmDeleteCard.DeleteCardMock.mutex.Lock()
+	mmDeleteCard.DeleteCardMock.callArgs = append(mmDeleteCard.DeleteCardMock.callArgs, &mm_params)
+	mmDeleteCard.DeleteCardMock.mutex.Unlock()
+
+	for _, e := range mmDeleteCard.DeleteCardMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteCard.DeleteCardMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteCard.DeleteCardMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteCard.DeleteCardMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteCard.DeleteCardMock.defaultExpectation.paramPtrs
+
+		mm_got := ProfileSvcMockDeleteCardParams{ctx, username, cardNumber, scope}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteCard.t.Errorf("ProfileSvcMock.DeleteCard got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteCard.DeleteCardMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmDeleteCard.t.Errorf("ProfileSvcMock.DeleteCard got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteCard.DeleteCardMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.cardNumber != nil && !minimock.Equal(*mm_want_ptrs.cardNumber, mm_got.cardNumber) {
+				mmDeleteCard.t.Errorf("ProfileSvcMock.DeleteCard got unexpected parameter cardNumber, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteCard.DeleteCardMock.defaultExpectation.expectationOrigins.originCardNumber, *mm_want_ptrs.cardNumber, mm_got.cardNumber, minimock.Diff(*mm_want_ptrs.cardNumber, mm_got.cardNumber))
+			}
+
+			if mm_want_ptrs.scope != nil && !minimock.Equal(*mm_want_ptrs.scope, mm_got.scope) {
+				mmDeleteCard.t.Errorf("ProfileSvcMock.DeleteCard got unexpected parameter scope, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteCard.DeleteCardMock.defaultExpectation.expectationOrigins.originScope, *mm_want_ptrs.scope, mm_got.scope, minimock.Diff(*mm_want_ptrs.scope, mm_got.scope))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteCard.t.Errorf("ProfileSvcMock.DeleteCard got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteCard.DeleteCardMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteCard.DeleteCardMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteCard.t.Fatal("No results are set for the ProfileSvcMock.DeleteCard")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteCard.funcDeleteCard != nil {
+		return mmDeleteCard.funcDeleteCard(ctx, username, cardNumber, scope)
+	}
+	mmDeleteCard.t.Fatalf("Unexpected call to ProfileSvcMock.DeleteCard. %v %v %v %v", ctx, username, cardNumber, scope)
+	return
+}
+
+// DeleteCardAfterCounter returns a count of finished ProfileSvcMock.DeleteCard invocations
+func (mmDeleteCard *ProfileSvcMock) DeleteCardAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteCard.afterDeleteCardCounter)
+}
+
+// DeleteCardBeforeCounter returns a count of ProfileSvcMock.DeleteCard invocations
+func (mmDeleteCard *ProfileSvcMock) DeleteCardBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteCard.beforeDeleteCardCounter)
+}
+
+// Calls returns a list of arguments used in each call to ProfileSvcMock.DeleteCard.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteCard *mProfileSvcMockDeleteCard) Calls() []*ProfileSvcMockDeleteCardParams {
+	mmDeleteCard.mutex.RLock()
+
+	argCopy := make([]*ProfileSvcMockDeleteCardParams, len(mmDeleteCard.callArgs))
+	copy(argCopy, mmDeleteCard.callArgs)
+
+	mmDeleteCard.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteCardDone returns true if the count of the DeleteCard invocations corresponds
+// the number of defined expectations
+func (m *ProfileSvcMock) MinimockDeleteCardDone() bool {
+	if m.DeleteCardMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteCardMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteCardMock.invocationsDone()
+}
+
+// MinimockDeleteCardInspect logs each unmet expectation
+func (m *ProfileSvcMock) MinimockDeleteCardInspect() {
+	for _, e := range m.DeleteCardMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ProfileSvcMock.DeleteCard at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteCardCounter := mm_atomic.LoadUint64(&m.afterDeleteCardCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteCardMock.defaultExpectation != nil && afterDeleteCardCounter < 1 {
+		if m.DeleteCardMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ProfileSvcMock.DeleteCard at\n%s", m.DeleteCardMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ProfileSvcMock.DeleteCard at\n%s with params: %#v", m.DeleteCardMock.defaultExpectation.expectationOrigins.origin, *m.DeleteCardMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteCard != nil && afterDeleteCardCounter < 1 {
+		m.t.Errorf("Expected call to ProfileSvcMock.DeleteCard at\n%s", m.funcDeleteCardOrigin)
+	}
+
+	if !m.DeleteCardMock.invocationsDone() && afterDeleteCardCounter > 0 {
+		m.t.Errorf("Expected %d calls to ProfileSvcMock.DeleteCard at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteCardMock.expectedInvocations), m.DeleteCardMock.expectedInvocationsOrigin, afterDeleteCardCounter)
+	}
+}
+
+type mProfileSvcMockDeleteFolder struct {
+	optional           bool
+	mock               *ProfileSvcMock
+	defaultExpectation *ProfileSvcMockDeleteFolderExpectation
+	expectations       []*ProfileSvcMockDeleteFolderExpectation
+
+	callArgs []*ProfileSvcMockDeleteFolderParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ProfileSvcMockDeleteFolderExpectation specifies expectation struct of the ProfileSvc.DeleteFolder
+type ProfileSvcMockDeleteFolderExpectation struct {
+	mock               *ProfileSvcMock
+	params             *ProfileSvcMockDeleteFolderParams
+	paramPtrs          *ProfileSvcMockDeleteFolderParamPtrs
+	expectationOrigins ProfileSvcMockDeleteFolderExpectationOrigins
+	results            *ProfileSvcMockDeleteFolderResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ProfileSvcMockDeleteFolderParams contains parameters of the ProfileSvc.DeleteFolder
+type ProfileSvcMockDeleteFolderParams struct {
+	ctx      context.Context
+	username string
+	id       int64
+}
+
+// ProfileSvcMockDeleteFolderParamPtrs contains pointers to parameters of the ProfileSvc.DeleteFolder
+type ProfileSvcMockDeleteFolderParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	id       *int64
+}
+
+// ProfileSvcMockDeleteFolderResults contains results of the ProfileSvc.DeleteFolder
+type ProfileSvcMockDeleteFolderResults struct {
+	err error
+}
+
+// ProfileSvcMockDeleteFolderOrigins contains origins of expectations of the ProfileSvc.DeleteFolder
+type ProfileSvcMockDeleteFolderExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originId       string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteFolder *mProfileSvcMockDeleteFolder) Optional() *mProfileSvcMockDeleteFolder {
+	mmDeleteFolder.optional = true
+	return mmDeleteFolder
+}
+
+// Expect sets up expected params for ProfileSvc.DeleteFolder
+func (mmDeleteFolder *mProfileSvcMockDeleteFolder) Expect(ctx context.Context, username string, id int64) *mProfileSvcMockDeleteFolder {
+	if mmDeleteFolder.mock.funcDeleteFolder != nil {
+		mmDeleteFolder.mock.t.Fatalf("ProfileSvcMock.DeleteFolder mock is already set by Set")
+	}
+
+	if mmDeleteFolder.defaultExpectation == nil {
+		mmDeleteFolder.defaultExpectation = &ProfileSvcMockDeleteFolderExpectation{}
+	}
+
+	if mmDeleteFolder.defaultExpectation.paramPtrs != nil {
+		mmDeleteFolder.mock.t.Fatalf("ProfileSvcMock.DeleteFolder mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteFolder.defaultExpectation.params = &ProfileSvcMockDeleteFolderParams{ctx, username, id}
+	mmDeleteFolder.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteFolder.expectations {
+		if minimock.Equal(e.params, mmDeleteFolder.defaultExpectation.params) {
+			mmDeleteFolder.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteFolder.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteFolder
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ProfileSvc.DeleteFolder
+func (mmDeleteFolder *mProfileSvcMockDeleteFolder) ExpectCtxParam1(ctx context.Context) *mProfileSvcMockDeleteFolder {
+	if mmDeleteFolder.mock.funcDeleteFolder != nil {
+		mmDeleteFolder.mock.t.Fatalf("ProfileSvcMock.DeleteFolder mock is already set by Set")
+	}
+
+	if mmDeleteFolder.defaultExpectation == nil {
+		mmDeleteFolder.defaultExpectation = &ProfileSvcMockDeleteFolderExpectation{}
+	}
+
+	if mmDeleteFolder.defaultExpectation.params != nil {
+		mmDeleteFolder.mock.t.Fatalf("ProfileSvcMock.DeleteFolder mock is already set by Expect")
+	}
+
+	if mmDeleteFolder.defaultExpectation.paramPtrs == nil {
+		mmDeleteFolder.defaultExpectation.paramPtrs = &ProfileSvcMockDeleteFolderParamPtrs{}
+	}
+	mmDeleteFolder.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteFolder.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteFolder
+}
+
+// ExpectUsernameParam2 sets up expected param username for ProfileSvc.DeleteFolder
+func (mmDeleteFolder *mProfileSvcMockDeleteFolder) ExpectUsernameParam2(username string) *mProfileSvcMockDeleteFolder {
+	if mmDeleteFolder.mock.funcDeleteFolder != nil {
+		mmDeleteFolder.mock.t.Fatalf("ProfileSvcMock.DeleteFolder mock is already set by Set")
+	}
+
+	if mmDeleteFolder.defaultExpectation == nil {
+		mmDeleteFolder.defaultExpectation = &ProfileSvcMockDeleteFolderExpectation{}
+	}
+
+	if mmDeleteFolder.defaultExpectation.params != nil {
+		mmDeleteFolder.mock.t.Fatalf("ProfileSvcMock.DeleteFolder mock is already set by Expect")
+	}
+
+	if mmDeleteFolder.defaultExpectation.paramPtrs == nil {
+		mmDeleteFolder.defaultExpectation.paramPtrs = &ProfileSvcMockDeleteFolderParamPtrs{}
+	}
+	mmDeleteFolder.defaultExpectation.paramPtrs.username = &username
+	mmDeleteFolder.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmDeleteFolder
+}
+
+// ExpectIdParam3 sets up expected param id for ProfileSvc.DeleteFolder
+func (mmDeleteFolder *mProfileSvcMockDeleteFolder) ExpectIdParam3(id int64) *mProfileSvcMockDeleteFolder {
+	if mmDeleteFolder.mock.funcDeleteFolder != nil {
+		mmDeleteFolder.mock.t.Fatalf("ProfileSvcMock.DeleteFolder mock is already set by Set")
+	}
+
+	if mmDeleteFolder.defaultExpectation == nil {
+		mmDeleteFolder.defaultExpectation = &ProfileSvcMockDeleteFolderExpectation{}
+	}
+
+	if mmDeleteFolder.defaultExpectation.params != nil {
+		mmDeleteFolder.mock.t.Fatalf("ProfileSvcMock.DeleteFolder mock is already set by Expect")
+	}
+
+	if mmDeleteFolder.defaultExpectation.paramPtrs == nil {
+		mmDeleteFolder.defaultExpectation.paramPtrs = &ProfileSvcMockDeleteFolderParamPtrs{}
+	}
+	mmDeleteFolder.defaultExpectation.paramPtrs.id = &id
+	mmDeleteFolder.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmDeleteFolder
+}
+
+// Inspect accepts an inspector function that has same arguments as the ProfileSvc.DeleteFolder
+func (mmDeleteFolder *mProfileSvcMockDeleteFolder) Inspect(f func(ctx context.Context, username string, id int64)) *mProfileSvcMockDeleteFolder {
+	if mmDeleteFolder.mock.inspectFuncDeleteFolder != nil {
+		mmDeleteFolder.mock.t.Fatalf("Inspect function is already set for ProfileSvcMock.DeleteFolder")
+	}
+
+	mmDeleteFolder.mock.inspectFuncDeleteFolder = f
+
+	return mmDeleteFolder
+}
+
+// Return sets up results that will be returned by ProfileSvc.DeleteFolder
+func (mmDeleteFolder *mProfileSvcMockDeleteFolder) Return(err error) *ProfileSvcMock {
+	if mmDeleteFolder.mock.funcDeleteFolder != nil {
+		mmDeleteFolder.mock.t.Fatalf("ProfileSvcMock.DeleteFolder mock is already set by Set")
+	}
+
+	if mmDeleteFolder.defaultExpectation == nil {
+		mmDeleteFolder.defaultExpectation = &ProfileSvcMockDeleteFolderExpectation{mock: mmDeleteFolder.mock}
+	}
+	mmDeleteFolder.defaultExpectation.results = &ProfileSvcMockDeleteFolderResults{err}
+	mmDeleteFolder.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteFolder.mock
+}
+
+// Set uses given function f to mock the ProfileSvc.DeleteFolder method
+func (mmDeleteFolder *mProfileSvcMockDeleteFolder) Set(f func(ctx context.Context, username string, id int64) (err error)) *ProfileSvcMock {
+	if mmDeleteFolder.defaultExpectation != nil {
+		mmDeleteFolder.mock.t.Fatalf("Default expectation is already set for the ProfileSvc.DeleteFolder method")
+	}
+
+	if len(mmDeleteFolder.expectations) > 0 {
+		mmDeleteFolder.mock.t.Fatalf("Some expectations are already set for the ProfileSvc.DeleteFolder method")
+	}
+
+	mmDeleteFolder.mock.funcDeleteFolder = f
+	mmDeleteFolder.mock.funcDeleteFolderOrigin = minimock.CallerInfo(1)
+	return mmDeleteFolder.mock
+}
+
+// When sets expectation for the ProfileSvc.DeleteFolder which will trigger the result defined by the following
+// Then helper
+func (mmDeleteFolder *mProfileSvcMockDeleteFolder) When(ctx context.Context, username string, id int64) *ProfileSvcMockDeleteFolderExpectation {
+	if mmDeleteFolder.mock.funcDeleteFolder != nil {
+		mmDeleteFolder.mock.t.Fatalf("ProfileSvcMock.DeleteFolder mock is already set by Set")
+	}
+
+	expectation := &ProfileSvcMockDeleteFolderExpectation{
+		mock:               mmDeleteFolder.mock,
+		params:             &ProfileSvcMockDeleteFolderParams{ctx, username, id},
+		expectationOrigins: ProfileSvcMockDeleteFolderExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteFolder.expectations = append(mmDeleteFolder.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ProfileSvc.DeleteFolder return parameters for the expectation previously defined by the When method
+func (e *ProfileSvcMockDeleteFolderExpectation) Then(err error) *ProfileSvcMock {
+	e.results = &ProfileSvcMockDeleteFolderResults{err}
+	return e.mock
+}
+
+// Times sets number of times ProfileSvc.DeleteFolder should be invoked
+func (mmDeleteFolder *mProfileSvcMockDeleteFolder) Times(n uint64) *mProfileSvcMockDeleteFolder {
+	if n == 0 {
+		mmDeleteFolder.mock.t.Fatalf("Times of ProfileSvcMock.DeleteFolder mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteFolder.expectedInvocations, n)
+	mmDeleteFolder.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteFolder
+}
+
+func (mmDeleteFolder *mProfileSvcMockDeleteFolder) invocationsDone() bool {
+	if len(mmDeleteFolder.expectations) == 0 && mmDeleteFolder.defaultExpectation == nil && mmDeleteFolder.mock.funcDeleteFolder == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteFolder.mock.afterDeleteFolderCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteFolder.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteFolder implements mm_handler.ProfileSvc
+func (mmDeleteFolder *ProfileSvcMock) DeleteFolder(ctx context.Context, username string, id int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteFolder.beforeDeleteFolderCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteFolder.afterDeleteFolderCounter, 1)
+
+	mmDeleteFolder.t.Helper()
+
+	if mmDeleteFolder.inspectFuncDeleteFolder != nil {
+		mmDeleteFolder.inspectFuncDeleteFolder(ctx, username, id)
+	}
+
+	mm_params := ProfileSvcMockDeleteFolderParams{ctx, username, id}
+
+	// Record call args
+	mmDeleteFolder.DeleteFolderMock.mutex.Lock()
+	mmDeleteFolder.DeleteFolderMock.callArgs = append(mmDeleteFolder.DeleteFolderMock.callArgs, &mm_params)
+	mmDeleteFolder.DeleteFolderMock.mutex.Unlock()
+
+	for _, e := range mmDeleteFolder.DeleteFolderMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteFolder.DeleteFolderMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteFolder.DeleteFolderMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteFolder.DeleteFolderMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteFolder.DeleteFolderMock.defaultExpectation.paramPtrs
+
+		mm_got := ProfileSvcMockDeleteFolderParams{ctx, username, id}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteFolder.t.Errorf("ProfileSvcMock.DeleteFolder got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteFolder.DeleteFolderMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmDeleteFolder.t.Errorf("ProfileSvcMock.DeleteFolder got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteFolder.DeleteFolderMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmDeleteFolder.t.Errorf("ProfileSvcMock.DeleteFolder got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteFolder.DeleteFolderMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteFolder.t.Errorf("ProfileSvcMock.DeleteFolder got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteFolder.DeleteFolderMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteFolder.DeleteFolderMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteFolder.t.Fatal("No results are set for the ProfileSvcMock.DeleteFolder")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteFolder.funcDeleteFolder != nil {
+		return mmDeleteFolder.funcDeleteFolder(ctx, username, id)
+	}
+	mmDeleteFolder.t.Fatalf("Unexpected call to ProfileSvcMock.DeleteFolder. %v %v %v", ctx, username, id)
+	return
+}
+
+// DeleteFolderAfterCounter returns a count of finished ProfileSvcMock.DeleteFolder invocations
+func (mmDeleteFolder *ProfileSvcMock) DeleteFolderAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteFolder.afterDeleteFolderCounter)
+}
+
+// DeleteFolderBeforeCounter returns a count of ProfileSvcMock.DeleteFolder invocations
+func (mmDeleteFolder *ProfileSvcMock) DeleteFolderBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteFolder.beforeDeleteFolderCounter)
+}
+
+// Calls returns a list of arguments used in each call to ProfileSvcMock.DeleteFolder.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteFolder *mProfileSvcMockDeleteFolder) Calls() []*ProfileSvcMockDeleteFolderParams {
+	mmDeleteFolder.mutex.RLock()
+
+	argCopy := make([]*ProfileSvcMockDeleteFolderParams, len(mmDeleteFolder.callArgs))
+	copy(argCopy, mmDeleteFolder.callArgs)
+
+	mmDeleteFolder.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteFolderDone returns true if the count of the DeleteFolder invocations corresponds
+// the number of defined expectations
+func (m *ProfileSvcMock) MinimockDeleteFolderDone() bool {
+	if m.DeleteFolderMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteFolderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteFolderMock.invocationsDone()
+}
+
+// MinimockDeleteFolderInspect logs each unmet expectation
+func (m *ProfileSvcMock) MinimockDeleteFolderInspect() {
+	for _, e := range m.DeleteFolderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ProfileSvcMock.DeleteFolder at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteFolderCounter := mm_atomic.LoadUint64(&m.afterDeleteFolderCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteFolderMock.defaultExpectation != nil && afterDeleteFolderCounter < 1 {
+		if m.DeleteFolderMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ProfileSvcMock.DeleteFolder at\n%s", m.DeleteFolderMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ProfileSvcMock.DeleteFolder at\n%s with params: %#v", m.DeleteFolderMock.defaultExpectation.expectationOrigins.origin, *m.DeleteFolderMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteFolder != nil && afterDeleteFolderCounter < 1 {
+		m.t.Errorf("Expected call to ProfileSvcMock.DeleteFolder at\n%s", m.funcDeleteFolderOrigin)
+	}
+
+	if !m.DeleteFolderMock.invocationsDone() && afterDeleteFolderCounter > 0 {
+		m.t.Errorf("Expected %d calls to ProfileSvcMock.DeleteFolder at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteFolderMock.expectedInvocations), m.DeleteFolderMock.expectedInvocationsOrigin, afterDeleteFolderCounter)
+	}
+}
+
+type mProfileSvcMockDeleteTag struct {
+	optional           bool
+	mock               *ProfileSvcMock
+	defaultExpectation *ProfileSvcMockDeleteTagExpectation
+	expectations       []*ProfileSvcMockDeleteTagExpectation
+
+	callArgs []*ProfileSvcMockDeleteTagParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ProfileSvcMockDeleteTagExpectation specifies expectation struct of the ProfileSvc.DeleteTag
+type ProfileSvcMockDeleteTagExpectation struct {
+	mock               *ProfileSvcMock
+	params             *ProfileSvcMockDeleteTagParams
+	paramPtrs          *ProfileSvcMockDeleteTagParamPtrs
+	expectationOrigins ProfileSvcMockDeleteTagExpectationOrigins
+	results            *ProfileSvcMockDeleteTagResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ProfileSvcMockDeleteTagParams contains parameters of the ProfileSvc.DeleteTag
+type ProfileSvcMockDeleteTagParams struct {
+	ctx      context.Context
+	username string
+	name     string
+}
+
+// ProfileSvcMockDeleteTagParamPtrs contains pointers to parameters of the ProfileSvc.DeleteTag
+type ProfileSvcMockDeleteTagParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	name     *string
+}
+
+// ProfileSvcMockDeleteTagResults contains results of the ProfileSvc.DeleteTag
+type ProfileSvcMockDeleteTagResults struct {
+	err error
+}
+
+// ProfileSvcMockDeleteTagOrigins contains origins of expectations of the ProfileSvc.DeleteTag
+type ProfileSvcMockDeleteTagExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originName     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteTag *mProfileSvcMockDeleteTag) Optional() *mProfileSvcMockDeleteTag {
+	mmDeleteTag.optional = true
+	return mmDeleteTag
+}
+
+// Expect sets up expected params for ProfileSvc.DeleteTag
+func (mmDeleteTag *mProfileSvcMockDeleteTag) Expect(ctx context.Context, username string, name string) *mProfileSvcMockDeleteTag {
+	if mmDeleteTag.mock.funcDeleteTag != nil {
+		mmDeleteTag.mock.t.Fatalf("ProfileSvcMock.DeleteTag mock is already set by Set")
+	}
+
+	if mmDeleteTag.defaultExpectation == nil {
+		mmDeleteTag.defaultExpectation = &ProfileSvcMockDeleteTagExpectation{}
+	}
+
+	if mmDeleteTag.defaultExpectation.paramPtrs != nil {
+		mmDeleteTag.mock.t.Fatalf("ProfileSvcMock.DeleteTag mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteTag.defaultExpectation.params = &ProfileSvcMockDeleteTagParams{ctx, username, name}
+	mmDeleteTag.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteTag.expectations {
+		if minimock.Equal(e.params, mmDeleteTag.defaultExpectation.params) {
+			mmDeleteTag.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteTag.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteTag
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ProfileSvc.DeleteTag
+func (mmDeleteTag *mProfileSvcMockDeleteTag) ExpectCtxParam1(ctx context.Context) *mProfileSvcMockDeleteTag {
+	if mmDeleteTag.mock.funcDeleteTag != nil {
+		mmDeleteTag.mock.t.Fatalf("ProfileSvcMock.DeleteTag mock is already set by Set")
+	}
+
+	if mmDeleteTag.defaultExpectation == nil {
+		mmDeleteTag.defaultExpectation = &ProfileSvcMockDeleteTagExpectation{}
+	}
+
+	if mmDeleteTag.defaultExpectation.params != nil {
+		mmDeleteTag.mock.t.Fatalf("ProfileSvcMock.DeleteTag mock is already set by Expect")
+	}
+
+	if mmDeleteTag.defaultExpectation.paramPtrs == nil {
+		mmDeleteTag.defaultExpectation.paramPtrs = &ProfileSvcMockDeleteTagParamPtrs{}
+	}
+	mmDeleteTag.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteTag.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteTag
+}
+
+// ExpectUsernameParam2 sets up expected param username for ProfileSvc.DeleteTag
+func (mmDeleteTag *mProfileSvcMockDeleteTag) ExpectUsernameParam2(username string) *mProfileSvcMockDeleteTag {
+	if mmDeleteTag.mock.funcDeleteTag != nil {
+		mmDeleteTag.mock.t.Fatalf("ProfileSvcMock.DeleteTag mock is already set by Set")
+	}
+
+	if mmDeleteTag.defaultExpectation == nil {
+		mmDeleteTag.defaultExpectation = &ProfileSvcMockDeleteTagExpectation{}
+	}
+
+	if mmDeleteTag.defaultExpectation.params != nil {
+		mmDeleteTag.mock.t.Fatalf("ProfileSvcMock.DeleteTag mock is already set by Expect")
+	}
+
+	if mmDeleteTag.defaultExpectation.paramPtrs == nil {
+		mmDeleteTag.defaultExpectation.paramPtrs = &ProfileSvcMockDeleteTagParamPtrs{}
+	}
+	mmDeleteTag.defaultExpectation.paramPtrs.username = &username
+	mmDeleteTag.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmDeleteTag
+}
+
+// ExpectNameParam3 sets up expected param name for ProfileSvc.DeleteTag
+func (mmDeleteTag *mProfileSvcMockDeleteTag) ExpectNameParam3(name string) *mProfileSvcMockDeleteTag {
+	if mmDeleteTag.mock.funcDeleteTag != nil {
+		mmDeleteTag.mock.t.Fatalf("ProfileSvcMock.DeleteTag mock is already set by Set")
+	}
+
+	if mmDeleteTag.defaultExpectation == nil {
+		mmDeleteTag.defaultExpectation = &ProfileSvcMockDeleteTagExpectation{}
+	}
+
+	if mmDeleteTag.defaultExpectation.params != nil {
+		mmDeleteTag.mock.t.Fatalf("ProfileSvcMock.DeleteTag mock is already set by Expect")
+	}
+
+	if mmDeleteTag.defaultExpectation.paramPtrs == nil {
+		mmDeleteTag.defaultExpectation.paramPtrs = &ProfileSvcMockDeleteTagParamPtrs{}
+	}
+	mmDeleteTag.defaultExpectation.paramPtrs.name = &name
+	mmDeleteTag.defaultExpectation.expectationOrigins.originName = minimock.CallerInfo(1)
+
+	return mmDeleteTag
+}
+
+// Inspect accepts an inspector function that has same arguments as the ProfileSvc.DeleteTag
+func (mmDeleteTag *mProfileSvcMockDeleteTag) Inspect(f func(ctx context.Context, username string, name string)) *mProfileSvcMockDeleteTag {
+	if mmDeleteTag.mock.inspectFuncDeleteTag != nil {
+		mmDeleteTag.mock.t.Fatalf("Inspect function is already set for ProfileSvcMock.DeleteTag")
+	}
+
+	mmDeleteTag.mock.inspectFuncDeleteTag = f
+
+	return mmDeleteTag
+}
+
+// Return sets up results that will be returned by ProfileSvc.DeleteTag
+func (mmDeleteTag *mProfileSvcMockDeleteTag) Return(err error) *ProfileSvcMock {
+	if mmDeleteTag.mock.funcDeleteTag != nil {
+		mmDeleteTag.mock.t.Fatalf("ProfileSvcMock.DeleteTag mock is already set by Set")
+	}
+
+	if mmDeleteTag.defaultExpectation == nil {
+		mmDeleteTag.defaultExpectation = &ProfileSvcMockDeleteTagExpectation{mock: mmDeleteTag.mock}
+	}
+	mmDeleteTag.defaultExpectation.results = &ProfileSvcMockDeleteTagResults{err}
+	mmDeleteTag.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteTag.mock
+}
+
+// Set uses given function f to mock the ProfileSvc.DeleteTag method
+func (mmDeleteTag *mProfileSvcMockDeleteTag) Set(f func(ctx context.Context, username string, name string) (err error)) *ProfileSvcMock {
+	if mmDeleteTag.defaultExpectation != nil {
+		mmDeleteTag.mock.t.Fatalf("Default expectation is already set for the ProfileSvc.DeleteTag method")
+	}
+
+	if len(mmDeleteTag.expectations) > 0 {
+		mmDeleteTag.mock.t.Fatalf("Some expectations are already set for the ProfileSvc.DeleteTag method")
+	}
+
+	mmDeleteTag.mock.funcDeleteTag = f
+	mmDeleteTag.mock.funcDeleteTagOrigin = minimock.CallerInfo(1)
+	return mmDeleteTag.mock
+}
+
+// When sets expectation for the ProfileSvc.DeleteTag which will trigger the result defined by the following
+// Then helper
+func (mmDeleteTag *mProfileSvcMockDeleteTag) When(ctx context.Context, username string, name string) *ProfileSvcMockDeleteTagExpectation {
+	if mmDeleteTag.mock.funcDeleteTag != nil {
+		mmDeleteTag.mock.t.Fatalf("ProfileSvcMock.DeleteTag mock is already set by Set")
+	}
+
+	expectation := &ProfileSvcMockDeleteTagExpectation{
+		mock:               mmDeleteTag.mock,
+		params:             &ProfileSvcMockDeleteTagParams{ctx, username, name},
+		expectationOrigins: ProfileSvcMockDeleteTagExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteTag.expectations = append(mmDeleteTag.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ProfileSvc.DeleteTag return parameters for the expectation previously defined by the When method
+func (e *ProfileSvcMockDeleteTagExpectation) Then(err error) *ProfileSvcMock {
+	e.results = &ProfileSvcMockDeleteTagResults{err}
+	return e.mock
+}
+
+// Times sets number of times ProfileSvc.DeleteTag should be invoked
+func (mmDeleteTag *mProfileSvcMockDeleteTag) Times(n uint64) *mProfileSvcMockDeleteTag {
+	if n == 0 {
+		mmDeleteTag.mock.t.Fatalf("Times of ProfileSvcMock.DeleteTag mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteTag.expectedInvocations, n)
+	mmDeleteTag.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteTag
+}
+
+func (mmDeleteTag *mProfileSvcMockDeleteTag) invocationsDone() bool {
+	if len(mmDeleteTag.expectations) == 0 && mmDeleteTag.defaultExpectation == nil && mmDeleteTag.mock.funcDeleteTag == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteTag.mock.afterDeleteTagCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteTag.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteTag implements mm_handler.ProfileSvc
+func (mmDeleteTag *ProfileSvcMock) DeleteTag(ctx context.Context, username string, name string) (err error) {
+	mm_atomic.AddUint64(&mmDeleteTag.beforeDeleteTagCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteTag.afterDeleteTagCounter, 1)
+
+	mmDeleteTag.t.Helper()
+
+	if mmDeleteTag.inspectFuncDeleteTag != nil {
+		mmDeleteTag.inspectFuncDeleteTag(ctx, username, name)
+	}
+
+	mm_params := ProfileSvcMockDeleteTagParams{ctx, username, name}
+
+	// Record call args
+	mmDeleteTag.DeleteTagMock.mutex.Lock()
+	mmDeleteTag.DeleteTagMock.callArgs = append(mmDeleteTag.DeleteTagMock.callArgs, &mm_params)
+	mmDeleteTag.DeleteTagMock.mutex.Unlock()
+
+	for _, e := range mmDeleteTag.DeleteTagMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteTag.DeleteTagMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteTag.DeleteTagMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteTag.DeleteTagMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteTag.DeleteTagMock.defaultExpectation.paramPtrs
+
+		mm_got := ProfileSvcMockDeleteTagParams{ctx, username, name}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteTag.t.Errorf("ProfileSvcMock.DeleteTag got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTag.DeleteTagMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmDeleteTag.t.Errorf("ProfileSvcMock.DeleteTag got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTag.DeleteTagMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.name != nil && !minimock.Equal(*mm_want_ptrs.name, mm_got.name) {
+				mmDeleteTag.t.Errorf("ProfileSvcMock.DeleteTag got unexpected parameter name, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteTag.DeleteTagMock.defaultExpectation.expectationOrigins.originName, *mm_want_ptrs.name, mm_got.name, minimock.Diff(*mm_want_ptrs.name, mm_got.name))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteTag.t.Errorf("ProfileSvcMock.DeleteTag got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteTag.DeleteTagMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteTag.DeleteTagMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteTag.t.Fatal("No results are set for the ProfileSvcMock.DeleteTag")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteTag.funcDeleteTag != nil {
+		return mmDeleteTag.funcDeleteTag(ctx, username, name)
+	}
+	mmDeleteTag.t.Fatalf("Unexpected call to ProfileSvcMock.DeleteTag. %v %v %v", ctx, username, name)
+	return
+}
+
+// DeleteTagAfterCounter returns a count of finished ProfileSvcMock.DeleteTag invocations
+func (mmDeleteTag *ProfileSvcMock) DeleteTagAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteTag.afterDeleteTagCounter)
+}
+
+// DeleteTagBeforeCounter returns a count of ProfileSvcMock.DeleteTag invocations
+func (mmDeleteTag *ProfileSvcMock) DeleteTagBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteTag.beforeDeleteTagCounter)
+}
+
+// Calls returns a list of arguments used in each call to ProfileSvcMock.DeleteTag.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteTag *mProfileSvcMockDeleteTag) Calls() []*ProfileSvcMockDeleteTagParams {
+	mmDeleteTag.mutex.RLock()
+
+	argCopy := make([]*ProfileSvcMockDeleteTagParams, len(mmDeleteTag.callArgs))
+	copy(argCopy, mmDeleteTag.callArgs)
+
+	mmDeleteTag.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteTagDone returns true if the count of the DeleteTag invocations corresponds
+// the number of defined expectations
+func (m *ProfileSvcMock) MinimockDeleteTagDone() bool {
+	if m.DeleteTagMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteTagMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteTagMock.invocationsDone()
+}
+
+// MinimockDeleteTagInspect logs each unmet expectation
+func (m *ProfileSvcMock) MinimockDeleteTagInspect() {
+	for _, e := range m.DeleteTagMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ProfileSvcMock.DeleteTag at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteTagCounter := mm_atomic.LoadUint64(&m.afterDeleteTagCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteTagMock.defaultExpectation != nil && afterDeleteTagCounter < 1 {
+		if m.DeleteTagMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ProfileSvcMock.DeleteTag at\n%s", m.DeleteTagMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ProfileSvcMock.DeleteTag at\n%s with params: %#v", m.DeleteTagMock.defaultExpectation.expectationOrigins.origin, *m.DeleteTagMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteTag != nil && afterDeleteTagCounter < 1 {
+		m.t.Errorf("Expected call to ProfileSvcMock.DeleteTag at\n%s", m.funcDeleteTagOrigin)
+	}
+
+	if !m.DeleteTagMock.invocationsDone() && afterDeleteTagCounter > 0 {
+		m.t.Errorf("Expected %d calls to ProfileSvcMock.DeleteTag at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteTagMock.expectedInvocations), m.DeleteTagMock.expectedInvocationsOrigin, afterDeleteTagCounter)
+	}
+}
+
+type mProfileSvcMockGetFolders struct {
+	optional           bool
+	mock               *ProfileSvcMock
+	defaultExpectation *ProfileSvcMockGetFoldersExpectation
+	expectations       []*ProfileSvcMockGetFoldersExpectation
+
+	callArgs []*ProfileSvcMockGetFoldersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ProfileSvcMockGetFoldersExpectation specifies expectation struct of the ProfileSvc.GetFolders
+type ProfileSvcMockGetFoldersExpectation struct {
+	mock               *ProfileSvcMock
+	params             *ProfileSvcMockGetFoldersParams
+	paramPtrs          *ProfileSvcMockGetFoldersParamPtrs
+	expectationOrigins ProfileSvcMockGetFoldersExpectationOrigins
+	results            *ProfileSvcMockGetFoldersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ProfileSvcMockGetFoldersParams contains parameters of the ProfileSvc.GetFolders
+type ProfileSvcMockGetFoldersParams struct {
+	ctx      context.Context
+	username string
+}
+
+// ProfileSvcMockGetFoldersParamPtrs contains pointers to parameters of the ProfileSvc.GetFolders
+type ProfileSvcMockGetFoldersParamPtrs struct {
+	ctx      *context.Context
+	username *string
+}
+
+// ProfileSvcMockGetFoldersResults contains results of the ProfileSvc.GetFolders
+type ProfileSvcMockGetFoldersResults struct {
+	fa1 []profile.Folder
+	err error
+}
+
+// ProfileSvcMockGetFoldersOrigins contains origins of expectations of the ProfileSvc.GetFolders
+type ProfileSvcMockGetFoldersExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetFolders *mProfileSvcMockGetFolders) Optional() *mProfileSvcMockGetFolders {
+	mmGetFolders.optional = true
+	return mmGetFolders
+}
+
+// Expect sets up expected params for ProfileSvc.GetFolders
+func (mmGetFolders *mProfileSvcMockGetFolders) Expect(ctx context.Context, username string) *mProfileSvcMockGetFolders {
+	if mmGetFolders.mock.funcGetFolders != nil {
+		mmGetFolders.mock.t.Fatalf("ProfileSvcMock.GetFolders mock is already set by Set")
+	}
+
+	if mmGetFolders.defaultExpectation == nil {
+		mmGetFolders.defaultExpectation = &ProfileSvcMockGetFoldersExpectation{}
+	}
+
+	if mmGetFolders.defaultExpectation.paramPtrs != nil {
+		mmGetFolders.mock.t.Fatalf("ProfileSvcMock.GetFolders mock is already set by ExpectParams functions")
+	}
+
+	mmGetFolders.defaultExpectation.params = &ProfileSvcMockGetFoldersParams{ctx, username}
+	mmGetFolders.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetFolders.expectations {
+		if minimock.Equal(e.params, mmGetFolders.defaultExpectation.params) {
+			mmGetFolders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetFolders.defaultExpectation.params)
+		}
+	}
+
+	return mmGetFolders
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ProfileSvc.GetFolders
+func (mmGetFolders *mProfileSvcMockGetFolders) ExpectCtxParam1(ctx context.Context) *mProfileSvcMockGetFolders {
+	if mmGetFolders.mock.funcGetFolders != nil {
+		mmGetFolders.mock.t.Fatalf("ProfileSvcMock.GetFolders mock is already set by Set")
+	}
+
+	if mmGetFolders.defaultExpectation == nil {
+		mmGetFolders.defaultExpectation = &ProfileSvcMockGetFoldersExpectation{}
+	}
+
+	if mmGetFolders.defaultExpectation.params != nil {
+		mmGetFolders.mock.t.Fatalf("ProfileSvcMock.GetFolders mock is already set by Expect")
+	}
+
+	if mmGetFolders.defaultExpectation.paramPtrs == nil {
+		mmGetFolders.defaultExpectation.paramPtrs = &ProfileSvcMockGetFoldersParamPtrs{}
+	}
+	mmGetFolders.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetFolders.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetFolders
+}
+
+// ExpectUsernameParam2 sets up expected param username for ProfileSvc.GetFolders
+func (mmGetFolders *mProfileSvcMockGetFolders) ExpectUsernameParam2(username string) *mProfileSvcMockGetFolders {
+	if mmGetFolders.mock.funcGetFolders != nil {
+		mmGetFolders.mock.t.Fatalf("ProfileSvcMock.GetFolders mock is already set by Set")
+	}
+
+	if mmGetFolders.defaultExpectation == nil {
+		mmGetFolders.defaultExpectation = &ProfileSvcMockGetFoldersExpectation{}
+	}
+
+	if mmGetFolders.defaultExpectation.params != nil {
+		mmGetFolders.mock.t.Fatalf("ProfileSvcMock.GetFolders mock is already set by Expect")
+	}
+
+	if mmGetFolders.defaultExpectation.paramPtrs == nil {
+		mmGetFolders.defaultExpectation.paramPtrs = &ProfileSvcMockGetFoldersParamPtrs{}
+	}
+	mmGetFolders.defaultExpectation.paramPtrs.username = &username
+	mmGetFolders.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetFolders
+}
+
+// Inspect accepts an inspector function that has same arguments as the ProfileSvc.GetFolders
+func (mmGetFolders *mProfileSvcMockGetFolders) Inspect(f func(ctx context.Context, username string)) *mProfileSvcMockGetFolders {
+	if mmGetFolders.mock.inspectFuncGetFolders != nil {
+		mmGetFolders.mock.t.Fatalf("Inspect function is already set for ProfileSvcMock.GetFolders")
+	}
+
+	mmGetFolders.mock.inspectFuncGetFolders = f
+
+	return mmGetFolders
+}
+
+// Return sets up results that will be returned by ProfileSvc.GetFolders
+func (mmGetFolders *mProfileSvcMockGetFolders) Return(fa1 []profile.Folder, err error) *ProfileSvcMock {
+	if mmGetFolders.mock.funcGetFolders != nil {
+		mmGetFolders.mock.t.Fatalf("ProfileSvcMock.GetFolders mock is already set by Set")
+	}
+
+	if mmGetFolders.defaultExpectation == nil {
+		mmGetFolders.defaultExpectation = &ProfileSvcMockGetFoldersExpectation{mock: mmGetFolders.mock}
+	}
+	mmGetFolders.defaultExpectation.results = &ProfileSvcMockGetFoldersResults{fa1, err}
+	mmGetFolders.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetFolders.mock
+}
+
+// Set uses given function f to mock the ProfileSvc.GetFolders method
+func (mmGetFolders *mProfileSvcMockGetFolders) Set(f func(ctx context.Context, username string) (fa1 []profile.Folder, err error)) *ProfileSvcMock {
+	if mmGetFolders.defaultExpectation != nil {
+		mmGetFolders.mock.t.Fatalf("Default expectation is already set for the ProfileSvc.GetFolders method")
+	}
+
+	if len(mmGetFolders.expectations) > 0 {
+		mmGetFolders.mock.t.Fatalf("Some expectations are already set for the ProfileSvc.GetFolders method")
+	}
+
+	mmGetFolders.mock.funcGetFolders = f
+	mmGetFolders.mock.funcGetFoldersOrigin = minimock.CallerInfo(1)
+	return mmGetFolders.mock
+}
+
+// When sets expectation for the ProfileSvc.GetFolders which will trigger the result defined by the following
+// Then helper
+func (mmGetFolders *mProfileSvcMockGetFolders) When(ctx context.Context, username string) *ProfileSvcMockGetFoldersExpectation {
+	if mmGetFolders.mock.funcGetFolders != nil {
+		mmGetFolders.mock.t.Fatalf("ProfileSvcMock.GetFolders mock is already set by Set")
+	}
+
+	expectation := &ProfileSvcMockGetFoldersExpectation{
+		mock:               mmGetFolders.mock,
+		params:             &ProfileSvcMockGetFoldersParams{ctx, username},
+		expectationOrigins: ProfileSvcMockGetFoldersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetFolders.expectations = append(mmGetFolders.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ProfileSvc.GetFolders return parameters for the expectation previously defined by the When method
+func (e *ProfileSvcMockGetFoldersExpectation) Then(fa1 []profile.Folder, err error) *ProfileSvcMock {
+	e.results = &ProfileSvcMockGetFoldersResults{fa1, err}
+	return e.mock
+}
+
+// Times sets number of times ProfileSvc.GetFolders should be invoked
+func (mmGetFolders *mProfileSvcMockGetFolders) Times(n uint64) *mProfileSvcMockGetFolders {
+	if n == 0 {
+		mmGetFolders.mock.t.Fatalf("Times of ProfileSvcMock.GetFolders mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetFolders.expectedInvocations, n)
+	mmGetFolders.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetFolders
+}
+
+func (mmGetFolders *mProfileSvcMockGetFolders) invocationsDone() bool {
+	if len(mmGetFolders.expectations) == 0 && mmGetFolders.defaultExpectation == nil && mmGetFolders.mock.funcGetFolders == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetFolders.mock.afterGetFoldersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetFolders.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetFolders implements mm_handler.ProfileSvc
+func (mmGetFolders *ProfileSvcMock) GetFolders(ctx context.Context, username string) (fa1 []profile.Folder, err error) {
+	mm_atomic.AddUint64(&mmGetFolders.beforeGetFoldersCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetFolders.afterGetFoldersCounter, 1)
+
+	mmGetFolders.t.Helper()
+
+	if mmGetFolders.inspectFuncGetFolders != nil {
+		mmGetFolders.inspectFuncGetFolders(ctx, username)
+	}
+
+	mm_params := ProfileSvcMockGetFoldersParams{ctx, username}
+
+	// Record call args
+	mmGetFolders.GetFoldersMock.mutex.Lock()
+	mmGetFolders.GetFoldersMock.callArgs = append(mmGetFolders.GetFoldersMock.callArgs, &mm_params)
+	mmGetFolders.GetFoldersMock.mutex.Unlock()
+
+	for _, e := range mmGetFolders.GetFoldersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.fa1, e.results.err
+		}
+	}
+
+	if mmGetFolders.GetFoldersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetFolders.GetFoldersMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetFolders.GetFoldersMock.defaultExpectation.params
+		mm_want_ptrs := mmGetFolders.GetFoldersMock.defaultExpectation.paramPtrs
+
+		mm_got := ProfileSvcMockGetFoldersParams{ctx, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetFolders.t.Errorf("ProfileSvcMock.GetFolders got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetFolders.GetFoldersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetFolders.t.Errorf("ProfileSvcMock.GetFolders got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetFolders.GetFoldersMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetFolders.t.Errorf("ProfileSvcMock.GetFolders got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetFolders.GetFoldersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetFolders.GetFoldersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetFolders.t.Fatal("No results are set for the ProfileSvcMock.GetFolders")
+		}
+		return (*mm_results).fa1, (*mm_results).err
+	}
+	if mmGetFolders.funcGetFolders != nil {
+		return mmGetFolders.funcGetFolders(ctx, username)
+	}
+	mmGetFolders.t.Fatalf("Unexpected call to ProfileSvcMock.GetFolders. %v %v", ctx, username)
+	return
+}
+
+// GetFoldersAfterCounter returns a count of finished ProfileSvcMock.GetFolders invocations
+func (mmGetFolders *ProfileSvcMock) GetFoldersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetFolders.afterGetFoldersCounter)
+}
+
+// GetFoldersBeforeCounter returns a count of ProfileSvcMock.GetFolders invocations
+func (mmGetFolders *ProfileSvcMock) GetFoldersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetFolders.beforeGetFoldersCounter)
+}
+
+// Calls returns a list of arguments used in each call to ProfileSvcMock.GetFolders.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetFolders *mProfileSvcMockGetFolders) Calls() []*ProfileSvcMockGetFoldersParams {
+	mmGetFolders.mutex.RLock()
+
+	argCopy := make([]*ProfileSvcMockGetFoldersParams, len(mmGetFolders.callArgs))
+	copy(argCopy, mmGetFolders.callArgs)
+
+	mmGetFolders.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetFoldersDone returns true if the count of the GetFolders invocations corresponds
+// the number of defined expectations
+func (m *ProfileSvcMock) MinimockGetFoldersDone() bool {
+	if m.GetFoldersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetFoldersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetFoldersMock.invocationsDone()
+}
+
+// MinimockGetFoldersInspect logs each unmet expectation
+func (m *ProfileSvcMock) MinimockGetFoldersInspect() {
+	for _, e := range m.GetFoldersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ProfileSvcMock.GetFolders at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetFoldersCounter := mm_atomic.LoadUint64(&m.afterGetFoldersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetFoldersMock.defaultExpectation != nil && afterGetFoldersCounter < 1 {
+		if m.GetFoldersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ProfileSvcMock.GetFolders at\n%s", m.GetFoldersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ProfileSvcMock.GetFolders at\n%s with params: %#v", m.GetFoldersMock.defaultExpectation.expectationOrigins.origin, *m.GetFoldersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetFolders != nil && afterGetFoldersCounter < 1 {
+		m.t.Errorf("Expected call to ProfileSvcMock.GetFolders at\n%s", m.funcGetFoldersOrigin)
+	}
+
+	if !m.GetFoldersMock.invocationsDone() && afterGetFoldersCounter > 0 {
+		m.t.Errorf("Expected %d calls to ProfileSvcMock.GetFolders at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetFoldersMock.expectedInvocations), m.GetFoldersMock.expectedInvocationsOrigin, afterGetFoldersCounter)
+	}
+}
+
+type mProfileSvcMockGetTags struct {
+	optional           bool
+	mock               *ProfileSvcMock
+	defaultExpectation *ProfileSvcMockGetTagsExpectation
+	expectations       []*ProfileSvcMockGetTagsExpectation
+
+	callArgs []*ProfileSvcMockGetTagsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ProfileSvcMockGetTagsExpectation specifies expectation struct of the ProfileSvc.GetTags
+type ProfileSvcMockGetTagsExpectation struct {
+	mock               *ProfileSvcMock
+	params             *ProfileSvcMockGetTagsParams
+	paramPtrs          *ProfileSvcMockGetTagsParamPtrs
+	expectationOrigins ProfileSvcMockGetTagsExpectationOrigins
+	results            *ProfileSvcMockGetTagsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ProfileSvcMockGetTagsParams contains parameters of the ProfileSvc.GetTags
+type ProfileSvcMockGetTagsParams struct {
+	ctx      context.Context
+	username string
+}
+
+// ProfileSvcMockGetTagsParamPtrs contains pointers to parameters of the ProfileSvc.GetTags
+type ProfileSvcMockGetTagsParamPtrs struct {
+	ctx      *context.Context
+	username *string
+}
+
+// ProfileSvcMockGetTagsResults contains results of the ProfileSvc.GetTags
+type ProfileSvcMockGetTagsResults struct {
+	ta1 []profile.Tag
+	err error
+}
+
+// ProfileSvcMockGetTagsOrigins contains origins of expectations of the ProfileSvc.GetTags
+type ProfileSvcMockGetTagsExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetTags *mProfileSvcMockGetTags) Optional() *mProfileSvcMockGetTags {
+	mmGetTags.optional = true
+	return mmGetTags
+}
+
+// Expect sets up expected params for ProfileSvc.GetTags
+func (mmGetTags *mProfileSvcMockGetTags) Expect(ctx context.Context, username string) *mProfileSvcMockGetTags {
+	if mmGetTags.mock.funcGetTags != nil {
+		mmGetTags.mock.t.Fatalf("ProfileSvcMock.GetTags mock is already set by Set")
+	}
+
+	if mmGetTags.defaultExpectation == nil {
+		mmGetTags.defaultExpectation = &ProfileSvcMockGetTagsExpectation{}
+	}
+
+	if mmGetTags.defaultExpectation.paramPtrs != nil {
+		mmGetTags.mock.t.Fatalf("ProfileSvcMock.GetTags mock is already set by ExpectParams functions")
+	}
+
+	mmGetTags.defaultExpectation.params = &ProfileSvcMockGetTagsParams{ctx, username}
+	mmGetTags.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetTags.expectations {
+		if minimock.Equal(e.params, mmGetTags.defaultExpectation.params) {
+			mmGetTags.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetTags.defaultExpectation.params)
+		}
+	}
+
+	return mmGetTags
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ProfileSvc.GetTags
+func (mmGetTags *mProfileSvcMockGetTags) ExpectCtxParam1(ctx context.Context) *mProfileSvcMockGetTags {
+	if mmGetTags.mock.funcGetTags != nil {
+		mmGetTags.mock.t.Fatalf("ProfileSvcMock.GetTags mock is already set by Set")
+	}
+
+	if mmGetTags.defaultExpectation == nil {
+		mmGetTags.defaultExpectation = &ProfileSvcMockGetTagsExpectation{}
+	}
+
+	if mmGetTags.defaultExpectation.params != nil {
+		mmGetTags.mock.t.Fatalf("ProfileSvcMock.GetTags mock is already set by Expect")
+	}
+
+	if mmGetTags.defaultExpectation.paramPtrs == nil {
+		mmGetTags.defaultExpectation.paramPtrs = &ProfileSvcMockGetTagsParamPtrs{}
+	}
+	mmGetTags.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetTags.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetTags
+}
+
+// ExpectUsernameParam2 sets up expected param username for ProfileSvc.GetTags
+func (mmGetTags *mProfileSvcMockGetTags) ExpectUsernameParam2(username string) *mProfileSvcMockGetTags {
+	if mmGetTags.mock.funcGetTags != nil {
+		mmGetTags.mock.t.Fatalf("ProfileSvcMock.GetTags mock is already set by Set")
+	}
+
+	if mmGetTags.defaultExpectation == nil {
+		mmGetTags.defaultExpectation = &ProfileSvcMockGetTagsExpectation{}
+	}
+
+	if mmGetTags.defaultExpectation.params != nil {
+		mmGetTags.mock.t.Fatalf("ProfileSvcMock.GetTags mock is already set by Expect")
+	}
+
+	if mmGetTags.defaultExpectation.paramPtrs == nil {
+		mmGetTags.defaultExpectation.paramPtrs = &ProfileSvcMockGetTagsParamPtrs{}
+	}
+	mmGetTags.defaultExpectation.paramPtrs.username = &username
+	mmGetTags.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetTags
+}
+
+// Inspect accepts an inspector function that has same arguments as the ProfileSvc.GetTags
+func (mmGetTags *mProfileSvcMockGetTags) Inspect(f func(ctx context.Context, username string)) *mProfileSvcMockGetTags {
+	if mmGetTags.mock.inspectFuncGetTags != nil {
+		mmGetTags.mock.t.Fatalf("Inspect function is already set for ProfileSvcMock.GetTags")
+	}
+
+	mmGetTags.mock.inspectFuncGetTags = f
+
+	return mmGetTags
+}
+
+// Return sets up results that will be returned by ProfileSvc.GetTags
+func (mmGetTags *mProfileSvcMockGetTags) Return(ta1 []profile.Tag, err error) *ProfileSvcMock {
+	if mmGetTags.mock.funcGetTags != nil {
+		mmGetTags.mock.t.Fatalf("ProfileSvcMock.GetTags mock is already set by Set")
+	}
+
+	if mmGetTags.defaultExpectation == nil {
+		mmGetTags.defaultExpectation = &ProfileSvcMockGetTagsExpectation{mock: mmGetTags.mock}
+	}
+	mmGetTags.defaultExpectation.results = &ProfileSvcMockGetTagsResults{ta1, err}
+	mmGetTags.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetTags.mock
+}
+
+// Set uses given function f to mock the ProfileSvc.GetTags method
+func (mmGetTags *mProfileSvcMockGetTags) Set(f func(ctx context.Context, username string) (ta1 []profile.Tag, err error)) *ProfileSvcMock {
+	if mmGetTags.defaultExpectation != nil {
+		mmGetTags.mock.t.Fatalf("Default expectation is already set for the ProfileSvc.GetTags method")
+	}
+
+	if len(mmGetTags.expectations) > 0 {
+		mmGetTags.mock.t.Fatalf("Some expectations are already set for the ProfileSvc.GetTags method")
+	}
+
+	mmGetTags.mock.funcGetTags = f
+	mmGetTags.mock.funcGetTagsOrigin = minimock.CallerInfo(1)
+	return mmGetTags.mock
+}
+
+// When sets expectation for the ProfileSvc.GetTags which will trigger the result defined by the following
+// Then helper
+func (mmGetTags *mProfileSvcMockGetTags) When(ctx context.Context, username string) *ProfileSvcMockGetTagsExpectation {
+	if mmGetTags.mock.funcGetTags != nil {
+		mmGetTags.mock.t.Fatalf("ProfileSvcMock.GetTags mock is already set by Set")
+	}
+
+	expectation := &ProfileSvcMockGetTagsExpectation{
+		mock:               mmGetTags.mock,
+		params:             &ProfileSvcMockGetTagsParams{ctx, username},
+		expectationOrigins: ProfileSvcMockGetTagsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetTags.expectations = append(mmGetTags.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ProfileSvc.GetTags return parameters for the expectation previously defined by the When method
+func (e *ProfileSvcMockGetTagsExpectation) Then(ta1 []profile.Tag, err error) *ProfileSvcMock {
+	e.results = &ProfileSvcMockGetTagsResults{ta1, err}
+	return e.mock
+}
+
+// Times sets number of times ProfileSvc.GetTags should be invoked
+func (mmGetTags *mProfileSvcMockGetTags) Times(n uint64) *mProfileSvcMockGetTags {
+	if n == 0 {
+		mmGetTags.mock.t.Fatalf("Times of ProfileSvcMock.GetTags mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetTags.expectedInvocations, n)
+	mmGetTags.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetTags
+}
+
+func (mmGetTags *mProfileSvcMockGetTags) invocationsDone() bool {
+	if len(mmGetTags.expectations) == 0 && mmGetTags.defaultExpectation == nil && mmGetTags.mock.funcGetTags == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetTags.mock.afterGetTagsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetTags.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetTags implements mm_handler.ProfileSvc
+func (mmGetTags *ProfileSvcMock) GetTags(ctx context.Context, username string) (ta1 []profile.Tag, err error) {
+	mm_atomic.AddUint64(&mmGetTags.beforeGetTagsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetTags.afterGetTagsCounter, 1)
+
+	mmGetTags.t.Helper()
+
+	if mmGetTags.inspectFuncGetTags != nil {
+		mmGetTags.inspectFuncGetTags(ctx, username)
+	}
+
+	mm_params := ProfileSvcMockGetTagsParams{ctx, username}
+
+	// Record call args
+	mmGetTags.GetTagsMock.mutex.Lock()
+	mmGetTags.GetTagsMock.callArgs = append(mmGetTags.GetTagsMock.callArgs, &mm_params)
+	mmGetTags.GetTagsMock.mutex.Unlock()
+
+	for _, e := range mmGetTags.GetTagsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ta1, e.results.err
+		}
+	}
+
+	if mmGetTags.GetTagsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetTags.GetTagsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetTags.GetTagsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetTags.GetTagsMock.defaultExpectation.paramPtrs
+
+		mm_got := ProfileSvcMockGetTagsParams{ctx, username}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetTags.t.Errorf("ProfileSvcMock.GetTags got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTags.GetTagsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetTags.t.Errorf("ProfileSvcMock.GetTags got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetTags.GetTagsMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetTags.t.Errorf("ProfileSvcMock.GetTags got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetTags.GetTagsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetTags.GetTagsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetTags.t.Fatal("No results are set for the ProfileSvcMock.GetTags")
+		}
+		return (*mm_results).ta1, (*mm_results).err
+	}
+	if mmGetTags.funcGetTags != nil {
+		return mmGetTags.funcGetTags(ctx, username)
+	}
+	mmGetTags.t.Fatalf("Unexpected call to ProfileSvcMock.GetTags. %v %v", ctx, username)
+	return
+}
+
+// GetTagsAfterCounter returns a count of finished ProfileSvcMock.GetTags invocations
+func (mmGetTags *ProfileSvcMock) GetTagsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTags.afterGetTagsCounter)
+}
+
+// GetTagsBeforeCounter returns a count of ProfileSvcMock.GetTags invocations
+func (mmGetTags *ProfileSvcMock) GetTagsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetTags.beforeGetTagsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ProfileSvcMock.GetTags.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetTags *mProfileSvcMockGetTags) Calls() []*ProfileSvcMockGetTagsParams {
+	mmGetTags.mutex.RLock()
+
+	argCopy := make([]*ProfileSvcMockGetTagsParams, len(mmGetTags.callArgs))
+	copy(argCopy, mmGetTags.callArgs)
+
+	mmGetTags.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetTagsDone returns true if the count of the GetTags invocations corresponds
+// the number of defined expectations
+func (m *ProfileSvcMock) MinimockGetTagsDone() bool {
+	if m.GetTagsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetTagsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetTagsMock.invocationsDone()
+}
+
+// MinimockGetTagsInspect logs each unmet expectation
+func (m *ProfileSvcMock) MinimockGetTagsInspect() {
+	for _, e := range m.GetTagsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ProfileSvcMock.GetTags at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetTagsCounter := mm_atomic.LoadUint64(&m.afterGetTagsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetTagsMock.defaultExpectation != nil && afterGetTagsCounter < 1 {
+		if m.GetTagsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ProfileSvcMock.GetTags at\n%s", m.GetTagsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ProfileSvcMock.GetTags at\n%s with params: %#v", m.GetTagsMock.defaultExpectation.expectationOrigins.origin, *m.GetTagsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetTags != nil && afterGetTagsCounter < 1 {
+		m.t.Errorf("Expected call to ProfileSvcMock.GetTags at\n%s", m.funcGetTagsOrigin)
+	}
+
+	if !m.GetTagsMock.invocationsDone() && afterGetTagsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ProfileSvcMock.GetTags at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetTagsMock.expectedInvocations), m.GetTagsMock.expectedInvocationsOrigin, afterGetTagsCounter)
+	}
+}
+
+type mProfileSvcMockGetUserCards struct {
+	optional           bool
+	mock               *ProfileSvcMock
+	defaultExpectation *ProfileSvcMockGetUserCardsExpectation
+	expectations       []*ProfileSvcMockGetUserCardsExpectation
+
+	callArgs []*ProfileSvcMockGetUserCardsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ProfileSvcMockGetUserCardsExpectation specifies expectation struct of the ProfileSvc.GetUserCards
+type ProfileSvcMockGetUserCardsExpectation struct {
+	mock               *ProfileSvcMock
+	params             *ProfileSvcMockGetUserCardsParams
+	paramPtrs          *ProfileSvcMockGetUserCardsParamPtrs
+	expectationOrigins ProfileSvcMockGetUserCardsExpectationOrigins
+	results            *ProfileSvcMockGetUserCardsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ProfileSvcMockGetUserCardsParams contains parameters of the ProfileSvc.GetUserCards
+type ProfileSvcMockGetUserCardsParams struct {
+	ctx      context.Context
+	username string
+	filter   profile.Filter
+	page     pagination.Request
+}
+
+// ProfileSvcMockGetUserCardsParamPtrs contains pointers to parameters of the ProfileSvc.GetUserCards
+type ProfileSvcMockGetUserCardsParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	filter   *profile.Filter
+	page     *pagination.Request
+}
+
+// ProfileSvcMockGetUserCardsResults contains results of the ProfileSvc.GetUserCards
+type ProfileSvcMockGetUserCardsResults struct {
+	cards []profile.CardInfo
+	next  string
+	err   error
+}
+
+// ProfileSvcMockGetUserCardsOrigins contains origins of expectations of the ProfileSvc.GetUserCards
+type ProfileSvcMockGetUserCardsExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originFilter   string
+	originPage     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetUserCards *mProfileSvcMockGetUserCards) Optional() *mProfileSvcMockGetUserCards {
+	mmGetUserCards.optional = true
+	return mmGetUserCards
+}
+
+// Expect sets up expected params for ProfileSvc.GetUserCards
+func (mmGetUserCards *mProfileSvcMockGetUserCards) Expect(ctx context.Context, username string, filter profile.Filter, page pagination.Request) *mProfileSvcMockGetUserCards {
+	if mmGetUserCards.mock.funcGetUserCards != nil {
+		mmGetUserCards.mock.t.Fatalf("ProfileSvcMock.GetUserCards mock is already set by Set")
+	}
+
+	if mmGetUserCards.defaultExpectation == nil {
+		mmGetUserCards.defaultExpectation = &ProfileSvcMockGetUserCardsExpectation{}
+	}
+
+	if mmGetUserCards.defaultExpectation.paramPtrs != nil {
+		mmGetUserCards.mock.t.Fatalf("ProfileSvcMock.GetUserCards mock is already set by ExpectParams functions")
+	}
+
+	mmGetUserCards.defaultExpectation.params = &ProfileSvcMockGetUserCardsParams{ctx, username, filter, page}
+	mmGetUserCards.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetUserCards.expectations {
+		if minimock.Equal(e.params, mmGetUserCards.defaultExpectation.params) {
+			mmGetUserCards.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserCards.defaultExpectation.params)
+		}
+	}
+
+	return mmGetUserCards
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ProfileSvc.GetUserCards
+func (mmGetUserCards *mProfileSvcMockGetUserCards) ExpectCtxParam1(ctx context.Context) *mProfileSvcMockGetUserCards {
+	if mmGetUserCards.mock.funcGetUserCards != nil {
+		mmGetUserCards.mock.t.Fatalf("ProfileSvcMock.GetUserCards mock is already set by Set")
+	}
+
+	if mmGetUserCards.defaultExpectation == nil {
+		mmGetUserCards.defaultExpectation = &ProfileSvcMockGetUserCardsExpectation{}
+	}
+
+	if mmGetUserCards.defaultExpectation.params != nil {
+		mmGetUserCards.mock.t.Fatalf("ProfileSvcMock.GetUserCards mock is already set by Expect")
+	}
+
+	if mmGetUserCards.defaultExpectation.paramPtrs == nil {
+		mmGetUserCards.defaultExpectation.paramPtrs = &ProfileSvcMockGetUserCardsParamPtrs{}
+	}
+	mmGetUserCards.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetUserCards.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetUserCards
+}
+
+// ExpectUsernameParam2 sets up expected param username for ProfileSvc.GetUserCards
+func (mmGetUserCards *mProfileSvcMockGetUserCards) ExpectUsernameParam2(username string) *mProfileSvcMockGetUserCards {
+	if mmGetUserCards.mock.funcGetUserCards != nil {
+		mmGetUserCards.mock.t.Fatalf("ProfileSvcMock.GetUserCards mock is already set by Set")
+	}
+
+	if mmGetUserCards.defaultExpectation == nil {
+		mmGetUserCards.defaultExpectation = &ProfileSvcMockGetUserCardsExpectation{}
+	}
+
+	if mmGetUserCards.defaultExpectation.params != nil {
+		mmGetUserCards.mock.t.Fatalf("ProfileSvcMock.GetUserCards mock is already set by Expect")
+	}
+
+	if mmGetUserCards.defaultExpectation.paramPtrs == nil {
+		mmGetUserCards.defaultExpectation.paramPtrs = &ProfileSvcMockGetUserCardsParamPtrs{}
+	}
+	mmGetUserCards.defaultExpectation.paramPtrs.username = &username
+	mmGetUserCards.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmGetUserCards
+}
+
+// ExpectFilterParam3 sets up expected param filter for ProfileSvc.GetUserCards
+func (mmGetUserCards *mProfileSvcMockGetUserCards) ExpectFilterParam3(filter profile.Filter) *mProfileSvcMockGetUserCards {
+	if mmGetUserCards.mock.funcGetUserCards != nil {
+		mmGetUserCards.mock.t.Fatalf("ProfileSvcMock.GetUserCards mock is already set by Set")
+	}
+
+	if mmGetUserCards.defaultExpectation == nil {
+		mmGetUserCards.defaultExpectation = &ProfileSvcMockGetUserCardsExpectation{}
+	}
+
+	if mmGetUserCards.defaultExpectation.params != nil {
+		mmGetUserCards.mock.t.Fatalf("ProfileSvcMock.GetUserCards mock is already set by Expect")
+	}
+
+	if mmGetUserCards.defaultExpectation.paramPtrs == nil {
+		mmGetUserCards.defaultExpectation.paramPtrs = &ProfileSvcMockGetUserCardsParamPtrs{}
+	}
+	mmGetUserCards.defaultExpectation.paramPtrs.filter = &filter
+	mmGetUserCards.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmGetUserCards
+}
+
+// ExpectPageParam4 sets up expected param page for ProfileSvc.GetUserCards
+func (mmGetUserCards *mProfileSvcMockGetUserCards) ExpectPageParam4(page pagination.Request) *mProfileSvcMockGetUserCards {
+	if mmGetUserCards.mock.funcGetUserCards != nil {
+		mmGetUserCards.mock.t.Fatalf("ProfileSvcMock.GetUserCards mock is already set by Set")
+	}
+
+	if mmGetUserCards.defaultExpectation == nil {
+		mmGetUserCards.defaultExpectation = &ProfileSvcMockGetUserCardsExpectation{}
+	}
+
+	if mmGetUserCards.defaultExpectation.params != nil {
+		mmGetUserCards.mock.t.Fatalf("ProfileSvcMock.GetUserCards mock is already set by Expect")
+	}
+
+	if mmGetUserCards.defaultExpectation.paramPtrs == nil {
+		mmGetUserCards.defaultExpectation.paramPtrs = &ProfileSvcMockGetUserCardsParamPtrs{}
+	}
+	mmGetUserCards.defaultExpectation.paramPtrs.page = &page
+	mmGetUserCards.defaultExpectation.expectationOrigins.originPage = minimock.CallerInfo(1)
+
+	return mmGetUserCards
+}
+
+// Inspect accepts an inspector function that has same arguments as the ProfileSvc.GetUserCards
+func (mmGetUserCards *mProfileSvcMockGetUserCards) Inspect(f func(ctx context.Context, username string, filter profile.Filter, page pagination.Request)) *mProfileSvcMockGetUserCards {
+	if mmGetUserCards.mock.inspectFuncGetUserCards != nil {
+		mmGetUserCards.mock.t.Fatalf("Inspect function is already set for ProfileSvcMock.GetUserCards")
+	}
+
+	mmGetUserCards.mock.inspectFuncGetUserCards = f
+
+	return mmGetUserCards
+}
+
+// Return sets up results that will be returned by ProfileSvc.GetUserCards
+func (mmGetUserCards *mProfileSvcMockGetUserCards) Return(cards []profile.CardInfo, next string, err error) *ProfileSvcMock {
+	if mmGetUserCards.mock.funcGetUserCards != nil {
+		mmGetUserCards.mock.t.Fatalf("ProfileSvcMock.GetUserCards mock is already set by Set")
+	}
+
+	if mmGetUserCards.defaultExpectation == nil {
+		mmGetUserCards.defaultExpectation = &ProfileSvcMockGetUserCardsExpectation{mock: mmGetUserCards.mock}
+	}
+	mmGetUserCards.defaultExpectation.results = &ProfileSvcMockGetUserCardsResults{cards, next, err}
+	mmGetUserCards.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetUserCards.mock
+}
+
+// Set uses given function f to mock the ProfileSvc.GetUserCards method
+func (mmGetUserCards *mProfileSvcMockGetUserCards) Set(f func(ctx context.Context, username string, filter profile.Filter, page pagination.Request) (cards []profile.CardInfo, next string, err error)) *ProfileSvcMock {
+	if mmGetUserCards.defaultExpectation != nil {
+		mmGetUserCards.mock.t.Fatalf("Default expectation is already set for the ProfileSvc.GetUserCards method")
+	}
+
+	if len(mmGetUserCards.expectations) > 0 {
+		mmGetUserCards.mock.t.Fatalf("Some expectations are already set for the ProfileSvc.GetUserCards method")
+	}
+
+	mmGetUserCards.mock.funcGetUserCards = f
+	mmGetUserCards.mock.funcGetUserCardsOrigin = minimock.CallerInfo(1)
+	return mmGetUserCards.mock
+}
+
+// When sets expectation for the ProfileSvc.GetUserCards which will trigger the result defined by the following
+// Then helper
+func (mmGetUserCards *mProfileSvcMockGetUserCards) When(ctx context.Context, username string, filter profile.Filter, page pagination.Request) *ProfileSvcMockGetUserCardsExpectation {
+	if mmGetUserCards.mock.funcGetUserCards != nil {
+		mmGetUserCards.mock.t.Fatalf("ProfileSvcMock.GetUserCards mock is already set by Set")
+	}
+
+	expectation := &ProfileSvcMockGetUserCardsExpectation{
+		mock:               mmGetUserCards.mock,
+		params:             &ProfileSvcMockGetUserCardsParams{ctx, username, filter, page},
+		expectationOrigins: ProfileSvcMockGetUserCardsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetUserCards.expectations = append(mmGetUserCards.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ProfileSvc.GetUserCards return parameters for the expectation previously defined by the When method
+func (e *ProfileSvcMockGetUserCardsExpectation) Then(cards []profile.CardInfo, next string, err error) *ProfileSvcMock {
+	e.results = &ProfileSvcMockGetUserCardsResults{cards, next, err}
+	return e.mock
+}
+
+// Times sets number of times ProfileSvc.GetUserCards should be invoked
+func (mmGetUserCards *mProfileSvcMockGetUserCards) Times(n uint64) *mProfileSvcMockGetUserCards {
+	if n == 0 {
+		mmGetUserCards.mock.t.Fatalf("Times of ProfileSvcMock.GetUserCards mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetUserCards.expectedInvocations, n)
+	mmGetUserCards.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetUserCards
+}
+
+func (mmGetUserCards *mProfileSvcMockGetUserCards) invocationsDone() bool {
+	if len(mmGetUserCards.expectations) == 0 && mmGetUserCards.defaultExpectation == nil && mmGetUserCards.mock.funcGetUserCards == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetUserCards.mock.afterGetUserCardsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserCards.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetUserCards implements mm_handler.ProfileSvc
+func (mmGetUserCards *ProfileSvcMock) GetUserCards(ctx context.Context, username string, filter profile.Filter, page pagination.Request) (cards []profile.CardInfo, next string, err error) {
+	mm_atomic.AddUint64(&mmGetUserCards.beforeGetUserCardsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetUserCards.afterGetUserCardsCounter, 1)
+
+	mmGetUserCards.t.Helper()
+
+	if mmGetUserCards.inspectFuncGetUserCards != nil {
+		mmGetUserCards.inspectFuncGetUserCards(ctx, username, filter, page)
+	}
+
+	mm_params := ProfileSvcMockGetUserCardsParams{ctx, username, filter, page}
+
+	// Record call args
+	mmGetUserCards.GetUserCardsMock.mutex.Lock()
+	mmGetUserCards.GetUserCardsMock.callArgs = append(mmGetUserCards.GetUserCardsMock.callArgs, &mm_params)
+	mmGetUserCards.GetUserCardsMock.mutex.Unlock()
+
+	for _, e := range mmGetUserCards.GetUserCardsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.cards, e.results.next, e.results.err
+		}
+	}
+
+	if mmGetUserCards.GetUserCardsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetUserCards.GetUserCardsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetUserCards.GetUserCardsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetUserCards.GetUserCardsMock.defaultExpectation.paramPtrs
+
+		mm_got := ProfileSvcMockGetUserCardsParams{ctx, username, filter, page}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetUserCards.t.Errorf("ProfileSvcMock.GetUserCards got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserCards.GetUserCardsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmGetUserCards.t.Errorf("ProfileSvcMock.GetUserCards got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserCards.GetUserCardsMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmGetUserCards.t.Errorf("ProfileSvcMock.GetUserCards got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserCards.GetUserCardsMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
+			}
+
+			if mm_want_ptrs.page != nil && !minimock.Equal(*mm_want_ptrs.page, mm_got.page) {
+				mmGetUserCards.t.Errorf("ProfileSvcMock.GetUserCards got unexpected parameter page, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetUserCards.GetUserCardsMock.defaultExpectation.expectationOrigins.originPage, *mm_want_ptrs.page, mm_got.page, minimock.Diff(*mm_want_ptrs.page, mm_got.page))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetUserCards.t.Errorf("ProfileSvcMock.GetUserCards got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetUserCards.GetUserCardsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetUserCards.GetUserCardsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetUserCards.t.Fatal("No results are set for the ProfileSvcMock.GetUserCards")
+		}
+		return (*mm_results).cards, (*mm_results).next, (*mm_results).err
+	}
+	if mmGetUserCards.funcGetUserCards != nil {
+		return mmGetUserCards.funcGetUserCards(ctx, username, filter, page)
+	}
+	mmGetUserCards.t.Fatalf("Unexpected call to ProfileSvcMock.GetUserCards. %v %v %v %v", ctx, username, filter, page)
+	return
+}
+
+// GetUserCardsAfterCounter returns a count of finished ProfileSvcMock.GetUserCards invocations
+func (mmGetUserCards *ProfileSvcMock) GetUserCardsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserCards.afterGetUserCardsCounter)
+}
+
+// GetUserCardsBeforeCounter returns a count of ProfileSvcMock.GetUserCards invocations
+func (mmGetUserCards *ProfileSvcMock) GetUserCardsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetUserCards.beforeGetUserCardsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ProfileSvcMock.GetUserCards.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetUserCards *mProfileSvcMockGetUserCards) Calls() []*ProfileSvcMockGetUserCardsParams {
+	mmGetUserCards.mutex.RLock()
+
+	argCopy := make([]*ProfileSvcMockGetUserCardsParams, len(mmGetUserCards.callArgs))
+	copy(argCopy, mmGetUserCards.callArgs)
+
+	mmGetUserCards.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetUserCardsDone returns true if the count of the GetUserCards invocations corresponds
+// the number of defined expectations
+func (m *ProfileSvcMock) MinimockGetUserCardsDone() bool {
+	if m.GetUserCardsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetUserCardsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetUserCardsMock.invocationsDone()
+}
+
+// MinimockGetUserCardsInspect logs each unmet expectation
+func (m *ProfileSvcMock) MinimockGetUserCardsInspect() {
+	for _, e := range m.GetUserCardsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ProfileSvcMock.GetUserCards at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetUserCardsCounter := mm_atomic.LoadUint64(&m.afterGetUserCardsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetUserCardsMock.defaultExpectation != nil && afterGetUserCardsCounter < 1 {
+		if m.GetUserCardsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ProfileSvcMock.GetUserCards at\n%s", m.GetUserCardsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ProfileSvcMock.GetUserCards at\n%s with params: %#v", m.GetUserCardsMock.defaultExpectation.expectationOrigins.origin, *m.GetUserCardsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetUserCards != nil && afterGetUserCardsCounter < 1 {
+		m.t.Errorf("Expected call to ProfileSvcMock.GetUserCards at\n%s", m.funcGetUserCardsOrigin)
+	}
+
+	if !m.GetUserCardsMock.invocationsDone() && afterGetUserCardsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ProfileSvcMock.GetUserCards at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetUserCardsMock.expectedInvocations), m.GetUserCardsMock.expectedInvocationsOrigin, afterGetUserCardsCounter)
+	}
+}
+
+type mProfileSvcMockRevokeShare struct {
+	optional           bool
+	mock               *ProfileSvcMock
+	defaultExpectation *ProfileSvcMockRevokeShareExpectation
+	expectations       []*ProfileSvcMockRevokeShareExpectation
+
+	callArgs []*ProfileSvcMockRevokeShareParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ProfileSvcMockRevokeShareExpectation specifies expectation struct of the ProfileSvc.RevokeShare
+type ProfileSvcMockRevokeShareExpectation struct {
+	mock               *ProfileSvcMock
+	params             *ProfileSvcMockRevokeShareParams
+	paramPtrs          *ProfileSvcMockRevokeShareParamPtrs
+	expectationOrigins ProfileSvcMockRevokeShareExpectationOrigins
+	results            *ProfileSvcMockRevokeShareResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ProfileSvcMockRevokeShareParams contains parameters of the ProfileSvc.RevokeShare
+type ProfileSvcMockRevokeShareParams struct {
+	ctx        context.Context
+	owner      string
+	grantee    string
+	cardNumber string
+}
+
+// ProfileSvcMockRevokeShareParamPtrs contains pointers to parameters of the ProfileSvc.RevokeShare
+type ProfileSvcMockRevokeShareParamPtrs struct {
+	ctx        *context.Context
+	owner      *string
+	grantee    *string
+	cardNumber *string
+}
+
+// ProfileSvcMockRevokeShareResults contains results of the ProfileSvc.RevokeShare
+type ProfileSvcMockRevokeShareResults struct {
+	err error
+}
+
+// ProfileSvcMockRevokeShareOrigins contains origins of expectations of the ProfileSvc.RevokeShare
+type ProfileSvcMockRevokeShareExpectationOrigins struct {
+	origin           string
+	originCtx        string
+	originOwner      string
+	originGrantee    string
+	originCardNumber string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRevokeShare *mProfileSvcMockRevokeShare) Optional() *mProfileSvcMockRevokeShare {
+	mmRevokeShare.optional = true
+	return mmRevokeShare
+}
+
+// Expect sets up expected params for ProfileSvc.RevokeShare
+func (mmRevokeShare *mProfileSvcMockRevokeShare) Expect(ctx context.Context, owner string, grantee string, cardNumber string) *mProfileSvcMockRevokeShare {
+	if mmRevokeShare.mock.funcRevokeShare != nil {
+		mmRevokeShare.mock.t.Fatalf("ProfileSvcMock.RevokeShare mock is already set by Set")
+	}
+
+	if mmRevokeShare.defaultExpectation == nil {
+		mmRevokeShare.defaultExpectation = &ProfileSvcMockRevokeShareExpectation{}
+	}
+
+	if mmRevokeShare.defaultExpectation.paramPtrs != nil {
+		mmRevokeShare.mock.t.Fatalf("ProfileSvcMock.RevokeShare mock is already set by ExpectParams functions")
+	}
+
+	mmRevokeShare.defaultExpectation.params = &ProfileSvcMockRevokeShareParams{ctx, owner, grantee, cardNumber}
+	mmRevokeShare.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRevokeShare.expectations {
+		if minimock.Equal(e.params, mmRevokeShare.defaultExpectation.params) {
+			mmRevokeShare.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRevokeShare.defaultExpectation.params)
+		}
+	}
+
+	return mmRevokeShare
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ProfileSvc.RevokeShare
+func (mmRevokeShare *mProfileSvcMockRevokeShare) ExpectCtxParam1(ctx context.Context) *mProfileSvcMockRevokeShare {
+	if mmRevokeShare.mock.funcRevokeShare != nil {
+		mmRevokeShare.mock.t.Fatalf("ProfileSvcMock.RevokeShare mock is already set by Set")
+	}
+
+	if mmRevokeShare.defaultExpectation == nil {
+		mmRevokeShare.defaultExpectation = &ProfileSvcMockRevokeShareExpectation{}
+	}
+
+	if mmRevokeShare.defaultExpectation.params != nil {
+		mmRevokeShare.mock.t.Fatalf("ProfileSvcMock.RevokeShare mock is already set by Expect")
+	}
+
+	if mmRevokeShare.defaultExpectation.paramPtrs == nil {
+		mmRevokeShare.defaultExpectation.paramPtrs = &ProfileSvcMockRevokeShareParamPtrs{}
+	}
+	mmRevokeShare.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRevokeShare.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRevokeShare
+}
+
+// ExpectOwnerParam2 sets up expected param owner for ProfileSvc.RevokeShare
+func (mmRevokeShare *mProfileSvcMockRevokeShare) ExpectOwnerParam2(owner string) *mProfileSvcMockRevokeShare {
+	if mmRevokeShare.mock.funcRevokeShare != nil {
+		mmRevokeShare.mock.t.Fatalf("ProfileSvcMock.RevokeShare mock is already set by Set")
+	}
+
+	if mmRevokeShare.defaultExpectation == nil {
+		mmRevokeShare.defaultExpectation = &ProfileSvcMockRevokeShareExpectation{}
+	}
+
+	if mmRevokeShare.defaultExpectation.params != nil {
+		mmRevokeShare.mock.t.Fatalf("ProfileSvcMock.RevokeShare mock is already set by Expect")
+	}
+
+	if mmRevokeShare.defaultExpectation.paramPtrs == nil {
+		mmRevokeShare.defaultExpectation.paramPtrs = &ProfileSvcMockRevokeShareParamPtrs{}
+	}
+	mmRevokeShare.defaultExpectation.paramPtrs.owner = &owner
+	mmRevokeShare.defaultExpectation.expectationOrigins.originOwner = minimock.CallerInfo(1)
+
+	return mmRevokeShare
+}
+
+// ExpectGranteeParam3 sets up expected param grantee for ProfileSvc.RevokeShare
+func (mmRevokeShare *mProfileSvcMockRevokeShare) ExpectGranteeParam3(grantee string) *mProfileSvcMockRevokeShare {
+	if mmRevokeShare.mock.funcRevokeShare != nil {
+		mmRevokeShare.mock.t.Fatalf("ProfileSvcMock.RevokeShare mock is already set by Set")
+	}
+
+	if mmRevokeShare.defaultExpectation == nil {
+		mmRevokeShare.defaultExpectation = &ProfileSvcMockRevokeShareExpectation{}
+	}
+
+	if mmRevokeShare.defaultExpectation.params != nil {
+		mmRevokeShare.mock.t.Fatalf("ProfileSvcMock.RevokeShare mock is already set by Expect")
+	}
+
+	if mmRevokeShare.defaultExpectation.paramPtrs == nil {
+		mmRevokeShare.defaultExpectation.paramPtrs = &ProfileSvcMockRevokeShareParamPtrs{}
+	}
+	mmRevokeShare.defaultExpectation.paramPtrs.grantee = &grantee
+	mmRevokeShare.defaultExpectation.expectationOrigins.originGrantee = minimock.CallerInfo(1)
+
+	return mmRevokeShare
+}
+
+// ExpectCardNumberParam4 sets up expected param cardNumber for ProfileSvc.RevokeShare
+func (mmRevokeShare *mProfileSvcMockRevokeShare) ExpectCardNumberParam4(cardNumber string) *mProfileSvcMockRevokeShare {
+	if mmRevokeShare.mock.funcRevokeShare != nil {
+		mmRevokeShare.mock.t.Fatalf("ProfileSvcMock.RevokeShare mock is already set by Set")
+	}
+
+	if mmRevokeShare.defaultExpectation == nil {
+		mmRevokeShare.defaultExpectation = &ProfileSvcMockRevokeShareExpectation{}
+	}
+
+	if mmRevokeShare.defaultExpectation.params != nil {
+		mmRevokeShare.mock.t.Fatalf("ProfileSvcMock.RevokeShare mock is already set by Expect")
+	}
+
+	if mmRevokeShare.defaultExpectation.paramPtrs == nil {
+		mmRevokeShare.defaultExpectation.paramPtrs = &ProfileSvcMockRevokeShareParamPtrs{}
+	}
+	mmRevokeShare.defaultExpectation.paramPtrs.cardNumber = &cardNumber
+	mmRevokeShare.defaultExpectation.expectationOrigins.originCardNumber = minimock.CallerInfo(1)
+
+	return mmRevokeShare
+}
+
+// Inspect accepts an inspector function that has same arguments as the ProfileSvc.RevokeShare
+func (mmRevokeShare *mProfileSvcMockRevokeShare) Inspect(f func(ctx context.Context, owner string, grantee string, cardNumber string)) *mProfileSvcMockRevokeShare {
+	if mmRevokeShare.mock.inspectFuncRevokeShare != nil {
+		mmRevokeShare.mock.t.Fatalf("Inspect function is already set for ProfileSvcMock.RevokeShare")
+	}
+
+	mmRevokeShare.mock.inspectFuncRevokeShare = f
+
+	return mmRevokeShare
+}
+
+// Return sets up results that will be returned by ProfileSvc.RevokeShare
+func (mmRevokeShare *mProfileSvcMockRevokeShare) Return(err error) *ProfileSvcMock {
+	if mmRevokeShare.mock.funcRevokeShare != nil {
+		mmRevokeShare.mock.t.Fatalf("ProfileSvcMock.RevokeShare mock is already set by Set")
+	}
+
+	if mmRevokeShare.defaultExpectation == nil {
+		mmRevokeShare.defaultExpectation = &ProfileSvcMockRevokeShareExpectation{mock: mmRevokeShare.mock}
+	}
+	mmRevokeShare.defaultExpectation.results = &ProfileSvcMockRevokeShareResults{err}
+	mmRevokeShare.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRevokeShare.mock
+}
+
+// Set uses given function f to mock the ProfileSvc.RevokeShare method
+func (mmRevokeShare *mProfileSvcMockRevokeShare) Set(f func(ctx context.Context, owner string, grantee string, cardNumber string) (err error)) *ProfileSvcMock {
+	if mmRevokeShare.defaultExpectation != nil {
+		mmRevokeShare.mock.t.Fatalf("Default expectation is already set for the ProfileSvc.RevokeShare method")
+	}
+
+	if len(mmRevokeShare.expectations) > 0 {
+		mmRevokeShare.mock.t.Fatalf("Some expectations are already set for the ProfileSvc.RevokeShare method")
+	}
+
+	mmRevokeShare.mock.funcRevokeShare = f
+	mmRevokeShare.mock.funcRevokeShareOrigin = minimock.CallerInfo(1)
+	return mmRevokeShare.mock
+}
+
+// When sets expectation for the ProfileSvc.RevokeShare which will trigger the result defined by the following
+// Then helper
+func (mmRevokeShare *mProfileSvcMockRevokeShare) When(ctx context.Context, owner string, grantee string, cardNumber string) *ProfileSvcMockRevokeShareExpectation {
+	if mmRevokeShare.mock.funcRevokeShare != nil {
+		mmRevokeShare.mock.t.Fatalf("ProfileSvcMock.RevokeShare mock is already set by Set")
+	}
+
+	expectation := &ProfileSvcMockRevokeShareExpectation{
+		mock:               mmRevokeShare.mock,
+		params:             &ProfileSvcMockRevokeShareParams{ctx, owner, grantee, cardNumber},
+		expectationOrigins: ProfileSvcMockRevokeShareExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmRevokeShare.expectations = append(mmRevokeShare.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ProfileSvc.RevokeShare return parameters for the expectation previously defined by the When method
+func (e *ProfileSvcMockRevokeShareExpectation) Then(err error) *ProfileSvcMock {
+	e.results = &ProfileSvcMockRevokeShareResults{err}
+	return e.mock
+}
+
+// Times sets number of times ProfileSvc.RevokeShare should be invoked
+func (mmRevokeShare *mProfileSvcMockRevokeShare) Times(n uint64) *mProfileSvcMockRevokeShare {
+	if n == 0 {
+		mmRevokeShare.mock.t.Fatalf("Times of ProfileSvcMock.RevokeShare mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRevokeShare.expectedInvocations, n)
+	mmRevokeShare.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRevokeShare
+}
+
+func (mmRevokeShare *mProfileSvcMockRevokeShare) invocationsDone() bool {
+	if len(mmRevokeShare.expectations) == 0 && mmRevokeShare.defaultExpectation == nil && mmRevokeShare.mock.funcRevokeShare == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRevokeShare.mock.afterRevokeShareCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRevokeShare.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// RevokeShare implements mm_handler.ProfileSvc
+func (mmRevokeShare *ProfileSvcMock) RevokeShare(ctx context.Context, owner string, grantee string, cardNumber string) (err error) {
+	mm_atomic.AddUint64(&mmRevokeShare.beforeRevokeShareCounter, 1)
+	defer mm_atomic.AddUint64(&mmRevokeShare.afterRevokeShareCounter, 1)
+
+	mmRevokeShare.t.Helper()
+
+	if mmRevokeShare.inspectFuncRevokeShare != nil {
+		mmRevokeShare.inspectFuncRevokeShare(ctx, owner, grantee, cardNumber)
+	}
+
+	mm_params := ProfileSvcMockRevokeShareParams{ctx, owner, grantee, cardNumber}
+
+	// Record call args
+	mmRevokeShare.RevokeShareMock.mutex.Lock()
+	mmRevokeShare.RevokeShareMock.callArgs = append(mmRevokeShare.RevokeShareMock.callArgs, &mm_params)
+	mmRevokeShare.RevokeShareMock.mutex.Unlock()
+
+	for _, e := range mmRevokeShare.RevokeShareMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmRevokeShare.RevokeShareMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRevokeShare.RevokeShareMock.defaultExpectation.Counter, 1)
+		mm_want := mmRevokeShare.RevokeShareMock.defaultExpectation.params
+		mm_want_ptrs := mmRevokeShare.RevokeShareMock.defaultExpectation.paramPtrs
+
+		mm_got := ProfileSvcMockRevokeShareParams{ctx, owner, grantee, cardNumber}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRevokeShare.t.Errorf("ProfileSvcMock.RevokeShare got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeShare.RevokeShareMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.owner != nil && !minimock.Equal(*mm_want_ptrs.owner, mm_got.owner) {
+				mmRevokeShare.t.Errorf("ProfileSvcMock.RevokeShare got unexpected parameter owner, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeShare.RevokeShareMock.defaultExpectation.expectationOrigins.originOwner, *mm_want_ptrs.owner, mm_got.owner, minimock.Diff(*mm_want_ptrs.owner, mm_got.owner))
+			}
+
+			if mm_want_ptrs.grantee != nil && !minimock.Equal(*mm_want_ptrs.grantee, mm_got.grantee) {
+				mmRevokeShare.t.Errorf("ProfileSvcMock.RevokeShare got unexpected parameter grantee, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeShare.RevokeShareMock.defaultExpectation.expectationOrigins.originGrantee, *mm_want_ptrs.grantee, mm_got.grantee, minimock.Diff(*mm_want_ptrs.grantee, mm_got.grantee))
+			}
+
+			if mm_want_ptrs.cardNumber != nil && !minimock.Equal(*mm_want_ptrs.cardNumber, mm_got.cardNumber) {
+				mmRevokeShare.t.Errorf("ProfileSvcMock.RevokeShare got unexpected parameter cardNumber, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRevokeShare.RevokeShareMock.defaultExpectation.expectationOrigins.originCardNumber, *mm_want_ptrs.cardNumber, mm_got.cardNumber, minimock.Diff(*mm_want_ptrs.cardNumber, mm_got.cardNumber))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRevokeShare.t.Errorf("ProfileSvcMock.RevokeShare got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRevokeShare.RevokeShareMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmRevokeShare.RevokeShareMock.defaultExpectation.results
+		if mm_results == nil {
+			mmRevokeShare.t.Fatal("No results are set for the ProfileSvcMock.RevokeShare")
+		}
+		return (*mm_results).err
+	}
+	if mmRevokeShare.funcRevokeShare != nil {
+		return mmRevokeShare.funcRevokeShare(ctx, owner, grantee, cardNumber)
+	}
+	mmRevokeShare.t.Fatalf("Unexpected call to ProfileSvcMock.RevokeShare. %v %v %v %v", ctx, owner, grantee, cardNumber)
+	return
+}
+
+// RevokeShareAfterCounter returns a count of finished ProfileSvcMock.RevokeShare invocations
+func (mmRevokeShare *ProfileSvcMock) RevokeShareAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeShare.afterRevokeShareCounter)
+}
+
+// RevokeShareBeforeCounter returns a count of ProfileSvcMock.RevokeShare invocations
+func (mmRevokeShare *ProfileSvcMock) RevokeShareBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRevokeShare.beforeRevokeShareCounter)
+}
+
+// Calls returns a list of arguments used in each call to ProfileSvcMock.RevokeShare.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRevokeShare *mProfileSvcMockRevokeShare) Calls() []*ProfileSvcMockRevokeShareParams {
+	mmRevokeShare.mutex.RLock()
+
+	argCopy := make([]*ProfileSvcMockRevokeShareParams, len(mmRevokeShare.callArgs))
+	copy(argCopy, mmRevokeShare.callArgs)
+
+	mmRevokeShare.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRevokeShareDone returns true if the count of the RevokeShare invocations corresponds
+// the number of defined expectations
+func (m *ProfileSvcMock) MinimockRevokeShareDone() bool {
+	if m.RevokeShareMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.RevokeShareMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.RevokeShareMock.invocationsDone()
+}
+
+// MinimockRevokeShareInspect logs each unmet expectation
+func (m *ProfileSvcMock) MinimockRevokeShareInspect() {
+	for _, e := range m.RevokeShareMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ProfileSvcMock.RevokeShare at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRevokeShareCounter := mm_atomic.LoadUint64(&m.afterRevokeShareCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RevokeShareMock.defaultExpectation != nil && afterRevokeShareCounter < 1 {
+		if m.RevokeShareMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ProfileSvcMock.RevokeShare at\n%s", m.RevokeShareMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ProfileSvcMock.RevokeShare at\n%s with params: %#v", m.RevokeShareMock.defaultExpectation.expectationOrigins.origin, *m.RevokeShareMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRevokeShare != nil && afterRevokeShareCounter < 1 {
+		m.t.Errorf("Expected call to ProfileSvcMock.RevokeShare at\n%s", m.funcRevokeShareOrigin)
+	}
+
+	if !m.RevokeShareMock.invocationsDone() && afterRevokeShareCounter > 0 {
+		m.t.Errorf("Expected %d calls to ProfileSvcMock.RevokeShare at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RevokeShareMock.expectedInvocations), m.RevokeShareMock.expectedInvocationsOrigin, afterRevokeShareCounter)
+	}
+}
+
+type mProfileSvcMockSearch struct {
+	optional           bool
+	mock               *ProfileSvcMock
+	defaultExpectation *ProfileSvcMockSearchExpectation
+	expectations       []*ProfileSvcMockSearchExpectation
+
+	callArgs []*ProfileSvcMockSearchParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ProfileSvcMockSearchExpectation specifies expectation struct of the ProfileSvc.Search
+type ProfileSvcMockSearchExpectation struct {
+	mock               *ProfileSvcMock
+	params             *ProfileSvcMockSearchParams
+	paramPtrs          *ProfileSvcMockSearchParamPtrs
+	expectationOrigins ProfileSvcMockSearchExpectationOrigins
+	results            *ProfileSvcMockSearchResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ProfileSvcMockSearchParams contains parameters of the ProfileSvc.Search
+type ProfileSvcMockSearchParams struct {
+	ctx      context.Context
+	username string
+	text     string
+	page     pagination.Request
+}
+
+// ProfileSvcMockSearchParamPtrs contains pointers to parameters of the ProfileSvc.Search
+type ProfileSvcMockSearchParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	text     *string
+	page     *pagination.Request
+}
+
+// ProfileSvcMockSearchResults contains results of the ProfileSvc.Search
+type ProfileSvcMockSearchResults struct {
+	cards []profile.CardInfo
+	next  string
+	err   error
+}
+
+// ProfileSvcMockSearchOrigins contains origins of expectations of the ProfileSvc.Search
+type ProfileSvcMockSearchExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originText     string
+	originPage     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSearch *mProfileSvcMockSearch) Optional() *mProfileSvcMockSearch {
+	mmSearch.optional = true
+	return mmSearch
+}
+
+// Expect sets up expected params for ProfileSvc.Search
+func (mmSearch *mProfileSvcMockSearch) Expect(ctx context.Context, username string, text string, page pagination.Request) *mProfileSvcMockSearch {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("ProfileSvcMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &ProfileSvcMockSearchExpectation{}
+	}
+
+	if mmSearch.defaultExpectation.paramPtrs != nil {
+		mmSearch.mock.t.Fatalf("ProfileSvcMock.Search mock is already set by ExpectParams functions")
+	}
+
+	mmSearch.defaultExpectation.params = &ProfileSvcMockSearchParams{ctx, username, text, page}
+	mmSearch.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSearch.expectations {
+		if minimock.Equal(e.params, mmSearch.defaultExpectation.params) {
+			mmSearch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSearch.defaultExpectation.params)
+		}
+	}
+
+	return mmSearch
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ProfileSvc.Search
+func (mmSearch *mProfileSvcMockSearch) ExpectCtxParam1(ctx context.Context) *mProfileSvcMockSearch {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("ProfileSvcMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &ProfileSvcMockSearchExpectation{}
+	}
+
+	if mmSearch.defaultExpectation.params != nil {
+		mmSearch.mock.t.Fatalf("ProfileSvcMock.Search mock is already set by Expect")
+	}
+
+	if mmSearch.defaultExpectation.paramPtrs == nil {
+		mmSearch.defaultExpectation.paramPtrs = &ProfileSvcMockSearchParamPtrs{}
+	}
+	mmSearch.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSearch.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSearch
+}
+
+// ExpectUsernameParam2 sets up expected param username for ProfileSvc.Search
+func (mmSearch *mProfileSvcMockSearch) ExpectUsernameParam2(username string) *mProfileSvcMockSearch {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("ProfileSvcMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &ProfileSvcMockSearchExpectation{}
+	}
+
+	if mmSearch.defaultExpectation.params != nil {
+		mmSearch.mock.t.Fatalf("ProfileSvcMock.Search mock is already set by Expect")
+	}
+
+	if mmSearch.defaultExpectation.paramPtrs == nil {
+		mmSearch.defaultExpectation.paramPtrs = &ProfileSvcMockSearchParamPtrs{}
+	}
+	mmSearch.defaultExpectation.paramPtrs.username = &username
+	mmSearch.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmSearch
+}
+
+// ExpectTextParam3 sets up expected param text for ProfileSvc.Search
+func (mmSearch *mProfileSvcMockSearch) ExpectTextParam3(text string) *mProfileSvcMockSearch {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("ProfileSvcMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &ProfileSvcMockSearchExpectation{}
+	}
+
+	if mmSearch.defaultExpectation.params != nil {
+		mmSearch.mock.t.Fatalf("ProfileSvcMock.Search mock is already set by Expect")
+	}
+
+	if mmSearch.defaultExpectation.paramPtrs == nil {
+		mmSearch.defaultExpectation.paramPtrs = &ProfileSvcMockSearchParamPtrs{}
+	}
+	mmSearch.defaultExpectation.paramPtrs.text = &text
+	mmSearch.defaultExpectation.expectationOrigins.originText = minimock.CallerInfo(1)
+
+	return mmSearch
+}
+
+// ExpectPageParam4 sets up expected param page for ProfileSvc.Search
+func (mmSearch *mProfileSvcMockSearch) ExpectPageParam4(page pagination.Request) *mProfileSvcMockSearch {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("ProfileSvcMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &ProfileSvcMockSearchExpectation{}
+	}
+
+	if mmSearch.defaultExpectation.params != nil {
+		mmSearch.mock.t.Fatalf("ProfileSvcMock.Search mock is already set by Expect")
+	}
+
+	if mmSearch.defaultExpectation.paramPtrs == nil {
+		mmSearch.defaultExpectation.paramPtrs = &ProfileSvcMockSearchParamPtrs{}
+	}
+	mmSearch.defaultExpectation.paramPtrs.page = &page
+	mmSearch.defaultExpectation.expectationOrigins.originPage = minimock.CallerInfo(1)
+
+	return mmSearch
+}
+
+// Inspect accepts an inspector function that has same arguments as the ProfileSvc.Search
+func (mmSearch *mProfileSvcMockSearch) Inspect(f func(ctx context.Context, username string, text string, page pagination.Request)) *mProfileSvcMockSearch {
+	if mmSearch.mock.inspectFuncSearch != nil {
+		mmSearch.mock.t.Fatalf("Inspect function is already set for ProfileSvcMock.Search")
+	}
+
+	mmSearch.mock.inspectFuncSearch = f
+
+	return mmSearch
+}
+
+// Return sets up results that will be returned by ProfileSvc.Search
+func (mmSearch *mProfileSvcMockSearch) Return(cards []profile.CardInfo, next string, err error) *ProfileSvcMock {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("ProfileSvcMock.Search mock is already set by Set")
+	}
+
+	if mmSearch.defaultExpectation == nil {
+		mmSearch.defaultExpectation = &ProfileSvcMockSearchExpectation{mock: mmSearch.mock}
+	}
+	mmSearch.defaultExpectation.results = &ProfileSvcMockSearchResults{cards, next, err}
+	mmSearch.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSearch.mock
+}
+
+// Set uses given function f to mock the ProfileSvc.Search method
+func (mmSearch *mProfileSvcMockSearch) Set(f func(ctx context.Context, username string, text string, page pagination.Request) (cards []profile.CardInfo, next string, err error)) *ProfileSvcMock {
+	if mmSearch.defaultExpectation != nil {
+		mmSearch.mock.t.Fatalf("Default expectation is already set for the ProfileSvc.Search method")
+	}
+
+	if len(mmSearch.expectations) > 0 {
+		mmSearch.mock.t.Fatalf("Some expectations are already set for the ProfileSvc.Search method")
+	}
+
+	mmSearch.mock.funcSearch = f
+	mmSearch.mock.funcSearchOrigin = minimock.CallerInfo(1)
+	return mmSearch.mock
+}
+
+// When sets expectation for the ProfileSvc.Search which will trigger the result defined by the following
+// Then helper
+func (mmSearch *mProfileSvcMockSearch) When(ctx context.Context, username string, text string, page pagination.Request) *ProfileSvcMockSearchExpectation {
+	if mmSearch.mock.funcSearch != nil {
+		mmSearch.mock.t.Fatalf("ProfileSvcMock.Search mock is already set by Set")
+	}
+
+	expectation := &ProfileSvcMockSearchExpectation{
+		mock:               mmSearch.mock,
+		params:             &ProfileSvcMockSearchParams{ctx, username, text, page},
+		expectationOrigins: ProfileSvcMockSearchExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSearch.expectations = append(mmSearch.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ProfileSvc.Search return parameters for the expectation previously defined by the When method
+func (e *ProfileSvcMockSearchExpectation) Then(cards []profile.CardInfo, next string, err error) *ProfileSvcMock {
+	e.results = &ProfileSvcMockSearchResults{cards, next, err}
+	return e.mock
+}
+
+// Times sets number of times ProfileSvc.Search should be invoked
+func (mmSearch *mProfileSvcMockSearch) Times(n uint64) *mProfileSvcMockSearch {
+	if n == 0 {
+		mmSearch.mock.t.Fatalf("Times of ProfileSvcMock.Search mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSearch.expectedInvocations, n)
+	mmSearch.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSearch
 }
 
-func (mmDeleteCard *mProfileSvcMockDeleteCard) invocationsDone() bool {
-	if len(mmDeleteCard.expectations) == 0 && mmDeleteCard.defaultExpectation == nil && mmDeleteCard.mock.funcDeleteCard == nil {
+func (mmSearch *mProfileSvcMockSearch) invocationsDone() bool {
+	if len(mmSearch.expectations) == 0 && mmSearch.defaultExpectation == nil && mmSearch.mock.funcSearch == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteCard.mock.afterDeleteCardCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteCard.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSearch.mock.afterSearchCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSearch.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteCard implements mm_handler.ProfileSvc
-func (mmDeleteCard *ProfileSvcMock) DeleteCard(ctx context.Context, username string, cardNumber string) (err error) {
-	mm_atomic.AddUint64(&mmDeleteCard.beforeDeleteCardCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteCard.afterDeleteCardCounter, 1)
+// Search implements mm_handler.ProfileSvc
+func (mmSearch *ProfileSvcMock) Search(ctx context.Context, username string, text string, page pagination.Request) (cards []profile.CardInfo, next string, err error) {
+	mm_atomic.AddUint64(&mmSearch.beforeSearchCounter, 1)
+	defer mm_atomic.AddUint64(&mmSearch.afterSearchCounter, 1)
 
-	mmDeleteCard.t.Helper()
+	mmSearch.t.Helper()
 
-	if mmDeleteCard.inspectFuncDeleteCard != nil {
-		mmDeleteCard.inspectFuncDeleteCard(ctx, username, cardNumber)
+	if mmSearch.inspectFuncSearch != nil {
+		mmSearch.inspectFuncSearch(ctx, username, text, page)
 	}
 
-	mm_params := ProfileSvcMockDeleteCardParams{ctx, username, cardNumber}
+	mm_params := ProfileSvcMockSearchParams{ctx, username, text, page}
 
 	// Record call args
-	mmDeleteCard.DeleteCardMock.mutex.Lock()
-	mmDeleteCard.DeleteCardMock.callArgs = append(mmDeleteCard.DeleteCardMock.callArgs, &mm_params)
-	mmDeleteCard.DeleteCardMock.mutex.Unlock()
+	mmSearch.SearchMock.mutex.Lock()
+	mmSearch.SearchMock.callArgs = append(mmSearch.SearchMock.callArgs, &mm_params)
+	mmSearch.SearchMock.mutex.Unlock()
 
-	for _, e := range mmDeleteCard.DeleteCardMock.expectations {
+	for _, e := range mmSearch.SearchMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.cards, e.results.next, e.results.err
 		}
 	}
 
-	if mmDeleteCard.DeleteCardMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteCard.DeleteCardMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteCard.DeleteCardMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteCard.DeleteCardMock.defaultExpectation.paramPtrs
+	if mmSearch.SearchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSearch.SearchMock.defaultExpectation.Counter, 1)
+		mm_want := mmSearch.SearchMock.defaultExpectation.params
+		mm_want_ptrs := mmSearch.SearchMock.defaultExpectation.paramPtrs
 
-		mm_got := ProfileSvcMockDeleteCardParams{ctx, username, cardNumber}
+		mm_got := ProfileSvcMockSearchParams{ctx, username, text, page}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteCard.t.Errorf("ProfileSvcMock.DeleteCard got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteCard.DeleteCardMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmSearch.t.Errorf("ProfileSvcMock.Search got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearch.SearchMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
-				mmDeleteCard.t.Errorf("ProfileSvcMock.DeleteCard got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteCard.DeleteCardMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+				mmSearch.t.Errorf("ProfileSvcMock.Search got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearch.SearchMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
 			}
 
-			if mm_want_ptrs.cardNumber != nil && !minimock.Equal(*mm_want_ptrs.cardNumber, mm_got.cardNumber) {
-				mmDeleteCard.t.Errorf("ProfileSvcMock.DeleteCard got unexpected parameter cardNumber, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteCard.DeleteCardMock.defaultExpectation.expectationOrigins.originCardNumber, *mm_want_ptrs.cardNumber, mm_got.cardNumber, minimock.Diff(*mm_want_ptrs.cardNumber, mm_got.cardNumber))
+			if mm_want_ptrs.text != nil && !minimock.Equal(*mm_want_ptrs.text, mm_got.text) {
+				mmSearch.t.Errorf("ProfileSvcMock.Search got unexpected parameter text, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearch.SearchMock.defaultExpectation.expectationOrigins.originText, *mm_want_ptrs.text, mm_got.text, minimock.Diff(*mm_want_ptrs.text, mm_got.text))
+			}
+
+			if mm_want_ptrs.page != nil && !minimock.Equal(*mm_want_ptrs.page, mm_got.page) {
+				mmSearch.t.Errorf("ProfileSvcMock.Search got unexpected parameter page, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearch.SearchMock.defaultExpectation.expectationOrigins.originPage, *mm_want_ptrs.page, mm_got.page, minimock.Diff(*mm_want_ptrs.page, mm_got.page))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteCard.t.Errorf("ProfileSvcMock.DeleteCard got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteCard.DeleteCardMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSearch.t.Errorf("ProfileSvcMock.Search got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSearch.SearchMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteCard.DeleteCardMock.defaultExpectation.results
+		mm_results := mmSearch.SearchMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteCard.t.Fatal("No results are set for the ProfileSvcMock.DeleteCard")
+			mmSearch.t.Fatal("No results are set for the ProfileSvcMock.Search")
 		}
-		return (*mm_results).err
+		return (*mm_results).cards, (*mm_results).next, (*mm_results).err
 	}
-	if mmDeleteCard.funcDeleteCard != nil {
-		return mmDeleteCard.funcDeleteCard(ctx, username, cardNumber)
+	if mmSearch.funcSearch != nil {
+		return mmSearch.funcSearch(ctx, username, text, page)
 	}
-	mmDeleteCard.t.Fatalf("Unexpected call to ProfileSvcMock.DeleteCard. %v %v %v", ctx, username, cardNumber)
+	mmSearch.t.Fatalf("Unexpected call to ProfileSvcMock.Search. %v %v %v %v", ctx, username, text, page)
 	return
 }
 
-// DeleteCardAfterCounter returns a count of finished ProfileSvcMock.DeleteCard invocations
-func (mmDeleteCard *ProfileSvcMock) DeleteCardAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteCard.afterDeleteCardCounter)
+// SearchAfterCounter returns a count of finished ProfileSvcMock.Search invocations
+func (mmSearch *ProfileSvcMock) SearchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearch.afterSearchCounter)
 }
 
-// DeleteCardBeforeCounter returns a count of ProfileSvcMock.DeleteCard invocations
-func (mmDeleteCard *ProfileSvcMock) DeleteCardBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteCard.beforeDeleteCardCounter)
+// SearchBeforeCounter returns a count of ProfileSvcMock.Search invocations
+func (mmSearch *ProfileSvcMock) SearchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearch.beforeSearchCounter)
 }
 
-// Calls returns a list of arguments used in each call to ProfileSvcMock.DeleteCard.
+// Calls returns a list of arguments used in each call to ProfileSvcMock.Search.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteCard *mProfileSvcMockDeleteCard) Calls() []*ProfileSvcMockDeleteCardParams {
-	mmDeleteCard.mutex.RLock()
+func (mmSearch *mProfileSvcMockSearch) Calls() []*ProfileSvcMockSearchParams {
+	mmSearch.mutex.RLock()
 
-	argCopy := make([]*ProfileSvcMockDeleteCardParams, len(mmDeleteCard.callArgs))
-	copy(argCopy, mmDeleteCard.callArgs)
+	argCopy := make([]*ProfileSvcMockSearchParams, len(mmSearch.callArgs))
+	copy(argCopy, mmSearch.callArgs)
 
-	mmDeleteCard.mutex.RUnlock()
+	mmSearch.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteCardDone returns true if the count of the DeleteCard invocations corresponds
+// MinimockSearchDone returns true if the count of the Search invocations corresponds
 // the number of defined expectations
-func (m *ProfileSvcMock) MinimockDeleteCardDone() bool {
-	if m.DeleteCardMock.optional {
+func (m *ProfileSvcMock) MinimockSearchDone() bool {
+	if m.SearchMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteCardMock.expectations {
+	for _, e := range m.SearchMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteCardMock.invocationsDone()
+	return m.SearchMock.invocationsDone()
 }
 
-// MinimockDeleteCardInspect logs each unmet expectation
-func (m *ProfileSvcMock) MinimockDeleteCardInspect() {
-	for _, e := range m.DeleteCardMock.expectations {
+// MinimockSearchInspect logs each unmet expectation
+func (m *ProfileSvcMock) MinimockSearchInspect() {
+	for _, e := range m.SearchMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ProfileSvcMock.DeleteCard at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ProfileSvcMock.Search at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteCardCounter := mm_atomic.LoadUint64(&m.afterDeleteCardCounter)
+	afterSearchCounter := mm_atomic.LoadUint64(&m.afterSearchCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteCardMock.defaultExpectation != nil && afterDeleteCardCounter < 1 {
-		if m.DeleteCardMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ProfileSvcMock.DeleteCard at\n%s", m.DeleteCardMock.defaultExpectation.returnOrigin)
+	if m.SearchMock.defaultExpectation != nil && afterSearchCounter < 1 {
+		if m.SearchMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ProfileSvcMock.Search at\n%s", m.SearchMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ProfileSvcMock.DeleteCard at\n%s with params: %#v", m.DeleteCardMock.defaultExpectation.expectationOrigins.origin, *m.DeleteCardMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ProfileSvcMock.Search at\n%s with params: %#v", m.SearchMock.defaultExpectation.expectationOrigins.origin, *m.SearchMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteCard != nil && afterDeleteCardCounter < 1 {
-		m.t.Errorf("Expected call to ProfileSvcMock.DeleteCard at\n%s", m.funcDeleteCardOrigin)
+	if m.funcSearch != nil && afterSearchCounter < 1 {
+		m.t.Errorf("Expected call to ProfileSvcMock.Search at\n%s", m.funcSearchOrigin)
 	}
 
-	if !m.DeleteCardMock.invocationsDone() && afterDeleteCardCounter > 0 {
-		m.t.Errorf("Expected %d calls to ProfileSvcMock.DeleteCard at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteCardMock.expectedInvocations), m.DeleteCardMock.expectedInvocationsOrigin, afterDeleteCardCounter)
+	if !m.SearchMock.invocationsDone() && afterSearchCounter > 0 {
+		m.t.Errorf("Expected %d calls to ProfileSvcMock.Search at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SearchMock.expectedInvocations), m.SearchMock.expectedInvocationsOrigin, afterSearchCounter)
 	}
 }
 
-type mProfileSvcMockGetUserCards struct {
+type mProfileSvcMockShareCard struct {
 	optional           bool
 	mock               *ProfileSvcMock
-	defaultExpectation *ProfileSvcMockGetUserCardsExpectation
-	expectations       []*ProfileSvcMockGetUserCardsExpectation
+	defaultExpectation *ProfileSvcMockShareCardExpectation
+	expectations       []*ProfileSvcMockShareCardExpectation
 
-	callArgs []*ProfileSvcMockGetUserCardsParams
+	callArgs []*ProfileSvcMockShareCardParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ProfileSvcMockGetUserCardsExpectation specifies expectation struct of the ProfileSvc.GetUserCards
-type ProfileSvcMockGetUserCardsExpectation struct {
+// ProfileSvcMockShareCardExpectation specifies expectation struct of the ProfileSvc.ShareCard
+type ProfileSvcMockShareCardExpectation struct {
 	mock               *ProfileSvcMock
-	params             *ProfileSvcMockGetUserCardsParams
-	paramPtrs          *ProfileSvcMockGetUserCardsParamPtrs
-	expectationOrigins ProfileSvcMockGetUserCardsExpectationOrigins
-	results            *ProfileSvcMockGetUserCardsResults
+	params             *ProfileSvcMockShareCardParams
+	paramPtrs          *ProfileSvcMockShareCardParamPtrs
+	expectationOrigins ProfileSvcMockShareCardExpectationOrigins
+	results            *ProfileSvcMockShareCardResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ProfileSvcMockGetUserCardsParams contains parameters of the ProfileSvc.GetUserCards
-type ProfileSvcMockGetUserCardsParams struct {
-	ctx      context.Context
-	username string
+// ProfileSvcMockShareCardParams contains parameters of the ProfileSvc.ShareCard
+type ProfileSvcMockShareCardParams struct {
+	ctx   context.Context
+	share profile.CardShare
 }
 
-// ProfileSvcMockGetUserCardsParamPtrs contains pointers to parameters of the ProfileSvc.GetUserCards
-type ProfileSvcMockGetUserCardsParamPtrs struct {
-	ctx      *context.Context
-	username *string
+// ProfileSvcMockShareCardParamPtrs contains pointers to parameters of the ProfileSvc.ShareCard
+type ProfileSvcMockShareCardParamPtrs struct {
+	ctx   *context.Context
+	share *profile.CardShare
 }
 
-// ProfileSvcMockGetUserCardsResults contains results of the ProfileSvc.GetUserCards
-type ProfileSvcMockGetUserCardsResults struct {
-	ca1 []profile.CardInfo
+// ProfileSvcMockShareCardResults contains results of the ProfileSvc.ShareCard
+type ProfileSvcMockShareCardResults struct {
 	err error
 }
 
-// ProfileSvcMockGetUserCardsOrigins contains origins of expectations of the ProfileSvc.GetUserCards
-type ProfileSvcMockGetUserCardsExpectationOrigins struct {
-	origin         string
-	originCtx      string
-	originUsername string
+// ProfileSvcMockShareCardOrigins contains origins of expectations of the ProfileSvc.ShareCard
+type ProfileSvcMockShareCardExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originShare string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -490,292 +3593,292 @@ type ProfileSvcMockGetUserCardsExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetUserCards *mProfileSvcMockGetUserCards) Optional() *mProfileSvcMockGetUserCards {
-	mmGetUserCards.optional = true
-	return mmGetUserCards
+func (mmShareCard *mProfileSvcMockShareCard) Optional() *mProfileSvcMockShareCard {
+	mmShareCard.optional = true
+	return mmShareCard
 }
 
-// Expect sets up expected params for ProfileSvc.GetUserCards
-func (mmGetUserCards *mProfileSvcMockGetUserCards) Expect(ctx context.Context, username string) *mProfileSvcMockGetUserCards {
-	if mmGetUserCards.mock.funcGetUserCards != nil {
-		mmGetUserCards.mock.t.Fatalf("ProfileSvcMock.GetUserCards mock is already set by Set")
+// Expect sets up expected params for ProfileSvc.ShareCard
+func (mmShareCard *mProfileSvcMockShareCard) Expect(ctx context.Context, share profile.CardShare) *mProfileSvcMockShareCard {
+	if mmShareCard.mock.funcShareCard != nil {
+		mmShareCard.mock.t.Fatalf("ProfileSvcMock.ShareCard mock is already set by Set")
 	}
 
-	if mmGetUserCards.defaultExpectation == nil {
-		mmGetUserCards.defaultExpectation = &ProfileSvcMockGetUserCardsExpectation{}
+	if mmShareCard.defaultExpectation == nil {
+		mmShareCard.defaultExpectation = &ProfileSvcMockShareCardExpectation{}
 	}
 
-	if mmGetUserCards.defaultExpectation.paramPtrs != nil {
-		mmGetUserCards.mock.t.Fatalf("ProfileSvcMock.GetUserCards mock is already set by ExpectParams functions")
+	if mmShareCard.defaultExpectation.paramPtrs != nil {
+		mmShareCard.mock.t.Fatalf("ProfileSvcMock.ShareCard mock is already set by ExpectParams functions")
 	}
 
-	mmGetUserCards.defaultExpectation.params = &ProfileSvcMockGetUserCardsParams{ctx, username}
-	mmGetUserCards.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetUserCards.expectations {
-		if minimock.Equal(e.params, mmGetUserCards.defaultExpectation.params) {
-			mmGetUserCards.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetUserCards.defaultExpectation.params)
+	mmShareCard.defaultExpectation.params = &ProfileSvcMockShareCardParams{ctx, share}
+	mmShareCard.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmShareCard.expectations {
+		if minimock.Equal(e.params, mmShareCard.defaultExpectation.params) {
+			mmShareCard.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmShareCard.defaultExpectation.params)
 		}
 	}
 
-	return mmGetUserCards
+	return mmShareCard
 }
 
-// ExpectCtxParam1 sets up expected param ctx for ProfileSvc.GetUserCards
-func (mmGetUserCards *mProfileSvcMockGetUserCards) ExpectCtxParam1(ctx context.Context) *mProfileSvcMockGetUserCards {
-	if mmGetUserCards.mock.funcGetUserCards != nil {
-		mmGetUserCards.mock.t.Fatalf("ProfileSvcMock.GetUserCards mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for ProfileSvc.ShareCard
+func (mmShareCard *mProfileSvcMockShareCard) ExpectCtxParam1(ctx context.Context) *mProfileSvcMockShareCard {
+	if mmShareCard.mock.funcShareCard != nil {
+		mmShareCard.mock.t.Fatalf("ProfileSvcMock.ShareCard mock is already set by Set")
 	}
 
-	if mmGetUserCards.defaultExpectation == nil {
-		mmGetUserCards.defaultExpectation = &ProfileSvcMockGetUserCardsExpectation{}
+	if mmShareCard.defaultExpectation == nil {
+		mmShareCard.defaultExpectation = &ProfileSvcMockShareCardExpectation{}
 	}
 
-	if mmGetUserCards.defaultExpectation.params != nil {
-		mmGetUserCards.mock.t.Fatalf("ProfileSvcMock.GetUserCards mock is already set by Expect")
+	if mmShareCard.defaultExpectation.params != nil {
+		mmShareCard.mock.t.Fatalf("ProfileSvcMock.ShareCard mock is already set by Expect")
 	}
 
-	if mmGetUserCards.defaultExpectation.paramPtrs == nil {
-		mmGetUserCards.defaultExpectation.paramPtrs = &ProfileSvcMockGetUserCardsParamPtrs{}
+	if mmShareCard.defaultExpectation.paramPtrs == nil {
+		mmShareCard.defaultExpectation.paramPtrs = &ProfileSvcMockShareCardParamPtrs{}
 	}
-	mmGetUserCards.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetUserCards.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmShareCard.defaultExpectation.paramPtrs.ctx = &ctx
+	mmShareCard.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetUserCards
+	return mmShareCard
 }
 
-// ExpectUsernameParam2 sets up expected param username for ProfileSvc.GetUserCards
-func (mmGetUserCards *mProfileSvcMockGetUserCards) ExpectUsernameParam2(username string) *mProfileSvcMockGetUserCards {
-	if mmGetUserCards.mock.funcGetUserCards != nil {
-		mmGetUserCards.mock.t.Fatalf("ProfileSvcMock.GetUserCards mock is already set by Set")
+// ExpectShareParam2 sets up expected param share for ProfileSvc.ShareCard
+func (mmShareCard *mProfileSvcMockShareCard) ExpectShareParam2(share profile.CardShare) *mProfileSvcMockShareCard {
+	if mmShareCard.mock.funcShareCard != nil {
+		mmShareCard.mock.t.Fatalf("ProfileSvcMock.ShareCard mock is already set by Set")
 	}
 
-	if mmGetUserCards.defaultExpectation == nil {
-		mmGetUserCards.defaultExpectation = &ProfileSvcMockGetUserCardsExpectation{}
+	if mmShareCard.defaultExpectation == nil {
+		mmShareCard.defaultExpectation = &ProfileSvcMockShareCardExpectation{}
 	}
 
-	if mmGetUserCards.defaultExpectation.params != nil {
-		mmGetUserCards.mock.t.Fatalf("ProfileSvcMock.GetUserCards mock is already set by Expect")
+	if mmShareCard.defaultExpectation.params != nil {
+		mmShareCard.mock.t.Fatalf("ProfileSvcMock.ShareCard mock is already set by Expect")
 	}
 
-	if mmGetUserCards.defaultExpectation.paramPtrs == nil {
-		mmGetUserCards.defaultExpectation.paramPtrs = &ProfileSvcMockGetUserCardsParamPtrs{}
+	if mmShareCard.defaultExpectation.paramPtrs == nil {
+		mmShareCard.defaultExpectation.paramPtrs = &ProfileSvcMockShareCardParamPtrs{}
 	}
-	mmGetUserCards.defaultExpectation.paramPtrs.username = &username
-	mmGetUserCards.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+	mmShareCard.defaultExpectation.paramPtrs.share = &share
+	mmShareCard.defaultExpectation.expectationOrigins.originShare = minimock.CallerInfo(1)
 
-	return mmGetUserCards
+	return mmShareCard
 }
 
-// Inspect accepts an inspector function that has same arguments as the ProfileSvc.GetUserCards
-func (mmGetUserCards *mProfileSvcMockGetUserCards) Inspect(f func(ctx context.Context, username string)) *mProfileSvcMockGetUserCards {
-	if mmGetUserCards.mock.inspectFuncGetUserCards != nil {
-		mmGetUserCards.mock.t.Fatalf("Inspect function is already set for ProfileSvcMock.GetUserCards")
+// Inspect accepts an inspector function that has same arguments as the ProfileSvc.ShareCard
+func (mmShareCard *mProfileSvcMockShareCard) Inspect(f func(ctx context.Context, share profile.CardShare)) *mProfileSvcMockShareCard {
+	if mmShareCard.mock.inspectFuncShareCard != nil {
+		mmShareCard.mock.t.Fatalf("Inspect function is already set for ProfileSvcMock.ShareCard")
 	}
 
-	mmGetUserCards.mock.inspectFuncGetUserCards = f
+	mmShareCard.mock.inspectFuncShareCard = f
 
-	return mmGetUserCards
+	return mmShareCard
 }
 
-// Return sets up results that will be returned by ProfileSvc.GetUserCards
-func (mmGetUserCards *mProfileSvcMockGetUserCards) Return(ca1 []profile.CardInfo, err error) *ProfileSvcMock {
-	if mmGetUserCards.mock.funcGetUserCards != nil {
-		mmGetUserCards.mock.t.Fatalf("ProfileSvcMock.GetUserCards mock is already set by Set")
+// Return sets up results that will be returned by ProfileSvc.ShareCard
+func (mmShareCard *mProfileSvcMockShareCard) Return(err error) *ProfileSvcMock {
+	if mmShareCard.mock.funcShareCard != nil {
+		mmShareCard.mock.t.Fatalf("ProfileSvcMock.ShareCard mock is already set by Set")
 	}
 
-	if mmGetUserCards.defaultExpectation == nil {
-		mmGetUserCards.defaultExpectation = &ProfileSvcMockGetUserCardsExpectation{mock: mmGetUserCards.mock}
+	if mmShareCard.defaultExpectation == nil {
+		mmShareCard.defaultExpectation = &ProfileSvcMockShareCardExpectation{mock: mmShareCard.mock}
 	}
-	mmGetUserCards.defaultExpectation.results = &ProfileSvcMockGetUserCardsResults{ca1, err}
-	mmGetUserCards.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetUserCards.mock
+	mmShareCard.defaultExpectation.results = &ProfileSvcMockShareCardResults{err}
+	mmShareCard.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmShareCard.mock
 }
 
-// Set uses given function f to mock the ProfileSvc.GetUserCards method
-func (mmGetUserCards *mProfileSvcMockGetUserCards) Set(f func(ctx context.Context, username string) (ca1 []profile.CardInfo, err error)) *ProfileSvcMock {
-	if mmGetUserCards.defaultExpectation != nil {
-		mmGetUserCards.mock.t.Fatalf("Default expectation is already set for the ProfileSvc.GetUserCards method")
+// Set uses given function f to mock the ProfileSvc.ShareCard method
+func (mmShareCard *mProfileSvcMockShareCard) Set(f func(ctx context.Context, share profile.CardShare) (err error)) *ProfileSvcMock {
+	if mmShareCard.defaultExpectation != nil {
+		mmShareCard.mock.t.Fatalf("Default expectation is already set for the ProfileSvc.ShareCard method")
 	}
 
-	if len(mmGetUserCards.expectations) > 0 {
-		mmGetUserCards.mock.t.Fatalf("Some expectations are already set for the ProfileSvc.GetUserCards method")
+	if len(mmShareCard.expectations) > 0 {
+		mmShareCard.mock.t.Fatalf("Some expectations are already set for the ProfileSvc.ShareCard method")
 	}
 
-	mmGetUserCards.mock.funcGetUserCards = f
-	mmGetUserCards.mock.funcGetUserCardsOrigin = minimock.CallerInfo(1)
-	return mmGetUserCards.mock
+	mmShareCard.mock.funcShareCard = f
+	mmShareCard.mock.funcShareCardOrigin = minimock.CallerInfo(1)
+	return mmShareCard.mock
 }
 
-// When sets expectation for the ProfileSvc.GetUserCards which will trigger the result defined by the following
+// When sets expectation for the ProfileSvc.ShareCard which will trigger the result defined by the following
 // Then helper
-func (mmGetUserCards *mProfileSvcMockGetUserCards) When(ctx context.Context, username string) *ProfileSvcMockGetUserCardsExpectation {
-	if mmGetUserCards.mock.funcGetUserCards != nil {
-		mmGetUserCards.mock.t.Fatalf("ProfileSvcMock.GetUserCards mock is already set by Set")
+func (mmShareCard *mProfileSvcMockShareCard) When(ctx context.Context, share profile.CardShare) *ProfileSvcMockShareCardExpectation {
+	if mmShareCard.mock.funcShareCard != nil {
+		mmShareCard.mock.t.Fatalf("ProfileSvcMock.ShareCard mock is already set by Set")
 	}
 
-	expectation := &ProfileSvcMockGetUserCardsExpectation{
-		mock:               mmGetUserCards.mock,
-		params:             &ProfileSvcMockGetUserCardsParams{ctx, username},
-		expectationOrigins: ProfileSvcMockGetUserCardsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ProfileSvcMockShareCardExpectation{
+		mock:               mmShareCard.mock,
+		params:             &ProfileSvcMockShareCardParams{ctx, share},
+		expectationOrigins: ProfileSvcMockShareCardExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetUserCards.expectations = append(mmGetUserCards.expectations, expectation)
+	mmShareCard.expectations = append(mmShareCard.expectations, expectation)
 	return expectation
 }
 
-// Then sets up ProfileSvc.GetUserCards return parameters for the expectation previously defined by the When method
-func (e *ProfileSvcMockGetUserCardsExpectation) Then(ca1 []profile.CardInfo, err error) *ProfileSvcMock {
-	e.results = &ProfileSvcMockGetUserCardsResults{ca1, err}
+// Then sets up ProfileSvc.ShareCard return parameters for the expectation previously defined by the When method
+func (e *ProfileSvcMockShareCardExpectation) Then(err error) *ProfileSvcMock {
+	e.results = &ProfileSvcMockShareCardResults{err}
 	return e.mock
 }
 
-// Times sets number of times ProfileSvc.GetUserCards should be invoked
-func (mmGetUserCards *mProfileSvcMockGetUserCards) Times(n uint64) *mProfileSvcMockGetUserCards {
+// Times sets number of times ProfileSvc.ShareCard should be invoked
+func (mmShareCard *mProfileSvcMockShareCard) Times(n uint64) *mProfileSvcMockShareCard {
 	if n == 0 {
-		mmGetUserCards.mock.t.Fatalf("Times of ProfileSvcMock.GetUserCards mock can not be zero")
+		mmShareCard.mock.t.Fatalf("Times of ProfileSvcMock.ShareCard mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetUserCards.expectedInvocations, n)
-	mmGetUserCards.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetUserCards
+	mm_atomic.StoreUint64(&mmShareCard.expectedInvocations, n)
+	mmShareCard.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmShareCard
 }
 
-func (mmGetUserCards *mProfileSvcMockGetUserCards) invocationsDone() bool {
-	if len(mmGetUserCards.expectations) == 0 && mmGetUserCards.defaultExpectation == nil && mmGetUserCards.mock.funcGetUserCards == nil {
+func (mmShareCard *mProfileSvcMockShareCard) invocationsDone() bool {
+	if len(mmShareCard.expectations) == 0 && mmShareCard.defaultExpectation == nil && mmShareCard.mock.funcShareCard == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetUserCards.mock.afterGetUserCardsCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetUserCards.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmShareCard.mock.afterShareCardCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmShareCard.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetUserCards implements mm_handler.ProfileSvc
-func (mmGetUserCards *ProfileSvcMock) GetUserCards(ctx context.Context, username string) (ca1 []profile.CardInfo, err error) {
-	mm_atomic.AddUint64(&mmGetUserCards.beforeGetUserCardsCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetUserCards.afterGetUserCardsCounter, 1)
+// ShareCard implements mm_handler.ProfileSvc
+func (mmShareCard *ProfileSvcMock) ShareCard(ctx context.Context, share profile.CardShare) (err error) {
+	mm_atomic.AddUint64(&mmShareCard.beforeShareCardCounter, 1)
+	defer mm_atomic.AddUint64(&mmShareCard.afterShareCardCounter, 1)
 
-	mmGetUserCards.t.Helper()
+	mmShareCard.t.Helper()
 
-	if mmGetUserCards.inspectFuncGetUserCards != nil {
-		mmGetUserCards.inspectFuncGetUserCards(ctx, username)
+	if mmShareCard.inspectFuncShareCard != nil {
+		mmShareCard.inspectFuncShareCard(ctx, share)
 	}
 
-	mm_params := ProfileSvcMockGetUserCardsParams{ctx, username}
+	mm_params := ProfileSvcMockShareCardParams{ctx, share}
 
 	// Record call args
-	mmGetUserCards.GetUserCardsMock.mutex.Lock()
-	mmGetUserCards.GetUserCardsMock.callArgs = append(mmGetUserCards.GetUserCardsMock.callArgs, &mm_params)
-	mmGetUserCards.GetUserCardsMock.mutex.Unlock()
+	mmShareCard.ShareCardMock.mutex.Lock()
+	mmShareCard.ShareCardMock.callArgs = append(mmShareCard.ShareCardMock.callArgs, &mm_params)
+	mmShareCard.ShareCardMock.mutex.Unlock()
 
-	for _, e := range mmGetUserCards.GetUserCardsMock.expectations {
+	for _, e := range mmShareCard.ShareCardMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.ca1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGetUserCards.GetUserCardsMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetUserCards.GetUserCardsMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetUserCards.GetUserCardsMock.defaultExpectation.params
-		mm_want_ptrs := mmGetUserCards.GetUserCardsMock.defaultExpectation.paramPtrs
+	if mmShareCard.ShareCardMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmShareCard.ShareCardMock.defaultExpectation.Counter, 1)
+		mm_want := mmShareCard.ShareCardMock.defaultExpectation.params
+		mm_want_ptrs := mmShareCard.ShareCardMock.defaultExpectation.paramPtrs
 
-		mm_got := ProfileSvcMockGetUserCardsParams{ctx, username}
+		mm_got := ProfileSvcMockShareCardParams{ctx, share}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetUserCards.t.Errorf("ProfileSvcMock.GetUserCards got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetUserCards.GetUserCardsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmShareCard.t.Errorf("ProfileSvcMock.ShareCard got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmShareCard.ShareCardMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
-				mmGetUserCards.t.Errorf("ProfileSvcMock.GetUserCards got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetUserCards.GetUserCardsMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			if mm_want_ptrs.share != nil && !minimock.Equal(*mm_want_ptrs.share, mm_got.share) {
+				mmShareCard.t.Errorf("ProfileSvcMock.ShareCard got unexpected parameter share, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmShareCard.ShareCardMock.defaultExpectation.expectationOrigins.originShare, *mm_want_ptrs.share, mm_got.share, minimock.Diff(*mm_want_ptrs.share, mm_got.share))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetUserCards.t.Errorf("ProfileSvcMock.GetUserCards got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetUserCards.GetUserCardsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmShareCard.t.Errorf("ProfileSvcMock.ShareCard got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmShareCard.ShareCardMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetUserCards.GetUserCardsMock.defaultExpectation.results
+		mm_results := mmShareCard.ShareCardMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetUserCards.t.Fatal("No results are set for the ProfileSvcMock.GetUserCards")
+			mmShareCard.t.Fatal("No results are set for the ProfileSvcMock.ShareCard")
 		}
-		return (*mm_results).ca1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetUserCards.funcGetUserCards != nil {
-		return mmGetUserCards.funcGetUserCards(ctx, username)
+	if mmShareCard.funcShareCard != nil {
+		return mmShareCard.funcShareCard(ctx, share)
 	}
-	mmGetUserCards.t.Fatalf("Unexpected call to ProfileSvcMock.GetUserCards. %v %v", ctx, username)
+	mmShareCard.t.Fatalf("Unexpected call to ProfileSvcMock.ShareCard. %v %v", ctx, share)
 	return
 }
 
-// GetUserCardsAfterCounter returns a count of finished ProfileSvcMock.GetUserCards invocations
-func (mmGetUserCards *ProfileSvcMock) GetUserCardsAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetUserCards.afterGetUserCardsCounter)
+// ShareCardAfterCounter returns a count of finished ProfileSvcMock.ShareCard invocations
+func (mmShareCard *ProfileSvcMock) ShareCardAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmShareCard.afterShareCardCounter)
 }
 
-// GetUserCardsBeforeCounter returns a count of ProfileSvcMock.GetUserCards invocations
-func (mmGetUserCards *ProfileSvcMock) GetUserCardsBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetUserCards.beforeGetUserCardsCounter)
+// ShareCardBeforeCounter returns a count of ProfileSvcMock.ShareCard invocations
+func (mmShareCard *ProfileSvcMock) ShareCardBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmShareCard.beforeShareCardCounter)
 }
 
-// Calls returns a list of arguments used in each call to ProfileSvcMock.GetUserCards.
+// Calls returns a list of arguments used in each call to ProfileSvcMock.ShareCard.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetUserCards *mProfileSvcMockGetUserCards) Calls() []*ProfileSvcMockGetUserCardsParams {
-	mmGetUserCards.mutex.RLock()
+func (mmShareCard *mProfileSvcMockShareCard) Calls() []*ProfileSvcMockShareCardParams {
+	mmShareCard.mutex.RLock()
 
-	argCopy := make([]*ProfileSvcMockGetUserCardsParams, len(mmGetUserCards.callArgs))
-	copy(argCopy, mmGetUserCards.callArgs)
+	argCopy := make([]*ProfileSvcMockShareCardParams, len(mmShareCard.callArgs))
+	copy(argCopy, mmShareCard.callArgs)
 
-	mmGetUserCards.mutex.RUnlock()
+	mmShareCard.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetUserCardsDone returns true if the count of the GetUserCards invocations corresponds
+// MinimockShareCardDone returns true if the count of the ShareCard invocations corresponds
 // the number of defined expectations
-func (m *ProfileSvcMock) MinimockGetUserCardsDone() bool {
-	if m.GetUserCardsMock.optional {
+func (m *ProfileSvcMock) MinimockShareCardDone() bool {
+	if m.ShareCardMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetUserCardsMock.expectations {
+	for _, e := range m.ShareCardMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetUserCardsMock.invocationsDone()
+	return m.ShareCardMock.invocationsDone()
 }
 
-// MinimockGetUserCardsInspect logs each unmet expectation
-func (m *ProfileSvcMock) MinimockGetUserCardsInspect() {
-	for _, e := range m.GetUserCardsMock.expectations {
+// MinimockShareCardInspect logs each unmet expectation
+func (m *ProfileSvcMock) MinimockShareCardInspect() {
+	for _, e := range m.ShareCardMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ProfileSvcMock.GetUserCards at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ProfileSvcMock.ShareCard at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetUserCardsCounter := mm_atomic.LoadUint64(&m.afterGetUserCardsCounter)
+	afterShareCardCounter := mm_atomic.LoadUint64(&m.afterShareCardCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetUserCardsMock.defaultExpectation != nil && afterGetUserCardsCounter < 1 {
-		if m.GetUserCardsMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ProfileSvcMock.GetUserCards at\n%s", m.GetUserCardsMock.defaultExpectation.returnOrigin)
+	if m.ShareCardMock.defaultExpectation != nil && afterShareCardCounter < 1 {
+		if m.ShareCardMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ProfileSvcMock.ShareCard at\n%s", m.ShareCardMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ProfileSvcMock.GetUserCards at\n%s with params: %#v", m.GetUserCardsMock.defaultExpectation.expectationOrigins.origin, *m.GetUserCardsMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ProfileSvcMock.ShareCard at\n%s with params: %#v", m.ShareCardMock.defaultExpectation.expectationOrigins.origin, *m.ShareCardMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetUserCards != nil && afterGetUserCardsCounter < 1 {
-		m.t.Errorf("Expected call to ProfileSvcMock.GetUserCards at\n%s", m.funcGetUserCardsOrigin)
+	if m.funcShareCard != nil && afterShareCardCounter < 1 {
+		m.t.Errorf("Expected call to ProfileSvcMock.ShareCard at\n%s", m.funcShareCardOrigin)
 	}
 
-	if !m.GetUserCardsMock.invocationsDone() && afterGetUserCardsCounter > 0 {
-		m.t.Errorf("Expected %d calls to ProfileSvcMock.GetUserCards at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetUserCardsMock.expectedInvocations), m.GetUserCardsMock.expectedInvocationsOrigin, afterGetUserCardsCounter)
+	if !m.ShareCardMock.invocationsDone() && afterShareCardCounter > 0 {
+		m.t.Errorf("Expected %d calls to ProfileSvcMock.ShareCard at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ShareCardMock.expectedInvocations), m.ShareCardMock.expectedInvocationsOrigin, afterShareCardCounter)
 	}
 }
 
@@ -807,12 +3910,14 @@ type ProfileSvcMockUploadInfoExpectation struct {
 type ProfileSvcMockUploadInfoParams struct {
 	ctx     context.Context
 	profile profile.CardInfo
+	scope   *apikey.Scope
 }
 
 // ProfileSvcMockUploadInfoParamPtrs contains pointers to parameters of the ProfileSvc.UploadInfo
 type ProfileSvcMockUploadInfoParamPtrs struct {
 	ctx     *context.Context
 	profile *profile.CardInfo
+	scope   **apikey.Scope
 }
 
 // ProfileSvcMockUploadInfoResults contains results of the ProfileSvc.UploadInfo
@@ -825,6 +3930,7 @@ type ProfileSvcMockUploadInfoExpectationOrigins struct {
 	origin        string
 	originCtx     string
 	originProfile string
+	originScope   string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -838,7 +3944,7 @@ func (mmUploadInfo *mProfileSvcMockUploadInfo) Optional() *mProfileSvcMockUpload
 }
 
 // Expect sets up expected params for ProfileSvc.UploadInfo
-func (mmUploadInfo *mProfileSvcMockUploadInfo) Expect(ctx context.Context, profile profile.CardInfo) *mProfileSvcMockUploadInfo {
+func (mmUploadInfo *mProfileSvcMockUploadInfo) Expect(ctx context.Context, profile profile.CardInfo, scope *apikey.Scope) *mProfileSvcMockUploadInfo {
 	if mmUploadInfo.mock.funcUploadInfo != nil {
 		mmUploadInfo.mock.t.Fatalf("ProfileSvcMock.UploadInfo mock is already set by Set")
 	}
@@ -851,7 +3957,7 @@ func (mmUploadInfo *mProfileSvcMockUploadInfo) Expect(ctx context.Context, profi
 		mmUploadInfo.mock.t.Fatalf("ProfileSvcMock.UploadInfo mock is already set by ExpectParams functions")
 	}
 
-	mmUploadInfo.defaultExpectation.params = &ProfileSvcMockUploadInfoParams{ctx, profile}
+	mmUploadInfo.defaultExpectation.params = &ProfileSvcMockUploadInfoParams{ctx, profile, scope}
 	mmUploadInfo.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmUploadInfo.expectations {
 		if minimock.Equal(e.params, mmUploadInfo.defaultExpectation.params) {
@@ -908,8 +4014,31 @@ func (mmUploadInfo *mProfileSvcMockUploadInfo) ExpectProfileParam2(profile profi
 	return mmUploadInfo
 }
 
+// ExpectScopeParam3 sets up expected param scope for ProfileSvc.UploadInfo
+func (mmUploadInfo *mProfileSvcMockUploadInfo) ExpectScopeParam3(scope *apikey.Scope) *mProfileSvcMockUploadInfo {
+	if mmUploadInfo.mock.funcUploadInfo != nil {
+		mmUploadInfo.mock.t.Fatalf("ProfileSvcMock.UploadInfo mock is already set by Set")
+	}
+
+	if mmUploadInfo.defaultExpectation == nil {
+		mmUploadInfo.defaultExpectation = &ProfileSvcMockUploadInfoExpectation{}
+	}
+
+	if mmUploadInfo.defaultExpectation.params != nil {
+		mmUploadInfo.mock.t.Fatalf("ProfileSvcMock.UploadInfo mock is already set by Expect")
+	}
+
+	if mmUploadInfo.defaultExpectation.paramPtrs == nil {
+		mmUploadInfo.defaultExpectation.paramPtrs = &ProfileSvcMockUploadInfoParamPtrs{}
+	}
+	mmUploadInfo.defaultExpectation.paramPtrs.scope = &scope
+	mmUploadInfo.defaultExpectation.expectationOrigins.originScope = minimock.CallerInfo(1)
+
+	return mmUploadInfo
+}
+
 // Inspect accepts an inspector function that has same arguments as the ProfileSvc.UploadInfo
-func (mmUploadInfo *mProfileSvcMockUploadInfo) Inspect(f func(ctx context.Context, profile profile.CardInfo)) *mProfileSvcMockUploadInfo {
+func (mmUploadInfo *mProfileSvcMockUploadInfo) Inspect(f func(ctx context.Context, profile profile.CardInfo, scope *apikey.Scope)) *mProfileSvcMockUploadInfo {
 	if mmUploadInfo.mock.inspectFuncUploadInfo != nil {
 		mmUploadInfo.mock.t.Fatalf("Inspect function is already set for ProfileSvcMock.UploadInfo")
 	}
@@ -934,7 +4063,7 @@ func (mmUploadInfo *mProfileSvcMockUploadInfo) Return(err error) *ProfileSvcMock
 }
 
 // Set uses given function f to mock the ProfileSvc.UploadInfo method
-func (mmUploadInfo *mProfileSvcMockUploadInfo) Set(f func(ctx context.Context, profile profile.CardInfo) (err error)) *ProfileSvcMock {
+func (mmUploadInfo *mProfileSvcMockUploadInfo) Set(f func(ctx context.Context, profile profile.CardInfo, scope *apikey.Scope) (err error)) *ProfileSvcMock {
 	if mmUploadInfo.defaultExpectation != nil {
 		mmUploadInfo.mock.t.Fatalf("Default expectation is already set for the ProfileSvc.UploadInfo method")
 	}
@@ -950,14 +4079,14 @@ func (mmUploadInfo *mProfileSvcMockUploadInfo) Set(f func(ctx context.Context, p
 
 // When sets expectation for the ProfileSvc.UploadInfo which will trigger the result defined by the following
 // Then helper
-func (mmUploadInfo *mProfileSvcMockUploadInfo) When(ctx context.Context, profile profile.CardInfo) *ProfileSvcMockUploadInfoExpectation {
+func (mmUploadInfo *mProfileSvcMockUploadInfo) When(ctx context.Context, profile profile.CardInfo, scope *apikey.Scope) *ProfileSvcMockUploadInfoExpectation {
 	if mmUploadInfo.mock.funcUploadInfo != nil {
 		mmUploadInfo.mock.t.Fatalf("ProfileSvcMock.UploadInfo mock is already set by Set")
 	}
 
 	expectation := &ProfileSvcMockUploadInfoExpectation{
 		mock:               mmUploadInfo.mock,
-		params:             &ProfileSvcMockUploadInfoParams{ctx, profile},
+		params:             &ProfileSvcMockUploadInfoParams{ctx, profile, scope},
 		expectationOrigins: ProfileSvcMockUploadInfoExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmUploadInfo.expectations = append(mmUploadInfo.expectations, expectation)
@@ -992,17 +4121,17 @@ func (mmUploadInfo *mProfileSvcMockUploadInfo) invocationsDone() bool {
 }
 
 // UploadInfo implements mm_handler.ProfileSvc
-func (mmUploadInfo *ProfileSvcMock) UploadInfo(ctx context.Context, profile profile.CardInfo) (err error) {
+func (mmUploadInfo *ProfileSvcMock) UploadInfo(ctx context.Context, profile profile.CardInfo, scope *apikey.Scope) (err error) {
 	mm_atomic.AddUint64(&mmUploadInfo.beforeUploadInfoCounter, 1)
 	defer mm_atomic.AddUint64(&mmUploadInfo.afterUploadInfoCounter, 1)
 
 	mmUploadInfo.t.Helper()
 
 	if mmUploadInfo.inspectFuncUploadInfo != nil {
-		mmUploadInfo.inspectFuncUploadInfo(ctx, profile)
+		mmUploadInfo.inspectFuncUploadInfo(ctx, profile, scope)
 	}
 
-	mm_params := ProfileSvcMockUploadInfoParams{ctx, profile}
+	mm_params := ProfileSvcMockUploadInfoParams{ctx, profile, scope}
 
 	// Record call args
 	mmUploadInfo.UploadInfoMock.mutex.Lock()
@@ -1021,7 +4150,7 @@ func (mmUploadInfo *ProfileSvcMock) UploadInfo(ctx context.Context, profile prof
 		mm_want := mmUploadInfo.UploadInfoMock.defaultExpectation.params
 		mm_want_ptrs := mmUploadInfo.UploadInfoMock.defaultExpectation.paramPtrs
 
-		mm_got := ProfileSvcMockUploadInfoParams{ctx, profile}
+		mm_got := ProfileSvcMockUploadInfoParams{ctx, profile, scope}
 
 		if mm_want_ptrs != nil {
 
@@ -1035,6 +4164,11 @@ func (mmUploadInfo *ProfileSvcMock) UploadInfo(ctx context.Context, profile prof
 					mmUploadInfo.UploadInfoMock.defaultExpectation.expectationOrigins.originProfile, *mm_want_ptrs.profile, mm_got.profile, minimock.Diff(*mm_want_ptrs.profile, mm_got.profile))
 			}
 
+			if mm_want_ptrs.scope != nil && !minimock.Equal(*mm_want_ptrs.scope, mm_got.scope) {
+				mmUploadInfo.t.Errorf("ProfileSvcMock.UploadInfo got unexpected parameter scope, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmUploadInfo.UploadInfoMock.defaultExpectation.expectationOrigins.originScope, *mm_want_ptrs.scope, mm_got.scope, minimock.Diff(*mm_want_ptrs.scope, mm_got.scope))
+			}
+
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
 			mmUploadInfo.t.Errorf("ProfileSvcMock.UploadInfo got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 				mmUploadInfo.UploadInfoMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
@@ -1047,9 +4181,9 @@ func (mmUploadInfo *ProfileSvcMock) UploadInfo(ctx context.Context, profile prof
 		return (*mm_results).err
 	}
 	if mmUploadInfo.funcUploadInfo != nil {
-		return mmUploadInfo.funcUploadInfo(ctx, profile)
+		return mmUploadInfo.funcUploadInfo(ctx, profile, scope)
 	}
-	mmUploadInfo.t.Fatalf("Unexpected call to ProfileSvcMock.UploadInfo. %v %v", ctx, profile)
+	mmUploadInfo.t.Fatalf("Unexpected call to ProfileSvcMock.UploadInfo. %v %v %v", ctx, profile, scope)
 	return
 }
 
@@ -1125,10 +4259,26 @@ func (m *ProfileSvcMock) MinimockUploadInfoInspect() {
 func (m *ProfileSvcMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockCreateFolderInspect()
+
 			m.MinimockDeleteCardInspect()
 
+			m.MinimockDeleteFolderInspect()
+
+			m.MinimockDeleteTagInspect()
+
+			m.MinimockGetFoldersInspect()
+
+			m.MinimockGetTagsInspect()
+
 			m.MinimockGetUserCardsInspect()
 
+			m.MinimockRevokeShareInspect()
+
+			m.MinimockSearchInspect()
+
+			m.MinimockShareCardInspect()
+
 			m.MinimockUploadInfoInspect()
 		}
 	})
@@ -1153,7 +4303,15 @@ func (m *ProfileSvcMock) MinimockWait(timeout mm_time.Duration) {
 func (m *ProfileSvcMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockCreateFolderDone() &&
 		m.MinimockDeleteCardDone() &&
+		m.MinimockDeleteFolderDone() &&
+		m.MinimockDeleteTagDone() &&
+		m.MinimockGetFoldersDone() &&
+		m.MinimockGetTagsDone() &&
 		m.MinimockGetUserCardsDone() &&
+		m.MinimockRevokeShareDone() &&
+		m.MinimockSearchDone() &&
+		m.MinimockShareCardDone() &&
 		m.MinimockUploadInfoDone()
 }
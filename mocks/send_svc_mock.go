@@ -0,0 +1,836 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.3). DO NOT EDIT.
+
+package mock_service
+
+//go:generate minimock -i github.com/gleb-korostelev/GophKeeper/internal/handler.SendSvc -o send_svc_mock.go -n SendSvcMock -p mock_service
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gleb-korostelev/GophKeeper/models/send"
+	"github.com/gojuno/minimock/v3"
+)
+
+// SendSvcMock implements mm_handler.SendSvc
+type SendSvcMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcCreateSend          func(ctx context.Context, sd send.Send, password string) (id string, err error)
+	funcCreateSendOrigin    string
+	inspectFuncCreateSend   func(ctx context.Context, sd send.Send, password string)
+	afterCreateSendCounter  uint64
+	beforeCreateSendCounter uint64
+	CreateSendMock          mSendSvcMockCreateSend
+
+	funcOpenSend          func(ctx context.Context, id string, password string) (s1 send.Send, err error)
+	funcOpenSendOrigin    string
+	inspectFuncOpenSend   func(ctx context.Context, id string, password string)
+	afterOpenSendCounter  uint64
+	beforeOpenSendCounter uint64
+	OpenSendMock          mSendSvcMockOpenSend
+}
+
+// NewSendSvcMock returns a mock for mm_handler.SendSvc
+func NewSendSvcMock(t minimock.Tester) *SendSvcMock {
+	m := &SendSvcMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.CreateSendMock = mSendSvcMockCreateSend{mock: m}
+	m.CreateSendMock.callArgs = []*SendSvcMockCreateSendParams{}
+
+	m.OpenSendMock = mSendSvcMockOpenSend{mock: m}
+	m.OpenSendMock.callArgs = []*SendSvcMockOpenSendParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mSendSvcMockCreateSend struct {
+	optional           bool
+	mock               *SendSvcMock
+	defaultExpectation *SendSvcMockCreateSendExpectation
+	expectations       []*SendSvcMockCreateSendExpectation
+
+	callArgs []*SendSvcMockCreateSendParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SendSvcMockCreateSendExpectation specifies expectation struct of the SendSvc.CreateSend
+type SendSvcMockCreateSendExpectation struct {
+	mock               *SendSvcMock
+	params             *SendSvcMockCreateSendParams
+	paramPtrs          *SendSvcMockCreateSendParamPtrs
+	expectationOrigins SendSvcMockCreateSendExpectationOrigins
+	results            *SendSvcMockCreateSendResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SendSvcMockCreateSendParams contains parameters of the SendSvc.CreateSend
+type SendSvcMockCreateSendParams struct {
+	ctx      context.Context
+	sd       send.Send
+	password string
+}
+
+// SendSvcMockCreateSendParamPtrs contains pointers to parameters of the SendSvc.CreateSend
+type SendSvcMockCreateSendParamPtrs struct {
+	ctx      *context.Context
+	sd       *send.Send
+	password *string
+}
+
+// SendSvcMockCreateSendResults contains results of the SendSvc.CreateSend
+type SendSvcMockCreateSendResults struct {
+	id  string
+	err error
+}
+
+// SendSvcMockCreateSendOrigins contains origins of expectations of the SendSvc.CreateSend
+type SendSvcMockCreateSendExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originSd       string
+	originPassword string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCreateSend *mSendSvcMockCreateSend) Optional() *mSendSvcMockCreateSend {
+	mmCreateSend.optional = true
+	return mmCreateSend
+}
+
+// Expect sets up expected params for SendSvc.CreateSend
+func (mmCreateSend *mSendSvcMockCreateSend) Expect(ctx context.Context, sd send.Send, password string) *mSendSvcMockCreateSend {
+	if mmCreateSend.mock.funcCreateSend != nil {
+		mmCreateSend.mock.t.Fatalf("SendSvcMock.CreateSend mock is already set by Set")
+	}
+
+	if mmCreateSend.defaultExpectation == nil {
+		mmCreateSend.defaultExpectation = &SendSvcMockCreateSendExpectation{}
+	}
+
+	if mmCreateSend.defaultExpectation.paramPtrs != nil {
+		mmCreateSend.mock.t.Fatalf("SendSvcMock.CreateSend mock is already set by ExpectParams functions")
+	}
+
+	mmCreateSend.defaultExpectation.params = &SendSvcMockCreateSendParams{ctx, sd, password}
+	mmCreateSend.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCreateSend.expectations {
+		if minimock.Equal(e.params, mmCreateSend.defaultExpectation.params) {
+			mmCreateSend.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCreateSend.defaultExpectation.params)
+		}
+	}
+
+	return mmCreateSend
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SendSvc.CreateSend
+func (mmCreateSend *mSendSvcMockCreateSend) ExpectCtxParam1(ctx context.Context) *mSendSvcMockCreateSend {
+	if mmCreateSend.mock.funcCreateSend != nil {
+		mmCreateSend.mock.t.Fatalf("SendSvcMock.CreateSend mock is already set by Set")
+	}
+
+	if mmCreateSend.defaultExpectation == nil {
+		mmCreateSend.defaultExpectation = &SendSvcMockCreateSendExpectation{}
+	}
+
+	if mmCreateSend.defaultExpectation.params != nil {
+		mmCreateSend.mock.t.Fatalf("SendSvcMock.CreateSend mock is already set by Expect")
+	}
+
+	if mmCreateSend.defaultExpectation.paramPtrs == nil {
+		mmCreateSend.defaultExpectation.paramPtrs = &SendSvcMockCreateSendParamPtrs{}
+	}
+	mmCreateSend.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCreateSend.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCreateSend
+}
+
+// ExpectSdParam2 sets up expected param sd for SendSvc.CreateSend
+func (mmCreateSend *mSendSvcMockCreateSend) ExpectSdParam2(sd send.Send) *mSendSvcMockCreateSend {
+	if mmCreateSend.mock.funcCreateSend != nil {
+		mmCreateSend.mock.t.Fatalf("SendSvcMock.CreateSend mock is already set by Set")
+	}
+
+	if mmCreateSend.defaultExpectation == nil {
+		mmCreateSend.defaultExpectation = &SendSvcMockCreateSendExpectation{}
+	}
+
+	if mmCreateSend.defaultExpectation.params != nil {
+		mmCreateSend.mock.t.Fatalf("SendSvcMock.CreateSend mock is already set by Expect")
+	}
+
+	if mmCreateSend.defaultExpectation.paramPtrs == nil {
+		mmCreateSend.defaultExpectation.paramPtrs = &SendSvcMockCreateSendParamPtrs{}
+	}
+	mmCreateSend.defaultExpectation.paramPtrs.sd = &sd
+	mmCreateSend.defaultExpectation.expectationOrigins.originSd = minimock.CallerInfo(1)
+
+	return mmCreateSend
+}
+
+// ExpectPasswordParam3 sets up expected param password for SendSvc.CreateSend
+func (mmCreateSend *mSendSvcMockCreateSend) ExpectPasswordParam3(password string) *mSendSvcMockCreateSend {
+	if mmCreateSend.mock.funcCreateSend != nil {
+		mmCreateSend.mock.t.Fatalf("SendSvcMock.CreateSend mock is already set by Set")
+	}
+
+	if mmCreateSend.defaultExpectation == nil {
+		mmCreateSend.defaultExpectation = &SendSvcMockCreateSendExpectation{}
+	}
+
+	if mmCreateSend.defaultExpectation.params != nil {
+		mmCreateSend.mock.t.Fatalf("SendSvcMock.CreateSend mock is already set by Expect")
+	}
+
+	if mmCreateSend.defaultExpectation.paramPtrs == nil {
+		mmCreateSend.defaultExpectation.paramPtrs = &SendSvcMockCreateSendParamPtrs{}
+	}
+	mmCreateSend.defaultExpectation.paramPtrs.password = &password
+	mmCreateSend.defaultExpectation.expectationOrigins.originPassword = minimock.CallerInfo(1)
+
+	return mmCreateSend
+}
+
+// Inspect accepts an inspector function that has same arguments as the SendSvc.CreateSend
+func (mmCreateSend *mSendSvcMockCreateSend) Inspect(f func(ctx context.Context, sd send.Send, password string)) *mSendSvcMockCreateSend {
+	if mmCreateSend.mock.inspectFuncCreateSend != nil {
+		mmCreateSend.mock.t.Fatalf("Inspect function is already set for SendSvcMock.CreateSend")
+	}
+
+	mmCreateSend.mock.inspectFuncCreateSend = f
+
+	return mmCreateSend
+}
+
+// Return sets up results that will be returned by SendSvc.CreateSend
+func (mmCreateSend *mSendSvcMockCreateSend) Return(id string, err error) *SendSvcMock {
+	if mmCreateSend.mock.funcCreateSend != nil {
+		mmCreateSend.mock.t.Fatalf("SendSvcMock.CreateSend mock is already set by Set")
+	}
+
+	if mmCreateSend.defaultExpectation == nil {
+		mmCreateSend.defaultExpectation = &SendSvcMockCreateSendExpectation{mock: mmCreateSend.mock}
+	}
+	mmCreateSend.defaultExpectation.results = &SendSvcMockCreateSendResults{id, err}
+	mmCreateSend.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCreateSend.mock
+}
+
+// Set uses given function f to mock the SendSvc.CreateSend method
+func (mmCreateSend *mSendSvcMockCreateSend) Set(f func(ctx context.Context, sd send.Send, password string) (id string, err error)) *SendSvcMock {
+	if mmCreateSend.defaultExpectation != nil {
+		mmCreateSend.mock.t.Fatalf("Default expectation is already set for the SendSvc.CreateSend method")
+	}
+
+	if len(mmCreateSend.expectations) > 0 {
+		mmCreateSend.mock.t.Fatalf("Some expectations are already set for the SendSvc.CreateSend method")
+	}
+
+	mmCreateSend.mock.funcCreateSend = f
+	mmCreateSend.mock.funcCreateSendOrigin = minimock.CallerInfo(1)
+	return mmCreateSend.mock
+}
+
+// When sets expectation for the SendSvc.CreateSend which will trigger the result defined by the following
+// Then helper
+func (mmCreateSend *mSendSvcMockCreateSend) When(ctx context.Context, sd send.Send, password string) *SendSvcMockCreateSendExpectation {
+	if mmCreateSend.mock.funcCreateSend != nil {
+		mmCreateSend.mock.t.Fatalf("SendSvcMock.CreateSend mock is already set by Set")
+	}
+
+	expectation := &SendSvcMockCreateSendExpectation{
+		mock:               mmCreateSend.mock,
+		params:             &SendSvcMockCreateSendParams{ctx, sd, password},
+		expectationOrigins: SendSvcMockCreateSendExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCreateSend.expectations = append(mmCreateSend.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SendSvc.CreateSend return parameters for the expectation previously defined by the When method
+func (e *SendSvcMockCreateSendExpectation) Then(id string, err error) *SendSvcMock {
+	e.results = &SendSvcMockCreateSendResults{id, err}
+	return e.mock
+}
+
+// Times sets number of times SendSvc.CreateSend should be invoked
+func (mmCreateSend *mSendSvcMockCreateSend) Times(n uint64) *mSendSvcMockCreateSend {
+	if n == 0 {
+		mmCreateSend.mock.t.Fatalf("Times of SendSvcMock.CreateSend mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCreateSend.expectedInvocations, n)
+	mmCreateSend.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCreateSend
+}
+
+func (mmCreateSend *mSendSvcMockCreateSend) invocationsDone() bool {
+	if len(mmCreateSend.expectations) == 0 && mmCreateSend.defaultExpectation == nil && mmCreateSend.mock.funcCreateSend == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCreateSend.mock.afterCreateSendCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCreateSend.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CreateSend implements mm_handler.SendSvc
+func (mmCreateSend *SendSvcMock) CreateSend(ctx context.Context, sd send.Send, password string) (id string, err error) {
+	mm_atomic.AddUint64(&mmCreateSend.beforeCreateSendCounter, 1)
+	defer mm_atomic.AddUint64(&mmCreateSend.afterCreateSendCounter, 1)
+
+	mmCreateSend.t.Helper()
+
+	if mmCreateSend.inspectFuncCreateSend != nil {
+		mmCreateSend.inspectFuncCreateSend(ctx, sd, password)
+	}
+
+	mm_params := SendSvcMockCreateSendParams{ctx, sd, password}
+
+	// Record call args
+	mmCreateSend.CreateSendMock.mutex.Lock()
+	mmCreateSend.CreateSendMock.callArgs = append(mmCreateSend.CreateSendMock.callArgs, &mm_params)
+	mmCreateSend.CreateSendMock.mutex.Unlock()
+
+	for _, e := range mmCreateSend.CreateSendMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.id, e.results.err
+		}
+	}
+
+	if mmCreateSend.CreateSendMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCreateSend.CreateSendMock.defaultExpectation.Counter, 1)
+		mm_want := mmCreateSend.CreateSendMock.defaultExpectation.params
+		mm_want_ptrs := mmCreateSend.CreateSendMock.defaultExpectation.paramPtrs
+
+		mm_got := SendSvcMockCreateSendParams{ctx, sd, password}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCreateSend.t.Errorf("SendSvcMock.CreateSend got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateSend.CreateSendMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.sd != nil && !minimock.Equal(*mm_want_ptrs.sd, mm_got.sd) {
+				mmCreateSend.t.Errorf("SendSvcMock.CreateSend got unexpected parameter sd, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateSend.CreateSendMock.defaultExpectation.expectationOrigins.originSd, *mm_want_ptrs.sd, mm_got.sd, minimock.Diff(*mm_want_ptrs.sd, mm_got.sd))
+			}
+
+			if mm_want_ptrs.password != nil && !minimock.Equal(*mm_want_ptrs.password, mm_got.password) {
+				mmCreateSend.t.Errorf("SendSvcMock.CreateSend got unexpected parameter password, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCreateSend.CreateSendMock.defaultExpectation.expectationOrigins.originPassword, *mm_want_ptrs.password, mm_got.password, minimock.Diff(*mm_want_ptrs.password, mm_got.password))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCreateSend.t.Errorf("SendSvcMock.CreateSend got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCreateSend.CreateSendMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCreateSend.CreateSendMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCreateSend.t.Fatal("No results are set for the SendSvcMock.CreateSend")
+		}
+		return (*mm_results).id, (*mm_results).err
+	}
+	if mmCreateSend.funcCreateSend != nil {
+		return mmCreateSend.funcCreateSend(ctx, sd, password)
+	}
+	mmCreateSend.t.Fatalf("Unexpected call to SendSvcMock.CreateSend. %v %v %v", ctx, sd, password)
+	return
+}
+
+// CreateSendAfterCounter returns a count of finished SendSvcMock.CreateSend invocations
+func (mmCreateSend *SendSvcMock) CreateSendAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateSend.afterCreateSendCounter)
+}
+
+// CreateSendBeforeCounter returns a count of SendSvcMock.CreateSend invocations
+func (mmCreateSend *SendSvcMock) CreateSendBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCreateSend.beforeCreateSendCounter)
+}
+
+// Calls returns a list of arguments used in each call to SendSvcMock.CreateSend.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCreateSend *mSendSvcMockCreateSend) Calls() []*SendSvcMockCreateSendParams {
+	mmCreateSend.mutex.RLock()
+
+	argCopy := make([]*SendSvcMockCreateSendParams, len(mmCreateSend.callArgs))
+	copy(argCopy, mmCreateSend.callArgs)
+
+	mmCreateSend.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCreateSendDone returns true if the count of the CreateSend invocations corresponds
+// the number of defined expectations
+func (m *SendSvcMock) MinimockCreateSendDone() bool {
+	if m.CreateSendMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CreateSendMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CreateSendMock.invocationsDone()
+}
+
+// MinimockCreateSendInspect logs each unmet expectation
+func (m *SendSvcMock) MinimockCreateSendInspect() {
+	for _, e := range m.CreateSendMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SendSvcMock.CreateSend at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCreateSendCounter := mm_atomic.LoadUint64(&m.afterCreateSendCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CreateSendMock.defaultExpectation != nil && afterCreateSendCounter < 1 {
+		if m.CreateSendMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SendSvcMock.CreateSend at\n%s", m.CreateSendMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SendSvcMock.CreateSend at\n%s with params: %#v", m.CreateSendMock.defaultExpectation.expectationOrigins.origin, *m.CreateSendMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCreateSend != nil && afterCreateSendCounter < 1 {
+		m.t.Errorf("Expected call to SendSvcMock.CreateSend at\n%s", m.funcCreateSendOrigin)
+	}
+
+	if !m.CreateSendMock.invocationsDone() && afterCreateSendCounter > 0 {
+		m.t.Errorf("Expected %d calls to SendSvcMock.CreateSend at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CreateSendMock.expectedInvocations), m.CreateSendMock.expectedInvocationsOrigin, afterCreateSendCounter)
+	}
+}
+
+type mSendSvcMockOpenSend struct {
+	optional           bool
+	mock               *SendSvcMock
+	defaultExpectation *SendSvcMockOpenSendExpectation
+	expectations       []*SendSvcMockOpenSendExpectation
+
+	callArgs []*SendSvcMockOpenSendParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// SendSvcMockOpenSendExpectation specifies expectation struct of the SendSvc.OpenSend
+type SendSvcMockOpenSendExpectation struct {
+	mock               *SendSvcMock
+	params             *SendSvcMockOpenSendParams
+	paramPtrs          *SendSvcMockOpenSendParamPtrs
+	expectationOrigins SendSvcMockOpenSendExpectationOrigins
+	results            *SendSvcMockOpenSendResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// SendSvcMockOpenSendParams contains parameters of the SendSvc.OpenSend
+type SendSvcMockOpenSendParams struct {
+	ctx      context.Context
+	id       string
+	password string
+}
+
+// SendSvcMockOpenSendParamPtrs contains pointers to parameters of the SendSvc.OpenSend
+type SendSvcMockOpenSendParamPtrs struct {
+	ctx      *context.Context
+	id       *string
+	password *string
+}
+
+// SendSvcMockOpenSendResults contains results of the SendSvc.OpenSend
+type SendSvcMockOpenSendResults struct {
+	s1  send.Send
+	err error
+}
+
+// SendSvcMockOpenSendOrigins contains origins of expectations of the SendSvc.OpenSend
+type SendSvcMockOpenSendExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originId       string
+	originPassword string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmOpenSend *mSendSvcMockOpenSend) Optional() *mSendSvcMockOpenSend {
+	mmOpenSend.optional = true
+	return mmOpenSend
+}
+
+// Expect sets up expected params for SendSvc.OpenSend
+func (mmOpenSend *mSendSvcMockOpenSend) Expect(ctx context.Context, id string, password string) *mSendSvcMockOpenSend {
+	if mmOpenSend.mock.funcOpenSend != nil {
+		mmOpenSend.mock.t.Fatalf("SendSvcMock.OpenSend mock is already set by Set")
+	}
+
+	if mmOpenSend.defaultExpectation == nil {
+		mmOpenSend.defaultExpectation = &SendSvcMockOpenSendExpectation{}
+	}
+
+	if mmOpenSend.defaultExpectation.paramPtrs != nil {
+		mmOpenSend.mock.t.Fatalf("SendSvcMock.OpenSend mock is already set by ExpectParams functions")
+	}
+
+	mmOpenSend.defaultExpectation.params = &SendSvcMockOpenSendParams{ctx, id, password}
+	mmOpenSend.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmOpenSend.expectations {
+		if minimock.Equal(e.params, mmOpenSend.defaultExpectation.params) {
+			mmOpenSend.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmOpenSend.defaultExpectation.params)
+		}
+	}
+
+	return mmOpenSend
+}
+
+// ExpectCtxParam1 sets up expected param ctx for SendSvc.OpenSend
+func (mmOpenSend *mSendSvcMockOpenSend) ExpectCtxParam1(ctx context.Context) *mSendSvcMockOpenSend {
+	if mmOpenSend.mock.funcOpenSend != nil {
+		mmOpenSend.mock.t.Fatalf("SendSvcMock.OpenSend mock is already set by Set")
+	}
+
+	if mmOpenSend.defaultExpectation == nil {
+		mmOpenSend.defaultExpectation = &SendSvcMockOpenSendExpectation{}
+	}
+
+	if mmOpenSend.defaultExpectation.params != nil {
+		mmOpenSend.mock.t.Fatalf("SendSvcMock.OpenSend mock is already set by Expect")
+	}
+
+	if mmOpenSend.defaultExpectation.paramPtrs == nil {
+		mmOpenSend.defaultExpectation.paramPtrs = &SendSvcMockOpenSendParamPtrs{}
+	}
+	mmOpenSend.defaultExpectation.paramPtrs.ctx = &ctx
+	mmOpenSend.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmOpenSend
+}
+
+// ExpectIdParam2 sets up expected param id for SendSvc.OpenSend
+func (mmOpenSend *mSendSvcMockOpenSend) ExpectIdParam2(id string) *mSendSvcMockOpenSend {
+	if mmOpenSend.mock.funcOpenSend != nil {
+		mmOpenSend.mock.t.Fatalf("SendSvcMock.OpenSend mock is already set by Set")
+	}
+
+	if mmOpenSend.defaultExpectation == nil {
+		mmOpenSend.defaultExpectation = &SendSvcMockOpenSendExpectation{}
+	}
+
+	if mmOpenSend.defaultExpectation.params != nil {
+		mmOpenSend.mock.t.Fatalf("SendSvcMock.OpenSend mock is already set by Expect")
+	}
+
+	if mmOpenSend.defaultExpectation.paramPtrs == nil {
+		mmOpenSend.defaultExpectation.paramPtrs = &SendSvcMockOpenSendParamPtrs{}
+	}
+	mmOpenSend.defaultExpectation.paramPtrs.id = &id
+	mmOpenSend.defaultExpectation.expectationOrigins.originId = minimock.CallerInfo(1)
+
+	return mmOpenSend
+}
+
+// ExpectPasswordParam3 sets up expected param password for SendSvc.OpenSend
+func (mmOpenSend *mSendSvcMockOpenSend) ExpectPasswordParam3(password string) *mSendSvcMockOpenSend {
+	if mmOpenSend.mock.funcOpenSend != nil {
+		mmOpenSend.mock.t.Fatalf("SendSvcMock.OpenSend mock is already set by Set")
+	}
+
+	if mmOpenSend.defaultExpectation == nil {
+		mmOpenSend.defaultExpectation = &SendSvcMockOpenSendExpectation{}
+	}
+
+	if mmOpenSend.defaultExpectation.params != nil {
+		mmOpenSend.mock.t.Fatalf("SendSvcMock.OpenSend mock is already set by Expect")
+	}
+
+	if mmOpenSend.defaultExpectation.paramPtrs == nil {
+		mmOpenSend.defaultExpectation.paramPtrs = &SendSvcMockOpenSendParamPtrs{}
+	}
+	mmOpenSend.defaultExpectation.paramPtrs.password = &password
+	mmOpenSend.defaultExpectation.expectationOrigins.originPassword = minimock.CallerInfo(1)
+
+	return mmOpenSend
+}
+
+// Inspect accepts an inspector function that has same arguments as the SendSvc.OpenSend
+func (mmOpenSend *mSendSvcMockOpenSend) Inspect(f func(ctx context.Context, id string, password string)) *mSendSvcMockOpenSend {
+	if mmOpenSend.mock.inspectFuncOpenSend != nil {
+		mmOpenSend.mock.t.Fatalf("Inspect function is already set for SendSvcMock.OpenSend")
+	}
+
+	mmOpenSend.mock.inspectFuncOpenSend = f
+
+	return mmOpenSend
+}
+
+// Return sets up results that will be returned by SendSvc.OpenSend
+func (mmOpenSend *mSendSvcMockOpenSend) Return(s1 send.Send, err error) *SendSvcMock {
+	if mmOpenSend.mock.funcOpenSend != nil {
+		mmOpenSend.mock.t.Fatalf("SendSvcMock.OpenSend mock is already set by Set")
+	}
+
+	if mmOpenSend.defaultExpectation == nil {
+		mmOpenSend.defaultExpectation = &SendSvcMockOpenSendExpectation{mock: mmOpenSend.mock}
+	}
+	mmOpenSend.defaultExpectation.results = &SendSvcMockOpenSendResults{s1, err}
+	mmOpenSend.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmOpenSend.mock
+}
+
+// Set uses given function f to mock the SendSvc.OpenSend method
+func (mmOpenSend *mSendSvcMockOpenSend) Set(f func(ctx context.Context, id string, password string) (s1 send.Send, err error)) *SendSvcMock {
+	if mmOpenSend.defaultExpectation != nil {
+		mmOpenSend.mock.t.Fatalf("Default expectation is already set for the SendSvc.OpenSend method")
+	}
+
+	if len(mmOpenSend.expectations) > 0 {
+		mmOpenSend.mock.t.Fatalf("Some expectations are already set for the SendSvc.OpenSend method")
+	}
+
+	mmOpenSend.mock.funcOpenSend = f
+	mmOpenSend.mock.funcOpenSendOrigin = minimock.CallerInfo(1)
+	return mmOpenSend.mock
+}
+
+// When sets expectation for the SendSvc.OpenSend which will trigger the result defined by the following
+// Then helper
+func (mmOpenSend *mSendSvcMockOpenSend) When(ctx context.Context, id string, password string) *SendSvcMockOpenSendExpectation {
+	if mmOpenSend.mock.funcOpenSend != nil {
+		mmOpenSend.mock.t.Fatalf("SendSvcMock.OpenSend mock is already set by Set")
+	}
+
+	expectation := &SendSvcMockOpenSendExpectation{
+		mock:               mmOpenSend.mock,
+		params:             &SendSvcMockOpenSendParams{ctx, id, password},
+		expectationOrigins: SendSvcMockOpenSendExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmOpenSend.expectations = append(mmOpenSend.expectations, expectation)
+	return expectation
+}
+
+// Then sets up SendSvc.OpenSend return parameters for the expectation previously defined by the When method
+func (e *SendSvcMockOpenSendExpectation) Then(s1 send.Send, err error) *SendSvcMock {
+	e.results = &SendSvcMockOpenSendResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times SendSvc.OpenSend should be invoked
+func (mmOpenSend *mSendSvcMockOpenSend) Times(n uint64) *mSendSvcMockOpenSend {
+	if n == 0 {
+		mmOpenSend.mock.t.Fatalf("Times of SendSvcMock.OpenSend mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmOpenSend.expectedInvocations, n)
+	mmOpenSend.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmOpenSend
+}
+
+func (mmOpenSend *mSendSvcMockOpenSend) invocationsDone() bool {
+	if len(mmOpenSend.expectations) == 0 && mmOpenSend.defaultExpectation == nil && mmOpenSend.mock.funcOpenSend == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmOpenSend.mock.afterOpenSendCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmOpenSend.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// OpenSend implements mm_handler.SendSvc
+func (mmOpenSend *SendSvcMock) OpenSend(ctx context.Context, id string, password string) (s1 send.Send, err error) {
+	mm_atomic.AddUint64(&mmOpenSend.beforeOpenSendCounter, 1)
+	defer mm_atomic.AddUint64(&mmOpenSend.afterOpenSendCounter, 1)
+
+	mmOpenSend.t.Helper()
+
+	if mmOpenSend.inspectFuncOpenSend != nil {
+		mmOpenSend.inspectFuncOpenSend(ctx, id, password)
+	}
+
+	mm_params := SendSvcMockOpenSendParams{ctx, id, password}
+
+	// Record call args
+	mmOpenSend.OpenSendMock.mutex.Lock()
+	mmOpenSend.OpenSendMock.callArgs = append(mmOpenSend.OpenSendMock.callArgs, &mm_params)
+	mmOpenSend.OpenSendMock.mutex.Unlock()
+
+	for _, e := range mmOpenSend.OpenSendMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmOpenSend.OpenSendMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmOpenSend.OpenSendMock.defaultExpectation.Counter, 1)
+		mm_want := mmOpenSend.OpenSendMock.defaultExpectation.params
+		mm_want_ptrs := mmOpenSend.OpenSendMock.defaultExpectation.paramPtrs
+
+		mm_got := SendSvcMockOpenSendParams{ctx, id, password}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmOpenSend.t.Errorf("SendSvcMock.OpenSend got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOpenSend.OpenSendMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.id != nil && !minimock.Equal(*mm_want_ptrs.id, mm_got.id) {
+				mmOpenSend.t.Errorf("SendSvcMock.OpenSend got unexpected parameter id, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOpenSend.OpenSendMock.defaultExpectation.expectationOrigins.originId, *mm_want_ptrs.id, mm_got.id, minimock.Diff(*mm_want_ptrs.id, mm_got.id))
+			}
+
+			if mm_want_ptrs.password != nil && !minimock.Equal(*mm_want_ptrs.password, mm_got.password) {
+				mmOpenSend.t.Errorf("SendSvcMock.OpenSend got unexpected parameter password, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOpenSend.OpenSendMock.defaultExpectation.expectationOrigins.originPassword, *mm_want_ptrs.password, mm_got.password, minimock.Diff(*mm_want_ptrs.password, mm_got.password))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmOpenSend.t.Errorf("SendSvcMock.OpenSend got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmOpenSend.OpenSendMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmOpenSend.OpenSendMock.defaultExpectation.results
+		if mm_results == nil {
+			mmOpenSend.t.Fatal("No results are set for the SendSvcMock.OpenSend")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmOpenSend.funcOpenSend != nil {
+		return mmOpenSend.funcOpenSend(ctx, id, password)
+	}
+	mmOpenSend.t.Fatalf("Unexpected call to SendSvcMock.OpenSend. %v %v %v", ctx, id, password)
+	return
+}
+
+// OpenSendAfterCounter returns a count of finished SendSvcMock.OpenSend invocations
+func (mmOpenSend *SendSvcMock) OpenSendAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOpenSend.afterOpenSendCounter)
+}
+
+// OpenSendBeforeCounter returns a count of SendSvcMock.OpenSend invocations
+func (mmOpenSend *SendSvcMock) OpenSendBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOpenSend.beforeOpenSendCounter)
+}
+
+// Calls returns a list of arguments used in each call to SendSvcMock.OpenSend.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmOpenSend *mSendSvcMockOpenSend) Calls() []*SendSvcMockOpenSendParams {
+	mmOpenSend.mutex.RLock()
+
+	argCopy := make([]*SendSvcMockOpenSendParams, len(mmOpenSend.callArgs))
+	copy(argCopy, mmOpenSend.callArgs)
+
+	mmOpenSend.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockOpenSendDone returns true if the count of the OpenSend invocations corresponds
+// the number of defined expectations
+func (m *SendSvcMock) MinimockOpenSendDone() bool {
+	if m.OpenSendMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.OpenSendMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.OpenSendMock.invocationsDone()
+}
+
+// MinimockOpenSendInspect logs each unmet expectation
+func (m *SendSvcMock) MinimockOpenSendInspect() {
+	for _, e := range m.OpenSendMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to SendSvcMock.OpenSend at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterOpenSendCounter := mm_atomic.LoadUint64(&m.afterOpenSendCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.OpenSendMock.defaultExpectation != nil && afterOpenSendCounter < 1 {
+		if m.OpenSendMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to SendSvcMock.OpenSend at\n%s", m.OpenSendMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to SendSvcMock.OpenSend at\n%s with params: %#v", m.OpenSendMock.defaultExpectation.expectationOrigins.origin, *m.OpenSendMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcOpenSend != nil && afterOpenSendCounter < 1 {
+		m.t.Errorf("Expected call to SendSvcMock.OpenSend at\n%s", m.funcOpenSendOrigin)
+	}
+
+	if !m.OpenSendMock.invocationsDone() && afterOpenSendCounter > 0 {
+		m.t.Errorf("Expected %d calls to SendSvcMock.OpenSend at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.OpenSendMock.expectedInvocations), m.OpenSendMock.expectedInvocationsOrigin, afterOpenSendCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *SendSvcMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockCreateSendInspect()
+
+			m.MinimockOpenSendInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *SendSvcMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *SendSvcMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockCreateSendDone() &&
+		m.MinimockOpenSendDone()
+}
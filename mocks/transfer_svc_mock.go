@@ -0,0 +1,930 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.3). DO NOT EDIT.
+
+package mock_service
+
+//go:generate minimock -i github.com/gleb-korostelev/GophKeeper/internal/handler.TransferSvc -o transfer_svc_mock.go -n TransferSvcMock -p mock_service
+
+import (
+	"context"
+	"io"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/gleb-korostelev/GophKeeper/models/transfer"
+	"github.com/gojuno/minimock/v3"
+)
+
+// TransferSvcMock implements mm_handler.TransferSvc
+type TransferSvcMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcExport          func(ctx context.Context, username string, opts transfer.ExportOptions) (e1 transfer.Export, err error)
+	funcExportOrigin    string
+	inspectFuncExport   func(ctx context.Context, username string, opts transfer.ExportOptions)
+	afterExportCounter  uint64
+	beforeExportCounter uint64
+	ExportMock          mTransferSvcMockExport
+
+	funcImport          func(ctx context.Context, username string, format string, r io.Reader, password string, dryRun bool) (r1 transfer.Report, err error)
+	funcImportOrigin    string
+	inspectFuncImport   func(ctx context.Context, username string, format string, r io.Reader, password string, dryRun bool)
+	afterImportCounter  uint64
+	beforeImportCounter uint64
+	ImportMock          mTransferSvcMockImport
+}
+
+// NewTransferSvcMock returns a mock for mm_handler.TransferSvc
+func NewTransferSvcMock(t minimock.Tester) *TransferSvcMock {
+	m := &TransferSvcMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ExportMock = mTransferSvcMockExport{mock: m}
+	m.ExportMock.callArgs = []*TransferSvcMockExportParams{}
+
+	m.ImportMock = mTransferSvcMockImport{mock: m}
+	m.ImportMock.callArgs = []*TransferSvcMockImportParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mTransferSvcMockExport struct {
+	optional           bool
+	mock               *TransferSvcMock
+	defaultExpectation *TransferSvcMockExportExpectation
+	expectations       []*TransferSvcMockExportExpectation
+
+	callArgs []*TransferSvcMockExportParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TransferSvcMockExportExpectation specifies expectation struct of the TransferSvc.Export
+type TransferSvcMockExportExpectation struct {
+	mock               *TransferSvcMock
+	params             *TransferSvcMockExportParams
+	paramPtrs          *TransferSvcMockExportParamPtrs
+	expectationOrigins TransferSvcMockExportExpectationOrigins
+	results            *TransferSvcMockExportResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TransferSvcMockExportParams contains parameters of the TransferSvc.Export
+type TransferSvcMockExportParams struct {
+	ctx      context.Context
+	username string
+	opts     transfer.ExportOptions
+}
+
+// TransferSvcMockExportParamPtrs contains pointers to parameters of the TransferSvc.Export
+type TransferSvcMockExportParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	opts     *transfer.ExportOptions
+}
+
+// TransferSvcMockExportResults contains results of the TransferSvc.Export
+type TransferSvcMockExportResults struct {
+	e1  transfer.Export
+	err error
+}
+
+// TransferSvcMockExportOrigins contains origins of expectations of the TransferSvc.Export
+type TransferSvcMockExportExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originOpts     string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmExport *mTransferSvcMockExport) Optional() *mTransferSvcMockExport {
+	mmExport.optional = true
+	return mmExport
+}
+
+// Expect sets up expected params for TransferSvc.Export
+func (mmExport *mTransferSvcMockExport) Expect(ctx context.Context, username string, opts transfer.ExportOptions) *mTransferSvcMockExport {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("TransferSvcMock.Export mock is already set by Set")
+	}
+
+	if mmExport.defaultExpectation == nil {
+		mmExport.defaultExpectation = &TransferSvcMockExportExpectation{}
+	}
+
+	if mmExport.defaultExpectation.paramPtrs != nil {
+		mmExport.mock.t.Fatalf("TransferSvcMock.Export mock is already set by ExpectParams functions")
+	}
+
+	mmExport.defaultExpectation.params = &TransferSvcMockExportParams{ctx, username, opts}
+	mmExport.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExport.expectations {
+		if minimock.Equal(e.params, mmExport.defaultExpectation.params) {
+			mmExport.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExport.defaultExpectation.params)
+		}
+	}
+
+	return mmExport
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TransferSvc.Export
+func (mmExport *mTransferSvcMockExport) ExpectCtxParam1(ctx context.Context) *mTransferSvcMockExport {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("TransferSvcMock.Export mock is already set by Set")
+	}
+
+	if mmExport.defaultExpectation == nil {
+		mmExport.defaultExpectation = &TransferSvcMockExportExpectation{}
+	}
+
+	if mmExport.defaultExpectation.params != nil {
+		mmExport.mock.t.Fatalf("TransferSvcMock.Export mock is already set by Expect")
+	}
+
+	if mmExport.defaultExpectation.paramPtrs == nil {
+		mmExport.defaultExpectation.paramPtrs = &TransferSvcMockExportParamPtrs{}
+	}
+	mmExport.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExport.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmExport
+}
+
+// ExpectUsernameParam2 sets up expected param username for TransferSvc.Export
+func (mmExport *mTransferSvcMockExport) ExpectUsernameParam2(username string) *mTransferSvcMockExport {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("TransferSvcMock.Export mock is already set by Set")
+	}
+
+	if mmExport.defaultExpectation == nil {
+		mmExport.defaultExpectation = &TransferSvcMockExportExpectation{}
+	}
+
+	if mmExport.defaultExpectation.params != nil {
+		mmExport.mock.t.Fatalf("TransferSvcMock.Export mock is already set by Expect")
+	}
+
+	if mmExport.defaultExpectation.paramPtrs == nil {
+		mmExport.defaultExpectation.paramPtrs = &TransferSvcMockExportParamPtrs{}
+	}
+	mmExport.defaultExpectation.paramPtrs.username = &username
+	mmExport.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmExport
+}
+
+// ExpectOptsParam3 sets up expected param opts for TransferSvc.Export
+func (mmExport *mTransferSvcMockExport) ExpectOptsParam3(opts transfer.ExportOptions) *mTransferSvcMockExport {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("TransferSvcMock.Export mock is already set by Set")
+	}
+
+	if mmExport.defaultExpectation == nil {
+		mmExport.defaultExpectation = &TransferSvcMockExportExpectation{}
+	}
+
+	if mmExport.defaultExpectation.params != nil {
+		mmExport.mock.t.Fatalf("TransferSvcMock.Export mock is already set by Expect")
+	}
+
+	if mmExport.defaultExpectation.paramPtrs == nil {
+		mmExport.defaultExpectation.paramPtrs = &TransferSvcMockExportParamPtrs{}
+	}
+	mmExport.defaultExpectation.paramPtrs.opts = &opts
+	mmExport.defaultExpectation.expectationOrigins.originOpts = minimock.CallerInfo(1)
+
+	return mmExport
+}
+
+// Inspect accepts an inspector function that has same arguments as the TransferSvc.Export
+func (mmExport *mTransferSvcMockExport) Inspect(f func(ctx context.Context, username string, opts transfer.ExportOptions)) *mTransferSvcMockExport {
+	if mmExport.mock.inspectFuncExport != nil {
+		mmExport.mock.t.Fatalf("Inspect function is already set for TransferSvcMock.Export")
+	}
+
+	mmExport.mock.inspectFuncExport = f
+
+	return mmExport
+}
+
+// Return sets up results that will be returned by TransferSvc.Export
+func (mmExport *mTransferSvcMockExport) Return(e1 transfer.Export, err error) *TransferSvcMock {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("TransferSvcMock.Export mock is already set by Set")
+	}
+
+	if mmExport.defaultExpectation == nil {
+		mmExport.defaultExpectation = &TransferSvcMockExportExpectation{mock: mmExport.mock}
+	}
+	mmExport.defaultExpectation.results = &TransferSvcMockExportResults{e1, err}
+	mmExport.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExport.mock
+}
+
+// Set uses given function f to mock the TransferSvc.Export method
+func (mmExport *mTransferSvcMockExport) Set(f func(ctx context.Context, username string, opts transfer.ExportOptions) (e1 transfer.Export, err error)) *TransferSvcMock {
+	if mmExport.defaultExpectation != nil {
+		mmExport.mock.t.Fatalf("Default expectation is already set for the TransferSvc.Export method")
+	}
+
+	if len(mmExport.expectations) > 0 {
+		mmExport.mock.t.Fatalf("Some expectations are already set for the TransferSvc.Export method")
+	}
+
+	mmExport.mock.funcExport = f
+	mmExport.mock.funcExportOrigin = minimock.CallerInfo(1)
+	return mmExport.mock
+}
+
+// When sets expectation for the TransferSvc.Export which will trigger the result defined by the following
+// Then helper
+func (mmExport *mTransferSvcMockExport) When(ctx context.Context, username string, opts transfer.ExportOptions) *TransferSvcMockExportExpectation {
+	if mmExport.mock.funcExport != nil {
+		mmExport.mock.t.Fatalf("TransferSvcMock.Export mock is already set by Set")
+	}
+
+	expectation := &TransferSvcMockExportExpectation{
+		mock:               mmExport.mock,
+		params:             &TransferSvcMockExportParams{ctx, username, opts},
+		expectationOrigins: TransferSvcMockExportExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmExport.expectations = append(mmExport.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TransferSvc.Export return parameters for the expectation previously defined by the When method
+func (e *TransferSvcMockExportExpectation) Then(e1 transfer.Export, err error) *TransferSvcMock {
+	e.results = &TransferSvcMockExportResults{e1, err}
+	return e.mock
+}
+
+// Times sets number of times TransferSvc.Export should be invoked
+func (mmExport *mTransferSvcMockExport) Times(n uint64) *mTransferSvcMockExport {
+	if n == 0 {
+		mmExport.mock.t.Fatalf("Times of TransferSvcMock.Export mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExport.expectedInvocations, n)
+	mmExport.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExport
+}
+
+func (mmExport *mTransferSvcMockExport) invocationsDone() bool {
+	if len(mmExport.expectations) == 0 && mmExport.defaultExpectation == nil && mmExport.mock.funcExport == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExport.mock.afterExportCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExport.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Export implements mm_handler.TransferSvc
+func (mmExport *TransferSvcMock) Export(ctx context.Context, username string, opts transfer.ExportOptions) (e1 transfer.Export, err error) {
+	mm_atomic.AddUint64(&mmExport.beforeExportCounter, 1)
+	defer mm_atomic.AddUint64(&mmExport.afterExportCounter, 1)
+
+	mmExport.t.Helper()
+
+	if mmExport.inspectFuncExport != nil {
+		mmExport.inspectFuncExport(ctx, username, opts)
+	}
+
+	mm_params := TransferSvcMockExportParams{ctx, username, opts}
+
+	// Record call args
+	mmExport.ExportMock.mutex.Lock()
+	mmExport.ExportMock.callArgs = append(mmExport.ExportMock.callArgs, &mm_params)
+	mmExport.ExportMock.mutex.Unlock()
+
+	for _, e := range mmExport.ExportMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.e1, e.results.err
+		}
+	}
+
+	if mmExport.ExportMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExport.ExportMock.defaultExpectation.Counter, 1)
+		mm_want := mmExport.ExportMock.defaultExpectation.params
+		mm_want_ptrs := mmExport.ExportMock.defaultExpectation.paramPtrs
+
+		mm_got := TransferSvcMockExportParams{ctx, username, opts}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExport.t.Errorf("TransferSvcMock.Export got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExport.ExportMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmExport.t.Errorf("TransferSvcMock.Export got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExport.ExportMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.opts != nil && !minimock.Equal(*mm_want_ptrs.opts, mm_got.opts) {
+				mmExport.t.Errorf("TransferSvcMock.Export got unexpected parameter opts, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExport.ExportMock.defaultExpectation.expectationOrigins.originOpts, *mm_want_ptrs.opts, mm_got.opts, minimock.Diff(*mm_want_ptrs.opts, mm_got.opts))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExport.t.Errorf("TransferSvcMock.Export got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExport.ExportMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExport.ExportMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExport.t.Fatal("No results are set for the TransferSvcMock.Export")
+		}
+		return (*mm_results).e1, (*mm_results).err
+	}
+	if mmExport.funcExport != nil {
+		return mmExport.funcExport(ctx, username, opts)
+	}
+	mmExport.t.Fatalf("Unexpected call to TransferSvcMock.Export. %v %v %v", ctx, username, opts)
+	return
+}
+
+// ExportAfterCounter returns a count of finished TransferSvcMock.Export invocations
+func (mmExport *TransferSvcMock) ExportAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExport.afterExportCounter)
+}
+
+// ExportBeforeCounter returns a count of TransferSvcMock.Export invocations
+func (mmExport *TransferSvcMock) ExportBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExport.beforeExportCounter)
+}
+
+// Calls returns a list of arguments used in each call to TransferSvcMock.Export.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExport *mTransferSvcMockExport) Calls() []*TransferSvcMockExportParams {
+	mmExport.mutex.RLock()
+
+	argCopy := make([]*TransferSvcMockExportParams, len(mmExport.callArgs))
+	copy(argCopy, mmExport.callArgs)
+
+	mmExport.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExportDone returns true if the count of the Export invocations corresponds
+// the number of defined expectations
+func (m *TransferSvcMock) MinimockExportDone() bool {
+	if m.ExportMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExportMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExportMock.invocationsDone()
+}
+
+// MinimockExportInspect logs each unmet expectation
+func (m *TransferSvcMock) MinimockExportInspect() {
+	for _, e := range m.ExportMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TransferSvcMock.Export at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterExportCounter := mm_atomic.LoadUint64(&m.afterExportCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExportMock.defaultExpectation != nil && afterExportCounter < 1 {
+		if m.ExportMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TransferSvcMock.Export at\n%s", m.ExportMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TransferSvcMock.Export at\n%s with params: %#v", m.ExportMock.defaultExpectation.expectationOrigins.origin, *m.ExportMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExport != nil && afterExportCounter < 1 {
+		m.t.Errorf("Expected call to TransferSvcMock.Export at\n%s", m.funcExportOrigin)
+	}
+
+	if !m.ExportMock.invocationsDone() && afterExportCounter > 0 {
+		m.t.Errorf("Expected %d calls to TransferSvcMock.Export at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExportMock.expectedInvocations), m.ExportMock.expectedInvocationsOrigin, afterExportCounter)
+	}
+}
+
+type mTransferSvcMockImport struct {
+	optional           bool
+	mock               *TransferSvcMock
+	defaultExpectation *TransferSvcMockImportExpectation
+	expectations       []*TransferSvcMockImportExpectation
+
+	callArgs []*TransferSvcMockImportParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// TransferSvcMockImportExpectation specifies expectation struct of the TransferSvc.Import
+type TransferSvcMockImportExpectation struct {
+	mock               *TransferSvcMock
+	params             *TransferSvcMockImportParams
+	paramPtrs          *TransferSvcMockImportParamPtrs
+	expectationOrigins TransferSvcMockImportExpectationOrigins
+	results            *TransferSvcMockImportResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// TransferSvcMockImportParams contains parameters of the TransferSvc.Import
+type TransferSvcMockImportParams struct {
+	ctx      context.Context
+	username string
+	format   string
+	r        io.Reader
+	password string
+	dryRun   bool
+}
+
+// TransferSvcMockImportParamPtrs contains pointers to parameters of the TransferSvc.Import
+type TransferSvcMockImportParamPtrs struct {
+	ctx      *context.Context
+	username *string
+	format   *string
+	r        *io.Reader
+	password *string
+	dryRun   *bool
+}
+
+// TransferSvcMockImportResults contains results of the TransferSvc.Import
+type TransferSvcMockImportResults struct {
+	r1  transfer.Report
+	err error
+}
+
+// TransferSvcMockImportOrigins contains origins of expectations of the TransferSvc.Import
+type TransferSvcMockImportExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originUsername string
+	originFormat   string
+	originR        string
+	originPassword string
+	originDryRun   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmImport *mTransferSvcMockImport) Optional() *mTransferSvcMockImport {
+	mmImport.optional = true
+	return mmImport
+}
+
+// Expect sets up expected params for TransferSvc.Import
+func (mmImport *mTransferSvcMockImport) Expect(ctx context.Context, username string, format string, r io.Reader, password string, dryRun bool) *mTransferSvcMockImport {
+	if mmImport.mock.funcImport != nil {
+		mmImport.mock.t.Fatalf("TransferSvcMock.Import mock is already set by Set")
+	}
+
+	if mmImport.defaultExpectation == nil {
+		mmImport.defaultExpectation = &TransferSvcMockImportExpectation{}
+	}
+
+	if mmImport.defaultExpectation.paramPtrs != nil {
+		mmImport.mock.t.Fatalf("TransferSvcMock.Import mock is already set by ExpectParams functions")
+	}
+
+	mmImport.defaultExpectation.params = &TransferSvcMockImportParams{ctx, username, format, r, password, dryRun}
+	mmImport.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmImport.expectations {
+		if minimock.Equal(e.params, mmImport.defaultExpectation.params) {
+			mmImport.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmImport.defaultExpectation.params)
+		}
+	}
+
+	return mmImport
+}
+
+// ExpectCtxParam1 sets up expected param ctx for TransferSvc.Import
+func (mmImport *mTransferSvcMockImport) ExpectCtxParam1(ctx context.Context) *mTransferSvcMockImport {
+	if mmImport.mock.funcImport != nil {
+		mmImport.mock.t.Fatalf("TransferSvcMock.Import mock is already set by Set")
+	}
+
+	if mmImport.defaultExpectation == nil {
+		mmImport.defaultExpectation = &TransferSvcMockImportExpectation{}
+	}
+
+	if mmImport.defaultExpectation.params != nil {
+		mmImport.mock.t.Fatalf("TransferSvcMock.Import mock is already set by Expect")
+	}
+
+	if mmImport.defaultExpectation.paramPtrs == nil {
+		mmImport.defaultExpectation.paramPtrs = &TransferSvcMockImportParamPtrs{}
+	}
+	mmImport.defaultExpectation.paramPtrs.ctx = &ctx
+	mmImport.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmImport
+}
+
+// ExpectUsernameParam2 sets up expected param username for TransferSvc.Import
+func (mmImport *mTransferSvcMockImport) ExpectUsernameParam2(username string) *mTransferSvcMockImport {
+	if mmImport.mock.funcImport != nil {
+		mmImport.mock.t.Fatalf("TransferSvcMock.Import mock is already set by Set")
+	}
+
+	if mmImport.defaultExpectation == nil {
+		mmImport.defaultExpectation = &TransferSvcMockImportExpectation{}
+	}
+
+	if mmImport.defaultExpectation.params != nil {
+		mmImport.mock.t.Fatalf("TransferSvcMock.Import mock is already set by Expect")
+	}
+
+	if mmImport.defaultExpectation.paramPtrs == nil {
+		mmImport.defaultExpectation.paramPtrs = &TransferSvcMockImportParamPtrs{}
+	}
+	mmImport.defaultExpectation.paramPtrs.username = &username
+	mmImport.defaultExpectation.expectationOrigins.originUsername = minimock.CallerInfo(1)
+
+	return mmImport
+}
+
+// ExpectFormatParam3 sets up expected param format for TransferSvc.Import
+func (mmImport *mTransferSvcMockImport) ExpectFormatParam3(format string) *mTransferSvcMockImport {
+	if mmImport.mock.funcImport != nil {
+		mmImport.mock.t.Fatalf("TransferSvcMock.Import mock is already set by Set")
+	}
+
+	if mmImport.defaultExpectation == nil {
+		mmImport.defaultExpectation = &TransferSvcMockImportExpectation{}
+	}
+
+	if mmImport.defaultExpectation.params != nil {
+		mmImport.mock.t.Fatalf("TransferSvcMock.Import mock is already set by Expect")
+	}
+
+	if mmImport.defaultExpectation.paramPtrs == nil {
+		mmImport.defaultExpectation.paramPtrs = &TransferSvcMockImportParamPtrs{}
+	}
+	mmImport.defaultExpectation.paramPtrs.format = &format
+	mmImport.defaultExpectation.expectationOrigins.originFormat = minimock.CallerInfo(1)
+
+	return mmImport
+}
+
+// ExpectRParam4 sets up expected param r for TransferSvc.Import
+func (mmImport *mTransferSvcMockImport) ExpectRParam4(r io.Reader) *mTransferSvcMockImport {
+	if mmImport.mock.funcImport != nil {
+		mmImport.mock.t.Fatalf("TransferSvcMock.Import mock is already set by Set")
+	}
+
+	if mmImport.defaultExpectation == nil {
+		mmImport.defaultExpectation = &TransferSvcMockImportExpectation{}
+	}
+
+	if mmImport.defaultExpectation.params != nil {
+		mmImport.mock.t.Fatalf("TransferSvcMock.Import mock is already set by Expect")
+	}
+
+	if mmImport.defaultExpectation.paramPtrs == nil {
+		mmImport.defaultExpectation.paramPtrs = &TransferSvcMockImportParamPtrs{}
+	}
+	mmImport.defaultExpectation.paramPtrs.r = &r
+	mmImport.defaultExpectation.expectationOrigins.originR = minimock.CallerInfo(1)
+
+	return mmImport
+}
+
+// ExpectPasswordParam5 sets up expected param password for TransferSvc.Import
+func (mmImport *mTransferSvcMockImport) ExpectPasswordParam5(password string) *mTransferSvcMockImport {
+	if mmImport.mock.funcImport != nil {
+		mmImport.mock.t.Fatalf("TransferSvcMock.Import mock is already set by Set")
+	}
+
+	if mmImport.defaultExpectation == nil {
+		mmImport.defaultExpectation = &TransferSvcMockImportExpectation{}
+	}
+
+	if mmImport.defaultExpectation.params != nil {
+		mmImport.mock.t.Fatalf("TransferSvcMock.Import mock is already set by Expect")
+	}
+
+	if mmImport.defaultExpectation.paramPtrs == nil {
+		mmImport.defaultExpectation.paramPtrs = &TransferSvcMockImportParamPtrs{}
+	}
+	mmImport.defaultExpectation.paramPtrs.password = &password
+	mmImport.defaultExpectation.expectationOrigins.originPassword = minimock.CallerInfo(1)
+
+	return mmImport
+}
+
+// ExpectDryRunParam6 sets up expected param dryRun for TransferSvc.Import
+func (mmImport *mTransferSvcMockImport) ExpectDryRunParam6(dryRun bool) *mTransferSvcMockImport {
+	if mmImport.mock.funcImport != nil {
+		mmImport.mock.t.Fatalf("TransferSvcMock.Import mock is already set by Set")
+	}
+
+	if mmImport.defaultExpectation == nil {
+		mmImport.defaultExpectation = &TransferSvcMockImportExpectation{}
+	}
+
+	if mmImport.defaultExpectation.params != nil {
+		mmImport.mock.t.Fatalf("TransferSvcMock.Import mock is already set by Expect")
+	}
+
+	if mmImport.defaultExpectation.paramPtrs == nil {
+		mmImport.defaultExpectation.paramPtrs = &TransferSvcMockImportParamPtrs{}
+	}
+	mmImport.defaultExpectation.paramPtrs.dryRun = &dryRun
+	mmImport.defaultExpectation.expectationOrigins.originDryRun = minimock.CallerInfo(1)
+
+	return mmImport
+}
+
+// Inspect accepts an inspector function that has same arguments as the TransferSvc.Import
+func (mmImport *mTransferSvcMockImport) Inspect(f func(ctx context.Context, username string, format string, r io.Reader, password string, dryRun bool)) *mTransferSvcMockImport {
+	if mmImport.mock.inspectFuncImport != nil {
+		mmImport.mock.t.Fatalf("Inspect function is already set for TransferSvcMock.Import")
+	}
+
+	mmImport.mock.inspectFuncImport = f
+
+	return mmImport
+}
+
+// Return sets up results that will be returned by TransferSvc.Import
+func (mmImport *mTransferSvcMockImport) Return(r1 transfer.Report, err error) *TransferSvcMock {
+	if mmImport.mock.funcImport != nil {
+		mmImport.mock.t.Fatalf("TransferSvcMock.Import mock is already set by Set")
+	}
+
+	if mmImport.defaultExpectation == nil {
+		mmImport.defaultExpectation = &TransferSvcMockImportExpectation{mock: mmImport.mock}
+	}
+	mmImport.defaultExpectation.results = &TransferSvcMockImportResults{r1, err}
+	mmImport.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmImport.mock
+}
+
+// Set uses given function f to mock the TransferSvc.Import method
+func (mmImport *mTransferSvcMockImport) Set(f func(ctx context.Context, username string, format string, r io.Reader, password string, dryRun bool) (r1 transfer.Report, err error)) *TransferSvcMock {
+	if mmImport.defaultExpectation != nil {
+		mmImport.mock.t.Fatalf("Default expectation is already set for the TransferSvc.Import method")
+	}
+
+	if len(mmImport.expectations) > 0 {
+		mmImport.mock.t.Fatalf("Some expectations are already set for the TransferSvc.Import method")
+	}
+
+	mmImport.mock.funcImport = f
+	mmImport.mock.funcImportOrigin = minimock.CallerInfo(1)
+	return mmImport.mock
+}
+
+// When sets expectation for the TransferSvc.Import which will trigger the result defined by the following
+// Then helper
+func (mmImport *mTransferSvcMockImport) When(ctx context.Context, username string, format string, r io.Reader, password string, dryRun bool) *TransferSvcMockImportExpectation {
+	if mmImport.mock.funcImport != nil {
+		mmImport.mock.t.Fatalf("TransferSvcMock.Import mock is already set by Set")
+	}
+
+	expectation := &TransferSvcMockImportExpectation{
+		mock:               mmImport.mock,
+		params:             &TransferSvcMockImportParams{ctx, username, format, r, password, dryRun},
+		expectationOrigins: TransferSvcMockImportExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmImport.expectations = append(mmImport.expectations, expectation)
+	return expectation
+}
+
+// Then sets up TransferSvc.Import return parameters for the expectation previously defined by the When method
+func (e *TransferSvcMockImportExpectation) Then(r1 transfer.Report, err error) *TransferSvcMock {
+	e.results = &TransferSvcMockImportResults{r1, err}
+	return e.mock
+}
+
+// Times sets number of times TransferSvc.Import should be invoked
+func (mmImport *mTransferSvcMockImport) Times(n uint64) *mTransferSvcMockImport {
+	if n == 0 {
+		mmImport.mock.t.Fatalf("Times of TransferSvcMock.Import mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmImport.expectedInvocations, n)
+	mmImport.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmImport
+}
+
+func (mmImport *mTransferSvcMockImport) invocationsDone() bool {
+	if len(mmImport.expectations) == 0 && mmImport.defaultExpectation == nil && mmImport.mock.funcImport == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmImport.mock.afterImportCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmImport.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Import implements mm_handler.TransferSvc
+func (mmImport *TransferSvcMock) Import(ctx context.Context, username string, format string, r io.Reader, password string, dryRun bool) (r1 transfer.Report, err error) {
+	mm_atomic.AddUint64(&mmImport.beforeImportCounter, 1)
+	defer mm_atomic.AddUint64(&mmImport.afterImportCounter, 1)
+
+	mmImport.t.Helper()
+
+	if mmImport.inspectFuncImport != nil {
+		mmImport.inspectFuncImport(ctx, username, format, r, password, dryRun)
+	}
+
+	mm_params := TransferSvcMockImportParams{ctx, username, format, r, password, dryRun}
+
+	// Record call args
+	mmImport.ImportMock.mutex.Lock()
+	mmImport.ImportMock.callArgs = append(mmImport.ImportMock.callArgs, &mm_params)
+	mmImport.ImportMock.mutex.Unlock()
+
+	for _, e := range mmImport.ImportMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.r1, e.results.err
+		}
+	}
+
+	if mmImport.ImportMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmImport.ImportMock.defaultExpectation.Counter, 1)
+		mm_want := mmImport.ImportMock.defaultExpectation.params
+		mm_want_ptrs := mmImport.ImportMock.defaultExpectation.paramPtrs
+
+		mm_got := TransferSvcMockImportParams{ctx, username, format, r, password, dryRun}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmImport.t.Errorf("TransferSvcMock.Import got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmImport.ImportMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.username != nil && !minimock.Equal(*mm_want_ptrs.username, mm_got.username) {
+				mmImport.t.Errorf("TransferSvcMock.Import got unexpected parameter username, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmImport.ImportMock.defaultExpectation.expectationOrigins.originUsername, *mm_want_ptrs.username, mm_got.username, minimock.Diff(*mm_want_ptrs.username, mm_got.username))
+			}
+
+			if mm_want_ptrs.format != nil && !minimock.Equal(*mm_want_ptrs.format, mm_got.format) {
+				mmImport.t.Errorf("TransferSvcMock.Import got unexpected parameter format, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmImport.ImportMock.defaultExpectation.expectationOrigins.originFormat, *mm_want_ptrs.format, mm_got.format, minimock.Diff(*mm_want_ptrs.format, mm_got.format))
+			}
+
+			if mm_want_ptrs.r != nil && !minimock.Equal(*mm_want_ptrs.r, mm_got.r) {
+				mmImport.t.Errorf("TransferSvcMock.Import got unexpected parameter r, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmImport.ImportMock.defaultExpectation.expectationOrigins.originR, *mm_want_ptrs.r, mm_got.r, minimock.Diff(*mm_want_ptrs.r, mm_got.r))
+			}
+
+			if mm_want_ptrs.password != nil && !minimock.Equal(*mm_want_ptrs.password, mm_got.password) {
+				mmImport.t.Errorf("TransferSvcMock.Import got unexpected parameter password, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmImport.ImportMock.defaultExpectation.expectationOrigins.originPassword, *mm_want_ptrs.password, mm_got.password, minimock.Diff(*mm_want_ptrs.password, mm_got.password))
+			}
+
+			if mm_want_ptrs.dryRun != nil && !minimock.Equal(*mm_want_ptrs.dryRun, mm_got.dryRun) {
+				mmImport.t.Errorf("TransferSvcMock.Import got unexpected parameter dryRun, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmImport.ImportMock.defaultExpectation.expectationOrigins.originDryRun, *mm_want_ptrs.dryRun, mm_got.dryRun, minimock.Diff(*mm_want_ptrs.dryRun, mm_got.dryRun))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmImport.t.Errorf("TransferSvcMock.Import got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmImport.ImportMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmImport.ImportMock.defaultExpectation.results
+		if mm_results == nil {
+			mmImport.t.Fatal("No results are set for the TransferSvcMock.Import")
+		}
+		return (*mm_results).r1, (*mm_results).err
+	}
+	if mmImport.funcImport != nil {
+		return mmImport.funcImport(ctx, username, format, r, password, dryRun)
+	}
+	mmImport.t.Fatalf("Unexpected call to TransferSvcMock.Import. %v %v %v %v %v %v", ctx, username, format, r, password, dryRun)
+	return
+}
+
+// ImportAfterCounter returns a count of finished TransferSvcMock.Import invocations
+func (mmImport *TransferSvcMock) ImportAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmImport.afterImportCounter)
+}
+
+// ImportBeforeCounter returns a count of TransferSvcMock.Import invocations
+func (mmImport *TransferSvcMock) ImportBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmImport.beforeImportCounter)
+}
+
+// Calls returns a list of arguments used in each call to TransferSvcMock.Import.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmImport *mTransferSvcMockImport) Calls() []*TransferSvcMockImportParams {
+	mmImport.mutex.RLock()
+
+	argCopy := make([]*TransferSvcMockImportParams, len(mmImport.callArgs))
+	copy(argCopy, mmImport.callArgs)
+
+	mmImport.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockImportDone returns true if the count of the Import invocations corresponds
+// the number of defined expectations
+func (m *TransferSvcMock) MinimockImportDone() bool {
+	if m.ImportMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ImportMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ImportMock.invocationsDone()
+}
+
+// MinimockImportInspect logs each unmet expectation
+func (m *TransferSvcMock) MinimockImportInspect() {
+	for _, e := range m.ImportMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to TransferSvcMock.Import at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterImportCounter := mm_atomic.LoadUint64(&m.afterImportCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ImportMock.defaultExpectation != nil && afterImportCounter < 1 {
+		if m.ImportMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to TransferSvcMock.Import at\n%s", m.ImportMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to TransferSvcMock.Import at\n%s with params: %#v", m.ImportMock.defaultExpectation.expectationOrigins.origin, *m.ImportMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcImport != nil && afterImportCounter < 1 {
+		m.t.Errorf("Expected call to TransferSvcMock.Import at\n%s", m.funcImportOrigin)
+	}
+
+	if !m.ImportMock.invocationsDone() && afterImportCounter > 0 {
+		m.t.Errorf("Expected %d calls to TransferSvcMock.Import at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ImportMock.expectedInvocations), m.ImportMock.expectedInvocationsOrigin, afterImportCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *TransferSvcMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockExportInspect()
+
+			m.MinimockImportInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *TransferSvcMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *TransferSvcMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockExportDone() &&
+		m.MinimockImportDone()
+}
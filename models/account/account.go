@@ -0,0 +1,75 @@
+// Package account defines the data structures describing a user account as a whole,
+// such as its sign-in sessions and the export of all personal data held about the user.
+package account
+
+import (
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/models/apikey"
+	"github.com/gleb-korostelev/GophKeeper/models/attachment"
+	"github.com/gleb-korostelev/GophKeeper/models/audit"
+	"github.com/gleb-korostelev/GophKeeper/models/device"
+	"github.com/gleb-korostelev/GophKeeper/models/emergency"
+	"github.com/gleb-korostelev/GophKeeper/models/org"
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/gleb-korostelev/GophKeeper/models/send"
+)
+
+// DeletedAliasPrefix prefixes the random alias that replaces the username in the audit log of a deleted account.
+const DeletedAliasPrefix = "deleted-"
+
+// Session represents a sign-in session of a user.
+//
+// Fields:
+// - ID: The session identifier, carried by the session's tokens.
+// - CreatedAt: When the user signed in.
+// - ExpiresAt: When the session expires.
+// - RevokedAt: When the session was revoked, if it was.
+type Session struct {
+	ID        string     `json:"id"`
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt time.Time  `json:"expires_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
+
+// PersonalData is the export of all personal data and events held about a user.
+//
+// Fields:
+// - Username: The username.
+// - AccountType: The account type.
+// - CreatedAt: When the account was created.
+// - UpdatedAt: When the account was last updated.
+// - PublicKey: The user's public key, if published.
+// - ExportedAt: When the export was made.
+// - Folders: The user's folders.
+// - Tags: The user's tags.
+// - Items: The user's own items.
+// - Shares: The shares the user granted and those granted to the user.
+// - Orgs: The user's organization memberships.
+// - EmergencyContacts: The emergency contacts the user designated and those that designated the user.
+// - Sends: The user's one-time share links.
+// - Attachments: The metadata of the user's attachments.
+// - Sessions: The user's sign-in sessions.
+// - Devices: The devices the user signed in from.
+// - APIKeys: The user's personal API keys, without their secrets.
+// - Events: The audit log entries about the user.
+type PersonalData struct {
+	Username          string                  `json:"username"`
+	AccountType       string                  `json:"account_type"`
+	CreatedAt         time.Time               `json:"created_at"`
+	UpdatedAt         time.Time               `json:"updated_at"`
+	PublicKey         []byte                  `json:"public_key,omitempty"`
+	ExportedAt        time.Time               `json:"exported_at"`
+	Folders           []profile.Folder        `json:"folders"`
+	Tags              []profile.Tag           `json:"tags"`
+	Items             []profile.CardInfo      `json:"items"`
+	Shares            []profile.CardShare     `json:"shares"`
+	Orgs              []org.Member            `json:"orgs"`
+	EmergencyContacts []emergency.Contact     `json:"emergency_contacts"`
+	Sends             []send.Send             `json:"sends"`
+	Attachments       []attachment.Attachment `json:"attachments"`
+	Sessions          []Session               `json:"sessions"`
+	Devices           []device.Device         `json:"devices"`
+	APIKeys           []apikey.Key            `json:"api_keys"`
+	Events            []audit.Event           `json:"events"`
+}
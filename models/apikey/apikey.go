@@ -0,0 +1,144 @@
+// Package apikey defines the data structures of personal API keys, which let automation such as
+// CI pipelines access a user's vault without the interactive challenge and sign-in round-trip.
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
+)
+
+// Limits of API keys.
+const (
+	MaxNameLength = 100                  // The longest key name.
+	MaxScopeItems = 100                  // The largest number of items or folders a scope names.
+	MaxTTL        = 365 * 24 * time.Hour // The longest lifetime of a key.
+)
+
+// Prefix starts every key, so that leaked keys are easy to recognize.
+const Prefix = "gk_"
+
+const (
+	idLength     = 16 // The length of the key identifier, in bytes.
+	secretLength = 32 // The length of the key secret, in bytes.
+)
+
+// ErrMalformed is returned when parsing a string that is not an API key.
+var ErrMalformed = errors.New("malformed api key")
+
+// Scope restricts what an API key grants access to. A scope naming neither items nor folders
+// covers all of the user's own items; otherwise it covers the named items and the items in
+// the named folders or any of their subfolders. Items shared with the user are never covered.
+//
+// Fields:
+// - Items: The card numbers of the covered items.
+// - Folders: The identifiers of the covered folders.
+// - ReadOnly: Whether the key only reads items.
+type Scope struct {
+	Items    []string `json:"items,omitempty"`
+	Folders  []int64  `json:"folders,omitempty"`
+	ReadOnly bool     `json:"read_only"`
+}
+
+// Restricted reports whether the scope names items or folders, rather than covering all items.
+func (s Scope) Restricted() bool {
+	return len(s.Items) > 0 || len(s.Folders) > 0
+}
+
+// HasItem reports whether the scope names the item with the given card number.
+func (s Scope) HasItem(cardNumber string) bool {
+	return slices.Contains(s.Items, cardNumber)
+}
+
+// Key represents a personal API key. Only a hash of the key's secret is stored;
+// the key itself is shown once, when it is created.
+//
+// Fields:
+// - ID: The key identifier, which is also part of the key.
+// - Username: The user the key acts as.
+// - Name: The name the user gave the key.
+// - Hash: The SHA-256 hash of the key's secret.
+// - Scope: What the key grants access to.
+// - ExpiresAt: When the key stops working.
+// - CreatedAt: When the key was created.
+// - LastUsedAt: When the key was last used, if ever.
+type Key struct {
+	ID         string     `json:"id"`
+	Username   string     `json:"username"`
+	Name       string     `json:"name"`
+	Hash       []byte     `json:"-"`
+	Scope      Scope      `json:"scope"`
+	ExpiresAt  time.Time  `json:"expires_at"`
+	CreatedAt  time.Time  `json:"created_at"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+}
+
+// SortCreated orders keys by creation time; it is the only sort of key listings.
+const SortCreated = "created"
+
+// DefaultSort orders keys newest first.
+const DefaultSort = "-" + SortCreated
+
+// Cursor returns the position of the key in listings, ordered by creation time and then by ID.
+func (k Key) Cursor() pagination.Cursor {
+	return pagination.Cursor{Key: pagination.TimeKey(k.CreatedAt), UID: k.ID}
+}
+
+// Hint returns the start of the key, enough for users to tell their keys apart.
+func (k Key) Hint() string {
+	return Prefix + strings.ReplaceAll(k.ID, "-", "")[:8]
+}
+
+// Expired reports whether the key has expired at the given time.
+func (k Key) Expired(now time.Time) bool {
+	return !now.Before(k.ExpiresAt)
+}
+
+// Verify reports whether a secret parsed from a key matches the key's Hash.
+func (k Key) Verify(secret []byte) bool {
+	sum := sha256.Sum256(secret)
+	return subtle.ConstantTimeCompare(sum[:], k.Hash) == 1
+}
+
+// Generate creates the identifier and secret of a new key. It returns the key as handed to the user,
+// and sets the key's ID and Hash.
+func (k *Key) Generate() (string, error) {
+	raw := make([]byte, idLength+secretLength)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+
+	id, secret := raw[:idLength], raw[idLength:]
+	sum := sha256.Sum256(secret)
+
+	k.ID = formatID(id)
+	k.Hash = sum[:]
+	return Prefix + hex.EncodeToString(raw), nil
+}
+
+// Parse splits a key into its identifier and secret.
+func Parse(key string) (id string, secret []byte, err error) {
+	text, ok := strings.CutPrefix(key, Prefix)
+	if !ok || len(text) != 2*(idLength+secretLength) {
+		return "", nil, ErrMalformed
+	}
+
+	raw, err := hex.DecodeString(text)
+	if err != nil {
+		return "", nil, ErrMalformed
+	}
+	return formatID(raw[:idLength]), raw[idLength:], nil
+}
+
+// formatID formats the identifier bytes of a key as a UUID.
+func formatID(id []byte) string {
+	h := hex.EncodeToString(id)
+	return h[0:8] + "-" + h[8:12] + "-" + h[12:16] + "-" + h[16:20] + "-" + h[20:32]
+}
@@ -0,0 +1,55 @@
+// Package attachment defines the data structures for files attached to vault items,
+// such as scanned documents, key files and certificates.
+package attachment
+
+import (
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
+)
+
+// Limits applied to attachments.
+const (
+	MaxChunkSize = 8 << 20        // Maximum size of a single upload chunk in bytes.
+	UploadTTL    = 24 * time.Hour // Time after which an unfinished upload is discarded.
+)
+
+// Attachment represents a file attached to a card.
+//
+// The content is uploaded in chunks and stored in a blob store under the attachment ID.
+// Received tracks how many bytes have arrived so far, so an interrupted upload
+// resumes from that offset. The upload is complete once Received reaches Size
+// and the content matches SHA256.
+type Attachment struct {
+	ID          string    `json:"id" example:"8d3c1c2e-4f3a-4b8a-9a0c-2f5d2c1e7b6a"`
+	Username    string    `json:"username" validate:"required,min=3,max=50" example:"john_doe"`
+	CardNumber  string    `json:"card_number" validate:"required" example:"1234567812345678"`
+	Name        string    `json:"name" validate:"required,max=255" example:"passport.pdf.enc"`
+	ContentType string    `json:"content_type" example:"application/octet-stream"`
+	Size        int64     `json:"size" validate:"required,min=1" example:"1048576"`
+	Received    int64     `json:"received" example:"0"`
+	SHA256      string    `json:"sha256" validate:"required,len=64"`
+	Complete    bool      `json:"complete"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// SortCreated orders attachments by upload registration time; it is the only sort of attachment listings.
+const SortCreated = "created"
+
+// Cursor returns the position of the attachment in listings, ordered by creation time and then by ID.
+func (a Attachment) Cursor() pagination.Cursor {
+	return pagination.Cursor{Key: pagination.TimeKey(a.CreatedAt), UID: a.ID}
+}
+
+// Range describes the part of an attachment carried by one upload chunk,
+// as announced by a "Content-Range: bytes Start-End/Total" header.
+type Range struct {
+	Start int64
+	End   int64
+	Total int64
+}
+
+// Length returns the number of bytes in the range.
+func (r Range) Length() int64 {
+	return r.End - r.Start + 1
+}
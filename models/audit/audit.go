@@ -0,0 +1,34 @@
+// Package audit defines the data structures of the audit log, which records security-relevant
+// actions taken on user accounts.
+package audit
+
+import "time"
+
+// Actions recorded in the audit log.
+const (
+	ActionExport         = "vault.export"            // A user exported their vault.
+	ActionPasswordChange = "account.password_change" // A user changed their master password.
+	ActionAccountDelete  = "account.delete"          // A user deleted their account.
+	ActionRecoverySetup  = "account.recovery_setup"  // A user created a recovery kit.
+	ActionRecoveryRemove = "account.recovery_remove" // A user removed their recovery kit.
+	ActionRecover        = "account.recover"         // A user recovered their account with a recovery kit.
+	ActionNewDevice      = "auth.new_device"         // A user signed in from a device not seen before.
+	ActionDeviceRemove   = "device.remove"           // A user removed one of their devices.
+	ActionAPIKeyCreate   = "api_key.create"          // A user created an API key.
+	ActionAPIKeyRevoke   = "api_key.revoke"          // A user revoked an API key.
+	ActionQuotaOverride  = "account.quota_override"  // An admin overrode the limits of a user.
+)
+
+// Event represents a single audit log entry.
+//
+// Fields:
+// - Username: The user who took the action.
+// - Action: What was done, one of the Action constants.
+// - Details: Additional facts about the action, such as the export format.
+// - CreatedAt: When the action was taken; set when events are loaded.
+type Event struct {
+	Username  string            `json:"username"`
+	Action    string            `json:"action"`
+	Details   map[string]string `json:"details,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/gleb-korostelev/GophKeeper/middleware"
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -35,6 +36,16 @@ var rolesHumanReadable = map[AccountType]string{
 	AccountRoleSuperAdmin:   middleware.RoleSuperAdmin,
 }
 
+// String returns the human-readable role name of the account type.
+func (t AccountType) String() string {
+	return rolesHumanReadable[t]
+}
+
+// IsAdmin reports whether the account type is an admin or a superadmin.
+func (t AccountType) IsAdmin() bool {
+	return t == AccountRoleAdmin || t == AccountRoleSuperAdmin
+}
+
 // Account represents a user account in the system.
 //
 // Fields:
@@ -45,6 +56,7 @@ var rolesHumanReadable = map[AccountType]string{
 // - CreatedAt: The timestamp when the account was created.
 // - RoleChangedAt: The timestamp when the account's role was last changed.
 // - UpdatedAt: The timestamp when the account was last updated.
+// - PublicKey: The public key other users wrap shared item keys to, if published.
 type Account struct {
 	ID            int
 	Username      string
@@ -53,6 +65,32 @@ type Account struct {
 	CreatedAt     time.Time
 	RoleChangedAt time.Time
 	UpdatedAt     time.Time
+	PublicKey     []byte
+}
+
+// Lockout limits the wrong passwords given for an account: after Attempts consecutive ones from a client,
+// sign-ins to the account from that client are refused for Duration, so that passwords cannot be
+// guessed without limit while the owner can still sign in from elsewhere. Zero Attempts disables it.
+type Lockout struct {
+	Attempts int
+	Duration time.Duration
+}
+
+// Enabled reports whether wrong passwords lock sign-ins out.
+func (l Lockout) Enabled() bool {
+	return l.Attempts > 0
+}
+
+// SignInFailures is the count of wrong passwords given for an account from a client since its last
+// sign-in or lockout from there, and the end of its lockout, if it was locked.
+type SignInFailures struct {
+	Failures    int
+	LockedUntil *time.Time
+}
+
+// Locked reports whether sign-ins from the client are refused at now.
+func (f SignInFailures) Locked(now time.Time) bool {
+	return f.LockedUntil != nil && now.Before(*f.LockedUntil)
 }
 
 // Profile represents the basic profile information for user authentication.
@@ -65,6 +103,20 @@ type Profile struct {
 	Password string
 }
 
+// PasswordChange describes a change of a user's master password.
+//
+// Fields:
+// - OldPassword: The current password, verified before anything is changed.
+// - NewPassword: The new password.
+// - PublicKey: The user's new public key, if the client re-keyed the vault along with the password.
+// - ItemKeys: Every item key the user holds, re-wrapped to the new public key.
+type PasswordChange struct {
+	OldPassword string
+	NewPassword string
+	PublicKey   []byte
+	ItemKeys    []profile.ItemKey
+}
+
 // GenerateSecret hashes a plaintext password and assigns it to the account's Secret field.
 func (a *Account) GenerateSecret(password string) error {
 	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
@@ -75,3 +127,8 @@ func (a *Account) GenerateSecret(password string) error {
 	a.Secret = hashedPassword
 	return nil
 }
+
+// VerifySecret reports whether a plaintext password matches the account's Secret.
+func (a *Account) VerifySecret(password string) bool {
+	return bcrypt.CompareHashAndPassword(a.Secret, []byte(password)) == nil
+}
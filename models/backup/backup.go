@@ -0,0 +1,77 @@
+// Package backup defines the data structures of the backups of the vault database,
+// such as the tables and blobs a backup holds and the summary of a backup or restore.
+package backup
+
+import "time"
+
+// Table is a table of the vault backed up as a whole, and the columns written for each row,
+// which leave out generated columns.
+//
+// Fields:
+// - Name: The name of the table in the vault schema.
+// - Columns: The columns of the table, in order.
+type Table struct {
+	Name    string   `json:"table"`
+	Columns []string `json:"columns"`
+}
+
+// Blob is a blob kept as a large object by the Postgres blob store.
+//
+// Fields:
+// - Key: The key the blob is stored under.
+// - OID: The large object holding the blob; it is not kept in the backup, since restores create new ones.
+type Blob struct {
+	Key string `json:"key"`
+	OID uint32 `json:"-"`
+}
+
+// Sequence is the state of a sequence of the vault, such as those generating identity columns.
+//
+// Fields:
+// - Name: The name of the sequence in the vault schema.
+// - Value: The value the sequence returned last, nil if it was never used.
+type Sequence struct {
+	Name  string `json:"name"`
+	Value *int64 `json:"value"`
+}
+
+// ForeignKey is a foreign key constraint of a table of the vault.
+type ForeignKey struct {
+	Table string
+	Name  string
+}
+
+// End closes the rows of a table or the content of a blob in a backup, so that restores can check
+// that they got all of it.
+//
+// Fields:
+// - Rows: The number of rows of a table.
+// - Size: The size of a blob in bytes.
+type End struct {
+	Rows int64 `json:"rows,omitempty"`
+	Size int64 `json:"size,omitempty"`
+}
+
+// TableSummary is the number of rows of a table in a backup.
+type TableSummary struct {
+	Name string
+	Rows int64
+}
+
+// Summary describes a backup that was written, verified or restored.
+//
+// Fields:
+// - CreatedAt: When the snapshot of the backup was taken.
+// - SchemaVersion: The version of the database schema the backup was taken from.
+// - KeyID: The ID of the operator key the backup is encrypted to.
+// - Tables: The tables of the backup and their number of rows.
+// - Blobs: The number of blobs of the backup.
+// - BlobBytes: The total size of the blobs in bytes.
+type Summary struct {
+	CreatedAt     time.Time
+	SchemaVersion int64
+	KeyID         string
+	Tables        []TableSummary
+	Blobs         int64
+	BlobBytes     int64
+}
@@ -0,0 +1,107 @@
+// Package device defines the data structures of user devices. A device holds an Ed25519 key pair;
+// it is registered at sign-in and binds the session's tokens to requests signed with its key.
+package device
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
+)
+
+// Limits of device descriptions.
+const (
+	MaxNameLength     = 100 // The longest device name.
+	MaxPlatformLength = 50  // The longest platform name.
+)
+
+// SignatureMaxSkew is how far the timestamp of a signed request may be off the server clock.
+const SignatureMaxSkew = 5 * time.Minute
+
+// MaxSignedBodySize is the largest request body a device can sign, that of the largest import.
+const MaxSignedBodySize = 16 << 20
+
+// Device represents a device a user signed in from.
+//
+// Fields:
+// - ID: The device identifier.
+// - Username: The user the device belongs to.
+// - Name: The name the user gave the device.
+// - Platform: The platform of the device, such as "linux" or "ios".
+// - PublicKey: The Ed25519 public key the device signs with.
+// - CreatedAt: When the device was registered.
+// - LastSeenAt: When the user last signed in from the device.
+// - Current: Whether the device is the one of the requesting session.
+type Device struct {
+	ID         string    `json:"id"`
+	Username   string    `json:"username"`
+	Name       string    `json:"name"`
+	Platform   string    `json:"platform"`
+	PublicKey  []byte    `json:"public_key"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	Current    bool      `json:"current,omitempty"`
+}
+
+// Sort fields of device listings. Devices are listed most recently seen first by default.
+const (
+	SortLastSeen = "last_seen"
+	SortCreated  = "created"
+)
+
+// Sorts lists the fields device listings can be sorted by.
+var Sorts = []string{SortLastSeen, SortCreated}
+
+// DefaultSort orders devices most recently seen first.
+const DefaultSort = "-" + SortLastSeen
+
+// Cursor returns the position of the device in listings with the given sort, tied by ID.
+func (d Device) Cursor(sort string) pagination.Cursor {
+	if sort == SortCreated {
+		return pagination.Cursor{Key: pagination.TimeKey(d.CreatedAt), UID: d.ID}
+	}
+	return pagination.Cursor{Key: pagination.TimeKey(d.LastSeenAt), UID: d.ID}
+}
+
+// Registration represents the device presented at sign-in.
+//
+// Fields:
+// - Name: The name of the device.
+// - Platform: The platform of the device.
+// - PublicKey: The Ed25519 public key of the device.
+// - Signature: The device's signature of the sign-in challenge, proving it holds the private key.
+type Registration struct {
+	Name      string
+	Platform  string
+	PublicKey []byte
+	Signature []byte
+}
+
+// Valid reports whether the registration is well-formed and its signature of the challenge verifies.
+func (r Registration) Valid(challenge string) bool {
+	name := strings.TrimSpace(r.Name)
+	if name == "" || len(name) > MaxNameLength || len(r.Platform) > MaxPlatformLength {
+		return false
+	}
+	if len(r.PublicKey) != ed25519.PublicKeySize || len(r.Signature) != ed25519.SignatureSize {
+		return false
+	}
+	return ed25519.Verify(r.PublicKey, []byte(challenge), r.Signature)
+}
+
+// RequestMessage returns the message a device signs for a request: the method, the request URI,
+// the timestamp header value, the session identifier and the content hash header value, separated
+// by newlines. The content hash binds the signature to the request body.
+func RequestMessage(method, requestURI, timestamp, sessionID, contentHash string) []byte {
+	return []byte(strings.Join([]string{method, requestURI, timestamp, sessionID, contentHash}, "\n"))
+}
+
+// ContentHash returns the hex-encoded SHA-256 of a request body, which a device sends and signs
+// along with the request.
+func ContentHash(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
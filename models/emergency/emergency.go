@@ -0,0 +1,78 @@
+// Package emergency defines the data structures for emergency (break-glass) access,
+// which lets a trusted contact reach a user's vault when the user is unreachable.
+package emergency
+
+import "time"
+
+// Statuses of an emergency contact.
+const (
+	StatusIdle      = "idle"      // The contact is designated but has not requested access.
+	StatusRequested = "requested" // The contact requested access and the wait period is running.
+	StatusApproved  = "approved"  // Access was approved by the owner or after the wait period.
+	StatusRejected  = "rejected"  // The owner rejected the request.
+)
+
+// Limits for the wait period an owner can choose.
+const (
+	MinWaitDays = 1
+	MaxWaitDays = 90
+)
+
+// Event is an action that moves an emergency contact between statuses.
+type Event string
+
+// Events of the emergency access state machine.
+const (
+	EventRequest Event = "request" // The contact requests access.
+	EventApprove Event = "approve" // The owner approves the request.
+	EventReject  Event = "reject"  // The owner rejects the request.
+	EventTimeout Event = "timeout" // The wait period ended without a rejection.
+)
+
+// transitions defines the allowed status changes for each event.
+var transitions = map[string]map[Event]string{
+	StatusIdle: {
+		EventRequest: StatusRequested,
+	},
+	StatusRejected: {
+		EventRequest: StatusRequested,
+	},
+	StatusRequested: {
+		EventApprove: StatusApproved,
+		EventReject:  StatusRejected,
+		EventTimeout: StatusApproved,
+	},
+}
+
+// Next returns the status reached by applying event to status,
+// and false if the event is not allowed in that status.
+func Next(status string, event Event) (string, bool) {
+	next, ok := transitions[status][event]
+	return next, ok
+}
+
+// Contact represents a trusted contact who may request access to a grantor's vault.
+//
+// Fields:
+// - Grantor: The username of the vault owner.
+// - Grantee: The username of the trusted contact.
+// - WaitDays: The number of days the owner has to reject a request before it is approved.
+// - Status: The current status of the contact.
+// - RequestedAt: The time access was last requested, if ever.
+type Contact struct {
+	Grantor     string     `json:"grantor" validate:"required,min=3,max=50" example:"john_doe"`
+	Grantee     string     `json:"grantee" validate:"required,min=3,max=50" example:"jane_doe"`
+	WaitDays    int        `json:"wait_days" validate:"required,min=1,max=90" example:"7"`
+	Status      string     `json:"status" validate:"oneof=idle requested approved rejected" example:"idle"`
+	RequestedAt *time.Time `json:"requested_at,omitempty"`
+}
+
+// AutoApproveAt returns when a pending request is approved automatically,
+// or nil if no request is pending.
+func (c Contact) AutoApproveAt() *time.Time {
+	if c.Status != StatusRequested || c.RequestedAt == nil {
+		return nil
+	}
+	at := c.RequestedAt.Add(time.Duration(c.WaitDays) * 24 * time.Hour)
+	return &at
+}
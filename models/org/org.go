@@ -0,0 +1,51 @@
+// Package org defines the data structures related to organizations, their members,
+// groups and the collections of cards shared within them.
+package org
+
+// Roles a member can hold within an organization, from the most to the least privileged.
+const (
+	RoleOwner    = "owner"
+	RoleAdmin    = "admin"
+	RoleMember   = "member"
+	RoleReadOnly = "read-only"
+)
+
+// roles lists organization roles ordered from the most to the least privileged.
+var roles = []string{RoleOwner, RoleAdmin, RoleMember, RoleReadOnly}
+
+// Member represents a user's membership in an organization.
+type Member struct {
+	Org      string `json:"org" validate:"required,min=3,max=100" example:"acme"`
+	Username string `json:"username" validate:"required,min=3,max=50" example:"john_doe"`
+	Role     string `json:"role" validate:"required,oneof=owner admin member read-only" example:"member"`
+}
+
+// Group represents a named set of organization members that collections can be made visible to.
+type Group struct {
+	Org     string   `json:"org" validate:"required,min=3,max=100" example:"acme"`
+	Name    string   `json:"name" validate:"required,min=1,max=100" example:"backend"`
+	Members []string `json:"members" example:"john_doe"`
+}
+
+// Collection represents a named set of cards within an organization, visible to the listed groups.
+type Collection struct {
+	Org    string   `json:"org" validate:"required,min=3,max=100" example:"acme"`
+	Name   string   `json:"name" validate:"required,min=1,max=100" example:"payments"`
+	Groups []string `json:"groups" example:"backend"`
+}
+
+// ValidRole reports whether role is one of the supported organization roles.
+func ValidRole(role string) bool {
+	return len(RolesAtLeast(role)) > 0
+}
+
+// RolesAtLeast returns all roles that are at least as privileged as min,
+// or nil if min is not a known role.
+func RolesAtLeast(min string) []string {
+	for i, role := range roles {
+		if role == min {
+			return roles[: i+1 : i+1]
+		}
+	}
+	return nil
+}
@@ -2,9 +2,55 @@
 // including card information and related metadata.
 package profile
 
-import "time"
+import (
+	"strconv"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/models/apikey"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
+)
+
+// Permissions that an owner can grant to another user on a shared card.
+const (
+	PermissionRead      = "read"
+	PermissionReadWrite = "read-write"
+)
+
+// Item types a card can be classified as.
+const (
+	TypeCard     = "card"
+	TypeLogin    = "login"
+	TypeNote     = "note"
+	TypeIdentity = "identity"
+)
+
+// Fields card listings can be sorted by.
+const (
+	SortCreated    = "created"
+	SortUpdated    = "updated"
+	SortHolder     = "holder"
+	SortExpiration = "expiration"
+)
+
+// MaxMetadataLength is the maximum length of the metadata of an item in characters.
+const MaxMetadataLength = 1000
+
+// Sorts lists the supported sort fields of card listings.
+var Sorts = []string{SortCreated, SortUpdated, SortHolder, SortExpiration}
+
+// SortRelevance orders search results best match first; it is the only sort of searches.
+const SortRelevance = "relevance"
 
 // CardInfo represents the structure for storing information about a user's card.
+//
+// Owner and Permission are only set for cards shared with the user: Owner holds the
+// username of the card owner and Permission the access level granted to the user.
+// Org and Collection are set for cards visible through an organization collection.
+// ItemKey holds the client-side wrapped item key, if the card is encrypted on the client.
+// Name, Type, FolderID and Tags organize the card; folders and tags are private to the owner.
+// URL, Login and Password hold the credentials of login items.
+// ID, CreatedAt and UpdatedAt are set when cards are loaded and position them in sorted listings;
+// Rank is set by searches and positions the card in search results.
 type CardInfo struct {
 	Username       string    `json:"username" validate:"required,min=3,max=50" example:"john_doe"`
 	CardNumber     string    `json:"card_number" validate:"required,len=16,numeric" example:"1234567812345678"`
@@ -12,4 +58,113 @@ type CardInfo struct {
 	ExpirationDate time.Time `json:"expiration_date" validate:"required" example:"2025-01-01"`
 	Cvv            string    `json:"cvv" validate:"required,len=3,numeric" example:"123"`
 	Metadata       string    `json:"metadata,omitempty" validate:"max=1000" example:"additional info"`
+	ItemKey        []byte    `json:"item_key,omitempty"`
+	Owner          string    `json:"owner,omitempty" example:"jane_doe"`
+	Permission     string    `json:"permission,omitempty" validate:"omitempty,oneof=read read-write" example:"read"`
+	Org            string    `json:"org,omitempty" example:"acme"`
+	Collection     string    `json:"collection,omitempty" example:"payments"`
+	Name           string    `json:"name,omitempty" validate:"max=200" example:"Travel card"`
+	Type           string    `json:"type,omitempty" validate:"omitempty,oneof=card login note identity" example:"card"`
+	FolderID       *int64    `json:"folder_id,omitempty" example:"1"`
+	Tags           []string  `json:"tags,omitempty" example:"travel"`
+	URL            string    `json:"url,omitempty" validate:"max=2000" example:"https://bank.example.com"`
+	Login          string    `json:"login,omitempty" validate:"max=200" example:"john_doe"`
+	Password       string    `json:"password,omitempty" validate:"max=1000"`
+	ID             int64     `json:"-"`
+	CreatedAt      time.Time `json:"-"`
+	UpdatedAt      time.Time `json:"-"`
+	Rank           float32   `json:"-"`
+}
+
+// Cursor returns the position of the card in a listing sorted by the given field.
+func (c CardInfo) Cursor(sort string) pagination.Cursor {
+	var key string
+	switch sort {
+	case SortUpdated:
+		key = pagination.TimeKey(c.UpdatedAt)
+	case SortHolder:
+		key = c.CardHolder
+	case SortExpiration:
+		key = pagination.DateKey(c.ExpirationDate)
+	case SortRelevance:
+		key = strconv.FormatFloat(float64(c.Rank), 'g', -1, 32)
+	default:
+		key = pagination.TimeKey(c.CreatedAt)
+	}
+	return pagination.Cursor{Key: key, ID: c.ID}
+}
+
+// CardShare represents an access grant on a card from its owner to another user.
+type CardShare struct {
+	Owner      string `json:"owner" validate:"required,min=3,max=50" example:"john_doe"`
+	Grantee    string `json:"grantee" validate:"required,min=3,max=50" example:"jane_doe"`
+	CardNumber string `json:"card_number" validate:"required,len=16,numeric" example:"1234567812345678"`
+	Permission string `json:"permission" validate:"required,oneof=read read-write" example:"read"`
+	WrappedKey []byte `json:"wrapped_key,omitempty"`
+}
+
+// ItemKey is an item key wrapped to a user's public key: the key of one of the user's own cards,
+// or the key of a card shared with the user by its owner.
+//
+// Fields:
+// - Owner: The owner of the card.
+// - CardNumber: The card number.
+// - Key: The wrapped item key.
+type ItemKey struct {
+	Owner      string
+	CardNumber string
+	Key        []byte
+}
+
+// IsShared reports whether the card was shared with the user rather than owned by them.
+func (c CardInfo) IsShared() bool {
+	return c.Owner != "" && c.Owner != c.Username
+}
+
+// ValidType reports whether t is one of the supported item types.
+func ValidType(t string) bool {
+	switch t {
+	case TypeCard, TypeLogin, TypeNote, TypeIdentity:
+		return true
+	}
+	return false
+}
+
+// Folder represents a user's folder. Folders nest through ParentID; top-level folders have none.
+type Folder struct {
+	ID       int64  `json:"id" example:"1"`
+	Username string `json:"username" validate:"required,min=3,max=50" example:"john_doe"`
+	ParentID *int64 `json:"parent_id,omitempty" example:"2"`
+	Name     string `json:"name" validate:"required,min=1,max=100" example:"Travel"`
+}
+
+// Tag represents a user's tag together with the number of cards carrying it.
+type Tag struct {
+	Name  string `json:"name" validate:"required,min=1,max=50" example:"travel"`
+	Cards int    `json:"cards" example:"3"`
+}
+
+// Filter narrows down a card listing. Zero values do not filter.
+//
+// Fields:
+// - FolderID: Only cards in this folder or any of its subfolders.
+// - Tag: Only cards carrying this tag.
+// - Type: Only cards of this item type.
+// - Scope: Only cards covered by the scope of the API key making the request.
+type Filter struct {
+	FolderID *int64
+	Tag      string
+	Type     string
+	Scope    *apikey.Scope
+}
+
+// Personal reports whether the filter uses the owner's folders or tags, which only apply
+// to the user's own cards, or an API key scope, which never covers cards of others.
+func (f Filter) Personal() bool {
+	return f.FolderID != nil || f.Tag != "" || f.Scope != nil
+}
+
+// ValidPermission reports whether p is one of the supported share permissions.
+func ValidPermission(p string) bool {
+	return p == PermissionRead || p == PermissionReadWrite
 }
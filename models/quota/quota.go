@@ -0,0 +1,137 @@
+// Package quota defines the data structures of the limits on what users store, which are set per account plan
+// and can be overridden per user, and of the usage they are checked against.
+package quota
+
+// Limits are the limits of an account; a limit of 0 is unlimited.
+//
+// Fields:
+// - Items: The number of items the user owns.
+// - Bytes: The total size in bytes of the user's items and attachments.
+// - AttachmentSize: The size in bytes of a single attachment.
+// - APIKeys: The number of the user's API keys that have not expired.
+// - Devices: The number of devices the user signed in from.
+type Limits struct {
+	Items          int64 `json:"items" example:"10000"`
+	Bytes          int64 `json:"bytes" example:"1073741824"`
+	AttachmentSize int64 `json:"attachment_size" example:"104857600"`
+	APIKeys        int64 `json:"api_keys" example:"20"`
+	Devices        int64 `json:"devices" example:"20"`
+}
+
+// Exceeded reports which limit, if any, a change of usage from before to after grows past.
+// Usage that is past a limit but does not grow is accepted, so that users above a lowered limit
+// can still edit and delete what they store.
+func (l Limits) Exceeded(before, after Usage) (name string, limit int64, exceeded bool) {
+	checks := []struct {
+		name          string
+		limit         int64
+		before, after int64
+	}{
+		{"items", l.Items, before.Items, after.Items},
+		{"bytes", l.Bytes, before.Bytes, after.Bytes},
+		{"api keys", l.APIKeys, before.APIKeys, after.APIKeys},
+		{"devices", l.Devices, before.Devices, after.Devices},
+	}
+	for _, c := range checks {
+		if c.limit > 0 && c.after > c.limit && c.after > c.before {
+			return c.name, c.limit, true
+		}
+	}
+	return "", 0, false
+}
+
+// Plans holds the limits of the account plans.
+//
+// Fields:
+// - User: The limits of unauthorized and authorized users.
+// - Admin: The limits of admins and superadmins.
+type Plans struct {
+	User  Limits
+	Admin Limits
+}
+
+// Plan returns the name and limits of the plan of an account, depending on whether it is an admin.
+func (p Plans) Plan(admin bool) (name string, limits Limits) {
+	if admin {
+		return PlanAdmin, p.Admin
+	}
+	return PlanUser, p.User
+}
+
+// Override replaces limits of the plan of a user; nil fields keep the limits of the plan.
+//
+// Fields:
+// - Items: The number of items the user owns.
+// - Bytes: The total size in bytes of the user's items and attachments.
+// - AttachmentSize: The size in bytes of a single attachment.
+// - APIKeys: The number of the user's API keys that have not expired.
+// - Devices: The number of devices the user signed in from.
+type Override struct {
+	Items          *int64 `json:"items,omitempty" example:"20000"`
+	Bytes          *int64 `json:"bytes,omitempty" example:"5368709120"`
+	AttachmentSize *int64 `json:"attachment_size,omitempty" example:"104857600"`
+	APIKeys        *int64 `json:"api_keys,omitempty" example:"20"`
+	Devices        *int64 `json:"devices,omitempty" example:"20"`
+}
+
+// Valid reports whether the override sets no negative limit.
+func (o Override) Valid() bool {
+	for _, v := range []*int64{o.Items, o.Bytes, o.AttachmentSize, o.APIKeys, o.Devices} {
+		if v != nil && *v < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Apply returns the limits l with the override applied.
+func (o Override) Apply(l Limits) Limits {
+	set := func(dst *int64, v *int64) {
+		if v != nil {
+			*dst = *v
+		}
+	}
+	set(&l.Items, o.Items)
+	set(&l.Bytes, o.Bytes)
+	set(&l.AttachmentSize, o.AttachmentSize)
+	set(&l.APIKeys, o.APIKeys)
+	set(&l.Devices, o.Devices)
+	return l
+}
+
+// Usage is what a user stores, counted against their limits.
+//
+// Fields:
+// - Items: The number of items the user owns.
+// - Bytes: The total size in bytes of the user's items and attachments, counting the declared size
+// of attachments still being uploaded.
+// - APIKeys: The number of the user's API keys that have not expired.
+// - Devices: The number of devices the user signed in from.
+type Usage struct {
+	Items   int64 `json:"items" example:"120"`
+	Bytes   int64 `json:"bytes" example:"5242880"`
+	APIKeys int64 `json:"api_keys" example:"2"`
+	Devices int64 `json:"devices" example:"3"`
+}
+
+// Report describes the usage and limits of a user.
+//
+// Fields:
+// - Username: The user.
+// - Plan: The plan of the user, "user" or "admin".
+// - Usage: What the user stores.
+// - Limits: The limits of the user, with the override applied.
+// - Override: The limits of the plan replaced for the user.
+type Report struct {
+	Username string   `json:"username" example:"john_doe"`
+	Plan     string   `json:"plan" example:"user"`
+	Usage    Usage    `json:"usage"`
+	Limits   Limits   `json:"limits"`
+	Override Override `json:"override"`
+}
+
+// Names of the account plans.
+const (
+	PlanUser  = "user"
+	PlanAdmin = "admin"
+)
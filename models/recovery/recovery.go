@@ -0,0 +1,162 @@
+// Package recovery defines the data structures of recovery kits, which split a user's vault key
+// into shares so that a forgotten master password does not lose the vault.
+package recovery
+
+import (
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Limits of a recovery kit.
+const (
+	MinVaultKeyLength = 16  // The shortest vault key that can be split.
+	MaxVaultKeyLength = 256 // The longest vault key that can be split.
+	MaxShares         = 16  // The largest number of shares of a kit.
+)
+
+// codeVersion is the version of the share code format.
+const codeVersion = 1
+
+// codeGroup is the number of characters between the dashes of a share code.
+const codeGroup = 5
+
+// codeEncoding encodes share codes with upper-case letters and digits only,
+// which keeps them readable and fits the alphanumeric mode of QR codes.
+var codeEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// ErrInvalidCode is returned when a share code is malformed or mistyped.
+var ErrInvalidCode = errors.New("invalid share code")
+
+// Setup represents the parameters of a new recovery kit.
+//
+// Fields:
+// - Password: The user's current password, confirming the setup.
+// - VaultKey: The vault key to split; it is not stored.
+// - Shares: The number of shares to create.
+// - Threshold: The number of shares required to recover the vault key.
+type Setup struct {
+	Password  string
+	VaultKey  []byte
+	Shares    int
+	Threshold int
+}
+
+// Kit represents the recovery kit of a user. The shares are handed out when the kit is created
+// and never stored; the kit keeps a verifier of the vault key to check a recovery against.
+//
+// Fields:
+// - ID: The kit identifier, carried by each of its shares.
+// - Username: The user the kit belongs to.
+// - Shares: The number of shares created.
+// - Threshold: The number of shares required to recover the vault key.
+// - Verifier: The bcrypt hash of the vault key's SHA-256 digest.
+// - CreatedAt: When the kit was created.
+type Kit struct {
+	ID        uint32    `json:"id"`
+	Username  string    `json:"username"`
+	Shares    int       `json:"shares"`
+	Threshold int       `json:"threshold"`
+	Verifier  []byte    `json:"-"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// FormatKitID formats a kit identifier the way it is shown to users, as eight hex digits.
+func FormatKitID(id uint32) string {
+	return fmt.Sprintf("%08X", id)
+}
+
+// GenerateVerifier hashes a vault key into the kit's Verifier.
+func (k *Kit) GenerateVerifier(vaultKey []byte) error {
+	verifier, err := bcrypt.GenerateFromPassword(keyDigest(vaultKey), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+
+	k.Verifier = verifier
+	return nil
+}
+
+// VerifyKey reports whether a vault key matches the kit's Verifier.
+func (k *Kit) VerifyKey(vaultKey []byte) bool {
+	return bcrypt.CompareHashAndPassword(k.Verifier, keyDigest(vaultKey)) == nil
+}
+
+// keyDigest returns the hex-encoded SHA-256 digest of a vault key, which fits the 72 byte input limit of bcrypt.
+func keyDigest(vaultKey []byte) []byte {
+	sum := sha256.Sum256(vaultKey)
+	return []byte(hex.EncodeToString(sum[:]))
+}
+
+// Share represents a single share of a recovery kit.
+//
+// Fields:
+// - KitID: The identifier of the kit the share belongs to.
+// - Threshold: The number of shares required to recover the vault key.
+// - Data: The Shamir share of the vault key.
+type Share struct {
+	KitID     uint32
+	Threshold int
+	Data      []byte
+}
+
+// Index returns the position of the share within its kit, starting at 1.
+func (s Share) Index() int {
+	if len(s.Data) == 0 {
+		return 0
+	}
+	return int(s.Data[len(s.Data)-1])
+}
+
+// Code encodes the share as a text code made of upper-case letters, digits and dashes,
+// suitable for printing and for QR codes. The code carries a checksum against typos.
+func (s Share) Code() string {
+	payload := make([]byte, 0, 6+len(s.Data)+crc32.Size)
+	payload = append(payload, codeVersion)
+	payload = binary.BigEndian.AppendUint32(payload, s.KitID)
+	payload = append(payload, byte(s.Threshold))
+	payload = append(payload, s.Data...)
+	payload = binary.BigEndian.AppendUint32(payload, crc32.ChecksumIEEE(payload))
+
+	text := codeEncoding.EncodeToString(payload)
+	groups := make([]string, 0, (len(text)+codeGroup-1)/codeGroup)
+	for len(text) > codeGroup {
+		groups = append(groups, text[:codeGroup])
+		text = text[codeGroup:]
+	}
+	return strings.Join(append(groups, text), "-")
+}
+
+// ParseCode decodes a share code produced by Code. Letter case, dashes and whitespace are ignored.
+func ParseCode(code string) (Share, error) {
+	text := strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' || r == '\t' || r == '\r' || r == '\n' {
+			return -1
+		}
+		return r
+	}, strings.ToUpper(code))
+
+	payload, err := codeEncoding.DecodeString(text)
+	if err != nil || len(payload) < 6+2+crc32.Size {
+		return Share{}, ErrInvalidCode
+	}
+
+	body, sum := payload[:len(payload)-crc32.Size], payload[len(payload)-crc32.Size:]
+	if crc32.ChecksumIEEE(body) != binary.BigEndian.Uint32(sum) || body[0] != codeVersion {
+		return Share{}, ErrInvalidCode
+	}
+
+	return Share{
+		KitID:     binary.BigEndian.Uint32(body[1:5]),
+		Threshold: int(body[5]),
+		Data:      body[6:],
+	}, nil
+}
@@ -34,10 +34,26 @@ type PostCreateProfileReq struct {
 // - Username: The username of the user attempting to sign in.
 // - Password: The user's password.
 // - Challenge: A challenge string used to validate the sign-in process.
+// - Device: Optional device the session is bound to.
 type PostSignInReq struct {
-	Username  string `json:"username"`
-	Password  string `json:"password"`
-	Challenge string `json:"challenge"`
+	Username  string     `json:"username"`
+	Password  string     `json:"password"`
+	Challenge string     `json:"challenge"`
+	Device    *DeviceReq `json:"device,omitempty"`
+}
+
+// DeviceReq represents the device a user signs in from.
+//
+// Fields:
+// - Name: The name of the device.
+// - Platform: The platform of the device, such as "linux" or "ios".
+// - PublicKey: The Ed25519 public key of the device.
+// - Signature: The device's Ed25519 signature of the challenge.
+type DeviceReq struct {
+	Name      string `json:"name"`
+	Platform  string `json:"platform"`
+	PublicKey []byte `json:"public_key"`
+	Signature []byte `json:"signature"`
 }
 
 // PostUploadInfoReq represents the structure of the request body for uploading card information.
@@ -48,10 +64,297 @@ type PostSignInReq struct {
 // - ExpirationDate: The expiration date of the card.
 // - Cvv: The CVV security code of the card.
 // - Metadata: Optional metadata associated with the card.
+// - ItemKey: Optional item key wrapped to the owner's public key (client-side encryption).
+// - Owner: Optional owner username, set when editing a card shared with read-write access.
+// - Name: Optional display name of the card.
+// - Type: Optional item type, one of "card" (default), "login", "note" or "identity".
+// - FolderID: Optional folder the card is filed in.
+// - Tags: Optional tags of the card; missing tags are created.
+// - URL: Optional website of a login item.
+// - Login: Optional username of a login item.
+// - Password: Optional password of a login item.
 type PostUploadInfoReq struct {
 	CardNumber     string    `json:"card_number"`
 	CardHolder     string    `json:"card_holder"`
 	ExpirationDate time.Time `json:"expiration_date"`
 	Cvv            string    `json:"cvv"`
 	Metadata       string    `json:"metadata"`
+	ItemKey        []byte    `json:"item_key,omitempty"`
+	Owner          string    `json:"owner,omitempty"`
+	Name           string    `json:"name,omitempty"`
+	Type           string    `json:"type,omitempty"`
+	FolderID       *int64    `json:"folder_id,omitempty"`
+	Tags           []string  `json:"tags,omitempty"`
+	URL            string    `json:"url,omitempty"`
+	Login          string    `json:"login,omitempty"`
+	Password       string    `json:"password,omitempty"`
+}
+
+// PostShareCardReq represents the structure of the request body for sharing a card.
+//
+// Fields:
+// - CardNumber: The card number to share.
+// - Username: The username of the grantee.
+// - Permission: The access level granted, either "read" or "read-write".
+// - WrappedKey: The item key re-wrapped to the grantee's public key, required for client-side encrypted cards.
+type PostShareCardReq struct {
+	CardNumber string `json:"card_number"`
+	Username   string `json:"username"`
+	Permission string `json:"permission"`
+	WrappedKey []byte `json:"wrapped_key,omitempty"`
+}
+
+// DeleteCardShareReq represents the structure of the request body for revoking a card share.
+//
+// Fields:
+// - CardNumber: The shared card number.
+// - Username: The username of the grantee whose access is revoked.
+type DeleteCardShareReq struct {
+	CardNumber string `json:"card_number"`
+	Username   string `json:"username"`
+}
+
+// PostPublicKeyReq represents the structure of the request body for publishing a user's public key.
+//
+// Fields:
+// - PublicKey: The public key other users wrap shared item keys to.
+type PostPublicKeyReq struct {
+	PublicKey []byte `json:"public_key"`
+}
+
+// PostCreateOrgReq represents the structure of the request body for creating an organization.
+//
+// Fields:
+// - Name: The unique organization name.
+type PostCreateOrgReq struct {
+	Name string `json:"name"`
+}
+
+// PostOrgMemberReq represents the structure of the request body for adding or updating an organization member.
+//
+// Fields:
+// - Username: The member's username.
+// - Role: The member's role, one of "owner", "admin", "member" or "read-only".
+type PostOrgMemberReq struct {
+	Username string `json:"username"`
+	Role     string `json:"role"`
+}
+
+// DeleteOrgMemberReq represents the structure of the request body for removing an organization member.
+//
+// Fields:
+// - Username: The username of the member to remove.
+type DeleteOrgMemberReq struct {
+	Username string `json:"username"`
+}
+
+// PostOrgGroupReq represents the structure of the request body for creating or replacing a group.
+//
+// Fields:
+// - Name: The group name, unique within the organization.
+// - Members: The usernames of the organization members in the group.
+type PostOrgGroupReq struct {
+	Name    string   `json:"name"`
+	Members []string `json:"members"`
+}
+
+// PostOrgCollectionReq represents the structure of the request body for creating or replacing a collection.
+//
+// Fields:
+// - Name: The collection name, unique within the organization.
+// - Groups: The groups the collection is visible to.
+type PostOrgCollectionReq struct {
+	Name   string   `json:"name"`
+	Groups []string `json:"groups"`
+}
+
+// PostCollectionCardReq represents the structure of the request body for adding a card to a collection.
+//
+// Fields:
+// - Collection: The collection name.
+// - CardNumber: The number of a card owned by the user.
+type PostCollectionCardReq struct {
+	Collection string `json:"collection"`
+	CardNumber string `json:"card_number"`
+}
+
+// PostCreateSendReq represents the structure of the request body for creating a one-time share link.
+//
+// Fields:
+// - Ciphertext: The payload, encrypted on the client with a key kept in the link's URL fragment.
+// - MaxViews: The number of times the link can be opened.
+// - ExpiresAt: The time after which the link stops working.
+// - Password: An optional password required to open the link.
+type PostCreateSendReq struct {
+	Ciphertext []byte    `json:"ciphertext"`
+	MaxViews   int       `json:"max_views"`
+	ExpiresAt  time.Time `json:"expires_at"`
+	Password   string    `json:"password,omitempty"`
+}
+
+// PostEmergencyContactReq represents the structure of the request body for designating an emergency contact.
+//
+// Fields:
+// - Username: The trusted contact's username.
+// - WaitDays: The number of days the user has to reject an access request before it is granted.
+type PostEmergencyContactReq struct {
+	Username string `json:"username"`
+	WaitDays int    `json:"wait_days"`
+}
+
+// EmergencyUsernameReq represents the structure of the request body for emergency access actions
+// that only name the other party: removing a contact, requesting access, approving or rejecting a request.
+//
+// Fields:
+// - Username: The grantee when the user acts as grantor, or the grantor when the user acts as grantee.
+type EmergencyUsernameReq struct {
+	Username string `json:"username"`
+}
+
+// PostFolderReq represents the structure of the request body for creating a folder.
+//
+// Fields:
+// - Name: The folder name, unique within the parent folder.
+// - ParentID: Optional folder to nest the new folder in.
+type PostFolderReq struct {
+	Name     string `json:"name"`
+	ParentID *int64 `json:"parent_id,omitempty"`
+}
+
+// DeleteFolderReq represents the structure of the request body for deleting a folder.
+//
+// Fields:
+// - ID: The identifier of the folder to delete.
+type DeleteFolderReq struct {
+	ID int64 `json:"id"`
+}
+
+// DeleteTagReq represents the structure of the request body for deleting a tag.
+//
+// Fields:
+// - Name: The name of the tag to delete.
+type DeleteTagReq struct {
+	Name string `json:"name"`
+}
+
+// PostAttachmentReq represents the structure of the request body for registering an attachment upload.
+//
+// Fields:
+// - CardNumber: The card the file is attached to.
+// - Name: The file name shown to the user.
+// - ContentType: The media type of the file, "application/octet-stream" if omitted.
+// - Size: The size of the file in bytes.
+// - SHA256: The hex-encoded SHA-256 checksum the uploaded content must match.
+type PostAttachmentReq struct {
+	CardNumber  string `json:"card_number"`
+	Name        string `json:"name"`
+	ContentType string `json:"content_type,omitempty"`
+	Size        int64  `json:"size"`
+	SHA256      string `json:"sha256"`
+}
+
+// PostChangePasswordReq represents the structure of the request body for changing the master password.
+//
+// Fields:
+// - OldPassword: The current password.
+// - NewPassword: The new password.
+// - PublicKey: The new public key, if the client re-keyed the vault along with the password.
+// - ItemKeys: Every item key the user holds, re-wrapped to the new public key; required with PublicKey.
+type PostChangePasswordReq struct {
+	OldPassword string       `json:"old_password"`
+	NewPassword string       `json:"new_password"`
+	PublicKey   []byte       `json:"public_key,omitempty"`
+	ItemKeys    []ItemKeyReq `json:"item_keys,omitempty"`
+}
+
+// ItemKeyReq represents a single re-wrapped item key in the request.
+//
+// Fields:
+// - Owner: The owner of the card, empty for the user's own cards.
+// - CardNumber: The card number.
+// - ItemKey: The item key wrapped to the new public key.
+type ItemKeyReq struct {
+	Owner      string `json:"owner,omitempty"`
+	CardNumber string `json:"card_number"`
+	ItemKey    []byte `json:"item_key"`
+}
+
+// DeleteAccountReq represents the structure of the request body for deleting the user's account.
+//
+// Fields:
+// - Password: The current password, required to confirm the deletion.
+type DeleteAccountReq struct {
+	Password string `json:"password"`
+}
+
+// PostRecoveryKitReq represents the structure of the request body for creating a recovery kit.
+//
+// Fields:
+// - Password: The current password, required to confirm the setup.
+// - VaultKey: The vault key to split into shares.
+// - Shares: The number of shares to create.
+// - Threshold: The number of shares required to recover the vault key.
+type PostRecoveryKitReq struct {
+	Password  string `json:"password"`
+	VaultKey  []byte `json:"vault_key"`
+	Shares    int    `json:"shares"`
+	Threshold int    `json:"threshold"`
+}
+
+// PostRecoverReq represents the structure of the request body for recovering an account with a recovery kit.
+//
+// Fields:
+// - Username: The account to recover.
+// - Shares: The share codes, at least as many as the threshold of the kit.
+// - NewPassword: The new password.
+// - PublicKey: The new public key, if the client re-keyed the vault along with the password.
+// - ItemKeys: Every item key the user holds, re-wrapped to the new public key; required with PublicKey.
+type PostRecoverReq struct {
+	Username    string       `json:"username"`
+	Shares      []string     `json:"shares"`
+	NewPassword string       `json:"new_password"`
+	PublicKey   []byte       `json:"public_key,omitempty"`
+	ItemKeys    []ItemKeyReq `json:"item_keys,omitempty"`
+}
+
+// PostAPIKeyReq represents the structure of the request body for creating a personal API key.
+//
+// Fields:
+// - Name: The name of the key, telling the user what it is for.
+// - ExpiresAt: When the key stops working.
+// - Scope: What the key grants access to.
+type PostAPIKeyReq struct {
+	Name      string      `json:"name"`
+	ExpiresAt time.Time   `json:"expires_at"`
+	Scope     APIKeyScope `json:"scope"`
+}
+
+// APIKeyScope represents what an API key grants access to. A scope naming neither items nor folders
+// covers all of the user's own items.
+//
+// Fields:
+// - Items: The card numbers of the covered items.
+// - Folders: The identifiers of the covered folders, including their subfolders.
+// - ReadOnly: Whether the key only reads items.
+type APIKeyScope struct {
+	Items    []string `json:"items,omitempty"`
+	Folders  []int64  `json:"folders,omitempty"`
+	ReadOnly bool     `json:"read_only"`
+}
+
+// PutQuotaOverrideReq represents the structure of the request body for overriding the limits of a user.
+// Omitted limits keep those of the user's plan; a limit of 0 is unlimited.
+//
+// Fields:
+// - Items: The number of items the user owns.
+// - Bytes: The total size in bytes of the user's items and attachments.
+// - AttachmentSize: The size in bytes of a single attachment.
+// - APIKeys: The number of the user's API keys that have not expired.
+// - Devices: The number of devices the user signed in from.
+type PutQuotaOverrideReq struct {
+	Items          *int64 `json:"items,omitempty" example:"20000"`
+	Bytes          *int64 `json:"bytes,omitempty" example:"5368709120"`
+	AttachmentSize *int64 `json:"attachment_size,omitempty" example:"104857600"`
+	APIKeys        *int64 `json:"api_keys,omitempty" example:"20"`
+	Devices        *int64 `json:"devices,omitempty" example:"20"`
 }
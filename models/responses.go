@@ -7,9 +7,11 @@ import "time"
 // Fields:
 // - Username: The username associated with the cards.
 // - Cards: A slice of CardResp containing the user's card details.
+// - NextCursor: The cursor of the next page, empty on the last page.
 type GetUserCardsResp struct {
-	Username string     `json:"username"`
-	Cards    []CardResp `json:"cards"`
+	Username   string     `json:"username"`
+	Cards      []CardResp `json:"cards"`
+	NextCursor string     `json:"next_cursor,omitempty"`
 }
 
 // CardResp represents the structure of a single card's information in the response.
@@ -20,12 +22,38 @@ type GetUserCardsResp struct {
 // - ExpirationDate: The expiration date of the card.
 // - Cvv: The CVV security code of the card.
 // - Metadata: Additional metadata associated with the card.
+// - ItemKey: The wrapped item key for client-side encrypted cards.
+// - Shared: Whether the card was shared with the user by another owner.
+// - Owner: The username of the card owner, set for shared cards.
+// - Permission: The access level granted on a shared card.
+// - Org: The organization through which the card is visible, if any.
+// - Collection: The organization collection containing the card, if any.
+// - Name: The display name of the card.
+// - Type: The item type of the card.
+// - FolderID: The folder the card is filed in, if any.
+// - Tags: The tags of the card.
+// - URL: The website of a login item.
+// - Login: The username of a login item.
+// - Password: The password of a login item.
 type CardResp struct {
 	CardNumber     string    `json:"card_number"`
 	CardHolder     string    `json:"card_holder"`
 	ExpirationDate time.Time `json:"expiration_date"`
 	Cvv            string    `json:"cvv"`
 	Metadata       string    `json:"metadata"`
+	ItemKey        []byte    `json:"item_key,omitempty"`
+	Shared         bool      `json:"shared,omitempty"`
+	Owner          string    `json:"owner,omitempty"`
+	Permission     string    `json:"permission,omitempty"`
+	Org            string    `json:"org,omitempty"`
+	Collection     string    `json:"collection,omitempty"`
+	Name           string    `json:"name,omitempty"`
+	Type           string    `json:"type,omitempty"`
+	FolderID       *int64    `json:"folder_id,omitempty"`
+	Tags           []string  `json:"tags,omitempty"`
+	URL            string    `json:"url,omitempty"`
+	Login          string    `json:"login,omitempty"`
+	Password       string    `json:"password,omitempty"`
 }
 
 // PostChallengeResp represents the structure of the response body for the PostChallenge endpoint.
@@ -53,3 +81,365 @@ type PostSignInResp struct {
 	Token        string `json:"token"`
 	RefreshToken string `json:"refresh_token"`
 }
+
+// GetPublicKeyResp represents the structure of the response body for fetching a user's public key.
+//
+// Fields:
+// - Username: The user the key belongs to.
+// - PublicKey: The user's public key.
+type GetPublicKeyResp struct {
+	Username  string `json:"username"`
+	PublicKey []byte `json:"public_key"`
+}
+
+// GetUserOrgsResp represents the structure of the API response for listing a user's organizations.
+//
+// Fields:
+// - Username: The username the memberships belong to.
+// - Orgs: The organizations the user is a member of.
+type GetUserOrgsResp struct {
+	Username string    `json:"username"`
+	Orgs     []OrgResp `json:"orgs"`
+}
+
+// OrgResp represents a single organization membership in the response.
+//
+// Fields:
+// - Name: The organization name.
+// - Role: The user's role within the organization.
+type OrgResp struct {
+	Name string `json:"name"`
+	Role string `json:"role"`
+}
+
+// PostCreateSendResp represents the structure of the response body for creating a one-time share link.
+//
+// Fields:
+// - ID: The send identifier.
+// - Path: The API path that opens the send; the client appends the decryption key as URL fragment.
+// - ExpiresAt: The time after which the link stops working.
+type PostCreateSendResp struct {
+	ID        string    `json:"id"`
+	Path      string    `json:"path"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// GetSendResp represents the structure of the response body for opening a one-time share link.
+//
+// Fields:
+// - Ciphertext: The client-encrypted payload.
+// - ViewsLeft: How many more times the link can be opened.
+// - ExpiresAt: The time after which the link stops working.
+type GetSendResp struct {
+	Ciphertext []byte    `json:"ciphertext"`
+	ViewsLeft  int       `json:"views_left"`
+	ExpiresAt  time.Time `json:"expires_at"`
+}
+
+// GetEmergencyContactsResp represents the structure of the API response for listing emergency contacts.
+//
+// Fields:
+// - Username: The username the contacts belong to.
+// - Contacts: The contacts the user designated and those that designated the user.
+type GetEmergencyContactsResp struct {
+	Username string                 `json:"username"`
+	Contacts []EmergencyContactResp `json:"contacts"`
+}
+
+// EmergencyContactResp represents a single emergency contact in the response.
+//
+// Fields:
+// - Grantor: The vault owner.
+// - Grantee: The trusted contact.
+// - WaitDays: The wait period in days.
+// - Status: One of "idle", "requested", "approved" or "rejected".
+// - RequestedAt: When access was last requested.
+// - AutoApproveAt: When a pending request is granted unless rejected.
+type EmergencyContactResp struct {
+	Grantor       string     `json:"grantor"`
+	Grantee       string     `json:"grantee"`
+	WaitDays      int        `json:"wait_days"`
+	Status        string     `json:"status"`
+	RequestedAt   *time.Time `json:"requested_at,omitempty"`
+	AutoApproveAt *time.Time `json:"auto_approve_at,omitempty"`
+}
+
+// PostFolderResp represents the structure of the response body for creating a folder.
+//
+// Fields:
+// - ID: The identifier of the new folder.
+type PostFolderResp struct {
+	ID int64 `json:"id"`
+}
+
+// GetFoldersResp represents the structure of the API response for listing a user's folders.
+//
+// Fields:
+// - Username: The username the folders belong to.
+// - Folders: The user's folders; nesting is expressed through parent identifiers.
+type GetFoldersResp struct {
+	Username string       `json:"username"`
+	Folders  []FolderResp `json:"folders"`
+}
+
+// FolderResp represents a single folder in the response.
+//
+// Fields:
+// - ID: The folder identifier.
+// - ParentID: The identifier of the parent folder, if any.
+// - Name: The folder name.
+type FolderResp struct {
+	ID       int64  `json:"id"`
+	ParentID *int64 `json:"parent_id,omitempty"`
+	Name     string `json:"name"`
+}
+
+// GetTagsResp represents the structure of the API response for listing a user's tags.
+//
+// Fields:
+// - Username: The username the tags belong to.
+// - Tags: The user's tags.
+type GetTagsResp struct {
+	Username string    `json:"username"`
+	Tags     []TagResp `json:"tags"`
+}
+
+// TagResp represents a single tag in the response.
+//
+// Fields:
+// - Name: The tag name.
+// - Cards: The number of cards carrying the tag.
+type TagResp struct {
+	Name  string `json:"name"`
+	Cards int    `json:"cards"`
+}
+
+// GetSearchResp represents the structure of the API response for a full-text search.
+//
+// Fields:
+// - Username: The username the cards belong to.
+// - Query: The search query.
+// - Cards: The matching cards, best matches first.
+// - NextCursor: The cursor of the next page, empty on the last page.
+type GetSearchResp struct {
+	Username   string     `json:"username"`
+	Query      string     `json:"query"`
+	Cards      []CardResp `json:"cards"`
+	NextCursor string     `json:"next_cursor,omitempty"`
+}
+
+// PostAttachmentResp represents the structure of the response body for registering an attachment upload.
+//
+// Fields:
+// - ID: The attachment identifier.
+// - Path: The API path the content is uploaded to in chunks and downloaded from.
+type PostAttachmentResp struct {
+	ID   string `json:"id"`
+	Path string `json:"path"`
+}
+
+// GetAttachmentsResp represents the structure of the API response for listing a card's attachments.
+//
+// Fields:
+// - Username: The username the attachments belong to.
+// - CardNumber: The card the attachments belong to.
+// - Attachments: The card's attachments, including unfinished uploads.
+// - NextCursor: The cursor of the next page, empty on the last page.
+type GetAttachmentsResp struct {
+	Username    string           `json:"username"`
+	CardNumber  string           `json:"card_number"`
+	Attachments []AttachmentResp `json:"attachments"`
+	NextCursor  string           `json:"next_cursor,omitempty"`
+}
+
+// AttachmentResp represents a single attachment and its upload state in the response.
+//
+// Fields:
+// - ID: The attachment identifier.
+// - Name: The file name.
+// - ContentType: The media type of the file.
+// - Size: The size of the file in bytes.
+// - Received: The number of bytes uploaded so far; an interrupted upload resumes at this offset.
+// - SHA256: The hex-encoded SHA-256 checksum of the file.
+// - Complete: Whether the upload finished and was verified.
+// - CreatedAt: When the attachment was registered.
+type AttachmentResp struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	Received    int64     `json:"received"`
+	SHA256      string    `json:"sha256"`
+	Complete    bool      `json:"complete"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// PostImportResp represents the structure of the response body for an import.
+//
+// Fields:
+// - Format: The format the file was read in.
+// - DryRun: Whether the import only checked the file without storing anything.
+// - Total: The number of items read from the file.
+// - Imported: The number of items stored, or that would be stored on a dry run.
+// - Duplicates: The items skipped because they match an existing item or an earlier item of the file.
+// - QuotaExceeded: On a dry run, the quota the import would exceed, omitted if it fits.
+type PostImportResp struct {
+	Format        string                `json:"format"`
+	DryRun        bool                  `json:"dry_run"`
+	Total         int                   `json:"total"`
+	Imported      int                   `json:"imported"`
+	Duplicates    []ImportDuplicateResp `json:"duplicates"`
+	QuotaExceeded string                `json:"quota_exceeded,omitempty"`
+}
+
+// ImportDuplicateResp represents a single item skipped by an import in the response.
+//
+// Fields:
+// - Name: The name of the skipped item.
+// - Type: The item type of the skipped item.
+// - Match: How the item matched, "card_number" or "url_login".
+type ImportDuplicateResp struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Match string `json:"match"`
+}
+
+// PostChangePasswordResp represents the structure of the response body for changing the master password.
+//
+// Fields:
+// - RevokedSessions: The number of other sessions that were signed out.
+type PostChangePasswordResp struct {
+	RevokedSessions int64 `json:"revoked_sessions"`
+}
+
+// PostRecoveryKitResp represents the structure of the response body for creating a recovery kit.
+//
+// Fields:
+// - KitID: The kit identifier, printed on each share.
+// - Threshold: The number of shares required to recover the vault key.
+// - Shares: The shares to hand out; they are not stored and cannot be retrieved again.
+type PostRecoveryKitResp struct {
+	KitID     string              `json:"kit_id"`
+	Threshold int                 `json:"threshold"`
+	Shares    []RecoveryShareResp `json:"shares"`
+}
+
+// RecoveryShareResp represents a single share of a recovery kit in the response.
+//
+// Fields:
+// - Index: The position of the share within the kit, starting at 1.
+// - Code: The share as a text code.
+// - QR: The text code as a PNG image of a QR code.
+type RecoveryShareResp struct {
+	Index int    `json:"index"`
+	Code  string `json:"code"`
+	QR    []byte `json:"qr"`
+}
+
+// GetRecoveryKitResp represents the structure of the API response for retrieving the user's recovery kit.
+//
+// Fields:
+// - KitID: The kit identifier, printed on each share.
+// - Shares: The number of shares created.
+// - Threshold: The number of shares required to recover the vault key.
+// - CreatedAt: When the kit was created.
+type GetRecoveryKitResp struct {
+	KitID     string    `json:"kit_id"`
+	Shares    int       `json:"shares"`
+	Threshold int       `json:"threshold"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// PostRecoverResp represents the structure of the response body for recovering an account.
+//
+// Fields:
+// - VaultKey: The reconstructed vault key.
+// - RevokedSessions: The number of sessions that were signed out.
+type PostRecoverResp struct {
+	VaultKey        []byte `json:"vault_key"`
+	RevokedSessions int64  `json:"revoked_sessions"`
+}
+
+// GetDevicesResp represents the structure of the API response for listing a user's devices.
+//
+// Fields:
+// - Username: The username the devices belong to.
+// - Devices: The user's devices, most recently seen first unless another sort was requested.
+// - NextCursor: The cursor of the next page, empty on the last page.
+type GetDevicesResp struct {
+	Username   string       `json:"username"`
+	Devices    []DeviceResp `json:"devices"`
+	NextCursor string       `json:"next_cursor,omitempty"`
+}
+
+// DeviceResp represents a single device in the response.
+//
+// Fields:
+// - ID: The device identifier.
+// - Name: The name of the device.
+// - Platform: The platform of the device.
+// - PublicKey: The Ed25519 public key of the device.
+// - CreatedAt: When the device was registered.
+// - LastSeenAt: When the user last signed in from the device.
+// - Current: Whether the request was made from the device.
+type DeviceResp struct {
+	ID         string    `json:"id"`
+	Name       string    `json:"name"`
+	Platform   string    `json:"platform"`
+	PublicKey  []byte    `json:"public_key"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	Current    bool      `json:"current"`
+}
+
+// DeleteDeviceResp represents the structure of the response body for removing a device.
+//
+// Fields:
+// - RevokedSessions: The number of sessions of the device that were signed out.
+type DeleteDeviceResp struct {
+	RevokedSessions int64 `json:"revoked_sessions"`
+}
+
+// PostAPIKeyResp represents the structure of the response body for creating a personal API key.
+//
+// Fields:
+// - Key: The API key, to be sent as 'ApiKey <key>' in the Authorization header. It is shown only once.
+// - APIKeyResp: The stored form of the key.
+type PostAPIKeyResp struct {
+	Key string `json:"key"`
+	APIKeyResp
+}
+
+// GetAPIKeysResp represents the structure of the API response for listing a user's API keys.
+//
+// Fields:
+// - Username: The username the keys belong to.
+// - Keys: The user's API keys, including expired ones, newest first unless another order was requested.
+// - NextCursor: The cursor of the next page, empty on the last page.
+type GetAPIKeysResp struct {
+	Username   string       `json:"username"`
+	Keys       []APIKeyResp `json:"keys"`
+	NextCursor string       `json:"next_cursor,omitempty"`
+}
+
+// APIKeyResp represents a single API key in the response.
+//
+// Fields:
+// - ID: The key identifier.
+// - Name: The name of the key.
+// - Hint: The start of the key, to tell keys apart.
+// - Scope: What the key grants access to.
+// - ExpiresAt: When the key stops working.
+// - CreatedAt: When the key was created.
+// - LastUsedAt: When the key was last used, if ever.
+// - Expired: Whether the key has expired.
+type APIKeyResp struct {
+	ID         string      `json:"id"`
+	Name       string      `json:"name"`
+	Hint       string      `json:"hint"`
+	Scope      APIKeyScope `json:"scope"`
+	ExpiresAt  time.Time   `json:"expires_at"`
+	CreatedAt  time.Time   `json:"created_at"`
+	LastUsedAt *time.Time  `json:"last_used_at,omitempty"`
+	Expired    bool        `json:"expired"`
+}
@@ -0,0 +1,38 @@
+// Package send defines the data structures for one-time share links ("sends"),
+// which expose a client-encrypted payload to people without an account.
+package send
+
+import "time"
+
+// Limits applied to newly created sends.
+const (
+	MaxViews    = 100                 // Maximum number of views a send can allow.
+	MaxLifetime = 30 * 24 * time.Hour // Maximum time a send can stay available.
+)
+
+// MaxFailedAttempts is the number of wrong passwords after which a send is disabled,
+// since it can be opened without an account.
+const MaxFailedAttempts = 10
+
+// Send represents a one-time share link.
+//
+// The payload is encrypted on the client with a key that only travels in the URL fragment,
+// so the server stores nothing but ciphertext.
+type Send struct {
+	ID           string    `json:"id" example:"8d3c1c2e-4f3a-4b8a-9a0c-2f5d2c1e7b6a"`
+	Username     string    `json:"username" validate:"required,min=3,max=50" example:"john_doe"`
+	Ciphertext   []byte    `json:"ciphertext" validate:"required"`
+	PasswordHash []byte    `json:"-"`
+	MaxViews     int       `json:"max_views" validate:"required,min=1,max=100" example:"1"`
+	Views        int       `json:"views" example:"0"`
+	ExpiresAt    time.Time `json:"expires_at" validate:"required" example:"2025-02-01T00:00:00Z"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+// ViewsLeft returns how many more times the send can be opened.
+func (s Send) ViewsLeft() int {
+	if s.Views >= s.MaxViews {
+		return 0
+	}
+	return s.MaxViews - s.Views
+}
@@ -0,0 +1,97 @@
+// Package transfer defines the data structures for moving vault items in and out of GophKeeper,
+// such as the report of an import from another password manager or the contents of a vault export.
+package transfer
+
+import (
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+)
+
+// MaxImportSize is the maximum size of an import file in bytes.
+const MaxImportSize = 16 << 20
+
+// Export formats. FormatArchive is also accepted by imports to restore a vault.
+const (
+	FormatArchive = "gophkeeper" // Password-encrypted archive.
+	FormatJSON    = "json"       // Plaintext JSON document.
+	FormatCSV     = "csv"        // Plaintext CSV file.
+)
+
+// MinExportPasswordLength is the minimum length of the password an archive is encrypted with.
+const MinExportPasswordLength = 8
+
+// Ways an imported item can match an item that already exists.
+const (
+	MatchCardNumber = "card_number" // Same card number.
+	MatchLogin      = "url_login"   // Same website and username.
+)
+
+// Report describes the outcome of an import.
+//
+// Fields:
+// - Format: The format the file was read in.
+// - DryRun: Whether the import only checked the file without storing anything.
+// - Total: The number of items read from the file.
+// - Imported: The number of items stored, or that would be stored on a dry run.
+// - Duplicates: The items skipped because they match an existing item or an earlier item of the file.
+// - QuotaExceeded: On a dry run, the quota the import would exceed, empty if it fits.
+type Report struct {
+	Format        string
+	DryRun        bool
+	Total         int
+	Imported      int
+	Duplicates    []Duplicate
+	QuotaExceeded string
+}
+
+// Duplicate describes an item skipped by an import.
+//
+// Fields:
+// - Name: The name of the skipped item.
+// - Type: The item type of the skipped item.
+// - Match: How the item matched, MatchCardNumber or MatchLogin.
+type Duplicate struct {
+	Name  string
+	Type  string
+	Match string
+}
+
+// ExportOptions describes a requested export.
+//
+// Fields:
+// - Format: The export format, FormatArchive by default.
+// - Password: The password the archive is encrypted with.
+// - ConfirmPlaintext: Whether the user confirmed that a plaintext format may be written.
+type ExportOptions struct {
+	Format           string
+	Password         string
+	ConfirmPlaintext bool
+}
+
+// Export is the file produced by an export.
+//
+// Fields:
+// - Format: The format of the file.
+// - ContentType: The media type of the file.
+// - Data: The file contents.
+type Export struct {
+	Format      string
+	ContentType string
+	Data        []byte
+}
+
+// Vault is the content of an export: all items and folders of a user.
+// It is the payload of an archive and the document written by plaintext JSON exports.
+//
+// Fields:
+// - Username: The user the vault belongs to.
+// - ExportedAt: When the export was made.
+// - Folders: The user's folders; nesting is expressed through parent identifiers.
+// - Items: The user's own items; shared items and attachments are not exported.
+type Vault struct {
+	Username   string             `json:"username"`
+	ExportedAt time.Time          `json:"exported_at"`
+	Folders    []profile.Folder   `json:"folders"`
+	Items      []profile.CardInfo `json:"items"`
+}
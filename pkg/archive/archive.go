@@ -0,0 +1,184 @@
+// Package archive implements the GophKeeper export file format: a self-describing JSON envelope
+// carrying a payload encrypted under a password.
+//
+// The key is derived from the password with Argon2id and the payload is sealed with
+// XChaCha20-Poly1305. The envelope header names the format, its version and every parameter
+// needed to decrypt it, and is authenticated together with the payload, so a file can be
+// restored by any later version that still supports its format version.
+package archive
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// Identifiers written to the envelope header.
+const (
+	Format  = "gophkeeper-export" // Format marks a file as a GophKeeper export.
+	Version = 1                   // Version is the envelope version written by Seal.
+
+	KDFArgon2id             = "argon2id"           // KDFArgon2id names the Argon2id key derivation.
+	CipherXChaCha20Poly1305 = "xchacha20-poly1305" // CipherXChaCha20Poly1305 names the XChaCha20-Poly1305 AEAD.
+)
+
+// Key derivation parameters used by Seal, following the RFC 9106 recommendation for memory-constrained environments.
+const (
+	argonTime    = 3
+	argonMemory  = 64 * 1024 // KiB
+	argonThreads = 4
+	saltSize     = 16
+)
+
+var (
+	// ErrUnsupported indicates that the data is not a GophKeeper export or uses an unsupported version or algorithm.
+	ErrUnsupported = errors.New("unsupported export file")
+
+	// ErrDecrypt indicates that the password is wrong or the file was modified.
+	ErrDecrypt = errors.New("failed to decrypt export file")
+)
+
+// KDF describes how the encryption key is derived from the password.
+//
+// Fields:
+// - Name: The key derivation function, KDFArgon2id.
+// - Salt: The random salt.
+// - Time: The number of passes over the memory.
+// - Memory: The memory size in KiB.
+// - Threads: The degree of parallelism.
+type KDF struct {
+	Name    string `json:"name"`
+	Salt    []byte `json:"salt"`
+	Time    uint32 `json:"time"`
+	Memory  uint32 `json:"memory"`
+	Threads uint8  `json:"threads"`
+}
+
+// Cipher describes how the payload is encrypted.
+//
+// Fields:
+// - Name: The AEAD cipher, CipherXChaCha20Poly1305.
+// - Nonce: The random nonce.
+type Cipher struct {
+	Name  string `json:"name"`
+	Nonce []byte `json:"nonce"`
+}
+
+// Header describes an export file. It is authenticated as associated data of the payload.
+//
+// Fields:
+// - Format: Always Format.
+// - Version: The envelope version.
+// - CreatedAt: When the file was written.
+// - KDF: The key derivation parameters.
+// - Cipher: The cipher parameters.
+type Header struct {
+	Format    string    `json:"format"`
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+	KDF       KDF       `json:"kdf"`
+	Cipher    Cipher    `json:"cipher"`
+}
+
+// envelope is the JSON document written to an export file.
+type envelope struct {
+	Header
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// Seal encrypts a payload under a password and returns the export file.
+func Seal(payload []byte, password string) ([]byte, error) {
+	h := Header{
+		Format:    Format,
+		Version:   Version,
+		CreatedAt: time.Now().UTC().Truncate(time.Second),
+		KDF: KDF{
+			Name:    KDFArgon2id,
+			Salt:    make([]byte, saltSize),
+			Time:    argonTime,
+			Memory:  argonMemory,
+			Threads: argonThreads,
+		},
+		Cipher: Cipher{
+			Name:  CipherXChaCha20Poly1305,
+			Nonce: make([]byte, chacha20poly1305.NonceSizeX),
+		},
+	}
+	if _, err := rand.Read(h.KDF.Salt); err != nil {
+		return nil, fmt.Errorf("failed to generate salt: %w", err)
+	}
+	if _, err := rand.Read(h.Cipher.Nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	aead, err := chacha20poly1305.NewX(deriveKey(h.KDF, password))
+	if err != nil {
+		return nil, err
+	}
+
+	ad, err := json.Marshal(h)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.MarshalIndent(envelope{
+		Header:     h,
+		Ciphertext: aead.Seal(nil, h.Cipher.Nonce, payload, ad),
+	}, "", "  ")
+}
+
+// Open decrypts an export file with a password and returns its header and payload.
+func Open(data []byte, password string) (Header, []byte, error) {
+	var env envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return Header{}, nil, fmt.Errorf("%w: %v", ErrUnsupported, err)
+	}
+
+	h := env.Header
+	switch {
+	case h.Format != Format:
+		return Header{}, nil, fmt.Errorf("%w: not a GophKeeper export", ErrUnsupported)
+	case h.Version != Version:
+		return Header{}, nil, fmt.Errorf("%w: version %d", ErrUnsupported, h.Version)
+	case !supportedKDF(h.KDF):
+		return Header{}, nil, fmt.Errorf("%w: key derivation %q (t=%d, m=%d, p=%d)",
+			ErrUnsupported, h.KDF.Name, h.KDF.Time, h.KDF.Memory, h.KDF.Threads)
+	case h.Cipher.Name != CipherXChaCha20Poly1305 || len(h.Cipher.Nonce) != chacha20poly1305.NonceSizeX:
+		return Header{}, nil, fmt.Errorf("%w: cipher %q", ErrUnsupported, h.Cipher.Name)
+	}
+
+	aead, err := chacha20poly1305.NewX(deriveKey(h.KDF, password))
+	if err != nil {
+		return Header{}, nil, err
+	}
+
+	ad, err := json.Marshal(h)
+	if err != nil {
+		return Header{}, nil, err
+	}
+
+	payload, err := aead.Open(nil, h.Cipher.Nonce, env.Ciphertext, ad)
+	if err != nil {
+		return Header{}, nil, ErrDecrypt
+	}
+
+	return h, payload, nil
+}
+
+// supportedKDF reports whether a file's key derivation uses exactly the parameters Seal writes.
+// The header is only authenticated after the key is derived, so any other cost parameters
+// are rejected up front: a crafted file could otherwise make Open burn arbitrary CPU and memory.
+func supportedKDF(kdf KDF) bool {
+	return kdf.Name == KDFArgon2id && len(kdf.Salt) == saltSize &&
+		kdf.Time == argonTime && kdf.Memory == argonMemory && kdf.Threads == argonThreads
+}
+
+// deriveKey derives the encryption key from a password.
+func deriveKey(kdf KDF, password string) []byte {
+	return argon2.IDKey([]byte(password), kdf.Salt, kdf.Time, kdf.Memory, kdf.Threads, chacha20poly1305.KeySize)
+}
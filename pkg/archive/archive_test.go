@@ -0,0 +1,152 @@
+package archive
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const password = "correct horse battery staple"
+
+// tamper decodes an export file, applies f to its envelope and encodes it again.
+func tamper(t *testing.T, data []byte, f func(env *envelope)) []byte {
+	t.Helper()
+
+	var env envelope
+	require.NoError(t, json.Unmarshal(data, &env))
+	f(&env)
+
+	out, err := json.Marshal(env)
+	require.NoError(t, err)
+	return out
+}
+
+func TestSealOpen(t *testing.T) {
+	payload := []byte(`{"username":"john_doe","items":[]}`)
+
+	data, err := Seal(payload, password)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "john_doe")
+
+	h, got, err := Open(data, password)
+	require.NoError(t, err)
+	assert.Equal(t, payload, got)
+	assert.Equal(t, Format, h.Format)
+	assert.Equal(t, Version, h.Version)
+	assert.Equal(t, KDFArgon2id, h.KDF.Name)
+	assert.Equal(t, CipherXChaCha20Poly1305, h.Cipher.Name)
+
+	// Every file gets its own salt and nonce.
+	again, err := Seal(payload, password)
+	require.NoError(t, err)
+	assert.NotEqual(t, data, again)
+}
+
+func TestOpenWrongPassword(t *testing.T) {
+	data, err := Seal([]byte("payload"), password)
+	require.NoError(t, err)
+
+	_, _, err = Open(data, "wrong password")
+	assert.ErrorIs(t, err, ErrDecrypt)
+}
+
+func TestOpenTampered(t *testing.T) {
+	data, err := Seal([]byte("payload"), password)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		modify func(env *envelope)
+		err    error
+	}{
+		{
+			name:   "Creation time",
+			modify: func(env *envelope) { env.CreatedAt = env.CreatedAt.Add(time.Hour) },
+			err:    ErrDecrypt,
+		},
+		{
+			name:   "Salt",
+			modify: func(env *envelope) { env.KDF.Salt[0] ^= 1 },
+			err:    ErrDecrypt,
+		},
+		{
+			name:   "Nonce",
+			modify: func(env *envelope) { env.Cipher.Nonce[0] ^= 1 },
+			err:    ErrDecrypt,
+		},
+		{
+			name:   "Ciphertext",
+			modify: func(env *envelope) { env.Ciphertext[0] ^= 1 },
+			err:    ErrDecrypt,
+		},
+		{
+			name:   "Truncated ciphertext",
+			modify: func(env *envelope) { env.Ciphertext = env.Ciphertext[:len(env.Ciphertext)-1] },
+			err:    ErrDecrypt,
+		},
+		{
+			name:   "Format",
+			modify: func(env *envelope) { env.Format = "other-export" },
+			err:    ErrUnsupported,
+		},
+		{
+			name:   "Version",
+			modify: func(env *envelope) { env.Version = Version + 1 },
+			err:    ErrUnsupported,
+		},
+		{
+			name:   "Cipher",
+			modify: func(env *envelope) { env.Cipher.Name = "aes-256-gcm" },
+			err:    ErrUnsupported,
+		},
+		{
+			name:   "Nonce size",
+			modify: func(env *envelope) { env.Cipher.Nonce = env.Cipher.Nonce[:12] },
+			err:    ErrUnsupported,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := Open(tamper(t, data, tt.modify), password)
+			assert.ErrorIs(t, err, tt.err)
+		})
+	}
+}
+
+func TestOpenUnsupportedKDF(t *testing.T) {
+	data, err := Seal([]byte("payload"), password)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		modify func(kdf *KDF)
+	}{
+		{name: "Name", modify: func(kdf *KDF) { kdf.Name = "scrypt" }},
+		{name: "Expensive time", modify: func(kdf *KDF) { kdf.Time = 10 }},
+		{name: "Cheap time", modify: func(kdf *KDF) { kdf.Time = 1 }},
+		{name: "Expensive memory", modify: func(kdf *KDF) { kdf.Memory = 1024 * 1024 }},
+		{name: "Cheap memory", modify: func(kdf *KDF) { kdf.Memory = 8 }},
+		{name: "Threads", modify: func(kdf *KDF) { kdf.Threads = 255 }},
+		{name: "No threads", modify: func(kdf *KDF) { kdf.Threads = 0 }},
+		{name: "Short salt", modify: func(kdf *KDF) { kdf.Salt = kdf.Salt[:4] }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// A derivation with these parameters would fail with ErrDecrypt instead.
+			_, _, err := Open(tamper(t, data, func(env *envelope) { tt.modify(&env.KDF) }), password)
+			assert.ErrorIs(t, err, ErrUnsupported)
+		})
+	}
+}
+
+func TestOpenMalformed(t *testing.T) {
+	for _, data := range []string{"", "not json", `{"format": 1}`, `[]`} {
+		_, _, err := Open([]byte(data), password)
+		assert.ErrorIs(t, err, ErrUnsupported, "input %q", data)
+	}
+}
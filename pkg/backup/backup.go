@@ -0,0 +1,664 @@
+// Package backup implements the GophKeeper backup file format: a stream of records, compressed,
+// checksummed and encrypted to the public key of an operator, so that backups can be taken by hosts
+// that cannot read them.
+//
+// A file starts with a JSON header line naming the format, its version and every algorithm, and carrying
+// the data key encrypted to the operator key: an ephemeral X25519 key agreement with the operator key
+// derives, with HKDF-SHA256, the key sealing the data key with ChaCha20-Poly1305. The gzip-compressed
+// records follow, sealed with the data key in chunks with ChaCha20-Poly1305. The nonce of each chunk holds
+// its counter and whether it is the last one, and the header line is the associated data of every chunk,
+// so that neither the header nor the chunks can be modified, reordered, dropped or truncated unnoticed.
+// The last record carries the SHA-256 checksum of all records before it.
+package backup
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/cipher"
+	"crypto/ecdh"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"time"
+
+	"golang.org/x/crypto/chacha20poly1305"
+	"golang.org/x/crypto/hkdf"
+)
+
+// Identifiers written to the file header.
+const (
+	Format  = "gophkeeper-backup" // Format marks a file as a GophKeeper backup.
+	Version = 1                   // Version is the file version written by NewWriter.
+
+	KeyAgreementX25519 = "x25519-hkdf-sha256"       // KeyAgreementX25519 names the encryption of the data key.
+	CipherChaCha20     = "chacha20-poly1305-stream" // CipherChaCha20 names the chunked encryption of the records.
+	CompressionGzip    = "gzip"                     // CompressionGzip names the compression of the records.
+	ChecksumSHA256     = "sha256"                   // ChecksumSHA256 names the checksum of the records.
+)
+
+// Kind is the kind of a record.
+type Kind byte
+
+// Kinds of records. The meaning of the payloads is up to the writer of the file, except for the checksum
+// record, which the Writer appends and the Reader verifies.
+const (
+	KindTable     Kind = 'T' // The start of the rows of a table.
+	KindBlob      Kind = 'B' // The start of the content of a blob.
+	KindData      Kind = 'D' // A part of the rows or content started last.
+	KindEnd       Kind = 'E' // The end of the rows or content started last.
+	KindSequences Kind = 'S' // The values of sequences.
+	kindChecksum  Kind = 'Z' // The checksum of the records before it; always the last record.
+)
+
+const (
+	// chunkSize is the size of the plaintext of all encrypted chunks but the last.
+	chunkSize = 64 << 10
+
+	// dataSize is the size KindData records are buffered to before they are written.
+	dataSize = 32 << 10
+
+	// maxRecordSize bounds the payloads the Reader accepts, so that a crafted file cannot exhaust memory.
+	maxRecordSize = 16 << 20
+
+	// maxHeaderSize bounds the header line the Reader accepts.
+	maxHeaderSize = 64 << 10
+
+	// keyInfo binds the keys derived from the key agreement to this format.
+	keyInfo = "gophkeeper backup v1 data key"
+)
+
+// PEM block types of operator keys.
+const (
+	privateKeyType = "GOPHKEEPER BACKUP PRIVATE KEY"
+	publicKeyType  = "GOPHKEEPER BACKUP PUBLIC KEY"
+)
+
+var (
+	// ErrUnsupported indicates that the data is not a GophKeeper backup or uses an unsupported version or algorithm.
+	ErrUnsupported = errors.New("unsupported backup file")
+
+	// ErrWrongKey indicates that the backup is encrypted to another operator key.
+	ErrWrongKey = errors.New("backup is encrypted to another operator key")
+
+	// ErrCorrupt indicates that the backup is truncated, was modified or fails its checksum.
+	ErrCorrupt = errors.New("backup file is corrupt")
+
+	// ErrInvalidKey indicates that an operator key file cannot be parsed.
+	ErrInvalidKey = errors.New("invalid operator key")
+)
+
+// Recipient describes how the data key is encrypted to the operator key.
+//
+// Fields:
+// - Name: The key agreement, KeyAgreementX25519.
+// - KeyID: The ID of the operator public key, as returned by KeyID.
+// - EphemeralKey: The ephemeral X25519 public key of the key agreement.
+// - WrappedKey: The data key sealed with the derived key.
+type Recipient struct {
+	Name         string `json:"name"`
+	KeyID        string `json:"key_id"`
+	EphemeralKey []byte `json:"ephemeral_key"`
+	WrappedKey   []byte `json:"wrapped_key"`
+}
+
+// Header describes a backup file. It is authenticated as associated data of every chunk.
+//
+// Fields:
+// - Format: Always Format.
+// - Version: The file version.
+// - CreatedAt: When the snapshot of the backup was taken.
+// - SchemaVersion: The version of the database schema the backup was taken from.
+// - Recipient: The data key, encrypted to the operator key.
+// - Cipher: The encryption of the records, CipherChaCha20.
+// - Compression: The compression of the records, CompressionGzip.
+// - Checksum: The checksum of the records, ChecksumSHA256.
+type Header struct {
+	Format        string    `json:"format"`
+	Version       int       `json:"version"`
+	CreatedAt     time.Time `json:"created_at"`
+	SchemaVersion int64     `json:"schema_version"`
+	Recipient     Recipient `json:"recipient"`
+	Cipher        string    `json:"cipher"`
+	Compression   string    `json:"compression"`
+	Checksum      string    `json:"checksum"`
+}
+
+// GenerateKey generates an operator key pair.
+func GenerateKey() (*ecdh.PrivateKey, error) {
+	return ecdh.X25519().GenerateKey(rand.Reader)
+}
+
+// KeyID returns the ID of an operator public key, which backups record to tell which key they are encrypted to.
+func KeyID(pub *ecdh.PublicKey) string {
+	sum := sha256.Sum256(pub.Bytes())
+	return hex.EncodeToString(sum[:8])
+}
+
+// MarshalPrivateKey encodes an operator private key as PEM.
+func MarshalPrivateKey(key *ecdh.PrivateKey) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: privateKeyType, Bytes: key.Bytes()})
+}
+
+// MarshalPublicKey encodes an operator public key as PEM.
+func MarshalPublicKey(pub *ecdh.PublicKey) []byte {
+	return pem.EncodeToMemory(&pem.Block{Type: publicKeyType, Bytes: pub.Bytes()})
+}
+
+// ParsePrivateKey decodes an operator private key encoded by MarshalPrivateKey.
+func ParsePrivateKey(data []byte) (*ecdh.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != privateKeyType {
+		return nil, fmt.Errorf("%w: no %s block", ErrInvalidKey, privateKeyType)
+	}
+	key, err := ecdh.X25519().NewPrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidKey, err)
+	}
+	return key, nil
+}
+
+// ParsePublicKey decodes an operator public key encoded by MarshalPublicKey.
+func ParsePublicKey(data []byte) (*ecdh.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != publicKeyType {
+		return nil, fmt.Errorf("%w: no %s block", ErrInvalidKey, publicKeyType)
+	}
+	pub, err := ecdh.X25519().NewPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidKey, err)
+	}
+	return pub, nil
+}
+
+// Writer writes a backup file. Records are written with WriteRecord and Data; Close completes the file.
+type Writer struct {
+	chunks *chunkWriter
+	gz     *gzip.Writer
+	sum    hash.Hash
+	data   []byte
+}
+
+// NewWriter writes the header of a backup file encrypted to the operator public key recipient
+// and returns a Writer writing its records.
+func NewWriter(w io.Writer, recipient *ecdh.PublicKey, createdAt time.Time, schemaVersion int64) (*Writer, error) {
+	dataKey := make([]byte, chacha20poly1305.KeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+
+	ephemeral, err := GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	shared, err := ephemeral.ECDH(recipient)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidKey, err)
+	}
+	wrapped, err := sealKey(shared, ephemeral.PublicKey(), recipient, dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	header, err := json.Marshal(Header{
+		Format:        Format,
+		Version:       Version,
+		CreatedAt:     createdAt.UTC(),
+		SchemaVersion: schemaVersion,
+		Recipient: Recipient{
+			Name:         KeyAgreementX25519,
+			KeyID:        KeyID(recipient),
+			EphemeralKey: ephemeral.PublicKey().Bytes(),
+			WrappedKey:   wrapped,
+		},
+		Cipher:      CipherChaCha20,
+		Compression: CompressionGzip,
+		Checksum:    ChecksumSHA256,
+	})
+	if err != nil {
+		return nil, err
+	}
+	header = append(header, '\n')
+	if _, err = w.Write(header); err != nil {
+		return nil, err
+	}
+
+	aead, err := chacha20poly1305.New(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	chunks := &chunkWriter{w: w, aead: aead, ad: header}
+	return &Writer{chunks: chunks, gz: gzip.NewWriter(chunks), sum: sha256.New()}, nil
+}
+
+// WriteRecord writes a record.
+func (w *Writer) WriteRecord(kind Kind, payload []byte) error {
+	if err := w.flushData(); err != nil {
+		return err
+	}
+	return w.writeRecord(kind, payload)
+}
+
+// Data returns a writer of KindData records, buffering what is written to it into records of up to 32 KiB.
+// The buffer is flushed by the next WriteRecord or Close.
+func (w *Writer) Data() io.Writer {
+	return dataWriter{w}
+}
+
+// Close writes the checksum record and the last chunk. It does not close the underlying writer.
+func (w *Writer) Close() error {
+	if err := w.flushData(); err != nil {
+		return err
+	}
+	if err := w.writeRecord(kindChecksum, w.sum.Sum(nil)); err != nil {
+		return err
+	}
+	if err := w.gz.Close(); err != nil {
+		return err
+	}
+	return w.chunks.close()
+}
+
+// writeRecord writes a record and adds it to the checksum.
+func (w *Writer) writeRecord(kind Kind, payload []byte) error {
+	var prefix [5]byte
+	prefix[0] = byte(kind)
+	binary.BigEndian.PutUint32(prefix[1:], uint32(len(payload)))
+
+	out := io.MultiWriter(w.gz, w.sum)
+	if kind == kindChecksum {
+		out = w.gz
+	}
+	if _, err := out.Write(prefix[:]); err != nil {
+		return err
+	}
+	_, err := out.Write(payload)
+	return err
+}
+
+// flushData writes the buffered data as a KindData record.
+func (w *Writer) flushData() error {
+	if len(w.data) == 0 {
+		return nil
+	}
+	err := w.writeRecord(KindData, w.data)
+	w.data = w.data[:0]
+	return err
+}
+
+// dataWriter buffers what is written to it into KindData records.
+type dataWriter struct {
+	w *Writer
+}
+
+// Write buffers p, writing a record each time the buffer fills.
+func (d dataWriter) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		free := dataSize - len(d.w.data)
+		if free > len(p) {
+			free = len(p)
+		}
+		d.w.data = append(d.w.data, p[:free]...)
+		p = p[free:]
+		if len(d.w.data) == dataSize {
+			if err := d.w.flushData(); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// Reader reads the records of a backup file.
+type Reader struct {
+	header Header
+	chunks *chunkReader
+	gz     *gzip.Reader
+	sum    hash.Hash
+
+	pending *record
+	done    bool
+}
+
+// record is a record read ahead by Data.
+type record struct {
+	kind    Kind
+	payload []byte
+}
+
+// ReadHeader reads the header of a backup file, which tells which operator key it is encrypted to,
+// without decrypting it.
+func ReadHeader(r io.Reader) (Header, error) {
+	header, _, err := readHeader(bufio.NewReader(r))
+	return header, err
+}
+
+// NewReader reads the header of a backup file and decrypts its data key with the operator private key.
+// It returns ErrWrongKey if the file is encrypted to another key.
+func NewReader(r io.Reader, identity *ecdh.PrivateKey) (*Reader, error) {
+	br := bufio.NewReader(r)
+	header, line, err := readHeader(br)
+	if err != nil {
+		return nil, err
+	}
+
+	if header.Recipient.KeyID != KeyID(identity.PublicKey()) {
+		return nil, fmt.Errorf("%w: it is encrypted to key %s, not %s", ErrWrongKey, header.Recipient.KeyID,
+			KeyID(identity.PublicKey()))
+	}
+	ephemeral, err := ecdh.X25519().NewPublicKey(header.Recipient.EphemeralKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid ephemeral key", ErrCorrupt)
+	}
+	shared, err := identity.ECDH(ephemeral)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid ephemeral key", ErrCorrupt)
+	}
+	dataKey, err := openKey(shared, ephemeral, identity.PublicKey(), header.Recipient.WrappedKey)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrWrongKey, err)
+	}
+
+	aead, err := chacha20poly1305.New(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	chunks := &chunkReader{r: br, aead: aead, ad: line}
+	gz, err := gzip.NewReader(chunks)
+	if err != nil {
+		return nil, corrupt(err)
+	}
+	gz.Multistream(false)
+	return &Reader{header: header, chunks: chunks, gz: gz, sum: sha256.New()}, nil
+}
+
+// Header returns the header of the file.
+func (r *Reader) Header() Header {
+	return r.header
+}
+
+// Next returns the next record. After the last record, it verifies the checksum and that the file ends,
+// returning io.EOF if they are correct and an ErrCorrupt error otherwise.
+func (r *Reader) Next() (Kind, []byte, error) {
+	if r.pending != nil {
+		rec := r.pending
+		r.pending = nil
+		return rec.kind, rec.payload, nil
+	}
+	if r.done {
+		return 0, nil, io.EOF
+	}
+
+	var prefix [5]byte
+	if _, err := io.ReadFull(r.gz, prefix[:]); err != nil {
+		return 0, nil, corrupt(err)
+	}
+	kind := Kind(prefix[0])
+	size := binary.BigEndian.Uint32(prefix[1:])
+	if size > maxRecordSize {
+		return 0, nil, fmt.Errorf("%w: record of %d bytes", ErrCorrupt, size)
+	}
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(r.gz, payload); err != nil {
+		return 0, nil, corrupt(err)
+	}
+
+	if kind != kindChecksum {
+		r.sum.Write(prefix[:])
+		r.sum.Write(payload)
+		return kind, payload, nil
+	}
+
+	r.done = true
+	if !bytes.Equal(payload, r.sum.Sum(nil)) {
+		return 0, nil, fmt.Errorf("%w: checksum mismatch", ErrCorrupt)
+	}
+	// Nothing may follow the checksum, neither in the compressed stream nor in the file.
+	if n, err := r.gz.Read(make([]byte, 1)); n != 0 || err != io.EOF {
+		return 0, nil, fmt.Errorf("%w: data after the checksum", ErrCorrupt)
+	}
+	if err := r.chunks.end(); err != nil {
+		return 0, nil, err
+	}
+	return 0, nil, io.EOF
+}
+
+// Data returns a reader of the payloads of the KindData records that follow, up to the next record
+// of another kind, which Next returns then.
+func (r *Reader) Data() io.Reader {
+	return &dataReader{r: r}
+}
+
+// dataReader reads the payloads of consecutive KindData records.
+type dataReader struct {
+	r   *Reader
+	buf []byte
+	err error
+}
+
+// Read reads from the current KindData record, moving on to the next one when it is exhausted.
+func (d *dataReader) Read(p []byte) (int, error) {
+	for len(d.buf) == 0 {
+		if d.err != nil {
+			return 0, d.err
+		}
+		kind, payload, err := d.r.Next()
+		switch {
+		case err == io.EOF:
+			d.err = io.EOF
+		case err != nil:
+			d.err = err
+		case kind != KindData:
+			d.r.pending = &record{kind: kind, payload: payload}
+			d.err = io.EOF
+		default:
+			d.buf = payload
+		}
+	}
+	n := copy(p, d.buf)
+	d.buf = d.buf[n:]
+	return n, nil
+}
+
+// readHeader reads and checks the header line of a backup file.
+func readHeader(r *bufio.Reader) (Header, []byte, error) {
+	var line []byte
+	for {
+		part, isPrefix, err := r.ReadLine()
+		if err != nil {
+			return Header{}, nil, fmt.Errorf("%w: %w", ErrUnsupported, err)
+		}
+		line = append(line, part...)
+		if len(line) > maxHeaderSize {
+			return Header{}, nil, fmt.Errorf("%w: header too long", ErrUnsupported)
+		}
+		if !isPrefix {
+			break
+		}
+	}
+	line = append(line, '\n')
+
+	var header Header
+	if err := json.Unmarshal(line, &header); err != nil || header.Format != Format {
+		return Header{}, nil, fmt.Errorf("%w: not a GophKeeper backup", ErrUnsupported)
+	}
+	if header.Version != Version || header.Recipient.Name != KeyAgreementX25519 || header.Cipher != CipherChaCha20 ||
+		header.Compression != CompressionGzip || header.Checksum != ChecksumSHA256 {
+		return Header{}, nil, fmt.Errorf("%w: version %d", ErrUnsupported, header.Version)
+	}
+	return header, line, nil
+}
+
+// deriveKey derives the key sealing the data key from the shared secret of the key agreement,
+// bound to both public keys.
+func deriveKey(shared []byte, ephemeral, recipient *ecdh.PublicKey) ([]byte, error) {
+	salt := append(append([]byte(nil), ephemeral.Bytes()...), recipient.Bytes()...)
+	key := make([]byte, chacha20poly1305.KeySize)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, shared, salt, []byte(keyInfo)), key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// sealKey seals the data key with the key derived from the key agreement. The derived key is only used once,
+// so the nonce is zero.
+func sealKey(shared []byte, ephemeral, recipient *ecdh.PublicKey, dataKey []byte) ([]byte, error) {
+	key, err := deriveKey(shared, ephemeral, recipient)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := chacha20poly1305.New(key)
+	if err != nil {
+		return nil, err
+	}
+	return aead.Seal(nil, make([]byte, aead.NonceSize()), dataKey, nil), nil
+}
+
+// openKey opens the data key sealed by sealKey.
+func openKey(shared []byte, ephemeral, recipient *ecdh.PublicKey, wrapped []byte) ([]byte, error) {
+	key, err := deriveKey(shared, ephemeral, recipient)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := chacha20poly1305.New(key)
+	if err != nil {
+		return nil, err
+	}
+	return aead.Open(nil, make([]byte, aead.NonceSize()), wrapped, nil)
+}
+
+// chunkNonce returns the nonce of a chunk: its counter, followed by 1 for the last chunk and 0 for the others.
+func chunkNonce(counter uint64, last bool) []byte {
+	nonce := make([]byte, chacha20poly1305.NonceSize)
+	binary.BigEndian.PutUint64(nonce[3:11], counter)
+	if last {
+		nonce[11] = 1
+	}
+	return nonce
+}
+
+// chunkWriter encrypts what is written to it in chunks. Each chunk is written as a flag byte, 1 for the last
+// chunk, the big-endian uint32 size of the ciphertext and the ciphertext.
+type chunkWriter struct {
+	w       io.Writer
+	aead    cipher.AEAD
+	ad      []byte
+	buf     []byte
+	counter uint64
+}
+
+// Write buffers p, writing a chunk each time the buffer exceeds the chunk size. The last full chunk is kept,
+// so that close can mark it as the last one.
+func (c *chunkWriter) Write(p []byte) (int, error) {
+	c.buf = append(c.buf, p...)
+	for len(c.buf) > chunkSize {
+		if err := c.writeChunk(c.buf[:chunkSize], false); err != nil {
+			return 0, err
+		}
+		c.buf = c.buf[chunkSize:]
+	}
+	return len(p), nil
+}
+
+// close writes the buffered data as the last chunk.
+func (c *chunkWriter) close() error {
+	return c.writeChunk(c.buf, true)
+}
+
+// writeChunk encrypts and writes one chunk.
+func (c *chunkWriter) writeChunk(plaintext []byte, last bool) error {
+	sealed := c.aead.Seal(nil, chunkNonce(c.counter, last), plaintext, c.ad)
+	c.counter++
+
+	var prefix [5]byte
+	if last {
+		prefix[0] = 1
+	}
+	binary.BigEndian.PutUint32(prefix[1:], uint32(len(sealed)))
+	if _, err := c.w.Write(prefix[:]); err != nil {
+		return err
+	}
+	_, err := c.w.Write(sealed)
+	return err
+}
+
+// chunkReader decrypts the chunks written by chunkWriter.
+type chunkReader struct {
+	r       io.Reader
+	aead    cipher.AEAD
+	ad      []byte
+	buf     []byte
+	counter uint64
+	last    bool
+}
+
+// Read reads from the current chunk, decrypting the next one when it is exhausted.
+func (c *chunkReader) Read(p []byte) (int, error) {
+	for len(c.buf) == 0 {
+		if c.last {
+			return 0, io.EOF
+		}
+		if err := c.readChunk(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, c.buf)
+	c.buf = c.buf[n:]
+	return n, nil
+}
+
+// readChunk reads and decrypts the next chunk. A file ending before its last chunk is corrupt.
+func (c *chunkReader) readChunk() error {
+	var prefix [5]byte
+	if _, err := io.ReadFull(c.r, prefix[:]); err != nil {
+		return corrupt(err)
+	}
+	last := prefix[0] == 1
+	size := binary.BigEndian.Uint32(prefix[1:])
+	if prefix[0] > 1 || size > chunkSize+uint32(c.aead.Overhead()) {
+		return fmt.Errorf("%w: invalid chunk", ErrCorrupt)
+	}
+
+	sealed := make([]byte, size)
+	if _, err := io.ReadFull(c.r, sealed); err != nil {
+		return corrupt(err)
+	}
+	plaintext, err := c.aead.Open(nil, chunkNonce(c.counter, last), sealed, c.ad)
+	if err != nil {
+		return fmt.Errorf("%w: chunk %d fails authentication", ErrCorrupt, c.counter)
+	}
+	c.counter++
+	c.buf = plaintext
+	c.last = last
+	return nil
+}
+
+// end checks that the last chunk was read and that nothing follows it.
+func (c *chunkReader) end() error {
+	if !c.last || len(c.buf) != 0 {
+		return fmt.Errorf("%w: data after the checksum", ErrCorrupt)
+	}
+	if n, _ := c.r.Read(make([]byte, 1)); n != 0 {
+		return fmt.Errorf("%w: data after the last chunk", ErrCorrupt)
+	}
+	return nil
+}
+
+// corrupt wraps an error reading the records, reporting a file that ends early as corrupt.
+func corrupt(err error) error {
+	if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return fmt.Errorf("%w: unexpected end of file", ErrCorrupt)
+	}
+	if errors.Is(err, ErrCorrupt) {
+		return err
+	}
+	return fmt.Errorf("%w: %w", ErrCorrupt, err)
+}
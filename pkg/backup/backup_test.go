@@ -0,0 +1,164 @@
+package backup
+
+import (
+	"bytes"
+	"crypto/ecdh"
+	"crypto/rand"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testRecord is a record written to and read from a test backup.
+type testRecord struct {
+	kind    Kind
+	payload []byte
+}
+
+// writeBackup writes records, each followed by data if it is a table, into a backup encrypted to a new key.
+// It returns the backup and the private key.
+func writeBackup(t *testing.T, data []byte, records ...testRecord) ([]byte, *ecdh.PrivateKey) {
+	t.Helper()
+	key, err := GenerateKey()
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, key.PublicKey(), time.Unix(1700000000, 0), 42)
+	require.NoError(t, err)
+	for _, rec := range records {
+		require.NoError(t, w.WriteRecord(rec.kind, rec.payload))
+		if rec.kind == KindTable {
+			_, err = w.Data().Write(data)
+			require.NoError(t, err)
+		}
+	}
+	require.NoError(t, w.Close())
+	return buf.Bytes(), key
+}
+
+func TestRoundTrip(t *testing.T) {
+	// Random data does not compress, so it spans several chunks and data records.
+	data := make([]byte, 3*chunkSize+123)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+
+	file, key := writeBackup(t, data,
+		testRecord{KindTable, []byte(`{"table":"users"}`)},
+		testRecord{KindEnd, []byte(`{"rows":1}`)},
+		testRecord{KindSequences, []byte(`[]`)},
+	)
+
+	r, err := NewReader(bytes.NewReader(file), key)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), r.Header().SchemaVersion)
+	assert.Equal(t, time.Unix(1700000000, 0).UTC(), r.Header().CreatedAt)
+	assert.Equal(t, KeyID(key.PublicKey()), r.Header().Recipient.KeyID)
+
+	kind, payload, err := r.Next()
+	require.NoError(t, err)
+	assert.Equal(t, KindTable, kind)
+	assert.Equal(t, `{"table":"users"}`, string(payload))
+
+	got, err := io.ReadAll(r.Data())
+	require.NoError(t, err)
+	assert.Equal(t, data, got)
+
+	kind, payload, err = r.Next()
+	require.NoError(t, err)
+	assert.Equal(t, KindEnd, kind)
+	assert.Equal(t, `{"rows":1}`, string(payload))
+
+	kind, _, err = r.Next()
+	require.NoError(t, err)
+	assert.Equal(t, KindSequences, kind)
+
+	_, _, err = r.Next()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestHeader(t *testing.T) {
+	file, key := writeBackup(t, nil)
+
+	header, err := ReadHeader(bytes.NewReader(file))
+	require.NoError(t, err)
+	assert.Equal(t, Format, header.Format)
+	assert.Equal(t, Version, header.Version)
+	assert.Equal(t, KeyID(key.PublicKey()), header.Recipient.KeyID)
+
+	_, err = ReadHeader(bytes.NewReader([]byte("not a backup\n")))
+	assert.ErrorIs(t, err, ErrUnsupported)
+}
+
+func TestWrongKey(t *testing.T) {
+	file, _ := writeBackup(t, nil)
+
+	other, err := GenerateKey()
+	require.NoError(t, err)
+	_, err = NewReader(bytes.NewReader(file), other)
+	assert.ErrorIs(t, err, ErrWrongKey)
+}
+
+func TestCorrupt(t *testing.T) {
+	data := make([]byte, 2*chunkSize)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+	file, key := writeBackup(t, data, testRecord{KindTable, []byte(`{}`)})
+	headerEnd := bytes.IndexByte(file, '\n') + 1
+
+	tests := []struct {
+		name   string
+		modify func([]byte) []byte
+	}{
+		{"truncated", func(b []byte) []byte { return b[:len(b)-100] }},
+		{"last chunk dropped", func(b []byte) []byte { return b[:headerEnd+2*(5+chunkSize+16)] }},
+		{"flipped bit", func(b []byte) []byte { b[headerEnd+1000] ^= 1; return b }},
+		{"modified header", func(b []byte) []byte {
+			return bytes.Replace(b, []byte(`"schema_version":42`), []byte(`"schema_version":43`), 1)
+		}},
+		{"trailing data", func(b []byte) []byte { return append(b, 0) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := NewReader(bytes.NewReader(tt.modify(bytes.Clone(file))), key)
+			if err == nil {
+				err = drain(r)
+			}
+			assert.ErrorIs(t, err, ErrCorrupt)
+		})
+	}
+}
+
+func TestKeys(t *testing.T) {
+	key, err := GenerateKey()
+	require.NoError(t, err)
+
+	priv, err := ParsePrivateKey(MarshalPrivateKey(key))
+	require.NoError(t, err)
+	assert.True(t, key.Equal(priv))
+
+	pub, err := ParsePublicKey(MarshalPublicKey(key.PublicKey()))
+	require.NoError(t, err)
+	assert.True(t, key.PublicKey().Equal(pub))
+
+	_, err = ParsePrivateKey(MarshalPublicKey(key.PublicKey()))
+	assert.ErrorIs(t, err, ErrInvalidKey)
+	_, err = ParsePublicKey([]byte("garbage"))
+	assert.ErrorIs(t, err, ErrInvalidKey)
+}
+
+// drain reads all records of a backup, returning nil if it ends correctly.
+func drain(r *Reader) error {
+	for {
+		if _, _, err := r.Next(); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+	}
+}
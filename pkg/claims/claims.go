@@ -62,13 +62,31 @@ func NewClaims(duration time.Duration, role Role) *Claims {
 	}
 }
 
-// Parse validates and parses a JWT token using the provided public key.
+// HeaderKeyID is the token header naming the key that signed the token.
+const HeaderKeyID = "kid"
+
+// ErrUnknownKey is returned when parsing a token signed by a key that is not known.
+var ErrUnknownKey = errors.New("token signed by unknown key")
+
+// KeyFunc returns the public key verifying tokens signed by the key with the given ID,
+// which is empty for tokens without a key ID header.
+type KeyFunc func(kid string) (ed25519.PublicKey, bool)
+
+// Parse validates and parses a JWT token using the public key of the key that signed it.
 //
 // Parameters:
 // - token: The JWT token string to be parsed.
-// - publicKey: The Ed25519 public key for signature verification.
-func (claims *Claims) Parse(token string, publicKey ed25519.PublicKey) error {
+// - keys: Looks up the Ed25519 public key for signature verification by the token's key ID.
+func (claims *Claims) Parse(token string, keys KeyFunc) error {
 	t, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		if _, ok := t.Method.(*jwt.SigningMethodEd25519); !ok {
+			return nil, fmt.Errorf("unexpected signing method %v", t.Header["alg"])
+		}
+		kid, _ := t.Header[HeaderKeyID].(string)
+		publicKey, ok := keys(kid)
+		if !ok {
+			return nil, ErrUnknownKey
+		}
 		return publicKey, nil
 	})
 
@@ -83,24 +101,32 @@ func (claims *Claims) Parse(token string, publicKey ed25519.PublicKey) error {
 }
 
 // Sign generates a JWT token and refresh token using the provided private key.
+// Both tokens name the key in their key ID header. The refresh token carries the session identifier (jti)
+// of the claims, if any.
 //
 // Parameters:
 // - key: The Ed25519 private key for signing the token.
+// - kid: The ID of the key.
 // - sub: The subject (e.g., username) to include in the refresh token.
 // - rTokenExp: The expiration duration for the refresh token.
-func (claims *Claims) Sign(key ed25519.PrivateKey, sub string, rTokenExp time.Duration) (string, string, error) {
+func (claims *Claims) Sign(key ed25519.PrivateKey, kid, sub string, rTokenExp time.Duration) (string, string, error) {
 	token := jwt.NewWithClaims(jwt.SigningMethodEdDSA, claims)
+	token.Header[HeaderKeyID] = kid
 	jwtToken, err := token.SignedString(key)
 	if err != nil {
 		return "", "", err
 	}
 	refreshToken := jwt.New(jwt.SigningMethodEdDSA)
+	refreshToken.Header[HeaderKeyID] = kid
 	rtClaims, ok := refreshToken.Claims.(jwt.MapClaims)
 	if !ok {
 		return "", "", fmt.Errorf("token is not valid JWT format")
 	}
 	rtClaims["sub"] = sub
 	rtClaims["exp"] = time.Now().Add(rTokenExp).Unix()
+	if claims.Id != "" {
+		rtClaims["jti"] = claims.Id
+	}
 	rt, err := refreshToken.SignedString(key)
 	if err != nil {
 		return "", "", err
@@ -151,10 +177,47 @@ func RoleAuthorized(username string) Ability {
 	}
 }
 
+// CardRead creates an ability to read a specific card.
+func CardRead(cardNumber string) Ability {
+	return Ability{
+		Name:  "card:read",
+		Scope: cardNumber,
+	}
+}
+
+// CardWrite creates an ability to edit a specific card.
+func CardWrite(cardNumber string) Ability {
+	return Ability{
+		Name:  "card:write",
+		Scope: cardNumber,
+	}
+}
+
+// CardShare creates an ability to grant and revoke access to a specific card.
+func CardShare(cardNumber string) Ability {
+	return Ability{
+		Name:  "card:share",
+		Scope: cardNumber,
+	}
+}
+
+// OrgRole creates an ability for holding a role within a specific organization.
+func OrgRole(role, org string) Ability {
+	return Ability{
+		Name:  "org:" + role,
+		Scope: org,
+	}
+}
+
 // Includes checks whether the claims include the specified abilities.
 func (claims *Claims) Includes(abilities ...Ability) bool {
+	return claims.Abilities.Includes(abilities...)
+}
+
+// Includes checks whether the abilities include all of the specified abilities.
+func (a Abilities) Includes(abilities ...Ability) bool {
 	for _, role := range abilities {
-		grantedScopes := set(claims.Abilities[role.Name])
+		grantedScopes := set(a[role.Name])
 		if _, ok := grantedScopes[role.Scope]; !ok {
 			return false
 		}
@@ -0,0 +1,145 @@
+package importer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+)
+
+// Bitwarden item types.
+const (
+	bitwardenLogin    = 1
+	bitwardenNote     = 2
+	bitwardenCard     = 3
+	bitwardenIdentity = 4
+)
+
+// bitwardenExport is an unencrypted Bitwarden JSON export.
+type bitwardenExport struct {
+	Encrypted bool `json:"encrypted"`
+	Folders   []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"folders"`
+	Items []bitwardenItem `json:"items"`
+}
+
+// bitwardenItem is a single vault item of a Bitwarden export.
+type bitwardenItem struct {
+	Type     int     `json:"type"`
+	Name     string  `json:"name"`
+	Notes    *string `json:"notes"`
+	FolderID *string `json:"folderId"`
+	Login    *struct {
+		URIs []struct {
+			URI string `json:"uri"`
+		} `json:"uris"`
+		Username *string `json:"username"`
+		Password *string `json:"password"`
+	} `json:"login"`
+	Card *struct {
+		CardholderName *string `json:"cardholderName"`
+		Number         *string `json:"number"`
+		ExpMonth       *string `json:"expMonth"`
+		ExpYear        *string `json:"expYear"`
+		Code           *string `json:"code"`
+	} `json:"card"`
+	Identity map[string]*string `json:"identity"`
+}
+
+// identityFields lists the Bitwarden identity fields kept in the item metadata, in order.
+var identityFields = []string{
+	"title", "firstName", "middleName", "lastName", "company", "email", "phone",
+	"address1", "address2", "address3", "city", "state", "postalCode", "country",
+	"ssn", "passportNumber", "licenseNumber", "username",
+}
+
+// parseBitwarden reads an unencrypted Bitwarden JSON export.
+func parseBitwarden(r io.Reader) ([]Item, error) {
+	var export bitwardenExport
+	if err := json.NewDecoder(r).Decode(&export); err != nil {
+		return nil, err
+	}
+	if export.Encrypted {
+		return nil, errors.New("encrypted Bitwarden exports are not supported")
+	}
+
+	folders := make(map[string][]string, len(export.Folders))
+	for _, f := range export.Folders {
+		folders[f.ID] = splitPath(f.Name)
+	}
+
+	items := make([]Item, 0, len(export.Items))
+	for _, it := range export.Items {
+		notes := str(it.Notes)
+
+		var card profile.CardInfo
+		switch it.Type {
+		case bitwardenLogin:
+			var url, username, password string
+			if it.Login != nil {
+				if len(it.Login.URIs) > 0 {
+					url = it.Login.URIs[0].URI
+				}
+				username, password = str(it.Login.Username), str(it.Login.Password)
+			}
+			card = login(it.Name, url, username, password, notes)
+		case bitwardenCard:
+			if it.Card == nil {
+				return nil, fmt.Errorf("card item %q has no card data", it.Name)
+			}
+			card = paymentCard(it.Name, str(it.Card.CardholderName), str(it.Card.Number),
+				str(it.Card.ExpMonth), str(it.Card.ExpYear), str(it.Card.Code), notes)
+		case bitwardenIdentity:
+			card = identity(it.Name, it.Identity, notes)
+		case bitwardenNote:
+			card = profile.CardInfo{Name: it.Name, Metadata: notes, Type: profile.TypeNote}
+		default:
+			return nil, fmt.Errorf("item %q has unknown type %d", it.Name, it.Type)
+		}
+
+		item := Item{Card: card}
+		if it.FolderID != nil {
+			item.Folder = folders[*it.FolderID]
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// identity builds an identity item holding the identity fields as "field: value" lines of metadata.
+func identity(name string, fields map[string]*string, notes string) profile.CardInfo {
+	var lines []string
+	for _, f := range identityFields {
+		if v := str(fields[f]); v != "" {
+			lines = append(lines, f+": "+v)
+		}
+	}
+	if notes != "" {
+		lines = append(lines, notes)
+	}
+
+	holder := strings.Join(strings.Fields(strings.Join([]string{
+		str(fields["firstName"]), str(fields["middleName"]), str(fields["lastName"]),
+	}, " ")), " ")
+
+	return profile.CardInfo{
+		Name:       strings.TrimSpace(name),
+		CardHolder: holder,
+		Metadata:   strings.Join(lines, "\n"),
+		Type:       profile.TypeIdentity,
+	}
+}
+
+// str dereferences an optional JSON string.
+func str(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
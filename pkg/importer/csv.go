@@ -0,0 +1,128 @@
+package importer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+)
+
+// csvColumns names the header aliases of each field of a CSV export, matched case-insensitively.
+type csvColumns struct {
+	title    []string
+	url      []string
+	username []string
+	password []string
+	notes    []string
+	group    []string
+
+	// rootGroup tells that group paths start with the name of the database itself.
+	rootGroup bool
+}
+
+// Column layouts of the supported CSV exports.
+var (
+	// keePassColumns covers the KeePassXC and KeePass 2 CSV exports.
+	keePassColumns = csvColumns{
+		title:    []string{"title", "account"},
+		url:      []string{"url", "web site"},
+		username: []string{"username", "login name", "user name"},
+		password: []string{"password"},
+		notes:    []string{"notes", "comments"},
+		group:    []string{"group"},
+
+		rootGroup: true,
+	}
+
+	// onePasswordColumns covers the 1Password 7 and 8 CSV exports.
+	onePasswordColumns = csvColumns{
+		title:    []string{"title", "name"},
+		url:      []string{"url", "website", "login url"},
+		username: []string{"username", "login username"},
+		password: []string{"password", "login password"},
+		notes:    []string{"notes", "notesplain"},
+	}
+
+	// chromeColumns covers the Chrome password manager CSV export.
+	chromeColumns = csvColumns{
+		title:    []string{"name"},
+		url:      []string{"url"},
+		username: []string{"username"},
+		password: []string{"password"},
+		notes:    []string{"note", "notes"},
+	}
+)
+
+// utf8BOM is the byte order mark some exporters write at the start of CSV files.
+var utf8BOM = []byte("\ufeff")
+
+// parseCSV reads a CSV export with a header row, mapping each row to a login or note.
+func parseCSV(r io.Reader, columns csvColumns) ([]Item, error) {
+	// A byte order mark would be read as part of the first header field and break a quoted one.
+	br := bufio.NewReader(r)
+	if prefix, _ := br.Peek(len(utf8BOM)); bytes.Equal(prefix, utf8BOM) {
+		_, _ = br.Discard(len(utf8BOM))
+	}
+
+	reader := csv.NewReader(br)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	index := make(map[string]int, len(header))
+	for i, h := range header {
+		h = strings.ToLower(strings.TrimSpace(h))
+		if _, ok := index[h]; !ok {
+			index[h] = i
+		}
+	}
+
+	column := func(aliases []string) int {
+		for _, a := range aliases {
+			if i, ok := index[a]; ok {
+				return i
+			}
+		}
+		return -1
+	}
+	title, url, username := column(columns.title), column(columns.url), column(columns.username)
+	password, notes, group := column(columns.password), column(columns.notes), column(columns.group)
+	if password < 0 || (url < 0 && username < 0) {
+		return nil, errors.New("missing password, url or username column")
+	}
+
+	var items []Item
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		value := func(i int) string {
+			if i < 0 || i >= len(record) {
+				return ""
+			}
+			return record[i]
+		}
+
+		folder := splitPath(value(group))
+		if columns.rootGroup && len(folder) > 0 {
+			folder = folder[1:]
+		}
+
+		items = append(items, Item{
+			Card:   login(value(title), value(url), value(username), value(password), value(notes)),
+			Folder: folder,
+		})
+	}
+
+	return items, nil
+}
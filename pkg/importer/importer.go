@@ -0,0 +1,150 @@
+// Package importer parses vault exports of other password managers into GophKeeper items.
+//
+// Supported formats are Bitwarden JSON, KeePass XML and CSV, 1Password CSV and Chrome CSV.
+// Payment cards become items of type card; logins, secure notes and identities become
+// items of the matching type. Items other than cards are returned without a card number,
+// the caller assigns them an identifier when storing them.
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+)
+
+// Supported import formats.
+const (
+	FormatBitwarden  = "bitwarden"
+	FormatKeePassXML = "keepass-xml"
+	FormatKeePassCSV = "keepass-csv"
+	Format1Password  = "1password"
+	FormatChrome     = "chrome"
+)
+
+// Formats lists the supported import formats.
+var Formats = []string{FormatBitwarden, FormatKeePassXML, FormatKeePassCSV, Format1Password, FormatChrome}
+
+var (
+	// ErrUnknownFormat indicates that the requested import format is not supported.
+	ErrUnknownFormat = errors.New("unknown import format")
+
+	// ErrInvalidFile indicates that the file could not be parsed in the requested format.
+	ErrInvalidFile = errors.New("malformed export")
+)
+
+// Item is a single entry read from an export.
+//
+// Fields:
+// - Card: The item, without username and, unless it is a payment card, without card number.
+// - Folder: The folder path of the item in the source vault, outermost folder first.
+type Item struct {
+	Card   profile.CardInfo
+	Folder []string
+}
+
+// Parse reads all items of an export in the given format.
+func Parse(format string, r io.Reader) ([]Item, error) {
+	var (
+		items []Item
+		err   error
+	)
+
+	switch format {
+	case FormatBitwarden:
+		items, err = parseBitwarden(r)
+	case FormatKeePassXML:
+		items, err = parseKeePassXML(r)
+	case FormatKeePassCSV:
+		items, err = parseCSV(r, keePassColumns)
+	case Format1Password:
+		items, err = parseCSV(r, onePasswordColumns)
+	case FormatChrome:
+		items, err = parseCSV(r, chromeColumns)
+	default:
+		return nil, ErrUnknownFormat
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFile, err)
+	}
+
+	return items, nil
+}
+
+// login builds a login item, or a note if the entry carries no credentials.
+func login(name, url, username, password, notes string) profile.CardInfo {
+	card := profile.CardInfo{
+		Name:     strings.TrimSpace(name),
+		URL:      strings.TrimSpace(url),
+		Login:    username,
+		Password: password,
+		Metadata: notes,
+		Type:     profile.TypeLogin,
+	}
+	if card.URL == "" && card.Login == "" && card.Password == "" {
+		card.Type = profile.TypeNote
+	}
+	if card.Name == "" {
+		card.Name = card.URL
+	}
+	return card
+}
+
+// paymentCard builds a card item, or a note if the entry has no usable card number.
+func paymentCard(name, holder, number, expMonth, expYear, cvv, notes string) profile.CardInfo {
+	number = digits(number)
+	if number == "" {
+		return profile.CardInfo{Name: name, Metadata: notes, Type: profile.TypeNote}
+	}
+
+	return profile.CardInfo{
+		Name:           strings.TrimSpace(name),
+		CardNumber:     number,
+		CardHolder:     strings.TrimSpace(holder),
+		ExpirationDate: expiration(expMonth, expYear),
+		Cvv:            strings.TrimSpace(cvv),
+		Metadata:       notes,
+		Type:           profile.TypeCard,
+	}
+}
+
+// digits strips everything but digits from a card number.
+func digits(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, s)
+}
+
+// expiration converts a card expiry month and year to the first day of that month.
+// Two-digit years are taken as 20xx. An unparsable expiry yields the zero time.
+func expiration(month, year string) time.Time {
+	var m, y int
+	if _, err := fmt.Sscan(strings.TrimSpace(month), &m); err != nil || m < 1 || m > 12 {
+		return time.Time{}
+	}
+	if _, err := fmt.Sscan(strings.TrimSpace(year), &y); err != nil || y < 0 {
+		return time.Time{}
+	}
+	if y < 100 {
+		y += 2000
+	}
+	return time.Date(y, time.Month(m), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// splitPath splits a slash-separated folder path, dropping empty segments.
+func splitPath(path string) []string {
+	var folders []string
+	for _, f := range strings.Split(path, "/") {
+		if f = strings.TrimSpace(f); f != "" {
+			folders = append(folders, f)
+		}
+	}
+	return folders
+}
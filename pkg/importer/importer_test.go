@@ -0,0 +1,199 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const bitwardenExportJSON = `{
+  "encrypted": false,
+  "folders": [{"id": "f1", "name": "Finance/Banks"}],
+  "items": [
+    {
+      "type": 1, "name": "Bank", "folderId": "f1", "notes": "main account",
+      "login": {"uris": [{"uri": "https://bank.example.com"}], "username": "john", "password": "secret"}
+    },
+    {
+      "type": 3, "name": "Travel card", "folderId": null,
+      "card": {"cardholderName": "John Doe", "number": "4111 1111 1111 1111", "expMonth": "7", "expYear": "27", "code": "123"}
+    },
+    {
+      "type": 3, "name": "Gift card",
+      "card": {"cardholderName": "John Doe", "number": "n/a"}
+    },
+    {
+      "type": 4, "name": "Passport",
+      "identity": {"firstName": "John", "middleName": null, "lastName": "Doe", "passportNumber": "X123"}
+    },
+    {"type": 2, "name": "Wifi", "notes": "guest password"}
+  ]
+}`
+
+const keePassExportXML = `<?xml version="1.0" encoding="utf-8"?>
+<KeePassFile>
+  <Meta><RecycleBinUUID>bin</RecycleBinUUID></Meta>
+  <Root>
+    <Group>
+      <UUID>root</UUID><Name>Database</Name>
+      <Entry>
+        <String><Key>Title</Key><Value>Mail</Value></String>
+        <String><Key>URL</Key><Value>https://mail.example.com</Value></String>
+        <String><Key>UserName</Key><Value>john</Value></String>
+        <String><Key>Password</Key><Value>secret</Value></String>
+      </Entry>
+      <Group>
+        <UUID>work</UUID><Name>Work</Name>
+        <Group>
+          <UUID>vpn</UUID><Name>VPN</Name>
+          <Entry>
+            <String><Key>Title</Key><Value>Gateway</Value></String>
+            <String><Key>UserName</Key><Value>jdoe</Value></String>
+            <String><Key>Password</Key><Value>hunter2</Value></String>
+            <String><Key>Notes</Key><Value>office only</Value></String>
+          </Entry>
+        </Group>
+      </Group>
+      <Group>
+        <UUID>bin</UUID><Name>Recycle Bin</Name>
+        <Entry><String><Key>Title</Key><Value>Deleted</Value></String></Entry>
+      </Group>
+    </Group>
+  </Root>
+</KeePassFile>`
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		input  string
+		want   []Item
+	}{
+		{
+			name:   "Bitwarden",
+			format: FormatBitwarden,
+			input:  bitwardenExportJSON,
+			want: []Item{
+				{
+					Card: profile.CardInfo{Name: "Bank", URL: "https://bank.example.com", Login: "john",
+						Password: "secret", Metadata: "main account", Type: profile.TypeLogin},
+					Folder: []string{"Finance", "Banks"},
+				},
+				{Card: profile.CardInfo{Name: "Travel card", CardNumber: "4111111111111111", CardHolder: "John Doe",
+					ExpirationDate: time.Date(2027, time.July, 1, 0, 0, 0, 0, time.UTC), Cvv: "123", Type: profile.TypeCard}},
+				{Card: profile.CardInfo{Name: "Gift card", Type: profile.TypeNote}},
+				{Card: profile.CardInfo{Name: "Passport", CardHolder: "John Doe",
+					Metadata: "firstName: John\nlastName: Doe\npassportNumber: X123", Type: profile.TypeIdentity}},
+				{Card: profile.CardInfo{Name: "Wifi", Metadata: "guest password", Type: profile.TypeNote}},
+			},
+		},
+		{
+			name:   "KeePass XML",
+			format: FormatKeePassXML,
+			input:  keePassExportXML,
+			want: []Item{
+				{Card: profile.CardInfo{Name: "Mail", URL: "https://mail.example.com", Login: "john",
+					Password: "secret", Type: profile.TypeLogin}},
+				{
+					Card: profile.CardInfo{Name: "Gateway", Login: "jdoe", Password: "hunter2",
+						Metadata: "office only", Type: profile.TypeLogin},
+					Folder: []string{"Work", "VPN"},
+				},
+			},
+		},
+		{
+			name:   "KeePass CSV",
+			format: FormatKeePassCSV,
+			input: "\ufeff\"Group\",\"Title\",\"Username\",\"Password\",\"URL\",\"Notes\"\n" +
+				"\"Database/Work\",\"Mail\",\"john\",\"secret\",\"https://mail.example.com\",\"line one\nline two\"\n" +
+				"\"Database\",\"Memo\",\"\",\"\",\"\",\"remember\"\n",
+			want: []Item{
+				{
+					Card: profile.CardInfo{Name: "Mail", URL: "https://mail.example.com", Login: "john",
+						Password: "secret", Metadata: "line one\nline two", Type: profile.TypeLogin},
+					Folder: []string{"Work"},
+				},
+				{Card: profile.CardInfo{Name: "Memo", Metadata: "remember", Type: profile.TypeNote}, Folder: []string{}},
+			},
+		},
+		{
+			name:   "1Password",
+			format: Format1Password,
+			input: "Title,Website,Username,Password,Notes\n" +
+				"Bank,https://bank.example.com,john,secret,\n",
+			want: []Item{
+				{Card: profile.CardInfo{Name: "Bank", URL: "https://bank.example.com", Login: "john",
+					Password: "secret", Type: profile.TypeLogin}},
+			},
+		},
+		{
+			name:   "Chrome",
+			format: FormatChrome,
+			input: "name,url,username,password,note\n" +
+				",https://shop.example.com,john,secret,\n",
+			want: []Item{
+				{Card: profile.CardInfo{Name: "https://shop.example.com", URL: "https://shop.example.com",
+					Login: "john", Password: "secret", Type: profile.TypeLogin}},
+			},
+		},
+		{
+			name:   "Short CSV row",
+			format: FormatChrome,
+			input: "name,url,username,password,note\n" +
+				"Shop,https://shop.example.com\n",
+			want: []Item{
+				{Card: profile.CardInfo{Name: "Shop", URL: "https://shop.example.com", Type: profile.TypeLogin}},
+			},
+		},
+		{
+			name:   "CSV header only",
+			format: Format1Password,
+			input:  "title,url,username,password\n",
+			want:   nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			items, err := Parse(tt.format, strings.NewReader(tt.input))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, items)
+		})
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		input  string
+	}{
+		{name: "Bitwarden malformed JSON", format: FormatBitwarden, input: `{"items": [`},
+		{name: "Bitwarden encrypted export", format: FormatBitwarden, input: `{"encrypted": true, "items": []}`},
+		{name: "Bitwarden unknown item type", format: FormatBitwarden, input: `{"items": [{"type": 9, "name": "x"}]}`},
+		{name: "Bitwarden card without card data", format: FormatBitwarden, input: `{"items": [{"type": 3, "name": "x"}]}`},
+		{name: "KeePass malformed XML", format: FormatKeePassXML, input: `<KeePassFile><Root><Group>`},
+		{name: "KeePass without root group", format: FormatKeePassXML, input: `<KeePassFile><Root></Root></KeePassFile>`},
+		{name: "Empty CSV", format: FormatKeePassCSV, input: ""},
+		{name: "CSV header without password", format: FormatChrome, input: "name,url,username\nShop,https://shop.example.com,john\n"},
+		{name: "CSV header without url or username", format: Format1Password, input: "title,password\nBank,secret\n"},
+		{name: "CSV row with bare quote", format: FormatChrome, input: "name,url,username,password\nSh\"op,https://shop.example.com,john,secret\n"},
+		{name: "CSV row with unterminated quote", format: FormatKeePassCSV, input: "title,username,password\n\"Mail,john,secret\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.format, strings.NewReader(tt.input))
+			assert.ErrorIs(t, err, ErrInvalidFile)
+		})
+	}
+}
+
+func TestParseUnknownFormat(t *testing.T) {
+	_, err := Parse("lastpass", strings.NewReader("name,url,username,password\n"))
+	assert.ErrorIs(t, err, ErrUnknownFormat)
+}
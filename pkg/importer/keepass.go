@@ -0,0 +1,78 @@
+package importer
+
+import (
+	"encoding/xml"
+	"errors"
+	"io"
+)
+
+// keePassFile is a KeePass 2 XML export.
+type keePassFile struct {
+	Meta struct {
+		RecycleBinUUID string `xml:"RecycleBinUUID"`
+	} `xml:"Meta"`
+	Root struct {
+		Groups []keePassGroup `xml:"Group"`
+	} `xml:"Root"`
+}
+
+// keePassGroup is a group of a KeePass database, possibly containing subgroups.
+type keePassGroup struct {
+	UUID    string         `xml:"UUID"`
+	Name    string         `xml:"Name"`
+	Entries []keePassEntry `xml:"Entry"`
+	Groups  []keePassGroup `xml:"Group"`
+}
+
+// keePassEntry is a single entry of a KeePass database.
+type keePassEntry struct {
+	Strings []struct {
+		Key   string `xml:"Key"`
+		Value string `xml:"Value"`
+	} `xml:"String"`
+}
+
+// field returns the value of one of the entry's standard or custom string fields.
+func (e keePassEntry) field(key string) string {
+	for _, s := range e.Strings {
+		if s.Key == key {
+			return s.Value
+		}
+	}
+	return ""
+}
+
+// parseKeePassXML reads a KeePass 2 XML export. The root group stands for the database
+// itself, so folder paths start below it; entries in the recycle bin are skipped.
+func parseKeePassXML(r io.Reader) ([]Item, error) {
+	var file keePassFile
+	if err := xml.NewDecoder(r).Decode(&file); err != nil {
+		return nil, err
+	}
+	if len(file.Root.Groups) == 0 {
+		return nil, errors.New("no root group")
+	}
+
+	var items []Item
+	var walk func(g keePassGroup, path []string)
+	walk = func(g keePassGroup, path []string) {
+		if g.UUID != "" && g.UUID == file.Meta.RecycleBinUUID {
+			return
+		}
+		for _, e := range g.Entries {
+			items = append(items, Item{
+				Card:   login(e.field("Title"), e.field("URL"), e.field("UserName"), e.field("Password"), e.field("Notes")),
+				Folder: path,
+			})
+		}
+		for _, sub := range g.Groups {
+			walk(sub, append(path[:len(path):len(path)], sub.Name))
+		}
+	}
+
+	for _, root := range file.Root.Groups {
+		walk(root, nil)
+	}
+
+	return items, nil
+}
@@ -0,0 +1,215 @@
+// Package keyring manages the Ed25519 keys signing access tokens, so that the signing key can be
+// rotated without signing everyone out.
+//
+// A keyring holds one active key, which signs new tokens, and any number of other keys, which only
+// verify tokens. A key that is generated but not yet promoted already verifies tokens, so that every
+// server knows it before any of them signs with it. A key replaced by another is retired and keeps
+// verifying the tokens it signed until they expire. Tokens name the key that signed them in their
+// "kid" header, and the public keys are published as a JSON Web Key Set.
+package keyring
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+)
+
+var (
+	// ErrNoActiveKey is returned when signing with a keyring without an active key.
+	ErrNoActiveKey = errors.New("keyring has no active key")
+
+	// ErrKeyNotFound is returned when promoting a key that is not in the keyring.
+	ErrKeyNotFound = errors.New("key not found in keyring")
+)
+
+// Key is a signing key of the keyring.
+//
+// Fields:
+// - ID: The key identifier, set as the "kid" header of the tokens the key signs.
+// - PrivateKey: The Ed25519 private key.
+// - CreatedAt: When the key was generated or imported.
+// - RetiredAt: When the key stopped signing tokens, if it did.
+type Key struct {
+	ID         string             `json:"kid"`
+	PrivateKey ed25519.PrivateKey `json:"private_key"`
+	CreatedAt  time.Time          `json:"created_at"`
+	RetiredAt  *time.Time         `json:"retired_at,omitempty"`
+}
+
+// Public returns the public key of k.
+func (k Key) Public() ed25519.PublicKey {
+	return k.PrivateKey.Public().(ed25519.PublicKey)
+}
+
+// NewKey wraps an Ed25519 private key as a key of a keyring, identified by the thumbprint of its public key.
+func NewKey(priv ed25519.PrivateKey, now time.Time) Key {
+	k := Key{PrivateKey: priv, CreatedAt: now.UTC()}
+	k.ID = thumbprint(k.Public())
+	return k
+}
+
+// Generate creates a new key.
+func Generate(now time.Time) (Key, error) {
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return Key{}, fmt.Errorf("failed to generate key: %w", err)
+	}
+	return NewKey(priv, now), nil
+}
+
+// thumbprint returns the JWK thumbprint (RFC 7638) of an Ed25519 public key, which identifies
+// the key the same way wherever it is computed.
+func thumbprint(pub ed25519.PublicKey) string {
+	// The members are required to be in lexicographic order, without whitespace.
+	canonical := fmt.Sprintf(`{"crv":"Ed25519","kty":"OKP","x":"%s"}`, base64.RawURLEncoding.EncodeToString(pub))
+	sum := sha256.Sum256([]byte(canonical))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// Keyring holds the signing keys.
+//
+// Fields:
+// - Active: The ID of the key signing new tokens.
+// - Keys: All keys, in the order they were added.
+type Keyring struct {
+	Active string `json:"active"`
+	Keys   []Key  `json:"keys"`
+}
+
+// Single returns a keyring holding only the given key, active.
+func Single(k Key) *Keyring {
+	return &Keyring{Active: k.ID, Keys: []Key{k}}
+}
+
+// Add adds a key to the keyring without making it active. Adding a key already in the keyring does nothing.
+func (r *Keyring) Add(k Key) {
+	if _, ok := r.key(k.ID); !ok {
+		r.Keys = append(r.Keys, k)
+	}
+}
+
+// Promote makes the key with the given ID active, retiring the previously active key.
+func (r *Keyring) Promote(kid string, now time.Time) error {
+	i, ok := r.key(kid)
+	if !ok {
+		return ErrKeyNotFound
+	}
+	if r.Active == kid {
+		return nil
+	}
+
+	if prev, ok := r.key(r.Active); ok {
+		retiredAt := now.UTC()
+		r.Keys[prev].RetiredAt = &retiredAt
+	}
+	r.Keys[i].RetiredAt = nil
+	r.Active = kid
+	return nil
+}
+
+// Prune removes the keys retired more than retention ago, which no unexpired token was signed with
+// if retention is the lifetime of the longest-lived tokens. It returns the removed keys.
+func (r *Keyring) Prune(now time.Time, retention time.Duration) (removed []Key) {
+	r.Keys = slices.DeleteFunc(r.Keys, func(k Key) bool {
+		if k.RetiredAt != nil && now.Sub(*k.RetiredAt) > retention {
+			removed = append(removed, k)
+			return true
+		}
+		return false
+	})
+	return removed
+}
+
+// Signing returns the active key.
+func (r *Keyring) Signing() (Key, error) {
+	i, ok := r.key(r.Active)
+	if !ok {
+		return Key{}, ErrNoActiveKey
+	}
+	return r.Keys[i], nil
+}
+
+// Verifying returns the public key verifying tokens signed by the key with the given ID.
+// Tokens without a key ID predate the keyring and are verified with the active key.
+func (r *Keyring) Verifying(kid string) (ed25519.PublicKey, bool) {
+	if kid == "" {
+		kid = r.Active
+	}
+	i, ok := r.key(kid)
+	if !ok {
+		return nil, false
+	}
+	return r.Keys[i].Public(), true
+}
+
+// JWKS returns the public keys of the keyring as a JSON Web Key Set.
+func (r *Keyring) JWKS() JWKS {
+	set := JWKS{Keys: make([]JWK, 0, len(r.Keys))}
+	for _, k := range r.Keys {
+		set.Keys = append(set.Keys, JWK{
+			Kty: "OKP",
+			Crv: "Ed25519",
+			X:   base64.RawURLEncoding.EncodeToString(k.Public()),
+			Kid: k.ID,
+			Use: "sig",
+			Alg: "EdDSA",
+		})
+	}
+	return set
+}
+
+// key returns the index of the key with the given ID.
+func (r *Keyring) key(kid string) (int, bool) {
+	i := slices.IndexFunc(r.Keys, func(k Key) bool { return k.ID == kid })
+	return i, i >= 0
+}
+
+// JWK is a public key in JSON Web Key form (RFC 8037).
+type JWK struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+}
+
+// JWKS is a JSON Web Key Set.
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// Marshal encodes the keyring in the form it is stored in.
+func (r *Keyring) Marshal() ([]byte, error) {
+	return json.MarshalIndent(r, "", "  ")
+}
+
+// Unmarshal decodes a stored keyring and checks that its keys are consistent.
+func Unmarshal(data []byte) (*Keyring, error) {
+	var r Keyring
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("failed to decode keyring: %w", err)
+	}
+
+	for _, k := range r.Keys {
+		if len(k.PrivateKey) != ed25519.PrivateKeySize {
+			return nil, fmt.Errorf("key %s: bad private key length: %d", k.ID, len(k.PrivateKey))
+		}
+		if !ed25519.NewKeyFromSeed(k.PrivateKey.Seed()).Equal(k.PrivateKey) {
+			return nil, fmt.Errorf("key %s: the public key does not match the seed", k.ID)
+		}
+		if k.ID != thumbprint(k.Public()) {
+			return nil, fmt.Errorf("key %s: the id does not match the key", k.ID)
+		}
+	}
+	if _, err := r.Signing(); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
@@ -0,0 +1,187 @@
+package keyring
+
+import (
+	"crypto/ed25519"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var t0 = time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)
+
+// testKey derives a reproducible key from a seed byte.
+func testKey(seed byte, now time.Time) Key {
+	s := make([]byte, ed25519.SeedSize)
+	s[0] = seed
+	return NewKey(ed25519.NewKeyFromSeed(s), now)
+}
+
+func TestPromote(t *testing.T) {
+	first, second := testKey(1, t0), testKey(2, t0.Add(time.Hour))
+
+	r := Single(first)
+	r.Add(second)
+	r.Add(second)
+	require.Len(t, r.Keys, 2)
+
+	// An added key verifies tokens before it signs any.
+	signing, err := r.Signing()
+	require.NoError(t, err)
+	assert.Equal(t, first.ID, signing.ID)
+	pub, ok := r.Verifying(second.ID)
+	require.True(t, ok)
+	assert.Equal(t, second.Public(), pub)
+
+	promotedAt := t0.Add(2 * time.Hour)
+	require.NoError(t, r.Promote(second.ID, promotedAt))
+
+	signing, err = r.Signing()
+	require.NoError(t, err)
+	assert.Equal(t, second.ID, signing.ID)
+	assert.Nil(t, r.Keys[1].RetiredAt)
+	require.NotNil(t, r.Keys[0].RetiredAt)
+	assert.Equal(t, promotedAt, *r.Keys[0].RetiredAt)
+
+	// The retired key keeps verifying the tokens it signed.
+	pub, ok = r.Verifying(first.ID)
+	require.True(t, ok)
+	assert.Equal(t, first.Public(), pub)
+
+	// Promoting the active key again changes nothing.
+	require.NoError(t, r.Promote(second.ID, promotedAt.Add(time.Hour)))
+	assert.Equal(t, promotedAt, *r.Keys[0].RetiredAt)
+
+	// Promoting a retired key reinstates it.
+	require.NoError(t, r.Promote(first.ID, promotedAt.Add(time.Hour)))
+	assert.Nil(t, r.Keys[0].RetiredAt)
+	require.NotNil(t, r.Keys[1].RetiredAt)
+
+	assert.ErrorIs(t, r.Promote("unknown", t0), ErrKeyNotFound)
+}
+
+func TestPrune(t *testing.T) {
+	const retention = 24 * time.Hour
+
+	first, second, third := testKey(1, t0), testKey(2, t0), testKey(3, t0)
+	r := Single(first)
+	r.Add(second)
+	r.Add(third)
+	require.NoError(t, r.Promote(second.ID, t0))
+	require.NoError(t, r.Promote(third.ID, t0.Add(12*time.Hour)))
+
+	// Keys retired within the retention are kept.
+	assert.Empty(t, r.Prune(t0.Add(retention), retention))
+	assert.Len(t, r.Keys, 3)
+
+	removed := r.Prune(t0.Add(retention+time.Second), retention)
+	require.Len(t, removed, 1)
+	assert.Equal(t, first.ID, removed[0].ID)
+	_, ok := r.Verifying(first.ID)
+	assert.False(t, ok)
+
+	// The active key is never pruned, however old.
+	removed = r.Prune(t0.Add(100*retention), retention)
+	require.Len(t, removed, 1)
+	assert.Equal(t, second.ID, removed[0].ID)
+	require.Len(t, r.Keys, 1)
+	assert.Equal(t, third.ID, r.Keys[0].ID)
+}
+
+func TestVerifyingWithoutKeyID(t *testing.T) {
+	first, second := testKey(1, t0), testKey(2, t0)
+	r := Single(first)
+	r.Add(second)
+
+	pub, ok := r.Verifying("")
+	require.True(t, ok)
+	assert.Equal(t, first.Public(), pub)
+
+	// Tokens without a key ID follow the active key.
+	require.NoError(t, r.Promote(second.ID, t0))
+	pub, ok = r.Verifying("")
+	require.True(t, ok)
+	assert.Equal(t, second.Public(), pub)
+
+	_, ok = r.Verifying("unknown")
+	assert.False(t, ok)
+
+	_, ok = (&Keyring{}).Verifying("")
+	assert.False(t, ok)
+}
+
+func TestMarshalUnmarshal(t *testing.T) {
+	r := Single(testKey(1, t0))
+	r.Add(testKey(2, t0))
+	require.NoError(t, r.Promote(r.Keys[1].ID, t0.Add(time.Hour)))
+
+	data, err := r.Marshal()
+	require.NoError(t, err)
+
+	got, err := Unmarshal(data)
+	require.NoError(t, err)
+	assert.Equal(t, r, got)
+
+	path := filepath.Join(t.TempDir(), "keyring.json")
+	require.NoError(t, r.Save(path))
+	loaded, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, r, loaded)
+}
+
+func TestUnmarshalInvalid(t *testing.T) {
+	first, second := testKey(1, t0), testKey(2, t0)
+
+	// mismatched pairs the public half of the second key with the seed of the first.
+	mismatched := append(ed25519.PrivateKey{}, first.PrivateKey...)
+	copy(mismatched[ed25519.SeedSize:], second.Public())
+
+	tests := []struct {
+		name    string
+		keyring Keyring
+		err     string
+	}{
+		{
+			name:    "Key ID of another key",
+			keyring: Keyring{Active: second.ID, Keys: []Key{{ID: second.ID, PrivateKey: first.PrivateKey, CreatedAt: t0}}},
+			err:     "the id does not match the key",
+		},
+		{
+			name:    "Public key not matching the seed",
+			keyring: Keyring{Active: first.ID, Keys: []Key{{ID: first.ID, PrivateKey: mismatched, CreatedAt: t0}}},
+			err:     "the public key does not match the seed",
+		},
+		{
+			name:    "Truncated private key",
+			keyring: Keyring{Active: first.ID, Keys: []Key{{ID: first.ID, PrivateKey: first.PrivateKey[:ed25519.SeedSize]}}},
+			err:     "bad private key length",
+		},
+		{
+			name:    "Unknown active key",
+			keyring: Keyring{Active: second.ID, Keys: []Key{first}},
+			err:     ErrNoActiveKey.Error(),
+		},
+		{
+			name:    "No keys",
+			keyring: Keyring{},
+			err:     ErrNoActiveKey.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.keyring)
+			require.NoError(t, err)
+
+			_, err = Unmarshal(data)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+		})
+	}
+
+	_, err := Unmarshal([]byte(`{"active": "kid", "keys": [`))
+	assert.ErrorContains(t, err, "failed to decode keyring")
+}
@@ -0,0 +1,136 @@
+package keyring
+
+import (
+	"context"
+	"crypto/ed25519"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// Load reads the keyring stored in the file at path.
+func Load(path string) (*Keyring, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	r, err := Unmarshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return r, nil
+}
+
+// Save stores the keyring in the file at path, readable by its owner only.
+// The file is replaced at once, so that servers reloading it never see it partially written.
+func (r *Keyring) Save(path string) error {
+	data, err := r.Marshal()
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to create keyring file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err = tmp.Chmod(0o600); err == nil {
+		_, err = tmp.Write(data)
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write keyring file: %w", err)
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// Store serves a keyring kept in a file, reloading it when the file changes, so that keys generated
+// or promoted from the command line take effect without a restart.
+// Reload implements the signature of scheduler jobs, so that it can poll the file periodically.
+type Store struct {
+	path string
+
+	mu      sync.RWMutex
+	ring    *Keyring
+	modTime time.Time
+}
+
+// NewStore loads the keyring in the file at path. Until the file exists, fallback is served instead;
+// it is an error for neither to exist.
+func NewStore(path string, fallback *Keyring) (*Store, error) {
+	s := &Store{path: path, ring: fallback}
+	if err := s.Reload(context.Background()); err != nil {
+		return nil, err
+	}
+	if s.ring == nil {
+		return nil, fmt.Errorf("keyring file %s does not exist", path)
+	}
+	return s, nil
+}
+
+// Static returns a store serving a keyring that is not kept in a file.
+func Static(r *Keyring) *Store {
+	return &Store{ring: r}
+}
+
+// Reload reloads the keyring if its file changed since it was last loaded.
+// A keyring that fails to load is reported and the current one stays in use.
+func (s *Store) Reload(context.Context) error {
+	if s.path == "" {
+		return nil
+	}
+
+	info, err := os.Stat(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	s.mu.RLock()
+	unchanged := info.ModTime().Equal(s.modTime)
+	s.mu.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	r, err := Load(s.path)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.ring, s.modTime = r, info.ModTime()
+	s.mu.Unlock()
+	return nil
+}
+
+// current returns the keyring currently served. Keyrings are replaced on reload, never changed.
+func (s *Store) current() *Keyring {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.ring
+}
+
+// Signing returns the active key of the current keyring.
+func (s *Store) Signing() (Key, error) {
+	return s.current().Signing()
+}
+
+// Verifying returns the public key verifying tokens signed by the key with the given ID.
+func (s *Store) Verifying(kid string) (ed25519.PublicKey, bool) {
+	return s.current().Verifying(kid)
+}
+
+// JWKS returns the public keys of the current keyring as a JSON Web Key Set.
+func (s *Store) JWKS() JWKS {
+	return s.current().JWKS()
+}
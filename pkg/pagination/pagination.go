@@ -0,0 +1,183 @@
+// Package pagination implements keyset (cursor) pagination shared by the list endpoints.
+//
+// Items are ordered by a sort key and a unique identifier breaking ties, either a numeric ID or,
+// for items identified by a UUID, its canonical text form. A page is requested
+// with a limit and the opaque cursor of the last item of the previous page, so pages stay
+// stable while items are added or removed. Sort keys are compared as strings, therefore
+// they must be encoded so that byte order matches the intended order (see TimeKey and DateKey).
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Query parameter keys understood by Parse.
+const (
+	LimitParam  = "limit"
+	CursorParam = "cursor"
+	SortParam   = "sort"
+)
+
+// Page size limits.
+const (
+	DefaultLimit = 50
+	MaxLimit     = 200
+)
+
+// descPrefix marks a descending sort in the sort query parameter, e.g. "-updated".
+const descPrefix = "-"
+
+// ErrInvalidPage indicates that the limit, cursor or sort parameter could not be used.
+var ErrInvalidPage = errors.New("invalid pagination parameters")
+
+// Request describes the requested page.
+//
+// Fields:
+// - Limit: The maximum number of items on the page.
+// - Sort: The field items are ordered by.
+// - Desc: Whether items are ordered in descending order.
+// - After: The position after which the page starts, nil for the first page.
+type Request struct {
+	Limit int
+	Sort  string
+	Desc  bool
+	After *Cursor
+}
+
+// Cursor is the position of an item in a sorted listing.
+//
+// Fields:
+// - Sort: The sort the cursor was issued for, with the descending prefix if any.
+// - Key: The item's sort key.
+// - ID: The item's unique identifier.
+// - UID: The item's UUID, for items identified by a UUID instead of ID.
+type Cursor struct {
+	Sort string `json:"s"`
+	Key  string `json:"k"`
+	ID   int64  `json:"i"`
+	UID  string `json:"u,omitempty"`
+}
+
+// Parse reads a page request from query parameters. The sort must be one of sorts,
+// optionally prefixed with "-" for descending order; it defaults to defaultSort, which may be prefixed too.
+func Parse(query url.Values, sorts []string, defaultSort string) (Request, error) {
+	req := Request{Limit: DefaultLimit}
+	req.Sort, req.Desc = strings.CutPrefix(defaultSort, descPrefix)
+
+	if limit := query.Get(LimitParam); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n < 1 || n > MaxLimit {
+			return Request{}, ErrInvalidPage
+		}
+		req.Limit = n
+	}
+
+	if sort := query.Get(SortParam); sort != "" {
+		req.Sort, req.Desc = strings.CutPrefix(sort, descPrefix)
+		if !slices.Contains(sorts, req.Sort) {
+			return Request{}, ErrInvalidPage
+		}
+	}
+
+	if cursor := query.Get(CursorParam); cursor != "" {
+		after, err := decode(cursor)
+		if err != nil || after.Sort != req.sortName() {
+			return Request{}, ErrInvalidPage
+		}
+		req.After = &after
+	}
+
+	return req, nil
+}
+
+// sortName returns the sort as given in the query, including the descending prefix.
+func (r Request) sortName() string {
+	if r.Desc {
+		return descPrefix + r.Sort
+	}
+	return r.Sort
+}
+
+// Fetch returns how many items to load to fill the page and detect whether another page follows.
+func (r Request) Fetch() int {
+	return r.Limit + 1
+}
+
+// Less reports whether a is ordered before b in the requested order.
+// UUIDs in canonical form compare like PostgreSQL compares the uuid type.
+func (r Request) Less(a, b Cursor) bool {
+	if r.Desc {
+		a, b = b, a
+	}
+	if a.Key != b.Key {
+		return a.Key < b.Key
+	}
+	if a.ID != b.ID {
+		return a.ID < b.ID
+	}
+	return a.UID < b.UID
+}
+
+// Sort orders items in the requested order. It is used to combine items loaded from several sources.
+func Sort[T any](items []T, r Request, key func(T) Cursor) {
+	slices.SortStableFunc(items, func(a, b T) int {
+		ka, kb := key(a), key(b)
+		switch {
+		case r.Less(ka, kb):
+			return -1
+		case r.Less(kb, ka):
+			return 1
+		}
+		return 0
+	})
+}
+
+// Trim cuts sorted items, loaded with Fetch, down to the page and returns the cursor of the next page,
+// or an empty string if this is the last page.
+func Trim[T any](items []T, r Request, key func(T) Cursor) ([]T, string) {
+	if len(items) <= r.Limit {
+		return items, ""
+	}
+
+	items = items[:r.Limit]
+	next := key(items[len(items)-1])
+	next.Sort = r.sortName()
+	return items, encode(next)
+}
+
+// TimeKey encodes a timestamp as a sort key with fixed microsecond precision,
+// so that keys compare in chronological order.
+func TimeKey(t time.Time) string {
+	return t.UTC().Format(timeKeyLayout)
+}
+
+// DateKey encodes a date as a sort key.
+func DateKey(t time.Time) string {
+	return t.Format(time.DateOnly)
+}
+
+// timeKeyLayout is the fixed-width timestamp layout used by TimeKey.
+const timeKeyLayout = "2006-01-02T15:04:05.000000Z"
+
+// encode serializes a cursor into an opaque URL-safe string.
+func encode(c Cursor) string {
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// decode parses a cursor produced by encode.
+func decode(s string) (c Cursor, err error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return Cursor{}, err
+	}
+	err = json.Unmarshal(raw, &c)
+	return
+}
@@ -0,0 +1,253 @@
+package pagination
+
+import (
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var sorts = []string{"created", "holder"}
+
+// item is a listed item positioned by its name and ID.
+type item struct {
+	name string
+	id   int64
+}
+
+func itemCursor(it item) Cursor {
+	return Cursor{Key: it.name, ID: it.id}
+}
+
+func TestParse(t *testing.T) {
+	after := Cursor{Sort: "-holder", Key: "John Doe", ID: 42}
+
+	tests := []struct {
+		name     string
+		query    url.Values
+		expected Request
+	}{
+		{
+			name:     "Defaults",
+			query:    url.Values{},
+			expected: Request{Limit: DefaultLimit, Sort: "created"},
+		},
+		{
+			name:     "Smallest limit",
+			query:    url.Values{LimitParam: {"1"}},
+			expected: Request{Limit: 1, Sort: "created"},
+		},
+		{
+			name:     "Largest limit",
+			query:    url.Values{LimitParam: {strconv.Itoa(MaxLimit)}},
+			expected: Request{Limit: MaxLimit, Sort: "created"},
+		},
+		{
+			name:     "Descending sort",
+			query:    url.Values{SortParam: {"-holder"}},
+			expected: Request{Limit: DefaultLimit, Sort: "holder", Desc: true},
+		},
+		{
+			name:     "Cursor of the same sort",
+			query:    url.Values{SortParam: {"-holder"}, CursorParam: {encode(after)}},
+			expected: Request{Limit: DefaultLimit, Sort: "holder", Desc: true, After: &after},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := Parse(tt.query, sorts, "created")
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, req)
+		})
+	}
+
+	// A descending default sort applies unless another sort is requested.
+	req, err := Parse(url.Values{}, sorts, "-created")
+	require.NoError(t, err)
+	assert.Equal(t, Request{Limit: DefaultLimit, Sort: "created", Desc: true}, req)
+
+	req, err = Parse(url.Values{SortParam: {"created"}}, sorts, "-created")
+	require.NoError(t, err)
+	assert.Equal(t, Request{Limit: DefaultLimit, Sort: "created"}, req)
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		query url.Values
+	}{
+		{name: "Zero limit", query: url.Values{LimitParam: {"0"}}},
+		{name: "Negative limit", query: url.Values{LimitParam: {"-1"}}},
+		{name: "Limit above the maximum", query: url.Values{LimitParam: {strconv.Itoa(MaxLimit + 1)}}},
+		{name: "Non-numeric limit", query: url.Values{LimitParam: {"ten"}}},
+		{name: "Unknown sort", query: url.Values{SortParam: {"name"}}},
+		{name: "Unknown descending sort", query: url.Values{SortParam: {"-name"}}},
+		{name: "Malformed cursor", query: url.Values{CursorParam: {"not a cursor"}}},
+		{name: "Cursor not JSON", query: url.Values{CursorParam: {"bm90IGpzb24"}}},
+		{
+			name:  "Cursor of another sort",
+			query: url.Values{SortParam: {"holder"}, CursorParam: {encode(Cursor{Sort: "created", Key: "k", ID: 1})}},
+		},
+		{
+			name:  "Cursor of the opposite order",
+			query: url.Values{SortParam: {"holder"}, CursorParam: {encode(Cursor{Sort: "-holder", Key: "k", ID: 1})}},
+		},
+		{
+			name:  "Cursor of another sort under the default sort",
+			query: url.Values{CursorParam: {encode(Cursor{Sort: "holder", Key: "k", ID: 1})}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.query, sorts, "created")
+			assert.ErrorIs(t, err, ErrInvalidPage)
+		})
+	}
+}
+
+func TestCursorRoundTrip(t *testing.T) {
+	for _, c := range []Cursor{
+		{Sort: "created", Key: TimeKey(time.Date(2025, time.March, 1, 12, 0, 0, 0, time.UTC)), ID: 1},
+		{Sort: "-holder", Key: "Jöhn \"Doe\"/+=", ID: 9223372036854775807},
+		{Sort: "relevance", Key: "", ID: 0},
+		{Sort: "created", Key: "2025-03-01T12:00:00.000000Z", UID: "8d3c1c2e-4f3a-4b8a-9a0c-2f5d2c1e7b6a"},
+	} {
+		s := encode(c)
+		assert.NotContains(t, s, "+", "cursor %q must be URL-safe", s)
+		assert.NotContains(t, s, "/", "cursor %q must be URL-safe", s)
+		assert.NotContains(t, s, "=", "cursor %q must be URL-safe", s)
+
+		got, err := decode(s)
+		require.NoError(t, err)
+		assert.Equal(t, c, got)
+	}
+}
+
+func TestLess(t *testing.T) {
+	tests := []struct {
+		name     string
+		desc     bool
+		a, b     Cursor
+		expected bool
+	}{
+		{name: "Smaller key", a: Cursor{Key: "a", ID: 2}, b: Cursor{Key: "b", ID: 1}, expected: true},
+		{name: "Larger key", a: Cursor{Key: "b", ID: 1}, b: Cursor{Key: "a", ID: 2}, expected: false},
+		{name: "Equal keys, smaller ID", a: Cursor{Key: "a", ID: 1}, b: Cursor{Key: "a", ID: 2}, expected: true},
+		{name: "Equal keys, larger ID", a: Cursor{Key: "a", ID: 2}, b: Cursor{Key: "a", ID: 1}, expected: false},
+		{name: "Same position", a: Cursor{Key: "a", ID: 1}, b: Cursor{Key: "a", ID: 1}, expected: false},
+		{name: "Descending, larger key", desc: true, a: Cursor{Key: "b", ID: 1}, b: Cursor{Key: "a", ID: 2}, expected: true},
+		{name: "Descending, smaller key", desc: true, a: Cursor{Key: "a", ID: 2}, b: Cursor{Key: "b", ID: 1}, expected: false},
+		{name: "Descending, equal keys, larger ID", desc: true, a: Cursor{Key: "a", ID: 2}, b: Cursor{Key: "a", ID: 1}, expected: true},
+		{name: "Descending, equal keys, smaller ID", desc: true, a: Cursor{Key: "a", ID: 1}, b: Cursor{Key: "a", ID: 2}, expected: false},
+		{name: "Descending, same position", desc: true, a: Cursor{Key: "a", ID: 1}, b: Cursor{Key: "a", ID: 1}, expected: false},
+		{
+			name:     "Equal keys, smaller UUID",
+			a:        Cursor{Key: "a", UID: "0f3c1c2e-4f3a-4b8a-9a0c-2f5d2c1e7b6a"},
+			b:        Cursor{Key: "a", UID: "8d3c1c2e-4f3a-4b8a-9a0c-2f5d2c1e7b6a"},
+			expected: true,
+		},
+		{
+			name:     "Descending, equal keys, larger UUID",
+			desc:     true,
+			a:        Cursor{Key: "a", UID: "8d3c1c2e-4f3a-4b8a-9a0c-2f5d2c1e7b6a"},
+			b:        Cursor{Key: "a", UID: "0f3c1c2e-4f3a-4b8a-9a0c-2f5d2c1e7b6a"},
+			expected: true,
+		},
+		{
+			name:     "Smaller key, larger UUID",
+			a:        Cursor{Key: "a", UID: "8d3c1c2e-4f3a-4b8a-9a0c-2f5d2c1e7b6a"},
+			b:        Cursor{Key: "b", UID: "0f3c1c2e-4f3a-4b8a-9a0c-2f5d2c1e7b6a"},
+			expected: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Request{Desc: tt.desc}.Less(tt.a, tt.b))
+		})
+	}
+}
+
+func TestSort(t *testing.T) {
+	items := []item{{"b", 1}, {"a", 3}, {"b", 0}, {"a", 2}}
+
+	Sort(items, Request{}, itemCursor)
+	assert.Equal(t, []item{{"a", 2}, {"a", 3}, {"b", 0}, {"b", 1}}, items)
+
+	Sort(items, Request{Desc: true}, itemCursor)
+	assert.Equal(t, []item{{"b", 1}, {"b", 0}, {"a", 3}, {"a", 2}}, items)
+}
+
+func TestTrim(t *testing.T) {
+	items := []item{{"a", 1}, {"b", 2}, {"c", 3}}
+
+	tests := []struct {
+		name     string
+		req      Request
+		expected []item
+		next     *Cursor
+	}{
+		{
+			name:     "Fewer items than the limit",
+			req:      Request{Limit: 5, Sort: "holder"},
+			expected: items,
+		},
+		{
+			name:     "As many items as the limit",
+			req:      Request{Limit: 3, Sort: "holder"},
+			expected: items,
+		},
+		{
+			name:     "More items than the limit",
+			req:      Request{Limit: 2, Sort: "holder"},
+			expected: items[:2],
+			next:     &Cursor{Sort: "holder", Key: "b", ID: 2},
+		},
+		{
+			name:     "Descending sort",
+			req:      Request{Limit: 1, Sort: "holder", Desc: true},
+			expected: items[:1],
+			next:     &Cursor{Sort: "-holder", Key: "a", ID: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, next := Trim(items, tt.req, itemCursor)
+			assert.Equal(t, tt.expected, page)
+
+			if tt.next == nil {
+				assert.Empty(t, next)
+				return
+			}
+
+			// The next cursor is accepted by Parse for the same sort and positions the next page.
+			query := url.Values{CursorParam: {next}, SortParam: {tt.req.sortName()}}
+			req, err := Parse(query, sorts, "created")
+			require.NoError(t, err)
+			assert.Equal(t, tt.next, req.After)
+		})
+	}
+
+	page, next := Trim([]item(nil), Request{Limit: 1}, itemCursor)
+	assert.Empty(t, page)
+	assert.Empty(t, next)
+}
+
+func TestTimeKey(t *testing.T) {
+	early := time.Date(2025, time.March, 1, 9, 59, 59, 999999000, time.UTC)
+	late := time.Date(2025, time.March, 1, 10, 0, 0, 0, time.UTC)
+	// The same instant in another zone encodes the same key.
+	zone := time.FixedZone("UTC+3", 3*60*60)
+
+	assert.Equal(t, "2025-03-01T09:59:59.999999Z", TimeKey(early))
+	assert.Equal(t, "2025-03-01T10:00:00.000000Z", TimeKey(late))
+	assert.Equal(t, TimeKey(late), TimeKey(late.In(zone)))
+	assert.Less(t, TimeKey(early), TimeKey(late))
+	assert.Equal(t, "2025-03-01", DateKey(late))
+}
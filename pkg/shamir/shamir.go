@@ -0,0 +1,181 @@
+// Package shamir implements Shamir's Secret Sharing over GF(2^8).
+//
+// A secret is split into a number of shares such that any threshold of them reconstruct it,
+// while fewer reveal nothing about it. Every byte of the secret is shared independently with
+// its own random polynomial of degree threshold-1. A share holds the value of each polynomial
+// at the share's x-coordinate, followed by the x-coordinate itself, so it is one byte longer
+// than the secret.
+//
+// Field arithmetic uses the AES polynomial x^8 + x^4 + x^3 + x + 1 and is free of
+// secret-dependent branches and table lookups.
+package shamir
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	// MaxShares is the largest number of shares a secret can be split into,
+	// bounded by the non-zero elements of GF(2^8).
+	MaxShares = 255
+
+	// MinThreshold is the smallest number of shares required to reconstruct a secret.
+	MinThreshold = 2
+)
+
+var (
+	// ErrEmptySecret is returned when splitting an empty secret.
+	ErrEmptySecret = errors.New("shamir: secret is empty")
+
+	// ErrInvalidThreshold is returned when the threshold is below MinThreshold or above the number of shares.
+	ErrInvalidThreshold = errors.New("shamir: threshold must be at least 2 and at most the number of shares")
+
+	// ErrInvalidParts is returned when the number of shares exceeds MaxShares.
+	ErrInvalidParts = errors.New("shamir: number of shares must not exceed 255")
+
+	// ErrTooFewShares is returned when combining fewer than MinThreshold shares.
+	ErrTooFewShares = errors.New("shamir: at least two shares are required")
+
+	// ErrInvalidShare is returned when combining malformed shares: too short, of different lengths,
+	// with a zero x-coordinate or with the same x-coordinate twice.
+	ErrInvalidShare = errors.New("shamir: invalid share")
+)
+
+// Split splits a secret into parts shares, any threshold of which reconstruct it.
+// The shares use the x-coordinates 1 to parts, in order.
+func Split(secret []byte, parts, threshold int) ([][]byte, error) {
+	return split(rand.Reader, secret, parts, threshold)
+}
+
+// split is Split with the polynomial coefficients read from r.
+func split(r io.Reader, secret []byte, parts, threshold int) ([][]byte, error) {
+	if len(secret) == 0 {
+		return nil, ErrEmptySecret
+	}
+	if parts > MaxShares {
+		return nil, ErrInvalidParts
+	}
+	if threshold < MinThreshold || threshold > parts {
+		return nil, ErrInvalidThreshold
+	}
+
+	// coeffs holds the random coefficients of every byte's polynomial, byte after byte;
+	// the constant term of each polynomial is the secret byte itself.
+	degree := threshold - 1
+	coeffs := make([]byte, len(secret)*degree)
+	if _, err := io.ReadFull(r, coeffs); err != nil {
+		return nil, fmt.Errorf("shamir: failed to read random coefficients: %w", err)
+	}
+	defer clear(coeffs)
+
+	shares := make([][]byte, parts)
+	for i := range shares {
+		x := byte(i + 1)
+		share := make([]byte, len(secret)+1)
+		for j, s := range secret {
+			share[j] = evaluate(s, coeffs[j*degree:(j+1)*degree], x)
+		}
+		share[len(secret)] = x
+		shares[i] = share
+	}
+	return shares, nil
+}
+
+// Combine reconstructs a secret from shares produced by Split. Any threshold of the shares
+// reconstruct the secret; fewer yield an unrelated value, which Combine cannot detect.
+func Combine(shares [][]byte) ([]byte, error) {
+	if len(shares) < MinThreshold {
+		return nil, ErrTooFewShares
+	}
+
+	size := len(shares[0])
+	if size < 2 {
+		return nil, fmt.Errorf("%w: share is too short", ErrInvalidShare)
+	}
+
+	xs := make([]byte, len(shares))
+	var seen [256]bool
+	for i, share := range shares {
+		if len(share) != size {
+			return nil, fmt.Errorf("%w: shares differ in length", ErrInvalidShare)
+		}
+
+		x := share[size-1]
+		if x == 0 {
+			return nil, fmt.Errorf("%w: zero x-coordinate", ErrInvalidShare)
+		}
+		if seen[x] {
+			return nil, fmt.Errorf("%w: duplicate share %d", ErrInvalidShare, x)
+		}
+		seen[x] = true
+		xs[i] = x
+	}
+
+	// Lagrange interpolation at x = 0: the weight of every share depends on the x-coordinates only,
+	// so it is computed once and applied to each byte.
+	weights := make([]byte, len(xs))
+	for i, xi := range xs {
+		w := byte(1)
+		for j, xj := range xs {
+			if i != j {
+				// Subtraction is addition in GF(2^8): xj / (xj - xi).
+				w = mul(w, div(xj, xj^xi))
+			}
+		}
+		weights[i] = w
+	}
+
+	secret := make([]byte, size-1)
+	for j := range secret {
+		var s byte
+		for i, share := range shares {
+			s ^= mul(weights[i], share[j])
+		}
+		secret[j] = s
+	}
+	return secret, nil
+}
+
+// evaluate returns the value at x of the polynomial with the constant term c0
+// and the higher coefficients coeffs, lowest degree first.
+func evaluate(c0 byte, coeffs []byte, x byte) byte {
+	// Horner's method, starting from the highest degree.
+	var y byte
+	for i := len(coeffs) - 1; i >= 0; i-- {
+		y = mul(y, x) ^ coeffs[i]
+	}
+	return mul(y, x) ^ c0
+}
+
+// mul multiplies two elements of GF(2^8) in constant time.
+func mul(a, b byte) byte {
+	var p byte
+	for i := 0; i < 8; i++ {
+		// Add a if the lowest bit of b is set.
+		p ^= -(b & 1) & a
+		// Multiply a by x, reducing by the field polynomial if the highest bit overflows.
+		a = a<<1 ^ -(a>>7)&0x1b
+		b >>= 1
+	}
+	return p
+}
+
+// inv returns the multiplicative inverse of an element of GF(2^8) as a^254,
+// since a^255 = 1 for every non-zero a. The inverse of zero is zero.
+func inv(a byte) byte {
+	// 254 = 0b11111110: square and multiply over its bits.
+	r := byte(1)
+	for i := 0; i < 7; i++ {
+		a = mul(a, a)
+		r = mul(r, a)
+	}
+	return r
+}
+
+// div divides a by a non-zero b in GF(2^8).
+func div(a, b byte) byte {
+	return mul(a, inv(b))
+}
@@ -0,0 +1,294 @@
+package shamir
+
+import (
+	"bytes"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// counterReader yields the bytes start, start+1, ... so that splits are reproducible.
+type counterReader struct{ next byte }
+
+func (r *counterReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = r.next
+		r.next++
+	}
+	return len(p), nil
+}
+
+// failingReader fails every read.
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) { return 0, errors.New("no entropy") }
+
+// mulSlow multiplies two elements of GF(2^8) as polynomials, reducing the product afterwards.
+func mulSlow(a, b byte) byte {
+	var p uint16
+	for i := 0; i < 8; i++ {
+		if b&(1<<i) != 0 {
+			p ^= uint16(a) << i
+		}
+	}
+	for i := 15; i >= 8; i-- {
+		if p&(1<<i) != 0 {
+			p ^= 0x11b << (i - 8)
+		}
+	}
+	return byte(p)
+}
+
+func TestMul(t *testing.T) {
+	// Known products in the AES field.
+	assert.Equal(t, byte(0xc1), mul(0x57, 0x83))
+	assert.Equal(t, byte(0xfe), mul(0x57, 0x13))
+	assert.Equal(t, byte(0x01), mul(0x53, 0xca))
+
+	for a := 0; a < 256; a++ {
+		for b := 0; b < 256; b++ {
+			got := mul(byte(a), byte(b))
+			if got != mulSlow(byte(a), byte(b)) {
+				t.Fatalf("mul(%#x, %#x) = %#x, want %#x", a, b, got, mulSlow(byte(a), byte(b)))
+			}
+			if got != mul(byte(b), byte(a)) {
+				t.Fatalf("mul is not commutative for %#x, %#x", a, b)
+			}
+		}
+	}
+}
+
+func TestMulDistributive(t *testing.T) {
+	for a := 0; a < 256; a += 7 {
+		for b := 0; b < 256; b += 5 {
+			for c := 0; c < 256; c += 3 {
+				x, y, z := byte(a), byte(b), byte(c)
+				if mul(x, y^z) != mul(x, y)^mul(x, z) {
+					t.Fatalf("mul does not distribute over addition for %#x, %#x, %#x", a, b, c)
+				}
+			}
+		}
+	}
+}
+
+func TestInv(t *testing.T) {
+	assert.Equal(t, byte(0), inv(0))
+	assert.Equal(t, byte(0xca), inv(0x53))
+
+	for a := 1; a < 256; a++ {
+		if p := mul(byte(a), inv(byte(a))); p != 1 {
+			t.Fatalf("%#x * inv(%#x) = %#x, want 1", a, a, p)
+		}
+	}
+}
+
+func TestDiv(t *testing.T) {
+	for a := 0; a < 256; a++ {
+		for b := 1; b < 256; b++ {
+			if q := div(byte(a), byte(b)); mul(q, byte(b)) != byte(a) {
+				t.Fatalf("div(%#x, %#x) * %#x != %#x", a, b, b, a)
+			}
+		}
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	// f(x) = 0x42 + 0x01*x: constant and linear terms only.
+	assert.Equal(t, byte(0x42), evaluate(0x42, []byte{0x01}, 0))
+	assert.Equal(t, byte(0x43), evaluate(0x42, []byte{0x01}, 1))
+	assert.Equal(t, byte(0x40), evaluate(0x42, []byte{0x01}, 2))
+
+	// f(x) = 7 + 3x + 2x^2 at x = 2: 7 ^ mul(3, 2) ^ mul(2, mul(2, 2)).
+	want := byte(7) ^ mul(3, 2) ^ mul(2, mul(2, 2))
+	assert.Equal(t, want, evaluate(7, []byte{3, 2}, 2))
+}
+
+func TestSplitKnownVector(t *testing.T) {
+	// With the coefficient 0x01 the shares of 0x42 lie on f(x) = 0x42 + x.
+	shares, err := split(&counterReader{next: 1}, []byte{0x42}, 3, 2)
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{{0x43, 1}, {0x40, 2}, {0x41, 3}}, shares)
+
+	secret, err := Combine(shares[1:])
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0x42}, secret)
+}
+
+func TestSplitShape(t *testing.T) {
+	secret := []byte("correct horse battery staple")
+
+	shares, err := Split(secret, 5, 3)
+	require.NoError(t, err)
+	require.Len(t, shares, 5)
+
+	for i, share := range shares {
+		assert.Len(t, share, len(secret)+1)
+		assert.Equal(t, byte(i+1), share[len(secret)], "x-coordinate of share %d", i)
+		assert.NotEqual(t, secret, share[:len(secret)], "share %d leaks the secret", i)
+	}
+}
+
+func TestSplitIsRandomized(t *testing.T) {
+	secret := []byte("same secret")
+
+	first, err := Split(secret, 3, 2)
+	require.NoError(t, err)
+	second, err := Split(secret, 3, 2)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, first, second)
+}
+
+func TestSplitCombine(t *testing.T) {
+	cases := []struct {
+		parts, threshold, size int
+	}{
+		{2, 2, 1},
+		{3, 2, 16},
+		{5, 3, 32},
+		{6, 6, 32},
+		{8, 4, 64},
+		{10, 2, 7},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("%d_of_%d_%d_bytes", c.threshold, c.parts, c.size), func(t *testing.T) {
+			secret := make([]byte, c.size)
+			_, err := rand.Read(secret)
+			require.NoError(t, err)
+
+			shares, err := Split(secret, c.parts, c.threshold)
+			require.NoError(t, err)
+
+			// Every subset of at least threshold shares, in any order, reconstructs the secret.
+			for mask := 1; mask < 1<<c.parts; mask++ {
+				var subset [][]byte
+				for i := c.parts - 1; i >= 0; i-- {
+					if mask&(1<<i) != 0 {
+						subset = append(subset, shares[i])
+					}
+				}
+				if len(subset) < c.threshold {
+					continue
+				}
+
+				got, err := Combine(subset)
+				require.NoError(t, err)
+				if !bytes.Equal(secret, got) {
+					t.Fatalf("subset %b reconstructed %x, want %x", mask, got, secret)
+				}
+			}
+		})
+	}
+}
+
+func TestSplitCombineMaxShares(t *testing.T) {
+	secret := []byte{0x00, 0xff, 0x10}
+
+	shares, err := Split(secret, MaxShares, MaxShares)
+	require.NoError(t, err)
+	require.Len(t, shares, MaxShares)
+	assert.Equal(t, byte(255), shares[MaxShares-1][len(secret)])
+
+	got, err := Combine(shares)
+	require.NoError(t, err)
+	assert.Equal(t, secret, got)
+}
+
+func TestCombineBelowThreshold(t *testing.T) {
+	secret := []byte("below the threshold")
+
+	shares, err := split(&counterReader{next: 7}, secret, 5, 3)
+	require.NoError(t, err)
+
+	got, err := Combine(shares[:2])
+	require.NoError(t, err)
+	assert.NotEqual(t, secret, got)
+}
+
+func TestCombineTamperedShare(t *testing.T) {
+	secret := []byte("tamper evident?")
+
+	shares, err := Split(secret, 3, 3)
+	require.NoError(t, err)
+	shares[1][0] ^= 0x01
+
+	// Shamir's scheme has no integrity protection: a changed share changes the secret.
+	got, err := Combine(shares)
+	require.NoError(t, err)
+	assert.NotEqual(t, secret, got)
+	assert.Equal(t, secret[1:], got[1:])
+}
+
+func TestSplitErrors(t *testing.T) {
+	cases := []struct {
+		name             string
+		secret           []byte
+		parts, threshold int
+		err              error
+	}{
+		{"empty secret", nil, 3, 2, ErrEmptySecret},
+		{"threshold below two", []byte("s"), 3, 1, ErrInvalidThreshold},
+		{"threshold above parts", []byte("s"), 3, 4, ErrInvalidThreshold},
+		{"zero parts", []byte("s"), 0, 2, ErrInvalidThreshold},
+		{"too many parts", []byte("s"), 256, 2, ErrInvalidParts},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			shares, err := Split(c.secret, c.parts, c.threshold)
+			assert.ErrorIs(t, err, c.err)
+			assert.Nil(t, shares)
+		})
+	}
+}
+
+func TestSplitReaderError(t *testing.T) {
+	shares, err := split(failingReader{}, []byte("secret"), 3, 2)
+	assert.ErrorContains(t, err, "no entropy")
+	assert.Nil(t, shares)
+}
+
+func TestCombineErrors(t *testing.T) {
+	cases := []struct {
+		name   string
+		shares [][]byte
+		err    error
+	}{
+		{"no shares", nil, ErrTooFewShares},
+		{"one share", [][]byte{{0x01, 0x01}}, ErrTooFewShares},
+		{"too short", [][]byte{{0x01}, {0x02}}, ErrInvalidShare},
+		{"different lengths", [][]byte{{0x01, 0x02, 0x01}, {0x01, 0x02}}, ErrInvalidShare},
+		{"zero x-coordinate", [][]byte{{0x01, 0x00}, {0x01, 0x02}}, ErrInvalidShare},
+		{"duplicate share", [][]byte{{0x01, 0x03}, {0x02, 0x05}, {0x01, 0x03}}, ErrInvalidShare},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			secret, err := Combine(c.shares)
+			assert.ErrorIs(t, err, c.err)
+			assert.Nil(t, secret)
+		})
+	}
+}
+
+func FuzzSplitCombine(f *testing.F) {
+	f.Add([]byte("secret"), uint8(3), uint8(2))
+	f.Add([]byte{0x00}, uint8(2), uint8(2))
+	f.Add(bytes.Repeat([]byte{0xff}, 64), uint8(9), uint8(5))
+
+	f.Fuzz(func(t *testing.T, secret []byte, parts, threshold uint8) {
+		shares, err := Split(secret, int(parts), int(threshold))
+		if err != nil {
+			return
+		}
+
+		got, err := Combine(shares[len(shares)-int(threshold):])
+		require.NoError(t, err)
+		require.Equal(t, secret, got)
+	})
+}
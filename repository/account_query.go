@@ -0,0 +1,249 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gleb-korostelev/GophKeeper/models/account"
+	"github.com/gleb-korostelev/GophKeeper/models/audit"
+	"github.com/gleb-korostelev/GophKeeper/models/org"
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/gleb-korostelev/GophKeeper/models/send"
+	"github.com/jackc/pgx/v5"
+)
+
+// GetUserShares retrieves the shares a user granted on their cards and the shares granted to the user.
+func GetUserShares(ctx context.Context, tx pgx.Tx, username string) ([]profile.CardShare, error) {
+	const query = `
+        SELECT o.username, g.username, c.card_number, s.permission, s.wrapped_key
+        FROM auth.card_shares s
+        JOIN auth.cards c ON c.id = s.card_id
+        JOIN auth.users o ON o.id = c.user_id
+        JOIN auth.users g ON g.id = s.grantee_id
+        WHERE o.username = $1
+           OR g.username = $1
+        ORDER BY s.created_at
+    `
+
+	rows, err := tx.Query(ctx, query, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query shares: %w", err)
+	}
+	defer rows.Close()
+
+	var shares []profile.CardShare
+	for rows.Next() {
+		var sh profile.CardShare
+		if err := rows.Scan(&sh.Owner, &sh.Grantee, &sh.CardNumber, &sh.Permission, &sh.WrappedKey); err != nil {
+			return nil, fmt.Errorf("failed to scan share: %w", err)
+		}
+		shares = append(shares, sh)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", rows.Err())
+	}
+
+	return shares, nil
+}
+
+// GetUserSends retrieves all one-time share links of a user, including expired ones not purged yet.
+func GetUserSends(ctx context.Context, tx pgx.Tx, username string) ([]send.Send, error) {
+	const query = `
+        SELECT s.id, s.ciphertext, s.max_views, s.views, s.expires_at, s.created_at
+        FROM auth.sends s
+        JOIN auth.users u ON u.id = s.user_id
+        WHERE u.username = $1
+        ORDER BY s.created_at
+    `
+
+	rows, err := tx.Query(ctx, query, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sends: %w", err)
+	}
+	defer rows.Close()
+
+	var sends []send.Send
+	for rows.Next() {
+		sd := send.Send{Username: username}
+		if err := rows.Scan(&sd.ID, &sd.Ciphertext, &sd.MaxViews, &sd.Views, &sd.ExpiresAt, &sd.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan send: %w", err)
+		}
+		sends = append(sends, sd)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", rows.Err())
+	}
+
+	return sends, nil
+}
+
+// GetSessions retrieves all sign-in sessions of a user that were not purged yet, newest first.
+func GetSessions(ctx context.Context, tx pgx.Tx, username string) ([]account.Session, error) {
+	const query = `
+        SELECT s.id, s.created_at, s.expires_at, s.revoked_at
+        FROM auth.sessions s
+        JOIN auth.users u ON u.id = s.user_id
+        WHERE u.username = $1
+        ORDER BY s.created_at DESC
+    `
+
+	rows, err := tx.Query(ctx, query, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sessions: %w", err)
+	}
+	defer rows.Close()
+
+	var sessions []account.Session
+	for rows.Next() {
+		var s account.Session
+		if err := rows.Scan(&s.ID, &s.CreatedAt, &s.ExpiresAt, &s.RevokedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan session: %w", err)
+		}
+		sessions = append(sessions, s)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", rows.Err())
+	}
+
+	return sessions, nil
+}
+
+// GetAuditEvents retrieves the audit log entries of a user's account in chronological order.
+func GetAuditEvents(ctx context.Context, tx pgx.Tx, username string) ([]audit.Event, error) {
+	const query = `
+        SELECT l.username, l.action, l.details, l.created_at
+        FROM auth.audit_log l
+        JOIN auth.users u ON u.id = l.user_id
+        WHERE u.username = $1
+        ORDER BY l.created_at, l.id
+    `
+
+	rows, err := tx.Query(ctx, query, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query audit events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []audit.Event
+	for rows.Next() {
+		var e audit.Event
+		if err := rows.Scan(&e.Username, &e.Action, &e.Details, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan audit event: %w", err)
+		}
+		events = append(events, e)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", rows.Err())
+	}
+
+	return events, nil
+}
+
+// AnonymizeAuditEvents unlinks the audit log entries of a user's account from it and replaces
+// the username recorded in them with an alias.
+func AnonymizeAuditEvents(ctx context.Context, tx pgx.Tx, username, alias string) error {
+	const query = `
+        UPDATE auth.audit_log l
+        SET user_id = NULL,
+            username = $2
+        FROM auth.users u
+        WHERE u.id = l.user_id
+          AND u.username = $1
+    `
+
+	_, err := tx.Exec(ctx, query, username, alias)
+	if err != nil {
+		return fmt.Errorf("failed to anonymize audit events: %w", err)
+	}
+
+	return nil
+}
+
+// GetOrphanedOrgs retrieves the organizations a user is the only owner of while other members remain,
+// which would be left without an owner if the user's account was deleted.
+func GetOrphanedOrgs(ctx context.Context, tx pgx.Tx, username string) ([]string, error) {
+	const query = `
+        SELECT o.name
+        FROM auth.orgs o
+        JOIN auth.org_members m ON m.org_id = o.id
+        JOIN auth.users u ON u.id = m.user_id
+        WHERE u.username = $1
+          AND m.role = $2
+          AND NOT EXISTS (
+              SELECT 1 FROM auth.org_members x
+              WHERE x.org_id = o.id AND x.user_id <> u.id AND x.role = $2
+          )
+          AND EXISTS (
+              SELECT 1 FROM auth.org_members x
+              WHERE x.org_id = o.id AND x.user_id <> u.id
+          )
+        ORDER BY o.name
+    `
+
+	rows, err := tx.Query(ctx, query, username, org.RoleOwner)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query orphaned orgs: %w", err)
+	}
+	defer rows.Close()
+
+	var names []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, fmt.Errorf("failed to scan org: %w", err)
+		}
+		names = append(names, name)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", rows.Err())
+	}
+
+	return names, nil
+}
+
+// DeleteSoleMemberOrgs removes the organizations a user is the only member of.
+func DeleteSoleMemberOrgs(ctx context.Context, tx pgx.Tx, username string) error {
+	const query = `
+        DELETE FROM auth.orgs o
+        USING auth.org_members m, auth.users u
+        WHERE m.org_id = o.id
+          AND u.id = m.user_id
+          AND u.username = $1
+          AND NOT EXISTS (
+              SELECT 1 FROM auth.org_members x
+              WHERE x.org_id = o.id AND x.user_id <> u.id
+          )
+    `
+
+	_, err := tx.Exec(ctx, query, username)
+	if err != nil {
+		return fmt.Errorf("failed to delete sole member orgs: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteAccount removes a user's account; everything the user owns is removed with it by cascading foreign keys.
+// ErrNoRowsAffected is returned if the account does not exist.
+func DeleteAccount(ctx context.Context, tx pgx.Tx, username string) error {
+	const query = `
+        DELETE FROM auth.users
+        WHERE username = $1
+    `
+
+	cmdTag, err := tx.Exec(ctx, query, username)
+	if err != nil {
+		return fmt.Errorf("failed to delete account: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gleb-korostelev/GophKeeper/models/apikey"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
+	"github.com/jackc/pgx/v5"
+)
+
+// apiKeyLastUsedPrecision is how stale the last-used time of an API key may get,
+// which spares a write on every request made with the key.
+const apiKeyLastUsedPrecision = "1 minute"
+
+// InsertAPIKey stores a new API key of a user.
+// ErrNoRowsAffected is returned if the user does not exist.
+func InsertAPIKey(ctx context.Context, tx pgx.Tx, k apikey.Key) error {
+	const query = `
+        INSERT INTO auth.api_keys (id, user_id, name, key_hash, items, folders, read_only, expires_at)
+        SELECT $1, id, $3, $4, $5, $6, $7, $8
+        FROM auth.users
+        WHERE username = $2
+    `
+
+	cmdTag, err := tx.Exec(ctx, query, k.ID, k.Username, k.Name, k.Hash, nonNil(k.Scope.Items),
+		nonNil(k.Scope.Folders), k.Scope.ReadOnly, k.ExpiresAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert api key: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// GetAPIKey retrieves an API key by its identifier.
+func GetAPIKey(ctx context.Context, tx pgx.Tx, id string) (k apikey.Key, err error) {
+	const query = `
+        SELECT k.id, u.username, k.name, k.key_hash, k.items, k.folders, k.read_only,
+               k.expires_at, k.created_at, k.last_used_at
+        FROM auth.api_keys k
+        JOIN auth.users u ON u.id = k.user_id
+        WHERE k.id = $1
+    `
+
+	err = tx.QueryRow(ctx, query, id).Scan(&k.ID, &k.Username, &k.Name, &k.Hash, &k.Scope.Items, &k.Scope.Folders,
+		&k.Scope.ReadOnly, &k.ExpiresAt, &k.CreatedAt, &k.LastUsedAt)
+	return
+}
+
+// GetAPIKeys retrieves a page of the API keys of a user, ordered by creation time, including one key
+// past the page so the caller can tell whether another page follows.
+func GetAPIKeys(ctx context.Context, tx pgx.Tx, username string, page pagination.Request) ([]apikey.Key, error) {
+	cond, tail, pageArgs := uuidKeyset("k", createdColumn, page, 2)
+	return queryAPIKeys(ctx, tx, cond, tail, append([]any{username}, pageArgs...))
+}
+
+// GetUserAPIKeys retrieves all API keys of a user, newest first.
+func GetUserAPIKeys(ctx context.Context, tx pgx.Tx, username string) ([]apikey.Key, error) {
+	return queryAPIKeys(ctx, tx, "TRUE", "ORDER BY k.created_at DESC, k.id DESC", []any{username})
+}
+
+// queryAPIKeys retrieves the API keys of the user given by the first argument matching cond,
+// ordered and limited by tail.
+func queryAPIKeys(ctx context.Context, tx pgx.Tx, cond, tail string, args []any) ([]apikey.Key, error) {
+	const queryTmpl = `
+        SELECT k.id, u.username, k.name, k.items, k.folders, k.read_only,
+               k.expires_at, k.created_at, k.last_used_at
+        FROM auth.api_keys k
+        JOIN auth.users u ON u.id = k.user_id
+        WHERE u.username = $1
+          AND %s
+        %s
+    `
+
+	rows, err := tx.Query(ctx, fmt.Sprintf(queryTmpl, cond, tail), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query api keys: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []apikey.Key
+	for rows.Next() {
+		var k apikey.Key
+		err := rows.Scan(&k.ID, &k.Username, &k.Name, &k.Scope.Items, &k.Scope.Folders, &k.Scope.ReadOnly,
+			&k.ExpiresAt, &k.CreatedAt, &k.LastUsedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan api key: %w", err)
+		}
+		keys = append(keys, k)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", rows.Err())
+	}
+
+	return keys, nil
+}
+
+// TouchAPIKey records the use of an API key. The last-used time is only updated once it is stale.
+func TouchAPIKey(ctx context.Context, tx pgx.Tx, id string) error {
+	const query = `
+        UPDATE auth.api_keys
+        SET last_used_at = (now() at time zone 'utc')
+        WHERE id = $1
+          AND (last_used_at IS NULL OR last_used_at < (now() at time zone 'utc') - $2::interval)
+    `
+
+	_, err := tx.Exec(ctx, query, id, apiKeyLastUsedPrecision)
+	if err != nil {
+		return fmt.Errorf("failed to touch api key: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteAPIKey removes an API key of a user.
+// ErrNoRowsAffected is returned if the user has no such key.
+func DeleteAPIKey(ctx context.Context, tx pgx.Tx, username, id string) error {
+	const query = `
+        DELETE FROM auth.api_keys k
+        USING auth.users u
+        WHERE u.id = k.user_id
+          AND u.username = $1
+          AND k.id::text = $2
+    `
+
+	cmdTag, err := tx.Exec(ctx, query, username, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete api key: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// nonNil returns an empty slice in place of nil, which the not null array columns require.
+func nonNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
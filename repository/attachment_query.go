@@ -0,0 +1,244 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/models/attachment"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
+	"github.com/jackc/pgx/v5"
+)
+
+// attachmentColumns lists the columns scanned by scanAttachment.
+const attachmentColumns = `
+        a.id, u.username, COALESCE(c.card_number, ''), a.name, a.content_type,
+        a.size, a.received, a.sha256, a.complete, a.created_at
+`
+
+// InsertAttachment stores the metadata of a new attachment on one of the user's cards.
+// ErrNoRowsAffected is returned if the user owns no such card.
+func InsertAttachment(ctx context.Context, tx pgx.Tx, a attachment.Attachment) error {
+	const query = `
+        INSERT INTO auth.attachments (id, user_id, card_id, name, content_type, size, sha256)
+        SELECT $1, u.id, c.id, $4, $5, $6, $7
+        FROM auth.users u
+        JOIN auth.cards c ON c.user_id = u.id
+        WHERE u.username = $2
+          AND c.card_number = $3
+    `
+
+	cmdTag, err := tx.Exec(ctx, query,
+		a.ID,
+		a.Username,
+		a.CardNumber,
+		a.Name,
+		a.ContentType,
+		a.Size,
+		a.SHA256,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert attachment: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// GetAttachment retrieves one of the user's attachments.
+func GetAttachment(ctx context.Context, tx pgx.Tx, username, id string) (attachment.Attachment, error) {
+	return getAttachment(ctx, tx, username, id, "")
+}
+
+// GetAttachmentForUpdate retrieves one of the user's attachments,
+// locking it until the end of the transaction.
+func GetAttachmentForUpdate(ctx context.Context, tx pgx.Tx, username, id string) (attachment.Attachment, error) {
+	return getAttachment(ctx, tx, username, id, "FOR UPDATE OF a")
+}
+
+// getAttachment retrieves one of the user's attachments with an optional locking clause.
+func getAttachment(ctx context.Context, tx pgx.Tx, username, id, lock string) (a attachment.Attachment, err error) {
+	const queryTmpl = `
+        SELECT %s
+        FROM auth.attachments a
+        JOIN auth.users u ON u.id = a.user_id
+        LEFT JOIN auth.cards c ON c.id = a.card_id
+        WHERE u.username = $1
+          AND a.id = $2
+        %s
+    `
+
+	row := tx.QueryRow(ctx, fmt.Sprintf(queryTmpl, attachmentColumns, lock), username, id)
+	err = scanAttachment(row, &a)
+	return
+}
+
+// GetCardAttachments retrieves a page of the attachments of one of the user's cards, ordered by creation time,
+// including one attachment past the page so the caller can tell whether another page follows.
+func GetCardAttachments(ctx context.Context, tx pgx.Tx, username, cardNumber string, page pagination.Request) (
+	[]attachment.Attachment, error) {
+	const queryTmpl = `
+        SELECT %s
+        FROM auth.attachments a
+        JOIN auth.users u ON u.id = a.user_id
+        JOIN auth.cards c ON c.id = a.card_id
+        WHERE u.username = $1
+          AND c.card_number = $2
+          AND %s
+        %s
+    `
+
+	cond, tail, pageArgs := uuidKeyset("a", createdColumn, page, 3)
+	args := append([]any{username, cardNumber}, pageArgs...)
+
+	rows, err := tx.Query(ctx, fmt.Sprintf(queryTmpl, attachmentColumns, cond, tail), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query attachments: %w", err)
+	}
+	defer rows.Close()
+
+	var attachments []attachment.Attachment
+	for rows.Next() {
+		var a attachment.Attachment
+		if err := scanAttachment(rows, &a); err != nil {
+			return nil, fmt.Errorf("failed to scan attachment: %w", err)
+		}
+		attachments = append(attachments, a)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", rows.Err())
+	}
+
+	return attachments, nil
+}
+
+// GetUserAttachments retrieves all attachments of a user, including those of deleted cards, oldest first.
+func GetUserAttachments(ctx context.Context, tx pgx.Tx, username string) ([]attachment.Attachment, error) {
+	const queryTmpl = `
+        SELECT %s
+        FROM auth.attachments a
+        JOIN auth.users u ON u.id = a.user_id
+        LEFT JOIN auth.cards c ON c.id = a.card_id
+        WHERE u.username = $1
+        ORDER BY a.created_at, a.id
+    `
+
+	rows, err := tx.Query(ctx, fmt.Sprintf(queryTmpl, attachmentColumns), username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query attachments: %w", err)
+	}
+	defer rows.Close()
+
+	var attachments []attachment.Attachment
+	for rows.Next() {
+		var a attachment.Attachment
+		if err := scanAttachment(rows, &a); err != nil {
+			return nil, fmt.Errorf("failed to scan attachment: %w", err)
+		}
+		attachments = append(attachments, a)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", rows.Err())
+	}
+
+	return attachments, nil
+}
+
+// UpdateAttachmentProgress records how many bytes of an attachment were received
+// and whether its upload is complete.
+func UpdateAttachmentProgress(ctx context.Context, tx pgx.Tx, id string, received int64, complete bool) error {
+	const query = `
+        UPDATE auth.attachments
+        SET received = $2,
+            complete = $3,
+            updated_at = (now() at time zone 'utc')
+        WHERE id = $1
+    `
+
+	cmdTag, err := tx.Exec(ctx, query, id, received, complete)
+	if err != nil {
+		return fmt.Errorf("failed to update attachment: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// DeleteAttachment removes one of the user's attachments.
+func DeleteAttachment(ctx context.Context, tx pgx.Tx, username, id string) error {
+	const query = `
+        DELETE FROM auth.attachments a
+        USING auth.users u
+        WHERE u.id = a.user_id
+          AND u.username = $1
+          AND a.id = $2
+    `
+
+	cmdTag, err := tx.Exec(ctx, query, username, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete attachment: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// DeleteStaleAttachments removes attachments whose card was deleted and uploads left unfinished
+// since before the given time, and returns their identifiers so their blobs can be removed.
+func DeleteStaleAttachments(ctx context.Context, tx pgx.Tx, before time.Time) ([]string, error) {
+	const query = `
+        DELETE FROM auth.attachments
+        WHERE card_id IS NULL
+           OR (NOT complete AND updated_at < $1)
+        RETURNING id
+    `
+
+	rows, err := tx.Query(ctx, query, before)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete stale attachments: %w", err)
+	}
+
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan attachment id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", rows.Err())
+	}
+
+	return ids, nil
+}
+
+// scanAttachment scans a row selected with attachmentColumns.
+func scanAttachment(row pgx.Row, a *attachment.Attachment) error {
+	return row.Scan(
+		&a.ID,
+		&a.Username,
+		&a.CardNumber,
+		&a.Name,
+		&a.ContentType,
+		&a.Size,
+		&a.Received,
+		&a.SHA256,
+		&a.Complete,
+		&a.CreatedAt,
+	)
+}
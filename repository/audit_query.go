@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gleb-korostelev/GophKeeper/models/audit"
+	"github.com/jackc/pgx/v5"
+)
+
+// InsertAuditEvent appends an event to the audit log. The event is linked to the user's account
+// if it exists; the username is recorded either way.
+func InsertAuditEvent(ctx context.Context, tx pgx.Tx, e audit.Event) error {
+	const query = `
+        INSERT INTO auth.audit_log (user_id, username, action, details)
+        VALUES ((SELECT id FROM auth.users WHERE username = $1), $1, $2, $3)
+    `
+
+	details := e.Details
+	if details == nil {
+		details = map[string]string{}
+	}
+
+	_, err := tx.Exec(ctx, query, e.Username, e.Action, details)
+	if err != nil {
+		return fmt.Errorf("failed to insert audit event: %w", err)
+	}
+
+	return nil
+}
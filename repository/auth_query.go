@@ -2,6 +2,9 @@ package repository
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"time"
 
 	"github.com/gleb-korostelev/GophKeeper/models"
 	"github.com/jackc/pgx/v5"
@@ -32,3 +35,103 @@ func UpdateAccountType(ctx context.Context, tx pgx.Tx, username string, accType
 
 	return
 }
+
+// GetSignInFailures returns the count of wrong passwords given for an account from a client and its lockout.
+// Zero failures are returned if none were recorded.
+func GetSignInFailures(ctx context.Context, tx pgx.Tx, username, client string) (f models.SignInFailures, err error) {
+	const query = `
+		SELECT f.failures, f.locked_until
+		FROM auth.sign_in_failures f
+		JOIN auth.users u ON u.id = f.user_id
+		WHERE u.username = $1
+		  AND f.client = $2;
+	`
+
+	err = tx.QueryRow(ctx, query, username, client).Scan(&f.Failures, &f.LockedUntil)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return models.SignInFailures{}, nil
+	}
+	if err != nil {
+		return f, fmt.Errorf("failed to query sign-in failures: %w", err)
+	}
+	return f, nil
+}
+
+// RecordFailedSignIn counts a wrong password given for an account from a client. The max-th consecutive
+// one locks sign-ins from the client for lockout and starts the count over.
+func RecordFailedSignIn(ctx context.Context, tx pgx.Tx, username, client string, max int,
+	lockout time.Duration) (err error) {
+	const query = `
+		INSERT INTO auth.sign_in_failures AS f (user_id, client, failures, locked_until)
+		SELECT id, $2, 1, CASE WHEN 1 >= $3 THEN now() + make_interval(secs => $4) END
+		FROM auth.users
+		WHERE username = $1
+		ON CONFLICT (user_id, client) DO UPDATE
+		SET failures = CASE WHEN f.failures + 1 >= $3 THEN 0 ELSE f.failures + 1 END,
+			locked_until = CASE WHEN f.failures + 1 >= $3 THEN now() + make_interval(secs => $4)
+			                    ELSE f.locked_until END,
+			updated_at = now();
+	`
+
+	_, err = tx.Exec(ctx, query, username, client, max, lockout.Seconds())
+	if err != nil {
+		return fmt.Errorf("failed to record failed sign-in: %w", err)
+	}
+	return nil
+}
+
+// ResetFailedSignIns clears the count of wrong passwords and the lockout of an account for a client
+// after a sign-in from it.
+func ResetFailedSignIns(ctx context.Context, tx pgx.Tx, username, client string) (err error) {
+	const query = `
+		DELETE FROM auth.sign_in_failures f
+		USING auth.users u
+		WHERE u.id = f.user_id
+		  AND u.username = $1
+		  AND f.client = $2;
+	`
+
+	_, err = tx.Exec(ctx, query, username, client)
+	if err != nil {
+		return fmt.Errorf("failed to reset failed sign-ins: %w", err)
+	}
+	return nil
+}
+
+// DeleteStaleSignInFailures forgets the wrong passwords of clients that gave none for age and are not locked.
+func DeleteStaleSignInFailures(ctx context.Context, tx pgx.Tx, age time.Duration) (int64, error) {
+	const query = `
+		DELETE FROM auth.sign_in_failures
+		WHERE updated_at < now() - make_interval(secs => $1)
+		  AND (locked_until IS NULL OR locked_until <= now());
+	`
+
+	cmdTag, err := tx.Exec(ctx, query, age.Seconds())
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete stale sign-in failures: %w", err)
+	}
+	return cmdTag.RowsAffected(), nil
+}
+
+// UpdateAccountSecret replaces the hashed password of an account, provided it still has the given old hash.
+// ErrNoRowsAffected is returned if the account does not exist or its password changed in the meantime.
+func UpdateAccountSecret(ctx context.Context, tx pgx.Tx, username string, oldSecret, secret []byte) error {
+	const query = `
+		UPDATE auth.users
+		SET secret = $3,
+			updated_at = now()
+		WHERE username = $1
+		  AND secret = $2;
+	`
+
+	cmdTag, err := tx.Exec(ctx, query, username, oldSecret, secret)
+	if err != nil {
+		return fmt.Errorf("failed to update account secret: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
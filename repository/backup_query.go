@@ -0,0 +1,284 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gleb-korostelev/GophKeeper/models/backup"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	// vaultSchema is the schema of the tables of the vault.
+	vaultSchema = "auth"
+
+	// blobsTable indexes the large objects of the Postgres blob store. It is backed up blob by blob
+	// rather than as a table, since restored blobs get new large objects.
+	blobsTable = "blobs"
+
+	// usageTable holds the usage of each user, derived from their items and attachments. It is not
+	// backed up but recounted after a restore.
+	usageTable = "user_usage"
+)
+
+// GetVaultTables returns the tables of the vault but the index of the blobs and the usage, with their columns
+// but the generated ones, which cannot be restored.
+func GetVaultTables(ctx context.Context, tx pgx.Tx) ([]backup.Table, error) {
+	const query = `
+        SELECT c.table_name::text, array_agg(c.column_name::text ORDER BY c.ordinal_position)
+        FROM information_schema.columns c
+        JOIN information_schema.tables t ON t.table_schema = c.table_schema AND t.table_name = c.table_name
+        WHERE c.table_schema = $1
+          AND t.table_type = 'BASE TABLE'
+          AND c.is_generated = 'NEVER'
+          AND c.table_name <> ALL($2)
+        GROUP BY c.table_name
+        ORDER BY c.table_name
+    `
+
+	rows, err := tx.Query(ctx, query, vaultSchema, []string{blobsTable, usageTable})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vault tables: %w", err)
+	}
+
+	tables, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (t backup.Table, err error) {
+		err = row.Scan(&t.Name, &t.Columns)
+		return
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get vault tables: %w", err)
+	}
+	return tables, nil
+}
+
+// CopyTableTo writes the rows of a vault table to w in the text format of COPY and returns their number.
+func CopyTableTo(ctx context.Context, tx pgx.Tx, table backup.Table, w io.Writer) (int64, error) {
+	query := fmt.Sprintf(`COPY %s (%s) TO STDOUT`, tableName(table.Name), columnList(table.Columns))
+
+	tag, err := tx.Conn().PgConn().CopyTo(ctx, w, query)
+	if err != nil {
+		return 0, fmt.Errorf("failed to copy table %s: %w", table.Name, err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// CopyTableFrom inserts the rows read from r, in the text format of COPY, into a vault table
+// and returns their number. Identity columns keep the values read.
+func CopyTableFrom(ctx context.Context, tx pgx.Tx, table backup.Table, r io.Reader) (int64, error) {
+	query := fmt.Sprintf(`COPY %s (%s) FROM STDIN`, tableName(table.Name), columnList(table.Columns))
+
+	tag, err := tx.Conn().PgConn().CopyFrom(ctx, r, query)
+	if err != nil {
+		return 0, fmt.Errorf("failed to restore table %s: %w", table.Name, err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// GetBlobs returns the blobs of the Postgres blob store.
+func GetBlobs(ctx context.Context, tx pgx.Tx) ([]backup.Blob, error) {
+	rows, err := tx.Query(ctx, `SELECT key, oid FROM auth.blobs ORDER BY key`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blobs: %w", err)
+	}
+
+	blobs, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (b backup.Blob, err error) {
+		err = row.Scan(&b.Key, &b.OID)
+		return
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get blobs: %w", err)
+	}
+	return blobs, nil
+}
+
+// ReadBlob writes the content of a blob to w and returns its size.
+func ReadBlob(ctx context.Context, tx pgx.Tx, blob backup.Blob, w io.Writer) (int64, error) {
+	los := tx.LargeObjects()
+	obj, err := los.Open(ctx, blob.OID, pgx.LargeObjectModeRead)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open blob %s: %w", blob.Key, err)
+	}
+	defer obj.Close()
+
+	n, err := io.Copy(w, obj)
+	if err != nil {
+		return n, fmt.Errorf("failed to read blob %s: %w", blob.Key, err)
+	}
+	return n, nil
+}
+
+// WriteBlob stores the content read from r as a new blob and returns its size.
+func WriteBlob(ctx context.Context, tx pgx.Tx, key string, r io.Reader) (int64, error) {
+	los := tx.LargeObjects()
+	oid, err := los.Create(ctx, 0)
+	if err != nil {
+		return 0, fmt.Errorf("failed to create large object: %w", err)
+	}
+
+	obj, err := los.Open(ctx, oid, pgx.LargeObjectModeWrite)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open large object: %w", err)
+	}
+	defer obj.Close()
+
+	n, err := io.Copy(obj, r)
+	if err != nil {
+		return n, fmt.Errorf("failed to restore blob %s: %w", key, err)
+	}
+
+	if _, err = tx.Exec(ctx, `INSERT INTO auth.blobs (key, oid) VALUES ($1, $2)`, key, oid); err != nil {
+		return n, fmt.Errorf("failed to restore blob %s: %w", key, err)
+	}
+	return n, nil
+}
+
+// GetSequences returns the state of the sequences of the vault.
+func GetSequences(ctx context.Context, tx pgx.Tx) ([]backup.Sequence, error) {
+	const query = `
+        SELECT sequencename::text, last_value
+        FROM pg_sequences
+        WHERE schemaname = $1
+        ORDER BY sequencename
+    `
+
+	rows, err := tx.Query(ctx, query, vaultSchema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sequences: %w", err)
+	}
+
+	seqs, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (s backup.Sequence, err error) {
+		err = row.Scan(&s.Name, &s.Value)
+		return
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sequences: %w", err)
+	}
+	return seqs, nil
+}
+
+// SetSequences restores the state of the sequences of the vault. Sequences that were never used
+// are reset to their start value.
+func SetSequences(ctx context.Context, tx pgx.Tx, seqs []backup.Sequence) error {
+	const query = `
+        SELECT setval(format('%I.%I', schemaname, sequencename)::regclass,
+                      COALESCE($3::bigint, start_value), $3::bigint IS NOT NULL)
+        FROM pg_sequences
+        WHERE schemaname = $1
+          AND sequencename = $2
+    `
+
+	for _, s := range seqs {
+		if _, err := tx.Exec(ctx, query, vaultSchema, s.Name, s.Value); err != nil {
+			return fmt.Errorf("failed to restore sequence %s: %w", s.Name, err)
+		}
+	}
+	return nil
+}
+
+// IsVaultEmpty reports whether the given vault tables and the blob store hold no rows.
+func IsVaultEmpty(ctx context.Context, tx pgx.Tx, tables []backup.Table) (bool, error) {
+	names := append(vaultTableNames(tables), tableName(blobsTable))
+	for _, name := range names {
+		var exists bool
+		if err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM `+name+`)`).Scan(&exists); err != nil {
+			return false, fmt.Errorf("failed to check table %s: %w", name, err)
+		}
+		if exists {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// TruncateVault removes all rows of the given vault tables, all blobs and the usage of all users,
+// restarting the identity columns.
+func TruncateVault(ctx context.Context, tx pgx.Tx, tables []backup.Table) error {
+	if _, err := tx.Exec(ctx, `SELECT lo_unlink(oid) FROM auth.blobs`); err != nil {
+		return fmt.Errorf("failed to remove blobs: %w", err)
+	}
+
+	names := append(vaultTableNames(tables), tableName(blobsTable), tableName(usageTable))
+	if _, err := tx.Exec(ctx, `TRUNCATE `+strings.Join(names, ", ")+` RESTART IDENTITY`); err != nil {
+		return fmt.Errorf("failed to truncate vault: %w", err)
+	}
+	return nil
+}
+
+// GetForeignKeys returns the foreign keys of the vault tables that are not deferrable.
+func GetForeignKeys(ctx context.Context, tx pgx.Tx) ([]backup.ForeignKey, error) {
+	const query = `
+        SELECT cl.relname::text, co.conname::text
+        FROM pg_constraint co
+        JOIN pg_class cl ON cl.oid = co.conrelid
+        JOIN pg_namespace n ON n.oid = cl.relnamespace
+        WHERE n.nspname = $1
+          AND co.contype = 'f'
+          AND NOT co.condeferrable
+        ORDER BY 1, 2
+    `
+
+	rows, err := tx.Query(ctx, query, vaultSchema)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get foreign keys: %w", err)
+	}
+
+	fks, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (fk backup.ForeignKey, err error) {
+		err = row.Scan(&fk.Table, &fk.Name)
+		return
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get foreign keys: %w", err)
+	}
+	return fks, nil
+}
+
+// DeferForeignKeys makes foreign keys deferred until the end of the transaction, so that tables
+// referencing each other can be restored in any order.
+func DeferForeignKeys(ctx context.Context, tx pgx.Tx, fks []backup.ForeignKey) error {
+	return alterForeignKeys(ctx, tx, fks, "DEFERRABLE INITIALLY DEFERRED")
+}
+
+// CheckForeignKeys checks the foreign keys deferred by DeferForeignKeys and makes them not deferrable again.
+func CheckForeignKeys(ctx context.Context, tx pgx.Tx, fks []backup.ForeignKey) error {
+	if _, err := tx.Exec(ctx, `SET CONSTRAINTS ALL IMMEDIATE`); err != nil {
+		return fmt.Errorf("failed to check foreign keys: %w", err)
+	}
+	return alterForeignKeys(ctx, tx, fks, "NOT DEFERRABLE")
+}
+
+// alterForeignKeys changes when foreign keys are checked.
+func alterForeignKeys(ctx context.Context, tx pgx.Tx, fks []backup.ForeignKey, mode string) error {
+	for _, fk := range fks {
+		query := fmt.Sprintf(`ALTER TABLE %s ALTER CONSTRAINT %s %s`,
+			tableName(fk.Table), pgx.Identifier{fk.Name}.Sanitize(), mode)
+		if _, err := tx.Exec(ctx, query); err != nil {
+			return fmt.Errorf("failed to alter foreign key %s: %w", fk.Name, err)
+		}
+	}
+	return nil
+}
+
+// tableName returns the quoted name of a table of the vault.
+func tableName(name string) string {
+	return pgx.Identifier{vaultSchema, name}.Sanitize()
+}
+
+// vaultTableNames returns the quoted names of tables of the vault.
+func vaultTableNames(tables []backup.Table) []string {
+	names := make([]string, 0, len(tables)+1)
+	for _, t := range tables {
+		names = append(names, tableName(t.Name))
+	}
+	return names
+}
+
+// columnList returns the quoted, comma-separated names of columns.
+func columnList(columns []string) string {
+	quoted := make([]string, len(columns))
+	for i, c := range columns {
+		quoted[i] = pgx.Identifier{c}.Sanitize()
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -1,4 +1,4 @@
-package database
+package repository
 
 import (
 	"context"
@@ -16,7 +16,8 @@ func GetAccountByUserName(ctx context.Context, tx pgx.Tx, username string) (acc
 			   account_type,
 			   created_at,
 			   role_changed_at,
-			   updated_at
+			   updated_at,
+			   public_key
 		FROM auth.users
 		WHERE username = $1;
 	`
@@ -30,6 +31,7 @@ func GetAccountByUserName(ctx context.Context, tx pgx.Tx, username string) (acc
 		&acc.CreatedAt,
 		&acc.RoleChangedAt,
 		&acc.UpdatedAt,
+		&acc.PublicKey,
 	)
 	acc.Username = user
 	return
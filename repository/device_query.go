@@ -0,0 +1,169 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gleb-korostelev/GophKeeper/models/device"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
+	"github.com/jackc/pgx/v5"
+)
+
+// GetDeviceByKey retrieves the device of a user with the given public key.
+func GetDeviceByKey(ctx context.Context, tx pgx.Tx, username string, publicKey []byte) (d device.Device, err error) {
+	const query = `
+        SELECT d.id, u.username, d.name, d.platform, d.public_key, d.created_at, d.last_seen_at
+        FROM auth.devices d
+        JOIN auth.users u ON u.id = d.user_id
+        WHERE u.username = $1
+          AND d.public_key = $2
+    `
+
+	err = tx.QueryRow(ctx, query, username, publicKey).Scan(&d.ID, &d.Username, &d.Name, &d.Platform,
+		&d.PublicKey, &d.CreatedAt, &d.LastSeenAt)
+	return
+}
+
+// InsertDevice registers a new device of a user.
+// ErrNoRowsAffected is returned if the user does not exist.
+func InsertDevice(ctx context.Context, tx pgx.Tx, d device.Device) error {
+	const query = `
+        INSERT INTO auth.devices (id, user_id, name, platform, public_key)
+        SELECT $1, id, $3, $4, $5
+        FROM auth.users
+        WHERE username = $2
+    `
+
+	cmdTag, err := tx.Exec(ctx, query, d.ID, d.Username, d.Name, d.Platform, d.PublicKey)
+	if err != nil {
+		return fmt.Errorf("failed to insert device: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// TouchDevice records a new sign-in from a device, updating its name and platform.
+// ErrNoRowsAffected is returned if the device does not exist.
+func TouchDevice(ctx context.Context, tx pgx.Tx, d device.Device) error {
+	const query = `
+        UPDATE auth.devices
+        SET name = $2,
+            platform = $3,
+            last_seen_at = (now() at time zone 'utc')
+        WHERE id = $1
+    `
+
+	cmdTag, err := tx.Exec(ctx, query, d.ID, d.Name, d.Platform)
+	if err != nil {
+		return fmt.Errorf("failed to touch device: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// GetDevices retrieves a page of the devices of a user in the order of the page sort, including one
+// device past the page so the caller can tell whether another page follows.
+// The device the given session is bound to is marked as current.
+func GetDevices(ctx context.Context, tx pgx.Tx, username, sessionID string, page pagination.Request) (
+	[]device.Device, error) {
+	col, ok := deviceSortColumns[page.Sort]
+	if !ok {
+		col = deviceSortColumns[device.SortLastSeen]
+	}
+	cond, tail, pageArgs := uuidKeyset("d", col, page, 3)
+
+	return queryDevices(ctx, tx, cond, tail, append([]any{username, sessionID}, pageArgs...))
+}
+
+// GetUserDevices retrieves all devices of a user, most recently seen first.
+func GetUserDevices(ctx context.Context, tx pgx.Tx, username string) ([]device.Device, error) {
+	return queryDevices(ctx, tx, "TRUE", "ORDER BY d.last_seen_at DESC, d.id DESC", []any{username, ""})
+}
+
+// queryDevices retrieves the devices of the user given by the first argument matching cond, ordered
+// and limited by tail. The device of the session given by the second argument is marked as current.
+func queryDevices(ctx context.Context, tx pgx.Tx, cond, tail string, args []any) ([]device.Device, error) {
+	const queryTmpl = `
+        SELECT d.id, u.username, d.name, d.platform, d.public_key, d.created_at, d.last_seen_at,
+               EXISTS (SELECT 1 FROM auth.sessions s WHERE s.id::text = $2 AND s.device_id = d.id)
+        FROM auth.devices d
+        JOIN auth.users u ON u.id = d.user_id
+        WHERE u.username = $1
+          AND %s
+        %s
+    `
+
+	rows, err := tx.Query(ctx, fmt.Sprintf(queryTmpl, cond, tail), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query devices: %w", err)
+	}
+	defer rows.Close()
+
+	var devices []device.Device
+	for rows.Next() {
+		var d device.Device
+		err := rows.Scan(&d.ID, &d.Username, &d.Name, &d.Platform, &d.PublicKey, &d.CreatedAt, &d.LastSeenAt, &d.Current)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan device: %w", err)
+		}
+		devices = append(devices, d)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", rows.Err())
+	}
+
+	return devices, nil
+}
+
+// DeleteDevice removes a device of a user.
+// ErrNoRowsAffected is returned if the user has no such device.
+func DeleteDevice(ctx context.Context, tx pgx.Tx, username, id string) error {
+	const query = `
+        DELETE FROM auth.devices d
+        USING auth.users u
+        WHERE u.id = d.user_id
+          AND u.username = $1
+          AND d.id::text = $2
+    `
+
+	cmdTag, err := tx.Exec(ctx, query, username, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete device: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// RevokeDeviceSessions revokes all active sessions of a user bound to a device and returns how many were revoked.
+func RevokeDeviceSessions(ctx context.Context, tx pgx.Tx, username, deviceID string) (int64, error) {
+	const query = `
+        UPDATE auth.sessions s
+        SET revoked_at = (now() at time zone 'utc')
+        FROM auth.users u
+        WHERE u.id = s.user_id
+          AND u.username = $1
+          AND s.device_id::text = $2
+          AND s.revoked_at IS NULL
+          AND s.expires_at > (now() at time zone 'utc')
+    `
+
+	cmdTag, err := tx.Exec(ctx, query, username, deviceID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to revoke device sessions: %w", err)
+	}
+
+	return cmdTag.RowsAffected(), nil
+}
@@ -0,0 +1,180 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gleb-korostelev/GophKeeper/models/emergency"
+	"github.com/jackc/pgx/v5"
+)
+
+// UpsertEmergencyContact designates a trusted contact for a grantor.
+// Re-designating an existing contact updates the wait period and resets any request.
+func UpsertEmergencyContact(ctx context.Context, tx pgx.Tx, contact emergency.Contact) error {
+	const query = `
+        INSERT INTO auth.emergency_contacts (grantor_id, grantee_id, wait_days, status)
+        SELECT g.id, e.id, $3, $4
+        FROM auth.users g, auth.users e
+        WHERE g.username = $1
+          AND e.username = $2
+        ON CONFLICT (grantor_id, grantee_id) DO UPDATE
+        SET wait_days = EXCLUDED.wait_days,
+            status = EXCLUDED.status,
+            requested_at = NULL,
+            updated_at = (now() at time zone 'utc')
+    `
+
+	cmdTag, err := tx.Exec(ctx, query, contact.Grantor, contact.Grantee, contact.WaitDays, emergency.StatusIdle)
+	if err != nil {
+		return fmt.Errorf("failed to upsert emergency contact: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// DeleteEmergencyContact removes a trusted contact together with any access it was granted.
+func DeleteEmergencyContact(ctx context.Context, tx pgx.Tx, grantor, grantee string) error {
+	const query = `
+        DELETE FROM auth.emergency_contacts ec
+        USING auth.users g, auth.users e
+        WHERE ec.grantor_id = g.id
+          AND ec.grantee_id = e.id
+          AND g.username = $1
+          AND e.username = $2
+    `
+
+	cmdTag, err := tx.Exec(ctx, query, grantor, grantee)
+	if err != nil {
+		return fmt.Errorf("failed to delete emergency contact: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// GetEmergencyContactForUpdate retrieves a trusted contact, locking it until the end of the transaction.
+func GetEmergencyContactForUpdate(ctx context.Context, tx pgx.Tx, grantor, grantee string) (c emergency.Contact, err error) {
+	const query = `
+        SELECT g.username, e.username, ec.wait_days, ec.status, ec.requested_at
+        FROM auth.emergency_contacts ec
+        JOIN auth.users g ON g.id = ec.grantor_id
+        JOIN auth.users e ON e.id = ec.grantee_id
+        WHERE g.username = $1
+          AND e.username = $2
+        FOR UPDATE OF ec
+    `
+
+	err = tx.QueryRow(ctx, query, grantor, grantee).Scan(
+		&c.Grantor,
+		&c.Grantee,
+		&c.WaitDays,
+		&c.Status,
+		&c.RequestedAt,
+	)
+	return
+}
+
+// UpdateEmergencyStatus moves a trusted contact to a new status.
+// Moving to the requested status also records the request time.
+func UpdateEmergencyStatus(ctx context.Context, tx pgx.Tx, grantor, grantee, status string) error {
+	const query = `
+        UPDATE auth.emergency_contacts ec
+        SET status = $3,
+            requested_at = CASE WHEN $3 = $4 THEN (now() at time zone 'utc') ELSE ec.requested_at END,
+            updated_at = (now() at time zone 'utc')
+        FROM auth.users g, auth.users e
+        WHERE ec.grantor_id = g.id
+          AND ec.grantee_id = e.id
+          AND g.username = $1
+          AND e.username = $2
+    `
+
+	cmdTag, err := tx.Exec(ctx, query, grantor, grantee, status, emergency.StatusRequested)
+	if err != nil {
+		return fmt.Errorf("failed to update emergency status: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// GetEmergencyContacts retrieves the trusted contacts a user designated and those that designated the user.
+func GetEmergencyContacts(ctx context.Context, tx pgx.Tx, username string) ([]emergency.Contact, error) {
+	const query = `
+        SELECT g.username, e.username, ec.wait_days, ec.status, ec.requested_at
+        FROM auth.emergency_contacts ec
+        JOIN auth.users g ON g.id = ec.grantor_id
+        JOIN auth.users e ON e.id = ec.grantee_id
+        WHERE g.username = $1
+           OR e.username = $1
+        ORDER BY ec.created_at
+    `
+
+	rows, err := tx.Query(ctx, query, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query emergency contacts: %w", err)
+	}
+	defer rows.Close()
+
+	var contacts []emergency.Contact
+	for rows.Next() {
+		var c emergency.Contact
+		if err := rows.Scan(&c.Grantor, &c.Grantee, &c.WaitDays, &c.Status, &c.RequestedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan emergency contact: %w", err)
+		}
+		contacts = append(contacts, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over rows: %w", err)
+	}
+
+	return contacts, nil
+}
+
+// ApproveExpiredEmergencyRequests approves every pending request whose wait period has ended
+// and returns the approved contacts.
+func ApproveExpiredEmergencyRequests(ctx context.Context, tx pgx.Tx) ([]emergency.Contact, error) {
+	const query = `
+        UPDATE auth.emergency_contacts ec
+        SET status = $1,
+            updated_at = (now() at time zone 'utc')
+        FROM auth.users g, auth.users e
+        WHERE ec.grantor_id = g.id
+          AND ec.grantee_id = e.id
+          AND ec.status = $2
+          AND ec.requested_at + ec.wait_days * interval '1 day' <= (now() at time zone 'utc')
+        RETURNING g.username, e.username, ec.wait_days, ec.status, ec.requested_at
+    `
+
+	rows, err := tx.Query(ctx, query, emergency.StatusApproved, emergency.StatusRequested)
+	if err != nil {
+		return nil, fmt.Errorf("failed to approve expired emergency requests: %w", err)
+	}
+	defer rows.Close()
+
+	var contacts []emergency.Contact
+	for rows.Next() {
+		var c emergency.Contact
+		if err := rows.Scan(&c.Grantor, &c.Grantee, &c.WaitDays, &c.Status, &c.RequestedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan emergency contact: %w", err)
+		}
+		contacts = append(contacts, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating over rows: %w", err)
+	}
+
+	return contacts, nil
+}
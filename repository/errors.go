@@ -0,0 +1,6 @@
+package repository
+
+import "errors"
+
+// ErrNoRowsAffected is returned by write queries that matched no rows.
+var ErrNoRowsAffected = errors.New("no rows affected")
@@ -0,0 +1,269 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/jackc/pgx/v5"
+)
+
+// InsertFolder creates a folder for a user and returns its identifier.
+// The parent folder, if any, must belong to the same user; otherwise pgx.ErrNoRows is returned.
+func InsertFolder(ctx context.Context, tx pgx.Tx, folder profile.Folder) (id int64, err error) {
+	const query = `
+        INSERT INTO auth.folders (user_id, parent_id, name)
+        SELECT u.id, $2, $3
+        FROM auth.users u
+        WHERE u.username = $1
+          AND ($2::bigint IS NULL OR EXISTS (
+              SELECT 1 FROM auth.folders p WHERE p.id = $2 AND p.user_id = u.id
+          ))
+        RETURNING id
+    `
+
+	err = tx.QueryRow(ctx, query, folder.Username, folder.ParentID, folder.Name).Scan(&id)
+	return
+}
+
+// GetFolders retrieves all folders of a user.
+func GetFolders(ctx context.Context, tx pgx.Tx, username string) ([]profile.Folder, error) {
+	const query = `
+        SELECT f.id, f.parent_id, f.name
+        FROM auth.folders f
+        JOIN auth.users u ON u.id = f.user_id
+        WHERE u.username = $1
+        ORDER BY f.parent_id NULLS FIRST, f.name
+    `
+
+	rows, err := tx.Query(ctx, query, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query folders: %w", err)
+	}
+	defer rows.Close()
+
+	var folders []profile.Folder
+	for rows.Next() {
+		f := profile.Folder{Username: username}
+		if err := rows.Scan(&f.ID, &f.ParentID, &f.Name); err != nil {
+			return nil, fmt.Errorf("failed to scan folder: %w", err)
+		}
+		folders = append(folders, f)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", rows.Err())
+	}
+
+	return folders, nil
+}
+
+// FolderExists reports whether a folder belongs to a user.
+func FolderExists(ctx context.Context, tx pgx.Tx, username string, id int64) (exists bool, err error) {
+	const query = `
+        SELECT EXISTS (
+            SELECT 1
+            FROM auth.folders f
+            JOIN auth.users u ON u.id = f.user_id
+            WHERE u.username = $1
+              AND f.id = $2
+        )
+    `
+
+	err = tx.QueryRow(ctx, query, username, id).Scan(&exists)
+	return
+}
+
+// GetFolderTree retrieves the identifiers of a user's folders among ids, together with all of their subfolders.
+func GetFolderTree(ctx context.Context, tx pgx.Tx, username string, ids []int64) ([]int64, error) {
+	const query = `
+        WITH RECURSIVE tree AS (
+            SELECT f.id
+            FROM auth.folders f
+            JOIN auth.users u ON u.id = f.user_id
+            WHERE u.username = $1
+              AND f.id = ANY($2::bigint[])
+            UNION
+            SELECT f.id
+            FROM auth.folders f
+            JOIN tree t ON f.parent_id = t.id
+        )
+        SELECT id FROM tree
+    `
+
+	rows, err := tx.Query(ctx, query, username, nonNil(ids))
+	if err != nil {
+		return nil, fmt.Errorf("failed to query folder tree: %w", err)
+	}
+	defer rows.Close()
+
+	var tree []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan folder id: %w", err)
+		}
+		tree = append(tree, id)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", rows.Err())
+	}
+
+	return tree, nil
+}
+
+// DeleteFolder removes a user's folder and its subfolders. Cards in them are moved out of any folder.
+func DeleteFolder(ctx context.Context, tx pgx.Tx, username string, id int64) error {
+	const query = `
+        DELETE FROM auth.folders f
+        USING auth.users u
+        WHERE f.user_id = u.id
+          AND u.username = $1
+          AND f.id = $2
+    `
+
+	cmdTag, err := tx.Exec(ctx, query, username, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete folder: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// SetCardTags replaces the tags of a user's card, creating tags the user does not have yet,
+// and keeps the tag names stored on the card for searches in step.
+func SetCardTags(ctx context.Context, tx pgx.Tx, username, cardNumber string, tags []string) error {
+	const deleteQuery = `
+        DELETE FROM auth.card_tags ct
+        USING auth.cards c, auth.users u
+        WHERE ct.card_id = c.id
+          AND c.user_id = u.id
+          AND u.username = $1
+          AND c.card_number = $2
+    `
+
+	_, err := tx.Exec(ctx, deleteQuery, username, cardNumber)
+	if err != nil {
+		return fmt.Errorf("failed to clear card tags: %w", err)
+	}
+
+	if len(tags) == 0 {
+		return updateTagNames(ctx, tx, username, cardNumber)
+	}
+
+	const tagsQuery = `
+        INSERT INTO auth.tags (user_id, name)
+        SELECT u.id, unnest($2::text[])
+        FROM auth.users u
+        WHERE u.username = $1
+        ON CONFLICT (user_id, name) DO NOTHING
+    `
+
+	_, err = tx.Exec(ctx, tagsQuery, username, tags)
+	if err != nil {
+		return fmt.Errorf("failed to insert tags: %w", err)
+	}
+
+	const linkQuery = `
+        INSERT INTO auth.card_tags (card_id, tag_id)
+        SELECT c.id, t.id
+        FROM auth.cards c
+        JOIN auth.users u ON u.id = c.user_id
+        JOIN auth.tags t ON t.user_id = u.id
+        WHERE u.username = $1
+          AND c.card_number = $2
+          AND t.name = ANY($3)
+    `
+
+	_, err = tx.Exec(ctx, linkQuery, username, cardNumber, tags)
+	if err != nil {
+		return fmt.Errorf("failed to tag card: %w", err)
+	}
+
+	return updateTagNames(ctx, tx, username, cardNumber)
+}
+
+// GetTags retrieves all tags of a user with the number of cards carrying each.
+func GetTags(ctx context.Context, tx pgx.Tx, username string) ([]profile.Tag, error) {
+	const query = `
+        SELECT t.name, count(ct.card_id)
+        FROM auth.tags t
+        JOIN auth.users u ON u.id = t.user_id
+        LEFT JOIN auth.card_tags ct ON ct.tag_id = t.id
+        WHERE u.username = $1
+        GROUP BY t.id, t.name
+        ORDER BY t.name
+    `
+
+	rows, err := tx.Query(ctx, query, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tags: %w", err)
+	}
+	defer rows.Close()
+
+	var tags []profile.Tag
+	for rows.Next() {
+		var t profile.Tag
+		if err := rows.Scan(&t.Name, &t.Cards); err != nil {
+			return nil, fmt.Errorf("failed to scan tag: %w", err)
+		}
+		tags = append(tags, t)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", rows.Err())
+	}
+
+	return tags, nil
+}
+
+// DeleteTag removes a user's tag from all of their cards.
+func DeleteTag(ctx context.Context, tx pgx.Tx, username, name string) error {
+	const query = `
+        DELETE FROM auth.tags t
+        USING auth.users u
+        WHERE t.user_id = u.id
+          AND u.username = $1
+          AND t.name = $2
+    `
+
+	cmdTag, err := tx.Exec(ctx, query, username, name)
+	if err != nil {
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return updateTagNames(ctx, tx, username, "")
+}
+
+// updateTagNames stores the names of the tags of a user's card on the card, where the search index reads them.
+// An empty card number updates all cards of the user.
+func updateTagNames(ctx context.Context, tx pgx.Tx, username, cardNumber string) error {
+	const query = `
+        UPDATE auth.cards c
+        SET tag_names = COALESCE((
+                SELECT string_agg(t.name, ' ' ORDER BY t.name)
+                FROM auth.card_tags ct
+                JOIN auth.tags t ON t.id = ct.tag_id
+                WHERE ct.card_id = c.id
+            ), '')
+        FROM auth.users u
+        WHERE u.id = c.user_id
+          AND u.username = $1
+          AND ($2 = '' OR c.card_number = $2)
+    `
+
+	_, err := tx.Exec(ctx, query, username, cardNumber)
+	if err != nil {
+		return fmt.Errorf("failed to update tag names: %w", err)
+	}
+	return nil
+}
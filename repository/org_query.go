@@ -0,0 +1,344 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gleb-korostelev/GophKeeper/models/org"
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
+	"github.com/jackc/pgx/v5"
+)
+
+// InsertOrg creates a new organization owned by username.
+func InsertOrg(ctx context.Context, tx pgx.Tx, name, username string) error {
+	const query = `
+        WITH o AS (
+            INSERT INTO auth.orgs (name) VALUES ($1) RETURNING id
+        )
+        INSERT INTO auth.org_members (org_id, user_id, role)
+        SELECT o.id, u.id, $3
+        FROM o, auth.users u
+        WHERE u.username = $2
+    `
+
+	cmdTag, err := tx.Exec(ctx, query, name, username, org.RoleOwner)
+	if err != nil {
+		return fmt.Errorf("failed to insert org: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// GetUserOrgs retrieves the organizations a user belongs to together with their role.
+func GetUserOrgs(ctx context.Context, tx pgx.Tx, username string) ([]org.Member, error) {
+	var members []org.Member
+
+	const query = `
+        SELECT o.name, m.role
+        FROM auth.org_members m
+        JOIN auth.orgs o ON o.id = m.org_id
+        JOIN auth.users u ON u.id = m.user_id
+        WHERE u.username = $1
+        ORDER BY o.name
+    `
+
+	rows, err := tx.Query(ctx, query, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query user orgs: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		m := org.Member{Username: username}
+		if err := rows.Scan(&m.Org, &m.Role); err != nil {
+			return nil, fmt.Errorf("failed to scan org membership: %w", err)
+		}
+		members = append(members, m)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", rows.Err())
+	}
+
+	return members, nil
+}
+
+// GetOrgRole retrieves the role of a user within an organization.
+func GetOrgRole(ctx context.Context, tx pgx.Tx, orgName, username string) (role string, err error) {
+	const query = `
+        SELECT m.role
+        FROM auth.org_members m
+        JOIN auth.orgs o ON o.id = m.org_id
+        JOIN auth.users u ON u.id = m.user_id
+        WHERE o.name = $1 AND u.username = $2
+    `
+
+	err = tx.QueryRow(ctx, query, orgName, username).Scan(&role)
+	return
+}
+
+// UpsertOrgMember adds a user to an organization or changes their role.
+func UpsertOrgMember(ctx context.Context, tx pgx.Tx, member org.Member) error {
+	const query = `
+    INSERT INTO auth.org_members (org_id, user_id, role, updated_at)
+    SELECT o.id, u.id, $3, now()
+    FROM auth.orgs o, auth.users u
+    WHERE o.name = $1 AND u.username = $2
+    ON CONFLICT (org_id, user_id)
+    DO UPDATE SET
+        role = EXCLUDED.role,
+        updated_at = now();
+    `
+
+	cmdTag, err := tx.Exec(ctx, query, member.Org, member.Username, member.Role)
+	if err != nil {
+		return fmt.Errorf("failed to upsert org member: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// DeleteOrgMember removes a user from an organization along with their memberships of its groups,
+// so that a member added again does not regain the collections of their former groups.
+func DeleteOrgMember(ctx context.Context, tx pgx.Tx, orgName, username string) error {
+	const query = `
+        DELETE FROM auth.org_members m
+        USING auth.orgs o, auth.users u
+        WHERE m.org_id = o.id
+          AND m.user_id = u.id
+          AND o.name = $1
+          AND u.username = $2
+    `
+
+	const groupsQuery = `
+        DELETE FROM auth.org_group_members gm
+        USING auth.org_groups g, auth.orgs o, auth.users u
+        WHERE gm.group_id = g.id
+          AND g.org_id = o.id
+          AND gm.user_id = u.id
+          AND o.name = $1
+          AND u.username = $2
+    `
+
+	cmdTag, err := tx.Exec(ctx, query, orgName, username)
+	if err != nil {
+		return fmt.Errorf("failed to delete org member: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsAffected
+	}
+
+	if _, err = tx.Exec(ctx, groupsQuery, orgName, username); err != nil {
+		return fmt.Errorf("failed to delete org group memberships: %w", err)
+	}
+
+	return nil
+}
+
+// UpsertOrgGroup creates a group within an organization if it does not exist yet
+// and drops all of its current members.
+func UpsertOrgGroup(ctx context.Context, tx pgx.Tx, orgName, name string) (groupID int64, err error) {
+	const query = `
+        INSERT INTO auth.org_groups (org_id, name)
+        SELECT id, $2 FROM auth.orgs WHERE name = $1
+        ON CONFLICT (org_id, name) DO UPDATE SET name = EXCLUDED.name
+        RETURNING id
+    `
+
+	err = tx.QueryRow(ctx, query, orgName, name).Scan(&groupID)
+	if err != nil {
+		return 0, err
+	}
+
+	const clear = `DELETE FROM auth.org_group_members WHERE group_id = $1`
+	if _, err = tx.Exec(ctx, clear, groupID); err != nil {
+		return 0, fmt.Errorf("failed to clear group members: %w", err)
+	}
+
+	return groupID, nil
+}
+
+// InsertOrgGroupMember adds an organization member to a group.
+func InsertOrgGroupMember(ctx context.Context, tx pgx.Tx, groupID int64, username string) error {
+	const query = `
+        INSERT INTO auth.org_group_members (group_id, user_id)
+        SELECT g.id, u.id
+        FROM auth.org_groups g
+        JOIN auth.org_members m ON m.org_id = g.org_id
+        JOIN auth.users u ON u.id = m.user_id
+        WHERE g.id = $1 AND u.username = $2
+        ON CONFLICT (group_id, user_id) DO UPDATE SET user_id = EXCLUDED.user_id
+    `
+
+	cmdTag, err := tx.Exec(ctx, query, groupID, username)
+	if err != nil {
+		return fmt.Errorf("failed to insert group member: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// UpsertCollection creates a collection within an organization if it does not exist yet
+// and drops all groups it is currently visible to.
+func UpsertCollection(ctx context.Context, tx pgx.Tx, orgName, name string) (collectionID int64, err error) {
+	const query = `
+        INSERT INTO auth.collections (org_id, name)
+        SELECT id, $2 FROM auth.orgs WHERE name = $1
+        ON CONFLICT (org_id, name) DO UPDATE SET name = EXCLUDED.name
+        RETURNING id
+    `
+
+	err = tx.QueryRow(ctx, query, orgName, name).Scan(&collectionID)
+	if err != nil {
+		return 0, err
+	}
+
+	const clear = `DELETE FROM auth.collection_groups WHERE collection_id = $1`
+	if _, err = tx.Exec(ctx, clear, collectionID); err != nil {
+		return 0, fmt.Errorf("failed to clear collection groups: %w", err)
+	}
+
+	return collectionID, nil
+}
+
+// InsertCollectionGroup makes a collection visible to a group of the same organization.
+func InsertCollectionGroup(ctx context.Context, tx pgx.Tx, collectionID int64, group string) error {
+	const query = `
+        INSERT INTO auth.collection_groups (collection_id, group_id)
+        SELECT c.id, g.id
+        FROM auth.collections c
+        JOIN auth.org_groups g ON g.org_id = c.org_id
+        WHERE c.id = $1 AND g.name = $2
+        ON CONFLICT (collection_id, group_id) DO UPDATE SET group_id = EXCLUDED.group_id
+    `
+
+	cmdTag, err := tx.Exec(ctx, query, collectionID, group)
+	if err != nil {
+		return fmt.Errorf("failed to insert collection group: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// InsertCollectionCard adds a card owned by username to a collection of an organization.
+func InsertCollectionCard(ctx context.Context, tx pgx.Tx, orgName, collection, username, cardNumber string) error {
+	const query = `
+        INSERT INTO auth.collection_cards (collection_id, card_id)
+        SELECT col.id, c.id
+        FROM auth.collections col
+        JOIN auth.orgs o ON o.id = col.org_id
+        CROSS JOIN auth.cards c
+        JOIN auth.users u ON u.id = c.user_id
+        WHERE o.name = $1
+          AND col.name = $2
+          AND u.username = $3
+          AND c.card_number = $4
+        ON CONFLICT (collection_id, card_id) DO UPDATE SET card_id = EXCLUDED.card_id
+    `
+
+	cmdTag, err := tx.Exec(ctx, query, orgName, collection, username, cardNumber)
+	if err != nil {
+		return fmt.Errorf("failed to insert collection card: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// GetOrgVisibleCards retrieves a page of the cards visible to a user through organization collections.
+// If orgName is empty, cards of all organizations the user belongs to are returned.
+// If itemType is not empty, only cards of that type are returned.
+// Item keys are not returned: they are wrapped to the owner's key, not to the organization.
+func GetOrgVisibleCards(ctx context.Context, tx pgx.Tx, username, orgName, itemType string, page pagination.Request) ([]profile.CardInfo, error) {
+	var cards []profile.CardInfo
+
+	const queryTmpl = `
+        SELECT c.id, c.created_at, c.updated_at,
+               c.card_number, c.card_holder, c.expiration_date, c.cvv, c.metadata,
+               c.owner, c.org, c.collection, c.permission, c.name, c.item_type,
+               c.url, c.login, c.password
+        FROM (
+            SELECT DISTINCT ON (c.id)
+                   c.id, c.created_at, c.updated_at,
+                   c.card_number, c.card_holder, c.expiration_date, c.cvv, c.metadata,
+                   o.username AS owner, org.name AS org, v.collection,
+                   CASE WHEN v.role = $3 THEN $4 ELSE $5 END AS permission,
+                   c.name, c.item_type, c.url, c.login, c.password
+            FROM auth.org_visible_cards v
+            JOIN auth.users u ON u.id = v.user_id
+            JOIN auth.orgs org ON org.id = v.org_id
+            JOIN auth.cards c ON c.id = v.card_id
+            JOIN auth.users o ON o.id = c.user_id
+            WHERE u.username = $1
+              AND ($2 = '' OR org.name = $2)
+              AND ($6 = '' OR c.item_type = $6)
+            ORDER BY c.id, v.role = $3
+        ) c
+        WHERE %s
+        %s
+    `
+
+	cond, tail, pageArgs := cardKeyset(page, 7)
+	query := fmt.Sprintf(queryTmpl, cond, tail)
+	args := append([]any{username, orgName, org.RoleReadOnly, profile.PermissionRead, profile.PermissionReadWrite,
+		itemType}, pageArgs...)
+
+	rows, err := tx.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query org cards: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var card profile.CardInfo
+		if err := rows.Scan(
+			&card.ID,
+			&card.CreatedAt,
+			&card.UpdatedAt,
+			&card.CardNumber,
+			&card.CardHolder,
+			&card.ExpirationDate,
+			&card.Cvv,
+			&card.Metadata,
+			&card.Owner,
+			&card.Org,
+			&card.Collection,
+			&card.Permission,
+			&card.Name,
+			&card.Type,
+			&card.URL,
+			&card.Login,
+			&card.Password,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan org card info: %w", err)
+		}
+		cards = append(cards, card)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", rows.Err())
+	}
+
+	return cards, nil
+}
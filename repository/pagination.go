@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/gleb-korostelev/GophKeeper/models/device"
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
+)
+
+// sortColumn describes how a sort field maps onto a SQL expression.
+//
+// Fields:
+// - expr: The expression items are ordered by.
+// - cast: The SQL type cursor keys are cast to before comparing.
+type sortColumn struct {
+	expr string
+	cast string
+}
+
+// cardSortColumns maps card sort fields onto columns of the cards table aliased as "c".
+// Text is compared with the "C" collation so that the database orders keys byte-wise, like pagination does.
+var cardSortColumns = map[string]sortColumn{
+	profile.SortCreated:    {expr: "c.created_at", cast: "timestamp"},
+	profile.SortUpdated:    {expr: "c.updated_at", cast: "timestamp"},
+	profile.SortHolder:     {expr: `c.card_holder COLLATE "C"`, cast: "text"},
+	profile.SortExpiration: {expr: "c.expiration_date", cast: "date"},
+}
+
+// cardKeyset builds the keyset condition selecting the cards after the page cursor, and the
+// ORDER BY and LIMIT clauses of the page. Placeholders are numbered starting at argN.
+func cardKeyset(page pagination.Request, argN int) (cond, tail string, args []any) {
+	col, ok := cardSortColumns[page.Sort]
+	if !ok {
+		col = cardSortColumns[profile.SortCreated]
+	}
+
+	op, dir := ">", "ASC"
+	if page.Desc {
+		op, dir = "<", "DESC"
+	}
+
+	cond = "TRUE"
+	if page.After != nil {
+		cond = fmt.Sprintf("(%s, c.id) %s ($%d::%s, $%d)", col.expr, op, argN, col.cast, argN+1)
+		args = append(args, page.After.Key, page.After.ID)
+		argN += 2
+	}
+
+	tail = fmt.Sprintf("ORDER BY %s %s, c.id %s LIMIT $%d", col.expr, dir, dir, argN)
+	args = append(args, page.Fetch())
+	return cond, tail, args
+}
+
+// createdColumn orders rows by creation time.
+var createdColumn = sortColumn{expr: "created_at", cast: "timestamp"}
+
+// deviceSortColumns maps device sort fields onto columns of the devices table.
+var deviceSortColumns = map[string]sortColumn{
+	device.SortLastSeen: {expr: "last_seen_at", cast: "timestamp"},
+	device.SortCreated:  createdColumn,
+}
+
+// uuidKeyset builds the keyset condition selecting the rows of the table aliased as alias after the
+// page cursor, and the ORDER BY and LIMIT clauses of the page, for listings ordered by the column col
+// of the table with the UUID of the rows breaking ties. Placeholders are numbered starting at argN.
+func uuidKeyset(alias string, col sortColumn, page pagination.Request, argN int) (cond, tail string, args []any) {
+	op, dir := ">", "ASC"
+	if page.Desc {
+		op, dir = "<", "DESC"
+	}
+
+	cond = "TRUE"
+	if page.After != nil {
+		cond = fmt.Sprintf("(%[1]s.%[2]s, %[1]s.id) %[3]s ($%[4]d::%[5]s, $%[6]d::uuid)",
+			alias, col.expr, op, argN, col.cast, argN+1)
+		args = append(args, page.After.Key, page.After.UID)
+		argN += 2
+	}
+
+	tail = fmt.Sprintf("ORDER BY %[1]s.%[2]s %[3]s, %[1]s.id %[3]s LIMIT $%[4]d", alias, col.expr, dir, argN)
+	args = append(args, page.Fetch())
+	return cond, tail, args
+}
@@ -5,14 +5,16 @@ import (
 	"fmt"
 
 	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
 	"github.com/jackc/pgx/v5"
 )
 
 // UploadCardInfo uploads or updates card information for a user.
 func UploadCardInfo(ctx context.Context, tx pgx.Tx, profile profile.CardInfo) (err error) {
 	const query = `
-    INSERT INTO auth.cards (user_id, card_holder, card_number, expiration_date, cvv, metadata, updated_at)
-    SELECT id, $2, $3, $4, $5, $6, now()
+    INSERT INTO auth.cards (user_id, card_holder, card_number, expiration_date, cvv, metadata, item_key,
+                            name, item_type, folder_id, url, login, password, updated_at)
+    SELECT id, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, now()
     FROM auth.users
     WHERE username = $1
     ON CONFLICT (user_id, card_number)
@@ -21,6 +23,13 @@ func UploadCardInfo(ctx context.Context, tx pgx.Tx, profile profile.CardInfo) (e
         expiration_date = EXCLUDED.expiration_date,
         cvv = EXCLUDED.cvv,
         metadata = EXCLUDED.metadata,
+        item_key = COALESCE(EXCLUDED.item_key, auth.cards.item_key),
+        name = EXCLUDED.name,
+        item_type = EXCLUDED.item_type,
+        folder_id = EXCLUDED.folder_id,
+        url = EXCLUDED.url,
+        login = EXCLUDED.login,
+        password = EXCLUDED.password,
         updated_at = now();
     `
 
@@ -31,6 +40,13 @@ func UploadCardInfo(ctx context.Context, tx pgx.Tx, profile profile.CardInfo) (e
 		profile.ExpirationDate,
 		profile.Cvv,
 		profile.Metadata,
+		profile.ItemKey,
+		profile.Name,
+		profile.Type,
+		profile.FolderID,
+		profile.URL,
+		profile.Login,
+		profile.Password,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to upload card info: %w", err)
@@ -39,18 +55,74 @@ func UploadCardInfo(ctx context.Context, tx pgx.Tx, profile profile.CardInfo) (e
 	return
 }
 
-// GetUserCards retrieves all cards associated with a user.
-func GetUserCards(ctx context.Context, tx pgx.Tx, username string) ([]profile.CardInfo, error) {
+// GetUserCards retrieves a page of the cards associated with a user that match the filter.
+// A folder filter also matches cards in any of the folder's subfolders, and so do the folders
+// of a restricted API key scope.
+func GetUserCards(ctx context.Context, tx pgx.Tx, username string, filter profile.Filter, page pagination.Request) ([]profile.CardInfo, error) {
 	var cards []profile.CardInfo
 
-	const query = `
-        SELECT c.card_number, c.card_holder, c.expiration_date, c.cvv, c.metadata
+	const queryTmpl = `
+        WITH RECURSIVE subfolders AS (
+            SELECT f.id
+            FROM auth.folders f
+            JOIN auth.users u ON u.id = f.user_id
+            WHERE u.username = $1
+              AND f.id = $2
+            UNION
+            SELECT f.id
+            FROM auth.folders f
+            JOIN subfolders s ON f.parent_id = s.id
+        ),
+        scoped AS (
+            SELECT f.id
+            FROM auth.folders f
+            JOIN auth.users u ON u.id = f.user_id
+            WHERE u.username = $1
+              AND f.id = ANY($6::bigint[])
+            UNION
+            SELECT f.id
+            FROM auth.folders f
+            JOIN scoped s ON f.parent_id = s.id
+        )
+        SELECT c.id, c.created_at, c.updated_at,
+               c.card_number, c.card_holder, c.expiration_date, c.cvv, c.metadata, c.item_key,
+               c.name, c.item_type, c.folder_id, c.url, c.login, c.password,
+               ARRAY(
+                   SELECT t.name
+                   FROM auth.card_tags ct
+                   JOIN auth.tags t ON t.id = ct.tag_id
+                   WHERE ct.card_id = c.id
+                   ORDER BY t.name
+               )
         FROM auth.cards c
         JOIN auth.users u ON c.user_id = u.id
         WHERE u.username = $1
+          AND ($2::bigint IS NULL OR c.folder_id IN (SELECT id FROM subfolders))
+          AND ($3 = '' OR EXISTS (
+              SELECT 1
+              FROM auth.card_tags ct
+              JOIN auth.tags t ON t.id = ct.tag_id
+              WHERE ct.card_id = c.id
+                AND t.name = $3
+          ))
+          AND ($4 = '' OR c.item_type = $4)
+          AND ($5::text[] IS NULL OR c.card_number = ANY($5) OR c.folder_id IN (SELECT id FROM scoped))
+          AND %s
+        %s
     `
 
-	rows, err := tx.Query(ctx, query, username)
+	// A NULL item list disables the scope condition.
+	var scopeItems []string
+	var scopeFolders []int64
+	if filter.Scope != nil && filter.Scope.Restricted() {
+		scopeItems, scopeFolders = nonNil(filter.Scope.Items), filter.Scope.Folders
+	}
+
+	cond, tail, pageArgs := cardKeyset(page, 7)
+	query := fmt.Sprintf(queryTmpl, cond, tail)
+	args := append([]any{username, filter.FolderID, filter.Tag, filter.Type, scopeItems, scopeFolders}, pageArgs...)
+
+	rows, err := tx.Query(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query user cards: %w", err)
 	}
@@ -58,7 +130,102 @@ func GetUserCards(ctx context.Context, tx pgx.Tx, username string) ([]profile.Ca
 
 	for rows.Next() {
 		var card profile.CardInfo
-		if err := rows.Scan(&card.CardNumber, &card.CardHolder, &card.ExpirationDate, &card.Cvv, &card.Metadata); err != nil {
+		if err := rows.Scan(
+			&card.ID,
+			&card.CreatedAt,
+			&card.UpdatedAt,
+			&card.CardNumber,
+			&card.CardHolder,
+			&card.ExpirationDate,
+			&card.Cvv,
+			&card.Metadata,
+			&card.ItemKey,
+			&card.Name,
+			&card.Type,
+			&card.FolderID,
+			&card.URL,
+			&card.Login,
+			&card.Password,
+			&card.Tags,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan card info: %w", err)
+		}
+		cards = append(cards, card)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", rows.Err())
+	}
+
+	return cards, nil
+}
+
+// SearchCards performs a full-text search over the names, holders, metadata and tags of a user's cards,
+// returning a page of the best matches first. Card numbers and CVVs are never searched.
+// The document matched is the expression of the cards_search_idx index, so that the index serves the search.
+func SearchCards(ctx context.Context, tx pgx.Tx, username, text string, page pagination.Request) (
+	[]profile.CardInfo, error) {
+	var cards []profile.CardInfo
+
+	const queryTmpl = `
+        SELECT c.id, c.card_number, c.card_holder, c.expiration_date, c.cvv, c.metadata, c.item_key,
+               c.name, c.item_type, c.folder_id, c.url, c.login, c.password, c.tags, c.rank
+        FROM (
+            SELECT c.id, c.card_number, c.card_holder, c.expiration_date, c.cvv, c.metadata, c.item_key,
+                   c.name, c.item_type, c.folder_id, c.url, c.login, c.password,
+                   ARRAY(
+                       SELECT t.name
+                       FROM auth.card_tags ct
+                       JOIN auth.tags t ON t.id = ct.tag_id
+                       WHERE ct.card_id = c.id
+                       ORDER BY t.name
+                   ) AS tags,
+                   ts_rank(to_tsvector('simple', c.name || ' ' || c.card_holder || ' ' || c.metadata || ' ' ||
+                                                 c.tag_names), q) AS rank
+            FROM auth.cards c
+            JOIN auth.users u ON c.user_id = u.id
+            CROSS JOIN websearch_to_tsquery('simple', $2) q
+            WHERE u.username = $1
+              AND to_tsvector('simple', c.name || ' ' || c.card_holder || ' ' || c.metadata || ' ' ||
+                                        c.tag_names) @@ q
+        ) c
+        WHERE %s
+        ORDER BY c.rank DESC, c.id DESC
+        LIMIT $3
+    `
+
+	cond := "TRUE"
+	args := []any{username, text, page.Fetch()}
+	if page.After != nil {
+		cond = "(c.rank, c.id) < ($4::real, $5)"
+		args = append(args, page.After.Key, page.After.ID)
+	}
+
+	rows, err := tx.Query(ctx, fmt.Sprintf(queryTmpl, cond), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search user cards: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var card profile.CardInfo
+		if err := rows.Scan(
+			&card.ID,
+			&card.CardNumber,
+			&card.CardHolder,
+			&card.ExpirationDate,
+			&card.Cvv,
+			&card.Metadata,
+			&card.ItemKey,
+			&card.Name,
+			&card.Type,
+			&card.FolderID,
+			&card.URL,
+			&card.Login,
+			&card.Password,
+			&card.Tags,
+			&card.Rank,
+		); err != nil {
 			return nil, fmt.Errorf("failed to scan card info: %w", err)
 		}
 		cards = append(cards, card)
@@ -71,6 +238,43 @@ func GetUserCards(ctx context.Context, tx pgx.Tx, username string) ([]profile.Ca
 	return cards, nil
 }
 
+// GetAllUserCards retrieves all cards owned by a user in creation order, loading them page by page.
+func GetAllUserCards(ctx context.Context, tx pgx.Tx, username string) ([]profile.CardInfo, error) {
+	var all []profile.CardInfo
+
+	page := pagination.Request{Limit: pagination.MaxLimit, Sort: profile.SortCreated}
+	for {
+		cards, err := GetUserCards(ctx, tx, username, profile.Filter{}, page)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(cards) <= page.Limit {
+			return append(all, cards...), nil
+		}
+
+		cards = cards[:page.Limit]
+		all = append(all, cards...)
+
+		after := cards[len(cards)-1].Cursor(page.Sort)
+		page.After = &after
+	}
+}
+
+// GetCardFolder retrieves the folder holding a card of a user; nil means the card is in no folder.
+func GetCardFolder(ctx context.Context, tx pgx.Tx, username, cardNumber string) (folderID *int64, err error) {
+	const query = `
+        SELECT c.folder_id
+        FROM auth.cards c
+        JOIN auth.users u ON u.id = c.user_id
+        WHERE u.username = $1
+          AND c.card_number = $2
+    `
+
+	err = tx.QueryRow(ctx, query, username, cardNumber).Scan(&folderID)
+	return
+}
+
 // deleteCard removes a specific card associated with a user from the database.
 func DeleteCard(ctx context.Context, tx pgx.Tx, username, cardNumber string) error {
 	const query = `
@@ -92,3 +296,35 @@ func DeleteCard(ctx context.Context, tx pgx.Tx, username, cardNumber string) err
 
 	return nil
 }
+
+// GetCardKeys retrieves the identifying fields of all of a user's cards:
+// card number, item type, name, URL and login.
+func GetCardKeys(ctx context.Context, tx pgx.Tx, username string) ([]profile.CardInfo, error) {
+	const query = `
+        SELECT c.card_number, c.item_type, c.name, c.url, c.login
+        FROM auth.cards c
+        JOIN auth.users u ON c.user_id = u.id
+        WHERE u.username = $1
+    `
+
+	rows, err := tx.Query(ctx, query, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query card keys: %w", err)
+	}
+	defer rows.Close()
+
+	var cards []profile.CardInfo
+	for rows.Next() {
+		card := profile.CardInfo{Username: username}
+		if err := rows.Scan(&card.CardNumber, &card.Type, &card.Name, &card.URL, &card.Login); err != nil {
+			return nil, fmt.Errorf("failed to scan card key: %w", err)
+		}
+		cards = append(cards, card)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", rows.Err())
+	}
+
+	return cards, nil
+}
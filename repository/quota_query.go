@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/quota"
+	"github.com/jackc/pgx/v5"
+)
+
+// GetQuota retrieves the account type of a user and the limits overridden for them, locking the user
+// until the end of the transaction, so that concurrent writes of the user cannot overrun a limit together.
+func GetQuota(ctx context.Context, tx pgx.Tx, username string) (
+	accType models.AccountType, override quota.Override, err error) {
+	const query = `
+        SELECT u.account_type, q.items, q.bytes, q.attachment_size, q.api_keys, q.devices
+        FROM auth.users u
+        LEFT JOIN auth.quota_overrides q ON q.user_id = u.id
+        WHERE u.username = $1
+        FOR UPDATE OF u
+    `
+
+	err = tx.QueryRow(ctx, query, username).Scan(
+		&accType,
+		&override.Items,
+		&override.Bytes,
+		&override.AttachmentSize,
+		&override.APIKeys,
+		&override.Devices,
+	)
+	return
+}
+
+// GetUsage counts what a user stores: their items and the total size of the items' fields and of the declared
+// size of their attachments, their API keys that have not expired and their devices.
+// Items and sizes are read from the user's row of auth.user_usage, which triggers keep up to date
+// in the transaction of every write, so that the check of a write does not sum all of the user's items.
+func GetUsage(ctx context.Context, tx pgx.Tx, username string) (usage quota.Usage, err error) {
+	const query = `
+        SELECT COALESCE(uu.items, 0),
+               COALESCE(uu.bytes, 0),
+               (SELECT COUNT(*) FROM auth.api_keys k
+                WHERE k.user_id = u.id AND k.expires_at > (now() at time zone 'utc')),
+               (SELECT COUNT(*) FROM auth.devices d WHERE d.user_id = u.id)
+        FROM auth.users u
+        LEFT JOIN auth.user_usage uu ON uu.user_id = u.id
+        WHERE u.username = $1
+    `
+
+	err = tx.QueryRow(ctx, query, username).Scan(&usage.Items, &usage.Bytes, &usage.APIKeys, &usage.Devices)
+	return
+}
+
+// UpsertQuotaOverride replaces the limits overridden for a user; nil limits keep those of the user's plan.
+// ErrNoRowsAffected is returned if the user does not exist.
+func UpsertQuotaOverride(ctx context.Context, tx pgx.Tx, username string, o quota.Override) error {
+	const query = `
+        INSERT INTO auth.quota_overrides (user_id, items, bytes, attachment_size, api_keys, devices)
+        SELECT id, $2, $3, $4, $5, $6
+        FROM auth.users
+        WHERE username = $1
+        ON CONFLICT (user_id)
+        DO UPDATE SET
+            items = EXCLUDED.items,
+            bytes = EXCLUDED.bytes,
+            attachment_size = EXCLUDED.attachment_size,
+            api_keys = EXCLUDED.api_keys,
+            devices = EXCLUDED.devices,
+            updated_at = (now() at time zone 'utc')
+    `
+
+	cmdTag, err := tx.Exec(ctx, query, username, o.Items, o.Bytes, o.AttachmentSize, o.APIKeys, o.Devices)
+	if err != nil {
+		return fmt.Errorf("failed to upsert quota override: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// RecountUsage recomputes the usage rows of all users from their items and attachments,
+// such as after a restore, which inserts rows before the users they belong to exist.
+func RecountUsage(ctx context.Context, tx pgx.Tx) error {
+	const query = `
+        INSERT INTO auth.user_usage (user_id, items, bytes)
+        SELECT u.id,
+               (SELECT COUNT(*) FROM auth.cards c WHERE c.user_id = u.id),
+               (SELECT COALESCE(SUM(auth.card_bytes(c)), 0) FROM auth.cards c WHERE c.user_id = u.id) +
+               (SELECT COALESCE(SUM(a.size), 0) FROM auth.attachments a WHERE a.user_id = u.id)
+        FROM auth.users u
+        ON CONFLICT (user_id)
+        DO UPDATE SET
+            items = EXCLUDED.items,
+            bytes = EXCLUDED.bytes
+    `
+
+	if _, err := tx.Exec(ctx, query); err != nil {
+		return fmt.Errorf("failed to recount usage: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gleb-korostelev/GophKeeper/models/recovery"
+	"github.com/jackc/pgx/v5"
+)
+
+// UpsertRecoveryKit stores the recovery kit of a user, replacing any previous kit.
+// ErrNoRowsAffected is returned if the user does not exist.
+func UpsertRecoveryKit(ctx context.Context, tx pgx.Tx, kit recovery.Kit) error {
+	const query = `
+        INSERT INTO auth.recovery_kits (user_id, kit_id, shares, threshold, verifier)
+        SELECT id, $2, $3, $4, $5
+        FROM auth.users
+        WHERE username = $1
+        ON CONFLICT (user_id) DO UPDATE
+        SET kit_id = EXCLUDED.kit_id,
+            shares = EXCLUDED.shares,
+            threshold = EXCLUDED.threshold,
+            verifier = EXCLUDED.verifier,
+            created_at = (now() at time zone 'utc')
+    `
+
+	cmdTag, err := tx.Exec(ctx, query, kit.Username, int64(kit.ID), kit.Shares, kit.Threshold, kit.Verifier)
+	if err != nil {
+		return fmt.Errorf("failed to upsert recovery kit: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// GetRecoveryKit retrieves the recovery kit of a user.
+func GetRecoveryKit(ctx context.Context, tx pgx.Tx, username string) (kit recovery.Kit, err error) {
+	const query = `
+        SELECT k.kit_id, u.username, k.shares, k.threshold, k.verifier, k.created_at
+        FROM auth.recovery_kits k
+        JOIN auth.users u ON u.id = k.user_id
+        WHERE u.username = $1
+    `
+
+	var id int64
+	err = tx.QueryRow(ctx, query, username).Scan(&id, &kit.Username, &kit.Shares, &kit.Threshold,
+		&kit.Verifier, &kit.CreatedAt)
+	kit.ID = uint32(id)
+	return
+}
+
+// DeleteRecoveryKit removes the recovery kit of a user.
+// ErrNoRowsAffected is returned if the user has no kit.
+func DeleteRecoveryKit(ctx context.Context, tx pgx.Tx, username string) error {
+	const query = `
+        DELETE FROM auth.recovery_kits k
+        USING auth.users u
+        WHERE u.id = k.user_id
+          AND u.username = $1
+    `
+
+	cmdTag, err := tx.Exec(ctx, query, username)
+	if err != nil {
+		return fmt.Errorf("failed to delete recovery kit: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
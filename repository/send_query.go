@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gleb-korostelev/GophKeeper/models/send"
+	"github.com/jackc/pgx/v5"
+)
+
+// InsertSend stores a new one-time share link for a user.
+func InsertSend(ctx context.Context, tx pgx.Tx, s send.Send) error {
+	const query = `
+        INSERT INTO auth.sends (id, user_id, ciphertext, password_hash, max_views, expires_at)
+        SELECT $1, id, $3, $4, $5, $6
+        FROM auth.users
+        WHERE username = $2
+    `
+
+	cmdTag, err := tx.Exec(ctx, query,
+		s.ID,
+		s.Username,
+		s.Ciphertext,
+		s.PasswordHash,
+		s.MaxViews,
+		s.ExpiresAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert send: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// GetSendForUpdate retrieves a send that still has views left, has not expired and has not been disabled
+// by wrong passwords, locking it until the end of the transaction.
+func GetSendForUpdate(ctx context.Context, tx pgx.Tx, id string) (s send.Send, err error) {
+	const query = `
+        SELECT id, ciphertext, password_hash, max_views, views, expires_at, created_at
+        FROM auth.sends
+        WHERE id = $1
+          AND views < max_views
+          AND failed_attempts < $2
+          AND expires_at > (now() at time zone 'utc')
+        FOR UPDATE
+    `
+
+	err = tx.QueryRow(ctx, query, id, send.MaxFailedAttempts).Scan(
+		&s.ID,
+		&s.Ciphertext,
+		&s.PasswordHash,
+		&s.MaxViews,
+		&s.Views,
+		&s.ExpiresAt,
+		&s.CreatedAt,
+	)
+	return
+}
+
+// IncrementSendViews consumes one view of a send.
+func IncrementSendViews(ctx context.Context, tx pgx.Tx, id string) (err error) {
+	const query = `
+        UPDATE auth.sends
+        SET views = views + 1
+        WHERE id = $1
+    `
+
+	_, err = tx.Exec(ctx, query, id)
+	return
+}
+
+// IncrementSendFailedAttempts counts a wrong password given to open a send.
+func IncrementSendFailedAttempts(ctx context.Context, tx pgx.Tx, id string) (err error) {
+	const query = `
+        UPDATE auth.sends
+        SET failed_attempts = failed_attempts + 1
+        WHERE id = $1
+    `
+
+	_, err = tx.Exec(ctx, query, id)
+	return
+}
+
+// DeleteExpiredSends removes sends that have expired, have no views left or were disabled by wrong passwords.
+func DeleteExpiredSends(ctx context.Context, tx pgx.Tx) (int64, error) {
+	const query = `
+        DELETE FROM auth.sends
+        WHERE expires_at <= (now() at time zone 'utc')
+           OR views >= max_views
+           OR failed_attempts >= $1
+    `
+
+	cmdTag, err := tx.Exec(ctx, query, send.MaxFailedAttempts)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired sends: %w", err)
+	}
+
+	return cmdTag.RowsAffected(), nil
+}
@@ -2,17 +2,134 @@ package repository
 
 import (
 	"context"
+	"io"
+	"time"
 
 	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/account"
+	"github.com/gleb-korostelev/GophKeeper/models/apikey"
+	"github.com/gleb-korostelev/GophKeeper/models/attachment"
+	"github.com/gleb-korostelev/GophKeeper/models/audit"
+	"github.com/gleb-korostelev/GophKeeper/models/backup"
+	"github.com/gleb-korostelev/GophKeeper/models/device"
+	"github.com/gleb-korostelev/GophKeeper/models/emergency"
+	"github.com/gleb-korostelev/GophKeeper/models/org"
 	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/gleb-korostelev/GophKeeper/models/quota"
+	"github.com/gleb-korostelev/GophKeeper/models/recovery"
+	"github.com/gleb-korostelev/GophKeeper/models/send"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
 	"github.com/jackc/pgx/v5"
 )
 
 type Repository interface {
 	GetAccountByUserName(ctx context.Context, tx pgx.Tx, username string) (models.Account, error)
 	UploadCardInfo(ctx context.Context, tx pgx.Tx, profile profile.CardInfo) error
-	GetUserCards(ctx context.Context, tx pgx.Tx, username string) ([]profile.CardInfo, error)
+	GetUserCards(ctx context.Context, tx pgx.Tx, username string, filter profile.Filter, page pagination.Request) ([]profile.CardInfo, error)
+	GetAllUserCards(ctx context.Context, tx pgx.Tx, username string) ([]profile.CardInfo, error)
+	GetCardKeys(ctx context.Context, tx pgx.Tx, username string) ([]profile.CardInfo, error)
+	SearchCards(ctx context.Context, tx pgx.Tx, username, text string, page pagination.Request) ([]profile.CardInfo, error)
 	DeleteCard(ctx context.Context, tx pgx.Tx, username, cardNumber string) error
+	GetCardFolder(ctx context.Context, tx pgx.Tx, username, cardNumber string) (folderID *int64, err error)
 	InsertAccount(ctx context.Context, tx pgx.Tx, username string, secret []byte) (err error)
 	UpdateAccountType(ctx context.Context, tx pgx.Tx, username string, accType models.AccountType) (err error)
+	GetSignInFailures(ctx context.Context, tx pgx.Tx, username, client string) (models.SignInFailures, error)
+	RecordFailedSignIn(ctx context.Context, tx pgx.Tx, username, client string, max int, lockout time.Duration) (err error)
+	ResetFailedSignIns(ctx context.Context, tx pgx.Tx, username, client string) (err error)
+	SetPublicKey(ctx context.Context, tx pgx.Tx, username string, publicKey []byte) (err error)
+	GetCardAccess(ctx context.Context, tx pgx.Tx, username, cardNumber string) (owner, permission string, encrypted bool, err error)
+	UpsertCardShare(ctx context.Context, tx pgx.Tx, share profile.CardShare) error
+	DeleteCardShare(ctx context.Context, tx pgx.Tx, owner, grantee, cardNumber string) error
+	GetSharedCards(ctx context.Context, tx pgx.Tx, username, itemType string, page pagination.Request) ([]profile.CardInfo, error)
+	UpdateSharedCardInfo(ctx context.Context, tx pgx.Tx, card profile.CardInfo) error
+	InsertOrg(ctx context.Context, tx pgx.Tx, name, username string) error
+	GetUserOrgs(ctx context.Context, tx pgx.Tx, username string) ([]org.Member, error)
+	GetOrgRole(ctx context.Context, tx pgx.Tx, orgName, username string) (role string, err error)
+	UpsertOrgMember(ctx context.Context, tx pgx.Tx, member org.Member) error
+	DeleteOrgMember(ctx context.Context, tx pgx.Tx, orgName, username string) error
+	UpsertOrgGroup(ctx context.Context, tx pgx.Tx, orgName, name string) (groupID int64, err error)
+	InsertOrgGroupMember(ctx context.Context, tx pgx.Tx, groupID int64, username string) error
+	UpsertCollection(ctx context.Context, tx pgx.Tx, orgName, name string) (collectionID int64, err error)
+	InsertCollectionGroup(ctx context.Context, tx pgx.Tx, collectionID int64, group string) error
+	InsertCollectionCard(ctx context.Context, tx pgx.Tx, orgName, collection, username, cardNumber string) error
+	GetOrgVisibleCards(ctx context.Context, tx pgx.Tx, username, orgName, itemType string, page pagination.Request) ([]profile.CardInfo, error)
+	InsertSend(ctx context.Context, tx pgx.Tx, s send.Send) error
+	GetSendForUpdate(ctx context.Context, tx pgx.Tx, id string) (send.Send, error)
+	IncrementSendViews(ctx context.Context, tx pgx.Tx, id string) (err error)
+	IncrementSendFailedAttempts(ctx context.Context, tx pgx.Tx, id string) (err error)
+	DeleteExpiredSends(ctx context.Context, tx pgx.Tx) (int64, error)
+	UpsertEmergencyContact(ctx context.Context, tx pgx.Tx, contact emergency.Contact) error
+	DeleteEmergencyContact(ctx context.Context, tx pgx.Tx, grantor, grantee string) error
+	GetEmergencyContactForUpdate(ctx context.Context, tx pgx.Tx, grantor, grantee string) (emergency.Contact, error)
+	UpdateEmergencyStatus(ctx context.Context, tx pgx.Tx, grantor, grantee, status string) error
+	GetEmergencyContacts(ctx context.Context, tx pgx.Tx, username string) ([]emergency.Contact, error)
+	InsertFolder(ctx context.Context, tx pgx.Tx, folder profile.Folder) (id int64, err error)
+	GetFolders(ctx context.Context, tx pgx.Tx, username string) ([]profile.Folder, error)
+	FolderExists(ctx context.Context, tx pgx.Tx, username string, id int64) (exists bool, err error)
+	GetFolderTree(ctx context.Context, tx pgx.Tx, username string, ids []int64) ([]int64, error)
+	DeleteFolder(ctx context.Context, tx pgx.Tx, username string, id int64) error
+	SetCardTags(ctx context.Context, tx pgx.Tx, username, cardNumber string, tags []string) error
+	GetTags(ctx context.Context, tx pgx.Tx, username string) ([]profile.Tag, error)
+	DeleteTag(ctx context.Context, tx pgx.Tx, username, name string) error
+	ApproveExpiredEmergencyRequests(ctx context.Context, tx pgx.Tx) ([]emergency.Contact, error)
+	InsertAttachment(ctx context.Context, tx pgx.Tx, a attachment.Attachment) error
+	GetAttachment(ctx context.Context, tx pgx.Tx, username, id string) (attachment.Attachment, error)
+	GetAttachmentForUpdate(ctx context.Context, tx pgx.Tx, username, id string) (attachment.Attachment, error)
+	GetCardAttachments(ctx context.Context, tx pgx.Tx, username, cardNumber string, page pagination.Request) (
+		[]attachment.Attachment, error)
+	UpdateAttachmentProgress(ctx context.Context, tx pgx.Tx, id string, received int64, complete bool) error
+	DeleteAttachment(ctx context.Context, tx pgx.Tx, username, id string) error
+	DeleteStaleAttachments(ctx context.Context, tx pgx.Tx, before time.Time) ([]string, error)
+	InsertAuditEvent(ctx context.Context, tx pgx.Tx, e audit.Event) error
+	UpdateAccountSecret(ctx context.Context, tx pgx.Tx, username string, oldSecret, secret []byte) error
+	InsertSession(ctx context.Context, tx pgx.Tx, id, username, deviceID string, expiresAt time.Time) error
+	IsSessionActive(ctx context.Context, tx pgx.Tx, id string) (active bool, deviceKey []byte, err error)
+	RevokeSessions(ctx context.Context, tx pgx.Tx, username, keepID string) (int64, error)
+	DeleteExpiredSessions(ctx context.Context, tx pgx.Tx) (int64, error)
+	DeleteStaleSignInFailures(ctx context.Context, tx pgx.Tx, age time.Duration) (int64, error)
+	GetItemKeys(ctx context.Context, tx pgx.Tx, username string) ([]profile.ItemKey, error)
+	UpdateItemKey(ctx context.Context, tx pgx.Tx, username string, key profile.ItemKey) error
+	GetUserShares(ctx context.Context, tx pgx.Tx, username string) ([]profile.CardShare, error)
+	GetUserSends(ctx context.Context, tx pgx.Tx, username string) ([]send.Send, error)
+	GetUserAttachments(ctx context.Context, tx pgx.Tx, username string) ([]attachment.Attachment, error)
+	GetSessions(ctx context.Context, tx pgx.Tx, username string) ([]account.Session, error)
+	GetAuditEvents(ctx context.Context, tx pgx.Tx, username string) ([]audit.Event, error)
+	AnonymizeAuditEvents(ctx context.Context, tx pgx.Tx, username, alias string) error
+	GetOrphanedOrgs(ctx context.Context, tx pgx.Tx, username string) ([]string, error)
+	DeleteSoleMemberOrgs(ctx context.Context, tx pgx.Tx, username string) error
+	DeleteAccount(ctx context.Context, tx pgx.Tx, username string) error
+	UpsertRecoveryKit(ctx context.Context, tx pgx.Tx, kit recovery.Kit) error
+	GetRecoveryKit(ctx context.Context, tx pgx.Tx, username string) (recovery.Kit, error)
+	DeleteRecoveryKit(ctx context.Context, tx pgx.Tx, username string) error
+	GetDeviceByKey(ctx context.Context, tx pgx.Tx, username string, publicKey []byte) (device.Device, error)
+	InsertDevice(ctx context.Context, tx pgx.Tx, d device.Device) error
+	TouchDevice(ctx context.Context, tx pgx.Tx, d device.Device) error
+	GetDevices(ctx context.Context, tx pgx.Tx, username, sessionID string, page pagination.Request) (
+		[]device.Device, error)
+	GetUserDevices(ctx context.Context, tx pgx.Tx, username string) ([]device.Device, error)
+	DeleteDevice(ctx context.Context, tx pgx.Tx, username, id string) error
+	RevokeDeviceSessions(ctx context.Context, tx pgx.Tx, username, deviceID string) (int64, error)
+	InsertAPIKey(ctx context.Context, tx pgx.Tx, k apikey.Key) error
+	GetAPIKey(ctx context.Context, tx pgx.Tx, id string) (apikey.Key, error)
+	GetAPIKeys(ctx context.Context, tx pgx.Tx, username string, page pagination.Request) ([]apikey.Key, error)
+	GetUserAPIKeys(ctx context.Context, tx pgx.Tx, username string) ([]apikey.Key, error)
+	TouchAPIKey(ctx context.Context, tx pgx.Tx, id string) error
+	DeleteAPIKey(ctx context.Context, tx pgx.Tx, username, id string) error
+	GetVaultTables(ctx context.Context, tx pgx.Tx) ([]backup.Table, error)
+	CopyTableTo(ctx context.Context, tx pgx.Tx, table backup.Table, w io.Writer) (int64, error)
+	CopyTableFrom(ctx context.Context, tx pgx.Tx, table backup.Table, r io.Reader) (int64, error)
+	GetBlobs(ctx context.Context, tx pgx.Tx) ([]backup.Blob, error)
+	ReadBlob(ctx context.Context, tx pgx.Tx, blob backup.Blob, w io.Writer) (int64, error)
+	WriteBlob(ctx context.Context, tx pgx.Tx, key string, r io.Reader) (int64, error)
+	GetSequences(ctx context.Context, tx pgx.Tx) ([]backup.Sequence, error)
+	SetSequences(ctx context.Context, tx pgx.Tx, seqs []backup.Sequence) error
+	IsVaultEmpty(ctx context.Context, tx pgx.Tx, tables []backup.Table) (bool, error)
+	TruncateVault(ctx context.Context, tx pgx.Tx, tables []backup.Table) error
+	GetForeignKeys(ctx context.Context, tx pgx.Tx) ([]backup.ForeignKey, error)
+	DeferForeignKeys(ctx context.Context, tx pgx.Tx, fks []backup.ForeignKey) error
+	CheckForeignKeys(ctx context.Context, tx pgx.Tx, fks []backup.ForeignKey) error
+	GetQuota(ctx context.Context, tx pgx.Tx, username string) (accType models.AccountType, override quota.Override, err error)
+	GetUsage(ctx context.Context, tx pgx.Tx, username string) (quota.Usage, error)
+	RecountUsage(ctx context.Context, tx pgx.Tx) error
+	UpsertQuotaOverride(ctx context.Context, tx pgx.Tx, username string, o quota.Override) error
 }
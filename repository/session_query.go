@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// InsertSession records a new sign-in session of a user, bound to a device unless deviceID is empty.
+// ErrNoRowsAffected is returned if the user does not exist.
+func InsertSession(ctx context.Context, tx pgx.Tx, id, username, deviceID string, expiresAt time.Time) error {
+	const query = `
+        INSERT INTO auth.sessions (id, user_id, device_id, expires_at)
+        SELECT $1, id, NULLIF($3, '')::uuid, $4
+        FROM auth.users
+        WHERE username = $2
+    `
+
+	cmdTag, err := tx.Exec(ctx, query, id, username, deviceID, expiresAt)
+	if err != nil {
+		return fmt.Errorf("failed to insert session: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// IsSessionActive reports whether a session exists and has neither expired nor been revoked.
+// For an active session bound to a device, the device's public key is returned as well.
+func IsSessionActive(ctx context.Context, tx pgx.Tx, id string) (active bool, deviceKey []byte, err error) {
+	const query = `
+        SELECT d.public_key
+        FROM auth.sessions s
+        LEFT JOIN auth.devices d ON d.id = s.device_id
+        WHERE s.id = $1
+          AND s.revoked_at IS NULL
+          AND s.expires_at > (now() at time zone 'utc')
+    `
+
+	err = tx.QueryRow(ctx, query, id).Scan(&deviceKey)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil, nil
+	}
+	if err != nil {
+		return false, nil, err
+	}
+	return true, deviceKey, nil
+}
+
+// RevokeSessions revokes all active sessions of a user except the one with the given identifier,
+// which may be empty to revoke every session, and returns how many were revoked.
+func RevokeSessions(ctx context.Context, tx pgx.Tx, username, keepID string) (int64, error) {
+	const query = `
+        UPDATE auth.sessions s
+        SET revoked_at = (now() at time zone 'utc')
+        FROM auth.users u
+        WHERE u.id = s.user_id
+          AND u.username = $1
+          AND s.id::text <> $2
+          AND s.revoked_at IS NULL
+          AND s.expires_at > (now() at time zone 'utc')
+    `
+
+	cmdTag, err := tx.Exec(ctx, query, username, keepID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to revoke sessions: %w", err)
+	}
+
+	return cmdTag.RowsAffected(), nil
+}
+
+// DeleteExpiredSessions removes sessions that expired or were revoked.
+func DeleteExpiredSessions(ctx context.Context, tx pgx.Tx) (int64, error) {
+	const query = `
+        DELETE FROM auth.sessions
+        WHERE expires_at <= (now() at time zone 'utc')
+           OR revoked_at IS NOT NULL
+    `
+
+	cmdTag, err := tx.Exec(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired sessions: %w", err)
+	}
+
+	return cmdTag.RowsAffected(), nil
+}
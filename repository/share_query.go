@@ -0,0 +1,306 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gleb-korostelev/GophKeeper/models/org"
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// GetCardAccess retrieves the owner of a card, the permission granted on it to username
+// and whether the card carries a client-side wrapped item key.
+// The permission is the strongest granted by a direct share or by the organization collections
+// the card is visible through; members with a read-only role only get read access through collections.
+func GetCardAccess(ctx context.Context, tx pgx.Tx, username, cardNumber string) (owner, permission string, encrypted bool, err error) {
+	const query = `
+        SELECT o.username,
+               CASE
+                   WHEN s.permission = $5 OR v.writable THEN $5
+                   WHEN s.permission IS NOT NULL OR v.writable IS NOT NULL THEN $4
+                   ELSE ''
+               END,
+               c.item_key IS NOT NULL
+        FROM auth.cards c
+        JOIN auth.users o ON o.id = c.user_id
+        LEFT JOIN auth.users g ON g.username = $1
+        LEFT JOIN auth.card_shares s ON s.card_id = c.id AND s.grantee_id = g.id
+        LEFT JOIN LATERAL (
+            SELECT bool_or(ov.role <> $3) AS writable
+            FROM auth.org_visible_cards ov
+            WHERE ov.card_id = c.id AND ov.user_id = g.id
+        ) v ON true
+        WHERE c.card_number = $2
+    `
+
+	err = tx.QueryRow(ctx, query, username, cardNumber,
+		org.RoleReadOnly, profile.PermissionRead, profile.PermissionReadWrite,
+	).Scan(&owner, &permission, &encrypted)
+	return
+}
+
+// UpsertCardShare grants or updates access to an owner's card for another user.
+func UpsertCardShare(ctx context.Context, tx pgx.Tx, share profile.CardShare) error {
+	const query = `
+    INSERT INTO auth.card_shares (card_id, grantee_id, permission, wrapped_key, updated_at)
+    SELECT c.id, g.id, $4, $5, now()
+    FROM auth.cards c
+    JOIN auth.users o ON o.id = c.user_id
+    JOIN auth.users g ON g.username = $2
+    WHERE o.username = $1 AND c.card_number = $3
+    ON CONFLICT (card_id, grantee_id)
+    DO UPDATE SET
+        permission = EXCLUDED.permission,
+        wrapped_key = EXCLUDED.wrapped_key,
+        updated_at = now();
+    `
+
+	cmdTag, err := tx.Exec(ctx, query,
+		share.Owner,
+		share.Grantee,
+		share.CardNumber,
+		share.Permission,
+		share.WrappedKey,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to upsert card share: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// DeleteCardShare revokes the access of grantee to an owner's card.
+func DeleteCardShare(ctx context.Context, tx pgx.Tx, owner, grantee, cardNumber string) error {
+	const query = `
+        DELETE FROM auth.card_shares s
+        USING auth.cards c, auth.users o, auth.users g
+        WHERE s.card_id = c.id
+          AND c.user_id = o.id
+          AND s.grantee_id = g.id
+          AND o.username = $1
+          AND g.username = $2
+          AND c.card_number = $3
+    `
+
+	cmdTag, err := tx.Exec(ctx, query, owner, grantee, cardNumber)
+	if err != nil {
+		return fmt.Errorf("failed to delete card share: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// GetSharedCards retrieves a page of the cards other users have shared with a user.
+// If itemType is not empty, only cards of that type are returned.
+func GetSharedCards(ctx context.Context, tx pgx.Tx, username, itemType string, page pagination.Request) ([]profile.CardInfo, error) {
+	var cards []profile.CardInfo
+
+	const queryTmpl = `
+        SELECT c.id, c.created_at, c.updated_at,
+               c.card_number, c.card_holder, c.expiration_date, c.cvv, c.metadata,
+               s.wrapped_key, o.username, s.permission, c.name, c.item_type,
+               c.url, c.login, c.password
+        FROM auth.card_shares s
+        JOIN auth.cards c ON c.id = s.card_id
+        JOIN auth.users o ON o.id = c.user_id
+        JOIN auth.users g ON g.id = s.grantee_id
+        WHERE g.username = $1
+          AND ($2 = '' OR c.item_type = $2)
+          AND %s
+        %s
+    `
+
+	cond, tail, pageArgs := cardKeyset(page, 3)
+	query := fmt.Sprintf(queryTmpl, cond, tail)
+
+	rows, err := tx.Query(ctx, query, append([]any{username, itemType}, pageArgs...)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query shared cards: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var card profile.CardInfo
+		if err := rows.Scan(
+			&card.ID,
+			&card.CreatedAt,
+			&card.UpdatedAt,
+			&card.CardNumber,
+			&card.CardHolder,
+			&card.ExpirationDate,
+			&card.Cvv,
+			&card.Metadata,
+			&card.ItemKey,
+			&card.Owner,
+			&card.Permission,
+			&card.Name,
+			&card.Type,
+			&card.URL,
+			&card.Login,
+			&card.Password,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan shared card info: %w", err)
+		}
+		cards = append(cards, card)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", rows.Err())
+	}
+
+	return cards, nil
+}
+
+// UpdateSharedCardInfo updates an existing card on behalf of a grantee with read-write access.
+// Unlike UploadCardInfo it never creates a card and never touches the owner's item key.
+func UpdateSharedCardInfo(ctx context.Context, tx pgx.Tx, card profile.CardInfo) error {
+	const query = `
+        UPDATE auth.cards c
+        SET card_holder = $3,
+            expiration_date = $4,
+            cvv = $5,
+            metadata = $6,
+            url = $7,
+            login = $8,
+            password = $9,
+            updated_at = now()
+        FROM auth.users o
+        WHERE o.id = c.user_id
+          AND o.username = $1
+          AND c.card_number = $2
+    `
+
+	cmdTag, err := tx.Exec(ctx, query,
+		card.Owner,
+		card.CardNumber,
+		card.CardHolder,
+		card.ExpirationDate,
+		card.Cvv,
+		card.Metadata,
+		card.URL,
+		card.Login,
+		card.Password,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update shared card info: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
+
+// SetPublicKey stores the public key other users wrap shared item keys to.
+func SetPublicKey(ctx context.Context, tx pgx.Tx, username string, publicKey []byte) (err error) {
+	const query = `
+		UPDATE auth.users
+		SET public_key = $2,
+			updated_at = now()
+		WHERE username = $1;
+	`
+
+	_, err = tx.Exec(ctx, query, username, publicKey)
+	return
+}
+
+// GetItemKeys retrieves every wrapped item key a user holds: the keys of the user's own
+// client-side encrypted cards and the keys of cards shared with the user.
+func GetItemKeys(ctx context.Context, tx pgx.Tx, username string) ([]profile.ItemKey, error) {
+	const query = `
+        SELECT u.username, c.card_number, c.item_key
+        FROM auth.cards c
+        JOIN auth.users u ON u.id = c.user_id
+        WHERE u.username = $1
+          AND c.item_key IS NOT NULL
+        UNION ALL
+        SELECT o.username, c.card_number, s.wrapped_key
+        FROM auth.card_shares s
+        JOIN auth.users g ON g.id = s.grantee_id
+        JOIN auth.cards c ON c.id = s.card_id
+        JOIN auth.users o ON o.id = c.user_id
+        WHERE g.username = $1
+          AND s.wrapped_key IS NOT NULL
+    `
+
+	rows, err := tx.Query(ctx, query, username)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query item keys: %w", err)
+	}
+	defer rows.Close()
+
+	var keys []profile.ItemKey
+	for rows.Next() {
+		var k profile.ItemKey
+		if err := rows.Scan(&k.Owner, &k.CardNumber, &k.Key); err != nil {
+			return nil, fmt.Errorf("failed to scan item key: %w", err)
+		}
+		keys = append(keys, k)
+	}
+
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("rows iteration error: %w", rows.Err())
+	}
+
+	return keys, nil
+}
+
+// UpdateItemKey replaces a wrapped item key held by a user: the item key of the user's own card,
+// or the wrapped key of a card shared with the user. ErrNoRowsAffected is returned if the user holds no such key.
+func UpdateItemKey(ctx context.Context, tx pgx.Tx, username string, key profile.ItemKey) error {
+	const ownQuery = `
+        UPDATE auth.cards c
+        SET item_key = $3,
+            updated_at = now()
+        FROM auth.users u
+        WHERE u.id = c.user_id
+          AND u.username = $1
+          AND c.card_number = $2
+          AND c.item_key IS NOT NULL
+    `
+
+	const sharedQuery = `
+        UPDATE auth.card_shares s
+        SET wrapped_key = $4,
+            updated_at = now()
+        FROM auth.users g, auth.cards c, auth.users o
+        WHERE g.id = s.grantee_id
+          AND c.id = s.card_id
+          AND o.id = c.user_id
+          AND g.username = $1
+          AND o.username = $2
+          AND c.card_number = $3
+          AND s.wrapped_key IS NOT NULL
+    `
+
+	var (
+		cmdTag pgconn.CommandTag
+		err    error
+	)
+	if key.Owner == username {
+		cmdTag, err = tx.Exec(ctx, ownQuery, username, key.CardNumber, key.Key)
+	} else {
+		cmdTag, err = tx.Exec(ctx, sharedQuery, username, key.Owner, key.CardNumber, key.Key)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update item key: %w", err)
+	}
+
+	if cmdTag.RowsAffected() == 0 {
+		return ErrNoRowsAffected
+	}
+
+	return nil
+}
@@ -0,0 +1,263 @@
+// Package account provides services for managing a user account as a whole,
+// such as exporting all personal data held about the user and deleting the account.
+package account
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/models/account"
+	"github.com/gleb-korostelev/GophKeeper/models/audit"
+	"github.com/gleb-korostelev/GophKeeper/models/quota"
+	"github.com/gleb-korostelev/GophKeeper/repository"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gleb-korostelev/GophKeeper/tools/blobstore"
+	"github.com/gleb-korostelev/GophKeeper/tools/db"
+	"github.com/gleb-korostelev/GophKeeper/tools/logger"
+	"github.com/gleb-korostelev/GophKeeper/tools/tracing"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// service defines the implementation of the account service.
+//
+// Fields:
+// - db: The database adapter for executing transactional operations.
+// - store: The blob store holding attachment contents, removed along with the account.
+// - plans: The limits of the account plans that usage is reported against.
+type service struct {
+	db    db.IAdapter
+	repo  repository.Repository
+	store blobstore.BlobStore
+	plans quota.Plans
+}
+
+// NewService creates a new instance of the account service.
+func NewService(db db.IAdapter, store blobstore.BlobStore, plans quota.Plans) *service {
+	return &service{db: db, store: store, plans: plans}
+}
+
+// GetPersonalData collects all personal data and events held about a user, read in a single transaction.
+func (s *service) GetPersonalData(ctx context.Context, username string) (data account.PersonalData, err error) {
+	ctx, span := tracing.Start(ctx, "account.GetPersonalData")
+	defer func() { tracing.End(span, err) }()
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		acc, err := s.repo.GetAccountByUserName(ctx, tx, username)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return svc.ErrAccountNotFound
+			}
+			return fmt.Errorf("error in getAccountByUserName: %w", err)
+		}
+
+		data = account.PersonalData{
+			Username:    acc.Username,
+			AccountType: acc.AccountType.String(),
+			CreatedAt:   acc.CreatedAt,
+			UpdatedAt:   acc.UpdatedAt,
+			PublicKey:   acc.PublicKey,
+			ExportedAt:  time.Now().UTC().Truncate(time.Second),
+		}
+
+		if data.Folders, err = s.repo.GetFolders(ctx, tx, username); err != nil {
+			return fmt.Errorf("error in getFolders: %w", err)
+		}
+		if data.Tags, err = s.repo.GetTags(ctx, tx, username); err != nil {
+			return fmt.Errorf("error in getTags: %w", err)
+		}
+		if data.Items, err = s.repo.GetAllUserCards(ctx, tx, username); err != nil {
+			return fmt.Errorf("error in getAllUserCards: %w", err)
+		}
+		if data.Shares, err = s.repo.GetUserShares(ctx, tx, username); err != nil {
+			return fmt.Errorf("error in getUserShares: %w", err)
+		}
+		if data.Orgs, err = s.repo.GetUserOrgs(ctx, tx, username); err != nil {
+			return fmt.Errorf("error in getUserOrgs: %w", err)
+		}
+		if data.EmergencyContacts, err = s.repo.GetEmergencyContacts(ctx, tx, username); err != nil {
+			return fmt.Errorf("error in getEmergencyContacts: %w", err)
+		}
+		if data.Sends, err = s.repo.GetUserSends(ctx, tx, username); err != nil {
+			return fmt.Errorf("error in getUserSends: %w", err)
+		}
+		if data.Attachments, err = s.repo.GetUserAttachments(ctx, tx, username); err != nil {
+			return fmt.Errorf("error in getUserAttachments: %w", err)
+		}
+		if data.Sessions, err = s.repo.GetSessions(ctx, tx, username); err != nil {
+			return fmt.Errorf("error in getSessions: %w", err)
+		}
+		if data.Devices, err = s.repo.GetUserDevices(ctx, tx, username); err != nil {
+			return fmt.Errorf("error in getUserDevices: %w", err)
+		}
+		if data.APIKeys, err = s.repo.GetUserAPIKeys(ctx, tx, username); err != nil {
+			return fmt.Errorf("error in getUserAPIKeys: %w", err)
+		}
+		if data.Events, err = s.repo.GetAuditEvents(ctx, tx, username); err != nil {
+			return fmt.Errorf("error in getAuditEvents: %w", err)
+		}
+		return nil
+	})
+	return
+}
+
+// DeleteAccount deletes a user's account after verifying their password. Everything the user owns,
+// their shares, memberships and sessions are removed in one transaction, which revokes all of their tokens;
+// organizations the user was the only member of are removed as well. The audit log keeps a record
+// of the deletion, with the user's entries unlinked from the account and their username replaced
+// by a random alias. Attachment contents are removed from the blob store once the deletion is committed.
+func (s *service) DeleteAccount(ctx context.Context, username, password string) (err error) {
+	ctx, span := tracing.Start(ctx, "account.DeleteAccount")
+	defer func() { tracing.End(span, err) }()
+
+	var blobs []string
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		acc, err := s.repo.GetAccountByUserName(ctx, tx, username)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return svc.ErrAccountNotFound
+			}
+			return fmt.Errorf("error in getAccountByUserName: %w", err)
+		}
+
+		if !acc.VerifySecret(password) {
+			return svc.ErrIncorrectPassword
+		}
+
+		orphaned, err := s.repo.GetOrphanedOrgs(ctx, tx, username)
+		if err != nil {
+			return fmt.Errorf("error in getOrphanedOrgs: %w", err)
+		}
+		if len(orphaned) > 0 {
+			return fmt.Errorf("%w: %s", svc.ErrLastOrgOwner, strings.Join(orphaned, ", "))
+		}
+
+		attachments, err := s.repo.GetUserAttachments(ctx, tx, username)
+		if err != nil {
+			return fmt.Errorf("error in getUserAttachments: %w", err)
+		}
+		for _, a := range attachments {
+			blobs = append(blobs, a.ID)
+		}
+
+		err = s.repo.InsertAuditEvent(ctx, tx, audit.Event{Username: username, Action: audit.ActionAccountDelete})
+		if err != nil {
+			return fmt.Errorf("error in insertAuditEvent: %w", err)
+		}
+
+		err = s.repo.AnonymizeAuditEvents(ctx, tx, username, account.DeletedAliasPrefix+uuid.New().String())
+		if err != nil {
+			return fmt.Errorf("error in anonymizeAuditEvents: %w", err)
+		}
+
+		if err = s.repo.DeleteSoleMemberOrgs(ctx, tx, username); err != nil {
+			return fmt.Errorf("error in deleteSoleMemberOrgs: %w", err)
+		}
+
+		if err = s.repo.DeleteAccount(ctx, tx, username); err != nil {
+			return fmt.Errorf("error in deleteAccount: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return
+	}
+
+	// The attachment rows are already gone, so a leftover blob is unreachable; failures are only logged.
+	for _, key := range blobs {
+		if err := s.store.Delete(ctx, key); err != nil {
+			logger.Ctx(ctx).Errorf("failed to delete blob of attachment %s: %v", key, err)
+		}
+	}
+	return nil
+}
+
+// GetUsage reports what a user stores and the limits it counts against.
+func (s *service) GetUsage(ctx context.Context, username string) (report quota.Report, err error) {
+	ctx, span := tracing.Start(ctx, "account.GetUsage")
+	defer func() { tracing.End(span, err) }()
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		report, err = s.getReport(ctx, tx, username)
+		return err
+	})
+	return
+}
+
+// SetQuotaOverride replaces the limits an admin overrides for a user and reports the user's usage
+// against their new limits. Nil limits of the override keep those of the user's plan; a limit of 0 is unlimited.
+// The override is recorded in the user's audit log.
+func (s *service) SetQuotaOverride(ctx context.Context, admin, username string, o quota.Override) (
+	report quota.Report, err error) {
+	ctx, span := tracing.Start(ctx, "account.SetQuotaOverride")
+	defer func() { tracing.End(span, err) }()
+
+	if !o.Valid() {
+		return quota.Report{}, svc.ErrInvalidQuota
+	}
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		err := s.repo.UpsertQuotaOverride(ctx, tx, username, o)
+		if err != nil {
+			if errors.Is(err, repository.ErrNoRowsAffected) {
+				return svc.ErrAccountNotFound
+			}
+			return fmt.Errorf("error in upsertQuotaOverride: %w", err)
+		}
+
+		details := map[string]string{"admin": admin}
+		for name, v := range map[string]*int64{
+			"items":           o.Items,
+			"bytes":           o.Bytes,
+			"attachment_size": o.AttachmentSize,
+			"api_keys":        o.APIKeys,
+			"devices":         o.Devices,
+		} {
+			if v != nil {
+				details[name] = strconv.FormatInt(*v, 10)
+			}
+		}
+		err = s.repo.InsertAuditEvent(ctx, tx, audit.Event{
+			Username: username,
+			Action:   audit.ActionQuotaOverride,
+			Details:  details,
+		})
+		if err != nil {
+			return fmt.Errorf("error in insertAuditEvent: %w", err)
+		}
+
+		report, err = s.getReport(ctx, tx, username)
+		return err
+	})
+	return
+}
+
+// getReport reads the usage and limits of a user.
+func (s *service) getReport(ctx context.Context, tx pgx.Tx, username string) (quota.Report, error) {
+	accType, override, err := s.repo.GetQuota(ctx, tx, username)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return quota.Report{}, svc.ErrAccountNotFound
+		}
+		return quota.Report{}, fmt.Errorf("error in getQuota: %w", err)
+	}
+
+	usage, err := s.repo.GetUsage(ctx, tx, username)
+	if err != nil {
+		return quota.Report{}, fmt.Errorf("error in getUsage: %w", err)
+	}
+
+	plan, limits := s.plans.Plan(accType.IsAdmin())
+	return quota.Report{
+		Username: username,
+		Plan:     plan,
+		Usage:    usage,
+		Limits:   override.Apply(limits),
+		Override: override,
+	}, nil
+}
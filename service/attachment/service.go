@@ -0,0 +1,307 @@
+// Package attachment provides services for files attached to cards. Contents are uploaded
+// in resumable chunks, verified against a SHA-256 checksum and kept in a blob store.
+package attachment
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/models/attachment"
+	"github.com/gleb-korostelev/GophKeeper/models/quota"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
+	"github.com/gleb-korostelev/GophKeeper/repository"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gleb-korostelev/GophKeeper/tools/blobstore"
+	"github.com/gleb-korostelev/GophKeeper/tools/db"
+	"github.com/gleb-korostelev/GophKeeper/tools/logger"
+	"github.com/gleb-korostelev/GophKeeper/tools/tracing"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	// defaultContentType is stored for attachments uploaded without a content type.
+	defaultContentType = "application/octet-stream"
+
+	// maxNameLength is the maximum length of an attachment name.
+	maxNameLength = 255
+)
+
+// service defines the implementation of the attachment service.
+//
+// Fields:
+// - db: The database adapter for executing transactional operations.
+// - store: The blob store holding attachment contents.
+// - plans: The limits of the account plans that attachments are checked against.
+type service struct {
+	db    db.IAdapter
+	repo  repository.Repository
+	store blobstore.BlobStore
+	plans quota.Plans
+}
+
+// NewService creates a new instance of the attachment service.
+func NewService(db db.IAdapter, store blobstore.BlobStore, plans quota.Plans) *service {
+	return &service{db: db, store: store, plans: plans}
+}
+
+// CreateAttachment registers a new attachment on one of the user's cards and returns its identifier.
+// The declared size must fit the user's attachment size limit and counts against their storage quota
+// from now on, so an upload cannot run out of space halfway.
+func (s *service) CreateAttachment(ctx context.Context, a attachment.Attachment) (id string, err error) {
+	ctx, span := tracing.Start(ctx, "attachment.CreateAttachment")
+	defer func() { tracing.End(span, err) }()
+
+	a.Name = strings.TrimSpace(a.Name)
+	a.SHA256 = strings.ToLower(a.SHA256)
+	if sum, err := hex.DecodeString(a.SHA256); err != nil || len(sum) != sha256.Size ||
+		a.Name == "" || len(a.Name) > maxNameLength ||
+		a.Size < 1 {
+		return "", svc.ErrInvalidAttachment
+	}
+	if a.ContentType == "" {
+		a.ContentType = defaultContentType
+	}
+	a.ID = uuid.New().String()
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		limits, err := svc.GetLimits(ctx, tx, s.repo, s.plans, a.Username)
+		if err != nil {
+			return err
+		}
+		if limits.AttachmentSize > 0 && a.Size > limits.AttachmentSize {
+			return fmt.Errorf("%w: the limit of %d bytes per attachment is reached",
+				svc.ErrQuotaExceeded, limits.AttachmentSize)
+		}
+
+		return svc.EnforceQuota(ctx, tx, s.repo, s.plans, a.Username, func() error {
+			err := s.repo.InsertAttachment(ctx, tx, a)
+			if err != nil {
+				if errors.Is(err, repository.ErrNoRowsAffected) {
+					return svc.ErrCardNotFound
+				}
+				return fmt.Errorf("error in insertAttachment: %w", err)
+			}
+			return nil
+		})
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return a.ID, nil
+}
+
+// UploadChunk stores one chunk of an attachment's content and returns the updated upload state.
+//
+// Chunks must arrive in order: a chunk has to start where the stored content ends, so after
+// a disconnect the client asks for the attachment's Received offset and continues from there.
+// Once the last chunk arrives the content is verified against its checksum; on a mismatch
+// the upload starts over and svc.ErrChecksumMismatch is returned.
+func (s *service) UploadChunk(ctx context.Context, username, id string, rng attachment.Range, body io.Reader) (
+	a attachment.Attachment, err error) {
+	ctx, span := tracing.Start(ctx, "attachment.UploadChunk")
+	defer func() { tracing.End(span, err) }()
+
+	if _, err = uuid.Parse(id); err != nil {
+		return attachment.Attachment{}, svc.ErrAttachmentNotFound
+	}
+	if rng.Start < 0 || rng.End < rng.Start || rng.Length() > attachment.MaxChunkSize {
+		return attachment.Attachment{}, svc.ErrInvalidRange
+	}
+
+	var mismatch bool
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		a, err = s.repo.GetAttachmentForUpdate(ctx, tx, username, id)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return svc.ErrAttachmentNotFound
+			}
+			return fmt.Errorf("error in getAttachmentForUpdate: %w", err)
+		}
+
+		switch {
+		case a.Complete:
+			return svc.ErrUploadComplete
+		case rng.Total != a.Size || rng.End >= a.Size:
+			return svc.ErrInvalidRange
+		case rng.Start != a.Received:
+			return fmt.Errorf("%w: expected offset %d", svc.ErrRangeMismatch, a.Received)
+		}
+
+		// The blob is written and verified in the transaction holding the attachment lock,
+		// so an upload uses a single connection and the chunk commits with its progress.
+		store := s.store.WithTx(tx)
+		n, err := store.WriteAt(ctx, a.ID, rng.Start, io.LimitReader(body, rng.Length()))
+		if err != nil {
+			return fmt.Errorf("error in writeAt: %w", err)
+		}
+		if n != rng.Length() {
+			return fmt.Errorf("%w: received %d of %d bytes", svc.ErrInvalidRange, n, rng.Length())
+		}
+
+		a.Received = rng.End + 1
+		if a.Received == a.Size {
+			sum, err := checksum(ctx, store, a.ID, a.Size)
+			if err != nil {
+				return err
+			}
+			if sum == a.SHA256 {
+				a.Complete = true
+			} else {
+				mismatch = true
+				a.Received = 0
+			}
+		}
+
+		err = s.repo.UpdateAttachmentProgress(ctx, tx, a.ID, a.Received, a.Complete)
+		if err != nil {
+			return fmt.Errorf("error in updateAttachmentProgress: %w", err)
+		}
+		return nil
+	})
+	if err == nil && mismatch {
+		err = svc.ErrChecksumMismatch
+	}
+	return
+}
+
+// GetAttachments retrieves a page of the attachments of one of the user's cards, including unfinished uploads,
+// and the cursor of the next page, empty on the last page.
+func (s *service) GetAttachments(ctx context.Context, username, cardNumber string, page pagination.Request) (
+	attachments []attachment.Attachment, next string, err error) {
+	ctx, span := tracing.Start(ctx, "attachment.GetAttachments")
+	defer func() { tracing.End(span, err) }()
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		attachments, err = s.repo.GetCardAttachments(ctx, tx, username, cardNumber, page)
+		if err != nil {
+			return fmt.Errorf("error in getCardAttachments: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	attachments, next = pagination.Trim(attachments, page, attachment.Attachment.Cursor)
+	return attachments, next, nil
+}
+
+// OpenAttachment returns a fully uploaded attachment together with a reader streaming its content.
+// The caller must close the reader.
+func (s *service) OpenAttachment(ctx context.Context, username, id string) (a attachment.Attachment, rc io.ReadCloser, err error) {
+	ctx, span := tracing.Start(ctx, "attachment.OpenAttachment")
+	defer func() { tracing.End(span, err) }()
+
+	if _, err = uuid.Parse(id); err != nil {
+		return attachment.Attachment{}, nil, svc.ErrAttachmentNotFound
+	}
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		a, err = s.repo.GetAttachment(ctx, tx, username, id)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return svc.ErrAttachmentNotFound
+			}
+			return fmt.Errorf("error in getAttachment: %w", err)
+		}
+		if !a.Complete {
+			return svc.ErrUploadIncomplete
+		}
+		return nil
+	})
+	if err != nil {
+		return attachment.Attachment{}, nil, err
+	}
+
+	rc, err = s.store.Open(ctx, a.ID)
+	if err != nil {
+		if errors.Is(err, blobstore.ErrNotFound) {
+			return attachment.Attachment{}, nil, svc.ErrAttachmentNotFound
+		}
+		return attachment.Attachment{}, nil, fmt.Errorf("error in open: %w", err)
+	}
+
+	return a, rc, nil
+}
+
+// DeleteAttachment removes one of the user's attachments and its content.
+func (s *service) DeleteAttachment(ctx context.Context, username, id string) (err error) {
+	ctx, span := tracing.Start(ctx, "attachment.DeleteAttachment")
+	defer func() { tracing.End(span, err) }()
+
+	if _, err = uuid.Parse(id); err != nil {
+		return svc.ErrAttachmentNotFound
+	}
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		err = s.repo.DeleteAttachment(ctx, tx, username, id)
+		if err != nil {
+			if errors.Is(err, repository.ErrNoRowsAffected) {
+				return svc.ErrAttachmentNotFound
+			}
+			return fmt.Errorf("error in deleteAttachment: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.deleteBlobs(ctx, id)
+	return nil
+}
+
+// PurgeStale removes attachments of deleted cards and uploads unfinished for longer than
+// attachment.UploadTTL, together with their content.
+func (s *service) PurgeStale(ctx context.Context) (err error) {
+	ctx, span := tracing.Start(ctx, "attachment.PurgeStale")
+	defer func() { tracing.End(span, err) }()
+
+	var ids []string
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		ids, err = s.repo.DeleteStaleAttachments(ctx, tx, time.Now().UTC().Add(-attachment.UploadTTL))
+		if err != nil {
+			return fmt.Errorf("error in deleteStaleAttachments: %w", err)
+		}
+		return nil
+	})
+	if err == nil && len(ids) > 0 {
+		s.deleteBlobs(ctx, ids...)
+		logger.Infof("purged %d stale attachments", len(ids))
+	}
+	return
+}
+
+// checksum returns the hex-encoded SHA-256 of the first size bytes of a blob.
+func checksum(ctx context.Context, store blobstore.BlobStore, key string, size int64) (string, error) {
+	rc, err := store.Open(ctx, key)
+	if err != nil {
+		return "", fmt.Errorf("error in open: %w", err)
+	}
+	defer rc.Close()
+
+	h := sha256.New()
+	if _, err = io.Copy(h, io.LimitReader(rc, size)); err != nil {
+		return "", fmt.Errorf("failed to hash blob: %w", err)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// deleteBlobs removes the content of deleted attachments. Failures are only logged:
+// the attachment rows are already gone, so a leftover blob is unreachable.
+func (s *service) deleteBlobs(ctx context.Context, ids ...string) {
+	for _, id := range ids {
+		if err := s.store.Delete(ctx, id); err != nil {
+			logger.Ctx(ctx).Errorf("failed to delete blob of attachment %s: %v", id, err)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/models/apikey"
+	"github.com/gleb-korostelev/GophKeeper/models/audit"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
+	"github.com/gleb-korostelev/GophKeeper/repository"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gleb-korostelev/GophKeeper/tools/tracing"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// CreateAPIKey creates an API key acting as a user, with the name, scope and expiry of k.
+// The folders of the scope must belong to the user. The key is returned once, along with its
+// stored form; only a hash of its secret is kept. The key counts against the user's API key limit
+// until it expires or is revoked.
+func (s *service) CreateAPIKey(ctx context.Context, username string, k apikey.Key) (
+	key apikey.Key, token string, err error) {
+	ctx, span := tracing.Start(ctx, "auth.CreateAPIKey")
+	defer func() { tracing.End(span, err) }()
+
+	now := time.Now().UTC()
+
+	k.Name = strings.TrimSpace(k.Name)
+	k.ExpiresAt = k.ExpiresAt.UTC()
+	if k.Name == "" || len(k.Name) > apikey.MaxNameLength ||
+		!k.ExpiresAt.After(now) || k.ExpiresAt.After(now.Add(apikey.MaxTTL)) ||
+		len(k.Scope.Items) > apikey.MaxScopeItems || len(k.Scope.Folders) > apikey.MaxScopeItems {
+		return apikey.Key{}, "", svc.ErrInvalidAPIKey
+	}
+
+	k.Username = username
+	k.CreatedAt = now
+	k.LastUsedAt = nil
+	token, err = k.Generate()
+	if err != nil {
+		return apikey.Key{}, "", fmt.Errorf("error in generate: %w", err)
+	}
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		for _, id := range k.Scope.Folders {
+			exists, err := s.repo.FolderExists(ctx, tx, username, id)
+			if err != nil {
+				return fmt.Errorf("error in folderExists: %w", err)
+			}
+			if !exists {
+				return svc.ErrFolderNotFound
+			}
+		}
+
+		err := svc.EnforceQuota(ctx, tx, s.repo, s.plans, username, func() error {
+			err := s.repo.InsertAPIKey(ctx, tx, k)
+			if err != nil {
+				if errors.Is(err, repository.ErrNoRowsAffected) {
+					return svc.ErrAccountNotFound
+				}
+				return fmt.Errorf("error in insertAPIKey: %w", err)
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		err = s.repo.InsertAuditEvent(ctx, tx, audit.Event{
+			Username: username,
+			Action:   audit.ActionAPIKeyCreate,
+			Details: map[string]string{
+				"key_id":     k.ID,
+				"name":       k.Name,
+				"read_only":  strconv.FormatBool(k.Scope.ReadOnly),
+				"expires_at": k.ExpiresAt.Format(time.RFC3339),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error in insertAuditEvent: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return apikey.Key{}, "", err
+	}
+	return k, token, nil
+}
+
+// GetAPIKeys retrieves a page of the API keys of a user, including expired ones,
+// and the cursor of the next page, empty on the last page.
+func (s *service) GetAPIKeys(ctx context.Context, username string, page pagination.Request) (
+	keys []apikey.Key, next string, err error) {
+	ctx, span := tracing.Start(ctx, "auth.GetAPIKeys")
+	defer func() { tracing.End(span, err) }()
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		keys, err = s.repo.GetAPIKeys(ctx, tx, username, page)
+		if err != nil {
+			return fmt.Errorf("error in getAPIKeys: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	keys, next = pagination.Trim(keys, page, apikey.Key.Cursor)
+	return keys, next, nil
+}
+
+// RevokeAPIKey removes an API key of a user; requests made with it are rejected from then on.
+func (s *service) RevokeAPIKey(ctx context.Context, username, id string) (err error) {
+	ctx, span := tracing.Start(ctx, "auth.RevokeAPIKey")
+	defer func() { tracing.End(span, err) }()
+
+	if _, err = uuid.Parse(id); err != nil {
+		return svc.ErrAPIKeyNotFound
+	}
+
+	return s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		err := s.repo.DeleteAPIKey(ctx, tx, username, id)
+		if err != nil {
+			if errors.Is(err, repository.ErrNoRowsAffected) {
+				return svc.ErrAPIKeyNotFound
+			}
+			return fmt.Errorf("error in deleteAPIKey: %w", err)
+		}
+
+		err = s.repo.InsertAuditEvent(ctx, tx, audit.Event{
+			Username: username,
+			Action:   audit.ActionAPIKeyRevoke,
+			Details:  map[string]string{"key_id": id},
+		})
+		if err != nil {
+			return fmt.Errorf("error in insertAuditEvent: %w", err)
+		}
+		return nil
+	})
+}
+
+// AuthenticateAPIKey reports whether an API key is valid and has not expired, returning its stored form.
+// The use of the key is recorded.
+func (s *service) AuthenticateAPIKey(ctx context.Context, token string) (key apikey.Key, ok bool, err error) {
+	ctx, span := tracing.Start(ctx, "auth.AuthenticateAPIKey")
+	defer func() { tracing.End(span, err) }()
+
+	id, secret, err := apikey.Parse(token)
+	if err != nil {
+		return apikey.Key{}, false, nil
+	}
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		key, err = s.repo.GetAPIKey(ctx, tx, id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil
+			}
+			return fmt.Errorf("error in getAPIKey: %w", err)
+		}
+
+		if !key.Verify(secret) || key.Expired(time.Now().UTC()) {
+			return nil
+		}
+		ok = true
+
+		if err = s.repo.TouchAPIKey(ctx, tx, id); err != nil {
+			return fmt.Errorf("error in touchAPIKey: %w", err)
+		}
+		return nil
+	})
+	if err != nil || !ok {
+		return apikey.Key{}, false, err
+	}
+	return key, true, nil
+}
@@ -0,0 +1,141 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gleb-korostelev/GophKeeper/models/audit"
+	"github.com/gleb-korostelev/GophKeeper/models/device"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
+	"github.com/gleb-korostelev/GophKeeper/repository"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gleb-korostelev/GophKeeper/tools/logger"
+	"github.com/gleb-korostelev/GophKeeper/tools/notify"
+	"github.com/gleb-korostelev/GophKeeper/tools/tracing"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// registerDevice looks up the device a user signs in from by its public key, registering it
+// if it was not seen before. A new device counts against the user's device limit and is recorded
+// in the audit log.
+func (s *service) registerDevice(ctx context.Context, tx pgx.Tx, username string, reg device.Registration) (
+	dev device.Device, isNew bool, err error) {
+	dev, err = s.repo.GetDeviceByKey(ctx, tx, username, reg.PublicKey)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return dev, false, fmt.Errorf("error in getDeviceByKey: %w", err)
+	}
+
+	dev.Name = strings.TrimSpace(reg.Name)
+	dev.Platform = reg.Platform
+
+	if err == nil {
+		if err = s.repo.TouchDevice(ctx, tx, dev); err != nil {
+			return dev, false, fmt.Errorf("error in touchDevice: %w", err)
+		}
+		return dev, false, nil
+	}
+
+	dev.ID = uuid.New().String()
+	dev.Username = username
+	dev.PublicKey = reg.PublicKey
+	err = svc.EnforceQuota(ctx, tx, s.repo, s.plans, username, func() error {
+		if err := s.repo.InsertDevice(ctx, tx, dev); err != nil {
+			return fmt.Errorf("error in insertDevice: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return dev, false, err
+	}
+
+	err = s.repo.InsertAuditEvent(ctx, tx, audit.Event{
+		Username: username,
+		Action:   audit.ActionNewDevice,
+		Details: map[string]string{
+			"device_id": dev.ID,
+			"name":      dev.Name,
+			"platform":  dev.Platform,
+		},
+	})
+	if err != nil {
+		return dev, false, fmt.Errorf("error in insertAuditEvent: %w", err)
+	}
+	return dev, true, nil
+}
+
+// GetDevices retrieves a page of the devices of a user, marking the one the given session is bound to
+// as current, and the cursor of the next page, empty on the last page.
+func (s *service) GetDevices(ctx context.Context, username, sessionID string, page pagination.Request) (
+	devices []device.Device, next string, err error) {
+	ctx, span := tracing.Start(ctx, "auth.GetDevices")
+	defer func() { tracing.End(span, err) }()
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		devices, err = s.repo.GetDevices(ctx, tx, username, sessionID, page)
+		if err != nil {
+			return fmt.Errorf("error in getDevices: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	devices, next = pagination.Trim(devices, page, func(d device.Device) pagination.Cursor {
+		return d.Cursor(page.Sort)
+	})
+	return devices, next, nil
+}
+
+// DeleteDevice removes a device of a user and revokes the sessions bound to it,
+// returning how many were revoked. Signing in from the device again registers it as new.
+func (s *service) DeleteDevice(ctx context.Context, username, id string) (revoked int64, err error) {
+	ctx, span := tracing.Start(ctx, "auth.DeleteDevice")
+	defer func() { tracing.End(span, err) }()
+
+	if _, err = uuid.Parse(id); err != nil {
+		return 0, svc.ErrDeviceNotFound
+	}
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		revoked, err = s.repo.RevokeDeviceSessions(ctx, tx, username, id)
+		if err != nil {
+			return fmt.Errorf("error in revokeDeviceSessions: %w", err)
+		}
+
+		err = s.repo.DeleteDevice(ctx, tx, username, id)
+		if err != nil {
+			if errors.Is(err, repository.ErrNoRowsAffected) {
+				return svc.ErrDeviceNotFound
+			}
+			return fmt.Errorf("error in deleteDevice: %w", err)
+		}
+
+		err = s.repo.InsertAuditEvent(ctx, tx, audit.Event{
+			Username: username,
+			Action:   audit.ActionDeviceRemove,
+			Details: map[string]string{
+				"device_id":        id,
+				"revoked_sessions": strconv.FormatInt(revoked, 10),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error in insertAuditEvent: %w", err)
+		}
+		return nil
+	})
+	return
+}
+
+// notify sends a notification, logging delivery failures instead of failing the operation.
+func (s *service) notify(ctx context.Context, recipient, subject, body string) {
+	err := s.notifier.Notify(ctx, notify.Notification{Recipient: recipient, Subject: subject, Body: body})
+	if err != nil {
+		logger.Ctx(ctx).Errorf("failed to notify %s: %v", recipient, err)
+	}
+}
@@ -0,0 +1,245 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"database/sql"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/audit"
+	"github.com/gleb-korostelev/GophKeeper/models/recovery"
+	"github.com/gleb-korostelev/GophKeeper/pkg/shamir"
+	"github.com/gleb-korostelev/GophKeeper/repository"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gleb-korostelev/GophKeeper/tools/tracing"
+	"github.com/jackc/pgx/v5"
+)
+
+// CreateRecoveryKit splits a user's vault key into shares, any threshold of which recover the account.
+// The user's password confirms the setup. Only a verifier of the vault key is stored; the shares are
+// returned once, to be handed out, and replace the shares of any previous kit.
+func (s *service) CreateRecoveryKit(ctx context.Context, username string, setup recovery.Setup) (
+	kit recovery.Kit, shares []recovery.Share, err error) {
+	ctx, span := tracing.Start(ctx, "auth.CreateRecoveryKit")
+	defer func() { tracing.End(span, err) }()
+
+	if setup.Threshold < shamir.MinThreshold || setup.Threshold > setup.Shares || setup.Shares > recovery.MaxShares ||
+		len(setup.VaultKey) < recovery.MinVaultKeyLength || len(setup.VaultKey) > recovery.MaxVaultKeyLength {
+		return kit, nil, svc.ErrInvalidRecoveryKit
+	}
+
+	parts, err := shamir.Split(setup.VaultKey, setup.Shares, setup.Threshold)
+	if err != nil {
+		return kit, nil, fmt.Errorf("error in shamir.Split: %w", err)
+	}
+
+	var id [4]byte
+	if _, err = rand.Read(id[:]); err != nil {
+		return kit, nil, fmt.Errorf("error in rand.Read: %w", err)
+	}
+
+	kit = recovery.Kit{
+		ID:        binary.BigEndian.Uint32(id[:]),
+		Username:  username,
+		Shares:    setup.Shares,
+		Threshold: setup.Threshold,
+	}
+	if err = kit.GenerateVerifier(setup.VaultKey); err != nil {
+		return kit, nil, err
+	}
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		acc, err := s.repo.GetAccountByUserName(ctx, tx, username)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return svc.ErrAccountNotFound
+			}
+			return fmt.Errorf("error in getAccountByUserName: %w", err)
+		}
+
+		if !acc.VerifySecret(setup.Password) {
+			return svc.ErrIncorrectPassword
+		}
+
+		if err = s.repo.UpsertRecoveryKit(ctx, tx, kit); err != nil {
+			return fmt.Errorf("error in upsertRecoveryKit: %w", err)
+		}
+
+		err = s.repo.InsertAuditEvent(ctx, tx, audit.Event{
+			Username: username,
+			Action:   audit.ActionRecoverySetup,
+			Details: map[string]string{
+				"kit_id":    recovery.FormatKitID(kit.ID),
+				"shares":    strconv.Itoa(kit.Shares),
+				"threshold": strconv.Itoa(kit.Threshold),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error in insertAuditEvent: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return recovery.Kit{}, nil, err
+	}
+
+	shares = make([]recovery.Share, len(parts))
+	for i, part := range parts {
+		shares[i] = recovery.Share{KitID: kit.ID, Threshold: kit.Threshold, Data: part}
+	}
+	return kit, shares, nil
+}
+
+// GetRecoveryKit retrieves the recovery kit of a user.
+func (s *service) GetRecoveryKit(ctx context.Context, username string) (kit recovery.Kit, err error) {
+	ctx, span := tracing.Start(ctx, "auth.GetRecoveryKit")
+	defer func() { tracing.End(span, err) }()
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		kit, err = s.repo.GetRecoveryKit(ctx, tx, username)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return svc.ErrRecoveryKitNotFound
+			}
+			return fmt.Errorf("error in getRecoveryKit: %w", err)
+		}
+		return nil
+	})
+	return
+}
+
+// DeleteRecoveryKit removes the recovery kit of a user, which invalidates all of its shares.
+func (s *service) DeleteRecoveryKit(ctx context.Context, username string) (err error) {
+	ctx, span := tracing.Start(ctx, "auth.DeleteRecoveryKit")
+	defer func() { tracing.End(span, err) }()
+
+	return s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		err := s.repo.DeleteRecoveryKit(ctx, tx, username)
+		if err != nil {
+			if errors.Is(err, repository.ErrNoRowsAffected) {
+				return svc.ErrRecoveryKitNotFound
+			}
+			return fmt.Errorf("error in deleteRecoveryKit: %w", err)
+		}
+
+		err = s.repo.InsertAuditEvent(ctx, tx, audit.Event{Username: username, Action: audit.ActionRecoveryRemove})
+		if err != nil {
+			return fmt.Errorf("error in insertAuditEvent: %w", err)
+		}
+		return nil
+	})
+}
+
+// Recover reconstructs a user's vault key from the share codes of their recovery kit and sets a new password
+// in place of a forgotten one. All sessions of the user are revoked. Clients re-keying the vault along with
+// the password combine the shares themselves first, to re-wrap the item keys, and send the new public key;
+// the recovery kit is removed then, since it splits the old key. The reconstructed vault key is returned.
+func (s *service) Recover(ctx context.Context, username string, codes []string, change models.PasswordChange) (
+	vaultKey []byte, revoked int64, err error) {
+	ctx, span := tracing.Start(ctx, "auth.Recover")
+	defer func() { tracing.End(span, err) }()
+
+	if change.NewPassword == "" {
+		return nil, 0, svc.ErrInvalidPassword
+	}
+	if len(change.ItemKeys) > 0 && len(change.PublicKey) == 0 {
+		return nil, 0, svc.ErrIncompleteRekey
+	}
+	if len(codes) == 0 {
+		return nil, 0, svc.ErrNotEnoughShares
+	}
+
+	parts := make([][]byte, len(codes))
+	var first recovery.Share
+	for i, code := range codes {
+		share, err := recovery.ParseCode(code)
+		if err != nil {
+			return nil, 0, fmt.Errorf("%w: share %d: %v", svc.ErrInvalidRecoveryShare, i+1, err)
+		}
+
+		if i == 0 {
+			first = share
+		} else if share.KitID != first.KitID || share.Threshold != first.Threshold {
+			return nil, 0, fmt.Errorf("%w: share %d belongs to another kit", svc.ErrInvalidRecoveryShare, i+1)
+		}
+		parts[i] = share.Data
+	}
+
+	if len(parts) < first.Threshold {
+		return nil, 0, fmt.Errorf("%w: %d of %d given", svc.ErrNotEnoughShares, len(parts), first.Threshold)
+	}
+
+	vaultKey, err = shamir.Combine(parts)
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w: %v", svc.ErrInvalidRecoveryShare, err)
+	}
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		acc, err := s.repo.GetAccountByUserName(ctx, tx, username)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return svc.ErrRecoveryFailed
+			}
+			return fmt.Errorf("error in getAccountByUserName: %w", err)
+		}
+
+		kit, err := s.repo.GetRecoveryKit(ctx, tx, username)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return svc.ErrRecoveryFailed
+			}
+			return fmt.Errorf("error in getRecoveryKit: %w", err)
+		}
+
+		if kit.ID != first.KitID || !kit.VerifyKey(vaultKey) {
+			return svc.ErrRecoveryFailed
+		}
+
+		oldSecret := acc.Secret
+		if err = acc.GenerateSecret(change.NewPassword); err != nil {
+			return err
+		}
+
+		err = s.repo.UpdateAccountSecret(ctx, tx, username, oldSecret, acc.Secret)
+		if err != nil {
+			if errors.Is(err, repository.ErrNoRowsAffected) {
+				// The password was changed concurrently; the user can simply retry.
+				return svc.ErrRecoveryFailed
+			}
+			return fmt.Errorf("error in updateAccountSecret: %w", err)
+		}
+
+		if len(change.PublicKey) > 0 {
+			if err = s.rekey(ctx, tx, username, change); err != nil {
+				return err
+			}
+		}
+
+		revoked, err = s.repo.RevokeSessions(ctx, tx, username, "")
+		if err != nil {
+			return fmt.Errorf("error in revokeSessions: %w", err)
+		}
+
+		err = s.repo.InsertAuditEvent(ctx, tx, audit.Event{
+			Username: username,
+			Action:   audit.ActionRecover,
+			Details: map[string]string{
+				"kit_id":           recovery.FormatKitID(kit.ID),
+				"rekeyed":          strconv.FormatBool(len(change.PublicKey) > 0),
+				"revoked_sessions": strconv.FormatInt(revoked, 10),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error in insertAuditEvent: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return vaultKey, revoked, nil
+}
@@ -5,19 +5,28 @@ package auth
 
 import (
 	"context"
-	"crypto/ed25519"
 	"database/sql"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gleb-korostelev/GophKeeper/models"
+	"github.com/gleb-korostelev/GophKeeper/models/audit"
+	"github.com/gleb-korostelev/GophKeeper/models/device"
+	"github.com/gleb-korostelev/GophKeeper/models/org"
+	"github.com/gleb-korostelev/GophKeeper/models/quota"
 	"github.com/gleb-korostelev/GophKeeper/pkg/claims"
+	"github.com/gleb-korostelev/GophKeeper/pkg/keyring"
 	"github.com/gleb-korostelev/GophKeeper/pkg/otp"
 	"github.com/gleb-korostelev/GophKeeper/repository"
 	svc "github.com/gleb-korostelev/GophKeeper/service"
 	"github.com/gleb-korostelev/GophKeeper/tools/db"
+	"github.com/gleb-korostelev/GophKeeper/tools/logger"
+	"github.com/gleb-korostelev/GophKeeper/tools/metrics"
+	"github.com/gleb-korostelev/GophKeeper/tools/notify"
+	"github.com/gleb-korostelev/GophKeeper/tools/tracing"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 )
@@ -25,26 +34,43 @@ import (
 const (
 	sevenDays = time.Hour * 24 * 7 // Token expiration duration for refresh tokens.
 	fiveMin   = time.Minute * 5    // Token expiration duration for short-lived tokens.
+	tokenTTL  = time.Hour          // Token expiration duration for access tokens and their sessions.
 )
 
+// KeyRetention is how long a retired signing key keeps verifying tokens: the lifetime of the longest-lived
+// tokens it signed.
+const KeyRetention = tokenTTL
+
 // service defines the implementation of the authentication service.
 //
 // Fields:
-// - privateKey: The private key used for signing JWT tokens.
+// - keys: The keyring whose active key signs JWT tokens.
 // - db: The database adapter for executing database operations.
+// - notifier: Tells users about sign-ins from new devices.
+// - plans: The limits of the account plans that API keys and devices are checked against.
+// - lockout: The limit on wrong passwords given for an account from a client.
 type service struct {
-	privateKey ed25519.PrivateKey
-	db         db.IAdapter
-	repo       repository.Repository
+	keys     *keyring.Store
+	db       db.IAdapter
+	repo     repository.Repository
+	notifier notify.Notifier
+	plans    quota.Plans
+	lockout  models.Lockout
 }
 
 // NewService creates a new instance of the authentication service.
-func NewService(db db.IAdapter, privateKey ed25519.PrivateKey) *service {
-	return &service{db: db, privateKey: privateKey}
+func NewService(db db.IAdapter, keys *keyring.Store, notifier notify.Notifier, plans quota.Plans,
+	lockout models.Lockout) *service {
+	return &service{db: db, keys: keys, notifier: notifier, plans: plans, lockout: lockout}
 }
 
 // CreateProfile creates a new user profile or retrieves an existing one, returning an OTP challenge.
+// Registering an existing username again only succeeds with the account's current password;
+// passwords are changed with ChangePassword.
 func (s *service) CreateProfile(ctx context.Context, profile models.Profile) (challenge string, err error) {
+	ctx, span := tracing.Start(ctx, "auth.CreateProfile")
+	defer func() { tracing.End(span, err) }()
+
 	var acc models.Account
 	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
 		acc, err = s.repo.GetAccountByUserName(ctx, tx, profile.Username)
@@ -62,6 +88,8 @@ func (s *service) CreateProfile(ctx context.Context, profile models.Profile) (ch
 			} else {
 				return fmt.Errorf("error in getAccountByUserName: %w", err)
 			}
+		} else if !acc.VerifySecret(profile.Password) {
+			return svc.ErrAccountExists
 		}
 		return nil
 	})
@@ -76,6 +104,9 @@ func (s *service) CreateProfile(ctx context.Context, profile models.Profile) (ch
 
 // GetChallenge generates an OTP challenge for an existing user profile.
 func (s *service) GetChallenge(ctx context.Context, profile models.Profile) (challenge string, err error) {
+	ctx, span := tracing.Start(ctx, "auth.GetChallenge")
+	defer func() { tracing.End(span, err) }()
+
 	var acc models.Account
 	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
 		acc, err = s.repo.GetAccountByUserName(ctx, tx, profile.Username)
@@ -94,8 +125,29 @@ func (s *service) GetChallenge(ctx context.Context, profile models.Profile) (cha
 }
 
 // SignIn authenticates a user by validating their OTP and password, then returns JWT tokens.
-func (s *service) SignIn(ctx context.Context, profile models.Profile, challenge string) (token, refresh string, err error) {
-	var acc models.Account
+// A client presenting a device binds the session to it: the device must have signed the challenge,
+// and requests with the session's tokens must be signed with the device key. Sign-ins from devices
+// not seen before are recorded in the audit log and notified to the user. Wrong passwords are counted per
+// client, the address the sign-in comes from: after the configured number of consecutive ones, sign-ins
+// to the account from that client fail with svc.ErrAccountLocked for the configured duration without the
+// password being checked, while other clients can still sign in. The outcome is counted in the metrics.
+func (s *service) SignIn(ctx context.Context, profile models.Profile, challenge, client string,
+	reg *device.Registration) (token, refresh string, err error) {
+	ctx, span := tracing.Start(ctx, "auth.SignIn")
+	defer func() {
+		metrics.SignIns.WithLabelValues(signInOutcome(err)).Inc()
+		tracing.End(span, err)
+	}()
+
+	if reg != nil && !reg.Valid(challenge) {
+		return "", "", svc.ErrInvalidDevice
+	}
+
+	var (
+		acc      models.Account
+		failures models.SignInFailures
+		orgs     []org.Member
+	)
 	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
 		acc, err = s.repo.GetAccountByUserName(ctx, tx, profile.Username)
 		if err != nil {
@@ -104,6 +156,21 @@ func (s *service) SignIn(ctx context.Context, profile models.Profile, challenge
 			}
 			return fmt.Errorf("error in getAccountByUserName: %w", err)
 		}
+
+		if s.lockout.Enabled() {
+			failures, err = s.repo.GetSignInFailures(ctx, tx, acc.Username, client)
+			if err != nil {
+				return fmt.Errorf("error in getSignInFailures: %w", err)
+			}
+			if failures.Locked(time.Now()) {
+				return svc.ErrAccountLocked
+			}
+		}
+
+		orgs, err = s.repo.GetUserOrgs(ctx, tx, profile.Username)
+		if err != nil {
+			return fmt.Errorf("error in getUserOrgs: %w", err)
+		}
 		return nil
 	})
 	if err != nil {
@@ -116,40 +183,102 @@ func (s *service) SignIn(ctx context.Context, profile models.Profile, challenge
 	}
 
 	if !otp.VerifyPassword(otpCurr, otpPrev, profile.Password, challenge, acc.Secret) {
+		if !s.lockout.Enabled() {
+			return "", "", svc.ErrIncorrectPassword
+		}
+		err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+			err := s.repo.RecordFailedSignIn(ctx, tx, acc.Username, client, s.lockout.Attempts, s.lockout.Duration)
+			if err != nil {
+				return fmt.Errorf("error in recordFailedSignIn: %w", err)
+			}
+			return nil
+		})
+		if err != nil {
+			return "", "", err
+		}
 		return "", "", svc.ErrIncorrectPassword
 	}
 
-	if acc.AccountType == models.AccountUnauthorizedUser {
-		acc.AccountType = models.AccountAuthorizedUser
-		err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+	var (
+		sessionID = uuid.New().String()
+		dev       device.Device
+		isNew     bool
+	)
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		if failures.Failures > 0 || failures.LockedUntil != nil {
+			err = s.repo.ResetFailedSignIns(ctx, tx, acc.Username, client)
+			if err != nil {
+				return fmt.Errorf("error in resetFailedSignIns: %w", err)
+			}
+		}
+
+		if acc.AccountType == models.AccountUnauthorizedUser {
+			acc.AccountType = models.AccountAuthorizedUser
 			err = s.repo.UpdateAccountType(ctx, tx, acc.Username, acc.AccountType)
 			if err != nil {
 				return fmt.Errorf("error in updateAccountType: %w", err)
 			}
-			return nil
-		})
+		}
+
+		if reg != nil {
+			dev, isNew, err = s.registerDevice(ctx, tx, acc.Username, *reg)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = s.repo.InsertSession(ctx, tx, sessionID, acc.Username, dev.ID, time.Now().UTC().Add(tokenTTL))
 		if err != nil {
-			return
+			return fmt.Errorf("error in insertSession: %w", err)
 		}
+		return nil
+	})
+	if err != nil {
+		return
+	}
+
+	if isNew {
+		s.notify(ctx, acc.Username, "sign-in from a new device",
+			fmt.Sprintf("your account was signed in from the new device %q (%s); if this was not you, "+
+				"remove the device and change your master password", dev.Name, dev.Platform))
 	}
 
 	roleFunc := getRole(acc.AccountType)
 	abilities := []claims.Ability{roleFunc(profile.Username)}
 
+	// Carry organization roles in the token so that org endpoints are authorized without a DB lookup.
+	for _, m := range orgs {
+		abilities = append(abilities, claims.OrgRole(m.Role, m.Org))
+	}
+
 	claims := claims.NewClaims(
-		time.Hour,
+		tokenTTL,
 		claims.Role{
 			Name:      profile.Username,
 			Global:    true,
 			Abilities: claims.ToAbilities(abilities...),
 		},
 	)
-	token, refresh, err = claims.Sign(s.privateKey, profile.Username, fiveMin)
+	claims.Id = sessionID
+
+	key, err := s.keys.Signing()
+	if err != nil {
+		return "", "", fmt.Errorf("error in signing: %w", err)
+	}
+	token, refresh, err = claims.Sign(key.PrivateKey, key.ID, profile.Username, fiveMin)
 	return
 }
 
+// GetJWKS retrieves the public keys verifying access tokens, as a JSON Web Key Set.
+func (s *service) GetJWKS(ctx context.Context) (keyring.JWKS, error) {
+	return s.keys.JWKS(), nil
+}
+
 // GetAccountByUserName retrieves an account by username.
 func (s *service) GetAccountByUserName(ctx context.Context, username string) (acc models.Account, err error) {
+	ctx, span := tracing.Start(ctx, "auth.GetAccountByUserName")
+	defer func() { tracing.End(span, err) }()
+
 	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
 		acc, err = s.repo.GetAccountByUserName(ctx, tx, username)
 		if err != nil {
@@ -163,3 +292,194 @@ func (s *service) GetAccountByUserName(ctx context.Context, username string) (ac
 	})
 	return acc, err
 }
+
+// SetPublicKey publishes the public key other users wrap shared item keys to.
+func (s *service) SetPublicKey(ctx context.Context, username string, publicKey []byte) (err error) {
+	ctx, span := tracing.Start(ctx, "auth.SetPublicKey")
+	defer func() { tracing.End(span, err) }()
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		err = s.repo.SetPublicKey(ctx, tx, username, publicKey)
+		if err != nil {
+			return fmt.Errorf("error in setPublicKey: %w", err)
+		}
+		return nil
+	})
+	return
+}
+
+// ChangePassword replaces a user's master password after verifying the old one and revokes all of the
+// user's sessions except the current one, returning how many were revoked.
+// If the client re-keyed the vault, the new public key and every item key the user holds, re-wrapped to it,
+// are stored in the same transaction, so the vault is never left with keys wrapped to different key pairs.
+func (s *service) ChangePassword(ctx context.Context, username, sessionID string, change models.PasswordChange) (
+	revoked int64, err error) {
+	ctx, span := tracing.Start(ctx, "auth.ChangePassword")
+	defer func() { tracing.End(span, err) }()
+
+	if change.NewPassword == "" {
+		return 0, svc.ErrInvalidPassword
+	}
+	if len(change.ItemKeys) > 0 && len(change.PublicKey) == 0 {
+		return 0, svc.ErrIncompleteRekey
+	}
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		acc, err := s.repo.GetAccountByUserName(ctx, tx, username)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return svc.ErrAccountNotFound
+			}
+			return fmt.Errorf("error in getAccountByUserName: %w", err)
+		}
+
+		if !acc.VerifySecret(change.OldPassword) {
+			return svc.ErrIncorrectPassword
+		}
+
+		oldSecret := acc.Secret
+		if err = acc.GenerateSecret(change.NewPassword); err != nil {
+			return err
+		}
+
+		err = s.repo.UpdateAccountSecret(ctx, tx, username, oldSecret, acc.Secret)
+		if err != nil {
+			if errors.Is(err, repository.ErrNoRowsAffected) {
+				// The password was changed concurrently, so the old password no longer holds.
+				return svc.ErrIncorrectPassword
+			}
+			return fmt.Errorf("error in updateAccountSecret: %w", err)
+		}
+
+		if len(change.PublicKey) > 0 {
+			if err = s.rekey(ctx, tx, username, change); err != nil {
+				return err
+			}
+		}
+
+		revoked, err = s.repo.RevokeSessions(ctx, tx, username, sessionID)
+		if err != nil {
+			return fmt.Errorf("error in revokeSessions: %w", err)
+		}
+
+		err = s.repo.InsertAuditEvent(ctx, tx, audit.Event{
+			Username: username,
+			Action:   audit.ActionPasswordChange,
+			Details: map[string]string{
+				"rekeyed":          strconv.FormatBool(len(change.PublicKey) > 0),
+				"revoked_sessions": strconv.FormatInt(revoked, 10),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error in insertAuditEvent: %w", err)
+		}
+		return nil
+	})
+	return
+}
+
+// rekey stores a user's new public key together with the re-wrapped item keys.
+// The keys must cover exactly the item keys the user holds. The user's recovery kit is removed.
+func (s *service) rekey(ctx context.Context, tx pgx.Tx, username string, change models.PasswordChange) error {
+	held, err := s.repo.GetItemKeys(ctx, tx, username)
+	if err != nil {
+		return fmt.Errorf("error in getItemKeys: %w", err)
+	}
+
+	type keyID struct{ owner, cardNumber string }
+	missing := make(map[keyID]bool, len(held))
+	for _, k := range held {
+		missing[keyID{k.Owner, k.CardNumber}] = true
+	}
+
+	for _, k := range change.ItemKeys {
+		if k.Owner == "" {
+			k.Owner = username
+		}
+
+		id := keyID{k.Owner, k.CardNumber}
+		if !missing[id] || len(k.Key) == 0 {
+			return fmt.Errorf("%w: unexpected key for card %s of %s", svc.ErrIncompleteRekey, k.CardNumber, k.Owner)
+		}
+		delete(missing, id)
+
+		if err = s.repo.UpdateItemKey(ctx, tx, username, k); err != nil {
+			return fmt.Errorf("error in updateItemKey: %w", err)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %d item keys were not re-wrapped", svc.ErrIncompleteRekey, len(missing))
+	}
+
+	err = s.repo.SetPublicKey(ctx, tx, username, change.PublicKey)
+	if err != nil {
+		return fmt.Errorf("error in setPublicKey: %w", err)
+	}
+
+	// A recovery kit splits the previous vault key, which no longer opens the item keys.
+	err = s.repo.DeleteRecoveryKit(ctx, tx, username)
+	if err != nil && !errors.Is(err, repository.ErrNoRowsAffected) {
+		return fmt.Errorf("error in deleteRecoveryKit: %w", err)
+	}
+	return nil
+}
+
+// SessionActive reports whether a sign-in session exists and has neither expired nor been revoked.
+// For a session bound to a device, the device's public key is returned as well.
+func (s *service) SessionActive(ctx context.Context, sessionID string) (active bool, deviceKey []byte, err error) {
+	ctx, span := tracing.Start(ctx, "auth.SessionActive")
+	defer func() { tracing.End(span, err) }()
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		active, deviceKey, err = s.repo.IsSessionActive(ctx, tx, sessionID)
+		if err != nil {
+			return fmt.Errorf("error in isSessionActive: %w", err)
+		}
+		return nil
+	})
+	return
+}
+
+// PurgeSessions removes sessions that have expired or were revoked, and forgets the wrong passwords of
+// clients that gave none for the lockout duration and are not locked.
+func (s *service) PurgeSessions(ctx context.Context) (err error) {
+	ctx, span := tracing.Start(ctx, "auth.PurgeSessions")
+	defer func() { tracing.End(span, err) }()
+
+	var purged int64
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		purged, err = s.repo.DeleteExpiredSessions(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("error in deleteExpiredSessions: %w", err)
+		}
+
+		_, err = s.repo.DeleteStaleSignInFailures(ctx, tx, s.lockout.Duration)
+		if err != nil {
+			return fmt.Errorf("error in deleteStaleSignInFailures: %w", err)
+		}
+		return nil
+	})
+	if err == nil && purged > 0 {
+		logger.Infof("purged %d expired sessions", purged)
+	}
+	return
+}
+
+// signInOutcome classifies the result of a sign-in for the metrics.
+func signInOutcome(err error) string {
+	switch {
+	case err == nil:
+		return metrics.SignInSuccess
+	case errors.Is(err, svc.ErrIncorrectPassword):
+		return metrics.SignInBadPassword
+	case errors.Is(err, svc.ErrAccountNotFound):
+		return metrics.SignInNotFound
+	case errors.Is(err, svc.ErrAccountLocked):
+		return metrics.SignInLocked
+	case errors.Is(err, svc.ErrInvalidDevice):
+		return metrics.SignInInvalidDevice
+	default:
+		return metrics.SignInError
+	}
+}
@@ -0,0 +1,378 @@
+// Package backup provides services for backing up the vault database as a whole and restoring it,
+// for operators rather than users.
+package backup
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdh"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/models/backup"
+	backupfile "github.com/gleb-korostelev/GophKeeper/pkg/backup"
+	"github.com/gleb-korostelev/GophKeeper/repository"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gleb-korostelev/GophKeeper/tools/db"
+	"github.com/gleb-korostelev/GophKeeper/tools/tracing"
+	"github.com/jackc/pgx/v5"
+)
+
+// service defines the implementation of the backup service.
+//
+// Fields:
+// - db: The database adapter for executing transactional operations.
+type service struct {
+	db   db.IAdapter
+	repo repository.Repository
+}
+
+// NewService creates a new instance of the backup service.
+func NewService(db db.IAdapter) *service {
+	return &service{db: db}
+}
+
+// Backup writes a backup of all tables, blobs and sequences of the vault to w, encrypted to the operator
+// public key recipient. Everything is read in a single read-only repeatable-read transaction, so the backup
+// is a consistent snapshot of the vault while the servers keep writing to it.
+func (s *service) Backup(ctx context.Context, w io.Writer, recipient *ecdh.PublicKey) (summary backup.Summary, err error) {
+	ctx, span := tracing.Start(ctx, "backup.Backup")
+	defer func() { tracing.End(span, err) }()
+
+	err = s.db.InSnapshot(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		// The snapshot is taken by the first query, so the schema version is that of the data.
+		version, err := db.AppliedVersion(ctx, tx)
+		if err != nil {
+			return err
+		}
+
+		summary = backup.Summary{
+			CreatedAt:     time.Now().UTC().Truncate(time.Second),
+			SchemaVersion: version,
+			KeyID:         backupfile.KeyID(recipient),
+		}
+		bw, err := backupfile.NewWriter(w, recipient, summary.CreatedAt, version)
+		if err != nil {
+			return fmt.Errorf("failed to write backup: %w", err)
+		}
+
+		tables, err := s.repo.GetVaultTables(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("error in getVaultTables: %w", err)
+		}
+		for _, table := range tables {
+			if err = writeJSON(bw, backupfile.KindTable, table); err != nil {
+				return err
+			}
+			rows, err := s.repo.CopyTableTo(ctx, tx, table, bw.Data())
+			if err != nil {
+				return fmt.Errorf("error in copyTableTo: %w", err)
+			}
+			if err = writeJSON(bw, backupfile.KindEnd, backup.End{Rows: rows}); err != nil {
+				return err
+			}
+			summary.Tables = append(summary.Tables, backup.TableSummary{Name: table.Name, Rows: rows})
+		}
+
+		blobs, err := s.repo.GetBlobs(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("error in getBlobs: %w", err)
+		}
+		for _, blob := range blobs {
+			if err = writeJSON(bw, backupfile.KindBlob, blob); err != nil {
+				return err
+			}
+			size, err := s.repo.ReadBlob(ctx, tx, blob, bw.Data())
+			if err != nil {
+				return fmt.Errorf("error in readBlob: %w", err)
+			}
+			if err = writeJSON(bw, backupfile.KindEnd, backup.End{Size: size}); err != nil {
+				return err
+			}
+			summary.Blobs++
+			summary.BlobBytes += size
+		}
+
+		seqs, err := s.repo.GetSequences(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("error in getSequences: %w", err)
+		}
+		if err = writeJSON(bw, backupfile.KindSequences, seqs); err != nil {
+			return err
+		}
+
+		if err = bw.Close(); err != nil {
+			return fmt.Errorf("failed to write backup: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return backup.Summary{}, err
+	}
+
+	return summary, nil
+}
+
+// Verify decrypts a backup with the operator private key identity and checks it entirely, without a database:
+// its checksum, and that each table and blob holds as many rows and bytes as recorded.
+func (s *service) Verify(ctx context.Context, r io.Reader, identity *ecdh.PrivateKey) (summary backup.Summary, err error) {
+	_, span := tracing.Start(ctx, "backup.Verify")
+	defer func() { tracing.End(span, err) }()
+
+	br, err := backupfile.NewReader(r, identity)
+	if err != nil {
+		return backup.Summary{}, err
+	}
+	return readBackup(br, verifier{})
+}
+
+// Restore restores a backup, decrypted with the operator private key identity, into the vault.
+// The database schema must be at the version the backup was taken from, and the vault must be empty
+// unless force is set, in which case everything in it is removed first.
+//
+// The backup is restored in a single transaction, with the foreign keys checked at its end, so that
+// a backup failing any check, such as a file cut short, leaves the vault as it was.
+func (s *service) Restore(ctx context.Context, r io.Reader, identity *ecdh.PrivateKey, force bool) (
+	summary backup.Summary, err error) {
+	ctx, span := tracing.Start(ctx, "backup.Restore")
+	defer func() { tracing.End(span, err) }()
+
+	br, err := backupfile.NewReader(r, identity)
+	if err != nil {
+		return backup.Summary{}, err
+	}
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		version, err := db.AppliedVersion(ctx, tx)
+		if err != nil {
+			return err
+		}
+		if version != br.Header().SchemaVersion {
+			return fmt.Errorf("%w: backup at version %d, database at version %d", svc.ErrBackupSchemaMismatch,
+				br.Header().SchemaVersion, version)
+		}
+
+		tables, err := s.repo.GetVaultTables(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("error in getVaultTables: %w", err)
+		}
+
+		empty, err := s.repo.IsVaultEmpty(ctx, tx, tables)
+		if err != nil {
+			return fmt.Errorf("error in isVaultEmpty: %w", err)
+		}
+		if !empty {
+			if !force {
+				return svc.ErrVaultNotEmpty
+			}
+			if err = s.repo.TruncateVault(ctx, tx, tables); err != nil {
+				return fmt.Errorf("error in truncateVault: %w", err)
+			}
+		}
+
+		fks, err := s.repo.GetForeignKeys(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("error in getForeignKeys: %w", err)
+		}
+		if err = s.repo.DeferForeignKeys(ctx, tx, fks); err != nil {
+			return fmt.Errorf("error in deferForeignKeys: %w", err)
+		}
+
+		known := make(map[string]bool, len(tables))
+		for _, t := range tables {
+			known[t.Name] = true
+		}
+		summary, err = readBackup(br, restorer{ctx: ctx, tx: tx, repo: s.repo, tables: known})
+		if err != nil {
+			return err
+		}
+
+		if err = s.repo.CheckForeignKeys(ctx, tx, fks); err != nil {
+			return fmt.Errorf("error in checkForeignKeys: %w", err)
+		}
+
+		// Usage is not backed up; it is derived from the restored items and attachments.
+		if err = s.repo.RecountUsage(ctx, tx); err != nil {
+			return fmt.Errorf("error in recountUsage: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return backup.Summary{}, err
+	}
+
+	return summary, nil
+}
+
+// sink receives the content of a backup as it is read.
+type sink interface {
+	table(table backup.Table, rows io.Reader) (int64, error)
+	blob(blob backup.Blob, content io.Reader) (int64, error)
+	sequences(seqs []backup.Sequence) error
+}
+
+// readBackup reads all records of a backup into dst, checking each table and blob against its end record,
+// and returns the summary of the backup.
+func readBackup(br *backupfile.Reader, dst sink) (backup.Summary, error) {
+	header := br.Header()
+	summary := backup.Summary{
+		CreatedAt:     header.CreatedAt,
+		SchemaVersion: header.SchemaVersion,
+		KeyID:         header.Recipient.KeyID,
+	}
+
+	for {
+		kind, payload, err := br.Next()
+		if errors.Is(err, io.EOF) {
+			return summary, nil
+		}
+		if err != nil {
+			return backup.Summary{}, err
+		}
+
+		switch kind {
+		case backupfile.KindTable:
+			var table backup.Table
+			if err = json.Unmarshal(payload, &table); err != nil {
+				return backup.Summary{}, fmt.Errorf("%w: %w", svc.ErrInvalidBackup, err)
+			}
+			rows, err := dst.table(table, br.Data())
+			if err != nil {
+				return backup.Summary{}, err
+			}
+			if err = readEnd(br, backup.End{Rows: rows}); err != nil {
+				return backup.Summary{}, fmt.Errorf("table %s: %w", table.Name, err)
+			}
+			summary.Tables = append(summary.Tables, backup.TableSummary{Name: table.Name, Rows: rows})
+
+		case backupfile.KindBlob:
+			var blob backup.Blob
+			if err = json.Unmarshal(payload, &blob); err != nil {
+				return backup.Summary{}, fmt.Errorf("%w: %w", svc.ErrInvalidBackup, err)
+			}
+			size, err := dst.blob(blob, br.Data())
+			if err != nil {
+				return backup.Summary{}, err
+			}
+			if err = readEnd(br, backup.End{Size: size}); err != nil {
+				return backup.Summary{}, fmt.Errorf("blob %s: %w", blob.Key, err)
+			}
+			summary.Blobs++
+			summary.BlobBytes += size
+
+		case backupfile.KindSequences:
+			var seqs []backup.Sequence
+			if err = json.Unmarshal(payload, &seqs); err != nil {
+				return backup.Summary{}, fmt.Errorf("%w: %w", svc.ErrInvalidBackup, err)
+			}
+			if err = dst.sequences(seqs); err != nil {
+				return backup.Summary{}, err
+			}
+
+		default:
+			return backup.Summary{}, fmt.Errorf("%w: unexpected record %q", svc.ErrInvalidBackup, kind)
+		}
+	}
+}
+
+// readEnd reads the end record of a table or blob and checks that it matches what was read.
+func readEnd(br *backupfile.Reader, got backup.End) error {
+	kind, payload, err := br.Next()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return fmt.Errorf("%w: missing end record", svc.ErrInvalidBackup)
+		}
+		return err
+	}
+
+	var want backup.End
+	if kind != backupfile.KindEnd || json.Unmarshal(payload, &want) != nil {
+		return fmt.Errorf("%w: missing end record", svc.ErrInvalidBackup)
+	}
+	if got != want {
+		return fmt.Errorf("%w: read %d rows and %d bytes, expected %d rows and %d bytes", svc.ErrInvalidBackup,
+			got.Rows, got.Size, want.Rows, want.Size)
+	}
+	return nil
+}
+
+// writeJSON writes a record holding v encoded as JSON.
+func writeJSON(bw *backupfile.Writer, kind backupfile.Kind, v any) error {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	if err = bw.WriteRecord(kind, payload); err != nil {
+		return fmt.Errorf("failed to write backup: %w", err)
+	}
+	return nil
+}
+
+// verifier is a sink counting the rows and bytes of a backup without storing them.
+// Rows are counted by line, since the text format of COPY escapes newlines within values.
+type verifier struct{}
+
+// table counts the rows of a table.
+func (verifier) table(_ backup.Table, rows io.Reader) (n int64, err error) {
+	buf := make([]byte, 32<<10)
+	for {
+		read, err := rows.Read(buf)
+		n += int64(bytes.Count(buf[:read], []byte{'\n'}))
+		if errors.Is(err, io.EOF) {
+			return n, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+}
+
+// blob counts the bytes of a blob.
+func (verifier) blob(_ backup.Blob, content io.Reader) (int64, error) {
+	return io.Copy(io.Discard, content)
+}
+
+// sequences accepts the sequences.
+func (verifier) sequences([]backup.Sequence) error {
+	return nil
+}
+
+// restorer is a sink storing the content of a backup into the vault within a transaction.
+type restorer struct {
+	ctx    context.Context
+	tx     pgx.Tx
+	repo   repository.Repository
+	tables map[string]bool
+}
+
+// table restores the rows of a table, which must exist in the database.
+func (r restorer) table(table backup.Table, rows io.Reader) (int64, error) {
+	if !r.tables[table.Name] {
+		return 0, fmt.Errorf("%w: table %s does not exist", svc.ErrInvalidBackup, table.Name)
+	}
+	n, err := r.repo.CopyTableFrom(r.ctx, r.tx, table, rows)
+	if err != nil {
+		return 0, fmt.Errorf("error in copyTableFrom: %w", err)
+	}
+	return n, nil
+}
+
+// blob restores a blob.
+func (r restorer) blob(blob backup.Blob, content io.Reader) (int64, error) {
+	n, err := r.repo.WriteBlob(r.ctx, r.tx, blob.Key, content)
+	if err != nil {
+		return 0, fmt.Errorf("error in writeBlob: %w", err)
+	}
+	return n, nil
+}
+
+// sequences restores the sequences.
+func (r restorer) sequences(seqs []backup.Sequence) error {
+	if err := r.repo.SetSequences(r.ctx, r.tx, seqs); err != nil {
+		return fmt.Errorf("error in setSequences: %w", err)
+	}
+	return nil
+}
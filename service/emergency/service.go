@@ -0,0 +1,250 @@
+// Package emergency provides services for emergency (break-glass) access, which lets a
+// trusted contact read a user's vault after a waiting period the user did not cut short.
+package emergency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gleb-korostelev/GophKeeper/models/emergency"
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
+	"github.com/gleb-korostelev/GophKeeper/repository"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gleb-korostelev/GophKeeper/tools/db"
+	"github.com/gleb-korostelev/GophKeeper/tools/logger"
+	"github.com/gleb-korostelev/GophKeeper/tools/notify"
+	"github.com/gleb-korostelev/GophKeeper/tools/tracing"
+	"github.com/jackc/pgx/v5"
+)
+
+// service defines the implementation of the emergency access service.
+//
+// Fields:
+// - db: The database adapter for executing transactional operations.
+// - notifier: Tells grantors and grantees about requests and decisions.
+type service struct {
+	db       db.IAdapter
+	repo     repository.Repository
+	notifier notify.Notifier
+}
+
+// NewService creates a new instance of the emergency access service.
+func NewService(db db.IAdapter, notifier notify.Notifier) *service {
+	return &service{db: db, notifier: notifier}
+}
+
+// AddContact designates a trusted contact, or changes the wait period of an existing one.
+// Any pending request or granted access of the contact is reset.
+func (s *service) AddContact(ctx context.Context, contact emergency.Contact) (err error) {
+	ctx, span := tracing.Start(ctx, "emergency.AddContact")
+	defer func() { tracing.End(span, err) }()
+
+	if contact.Grantor == contact.Grantee {
+		return svc.ErrNotAuthorized
+	}
+	if contact.WaitDays < emergency.MinWaitDays || contact.WaitDays > emergency.MaxWaitDays {
+		return svc.ErrInvalidWaitPeriod
+	}
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		err = s.repo.UpsertEmergencyContact(ctx, tx, contact)
+		if err != nil {
+			if errors.Is(err, repository.ErrNoRowsAffected) {
+				return svc.ErrAccountNotFound
+			}
+			return fmt.Errorf("error in upsertEmergencyContact: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	s.notify(ctx, contact.Grantee, "emergency contact",
+		fmt.Sprintf("%s added you as an emergency contact with a %d day wait period", contact.Grantor, contact.WaitDays))
+	return nil
+}
+
+// RemoveContact removes a trusted contact and revokes any access it was granted.
+func (s *service) RemoveContact(ctx context.Context, grantor, grantee string) (err error) {
+	ctx, span := tracing.Start(ctx, "emergency.RemoveContact")
+	defer func() { tracing.End(span, err) }()
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		err = s.repo.DeleteEmergencyContact(ctx, tx, grantor, grantee)
+		if err != nil {
+			if errors.Is(err, repository.ErrNoRowsAffected) {
+				return svc.ErrEmergencyContactNotFound
+			}
+			return fmt.Errorf("error in deleteEmergencyContact: %w", err)
+		}
+		return nil
+	})
+	return
+}
+
+// GetContacts retrieves the trusted contacts a user designated and those that designated the user.
+func (s *service) GetContacts(ctx context.Context, username string) (contacts []emergency.Contact, err error) {
+	ctx, span := tracing.Start(ctx, "emergency.GetContacts")
+	defer func() { tracing.End(span, err) }()
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		contacts, err = s.repo.GetEmergencyContacts(ctx, tx, username)
+		if err != nil {
+			return fmt.Errorf("error in getEmergencyContacts: %w", err)
+		}
+		return nil
+	})
+	return
+}
+
+// RequestAccess starts the wait period for a grantee's access to a grantor's vault.
+func (s *service) RequestAccess(ctx context.Context, grantee, grantor string) (err error) {
+	ctx, span := tracing.Start(ctx, "emergency.RequestAccess")
+	defer func() { tracing.End(span, err) }()
+
+	contact, err := s.transition(ctx, grantor, grantee, emergency.EventRequest)
+	if err != nil {
+		return err
+	}
+
+	s.notify(ctx, grantor, "emergency access requested",
+		fmt.Sprintf("%s requested emergency access to your vault; it is granted automatically in %d days unless you reject it",
+			grantee, contact.WaitDays))
+	return nil
+}
+
+// Approve grants a pending request before its wait period ends.
+func (s *service) Approve(ctx context.Context, grantor, grantee string) (err error) {
+	ctx, span := tracing.Start(ctx, "emergency.Approve")
+	defer func() { tracing.End(span, err) }()
+
+	_, err = s.transition(ctx, grantor, grantee, emergency.EventApprove)
+	if err != nil {
+		return err
+	}
+
+	s.notify(ctx, grantee, "emergency access approved",
+		fmt.Sprintf("%s approved your emergency access request", grantor))
+	return nil
+}
+
+// Reject denies a pending request. The grantee may request access again later.
+func (s *service) Reject(ctx context.Context, grantor, grantee string) (err error) {
+	ctx, span := tracing.Start(ctx, "emergency.Reject")
+	defer func() { tracing.End(span, err) }()
+
+	_, err = s.transition(ctx, grantor, grantee, emergency.EventReject)
+	if err != nil {
+		return err
+	}
+
+	s.notify(ctx, grantee, "emergency access rejected",
+		fmt.Sprintf("%s rejected your emergency access request", grantor))
+	return nil
+}
+
+// transition applies event to a trusted contact and returns the contact as it was before the change.
+func (s *service) transition(ctx context.Context, grantor, grantee string, event emergency.Event) (contact emergency.Contact, err error) {
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		contact, err = s.repo.GetEmergencyContactForUpdate(ctx, tx, grantor, grantee)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return svc.ErrEmergencyContactNotFound
+			}
+			return fmt.Errorf("error in getEmergencyContactForUpdate: %w", err)
+		}
+
+		next, ok := emergency.Next(contact.Status, event)
+		if !ok {
+			return svc.ErrInvalidTransition
+		}
+
+		err = s.repo.UpdateEmergencyStatus(ctx, tx, grantor, grantee, next)
+		if err != nil {
+			return fmt.Errorf("error in updateEmergencyStatus: %w", err)
+		}
+		return nil
+	})
+	return
+}
+
+// GetGrantorCards retrieves a page of a grantor's cards for a grantee whose emergency access was approved,
+// and returns the cursor of the next page. The cards are returned read-only.
+func (s *service) GetGrantorCards(ctx context.Context, grantee, grantor string, page pagination.Request) (
+	cards []profile.CardInfo, next string, err error,
+) {
+	ctx, span := tracing.Start(ctx, "emergency.GetGrantorCards")
+	defer func() { tracing.End(span, err) }()
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		contact, err := s.repo.GetEmergencyContactForUpdate(ctx, tx, grantor, grantee)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return svc.ErrEmergencyContactNotFound
+			}
+			return fmt.Errorf("error in getEmergencyContactForUpdate: %w", err)
+		}
+
+		if contact.Status != emergency.StatusApproved {
+			return svc.ErrNotAuthorized
+		}
+
+		cards, err = s.repo.GetUserCards(ctx, tx, grantor, profile.Filter{}, page)
+		if err != nil {
+			return fmt.Errorf("error in getUserCards: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	cards, next = pagination.Trim(cards, page, func(card profile.CardInfo) pagination.Cursor {
+		return card.Cursor(page.Sort)
+	})
+	for i := range cards {
+		cards[i].Owner = grantor
+		cards[i].Permission = profile.PermissionRead
+	}
+	return cards, next, nil
+}
+
+// AutoApprove approves every pending request whose wait period ended without a rejection.
+func (s *service) AutoApprove(ctx context.Context) (err error) {
+	ctx, span := tracing.Start(ctx, "emergency.AutoApprove")
+	defer func() { tracing.End(span, err) }()
+
+	var approved []emergency.Contact
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		approved, err = s.repo.ApproveExpiredEmergencyRequests(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("error in approveExpiredEmergencyRequests: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, c := range approved {
+		s.notify(ctx, c.Grantee, "emergency access granted",
+			fmt.Sprintf("the wait period ended and you now have emergency access to %s's vault", c.Grantor))
+		s.notify(ctx, c.Grantor, "emergency access granted",
+			fmt.Sprintf("%s now has emergency access to your vault", c.Grantee))
+	}
+	if len(approved) > 0 {
+		logger.Infof("auto-approved %d emergency access requests", len(approved))
+	}
+	return nil
+}
+
+// notify sends a notification, logging delivery failures instead of failing the operation.
+func (s *service) notify(ctx context.Context, recipient, subject, body string) {
+	err := s.notifier.Notify(ctx, notify.Notification{Recipient: recipient, Subject: subject, Body: body})
+	if err != nil {
+		logger.Ctx(ctx).Errorf("failed to notify %s: %v", recipient, err)
+	}
+}
@@ -2,6 +2,7 @@
 //
 // These errors are used to standardize the error handling for account and authorization-related
 // operations, ensuring consistent behavior and messaging throughout the application.
+// The package also enforces the storage quotas the services share.
 package service
 
 import "errors"
@@ -10,9 +11,184 @@ var (
 	// ErrAccountNotFound indicates that an account with the specified details could not be found.
 	ErrAccountNotFound = errors.New("account not found")
 
+	// ErrAccountLocked indicates that sign-ins to an account are refused after too many wrong passwords.
+	ErrAccountLocked = errors.New("account is temporarily locked")
+
 	// ErrIncorrectPassword indicates that the provided password does not match the stored account secret.
 	ErrIncorrectPassword = errors.New("incorrect password")
 
 	// ErrNotAuthorized indicates that the user does not have sufficient permissions for the requested operation.
 	ErrNotAuthorized = errors.New("not authorized")
+
+	// ErrCardNotFound indicates that the requested card does not exist or is not visible to the user.
+	ErrCardNotFound = errors.New("card not found")
+
+	// ErrShareNotFound indicates that no share exists for the given card and grantee.
+	ErrShareNotFound = errors.New("share not found")
+
+	// ErrInvalidPermission indicates that an unsupported share permission was requested.
+	ErrInvalidPermission = errors.New("invalid permission")
+
+	// ErrWrappedKeyRequired indicates that a client-side encrypted card was shared without
+	// re-wrapping its item key to the grantee's public key.
+	ErrWrappedKeyRequired = errors.New("wrapped key is required for encrypted cards")
+
+	// ErrOrgExists indicates that an organization with the requested name already exists.
+	ErrOrgExists = errors.New("organization already exists")
+
+	// ErrOrgNotFound indicates that the requested organization does not exist.
+	ErrOrgNotFound = errors.New("organization not found")
+
+	// ErrNotOrgMember indicates that a user is not a member of the organization.
+	ErrNotOrgMember = errors.New("user is not a member of the organization")
+
+	// ErrInvalidRole indicates that an unsupported organization role was requested.
+	ErrInvalidRole = errors.New("invalid role")
+
+	// ErrGroupNotFound indicates that the requested group does not exist in the organization.
+	ErrGroupNotFound = errors.New("group not found")
+
+	// ErrCollectionNotFound indicates that the requested collection does not exist in the organization.
+	ErrCollectionNotFound = errors.New("collection not found")
+
+	// ErrSendNotFound indicates that a send does not exist, has expired or has no views left.
+	ErrSendNotFound = errors.New("send not found")
+
+	// ErrInvalidSend indicates that a send was requested with an empty payload or out-of-range limits.
+	ErrInvalidSend = errors.New("invalid send parameters")
+
+	// ErrEmergencyContactNotFound indicates that the user is not a trusted contact of the grantor.
+	ErrEmergencyContactNotFound = errors.New("emergency contact not found")
+
+	// ErrInvalidWaitPeriod indicates that an emergency wait period is out of the allowed range.
+	ErrInvalidWaitPeriod = errors.New("invalid wait period")
+
+	// ErrInvalidTransition indicates that an emergency access action is not allowed in the current status.
+	ErrInvalidTransition = errors.New("action is not allowed in the current status")
+
+	// ErrInvalidMetadata indicates that the metadata of an item is longer than allowed.
+	ErrInvalidMetadata = errors.New("invalid metadata")
+
+	// ErrInvalidQuota indicates that a quota override sets a negative limit.
+	ErrInvalidQuota = errors.New("invalid quota")
+
+	// ErrInvalidType indicates that an unsupported item type was requested.
+	ErrInvalidType = errors.New("invalid item type")
+
+	// ErrInvalidTag indicates that a tag name is too long.
+	ErrInvalidTag = errors.New("invalid tag")
+
+	// ErrTagNotFound indicates that the user has no tag with the requested name.
+	ErrTagNotFound = errors.New("tag not found")
+
+	// ErrInvalidFolder indicates that a folder name is empty or too long.
+	ErrInvalidFolder = errors.New("invalid folder name")
+
+	// ErrFolderExists indicates that a folder with the same name already exists in the parent folder.
+	ErrFolderExists = errors.New("folder already exists")
+
+	// ErrFolderNotFound indicates that the requested folder does not exist or belongs to another user.
+	ErrFolderNotFound = errors.New("folder not found")
+
+	// ErrInvalidSearch indicates that a search was requested without a query or with another sort than relevance.
+	ErrInvalidSearch = errors.New("invalid search parameters")
+
+	// ErrAttachmentNotFound indicates that the requested attachment does not exist or belongs to another user.
+	ErrAttachmentNotFound = errors.New("attachment not found")
+
+	// ErrInvalidAttachment indicates that an attachment has no name, an invalid checksum or an unsupported size.
+	ErrInvalidAttachment = errors.New("invalid attachment")
+
+	// ErrQuotaExceeded indicates that a write would exceed one of the user's limits, such as the number of items,
+	// the total size of what they store or the size of a single attachment.
+	ErrQuotaExceeded = errors.New("quota exceeded")
+
+	// ErrInvalidRange indicates that an upload chunk does not fit the attachment or exceeds the chunk size limit.
+	ErrInvalidRange = errors.New("invalid content range")
+
+	// ErrRangeMismatch indicates that an upload chunk does not continue where the upload stopped.
+	ErrRangeMismatch = errors.New("content range does not continue the upload")
+
+	// ErrChecksumMismatch indicates that an uploaded attachment does not match its SHA-256 checksum.
+	ErrChecksumMismatch = errors.New("checksum mismatch")
+
+	// ErrUploadComplete indicates that a chunk was sent for an attachment that is already uploaded.
+	ErrUploadComplete = errors.New("upload is already complete")
+
+	// ErrUploadIncomplete indicates that an attachment was requested before its upload finished.
+	ErrUploadIncomplete = errors.New("upload is not complete")
+
+	// ErrUnknownFormat indicates that an import was requested in an unsupported format.
+	ErrUnknownFormat = errors.New("unknown import format")
+
+	// ErrInvalidImport indicates that an import file could not be read.
+	ErrInvalidImport = errors.New("invalid import file")
+
+	// ErrUnknownExportFormat indicates that an export was requested in an unsupported format.
+	ErrUnknownExportFormat = errors.New("unknown export format")
+
+	// ErrWeakExportPassword indicates that an encrypted export was requested with a missing or too short password.
+	ErrWeakExportPassword = errors.New("export password must be at least 8 characters")
+
+	// ErrPlaintextNotConfirmed indicates that a plaintext export was requested without explicit confirmation.
+	ErrPlaintextNotConfirmed = errors.New("plaintext export must be confirmed")
+
+	// ErrAccountExists indicates that a username is already registered with a different password.
+	ErrAccountExists = errors.New("account already exists")
+
+	// ErrInvalidPassword indicates that a new password is empty.
+	ErrInvalidPassword = errors.New("invalid password")
+
+	// ErrIncompleteRekey indicates that a password change re-keyed the vault without re-wrapping
+	// exactly the item keys the user holds.
+	ErrIncompleteRekey = errors.New("re-keying must re-wrap every item key")
+
+	// ErrLastOrgOwner indicates that an account cannot be deleted while it is the only owner
+	// of an organization that has other members.
+	ErrLastOrgOwner = errors.New("account is the last owner of an organization")
+
+	// ErrInvalidRecoveryKit indicates invalid recovery kit parameters, such as a threshold above the number of shares.
+	ErrInvalidRecoveryKit = errors.New("invalid recovery kit")
+
+	// ErrRecoveryKitNotFound indicates that a user has no recovery kit.
+	ErrRecoveryKitNotFound = errors.New("recovery kit not found")
+
+	// ErrInvalidRecoveryShare indicates a malformed share code or shares of different kits.
+	ErrInvalidRecoveryShare = errors.New("invalid recovery share")
+
+	// ErrNotEnoughShares indicates that fewer shares than the threshold of their kit were given.
+	ErrNotEnoughShares = errors.New("not enough recovery shares")
+
+	// ErrRecoveryFailed indicates that the shares do not recover the vault key of the account.
+	// It deliberately does not tell whether the account or its kit exists.
+	ErrRecoveryFailed = errors.New("recovery failed")
+
+	// ErrInvalidDevice indicates a malformed device registration or a device signature that does not verify.
+	ErrInvalidDevice = errors.New("invalid device registration")
+
+	// ErrDeviceNotFound indicates that a user has no device with the given identifier.
+	ErrDeviceNotFound = errors.New("device not found")
+
+	// ErrInvalidAPIKey indicates invalid API key parameters, such as an expiry in the past or an empty name.
+	ErrInvalidAPIKey = errors.New("invalid api key")
+
+	// ErrAPIKeyNotFound indicates that a user has no API key with the given identifier.
+	ErrAPIKeyNotFound = errors.New("api key not found")
+
+	// ErrOutOfScope indicates that the API key making a request does not cover the item it targets.
+	ErrOutOfScope = errors.New("item is outside the api key scope")
+
+	// ErrCardExists indicates that a card number is already registered in another vault.
+	ErrCardExists = errors.New("card already exists")
+
+	// ErrVaultNotEmpty indicates that a backup was to be restored into a database already holding vault data.
+	ErrVaultNotEmpty = errors.New("vault database is not empty")
+
+	// ErrBackupSchemaMismatch indicates that a backup was taken from another version of the database schema
+	// than the one it was to be restored into.
+	ErrBackupSchemaMismatch = errors.New("backup schema version does not match the database")
+
+	// ErrInvalidBackup indicates that a backup decrypts and passes its checksum but does not hold what it declares,
+	// such as a table missing from the database or fewer rows than its end record counts.
+	ErrInvalidBackup = errors.New("invalid backup")
 )
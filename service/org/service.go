@@ -0,0 +1,250 @@
+// Package org provides services for managing organizations, their members, groups
+// and the collections of cards shared within them.
+package org
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gleb-korostelev/GophKeeper/models/org"
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
+	"github.com/gleb-korostelev/GophKeeper/repository"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gleb-korostelev/GophKeeper/tools/db"
+	"github.com/gleb-korostelev/GophKeeper/tools/tracing"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// uniqueViolation is the PostgreSQL error code for unique constraint violations.
+const uniqueViolation = "23505"
+
+// service defines the implementation of the organization service.
+//
+// Fields:
+// - db: The database adapter for executing transactional operations.
+type service struct {
+	db   db.IAdapter
+	repo repository.Repository
+}
+
+// NewService creates a new instance of the organization service.
+func NewService(db db.IAdapter) *service {
+	return &service{db: db}
+}
+
+// CreateOrg creates a new organization with username as its owner.
+func (s *service) CreateOrg(ctx context.Context, username, name string) (err error) {
+	ctx, span := tracing.Start(ctx, "org.CreateOrg")
+	defer func() { tracing.End(span, err) }()
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		err = s.repo.InsertOrg(ctx, tx, name, username)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+				return svc.ErrOrgExists
+			}
+			if errors.Is(err, repository.ErrNoRowsAffected) {
+				return svc.ErrAccountNotFound
+			}
+			return fmt.Errorf("error in insertOrg: %w", err)
+		}
+		return nil
+	})
+	return
+}
+
+// GetUserOrgs retrieves the organizations a user belongs to.
+func (s *service) GetUserOrgs(ctx context.Context, username string) (members []org.Member, err error) {
+	ctx, span := tracing.Start(ctx, "org.GetUserOrgs")
+	defer func() { tracing.End(span, err) }()
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		members, err = s.repo.GetUserOrgs(ctx, tx, username)
+		if err != nil {
+			return fmt.Errorf("error in getUserOrgs: %w", err)
+		}
+		return nil
+	})
+	return
+}
+
+// SetMember adds a user to an organization or changes their role.
+// Only owners may grant the owner role or change the role of another owner.
+func (s *service) SetMember(ctx context.Context, actor string, member org.Member) (err error) {
+	ctx, span := tracing.Start(ctx, "org.SetMember")
+	defer func() { tracing.End(span, err) }()
+
+	if !org.ValidRole(member.Role) {
+		return svc.ErrInvalidRole
+	}
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		err = s.checkOwnerChange(ctx, tx, member.Org, actor, member.Username, member.Role)
+		if err != nil {
+			return err
+		}
+
+		err = s.repo.UpsertOrgMember(ctx, tx, member)
+		if err != nil {
+			if errors.Is(err, repository.ErrNoRowsAffected) {
+				return svc.ErrAccountNotFound
+			}
+			return fmt.Errorf("error in upsertOrgMember: %w", err)
+		}
+		return nil
+	})
+	return
+}
+
+// RemoveMember removes a user from an organization.
+// Only owners may remove another owner.
+func (s *service) RemoveMember(ctx context.Context, actor, orgName, username string) (err error) {
+	ctx, span := tracing.Start(ctx, "org.RemoveMember")
+	defer func() { tracing.End(span, err) }()
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		err = s.checkOwnerChange(ctx, tx, orgName, actor, username, "")
+		if err != nil {
+			return err
+		}
+
+		err = s.repo.DeleteOrgMember(ctx, tx, orgName, username)
+		if err != nil {
+			if errors.Is(err, repository.ErrNoRowsAffected) {
+				return svc.ErrNotOrgMember
+			}
+			return fmt.Errorf("error in deleteOrgMember: %w", err)
+		}
+		return nil
+	})
+	return
+}
+
+// checkOwnerChange ensures that only owners grant the owner role or modify another owner's membership.
+func (s *service) checkOwnerChange(ctx context.Context, tx pgx.Tx, orgName, actor, username, newRole string) error {
+	currentRole, err := s.repo.GetOrgRole(ctx, tx, orgName, username)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("error in getOrgRole: %w", err)
+	}
+
+	if newRole != org.RoleOwner && currentRole != org.RoleOwner {
+		return nil
+	}
+
+	actorRole, err := s.repo.GetOrgRole(ctx, tx, orgName, actor)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return svc.ErrNotOrgMember
+		}
+		return fmt.Errorf("error in getOrgRole: %w", err)
+	}
+
+	if actorRole != org.RoleOwner {
+		return svc.ErrNotAuthorized
+	}
+	return nil
+}
+
+// SetGroup creates a group within an organization or replaces its members.
+func (s *service) SetGroup(ctx context.Context, group org.Group) (err error) {
+	ctx, span := tracing.Start(ctx, "org.SetGroup")
+	defer func() { tracing.End(span, err) }()
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		groupID, err := s.repo.UpsertOrgGroup(ctx, tx, group.Org, group.Name)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return svc.ErrOrgNotFound
+			}
+			return fmt.Errorf("error in upsertOrgGroup: %w", err)
+		}
+
+		for _, username := range group.Members {
+			err = s.repo.InsertOrgGroupMember(ctx, tx, groupID, username)
+			if err != nil {
+				if errors.Is(err, repository.ErrNoRowsAffected) {
+					return fmt.Errorf("%w: %s", svc.ErrNotOrgMember, username)
+				}
+				return fmt.Errorf("error in insertOrgGroupMember: %w", err)
+			}
+		}
+		return nil
+	})
+	return
+}
+
+// SetCollection creates a collection within an organization or replaces the groups it is visible to.
+func (s *service) SetCollection(ctx context.Context, collection org.Collection) (err error) {
+	ctx, span := tracing.Start(ctx, "org.SetCollection")
+	defer func() { tracing.End(span, err) }()
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		collectionID, err := s.repo.UpsertCollection(ctx, tx, collection.Org, collection.Name)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return svc.ErrOrgNotFound
+			}
+			return fmt.Errorf("error in upsertCollection: %w", err)
+		}
+
+		for _, group := range collection.Groups {
+			err = s.repo.InsertCollectionGroup(ctx, tx, collectionID, group)
+			if err != nil {
+				if errors.Is(err, repository.ErrNoRowsAffected) {
+					return fmt.Errorf("%w: %s", svc.ErrGroupNotFound, group)
+				}
+				return fmt.Errorf("error in insertCollectionGroup: %w", err)
+			}
+		}
+		return nil
+	})
+	return
+}
+
+// AddCollectionCard adds one of the user's own cards to an organization collection.
+// ErrCollectionNotFound is returned if the collection does not exist or the card is not owned by the user.
+func (s *service) AddCollectionCard(ctx context.Context, username, orgName, collection, cardNumber string) (err error) {
+	ctx, span := tracing.Start(ctx, "org.AddCollectionCard")
+	defer func() { tracing.End(span, err) }()
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		err = s.repo.InsertCollectionCard(ctx, tx, orgName, collection, username, cardNumber)
+		if err != nil {
+			if errors.Is(err, repository.ErrNoRowsAffected) {
+				return svc.ErrCollectionNotFound
+			}
+			return fmt.Errorf("error in insertCollectionCard: %w", err)
+		}
+		return nil
+	})
+	return
+}
+
+// GetOrgCards retrieves a page of the cards of an organization visible to the user
+// and returns the cursor of the next page.
+func (s *service) GetOrgCards(ctx context.Context, username, orgName string, page pagination.Request) (
+	cards []profile.CardInfo, next string, err error,
+) {
+	ctx, span := tracing.Start(ctx, "org.GetOrgCards")
+	defer func() { tracing.End(span, err) }()
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		cards, err = s.repo.GetOrgVisibleCards(ctx, tx, username, orgName, "", page)
+		if err != nil {
+			return fmt.Errorf("error in getOrgVisibleCards: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	cards, next = pagination.Trim(cards, page, func(card profile.CardInfo) pagination.Cursor {
+		return card.Cursor(page.Sort)
+	})
+	return cards, next, nil
+}
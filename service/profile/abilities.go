@@ -0,0 +1,25 @@
+package profile
+
+import (
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/gleb-korostelev/GophKeeper/pkg/claims"
+)
+
+// cardAbilities maps the access a user has on a card to the abilities it grants.
+// Owners may read, edit and share their cards, grantees get what their share permits.
+func cardAbilities(username, cardNumber, owner, permission string) claims.Abilities {
+	switch {
+	case owner == username:
+		return claims.ToAbilities(
+			claims.CardRead(cardNumber),
+			claims.CardWrite(cardNumber),
+			claims.CardShare(cardNumber),
+		)
+	case permission == profile.PermissionReadWrite:
+		return claims.ToAbilities(claims.CardRead(cardNumber), claims.CardWrite(cardNumber))
+	case permission == profile.PermissionRead:
+		return claims.ToAbilities(claims.CardRead(cardNumber))
+	default:
+		return claims.Abilities{}
+	}
+}
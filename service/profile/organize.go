@@ -0,0 +1,169 @@
+package profile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
+	"github.com/gleb-korostelev/GophKeeper/repository"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gleb-korostelev/GophKeeper/tools/tracing"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Limits for organizing cards.
+const (
+	maxTagLength    = 50  // Maximum length of a tag name.
+	maxFolderLength = 100 // Maximum length of a folder name.
+)
+
+// uniqueViolation is the PostgreSQL error code for unique constraint violations.
+const uniqueViolation = "23505"
+
+// normalizeTags trims tag names and drops empty and duplicate ones.
+func normalizeTags(tags []string) ([]string, error) {
+	var result []string
+	seen := make(map[string]struct{}, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" {
+			continue
+		}
+		if len(tag) > maxTagLength {
+			return nil, svc.ErrInvalidTag
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		result = append(result, tag)
+	}
+	return result, nil
+}
+
+// CreateFolder creates a folder, optionally nested in another folder of the same user,
+// and returns its identifier.
+func (s *service) CreateFolder(ctx context.Context, folder profile.Folder) (id int64, err error) {
+	ctx, span := tracing.Start(ctx, "profile.CreateFolder")
+	defer func() { tracing.End(span, err) }()
+
+	folder.Name = strings.TrimSpace(folder.Name)
+	if folder.Name == "" || len(folder.Name) > maxFolderLength {
+		return 0, svc.ErrInvalidFolder
+	}
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		id, err = s.repo.InsertFolder(ctx, tx, folder)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+				return svc.ErrFolderExists
+			}
+			if errors.Is(err, pgx.ErrNoRows) {
+				return svc.ErrFolderNotFound
+			}
+			return fmt.Errorf("error in insertFolder: %w", err)
+		}
+		return nil
+	})
+	return
+}
+
+// GetFolders retrieves all folders of a user.
+func (s *service) GetFolders(ctx context.Context, username string) (folders []profile.Folder, err error) {
+	ctx, span := tracing.Start(ctx, "profile.GetFolders")
+	defer func() { tracing.End(span, err) }()
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		folders, err = s.repo.GetFolders(ctx, tx, username)
+		if err != nil {
+			return fmt.Errorf("error in getFolders: %w", err)
+		}
+		return nil
+	})
+	return
+}
+
+// DeleteFolder removes a folder and its subfolders. The cards they contain are kept outside any folder.
+func (s *service) DeleteFolder(ctx context.Context, username string, id int64) (err error) {
+	ctx, span := tracing.Start(ctx, "profile.DeleteFolder")
+	defer func() { tracing.End(span, err) }()
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		err = s.repo.DeleteFolder(ctx, tx, username, id)
+		if err != nil {
+			if errors.Is(err, repository.ErrNoRowsAffected) {
+				return svc.ErrFolderNotFound
+			}
+			return fmt.Errorf("error in deleteFolder: %w", err)
+		}
+		return nil
+	})
+	return
+}
+
+// GetTags retrieves all tags of a user with the number of cards carrying each.
+func (s *service) GetTags(ctx context.Context, username string) (tags []profile.Tag, err error) {
+	ctx, span := tracing.Start(ctx, "profile.GetTags")
+	defer func() { tracing.End(span, err) }()
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		tags, err = s.repo.GetTags(ctx, tx, username)
+		if err != nil {
+			return fmt.Errorf("error in getTags: %w", err)
+		}
+		return nil
+	})
+	return
+}
+
+// DeleteTag removes a tag from all of a user's cards.
+func (s *service) DeleteTag(ctx context.Context, username, name string) (err error) {
+	ctx, span := tracing.Start(ctx, "profile.DeleteTag")
+	defer func() { tracing.End(span, err) }()
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		err = s.repo.DeleteTag(ctx, tx, username, name)
+		if err != nil {
+			if errors.Is(err, repository.ErrNoRowsAffected) {
+				return svc.ErrTagNotFound
+			}
+			return fmt.Errorf("error in deleteTag: %w", err)
+		}
+		return nil
+	})
+	return
+}
+
+// Search performs a full-text search over the non-secret fields and tags of a user's cards.
+// It returns a page of the best matches first and the cursor of the next page.
+func (s *service) Search(ctx context.Context, username, text string, page pagination.Request) (
+	cards []profile.CardInfo, next string, err error) {
+	ctx, span := tracing.Start(ctx, "profile.Search")
+	defer func() { tracing.End(span, err) }()
+
+	text = strings.TrimSpace(text)
+	if text == "" || page.Sort != profile.SortRelevance || page.Desc {
+		return nil, "", svc.ErrInvalidSearch
+	}
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		cards, err = s.repo.SearchCards(ctx, tx, username, text, page)
+		if err != nil {
+			return fmt.Errorf("error in searchCards: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	cards, next = pagination.Trim(cards, page, func(card profile.CardInfo) pagination.Cursor {
+		return card.Cursor(profile.SortRelevance)
+	})
+	return cards, next, nil
+}
@@ -3,11 +3,21 @@ package profile
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
+	"slices"
+	"unicode/utf8"
 
+	"github.com/gleb-korostelev/GophKeeper/models/apikey"
 	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/gleb-korostelev/GophKeeper/models/quota"
+	"github.com/gleb-korostelev/GophKeeper/pkg/claims"
+	"github.com/gleb-korostelev/GophKeeper/pkg/pagination"
 	"github.com/gleb-korostelev/GophKeeper/repository"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
 	"github.com/gleb-korostelev/GophKeeper/tools/db"
+	"github.com/gleb-korostelev/GophKeeper/tools/tracing"
 	"github.com/jackc/pgx/v5"
 )
 
@@ -15,43 +25,196 @@ import (
 //
 // Fields:
 // - db: The database adapter for executing transactional operations.
+// - plans: The limits of the account plans that writes are checked against.
 type service struct {
-	db   db.IAdapter
-	repo repository.Repository
+	db    db.IAdapter
+	repo  repository.Repository
+	plans quota.Plans
 }
 
 // NewService creates a new instance of the profile service.
-func NewService(db db.IAdapter) *service {
-	return &service{db: db}
+func NewService(db db.IAdapter, plans quota.Plans) *service {
+	return &service{db: db, plans: plans}
 }
 
 // UploadInfo uploads or updates a user's card information in the database.
-func (s *service) UploadInfo(ctx context.Context, profile profile.CardInfo) (err error) {
+// If the card belongs to another owner, it is only updated when the user holds a read-write share on it.
+// A request made with an API key must be within the key's scope, which never covers shared cards.
+// The write counts against the quotas of the card owner.
+func (s *service) UploadInfo(ctx context.Context, card profile.CardInfo, scope *apikey.Scope) (err error) {
+	ctx, span := tracing.Start(ctx, "profile.UploadInfo")
+	defer func() { tracing.End(span, err) }()
+
+	if scope != nil && (scope.ReadOnly || card.IsShared()) {
+		return svc.ErrOutOfScope
+	}
+
+	if utf8.RuneCountInString(card.Metadata) > profile.MaxMetadataLength {
+		return svc.ErrInvalidMetadata
+	}
+
+	if card.IsShared() {
+		return s.updateSharedCard(ctx, card)
+	}
+
+	if card.Type == "" {
+		card.Type = profile.TypeCard
+	}
+	if !profile.ValidType(card.Type) {
+		return svc.ErrInvalidType
+	}
+
+	card.Tags, err = normalizeTags(card.Tags)
+	if err != nil {
+		return err
+	}
+
 	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
-		err = s.repo.UploadCardInfo(ctx, tx, profile)
+		err := s.checkScope(ctx, tx, scope, card.Username, card.CardNumber, true, card.FolderID)
+		if err != nil {
+			return err
+		}
+
+		if card.FolderID != nil {
+			exists, err := s.repo.FolderExists(ctx, tx, card.Username, *card.FolderID)
+			if err != nil {
+				return fmt.Errorf("error in folderExists: %w", err)
+			}
+			if !exists {
+				return svc.ErrFolderNotFound
+			}
+		}
+
+		err = svc.EnforceQuota(ctx, tx, s.repo, s.plans, card.Username, func() error {
+			if err := s.repo.UploadCardInfo(ctx, tx, card); err != nil {
+				return fmt.Errorf("error in uploadCardInfo: %w", err)
+			}
+			return nil
+		})
 		if err != nil {
-			return fmt.Errorf("error in uploadCardInfo: %w", err)
+			return err
+		}
+
+		err = s.repo.SetCardTags(ctx, tx, card.Username, card.CardNumber, card.Tags)
+		if err != nil {
+			return fmt.Errorf("error in setCardTags: %w", err)
 		}
 		return nil
 	})
 	return
 }
 
-// GetUserCards retrieves all card information associated with a username.
-func (s *service) GetUserCards(ctx context.Context, username string) (profile []profile.CardInfo, err error) {
+// updateSharedCard edits a card shared with the user after checking the card:write ability.
+func (s *service) updateSharedCard(ctx context.Context, card profile.CardInfo) error {
+	return s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		abilities, err := s.getCardAbilities(ctx, tx, card.Username, card.CardNumber, card.Owner)
+		if err != nil {
+			return err
+		}
+
+		if !abilities.Includes(claims.CardWrite(card.CardNumber)) {
+			return svc.ErrNotAuthorized
+		}
+
+		return svc.EnforceQuota(ctx, tx, s.repo, s.plans, card.Owner, func() error {
+			err := s.repo.UpdateSharedCardInfo(ctx, tx, card)
+			if err != nil {
+				if errors.Is(err, repository.ErrNoRowsAffected) {
+					return svc.ErrCardNotFound
+				}
+				return fmt.Errorf("error in updateSharedCardInfo: %w", err)
+			}
+			return nil
+		})
+	})
+}
+
+// GetUserCards retrieves a page of the card information associated with a username that matches the filter,
+// together with the cards shared with them directly and through organization collections,
+// and returns the cursor of the next page.
+// Each card is listed once, preferring ownership over a direct share over organization access,
+// with the strongest permission any of them grants.
+// Folder and tag filters only match the user's own cards.
+func (s *service) GetUserCards(ctx context.Context, username string, filter profile.Filter, page pagination.Request) (
+	cards []profile.CardInfo, next string, err error,
+) {
+	ctx, span := tracing.Start(ctx, "profile.GetUserCards")
+	defer func() { tracing.End(span, err) }()
+
+	if filter.Type != "" && !profile.ValidType(filter.Type) {
+		return nil, "", svc.ErrInvalidType
+	}
+
 	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
-		profile, err = s.repo.GetUserCards(ctx, tx, username)
+		cards, err = s.repo.GetUserCards(ctx, tx, username, filter, page)
 		if err != nil {
 			return fmt.Errorf("error in getUserCards: %w", err)
 		}
+
+		if filter.Personal() {
+			return nil
+		}
+
+		// Each source returns its first page in the same order, so the merged page is complete.
+		shared, err := s.repo.GetSharedCards(ctx, tx, username, filter.Type, page)
+		if err != nil {
+			return fmt.Errorf("error in getSharedCards: %w", err)
+		}
+
+		orgCards, err := s.repo.GetOrgVisibleCards(ctx, tx, username, "", filter.Type, page)
+		if err != nil {
+			return fmt.Errorf("error in getOrgVisibleCards: %w", err)
+		}
+
+		cards = mergeCards(cards, shared, orgCards)
 		return nil
 	})
-	return
+	if err != nil {
+		return nil, "", err
+	}
+
+	cursor := func(card profile.CardInfo) pagination.Cursor { return card.Cursor(page.Sort) }
+	pagination.Sort(cards, page, cursor)
+	cards, next = pagination.Trim(cards, page, cursor)
+	return cards, next, nil
+}
+
+// mergeCards concatenates card lists, skipping cards already present in an earlier list.
+// A card listed as read-only that a later list grants read-write on is reported read-write,
+// matching the strongest access GetCardAccess resolves when the card is edited.
+func mergeCards(lists ...[]profile.CardInfo) []profile.CardInfo {
+	var result []profile.CardInfo
+	seen := make(map[string]int)
+	for _, cards := range lists {
+		for _, card := range cards {
+			if i, ok := seen[card.CardNumber]; ok {
+				if result[i].Permission == profile.PermissionRead && card.Permission == profile.PermissionReadWrite {
+					result[i].Permission = profile.PermissionReadWrite
+				}
+				continue
+			}
+			seen[card.CardNumber] = len(result)
+			result = append(result, card)
+		}
+	}
+	return result
 }
 
 // DeleteCard deletes a specific card associated with a username.
-func (s *service) DeleteCard(ctx context.Context, username, cardNumber string) (err error) {
+// A request made with an API key must be within the key's scope.
+func (s *service) DeleteCard(ctx context.Context, username, cardNumber string, scope *apikey.Scope) (err error) {
+	ctx, span := tracing.Start(ctx, "profile.DeleteCard")
+	defer func() { tracing.End(span, err) }()
+
+	if scope != nil && scope.ReadOnly {
+		return svc.ErrOutOfScope
+	}
+
 	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		if err := s.checkScope(ctx, tx, scope, username, cardNumber, false, nil); err != nil {
+			return err
+		}
+
 		err = s.repo.DeleteCard(ctx, tx, username, cardNumber)
 		if err != nil {
 			return fmt.Errorf("error in deleteCard: %w", err)
@@ -60,3 +223,124 @@ func (s *service) DeleteCard(ctx context.Context, username, cardNumber string) (
 	})
 	return
 }
+
+// checkScope returns svc.ErrOutOfScope unless a restricted API key scope covers a card of the user,
+// by naming it or through the folder holding it. A written card must also end up in a covered folder,
+// so that the key can neither move cards out of its scope nor create cards outside of it.
+func (s *service) checkScope(ctx context.Context, tx pgx.Tx, scope *apikey.Scope, username, cardNumber string,
+	write bool, folderID *int64) error {
+	if scope == nil || !scope.Restricted() || scope.HasItem(cardNumber) {
+		return nil
+	}
+
+	tree, err := s.repo.GetFolderTree(ctx, tx, username, scope.Folders)
+	if err != nil {
+		return fmt.Errorf("error in getFolderTree: %w", err)
+	}
+	covered := func(id *int64) bool { return id != nil && slices.Contains(tree, *id) }
+
+	current, err := s.repo.GetCardFolder(ctx, tx, username, cardNumber)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		if !write {
+			return svc.ErrOutOfScope
+		}
+	case err != nil:
+		return fmt.Errorf("error in getCardFolder: %w", err)
+	case !covered(current):
+		return svc.ErrOutOfScope
+	}
+
+	if write && !covered(folderID) {
+		return svc.ErrOutOfScope
+	}
+	return nil
+}
+
+// ShareCard grants another user read or read-write access to one of the owner's cards.
+// Client-side encrypted cards can only be shared together with the item key re-wrapped
+// to the grantee's public key, since the server never sees the plaintext key.
+func (s *service) ShareCard(ctx context.Context, share profile.CardShare) (err error) {
+	ctx, span := tracing.Start(ctx, "profile.ShareCard")
+	defer func() { tracing.End(span, err) }()
+
+	if !profile.ValidPermission(share.Permission) {
+		return svc.ErrInvalidPermission
+	}
+	if share.Grantee == share.Owner {
+		return svc.ErrNotAuthorized
+	}
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		owner, _, encrypted, err := s.repo.GetCardAccess(ctx, tx, share.Owner, share.CardNumber)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return svc.ErrCardNotFound
+			}
+			return fmt.Errorf("error in getCardAccess: %w", err)
+		}
+
+		abilities := cardAbilities(share.Owner, share.CardNumber, owner, "")
+		if !abilities.Includes(claims.CardShare(share.CardNumber)) {
+			return svc.ErrNotAuthorized
+		}
+
+		if encrypted && len(share.WrappedKey) == 0 {
+			return svc.ErrWrappedKeyRequired
+		}
+
+		err = s.repo.UpsertCardShare(ctx, tx, share)
+		if err != nil {
+			if errors.Is(err, repository.ErrNoRowsAffected) {
+				return svc.ErrAccountNotFound
+			}
+			return fmt.Errorf("error in upsertCardShare: %w", err)
+		}
+		return nil
+	})
+	return
+}
+
+// RevokeShare removes the access a grantee has on one of the owner's cards.
+func (s *service) RevokeShare(ctx context.Context, owner, grantee, cardNumber string) (err error) {
+	ctx, span := tracing.Start(ctx, "profile.RevokeShare")
+	defer func() { tracing.End(span, err) }()
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		abilities, err := s.getCardAbilities(ctx, tx, owner, cardNumber, owner)
+		if err != nil {
+			return err
+		}
+
+		if !abilities.Includes(claims.CardShare(cardNumber)) {
+			return svc.ErrNotAuthorized
+		}
+
+		err = s.repo.DeleteCardShare(ctx, tx, owner, grantee, cardNumber)
+		if err != nil {
+			if errors.Is(err, repository.ErrNoRowsAffected) {
+				return svc.ErrShareNotFound
+			}
+			return fmt.Errorf("error in deleteCardShare: %w", err)
+		}
+		return nil
+	})
+	return
+}
+
+// getCardAbilities loads the access username has on a card of the expected owner.
+func (s *service) getCardAbilities(ctx context.Context, tx pgx.Tx, username, cardNumber, expectedOwner string) (claims.Abilities, error) {
+	owner, permission, _, err := s.repo.GetCardAccess(ctx, tx, username, cardNumber)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, svc.ErrCardNotFound
+		}
+		return nil, fmt.Errorf("error in getCardAccess: %w", err)
+	}
+
+	if owner != expectedOwner {
+		return nil, svc.ErrCardNotFound
+	}
+
+	return cardAbilities(username, cardNumber, owner, permission), nil
+}
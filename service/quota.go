@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gleb-korostelev/GophKeeper/models/quota"
+	"github.com/gleb-korostelev/GophKeeper/repository"
+	"github.com/jackc/pgx/v5"
+)
+
+// GetLimits locks a user until the end of the transaction and returns their limits: those of the plan
+// of their account type, with the limits overridden for them applied.
+func GetLimits(ctx context.Context, tx pgx.Tx, repo repository.Repository, plans quota.Plans, username string) (
+	quota.Limits, error) {
+	accType, override, err := repo.GetQuota(ctx, tx, username)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return quota.Limits{}, ErrAccountNotFound
+		}
+		return quota.Limits{}, fmt.Errorf("error in getQuota: %w", err)
+	}
+
+	_, limits := plans.Plan(accType.IsAdmin())
+	return override.Apply(limits), nil
+}
+
+// EnforceQuota runs write, which stores something for a user in tx, and returns ErrQuotaExceeded
+// if it grows the user's usage past one of their limits; the caller then rolls tx back.
+// The user stays locked until the end of tx, so concurrent writes cannot overrun a limit together.
+// Usage is read from the user's counters, which triggers update in tx as write stores, so checking
+// a write costs two single-row reads however much the user stores.
+func EnforceQuota(ctx context.Context, tx pgx.Tx, repo repository.Repository, plans quota.Plans, username string,
+	write func() error) error {
+	limits, err := GetLimits(ctx, tx, repo, plans, username)
+	if err != nil {
+		return err
+	}
+
+	before, err := repo.GetUsage(ctx, tx, username)
+	if err != nil {
+		return fmt.Errorf("error in getUsage: %w", err)
+	}
+
+	if err = write(); err != nil {
+		return err
+	}
+
+	after, err := repo.GetUsage(ctx, tx, username)
+	if err != nil {
+		return fmt.Errorf("error in getUsage: %w", err)
+	}
+
+	if name, limit, exceeded := limits.Exceeded(before, after); exceeded {
+		return fmt.Errorf("%w: the limit of %d %s is reached", ErrQuotaExceeded, limit, name)
+	}
+	return nil
+}
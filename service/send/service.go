@@ -0,0 +1,139 @@
+// Package send provides services for one-time share links ("sends"), which hand a
+// client-encrypted payload to someone without an account for a limited number of views.
+package send
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/models/send"
+	"github.com/gleb-korostelev/GophKeeper/repository"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gleb-korostelev/GophKeeper/tools/db"
+	"github.com/gleb-korostelev/GophKeeper/tools/logger"
+	"github.com/gleb-korostelev/GophKeeper/tools/tracing"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// service defines the implementation of the send service.
+//
+// Fields:
+// - db: The database adapter for executing transactional operations.
+type service struct {
+	db   db.IAdapter
+	repo repository.Repository
+}
+
+// NewService creates a new instance of the send service.
+func NewService(db db.IAdapter) *service {
+	return &service{db: db}
+}
+
+// CreateSend stores a new send and returns its identifier.
+// If password is not empty, it is required to open the send.
+func (s *service) CreateSend(ctx context.Context, sd send.Send, password string) (id string, err error) {
+	ctx, span := tracing.Start(ctx, "send.CreateSend")
+	defer func() { tracing.End(span, err) }()
+
+	now := time.Now().UTC()
+	if len(sd.Ciphertext) == 0 ||
+		sd.MaxViews < 1 || sd.MaxViews > send.MaxViews ||
+		!sd.ExpiresAt.After(now) || sd.ExpiresAt.After(now.Add(send.MaxLifetime)) {
+		return "", svc.ErrInvalidSend
+	}
+
+	if password != "" {
+		sd.PasswordHash, err = bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	sd.ID = uuid.New().String()
+	sd.ExpiresAt = sd.ExpiresAt.UTC()
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		err = s.repo.InsertSend(ctx, tx, sd)
+		if err != nil {
+			if errors.Is(err, repository.ErrNoRowsAffected) {
+				return svc.ErrAccountNotFound
+			}
+			return fmt.Errorf("error in insertSend: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return sd.ID, nil
+}
+
+// OpenSend consumes one view of a send and returns its ciphertext.
+// A wrong password does not consume a view but counts as a failed attempt; after send.MaxFailedAttempts
+// of them the send is disabled, so that the password of a send cannot be guessed without limit.
+func (s *service) OpenSend(ctx context.Context, id, password string) (sd send.Send, err error) {
+	ctx, span := tracing.Start(ctx, "send.OpenSend")
+	defer func() { tracing.End(span, err) }()
+
+	if _, err = uuid.Parse(id); err != nil {
+		return send.Send{}, svc.ErrSendNotFound
+	}
+
+	var wrongPassword bool
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		sd, err = s.repo.GetSendForUpdate(ctx, tx, id)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return svc.ErrSendNotFound
+			}
+			return fmt.Errorf("error in getSendForUpdate: %w", err)
+		}
+
+		if len(sd.PasswordHash) > 0 {
+			if bcrypt.CompareHashAndPassword(sd.PasswordHash, []byte(password)) != nil {
+				// The attempt is committed, so the error is only returned after the transaction.
+				wrongPassword = true
+				err = s.repo.IncrementSendFailedAttempts(ctx, tx, id)
+				if err != nil {
+					return fmt.Errorf("error in incrementSendFailedAttempts: %w", err)
+				}
+				return nil
+			}
+		}
+
+		err = s.repo.IncrementSendViews(ctx, tx, id)
+		if err != nil {
+			return fmt.Errorf("error in incrementSendViews: %w", err)
+		}
+		sd.Views++
+		return nil
+	})
+	if err == nil && wrongPassword {
+		return send.Send{}, svc.ErrIncorrectPassword
+	}
+	return
+}
+
+// PurgeExpired removes sends that have expired, have no views left or were disabled by wrong passwords.
+func (s *service) PurgeExpired(ctx context.Context) (err error) {
+	ctx, span := tracing.Start(ctx, "send.PurgeExpired")
+	defer func() { tracing.End(span, err) }()
+
+	var purged int64
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		purged, err = s.repo.DeleteExpiredSends(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("error in deleteExpiredSends: %w", err)
+		}
+		return nil
+	})
+	if err == nil && purged > 0 {
+		logger.Infof("purged %d expired sends", purged)
+	}
+	return
+}
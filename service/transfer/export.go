@@ -0,0 +1,211 @@
+package transfer
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/models/audit"
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/gleb-korostelev/GophKeeper/models/transfer"
+	"github.com/gleb-korostelev/GophKeeper/pkg/archive"
+	"github.com/gleb-korostelev/GophKeeper/pkg/importer"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gleb-korostelev/GophKeeper/tools/tracing"
+	"github.com/jackc/pgx/v5"
+)
+
+// maxFolderDepth bounds the folder paths rebuilt from an archive, guarding against parent cycles.
+const maxFolderDepth = 32
+
+// csvHeader lists the columns of a plaintext CSV export.
+var csvHeader = []string{
+	"type", "name", "folder", "card_number", "card_holder", "expiration_date", "cvv",
+	"url", "login", "password", "notes", "tags",
+}
+
+// Export writes all of a user's own items and folders in the requested format. By default the vault
+// is sealed into an archive encrypted with the given password; plaintext JSON and CSV are only written
+// if the user explicitly confirmed it. Every export is recorded in the audit log in the same transaction
+// the vault is read in, so no export goes unrecorded.
+func (s *service) Export(ctx context.Context, username string, opts transfer.ExportOptions) (
+	export transfer.Export, err error) {
+	ctx, span := tracing.Start(ctx, "transfer.Export")
+	defer func() { tracing.End(span, err) }()
+
+	format := opts.Format
+	if format == "" {
+		format = transfer.FormatArchive
+	}
+
+	switch format {
+	case transfer.FormatArchive:
+		if len([]rune(opts.Password)) < transfer.MinExportPasswordLength {
+			return transfer.Export{}, svc.ErrWeakExportPassword
+		}
+	case transfer.FormatJSON, transfer.FormatCSV:
+		if !opts.ConfirmPlaintext {
+			return transfer.Export{}, svc.ErrPlaintextNotConfirmed
+		}
+	default:
+		return transfer.Export{}, svc.ErrUnknownExportFormat
+	}
+
+	vault := transfer.Vault{Username: username, ExportedAt: time.Now().UTC().Truncate(time.Second)}
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) (err error) {
+		vault.Folders, err = s.repo.GetFolders(ctx, tx, username)
+		if err != nil {
+			return fmt.Errorf("error in getFolders: %w", err)
+		}
+
+		vault.Items, err = s.repo.GetAllUserCards(ctx, tx, username)
+		if err != nil {
+			return fmt.Errorf("error in getAllUserCards: %w", err)
+		}
+
+		err = s.repo.InsertAuditEvent(ctx, tx, audit.Event{
+			Username: username,
+			Action:   audit.ActionExport,
+			Details: map[string]string{
+				"format":    format,
+				"encrypted": strconv.FormatBool(format == transfer.FormatArchive),
+				"items":     strconv.Itoa(len(vault.Items)),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error in insertAuditEvent: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return transfer.Export{}, err
+	}
+
+	if vault.Folders == nil {
+		vault.Folders = []profile.Folder{}
+	}
+	if vault.Items == nil {
+		vault.Items = []profile.CardInfo{}
+	}
+
+	switch format {
+	case transfer.FormatCSV:
+		data, err := writeCSV(vault)
+		if err != nil {
+			return transfer.Export{}, fmt.Errorf("failed to write csv export: %w", err)
+		}
+		return transfer.Export{Format: format, ContentType: "text/csv", Data: data}, nil
+	case transfer.FormatJSON:
+		data, err := json.MarshalIndent(vault, "", "  ")
+		if err != nil {
+			return transfer.Export{}, fmt.Errorf("failed to write json export: %w", err)
+		}
+		return transfer.Export{Format: format, ContentType: "application/json", Data: data}, nil
+	}
+
+	payload, err := json.Marshal(vault)
+	if err != nil {
+		return transfer.Export{}, fmt.Errorf("failed to marshal vault: %w", err)
+	}
+
+	data, err := archive.Seal(payload, opts.Password)
+	if err != nil {
+		return transfer.Export{}, fmt.Errorf("failed to seal archive: %w", err)
+	}
+
+	return transfer.Export{Format: format, ContentType: "application/json", Data: data}, nil
+}
+
+// readArchive decrypts a GophKeeper archive and returns its items with the paths of their folders.
+func readArchive(r io.Reader, password string) ([]importer.Item, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	_, payload, err := archive.Open(data, password)
+	if err != nil {
+		return nil, err
+	}
+
+	var vault transfer.Vault
+	if err := json.Unmarshal(payload, &vault); err != nil {
+		return nil, fmt.Errorf("%w: %v", importer.ErrInvalidFile, err)
+	}
+
+	paths := folderPaths(vault.Folders)
+
+	items := make([]importer.Item, 0, len(vault.Items))
+	for _, card := range vault.Items {
+		item := importer.Item{Card: card}
+		if card.FolderID != nil {
+			item.Folder = paths[*card.FolderID]
+		}
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// folderPaths returns the path of every folder, from the top-level folder down to the folder itself.
+func folderPaths(folders []profile.Folder) map[int64][]string {
+	byID := make(map[int64]profile.Folder, len(folders))
+	for _, f := range folders {
+		byID[f.ID] = f
+	}
+
+	paths := make(map[int64][]string, len(folders))
+	for _, f := range folders {
+		var path []string
+		for cur, ok := f, true; ok && len(path) < maxFolderDepth; {
+			path = append([]string{cur.Name}, path...)
+			if cur.ParentID == nil {
+				break
+			}
+			cur, ok = byID[*cur.ParentID]
+		}
+		paths[f.ID] = path
+	}
+
+	return paths
+}
+
+// writeCSV writes a vault as a CSV file with one row per item. Folder paths are joined with "/".
+func writeCSV(vault transfer.Vault) ([]byte, error) {
+	paths := folderPaths(vault.Folders)
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(csvHeader); err != nil {
+		return nil, err
+	}
+
+	for _, card := range vault.Items {
+		var folder, expiration string
+		if card.FolderID != nil {
+			folder = strings.Join(paths[*card.FolderID], "/")
+		}
+		if !card.ExpirationDate.IsZero() {
+			expiration = card.ExpirationDate.Format(time.DateOnly)
+		}
+
+		err := w.Write([]string{
+			card.Type, card.Name, folder, card.CardNumber, card.CardHolder, expiration, card.Cvv,
+			card.URL, card.Login, card.Password, card.Metadata, strings.Join(card.Tags, ","),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
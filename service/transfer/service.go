@@ -0,0 +1,235 @@
+// Package transfer provides services for moving vault items in and out of GophKeeper,
+// such as importing the export of another password manager and exporting a user's vault.
+package transfer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/gleb-korostelev/GophKeeper/models/profile"
+	"github.com/gleb-korostelev/GophKeeper/models/quota"
+	"github.com/gleb-korostelev/GophKeeper/models/transfer"
+	"github.com/gleb-korostelev/GophKeeper/pkg/archive"
+	"github.com/gleb-korostelev/GophKeeper/pkg/importer"
+	"github.com/gleb-korostelev/GophKeeper/repository"
+	svc "github.com/gleb-korostelev/GophKeeper/service"
+	"github.com/gleb-korostelev/GophKeeper/tools/db"
+	"github.com/gleb-korostelev/GophKeeper/tools/tracing"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const (
+	// uniqueViolation is the PostgreSQL error code for unique constraint violations.
+	uniqueViolation = "23505"
+
+	// maxFolderLength is the maximum length of a folder name; longer source folder names are cut.
+	maxFolderLength = 100
+)
+
+// errDryRun rolls back the transaction of a dry run once the items are stored and checked against the quotas.
+var errDryRun = errors.New("dry run")
+
+// service defines the implementation of the transfer service.
+//
+// Fields:
+// - db: The database adapter for executing transactional operations.
+// - plans: The limits of the account plans that imports are checked against.
+type service struct {
+	db    db.IAdapter
+	repo  repository.Repository
+	plans quota.Plans
+}
+
+// NewService creates a new instance of the transfer service.
+func NewService(db db.IAdapter, plans quota.Plans) *service {
+	return &service{db: db, plans: plans}
+}
+
+// Import reads the export of another password manager, or a GophKeeper archive decrypted with password,
+// and stores its items in a user's vault, recreating the source folders. Items matching an existing item
+// or an earlier item of the file, by card number or by website and username, are skipped and reported
+// as duplicates, so restoring the same archive twice stores nothing the second time.
+// All items are stored in a single transaction, which fails as a whole if it exceeds the user's quotas
+// or holds a card whose number is already registered, without telling in which vault.
+// A dry run stores the items in a transaction it rolls back, reporting a quota the import would exceed
+// instead of failing.
+func (s *service) Import(ctx context.Context, username, format string, r io.Reader, password string, dryRun bool) (
+	report transfer.Report, err error) {
+	ctx, span := tracing.Start(ctx, "transfer.Import")
+	defer func() { tracing.End(span, err) }()
+
+	var items []importer.Item
+	if format == transfer.FormatArchive {
+		items, err = readArchive(r, password)
+	} else {
+		items, err = importer.Parse(format, r)
+	}
+	if err != nil {
+		switch {
+		case errors.Is(err, importer.ErrUnknownFormat):
+			return transfer.Report{}, svc.ErrUnknownFormat
+		case errors.Is(err, archive.ErrDecrypt):
+			return transfer.Report{}, svc.ErrIncorrectPassword
+		}
+		return transfer.Report{}, fmt.Errorf("%w: %w", svc.ErrInvalidImport, err)
+	}
+
+	for _, item := range items {
+		if utf8.RuneCountInString(item.Card.Metadata) > profile.MaxMetadataLength {
+			return transfer.Report{}, fmt.Errorf("%w: %s", svc.ErrInvalidMetadata, item.Card.Name)
+		}
+	}
+
+	report = transfer.Report{Format: format, DryRun: dryRun, Total: len(items)}
+
+	err = s.db.InTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		existing, err := s.repo.GetCardKeys(ctx, tx, username)
+		if err != nil {
+			return fmt.Errorf("error in getCardKeys: %w", err)
+		}
+
+		seen := make(map[string]bool, len(existing)+len(items))
+		for _, card := range existing {
+			for _, k := range duplicateKeys(card) {
+				seen[k.key] = true
+			}
+		}
+
+		var fresh []importer.Item
+	next:
+		for _, item := range items {
+			keys := duplicateKeys(item.Card)
+			for _, k := range keys {
+				if seen[k.key] {
+					report.Duplicates = append(report.Duplicates, transfer.Duplicate{
+						Name:  item.Card.Name,
+						Type:  item.Card.Type,
+						Match: k.match,
+					})
+					continue next
+				}
+			}
+			for _, k := range keys {
+				seen[k.key] = true
+			}
+			fresh = append(fresh, item)
+		}
+		report.Imported = len(fresh)
+
+		err = svc.EnforceQuota(ctx, tx, s.repo, s.plans, username, func() error {
+			return s.store(ctx, tx, username, fresh)
+		})
+		if !dryRun {
+			return err
+		}
+		if errors.Is(err, svc.ErrQuotaExceeded) {
+			report.QuotaExceeded = err.Error()
+		} else if err != nil {
+			return err
+		}
+		return errDryRun
+	})
+	if err != nil && !errors.Is(err, errDryRun) {
+		return transfer.Report{}, err
+	}
+
+	return report, nil
+}
+
+// store saves imported items, creating their folders where needed.
+func (s *service) store(ctx context.Context, tx pgx.Tx, username string, items []importer.Item) error {
+	folders, err := s.repo.GetFolders(ctx, tx, username)
+	if err != nil {
+		return fmt.Errorf("error in getFolders: %w", err)
+	}
+
+	ids := make(map[folderKey]int64, len(folders))
+	for _, f := range folders {
+		ids[newFolderKey(f.ParentID, f.Name)] = f.ID
+	}
+
+	for _, item := range items {
+		card := item.Card
+		card.Username = username
+		card.FolderID = nil
+		if card.CardNumber == "" {
+			card.CardNumber = uuid.New().String()
+		}
+
+		for _, name := range item.Folder {
+			if r := []rune(name); len(r) > maxFolderLength {
+				name = string(r[:maxFolderLength])
+			}
+
+			key := newFolderKey(card.FolderID, name)
+			id, ok := ids[key]
+			if !ok {
+				id, err = s.repo.InsertFolder(ctx, tx, profile.Folder{Username: username, ParentID: card.FolderID, Name: name})
+				if err != nil {
+					return fmt.Errorf("error in insertFolder: %w", err)
+				}
+				ids[key] = id
+			}
+			card.FolderID = &id
+		}
+
+		err = s.repo.UploadCardInfo(ctx, tx, card)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+				return fmt.Errorf("%w: %s", svc.ErrCardExists, card.Name)
+			}
+			return fmt.Errorf("error in uploadCardInfo: %w", err)
+		}
+
+		if len(card.Tags) > 0 {
+			err = s.repo.SetCardTags(ctx, tx, username, card.CardNumber, card.Tags)
+			if err != nil {
+				return fmt.Errorf("error in setCardTags: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// duplicateKey is a key under which an item is matched against other items, and how it matches.
+type duplicateKey struct {
+	key   string
+	match string
+}
+
+// duplicateKeys returns the keys under which an item is matched against other items.
+// Items are matched by card number, which items of other password managers only carry for cards,
+// and logins also by website and username.
+func duplicateKeys(card profile.CardInfo) []duplicateKey {
+	var keys []duplicateKey
+	if card.CardNumber != "" {
+		keys = append(keys, duplicateKey{key: "card\x00" + card.CardNumber, match: transfer.MatchCardNumber})
+	}
+	if card.Type == profile.TypeLogin && (card.URL != "" || card.Login != "") {
+		url := strings.TrimRight(strings.ToLower(strings.TrimSpace(card.URL)), "/")
+		keys = append(keys, duplicateKey{key: "login\x00" + url + "\x00" + card.Login, match: transfer.MatchLogin})
+	}
+	return keys
+}
+
+// folderKey identifies a folder by its parent and name.
+type folderKey struct {
+	parent int64
+	name   string
+}
+
+// newFolderKey builds the key of a folder; top-level folders have parent 0.
+func newFolderKey(parent *int64, name string) folderKey {
+	if parent == nil {
+		return folderKey{name: name}
+	}
+	return folderKey{parent: *parent, name: name}
+}
@@ -0,0 +1,30 @@
+// Package blobstore provides storage for large binary objects, such as attachment contents,
+// outside of regular table rows. Storage backends implement the BlobStore interface.
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ErrNotFound indicates that no blob is stored under the requested key.
+var ErrNotFound = errors.New("blob not found")
+
+// BlobStore stores binary objects under string keys.
+//
+// Methods:
+//   - WriteAt: Writes the content of r at offset, creating the blob if needed and discarding
+//     anything stored past offset, and returns the number of bytes written.
+//   - Open: Opens a blob for streaming reads.
+//   - Delete: Removes a blob; deleting a missing blob is not an error.
+//   - WithTx: Returns the store working inside tx, so that blob operations commit or roll back
+//     together with the caller's writes instead of holding connections of their own.
+type BlobStore interface {
+	WriteAt(ctx context.Context, key string, offset int64, r io.Reader) (int64, error)
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+	WithTx(tx pgx.Tx) BlobStore
+}
@@ -0,0 +1,96 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// localStore is a BlobStore keeping each blob in a file of a local directory.
+type localStore struct {
+	dir string
+}
+
+// NewLocalStore creates a BlobStore that keeps blobs as files in dir, creating it if needed.
+func NewLocalStore(dir string) (BlobStore, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create blob directory: %w", err)
+	}
+	return &localStore{dir: dir}, nil
+}
+
+// WithTx returns the store itself: files are not part of database transactions.
+func (s *localStore) WithTx(pgx.Tx) BlobStore {
+	return s
+}
+
+// WriteAt writes the content of r to the blob file at offset.
+func (s *localStore) WriteAt(ctx context.Context, key string, offset int64, r io.Reader) (int64, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return 0, err
+	}
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return 0, fmt.Errorf("failed to open blob: %w", err)
+	}
+	defer f.Close()
+
+	if err = f.Truncate(offset); err != nil {
+		return 0, fmt.Errorf("failed to truncate blob: %w", err)
+	}
+	if _, err = f.Seek(offset, io.SeekStart); err != nil {
+		return 0, fmt.Errorf("failed to seek blob: %w", err)
+	}
+
+	n, err := io.Copy(f, r)
+	if err != nil {
+		return n, fmt.Errorf("failed to write blob: %w", err)
+	}
+
+	return n, f.Sync()
+}
+
+// Open opens the blob file for reading.
+func (s *localStore) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	return f, err
+}
+
+// Delete removes the blob file.
+func (s *localStore) Delete(ctx context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("failed to delete blob: %w", err)
+	}
+	return nil
+}
+
+// path maps a key to a file within the store directory, rejecting keys that would escape it.
+func (s *localStore) path(key string) (string, error) {
+	if key == "" || key == "." || key == ".." || strings.ContainsAny(key, `/\`) {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	return filepath.Join(s.dir, key), nil
+}
@@ -0,0 +1,171 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/gleb-korostelev/GophKeeper/tools/db"
+	"github.com/jackc/pgx/v5"
+)
+
+// pgStore is a BlobStore keeping blobs as Postgres large objects,
+// indexed by key in the auth.blobs table. A store bound to a transaction by WithTx
+// runs every operation in that transaction.
+type pgStore struct {
+	db db.IAdapter
+	tx pgx.Tx
+}
+
+// NewPostgresStore creates a BlobStore that keeps blobs as large objects in the application database.
+func NewPostgresStore(db db.IAdapter) BlobStore {
+	return &pgStore{db: db}
+}
+
+// WithTx returns a store running its operations in tx.
+func (s *pgStore) WithTx(tx pgx.Tx) BlobStore {
+	return &pgStore{db: s.db, tx: tx}
+}
+
+// inTx runs f in the transaction the store is bound to, or in a new one.
+func (s *pgStore) inTx(ctx context.Context, f func(ctx context.Context, tx pgx.Tx) error) error {
+	if s.tx != nil {
+		return f(ctx, s.tx)
+	}
+	return s.db.InTx(ctx, f)
+}
+
+// WriteAt writes the content of r to the large object at offset, creating it on first write.
+func (s *pgStore) WriteAt(ctx context.Context, key string, offset int64, r io.Reader) (n int64, err error) {
+	err = s.inTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		los := tx.LargeObjects()
+
+		oid, err := lookupOID(ctx, tx, key, true)
+		if errors.Is(err, ErrNotFound) {
+			oid, err = los.Create(ctx, 0)
+			if err != nil {
+				return fmt.Errorf("failed to create large object: %w", err)
+			}
+			_, err = tx.Exec(ctx, `INSERT INTO auth.blobs (key, oid) VALUES ($1, $2)`, key, oid)
+		}
+		if err != nil {
+			return err
+		}
+
+		obj, err := los.Open(ctx, oid, pgx.LargeObjectModeWrite)
+		if err != nil {
+			return fmt.Errorf("failed to open large object: %w", err)
+		}
+		defer obj.Close()
+
+		if err = obj.Truncate(offset); err != nil {
+			return fmt.Errorf("failed to truncate large object: %w", err)
+		}
+		if _, err = obj.Seek(offset, io.SeekStart); err != nil {
+			return fmt.Errorf("failed to seek large object: %w", err)
+		}
+
+		n, err = io.Copy(obj, r)
+		if err != nil {
+			return fmt.Errorf("failed to write large object: %w", err)
+		}
+		return nil
+	})
+	return
+}
+
+// Open opens the large object for reading. Large objects can only be read inside a transaction,
+// so unless the store is bound to one, the returned reader keeps its own open until it is closed.
+func (s *pgStore) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	if s.tx != nil {
+		obj, err := openLargeObject(ctx, s.tx, key)
+		if err != nil {
+			return nil, err
+		}
+		return &pgReader{obj: obj}, nil
+	}
+
+	tx, err := s.db.GetConn().BeginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return nil, fmt.Errorf("error creating tx: %w", err)
+	}
+
+	obj, err := openLargeObject(ctx, tx, key)
+	if err != nil {
+		_ = tx.Rollback(ctx)
+		return nil, err
+	}
+
+	return &pgReader{ctx: ctx, tx: tx, obj: obj}, nil
+}
+
+// openLargeObject opens the large object stored under key for reading.
+func openLargeObject(ctx context.Context, tx pgx.Tx, key string) (*pgx.LargeObject, error) {
+	oid, err := lookupOID(ctx, tx, key, false)
+	if err != nil {
+		return nil, err
+	}
+
+	los := tx.LargeObjects()
+	obj, err := los.Open(ctx, oid, pgx.LargeObjectModeRead)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open large object: %w", err)
+	}
+	return obj, nil
+}
+
+// Delete unlinks the large object and removes its index entry.
+func (s *pgStore) Delete(ctx context.Context, key string) error {
+	return s.inTx(ctx, func(ctx context.Context, tx pgx.Tx) error {
+		var oid uint32
+		err := tx.QueryRow(ctx, `DELETE FROM auth.blobs WHERE key = $1 RETURNING oid`, key).Scan(&oid)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to delete blob: %w", err)
+		}
+
+		los := tx.LargeObjects()
+		return los.Unlink(ctx, oid)
+	})
+}
+
+// lookupOID returns the large object stored under key, optionally locking its index entry.
+func lookupOID(ctx context.Context, tx pgx.Tx, key string, forUpdate bool) (oid uint32, err error) {
+	query := `SELECT oid FROM auth.blobs WHERE key = $1`
+	if forUpdate {
+		query += ` FOR UPDATE`
+	}
+
+	err = tx.QueryRow(ctx, query, key).Scan(&oid)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, ErrNotFound
+	}
+	return
+}
+
+// pgReader streams a large object and ends its transaction, if it owns one, on Close.
+type pgReader struct {
+	ctx context.Context
+	tx  pgx.Tx
+	obj *pgx.LargeObject
+}
+
+// Read reads from the large object.
+func (r *pgReader) Read(p []byte) (int, error) {
+	return r.obj.Read(p)
+}
+
+// Close closes the large object and rolls back the read-only transaction of the reader.
+func (r *pgReader) Close() error {
+	err := r.obj.Close()
+	if r.tx == nil {
+		return err
+	}
+	if rbErr := r.tx.Rollback(r.ctx); err == nil {
+		err = rbErr
+	}
+	return err
+}
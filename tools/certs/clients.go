@@ -0,0 +1,71 @@
+package certs
+
+import (
+	"bufio"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// LoadCertPool reads the PEM-encoded CA certificates client certificates are verified against.
+func LoadCertPool(path string) (*x509.CertPool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no certificates found in %s", path)
+	}
+	return pool, nil
+}
+
+// SubjectMap maps the subjects of client certificates to the users they authenticate,
+// letting machine clients sign in with a certificate instead of a password.
+type SubjectMap map[string]string
+
+// LoadSubjectMap reads a subject map from a file. Each line holds a username followed by whitespace
+// and the certificate subject in the form of pkix.Name.String, such as
+//
+//	ci-bot CN=ci-runner,OU=Build,O=Acme
+//
+// Empty lines and lines starting with '#' are ignored.
+func LoadSubjectMap(path string) (SubjectMap, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer f.Close()
+
+	m := make(SubjectMap)
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		username, subject, ok := strings.Cut(line, " ")
+		subject = strings.TrimSpace(subject)
+		if !ok || subject == "" {
+			return nil, fmt.Errorf("%s:%d: expected a username and a subject", path, n)
+		}
+		if other, dup := m[subject]; dup && other != username {
+			return nil, fmt.Errorf("%s:%d: subject %q is already mapped to %s", path, n, subject, other)
+		}
+		m[subject] = username
+	}
+
+	if err = scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	return m, nil
+}
+
+// User returns the user the subject of a verified client certificate is mapped to.
+func (m SubjectMap) User(cert *x509.Certificate) (username string, ok bool) {
+	username, ok = m[cert.Subject.String()]
+	return
+}
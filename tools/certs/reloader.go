@@ -0,0 +1,92 @@
+// Package certs provides the TLS certificates of the server: certificates loaded from files and
+// reloaded when the files change, self-signed development certificates, and the mapping of
+// client certificates to the users they authenticate.
+package certs
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// Reloader serves a certificate and key pair loaded from files, reloading them when the files change,
+// so that renewed certificates take effect without a restart.
+// Reload implements the signature of scheduler jobs, so that it can poll the files periodically.
+type Reloader struct {
+	certFile, keyFile string
+
+	mu      sync.RWMutex
+	cert    *tls.Certificate
+	modTime time.Time
+}
+
+// NewReloader loads the certificate and key pair from the given PEM files.
+func NewReloader(certFile, keyFile string) (*Reloader, error) {
+	r := &Reloader{certFile: certFile, keyFile: keyFile}
+	if err := r.load(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// GetCertificate returns the current certificate; it fits tls.Config.GetCertificate.
+func (r *Reloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, nil
+}
+
+// Reload reloads the certificate and key pair if either file changed since they were last loaded.
+// A pair that fails to load is reported and the current certificate stays in use.
+func (r *Reloader) Reload(context.Context) error {
+	modTime, err := r.latestModTime()
+	if err != nil {
+		return err
+	}
+
+	r.mu.RLock()
+	unchanged := modTime.Equal(r.modTime)
+	r.mu.RUnlock()
+	if unchanged {
+		return nil
+	}
+
+	return r.load()
+}
+
+// load reads and parses the certificate and key pair and makes it current.
+func (r *Reloader) load() error {
+	// The modification time is read first, so that a change during the load is picked up by the next reload.
+	modTime, err := r.latestModTime()
+	if err != nil {
+		return err
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load certificate %s: %w", r.certFile, err)
+	}
+
+	r.mu.Lock()
+	r.cert, r.modTime = &cert, modTime
+	r.mu.Unlock()
+	return nil
+}
+
+// latestModTime returns the later modification time of the certificate and key files.
+func (r *Reloader) latestModTime() (time.Time, error) {
+	var latest time.Time
+	for _, name := range []string{r.certFile, r.keyFile} {
+		info, err := os.Stat(name)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("failed to stat %s: %w", name, err)
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest, nil
+}
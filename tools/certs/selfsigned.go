@@ -0,0 +1,121 @@
+package certs
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// File names of the development certificate within its directory.
+const (
+	DevCertFile = "dev-cert.pem"
+	DevKeyFile  = "dev-key.pem"
+)
+
+const (
+	// devCertTTL is how long a development certificate is valid.
+	devCertTTL = 365 * 24 * time.Hour
+
+	// devCertRenewBefore is how long before its expiry a development certificate is replaced.
+	devCertRenewBefore = 30 * 24 * time.Hour
+)
+
+// EnsureSelfSigned makes sure dir holds a self-signed development certificate for host, localhost
+// and the loopback addresses, and returns the paths of its certificate and key files.
+// An existing certificate is kept while it covers host and is not about to expire, so that clients
+// told to trust it keep doing so across restarts.
+func EnsureSelfSigned(dir, host string) (certFile, keyFile string, err error) {
+	certFile, keyFile = filepath.Join(dir, DevCertFile), filepath.Join(dir, DevKeyFile)
+
+	if usable(certFile, keyFile, host) {
+		return certFile, keyFile, nil
+	}
+
+	certPEM, keyPEM, err := generate(host, time.Now())
+	if err != nil {
+		return "", "", err
+	}
+
+	if err = os.MkdirAll(dir, 0o700); err != nil {
+		return "", "", fmt.Errorf("failed to create %s: %w", dir, err)
+	}
+	if err = os.WriteFile(keyFile, keyPEM, 0o600); err != nil {
+		return "", "", fmt.Errorf("failed to write %s: %w", keyFile, err)
+	}
+	if err = os.WriteFile(certFile, certPEM, 0o644); err != nil {
+		return "", "", fmt.Errorf("failed to write %s: %w", certFile, err)
+	}
+	return certFile, keyFile, nil
+}
+
+// usable reports whether the certificate and key files exist, match, cover host and stay valid for a while.
+func usable(certFile, keyFile, host string) bool {
+	pair, err := tlsPair(certFile, keyFile)
+	if err != nil {
+		return false
+	}
+	return pair.VerifyHostname(host) == nil && time.Until(pair.NotAfter) > devCertRenewBefore
+}
+
+// generate creates a self-signed ECDSA P-256 certificate for host and returns it with its key, PEM-encoded.
+func generate(host string, now time.Time) (certPEM, keyPEM []byte, err error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate key: %w", err)
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to generate serial number: %w", err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: host, Organization: []string{"GophKeeper development"}},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(devCertTTL),
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		tmpl.IPAddresses = append(tmpl.IPAddresses, ip)
+	} else if host != "" && host != "localhost" {
+		tmpl.DNSNames = append(tmpl.DNSNames, host)
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create certificate: %w", err)
+	}
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal key: %w", err)
+	}
+
+	certPEM = pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM = pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+	return certPEM, keyPEM, nil
+}
+
+// tlsPair loads a certificate and key pair and returns its parsed leaf certificate.
+func tlsPair(certFile, keyFile string) (*x509.Certificate, error) {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	return x509.ParseCertificate(cert.Certificate[0])
+}
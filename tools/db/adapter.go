@@ -11,21 +11,22 @@ import (
 	"fmt"
 	"time"
 
-	gophkeeper "github.com/gleb-korostelev/GophKeeper"
 	"github.com/gleb-korostelev/GophKeeper/tools/logger"
+	"github.com/gleb-korostelev/GophKeeper/tools/metrics"
+	"github.com/gleb-korostelev/GophKeeper/tools/tracing"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
-	"github.com/jackc/pgx/v5/stdlib"
-	"github.com/pressly/goose/v3"
 )
 
 // IAdapter defines the interface for the database adapter.
 //
 // Methods:
 // - InTx: Executes a function within a database transaction.
+// - InSnapshot: Executes a function within a read-only transaction seeing a single snapshot of the database.
 // - GetConn: Returns the underlying connection pool.
 type IAdapter interface {
 	InTx(ctx context.Context, f func(ctx context.Context, tx pgx.Tx) error) error
+	InSnapshot(ctx context.Context, f func(ctx context.Context, tx pgx.Tx) error) error
 	GetConn() *pgxpool.Pool
 }
 
@@ -37,7 +38,7 @@ type Adapter struct {
 	Config    *Config            // Configuration for the adapter.
 }
 
-// NewAdapter initializes a new database adapter.
+// NewAdapter initializes a new database adapter, applying the pending migrations if config.AutoMigrate is set.
 func NewAdapter(ctx context.Context, config Config, isolation sql.IsolationLevel) (IAdapter, error) {
 	poolConfig, err := pgxpool.ParseConfig(config.Dsn)
 	if err != nil {
@@ -45,7 +46,8 @@ func NewAdapter(ctx context.Context, config Config, isolation sql.IsolationLevel
 	}
 
 	poolConfig.MaxConns = int32(config.MaxOpenConns)
-	poolConfig.MaxConnLifetime = config.ConnMaxLifetime * time.Minute
+	poolConfig.MaxConnLifetime = config.ConnMaxLifetime
+	poolConfig.ConnConfig.Tracer = queryTracer{}
 
 	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
 	if err != nil {
@@ -58,9 +60,11 @@ func NewAdapter(ctx context.Context, config Config, isolation sql.IsolationLevel
 		Config:    &config,
 	}
 
-	if err := ad.gooseUp(ctx, pool); err != nil {
-		pool.Close()
-		return nil, err
+	if config.AutoMigrate {
+		if err := migrateUp(ctx, pool); err != nil {
+			pool.Close()
+			return nil, err
+		}
 	}
 	return ad, nil
 }
@@ -71,8 +75,27 @@ func (b *Adapter) GetConn() *pgxpool.Pool {
 }
 
 // InTx executes a function within a database transaction.
-func (b *Adapter) InTx(ctx context.Context, f func(ctx context.Context, tx pgx.Tx) error) (err error) {
-	tx, err := b.pool.BeginTx(ctx, pgx.TxOptions{})
+// The duration of the transaction and the reason it was rolled back, if it was, are recorded in the metrics,
+// and the transaction is traced, with the queries of f as its children.
+func (b *Adapter) InTx(ctx context.Context, f func(ctx context.Context, tx pgx.Tx) error) error {
+	return b.inTx(ctx, "db.InTx", pgx.TxOptions{}, f)
+}
+
+// InSnapshot executes a function within a read-only repeatable-read transaction, so that all queries of f
+// see the database as it was when the first of them ran, whatever is committed meanwhile.
+// It is recorded and traced like InTx.
+func (b *Adapter) InSnapshot(ctx context.Context, f func(ctx context.Context, tx pgx.Tx) error) error {
+	return b.inTx(ctx, "db.InSnapshot", pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly}, f)
+}
+
+// inTx executes a function within a transaction with the given options, traced as a span named name.
+func (b *Adapter) inTx(ctx context.Context, name string, opts pgx.TxOptions,
+	f func(ctx context.Context, tx pgx.Tx) error) (err error) {
+	ctx, span := tracing.Start(ctx, name)
+	defer func() { tracing.End(span, err) }()
+
+	start := time.Now()
+	tx, err := b.pool.BeginTx(ctx, opts)
 	if err != nil {
 		return fmt.Errorf("error creating tx: %w", err)
 	}
@@ -80,50 +103,36 @@ func (b *Adapter) InTx(ctx context.Context, f func(ctx context.Context, tx pgx.T
 	defer func() {
 		if p := recover(); p != nil {
 			_ = tx.Rollback(ctx)
-			logger.Error(p)
+			metrics.TxRollbacks.WithLabelValues(metrics.RollbackPanic).Inc()
+			logger.Ctx(ctx).Errorw("panic in transaction", "panic", fmt.Sprint(p))
 			err = fmt.Errorf("panic: %v", p)
 		} else if err != nil {
 			_ = tx.Rollback(ctx)
+			metrics.TxRollbacks.WithLabelValues(metrics.RollbackError).Inc()
 		} else {
 			err = tx.Commit(ctx)
 		}
+		metrics.TxDuration.Observe(time.Since(start).Seconds())
 	}()
 
 	err = f(ctx, tx)
 	return
 }
 
-// gooseUp applies database migrations using Goose.
-func (b *Adapter) gooseUp(ctx context.Context, pool *pgxpool.Pool) error {
-	sqlDB := stdlib.OpenDB(*pool.Config().ConnConfig)
-	defer sqlDB.Close()
-	goose.SetBaseFS(gophkeeper.EmbedMigrations)
-
-	if err := goose.Up(sqlDB, "migrations", goose.WithAllowMissing()); err != nil {
-		return fmt.Errorf("goose up error: %w", err)
-	}
-	return nil
-}
-
-// gooseCreate creates a new migration file using Goose.
-func (b *Adapter) gooseCreate(ctx context.Context, pool *pgxpool.Pool) error {
-	sqlDB := stdlib.OpenDB(*pool.Config().ConnConfig)
-	defer sqlDB.Close()
-	goose.SetBaseFS(gophkeeper.EmbedMigrations)
-	if err := goose.Create(sqlDB, "migrations", "", "sql"); err != nil {
+// migrateUp applies the pending migrations, waiting for other replicas applying them.
+func migrateUp(ctx context.Context, pool *pgxpool.Pool) error {
+	m, err := NewMigrator(pool)
+	if err != nil {
 		return err
 	}
-	return nil
-}
+	defer m.Close()
 
-// gooseDown rolls back the last database migration using Goose.
-func (b *Adapter) gooseDown(ctx context.Context, pool *pgxpool.Pool) error {
-	sqlDB := stdlib.OpenDB(*pool.Config().ConnConfig)
-	defer sqlDB.Close()
-	goose.SetBaseFS(gophkeeper.EmbedMigrations)
-	if err := goose.Down(sqlDB, "migrations"); err != nil {
-		return err
+	results, err := m.Up(ctx)
+	for _, res := range results {
+		logger.Infof("migration %s", res)
+	}
+	if err != nil {
+		return fmt.Errorf("goose up error: %w", err)
 	}
-
 	return nil
 }
@@ -9,4 +9,5 @@ type Config struct {
 	MaxIdleConns    int           // Maximum number of idle connections in the pool.
 	ConnMaxLifetime time.Duration // Maximum lifetime of a connection in the pool.
 	Dsn             string        // Data Source Name for the database connection.
+	AutoMigrate     bool          // Whether pending migrations are applied when the adapter is created.
 }
@@ -0,0 +1,81 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	gophkeeper "github.com/gleb-korostelev/GophKeeper"
+	"github.com/jackc/pgx/v5"
+	"github.com/pressly/goose/v3"
+)
+
+// appliedVersionQuery selects the version of the latest applied migration, where the latest record
+// of each version tells whether it is applied, as goose does, without creating the version table.
+const appliedVersionQuery = `
+SELECT COALESCE(MAX(version_id), 0) FROM (
+	SELECT DISTINCT ON (version_id) version_id, is_applied FROM goose_db_version ORDER BY version_id, id DESC
+) AS versions WHERE is_applied`
+
+// ErrMigrationsPending indicates that the database schema is older than the migrations the application embeds.
+var ErrMigrationsPending = errors.New("migrations pending")
+
+// Querier runs queries returning a single row, like *pgxpool.Pool and pgx.Tx.
+type Querier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// Ping reports whether the database answers.
+func Ping(ctx context.Context, db IAdapter) error {
+	pool := db.GetConn()
+	if pool == nil {
+		return errors.New("no database connection")
+	}
+	return pool.Ping(ctx)
+}
+
+// LatestVersion returns the version of the latest migration the application embeds.
+func LatestVersion() (int64, error) {
+	goose.SetBaseFS(gophkeeper.EmbedMigrations)
+	migrations, err := goose.CollectMigrations(MigrationsDir, 0, goose.MaxVersion)
+	if err != nil {
+		return 0, fmt.Errorf("failed to collect migrations: %w", err)
+	}
+	last, err := migrations.Last()
+	if err != nil {
+		return 0, fmt.Errorf("failed to collect migrations: %w", err)
+	}
+	return last.Version, nil
+}
+
+// CheckMigrations returns ErrMigrationsPending if the database schema is older than the latest migration
+// the application embeds. A newer schema is accepted, so that the previous release keeps working while
+// the next one rolls out.
+func CheckMigrations(ctx context.Context, db IAdapter) error {
+	pool := db.GetConn()
+	if pool == nil {
+		return errors.New("no database connection")
+	}
+
+	expected, err := LatestVersion()
+	if err != nil {
+		return err
+	}
+
+	current, err := AppliedVersion(ctx, pool)
+	if err != nil {
+		return err
+	}
+	if current < expected {
+		return fmt.Errorf("%w: schema at version %d, expected %d", ErrMigrationsPending, current, expected)
+	}
+	return nil
+}
+
+// AppliedVersion returns the version of the latest migration applied to the database.
+func AppliedVersion(ctx context.Context, q Querier) (version int64, err error) {
+	if err = q.QueryRow(ctx, appliedVersionQuery).Scan(&version); err != nil {
+		return 0, fmt.Errorf("failed to get schema version: %w", err)
+	}
+	return version, nil
+}
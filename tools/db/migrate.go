@@ -0,0 +1,95 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+
+	gophkeeper "github.com/gleb-korostelev/GophKeeper"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/jackc/pgx/v5/stdlib"
+	"github.com/pressly/goose/v3"
+	"github.com/pressly/goose/v3/lock"
+)
+
+// MigrationsDir is the directory of the migrations, embedded into the application from the source tree.
+const MigrationsDir = "migrations"
+
+// Migrator applies and rolls back the migrations embedded in the application.
+//
+// Each operation changing the schema holds a Postgres session-level advisory lock, so that replicas
+// starting together do not race on migrations: the others wait for the lock, for up to five minutes,
+// and then find nothing left to apply. Migrations are allowed to be applied out of order, so that
+// migrations merged from branches in between are still applied.
+type Migrator struct {
+	provider *goose.Provider
+}
+
+// NewMigrator creates a Migrator running the migrations over connections of pool; Close releases them.
+func NewMigrator(pool *pgxpool.Pool) (*Migrator, error) {
+	migrations, err := fs.Sub(gophkeeper.EmbedMigrations, MigrationsDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open migrations: %w", err)
+	}
+
+	locker, err := lock.NewPostgresSessionLocker()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create migration lock: %w", err)
+	}
+
+	sqlDB := stdlib.OpenDBFromPool(pool)
+	provider, err := goose.NewProvider(goose.DialectPostgres, sqlDB, migrations,
+		goose.WithSessionLocker(locker),
+		goose.WithAllowOutofOrder(true),
+	)
+	if err != nil {
+		sqlDB.Close()
+		return nil, fmt.Errorf("failed to create migration provider: %w", err)
+	}
+	return &Migrator{provider: provider}, nil
+}
+
+// Up applies all pending migrations and returns the results of those it ran.
+func (m *Migrator) Up(ctx context.Context) ([]*goose.MigrationResult, error) {
+	return m.provider.Up(ctx)
+}
+
+// Down rolls back the migration applied last. It returns goose.ErrNoNextVersion if none is applied.
+func (m *Migrator) Down(ctx context.Context) (*goose.MigrationResult, error) {
+	return m.provider.Down(ctx)
+}
+
+// Redo rolls back the migration applied last and applies it again.
+func (m *Migrator) Redo(ctx context.Context) ([]*goose.MigrationResult, error) {
+	down, err := m.provider.Down(ctx)
+	if err != nil {
+		return nil, err
+	}
+	up, err := m.provider.ApplyVersion(ctx, down.Source.Version, true)
+	if err != nil {
+		return []*goose.MigrationResult{down}, err
+	}
+	return []*goose.MigrationResult{down, up}, nil
+}
+
+// Status returns the state of each embedded migration.
+func (m *Migrator) Status(ctx context.Context) ([]*goose.MigrationStatus, error) {
+	return m.provider.Status(ctx)
+}
+
+// Version returns the version of the latest migration applied to the database and of the latest one
+// embedded in the application.
+func (m *Migrator) Version(ctx context.Context) (current, latest int64, err error) {
+	return m.provider.GetVersions(ctx)
+}
+
+// Close releases the connections of the migrator.
+func (m *Migrator) Close() error {
+	return m.provider.Close()
+}
+
+// CreateMigration creates an empty SQL migration in dir, named after the current time and name.
+// It is embedded into the application when the application is built again.
+func CreateMigration(dir, name string) error {
+	return goose.Create(nil, dir, name, "sql")
+}
@@ -23,3 +23,8 @@ func (m *mock) GetConn(context.Context) *sql.DB {
 func (m *mock) InTx(context.Context, func(ctx context.Context, tx pgx.Tx) error) error {
 	return nil
 }
+
+// InSnapshot simulates a snapshot transaction without performing any actual database operations.
+func (m *mock) InSnapshot(context.Context, func(ctx context.Context, tx pgx.Tx) error) error {
+	return nil
+}
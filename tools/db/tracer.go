@@ -0,0 +1,60 @@
+package db
+
+import (
+	"context"
+	"runtime"
+	"strings"
+
+	"github.com/gleb-korostelev/GophKeeper/tools/tracing"
+	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// repositoryPrefix is the prefix of the names of the repository functions, which query spans are named after.
+const repositoryPrefix = "github.com/gleb-korostelev/GophKeeper/repository."
+
+// queryTracer is a pgx.QueryTracer starting a span around each query.
+// Spans are named after the repository function running the query, such as repository.GetAccountByUserName,
+// or after the statement for queries run elsewhere, such as db.begin and db.commit.
+type queryTracer struct{}
+
+// TraceQueryStart starts the span of a query.
+func (queryTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryStartData) context.Context {
+	ctx, _ = tracing.Start(ctx, queryName(data.SQL),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.DBSystemPostgreSQL, semconv.DBQueryText(data.SQL)),
+	)
+	return ctx
+}
+
+// TraceQueryEnd ends the span of a query, recording its error if it failed.
+func (queryTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, data pgx.TraceQueryEndData) {
+	span := trace.SpanFromContext(ctx)
+	if data.Err == nil {
+		span.SetAttributes(attribute.Int64("db.rows_affected", data.CommandTag.RowsAffected()))
+	}
+	tracing.End(span, data.Err)
+}
+
+// queryName returns the name of the span of a query: the repository function on the call stack, if any,
+// or the first word of the statement.
+func queryName(sql string) string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	for {
+		frame, more := frames.Next()
+		if name, ok := strings.CutPrefix(frame.Function, repositoryPrefix); ok {
+			// Drop the suffixes of closures, such as .func1.
+			name, _, _ = strings.Cut(name, ".")
+			return "repository." + name
+		}
+		if !more {
+			break
+		}
+	}
+
+	statement, _, _ := strings.Cut(strings.TrimSpace(sql), " ")
+	return "db." + strings.ToLower(statement)
+}
@@ -0,0 +1,149 @@
+// Package health provides the readiness checks of the application.
+//
+// Dependencies register a Checker with a Registry under a name; the readiness probe and the health report
+// of administrators run all registered checks concurrently, each bounded by the timeout of the registry.
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Statuses of checks and reports.
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+)
+
+// Checker checks that a dependency of the application is usable.
+type Checker interface {
+	Check(ctx context.Context) error
+}
+
+// CheckerFunc adapts a function to the Checker interface.
+type CheckerFunc func(ctx context.Context) error
+
+// Check calls f.
+func (f CheckerFunc) Check(ctx context.Context) error {
+	return f(ctx)
+}
+
+// Result is the result of one check.
+//
+// Fields:
+// - Name: The name the check was registered under.
+// - Status: StatusUp if the check passed, StatusDown otherwise.
+// - Error: Why the check failed.
+// - DurationMs: How long the check took, in milliseconds.
+type Result struct {
+	Name       string  `json:"name"`
+	Status     string  `json:"status"`
+	Error      string  `json:"error,omitempty"`
+	DurationMs float64 `json:"duration_ms"`
+}
+
+// Report is the result of all checks of a registry.
+//
+// Fields:
+// - Status: StatusUp if all checks passed, StatusDown otherwise.
+// - Checks: The results of the checks, in the order they were registered.
+type Report struct {
+	Status string   `json:"status"`
+	Checks []Result `json:"checks"`
+}
+
+// Up reports whether all checks passed.
+func (r Report) Up() bool {
+	return r.Status == StatusUp
+}
+
+// check is a registered checker.
+type check struct {
+	name    string
+	checker Checker
+}
+
+// Registry holds the checks of the dependencies of the application. It is safe for concurrent use.
+type Registry struct {
+	timeout time.Duration
+
+	mu     sync.RWMutex
+	checks []check
+}
+
+// NewRegistry creates an empty registry whose checks fail if they take longer than timeout.
+func NewRegistry(timeout time.Duration) *Registry {
+	return &Registry{timeout: timeout}
+}
+
+// Register adds a check under a name, replacing the check registered under the same name, if any.
+func (r *Registry) Register(name string, checker Checker) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i := range r.checks {
+		if r.checks[i].name == name {
+			r.checks[i].checker = checker
+			return
+		}
+	}
+	r.checks = append(r.checks, check{name: name, checker: checker})
+}
+
+// Check runs all registered checks concurrently and reports their results.
+func (r *Registry) Check(ctx context.Context) Report {
+	r.mu.RLock()
+	checks := append([]check(nil), r.checks...)
+	r.mu.RUnlock()
+
+	report := Report{Status: StatusUp, Checks: make([]Result, len(checks))}
+
+	var wg sync.WaitGroup
+	for i, c := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			report.Checks[i] = r.run(ctx, c)
+		}()
+	}
+	wg.Wait()
+
+	for _, res := range report.Checks {
+		if res.Status != StatusUp {
+			report.Status = StatusDown
+		}
+	}
+	return report
+}
+
+// run runs one check within the timeout of the registry.
+func (r *Registry) run(ctx context.Context, c check) Result {
+	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	defer cancel()
+
+	start := time.Now()
+	errc := make(chan error, 1)
+	go func() {
+		errc <- c.checker.Check(ctx)
+	}()
+
+	// Checks ignoring the context are abandoned when the timeout expires, so that they cannot stall the probe.
+	var err error
+	select {
+	case err = <-errc:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	res := Result{
+		Name:       c.name,
+		Status:     StatusUp,
+		DurationMs: float64(time.Since(start).Microseconds()) / 1000,
+	}
+	if err != nil {
+		res.Status = StatusDown
+		res.Error = err.Error()
+	}
+	return res
+}
@@ -0,0 +1,189 @@
+// Package kms provides key management for encryption at rest, keeping master keys out of the configuration
+// of the application. Data is encrypted with data keys, which a key manager wraps with a master key it holds;
+// only the wrapped data keys are stored next to the data. Key managers implement the KeyManager interface.
+//
+// A wrapped data key has the form "<manager>:v<version>:<base64>", naming the version of the master key that
+// wrapped it. Rotating the master key creates a new version, which wraps new data keys; the previous versions
+// keep unwrapping the data keys they wrapped, until these are rewrapped.
+package kms
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// DataKeySize is the size of the data keys generated by GenerateDataKey, for AES-256.
+const DataKeySize = 32
+
+var (
+	// ErrInvalidWrappedKey indicates that a wrapped data key is malformed or was not wrapped by the key manager.
+	ErrInvalidWrappedKey = errors.New("invalid wrapped key")
+
+	// ErrUnknownKeyVersion indicates that a data key was wrapped by a master key version the key manager lacks.
+	ErrUnknownKeyVersion = errors.New("unknown master key version")
+
+	// ErrInvalidSealed indicates that sealed data is malformed or was tampered with.
+	ErrInvalidSealed = errors.New("invalid sealed data")
+)
+
+// KeyManager wraps data keys with a master key.
+//
+// Methods:
+//   - WrapKey: Encrypts a data key with the current version of the master key.
+//   - UnwrapKey: Decrypts a data key wrapped by any version of the master key.
+//   - Rotate: Creates a new version of the master key, which wraps data keys from then on.
+type KeyManager interface {
+	WrapKey(ctx context.Context, dataKey []byte) ([]byte, error)
+	UnwrapKey(ctx context.Context, wrapped []byte) ([]byte, error)
+	Rotate(ctx context.Context) error
+}
+
+// GenerateDataKey creates a random data key.
+func GenerateDataKey() ([]byte, error) {
+	key := make([]byte, DataKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, fmt.Errorf("failed to generate data key: %w", err)
+	}
+	return key, nil
+}
+
+// Seal encrypts plaintext with a new data key, which km wraps, and returns the wrapped key and the ciphertext
+// together, in the form Open reads.
+func Seal(ctx context.Context, km KeyManager, plaintext []byte) ([]byte, error) {
+	dataKey, err := GenerateDataKey()
+	if err != nil {
+		return nil, err
+	}
+	wrapped, err := km.WrapKey(ctx, dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to wrap data key: %w", err)
+	}
+	if len(wrapped) > 0xffff {
+		return nil, fmt.Errorf("wrapped key too long: %d bytes", len(wrapped))
+	}
+
+	// The wrapped key is authenticated with the ciphertext, so that it cannot be swapped.
+	ciphertext, err := encrypt(dataKey, plaintext, wrapped)
+	if err != nil {
+		return nil, err
+	}
+
+	sealed := binary.BigEndian.AppendUint16(nil, uint16(len(wrapped)))
+	sealed = append(sealed, wrapped...)
+	return append(sealed, ciphertext...), nil
+}
+
+// Open decrypts data encrypted by Seal, unwrapping its data key with km.
+func Open(ctx context.Context, km KeyManager, sealed []byte) ([]byte, error) {
+	if len(sealed) < 2 {
+		return nil, ErrInvalidSealed
+	}
+	n := int(binary.BigEndian.Uint16(sealed))
+	if len(sealed) < 2+n {
+		return nil, ErrInvalidSealed
+	}
+	wrapped, ciphertext := sealed[2:2+n], sealed[2+n:]
+
+	dataKey, err := km.UnwrapKey(ctx, wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key: %w", err)
+	}
+	plaintext, err := decrypt(dataKey, ciphertext, wrapped)
+	if err != nil {
+		return nil, ErrInvalidSealed
+	}
+	return plaintext, nil
+}
+
+// Rewrap wraps a data key again with the current version of the master key, so that the version that wrapped it
+// can be retired.
+func Rewrap(ctx context.Context, km KeyManager, wrapped []byte) ([]byte, error) {
+	dataKey, err := km.UnwrapKey(ctx, wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unwrap data key: %w", err)
+	}
+	return km.WrapKey(ctx, dataKey)
+}
+
+// KeyVersion returns the version of the master key that wrapped a data key.
+func KeyVersion(wrapped []byte) (int, error) {
+	_, version, _, err := splitWrapped(wrapped)
+	return version, err
+}
+
+// formatWrapped returns the wrapped form of a data key encrypted by the given version of a manager's master key.
+func formatWrapped(manager string, version int, ciphertext []byte) []byte {
+	return fmt.Appendf(nil, "%s:v%d:%s", manager, version, base64.StdEncoding.EncodeToString(ciphertext))
+}
+
+// parseWrapped parses a data key wrapped by the named manager.
+func parseWrapped(manager string, wrapped []byte) (version int, ciphertext []byte, err error) {
+	name, version, encoded, err := splitWrapped(wrapped)
+	if err != nil {
+		return 0, nil, err
+	}
+	if name != manager {
+		return 0, nil, fmt.Errorf("%w: wrapped by %q", ErrInvalidWrappedKey, name)
+	}
+	ciphertext, err = base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return 0, nil, ErrInvalidWrappedKey
+	}
+	return version, ciphertext, nil
+}
+
+// splitWrapped splits a wrapped data key into its parts.
+func splitWrapped(wrapped []byte) (manager string, version int, encoded string, err error) {
+	parts := strings.SplitN(string(wrapped), ":", 3)
+	if len(parts) != 3 || !strings.HasPrefix(parts[1], "v") {
+		return "", 0, "", ErrInvalidWrappedKey
+	}
+	version, err = strconv.Atoi(parts[1][1:])
+	if err != nil || version < 1 {
+		return "", 0, "", ErrInvalidWrappedKey
+	}
+	return parts[0], version, parts[2], nil
+}
+
+// encrypt encrypts plaintext with AES-GCM under key, authenticating additionalData with it.
+// The random nonce is prepended to the ciphertext.
+func encrypt(key, plaintext, additionalData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize(), gcm.NonceSize()+len(plaintext)+gcm.Overhead())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+	return gcm.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// decrypt decrypts a ciphertext produced by encrypt.
+func decrypt(key, ciphertext, additionalData []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	return gcm.Open(nil, ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():], additionalData)
+}
+
+// newGCM returns an AES-GCM cipher with the given key.
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,216 @@
+package kms
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testToken = "s.test-token"
+
+// newVaultStandIn starts a server implementing the transit endpoints used by the Vault key manager
+// for a single key named "master", mounted at "transit".
+func newVaultStandIn(t *testing.T) *httptest.Server {
+	set, err := newKeySet("vault")
+	require.NoError(t, err)
+	var mu sync.Mutex
+
+	fail := func(rw http.ResponseWriter, status int, msg string) {
+		rw.WriteHeader(status)
+		json.NewEncoder(rw).Encode(map[string][]string{"errors": {msg}})
+	}
+	reply := func(rw http.ResponseWriter, data map[string]string) {
+		json.NewEncoder(rw).Encode(map[string]any{"data": data})
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != testToken {
+			fail(rw, http.StatusForbidden, "permission denied")
+			return
+		}
+		var in map[string]string
+		json.NewDecoder(r.Body).Decode(&in)
+
+		mu.Lock()
+		defer mu.Unlock()
+		switch r.URL.Path {
+		case "/v1/transit/encrypt/master":
+			dataKey, err := base64.StdEncoding.DecodeString(in["plaintext"])
+			if err != nil {
+				fail(rw, http.StatusBadRequest, "invalid plaintext")
+				return
+			}
+			wrapped, _ := set.wrap(dataKey)
+			reply(rw, map[string]string{"ciphertext": string(wrapped)})
+		case "/v1/transit/decrypt/master":
+			dataKey, err := set.unwrap([]byte(in["ciphertext"]))
+			if err != nil {
+				fail(rw, http.StatusBadRequest, err.Error())
+				return
+			}
+			reply(rw, map[string]string{"plaintext": base64.StdEncoding.EncodeToString(dataKey)})
+		case "/v1/transit/keys/master/rotate":
+			set.rotate()
+			rw.WriteHeader(http.StatusNoContent)
+		default:
+			fail(rw, http.StatusNotFound, "no handler for route")
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestKeyManagers(t *testing.T) {
+	vault := newVaultStandIn(t)
+
+	tests := []struct {
+		name string
+		new  func(t *testing.T) KeyManager
+	}{
+		{
+			name: "memory",
+			new: func(t *testing.T) KeyManager {
+				km, err := NewMemory()
+				require.NoError(t, err)
+				return km
+			},
+		},
+		{
+			name: "local",
+			new: func(t *testing.T) KeyManager {
+				km, err := NewLocal(filepath.Join(t.TempDir(), "keystore.json"), "correct horse")
+				require.NoError(t, err)
+				return km
+			},
+		},
+		{
+			name: "vault",
+			new: func(t *testing.T) KeyManager {
+				return NewVault(VaultConfig{Addr: vault.URL + "/", Token: testToken, Mount: "transit", Key: "master"}, nil)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			km := tt.new(t)
+
+			dataKey, err := GenerateDataKey()
+			require.NoError(t, err)
+
+			wrapped, err := km.WrapKey(ctx, dataKey)
+			require.NoError(t, err)
+			assert.NotContains(t, string(wrapped), base64.StdEncoding.EncodeToString(dataKey))
+			version, err := KeyVersion(wrapped)
+			require.NoError(t, err)
+
+			got, err := km.UnwrapKey(ctx, wrapped)
+			require.NoError(t, err)
+			assert.Equal(t, dataKey, got)
+
+			// Data keys wrapped before a rotation still unwrap, and rewrap with the new version.
+			require.NoError(t, km.Rotate(ctx))
+			got, err = km.UnwrapKey(ctx, wrapped)
+			require.NoError(t, err)
+			assert.Equal(t, dataKey, got)
+
+			rewrapped, err := Rewrap(ctx, km, wrapped)
+			require.NoError(t, err)
+			newVersion, err := KeyVersion(rewrapped)
+			require.NoError(t, err)
+			assert.Equal(t, version+1, newVersion)
+
+			got, err = km.UnwrapKey(ctx, rewrapped)
+			require.NoError(t, err)
+			assert.Equal(t, dataKey, got)
+
+			// Tampered keys are rejected.
+			tampered := []byte(strings.Replace(string(wrapped), ":v", ":v9", 1))
+			_, err = km.UnwrapKey(ctx, tampered)
+			assert.Error(t, err)
+			_, err = km.UnwrapKey(ctx, []byte("not a wrapped key"))
+			assert.ErrorIs(t, err, ErrInvalidWrappedKey)
+
+			sealed, err := Seal(ctx, km, []byte("4111 1111 1111 1111"))
+			require.NoError(t, err)
+			assert.NotContains(t, string(sealed), "4111")
+
+			opened, err := Open(ctx, km, sealed)
+			require.NoError(t, err)
+			assert.Equal(t, []byte("4111 1111 1111 1111"), opened)
+
+			sealed[len(sealed)-1] ^= 1
+			_, err = Open(ctx, km, sealed)
+			assert.ErrorIs(t, err, ErrInvalidSealed)
+		})
+	}
+}
+
+func TestLocalKeystore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "kms", "keystore.json")
+
+	km, err := NewLocal(path, "correct horse")
+	require.NoError(t, err)
+	wrapped, err := km.WrapKey(ctx, []byte("0123456789abcdef0123456789abcdef"))
+	require.NoError(t, err)
+
+	_, err = NewLocal(path, "wrong horse")
+	assert.ErrorIs(t, err, ErrWrongPassphrase)
+	_, err = NewLocal(path, "")
+	assert.Error(t, err)
+
+	// Another process rotating the keystore: the first one picks up the new version when it meets it.
+	other, err := NewLocal(path, "correct horse")
+	require.NoError(t, err)
+	got, err := other.UnwrapKey(ctx, wrapped)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("0123456789abcdef0123456789abcdef"), got)
+
+	require.NoError(t, other.Rotate(ctx))
+	rewrapped, err := Rewrap(ctx, other, wrapped)
+	require.NoError(t, err)
+
+	got, err = km.UnwrapKey(ctx, rewrapped)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("0123456789abcdef0123456789abcdef"), got)
+
+	// Rotating keeps the versions created by other processes.
+	require.NoError(t, km.Rotate(ctx))
+	reopened, err := NewLocal(path, "correct horse")
+	require.NoError(t, err)
+	for _, w := range [][]byte{wrapped, rewrapped} {
+		_, err = reopened.UnwrapKey(ctx, w)
+		assert.NoError(t, err)
+	}
+	latest, err := reopened.WrapKey(ctx, []byte("0123456789abcdef0123456789abcdef"))
+	require.NoError(t, err)
+	version, err := KeyVersion(latest)
+	require.NoError(t, err)
+	assert.Equal(t, 3, version)
+}
+
+func TestVaultErrors(t *testing.T) {
+	vault := newVaultStandIn(t)
+	ctx := context.Background()
+
+	km := NewVault(VaultConfig{Addr: vault.URL, Token: "s.wrong", Mount: "transit", Key: "master"}, nil)
+	_, err := km.WrapKey(ctx, []byte("0123456789abcdef0123456789abcdef"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "permission denied")
+
+	km = NewVault(VaultConfig{Addr: vault.URL, Token: testToken, Mount: "transit", Key: "missing"}, nil)
+	err = km.Rotate(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "404")
+}
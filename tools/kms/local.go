@@ -0,0 +1,208 @@
+package kms
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// ErrWrongPassphrase indicates that a keystore cannot be decrypted with the given passphrase.
+var ErrWrongPassphrase = errors.New("wrong keystore passphrase or corrupted keystore")
+
+// keystore is the stored form of a local key manager's master key.
+//
+// Fields:
+// - KDF: The parameters deriving the key that encrypts the master key versions from the passphrase.
+// - Active: The version wrapping new data keys.
+// - Keys: The encrypted master key versions.
+type keystore struct {
+	KDF    kdfParams   `json:"kdf"`
+	Active int         `json:"active"`
+	Keys   []storedKey `json:"keys"`
+}
+
+// kdfParams are the Argon2id parameters deriving the key of a keystore from its passphrase.
+type kdfParams struct {
+	Salt    []byte `json:"salt"`
+	Time    uint32 `json:"time"`
+	Memory  uint32 `json:"memory"` // In KiB.
+	Threads uint8  `json:"threads"`
+}
+
+// derive derives the key of a keystore from its passphrase.
+func (p kdfParams) derive(passphrase string) []byte {
+	return argon2.IDKey([]byte(passphrase), p.Salt, p.Time, p.Memory, p.Threads, DataKeySize)
+}
+
+// storedKey is an encrypted master key version.
+type storedKey struct {
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"created_at"`
+	Key       []byte    `json:"key"`
+}
+
+// localManager is a KeyManager keeping its master key in a keystore file, encrypted with a passphrase.
+// It stands in for an external key management service where there is none.
+type localManager struct {
+	path string
+
+	mu      sync.Mutex
+	kdf     kdfParams
+	kek     []byte // Derived from the passphrase.
+	set     keySet
+	created map[int]time.Time
+}
+
+// NewLocal creates a KeyManager keeping its master key in the keystore file at path, encrypted with a key
+// derived from passphrase. The keystore is created with a random master key if it does not exist.
+// Keystores rotated by other processes are reloaded when data keys wrapped by a new version are unwrapped.
+func NewLocal(path, passphrase string) (KeyManager, error) {
+	if passphrase == "" {
+		return nil, errors.New("keystore passphrase is empty")
+	}
+	m := &localManager{path: path}
+
+	err := m.load(passphrase)
+	if !errors.Is(err, fs.ErrNotExist) {
+		if err != nil {
+			return nil, err
+		}
+		return m, nil
+	}
+
+	m.kdf = kdfParams{Salt: make([]byte, 16), Time: 3, Memory: 64 * 1024, Threads: 4}
+	if _, err = rand.Read(m.kdf.Salt); err != nil {
+		return nil, fmt.Errorf("failed to generate salt: %w", err)
+	}
+	m.kek = m.kdf.derive(passphrase)
+	if m.set, err = newKeySet("local"); err != nil {
+		return nil, err
+	}
+	m.created = map[int]time.Time{m.set.active: time.Now().UTC()}
+	if err = m.save(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// WrapKey encrypts a data key with the current version of the master key.
+func (m *localManager) WrapKey(ctx context.Context, dataKey []byte) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.set.wrap(dataKey)
+}
+
+// UnwrapKey decrypts a data key wrapped by any version of the master key, reloading the keystore
+// if the version is not known yet.
+func (m *localManager) UnwrapKey(ctx context.Context, wrapped []byte) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	dataKey, err := m.set.unwrap(wrapped)
+	if errors.Is(err, ErrUnknownKeyVersion) {
+		if reloadErr := m.load(""); reloadErr != nil {
+			return nil, reloadErr
+		}
+		dataKey, err = m.set.unwrap(wrapped)
+	}
+	return dataKey, err
+}
+
+// Rotate creates a new version of the master key and stores it in the keystore.
+// The keystore is reloaded first, so that versions created by other processes are kept.
+func (m *localManager) Rotate(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if err := m.load(""); err != nil {
+		return err
+	}
+	if err := m.set.rotate(); err != nil {
+		return err
+	}
+	m.created[m.set.active] = time.Now().UTC()
+	return m.save()
+}
+
+// load reads the keystore file. The key of the keystore is derived from passphrase, unless it is already known.
+func (m *localManager) load(passphrase string) error {
+	data, err := os.ReadFile(m.path)
+	if err != nil {
+		return err
+	}
+	var ks keystore
+	if err = json.Unmarshal(data, &ks); err != nil {
+		return fmt.Errorf("failed to decode keystore %s: %w", m.path, err)
+	}
+
+	kek := m.kek
+	if kek == nil {
+		kek = ks.KDF.derive(passphrase)
+	}
+	set := keySet{manager: "local", active: ks.Active, keys: map[int][]byte{}}
+	created := map[int]time.Time{}
+	for _, k := range ks.Keys {
+		key, err := decrypt(kek, k.Key, set.label(k.Version))
+		if err != nil {
+			return ErrWrongPassphrase
+		}
+		set.keys[k.Version] = key
+		created[k.Version] = k.CreatedAt
+	}
+	if _, ok := set.keys[set.active]; !ok {
+		return fmt.Errorf("keystore %s: %w: %d", m.path, ErrUnknownKeyVersion, set.active)
+	}
+
+	m.kdf, m.kek, m.set, m.created = ks.KDF, kek, set, created
+	return nil
+}
+
+// save stores the keystore file, readable by its owner only.
+// The file is replaced at once, so that other processes reading it never see it partially written.
+func (m *localManager) save() error {
+	ks := keystore{KDF: m.kdf, Active: m.set.active}
+	for version := 1; len(ks.Keys) < len(m.set.keys); version++ {
+		key, ok := m.set.keys[version]
+		if !ok {
+			continue
+		}
+		encrypted, err := encrypt(m.kek, key, m.set.label(version))
+		if err != nil {
+			return err
+		}
+		ks.Keys = append(ks.Keys, storedKey{Version: version, CreatedAt: m.created[version], Key: encrypted})
+	}
+	data, err := json.MarshalIndent(ks, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(m.path), 0o700); err != nil {
+		return fmt.Errorf("failed to create keystore directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(m.path), filepath.Base(m.path)+".*")
+	if err != nil {
+		return fmt.Errorf("failed to create keystore file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err = tmp.Chmod(0o600); err == nil {
+		_, err = tmp.Write(data)
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write keystore file: %w", err)
+	}
+	return os.Rename(tmp.Name(), m.path)
+}
@@ -0,0 +1,110 @@
+package kms
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sync"
+)
+
+// keySet holds the versions of a master key, which wrap data keys with AES-256-GCM.
+//
+// Fields:
+// - manager: The name of the key manager, prefixed to the data keys it wraps.
+// - active: The version wrapping new data keys.
+// - keys: The master key of each version.
+type keySet struct {
+	manager string
+	active  int
+	keys    map[int][]byte
+}
+
+// newKeySet creates a key set with a single, random version.
+func newKeySet(manager string) (keySet, error) {
+	s := keySet{manager: manager, keys: map[int][]byte{}}
+	return s, s.rotate()
+}
+
+// wrap encrypts a data key with the active version.
+func (s *keySet) wrap(dataKey []byte) ([]byte, error) {
+	ciphertext, err := encrypt(s.keys[s.active], dataKey, s.label(s.active))
+	if err != nil {
+		return nil, err
+	}
+	return formatWrapped(s.manager, s.active, ciphertext), nil
+}
+
+// unwrap decrypts a data key wrapped by any version.
+func (s *keySet) unwrap(wrapped []byte) ([]byte, error) {
+	version, ciphertext, err := parseWrapped(s.manager, wrapped)
+	if err != nil {
+		return nil, err
+	}
+	key, ok := s.keys[version]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d", ErrUnknownKeyVersion, version)
+	}
+	dataKey, err := decrypt(key, ciphertext, s.label(version))
+	if err != nil {
+		return nil, ErrInvalidWrappedKey
+	}
+	return dataKey, nil
+}
+
+// rotate adds a random version and makes it active.
+func (s *keySet) rotate() error {
+	key, err := GenerateDataKey()
+	if err != nil {
+		return err
+	}
+	version := 1
+	if len(s.keys) > 0 {
+		version = slices.Max(slices.Collect(maps.Keys(s.keys))) + 1
+	}
+	s.keys[version] = key
+	s.active = version
+	return nil
+}
+
+// label returns the data authenticated with the data keys wrapped by a version, binding them to it.
+func (s *keySet) label(version int) []byte {
+	return fmt.Appendf(nil, "%s:v%d", s.manager, version)
+}
+
+// memoryManager is a KeyManager holding its master key in memory.
+type memoryManager struct {
+	mu  sync.Mutex
+	set keySet
+}
+
+// NewMemory creates a KeyManager with a random master key held in memory, for tests and development.
+// The master key is lost when the process exits, and with it every data key it wrapped.
+func NewMemory() (KeyManager, error) {
+	set, err := newKeySet("memory")
+	if err != nil {
+		return nil, err
+	}
+	return &memoryManager{set: set}, nil
+}
+
+// WrapKey encrypts a data key with the current version of the master key.
+func (m *memoryManager) WrapKey(ctx context.Context, dataKey []byte) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.set.wrap(dataKey)
+}
+
+// UnwrapKey decrypts a data key wrapped by any version of the master key.
+func (m *memoryManager) UnwrapKey(ctx context.Context, wrapped []byte) ([]byte, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.set.unwrap(wrapped)
+}
+
+// Rotate creates a new version of the master key.
+func (m *memoryManager) Rotate(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.set.rotate()
+}
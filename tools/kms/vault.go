@@ -0,0 +1,135 @@
+package kms
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// VaultConfig holds the settings of a HashiCorp Vault transit secrets engine.
+//
+// Fields:
+// - Addr: The address of the Vault server, such as https://vault:8200.
+// - Token: The token authenticating to Vault; it needs the encrypt, decrypt and rotate capabilities of the key.
+// - Namespace: The Vault Enterprise namespace, if any.
+// - Mount: The path the transit engine is mounted at.
+// - Key: The name of the transit key used as the master key.
+type VaultConfig struct {
+	Addr      string
+	Token     string
+	Namespace string
+	Mount     string
+	Key       string
+}
+
+// vaultManager is a KeyManager using a key of a Vault transit secrets engine, which never leaves Vault.
+type vaultManager struct {
+	cfg    VaultConfig
+	client *http.Client
+}
+
+// NewVault creates a KeyManager using a key of a Vault transit secrets engine, or of any service implementing
+// its encrypt, decrypt and rotate endpoints. The key must exist. If client is nil, a client with a 10-second
+// timeout is used.
+func NewVault(cfg VaultConfig, client *http.Client) KeyManager {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &vaultManager{cfg: cfg, client: client}
+}
+
+// WrapKey encrypts a data key with the latest version of the transit key.
+func (v *vaultManager) WrapKey(ctx context.Context, dataKey []byte) ([]byte, error) {
+	var out struct {
+		Ciphertext string `json:"ciphertext"`
+	}
+	in := map[string]string{"plaintext": base64.StdEncoding.EncodeToString(dataKey)}
+	if err := v.do(ctx, "encrypt/"+url.PathEscape(v.cfg.Key), in, &out); err != nil {
+		return nil, err
+	}
+	return []byte(out.Ciphertext), nil
+}
+
+// UnwrapKey decrypts a data key wrapped by any version of the transit key.
+func (v *vaultManager) UnwrapKey(ctx context.Context, wrapped []byte) ([]byte, error) {
+	if _, _, _, err := splitWrapped(wrapped); err != nil {
+		return nil, err
+	}
+
+	var out struct {
+		Plaintext string `json:"plaintext"`
+	}
+	in := map[string]string{"ciphertext": string(wrapped)}
+	if err := v.do(ctx, "decrypt/"+url.PathEscape(v.cfg.Key), in, &out); err != nil {
+		return nil, err
+	}
+	dataKey, err := base64.StdEncoding.DecodeString(out.Plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("vault: failed to decode plaintext: %w", err)
+	}
+	return dataKey, nil
+}
+
+// Rotate creates a new version of the transit key.
+func (v *vaultManager) Rotate(ctx context.Context) error {
+	return v.do(ctx, "keys/"+url.PathEscape(v.cfg.Key)+"/rotate", nil, nil)
+}
+
+// do sends a request to an endpoint of the transit engine and decodes the data of the response into out.
+func (v *vaultManager) do(ctx context.Context, path string, in, out any) error {
+	var body bytes.Buffer
+	if in != nil {
+		if err := json.NewEncoder(&body).Encode(in); err != nil {
+			return err
+		}
+	}
+
+	endpoint := strings.TrimRight(v.cfg.Addr, "/") + "/v1/" + strings.Trim(v.cfg.Mount, "/") + "/" + path
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, &body)
+	if err != nil {
+		return fmt.Errorf("vault: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Vault-Token", v.cfg.Token)
+	if v.cfg.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.cfg.Namespace)
+	}
+	// Pass the trace context on, so that the calls show up in the traces of Vault and the proxies in between.
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("vault: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []string        `json:"errors"`
+	}
+	// Rotation answers with no content.
+	if resp.StatusCode != http.StatusNoContent {
+		if err = json.NewDecoder(resp.Body).Decode(&result); err != nil && resp.StatusCode < 300 {
+			return fmt.Errorf("vault: failed to decode response: %w", err)
+		}
+	}
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("vault: %s: %s", resp.Status, strings.Join(result.Errors, "; "))
+	}
+	if out == nil {
+		return nil
+	}
+	if err = json.Unmarshal(result.Data, out); err != nil {
+		return fmt.Errorf("vault: failed to decode response data: %w", err)
+	}
+	return nil
+}
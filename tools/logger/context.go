@@ -0,0 +1,36 @@
+package logger
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+// ctxKey is the type of the context key of the request-scoped logger.
+type ctxKey struct{}
+
+// With returns a copy of ctx whose logger adds the given key-value pairs to every entry.
+// It is used to give every log entry of a request its request ID, method, route and user.
+func With(ctx context.Context, args ...interface{}) context.Context {
+	return context.WithValue(ctx, ctxKey{}, fromContext(ctx).With(args...))
+}
+
+// Ctx returns the logger of ctx, or the common logger if ctx carries none, with the trace and span IDs
+// of the span in ctx, if there is one, so that log entries can be found from traces and the other way around.
+func Ctx(ctx context.Context) *zap.SugaredLogger {
+	l := fromContext(ctx)
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return l
+	}
+	return l.With(zap.String("trace_id", sc.TraceID().String()), zap.String("span_id", sc.SpanID().String()))
+}
+
+// fromContext returns the logger of ctx, or the common logger if ctx carries none.
+func fromContext(ctx context.Context) *zap.SugaredLogger {
+	if l, ok := ctx.Value(ctxKey{}).(*zap.SugaredLogger); ok {
+		return l
+	}
+	return global
+}
@@ -2,7 +2,7 @@ package logger
 
 // Info func
 func Info(args ...interface{}) {
-	global.Info(args)
+	global.Info(args...)
 }
 
 // Infof func
@@ -12,7 +12,7 @@ func Infof(tmp string, args ...interface{}) {
 
 // Fatal func
 func Fatal(args ...interface{}) {
-	global.Fatal(args)
+	global.Fatal(args...)
 }
 
 // Fatalf func
@@ -22,10 +22,15 @@ func Fatalf(tmp string, args ...interface{}) {
 
 // Error func
 func Error(args ...interface{}) {
-	global.Error(args)
+	global.Error(args...)
 }
 
 // Errorf func
 func Errorf(tmp string, args ...interface{}) {
 	global.Errorf(tmp, args...)
 }
+
+// Warnf func
+func Warnf(tmp string, args ...interface{}) {
+	global.Warnf(tmp, args...)
+}
@@ -27,6 +27,7 @@ func New(level zapcore.LevelEnabler, options ...zap.Option) *zap.SugaredLogger {
 
 // NewWithSink creates instance of *zap.SugaredLogger with standard JSON output.
 // If log level not provided, it uses default level (zap.ErrorLevel)
+// Entries are passed through Redact before they reach the sink, so that no secrets are logged.
 func NewWithSink(level zapcore.LevelEnabler, sink io.Writer, options ...zap.Option) *zap.SugaredLogger {
 	if level == nil {
 		level = defaultLevel
@@ -46,7 +47,7 @@ func NewWithSink(level zapcore.LevelEnabler, sink io.Writer, options ...zap.Opti
 			EncodeDuration: zapcore.SecondsDurationEncoder,
 			EncodeCaller:   zapcore.ShortCallerEncoder,
 		}),
-		zapcore.AddSync(sink),
+		zapcore.AddSync(redactingWriter{w: sink}),
 		level,
 	)
 
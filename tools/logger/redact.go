@@ -0,0 +1,107 @@
+package logger
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// redacted replaces secrets in log entries.
+const redacted = "[REDACTED]"
+
+var (
+	// sensitiveField matches a sensitive key followed by its value, in JSON as well as in key=value text,
+	// including JSON quoted in a string, such as a request body in an error message. Array values are
+	// matched whole, so that the recovery share codes of a recovery request are removed together.
+	sensitiveField = regexp.MustCompile(`(?i)\b([a-z0-9_.-]*(?:password|passwd|passphrase|secret|token|cvv2?|cvc|` +
+		`authorization|api_?key|private_?key|vault_?key|card_?number|shares))(\\?"?\s*[:=]\s*)` +
+		`("(?:[^"\\]|\\.)*"|\\"(?:[^\\]|\\[^"])*\\"|\[[^\]]*\]|[^\s"\\,;&}]+)`)
+
+	// authCredentials matches the credentials of an Authorization header.
+	authCredentials = regexp.MustCompile(`(?i)\b(Bearer|ApiKey|Basic)\s+[A-Za-z0-9._~+/=-]+`)
+
+	// jwt matches JSON web tokens.
+	jwt = regexp.MustCompile(`\beyJ[A-Za-z0-9_-]*\.[A-Za-z0-9_-]*\.[A-Za-z0-9_-]*`)
+
+	// apiKey matches personal API keys, which start with gk_.
+	apiKey = regexp.MustCompile(`\bgk_[0-9a-fA-F]{20,}`)
+
+	// cardNumber matches runs of 13 to 19 digits, optionally grouped by spaces or dashes.
+	cardNumber = regexp.MustCompile(`\b\d(?:[ -]?\d){12,18}\b`)
+)
+
+// Redact removes secrets from a log entry: the values of passwords, CVVs, secrets, tokens, keys,
+// recovery shares and card numbers by their keys, Authorization credentials, JWTs and API keys wherever they appear,
+// and all but the last four digits of any number passing the Luhn check of card numbers.
+func Redact(line []byte) []byte {
+	line = authCredentials.ReplaceAll(line, []byte("$1 "+redacted))
+	line = jwt.ReplaceAll(line, []byte(redacted))
+	line = apiKey.ReplaceAll(line, []byte("gk_"+redacted))
+	line = sensitiveField.ReplaceAllFunc(line, func(match []byte) []byte {
+		sub := sensitiveField.FindSubmatch(match)
+		value := sub[3]
+		switch {
+		case bytes.HasPrefix(value, []byte(`\"`)):
+			value = []byte(`\"` + redacted + `\"`)
+		case bytes.HasPrefix(value, []byte(`"`)):
+			value = []byte(`"` + redacted + `"`)
+		default:
+			value = []byte(redacted)
+		}
+		return append(append(sub[1], sub[2]...), value...)
+	})
+	return cardNumber.ReplaceAllFunc(line, maskCardNumber)
+}
+
+// maskCardNumber masks all but the last four digits of a card number; other numbers are returned as they are.
+func maskCardNumber(match []byte) []byte {
+	digits := make([]byte, 0, len(match))
+	for _, c := range match {
+		if c >= '0' && c <= '9' {
+			digits = append(digits, c)
+		}
+	}
+	if !luhn(digits) {
+		return match
+	}
+	return append([]byte("****"), digits[len(digits)-4:]...)
+}
+
+// luhn reports whether a number passes the Luhn check, which all card numbers pass.
+func luhn(digits []byte) bool {
+	sum := 0
+	for i := range digits {
+		d := int(digits[len(digits)-1-i] - '0')
+		if i%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+// redactingWriter is a log sink removing secrets from each entry before writing it.
+type redactingWriter struct {
+	w io.Writer
+}
+
+// Write redacts and writes a log entry. The JSON encoder writes each entry in a single call.
+func (r redactingWriter) Write(p []byte) (int, error) {
+	if _, err := r.w.Write(Redact(p)); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Sync flushes the underlying sink, if it buffers.
+func (r redactingWriter) Sync() error {
+	if s, ok := r.w.(zapcore.WriteSyncer); ok {
+		return s.Sync()
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+// Package metrics provides the Prometheus metrics of the application and the handler exposing them.
+//
+// The metrics are registered with a registry of their own, together with the Go runtime and process metrics,
+// so that only the application's metrics are exposed.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// namespace prefixes the names of the application's metrics.
+const namespace = "gophkeeper"
+
+// Sign-in outcomes, the values of the outcome label of SignIns.
+const (
+	SignInSuccess       = "success"
+	SignInBadPassword   = "bad_password"
+	SignInNotFound      = "not_found"
+	SignInLocked        = "locked"
+	SignInInvalidDevice = "invalid_device"
+	SignInError         = "error"
+)
+
+// Transaction rollback reasons, the values of the reason label of TxRollbacks.
+const (
+	RollbackError = "error"
+	RollbackPanic = "panic"
+)
+
+// Registry holds the metrics of the application.
+var Registry = prometheus.NewRegistry()
+
+var (
+	// HTTPRequestDuration observes the duration of the API requests by method, route template and status code.
+	HTTPRequestDuration = promauto.With(Registry).NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of API requests by method, route template and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	// SignIns counts the sign-in attempts by outcome.
+	SignIns = promauto.With(Registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "auth",
+		Name:      "sign_ins_total",
+		Help:      "Sign-in attempts by outcome: success, bad_password, not_found, locked, invalid_device or error.",
+	}, []string{"outcome"})
+
+	// TxDuration observes the duration of database transactions, from begin to commit or rollback.
+	TxDuration = promauto.With(Registry).NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "tx_duration_seconds",
+		Help:      "Duration of database transactions, from begin to commit or rollback.",
+		Buckets:   prometheus.DefBuckets,
+	})
+
+	// TxRollbacks counts the rolled back database transactions by reason.
+	TxRollbacks = promauto.With(Registry).NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "tx_rollbacks_total",
+		Help:      "Rolled back database transactions by reason: error or panic.",
+	}, []string{"reason"})
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// Handler serves the metrics of the registry in the Prometheus exposition format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
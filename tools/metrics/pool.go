@@ -0,0 +1,78 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// poolCollector collects the statistics of a database connection pool when the metrics are scraped.
+type poolCollector struct {
+	pool *pgxpool.Pool
+
+	acquiredConns        *prometheus.Desc
+	idleConns            *prometheus.Desc
+	constructingConns    *prometheus.Desc
+	totalConns           *prometheus.Desc
+	maxConns             *prometheus.Desc
+	acquires             *prometheus.Desc
+	acquireDuration      *prometheus.Desc
+	emptyAcquires        *prometheus.Desc
+	canceledAcquires     *prometheus.Desc
+	newConns             *prometheus.Desc
+	maxLifetimeDestroyed *prometheus.Desc
+	maxIdleDestroyed     *prometheus.Desc
+}
+
+// RegisterPool registers the statistics of a database connection pool with the registry.
+func RegisterPool(pool *pgxpool.Pool) error {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "db_pool", name), help, nil, nil)
+	}
+	return Registry.Register(&poolCollector{
+		pool:              pool,
+		acquiredConns:     desc("acquired_conns", "Connections currently acquired from the pool."),
+		idleConns:         desc("idle_conns", "Idle connections in the pool."),
+		constructingConns: desc("constructing_conns", "Connections being established."),
+		totalConns:        desc("total_conns", "Connections in the pool, acquired, idle or being established."),
+		maxConns:          desc("max_conns", "Maximum size of the pool."),
+		acquires:          desc("acquires_total", "Connections acquired from the pool."),
+		acquireDuration: desc("acquire_duration_seconds_total",
+			"Total time spent acquiring connections from the pool."),
+		emptyAcquires: desc("empty_acquires_total",
+			"Acquires that waited for a connection because the pool was empty."),
+		canceledAcquires: desc("canceled_acquires_total", "Acquires canceled by their context."),
+		newConns:         desc("new_conns_total", "Connections established."),
+		maxLifetimeDestroyed: desc("max_lifetime_destroyed_total",
+			"Connections closed for exceeding their maximum lifetime."),
+		maxIdleDestroyed: desc("max_idle_destroyed_total", "Connections closed for exceeding their maximum idle time."),
+	})
+}
+
+// Describe sends the descriptors of the pool statistics.
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	prometheus.DescribeByCollect(c, ch)
+}
+
+// Collect sends the current pool statistics.
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+	gauge := func(desc *prometheus.Desc, v float64) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, v)
+	}
+	counter := func(desc *prometheus.Desc, v float64) {
+		ch <- prometheus.MustNewConstMetric(desc, prometheus.CounterValue, v)
+	}
+
+	gauge(c.acquiredConns, float64(stat.AcquiredConns()))
+	gauge(c.idleConns, float64(stat.IdleConns()))
+	gauge(c.constructingConns, float64(stat.ConstructingConns()))
+	gauge(c.totalConns, float64(stat.TotalConns()))
+	gauge(c.maxConns, float64(stat.MaxConns()))
+	counter(c.acquires, float64(stat.AcquireCount()))
+	counter(c.acquireDuration, stat.AcquireDuration().Seconds())
+	counter(c.emptyAcquires, float64(stat.EmptyAcquireCount()))
+	counter(c.canceledAcquires, float64(stat.CanceledAcquireCount()))
+	counter(c.newConns, float64(stat.NewConnsCount()))
+	counter(c.maxLifetimeDestroyed, float64(stat.MaxLifetimeDestroyCount()))
+	counter(c.maxIdleDestroyed, float64(stat.MaxIdleDestroyCount()))
+}
@@ -0,0 +1,41 @@
+// Package notify provides a pluggable way to tell users about security-relevant events,
+// such as emergency access requests. Delivery channels implement the Notifier interface.
+package notify
+
+import (
+	"context"
+
+	"github.com/gleb-korostelev/GophKeeper/tools/logger"
+)
+
+// Notification represents a message addressed to a single user.
+//
+// Fields:
+// - Recipient: The username of the user to notify.
+// - Subject: A short summary of the event.
+// - Body: The full message text.
+type Notification struct {
+	Recipient string
+	Subject   string
+	Body      string
+}
+
+// Notifier delivers notifications to users.
+type Notifier interface {
+	Notify(ctx context.Context, n Notification) error
+}
+
+// logNotifier is a Notifier that writes notifications to the application log.
+type logNotifier struct{}
+
+// NewLogNotifier creates a Notifier that writes notifications to the application log.
+// It is used when no other delivery channel is configured.
+func NewLogNotifier() Notifier {
+	return logNotifier{}
+}
+
+// Notify writes the notification to the application log.
+func (logNotifier) Notify(_ context.Context, n Notification) error {
+	logger.Infof("notification to %s: %s: %s", n.Recipient, n.Subject, n.Body)
+	return nil
+}
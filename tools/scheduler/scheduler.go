@@ -0,0 +1,51 @@
+// Package scheduler runs periodic background jobs, such as purging expired data,
+// until they are closed together with the rest of the application resources.
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/tools/logger"
+)
+
+// Job represents a running periodic background job.
+// It implements closer.Closer, so it can be registered with the global closer.
+type Job struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// Every starts a job that calls f every interval until the job is closed or ctx is done.
+// Errors returned by f are logged and do not stop the job.
+func Every(ctx context.Context, name string, interval time.Duration, f func(ctx context.Context) error) *Job {
+	ctx, cancel := context.WithCancel(ctx)
+	j := &Job{cancel: cancel, done: make(chan struct{})}
+
+	go func() {
+		defer close(j.done)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := f(ctx); err != nil {
+					logger.Errorf("scheduled job %s failed: %v", name, err)
+				}
+			}
+		}
+	}()
+
+	return j
+}
+
+// Close stops the job and waits for a running iteration to finish.
+func (j *Job) Close() error {
+	j.cancel()
+	<-j.done
+	return nil
+}
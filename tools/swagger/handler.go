@@ -7,8 +7,6 @@ import (
 	"reflect"
 	"sort"
 	"strings"
-
-	"github.com/gleb-korostelev/GophKeeper/config"
 )
 
 // Handler struct
@@ -59,7 +57,7 @@ func (h *Handler) AddMimeTypeProduce() string {
 	return fmt.Sprintf(responseProduce, fmt.Sprintf(`"%s"`, mimes[0]))
 }
 
-func GenerateDoc(nameAPI string, handlers []Handler) (string, error) {
+func GenerateDoc(nameAPI, host string, handlers []Handler) (string, error) {
 	var (
 		handlersByPath = make(map[string][]Handler, len(handlers))
 		paths          = make([]string, 0, len(handlers))
@@ -162,8 +160,6 @@ func GenerateDoc(nameAPI string, handlers []Handler) (string, error) {
 		return paths[i] < paths[j]
 	})
 
-	host := config.GetConfigString(config.HttpsHost)
-
 	return fmt.Sprintf(SwaggerJSON, nameAPI, host, strings.Join(paths, ",")), nil
 }
 
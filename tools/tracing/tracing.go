@@ -0,0 +1,119 @@
+// Package tracing provides OpenTelemetry tracing of the application.
+//
+// Spans are started with Start in the router middleware, the service methods, the database transactions
+// and around each database query, so that the time of a request can be split between them. The W3C trace
+// context of incoming requests is continued, and finished spans are exported over OTLP/HTTP or to stdout.
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/gleb-korostelev/GophKeeper/tools/closer"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentation names the tracer of the application.
+const instrumentation = "github.com/gleb-korostelev/GophKeeper"
+
+// Options configures the export of spans.
+//
+// Fields:
+// - Exporter: Where spans are exported: "none", "otlp" or "stdout".
+// - Endpoint: The URL of the OTLP/HTTP collector; empty uses the OTEL_EXPORTER_OTLP_* environment variables.
+// - SampleRatio: The fraction of traces sampled, unless the caller already decided whether to sample them.
+// - ServiceName: The name of the service the spans come from.
+// - ServiceVersion: The version of the service.
+type Options struct {
+	Exporter       string
+	Endpoint       string
+	SampleRatio    float64
+	ServiceName    string
+	ServiceVersion string
+}
+
+// shutdownTimeout bounds how long exporting the remaining spans may delay shutdown.
+const shutdownTimeout = 5 * time.Second
+
+// provider flushes the spans of a tracer provider when it is closed.
+type provider struct {
+	tp *sdktrace.TracerProvider
+}
+
+// Close exports the remaining spans and stops the tracer provider.
+func (p provider) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	return p.tp.Shutdown(ctx)
+}
+
+// Setup installs the W3C trace context and baggage propagators and, unless the exporter is "none", a tracer
+// provider exporting spans. The returned closer exports the remaining spans; it is nil if nothing is exported.
+//
+// Without an exporter, spans are not recorded, but the trace context of incoming requests is still passed on
+// and logged.
+func Setup(ctx context.Context, opts Options) (closer.Closer, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var (
+		exporter sdktrace.SpanExporter
+		err      error
+	)
+	switch opts.Exporter {
+	case "none":
+		return nil, nil
+	case "otlp":
+		var clientOpts []otlptracehttp.Option
+		if opts.Endpoint != "" {
+			clientOpts = append(clientOpts, otlptracehttp.WithEndpointURL(opts.Endpoint))
+		}
+		exporter, err = otlptracehttp.New(ctx, clientOpts...)
+	case "stdout":
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	default:
+		return nil, fmt.Errorf("unknown trace exporter %q", opts.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s trace exporter: %w", opts.Exporter, err)
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		semconv.ServiceName(opts.ServiceName),
+		semconv.ServiceVersion(opts.ServiceVersion),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace resource: %w", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SampleRatio))),
+	)
+	otel.SetTracerProvider(tp)
+	return provider{tp: tp}, nil
+}
+
+// Start starts a span with the given name, a child of the span in ctx if there is one.
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentation).Start(ctx, name, opts...)
+}
+
+// End ends a span, recording err on it if it is not nil.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}